@@ -379,6 +379,10 @@ func NewOsmosisApp(
 		// Create sqs grpc client
 		sqsGRPCClients := make([]domain.SQSGRPClient, len(sqsConfig.GRPCIngestAddress))
 		for i, grpcIngestAddress := range sqsConfig.GRPCIngestAddress {
+			if sqsConfig.GRPCIngestDeltaStreaming {
+				sqsGRPCClients[i] = sqsservice.NewGRPCDeltaClient(grpcIngestAddress, sqsConfig.GRPCIngestMaxCallSizeBytes, appCodec)
+				continue
+			}
 			sqsGRPCClients[i] = sqsservice.NewGRPCCLient(grpcIngestAddress, sqsConfig.GRPCIngestMaxCallSizeBytes, appCodec)
 		}
 
@@ -410,8 +414,22 @@ func NewOsmosisApp(
 	}
 
 	// initialize indexer if enabled
+	var indexerDeliveryPublisher *indexer.DeliveryPublisher
 	if indexerConfig.IsEnabled {
-		indexerPublisher := indexerConfig.Initialize()
+		indexerClient, err := indexerConfig.Initialize()
+		if err != nil {
+			panic(fmt.Sprintf("failed to initialize the indexer publisher: %s", err))
+		}
+
+		// Deliver the indexer messages at least once, with idempotency keys, by checkpointing the delivered heights
+		// and recording the undelivered messages in an outbox next to the node data.
+		indexerDeliveryPublisher = indexer.NewDeliveryPublisher(
+			indexerClient,
+			indexerservice.NewFileHeightCheckpoint(filepath.Join(dataDir, indexer.DeliveryCheckpointFileName)),
+			filepath.Join(dataDir, indexer.DeliveryOutboxFileName),
+			logger,
+		)
+		indexerPublisher := indexerDeliveryPublisher
 
 		// TODO: handle graceful shutdown
 		pubSubCtx := context.Background()
@@ -529,6 +547,7 @@ func NewOsmosisApp(
 					gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 					gammclient.SetScalingFactorControllerProposalHandler,
 					clclient.TickSpacingDecreaseProposalHandler,
+					clclient.SetDynamicSpreadFactorProposalHandler,
 					cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 					cwpoolclient.MigratePoolContractsProposalHandler,
 					txfeesclient.SubmitUpdateFeeTokenProposalHandler,
@@ -568,12 +587,12 @@ func NewOsmosisApp(
 	app.sm.RegisterStoreDecoders()
 
 	// initialize lanes + mempool
-	mevLane, defaultLane := CreateLanes(app, txConfig)
+	mevLane, intentLane, defaultLane := CreateLanes(app, txConfig)
 
 	// create the mempool
 	lanedMempool, err := block.NewLanedMempool(
 		app.Logger(),
-		[]block.Lane{mevLane, defaultLane},
+		[]block.Lane{mevLane, intentLane, defaultLane},
 	)
 	if err != nil {
 		panic(err)
@@ -614,6 +633,7 @@ func NewOsmosisApp(
 		base.WithAnteHandler(anteHandler),
 	}
 	mevLane.WithOptions(opt...)
+	intentLane.WithOptions(opt...)
 	defaultLane.WithOptions(opt...)
 
 	// ABCI handlers
@@ -702,6 +722,14 @@ func NewOsmosisApp(
 		}
 	}
 
+	if indexerDeliveryPublisher != nil {
+		// Redeliver the indexer messages of the committed heights that were not acknowledged before the node stopped.
+		// On failure, they are redelivered with the messages of the next block.
+		if err := indexerDeliveryPublisher.Start(context.Background(), app.ChainID(), app.LastBlockHeight()); err != nil {
+			logger.Error("failed to redeliver the indexer messages", "err", err)
+		}
+	}
+
 	return app
 }
 
@@ -715,6 +743,30 @@ func getSQSServiceWriteListeners(app *OsmosisApp, appCodec codec.Codec, blockPoo
 	return writeListeners, storeKeyMap
 }
 
+// NewIndexerBackfiller creates a backfiller replaying the blocks of the given source and publishing
+// the same data as the indexer streaming service through the given publisher.
+func (app *OsmosisApp) NewIndexerBackfiller(publisher indexerdomain.Publisher, source indexerdomain.BlockSource, checkpoint indexerdomain.HeightCheckpoint) *indexerservice.Backfiller {
+	keepers := indexerdomain.Keepers{
+		BankKeeper:        app.BankKeeper,
+		PoolManagerKeeper: app.PoolManagerKeeper,
+	}
+
+	poolKeepers := commondomain.PoolExtractorKeepers{
+		GammKeeper:         app.GAMMKeeper,
+		CosmWasmPoolKeeper: app.CosmwasmPoolKeeper,
+		WasmKeeper:         app.WasmKeeper,
+		BankKeeper:         app.BankKeeper,
+		ProtorevKeeper:     app.ProtoRevKeeper,
+		PoolManagerKeeper:  app.PoolManagerKeeper,
+		ConcentratedKeeper: app.ConcentratedLiquidityKeeper,
+	}
+
+	poolTracker := pooltracker.NewMemory()
+	poolExtractor := poolextractor.New(poolKeepers, poolTracker)
+
+	return indexerservice.NewBackfiller(publisher, source, checkpoint, poolExtractor, poolTracker, keepers, app.GetTxConfig().TxDecoder(), app.Logger())
+}
+
 // getIndexerServiceWriteListeners returns the write listeners for the app that are specific to the indexer service.
 func getIndexerServiceWriteListeners(ctx context.Context, app *OsmosisApp, appCodec codec.Codec, blockPoolUpdateTracker domain.BlockPoolUpdateTracker, wasmkeeper *wasmkeeper.Keeper, client indexerdomain.Publisher, blockProcessStrategyManager commondomain.BlockProcessStrategyManager) (map[storetypes.StoreKey][]commondomain.WriteListener, map[string]storetypes.StoreKey) {
 	writeListeners, storeKeyMap := getPoolWriteListeners(app, appCodec, blockPoolUpdateTracker, wasmkeeper)
@@ -1146,7 +1198,17 @@ func (app *OsmosisApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain)
 		panic(err)
 	}
 
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	res, err := app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	if err != nil {
+		return nil, err
+	}
+
+	// Index the pools set by the pool modules' genesis by their denoms, which needs all pool modules to be initialized.
+	if err := app.PoolManagerKeeper.SetAllPoolDenomIndexes(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // LoadHeight loads a particular height.
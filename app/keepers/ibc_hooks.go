@@ -0,0 +1,34 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	ibchookstypes "github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// IBCHooksPoolManagerWrapper is a local wrapper around the poolmanager keeper that exports the swaps
+// used by the osmosis_swap memos of the ibc-hooks middleware
+type IBCHooksPoolManagerWrapper struct {
+	poolManagerKeeper *poolmanager.Keeper
+}
+
+var _ ibchookstypes.PoolManagerKeeper = IBCHooksPoolManagerWrapper{}
+
+func NewIBCHooksPoolManagerWrapper(poolManagerKeeper *poolmanager.Keeper) IBCHooksPoolManagerWrapper {
+	return IBCHooksPoolManagerWrapper{poolManagerKeeper: poolManagerKeeper}
+}
+
+func (w IBCHooksPoolManagerWrapper) RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []ibchookstypes.SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int) (osmomath.Int, error) {
+	poolManagerRoutes := make([]poolmanagertypes.SwapAmountInRoute, len(routes))
+	for i, route := range routes {
+		poolManagerRoutes[i] = poolmanagertypes.SwapAmountInRoute{
+			PoolId:        route.PoolId,
+			TokenOutDenom: route.TokenOutDenom,
+		}
+	}
+	return w.poolManagerKeeper.RouteExactAmountIn(ctx, sender, poolManagerRoutes, tokenIn, tokenOutMinAmount)
+}
@@ -236,11 +236,13 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.AuthenticatorManager = authenticator.NewAuthenticatorManager()
 	appKeepers.AuthenticatorManager.InitializeAuthenticators([]authenticator.Authenticator{
 		authenticator.NewSignatureVerification(appKeepers.AccountKeeper),
+		authenticator.NewPasskeyVerification(appKeepers.AccountKeeper),
 		authenticator.NewMessageFilter(encodingConfig),
 		authenticator.NewAllOf(appKeepers.AuthenticatorManager),
 		authenticator.NewAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAnyOf(appKeepers.AuthenticatorManager),
 		authenticator.NewPartitionedAllOf(appKeepers.AuthenticatorManager),
+		authenticator.NewSessionKey(appKeepers.AuthenticatorManager, appKeepers.keys[smartaccounttypes.StoreKey]),
 	})
 	govModuleAddr := appKeepers.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
 
@@ -457,8 +459,17 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
-	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
+	// register the native spend limit authenticator, which values spending with twap prices
+	appKeepers.AuthenticatorManager.RegisterAuthenticator(
+		authenticator.NewSpendLimit(appKeepers.keys[smartaccounttypes.StoreKey], appKeepers.BankKeeper, appKeepers.TwapKeeper))
+
+	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(
+		appKeepers.keys[epochstypes.StoreKey],
+		appKeepers.tkeys[epochstypes.TransientStoreKey],
+		appKeepers.GetSubspace(epochstypes.ModuleName),
+	)
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
@@ -577,7 +588,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	wasmCapabilities := wasmkeeper.BuiltInCapabilities()
 	wasmCapabilities = append(wasmCapabilities, "osmosis")
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.BankKeeper, appKeepers.TokenFactoryKeeper, appKeepers.PoolManagerKeeper, appKeepers.ConcentratedLiquidityKeeper, appKeepers.LockupKeeper, appKeepers.TwapKeeper), wasmOpts...)
 	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	wasmKeeper := wasmkeeper.NewKeeper(
@@ -608,6 +619,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
 	appKeepers.RateLimitingICS4Wrapper.ContractKeeper = appKeepers.ContractKeeper
 	appKeepers.Ics20WasmHooks.ContractKeeper = appKeepers.WasmKeeper
+	appKeepers.Ics20WasmHooks.PoolManagerKeeper = NewIBCHooksPoolManagerWrapper(appKeepers.PoolManagerKeeper)
+	appKeepers.Ics20WasmHooks.TransferKeeper = appKeepers.TransferKeeper
+	appKeepers.Ics20WasmHooks.BankKeeper = appKeepers.BankKeeper
 	appKeepers.CosmwasmPoolKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 	appKeepers.IBCHooksKeeper.ContractKeeper = appKeepers.ContractKeeper
 	appKeepers.ConcentratedLiquidityKeeper.SetContractKeeper(appKeepers.ContractKeeper)
@@ -637,7 +651,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		AddRoute(concentratedliquiditytypes.RouterKey, concentratedliquidity.NewConcentratedLiquidityProposalHandler(*appKeepers.ConcentratedLiquidityKeeper)).
 		AddRoute(cosmwasmpooltypes.RouterKey, cosmwasmpool.NewCosmWasmPoolProposalHandler(*appKeepers.CosmwasmPoolKeeper)).
 		AddRoute(poolmanagertypes.RouterKey, poolmanager.NewPoolManagerProposalHandler(*appKeepers.PoolManagerKeeper)).
-		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper))
+		AddRoute(incentivestypes.RouterKey, incentiveskeeper.NewIncentivesProposalHandler(*appKeepers.IncentivesKeeper)).
+		AddRoute(ibcratelimittypes.RouterKey, ibcratelimit.NewRateLimitProposalHandler(appKeepers.RateLimitingICS4Wrapper))
 
 	govConfig := govtypes.DefaultConfig()
 	// Set the maximum metadata length for government-related configurations to 10,200, deviating from the default value of 256.
@@ -685,6 +700,7 @@ func (appKeepers *AppKeepers) WireICS20PreWasmKeeper(
 		nil,
 		appKeepers.BankKeeper,
 		appKeepers.GetSubspace(ibcratelimittypes.ModuleName),
+		appKeepers.keys[ibcratelimittypes.StoreKey],
 	)
 	appKeepers.RateLimitingICS4Wrapper = &rateLimitingICS4Wrapper
 
@@ -817,6 +833,7 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(smartaccounttypes.ModuleName).WithKeyTable(smartaccounttypes.ParamKeyTable())
 	paramsKeeper.Subspace(txfeestypes.ModuleName)
 	paramsKeeper.Subspace(auctiontypes.ModuleName)
+	paramsKeeper.Subspace(epochstypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -833,6 +850,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.TokenFactoryKeeper.Hooks(),
 		),
 	)
+	// Enforce the freezes and pauses of the tokenfactory denoms on every send.
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.SendRestriction)
 
 	appKeepers.StakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -888,6 +907,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
@@ -941,5 +961,6 @@ func KVStoreKeys() []string {
 		cosmwasmpooltypes.StoreKey,
 		auctiontypes.StoreKey,
 		smartaccounttypes.StoreKey,
+		ibcratelimittypes.StoreKey,
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	protorevtypes "github.com/osmosis-labs/osmosis/v31/x/protorev/types"
 	twaptypes "github.com/osmosis-labs/osmosis/v31/x/twap/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // GenerateKeys generates new keys (KV Store, Transient store, and memory store).
@@ -17,7 +18,7 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 	appKeepers.keys = storetypes.NewKVStoreKeys(KVStoreKeys()...)
 
 	// Define transient store keys
-	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey, protorevtypes.TransientStoreKey)
+	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, twaptypes.TransientStoreKey, protorevtypes.TransientStoreKey, epochstypes.TransientStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -47,6 +47,7 @@ import (
 	downtimemodule "github.com/osmosis-labs/osmosis/v31/x/downtime-detector/module"
 	"github.com/osmosis-labs/osmosis/v31/x/gamm"
 	gammclient "github.com/osmosis-labs/osmosis/v31/x/gamm/client"
+	ibcratelimitclient "github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/client"
 	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/ibcratelimitmodule"
 	"github.com/osmosis-labs/osmosis/v31/x/incentives"
 	incentivesclient "github.com/osmosis-labs/osmosis/v31/x/incentives/client"
@@ -91,11 +92,18 @@ var AppModuleBasics = module.NewBasicManager(
 			gammclient.CreateCLPoolAndLinkToCFMMProposalHandler,
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SetDynamicSpreadFactorProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
+			ibcratelimitclient.AddRateLimitProposalHandler,
+			ibcratelimitclient.RemoveRateLimitProposalHandler,
+			ibcratelimitclient.ResetRateLimitProposalHandler,
+			ibcratelimitclient.EditRateLimitProposalHandler,
+			ibcratelimitclient.SetDenomRestrictionsProposalHandler,
+			ibcratelimitclient.UnsetDenomRestrictionsProposalHandler,
 		},
 	),
 	params.AppModuleBasic{},
@@ -15,21 +15,28 @@ import (
 	"github.com/skip-mev/block-sdk/v2/block/base"
 	defaultlane "github.com/skip-mev/block-sdk/v2/lanes/base"
 	mevlane "github.com/skip-mev/block-sdk/v2/lanes/mev"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
 )
 
 const (
 	maxTxPerTopOfBlockAuctionLane = 500  // this is the maximum # of bids that will be held in the app-side in-memory mempool
+	maxTxPerIntentLane            = 500  // swap intents settled in the batch auction at the end of the block
 	maxTxPerDefaultLane           = 3000 // all other txs
+
+	// IntentLaneName is the name of the lane collecting swap intents.
+	IntentLaneName = "intent"
 )
 
 var (
-	defaultLaneBlockspacePercentage           = math.LegacyMustNewDecFromStr("0.90")
+	defaultLaneBlockspacePercentage           = math.LegacyMustNewDecFromStr("0.80")
 	topOfBlockAuctionLaneBlockspacePercentage = math.LegacyMustNewDecFromStr("0.10")
+	intentLaneBlockspacePercentage            = math.LegacyMustNewDecFromStr("0.10")
 )
 
 // CreateLanes walks through the process of creating the lanes for the block sdk. In this function
-// we create three separate lanes - MEV, Free, and Default - and then return them.
-func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *base.BaseLane) {
+// we create three separate lanes - MEV, Intent, and Default - and then return them.
+func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *base.BaseLane, *base.BaseLane) {
 	// Create the signer extractor. This is used to extract the expected signers from
 	// a transaction. Each lane can have a different signer extractor if needed.
 	signerAdapter := signerextraction.NewDefaultAdapter()
@@ -49,6 +56,17 @@ func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *
 		MaxTxs:          maxTxPerTopOfBlockAuctionLane,
 	}
 
+	// Create an intent configuration that accepts maxTxPerIntentLane transactions and consumes intentLaneBlockspacePercentage of the
+	// block space.
+	intentConfig := base.LaneConfig{
+		Logger:          app.Logger(),
+		TxEncoder:       txConfig.TxEncoder(),
+		TxDecoder:       txConfig.TxDecoder(),
+		MaxBlockSpace:   intentLaneBlockspacePercentage,
+		SignerExtractor: signerAdapter,
+		MaxTxs:          maxTxPerIntentLane,
+	}
+
 	// Create a default configuration that accepts maxTxPerDefaultLane transactions and consumes defaultLaneBlockspacePercentage of the
 	// block space.
 	defaultConfig := base.LaneConfig{
@@ -67,8 +85,12 @@ func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *
 	factory := mevlane.NewDefaultAuctionFactory(txConfig.TxDecoder(), signerAdapter)
 	mevMatchHandler := factory.MatchHandler()
 
+	// Create the final match handler for the intent lane. I.e this will direct all txs that only
+	// submit swap intents to this lane
+	intentMatchHandler := IntentMatchHandler()
+
 	// Create the final match handler for the default lane. I.e this will direct all txs that are
-	// not free nor mev to this lane
+	// not intents nor mev to this lane
 	defaultMatchHandler := base.DefaultMatchHandler()
 
 	// Create the lanes.
@@ -78,6 +100,21 @@ func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *
 		mevMatchHandler,
 	)
 
+	intentMempool := base.NewMempoolWithDefaultOrdering(
+		base.DefaultTxPriority(),
+		intentConfig.SignerExtractor,
+		intentConfig.MaxTxs,
+	)
+	intentLane, err := base.NewBaseLane(
+		intentConfig,
+		IntentLaneName,
+		base.WithMatchHandler(intentMatchHandler),
+		base.WithMempool(intentMempool),
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	defaultMempool := base.NewMempoolWithDefaultOrdering(
 		base.DefaultTxPriority(),
 		defaultConfig.SignerExtractor,
@@ -89,7 +126,25 @@ func CreateLanes(app *OsmosisApp, txConfig client.TxConfig) (*mevlane.MEVLane, *
 		defaultMempool,
 	)
 
-	return mevLane, defaultLane
+	return mevLane, intentLane, defaultLane
+}
+
+// IntentMatchHandler returns a match handler accepting txs whose messages all submit swap intents.
+// Intents are not executed when included, they are escrowed and settled together at a uniform
+// clearing price in the poolmanager EndBlock, so their order within the block does not matter.
+func IntentMatchHandler() base.MatchHandler {
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if _, ok := msg.(*poolmanagertypes.MsgSubmitSwapIntent); !ok {
+				return false
+			}
+		}
+		return true
+	}
 }
 
 var _ block.Mempool = (*LanedMempoolWithTelemetry)(nil)
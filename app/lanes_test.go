@@ -3,12 +3,15 @@ package app_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/app"
 	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
 
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -95,6 +98,46 @@ func (suite *MempoolCapacityTestSuite) TestDefaultLaneStaledTransactions() {
 	}
 }
 
+func TestIntentMatchHandler(t *testing.T) {
+	txConfig := app.MakeEncodingConfig().TxConfig
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	intentMsg := &poolmanagertypes.MsgSubmitSwapIntent{
+		Sender:            sender.String(),
+		TokenIn:           sdk.NewInt64Coin("uosmo", 1000),
+		TokenOutDenom:     "uion",
+		TokenOutMinAmount: osmomath.OneInt(),
+		Deadline:          time.Unix(1700000000, 0).UTC(),
+	}
+	sendMsg := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)))
+
+	tests := map[string]struct {
+		msgs          []sdk.Msg
+		expectedMatch bool
+	}{
+		"only swap intents": {
+			msgs:          []sdk.Msg{intentMsg, intentMsg},
+			expectedMatch: true,
+		},
+		"swap intent and other message": {
+			msgs: []sdk.Msg{intentMsg, sendMsg},
+		},
+		"no swap intent": {
+			msgs: []sdk.Msg{sendMsg},
+		},
+		"no messages": {},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			match := app.IntentMatchHandler()(sdk.Context{}, txBuilder.GetTx())
+			require.Equal(t, tc.expectedMatch, match)
+		})
+	}
+}
+
 func (suite *MempoolCapacityTestSuite) createTestTx(txIndex int) sdk.Tx {
 	// Use modulo to cycle through accounts if we have more transactions than accounts
 	accountIndex := txIndex % len(suite.accAddresses)
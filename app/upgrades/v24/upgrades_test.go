@@ -70,6 +70,11 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               time.Time{}, // no previous error
+		// records parsed from the store default the volatility and OHLC fields to zero.
+		P0HighSpotPrice: osmomath.ZeroDec(),
+		P0LowSpotPrice:  osmomath.ZeroDec(),
+		P1HighSpotPrice: osmomath.ZeroDec(),
+		P1LowSpotPrice:  osmomath.ZeroDec(),
 	}
 	twapRecord2 := twapRecord1
 	twapRecord2.Time = time.Date(2023, 0o2, 2, 0, 0, 0, 0, time.UTC)
@@ -4,10 +4,14 @@ import (
 	"context"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/osmosis-labs/osmosis/v31/app/keepers"
 	"github.com/osmosis-labs/osmosis/v31/app/upgrades"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 func CreateUpgradeHandler(
@@ -16,7 +20,9 @@ func CreateUpgradeHandler(
 	bpm upgrades.BaseAppParamManager,
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
-	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	return func(context context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(context)
+
 		// Run migrations before applying any other state changes.
 		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
 		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
@@ -24,6 +30,34 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// Initialize the limit order id counter added to concentrated liquidity.
+		keepers.ConcentratedLiquidityKeeper.SetNextLimitOrderId(ctx, 1)
+
+		// Set the bounds of the smart order router added to poolmanager.
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeySmartRouterParams, poolmanagertypes.DefaultSmartRouterParams())
+
+		// Index the existing pools by their denoms for the smart order router.
+		err = keepers.PoolManagerKeeper.SetAllPoolDenomIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Create the module account escrowing swap intents until they are settled.
+		keepers.AccountKeeper.GetModuleAccount(ctx, poolmanagertypes.SwapIntentEscrowName)
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeySwapIntentParams, poolmanagertypes.DefaultSwapIntentParams())
+
+		// Move the rate limits of the IBC rate limiting contract into the module state, which then enforces them.
+		err = keepers.RateLimitingICS4Wrapper.ImportContractState(ctx, keepers.WasmKeeper)
+		if err != nil {
+			return nil, err
+		}
+
+		// Set the params added to epochs, which bound the epoch work hooks may do per block.
+		keepers.EpochsKeeper.SetParams(ctx, epochstypes.DefaultParams())
+
+		// Set the minimum OSMO equivalent of the locks that can be superfluid redelegated.
+		keepers.SuperfluidKeeper.SetParam(ctx, superfluidtypes.KeyMinRedelegationOsmoAmount, superfluidtypes.DefaultMinRedelegationOsmoAmount)
+
 		return migrations, nil
 	}
 }
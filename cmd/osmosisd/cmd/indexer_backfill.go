@@ -0,0 +1,184 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	tmstore "github.com/cometbft/cometbft/store"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v31/app"
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer"
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	indexerservice "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service"
+)
+
+const flagCheckpointFile = "checkpoint-file"
+
+// indexerBackfillCmd replays a range of heights from the local block store and state history through the indexer publisher.
+func indexerBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer-backfill [start-height] [end-height]",
+		Short: "Publish the indexer data of a range of past heights",
+		Long: `Replays the blocks from start-height to end-height, both inclusive, from the local block store and state history,
+and publishes the same block, transaction, pair and token supply data as the indexer does live, through the publisher
+configured in the [osmosis-indexer] section of app.toml. The node must be stopped while backfilling.
+
+The blocks must be in the block store, their results must be in the state store (discard_abci_responses = false),
+and the application state must not be pruned from start-height - 1 to end-height (e.g. pruning = "nothing").
+
+The last published height is checkpointed after each block, so that an interrupted backfill resumes from the next height
+when run again with the same checkpoint file.
+Example:
+	osmosisd indexer-backfill 16000000 16100000
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			checkpointFile, err := cmd.Flags().GetString(flagCheckpointFile)
+			if err != nil {
+				return err
+			}
+			if checkpointFile == "" {
+				checkpointFile = filepath.Join(home, "data", "indexer-backfill-checkpoint.json")
+			}
+
+			indexerConfig := indexer.NewConfigFromOptions(serverCtx.Viper)
+			if !indexerConfig.IsEnabled {
+				return fmt.Errorf("the indexer must be enabled and configured in app.toml to backfill")
+			}
+			client, err := indexerConfig.Initialize()
+			if err != nil {
+				return err
+			}
+			// The messages are keyed like the live ones and their delivery is awaited before each height is checkpointed.
+			publisher := indexer.NewDeliveryPublisher(client, nil, "", serverCtx.Logger)
+			defer publisher.Close()
+
+			// The app must not run the live indexer, which would redeliver the messages of its own outbox.
+			serverCtx.Viper.Set("osmosis-indexer.is-enabled", false)
+
+			db, err := openDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return fmt.Errorf("error opening DB, make sure osmosisd is not running when backfilling: %w", err)
+			}
+			app := osmosis.NewOsmosisApp(serverCtx.Logger, db, nil, true, map[int64]bool{}, home, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+
+			source, err := newNodeBlockSource(serverCtx.Config, app.CommitMultiStore(), serverCtx.Logger)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			backfiller := app.NewIndexerBackfiller(publisher, source, indexerservice.NewFileHeightCheckpoint(checkpointFile))
+			return backfiller.Run(ctx, startHeight, endHeight)
+		},
+	}
+
+	cmd.Flags().String(flagCheckpointFile, "", "The file checkpointing the backfill progress (default: <home>/data/indexer-backfill-checkpoint.json)")
+
+	return cmd
+}
+
+var _ indexerdomain.BlockSource = (*nodeBlockSource)(nil)
+
+// nodeBlockSource loads the blocks from the block store, their results from the state store
+// and their state from the application multistore of the node.
+type nodeBlockSource struct {
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+	multiStore storetypes.CommitMultiStore
+	logger     log.Logger
+}
+
+func newNodeBlockSource(config *cmtcfg.Config, multiStore storetypes.CommitMultiStore, logger log.Logger) (*nodeBlockSource, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodeBlockSource{
+		blockStore: tmstore.NewBlockStore(blockStoreDB),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: false,
+		}),
+		multiStore: multiStore,
+		logger:     logger,
+	}, nil
+}
+
+// LoadFinalizeBlock implements indexerdomain.BlockSource.
+func (s *nodeBlockSource) LoadFinalizeBlock(height int64) (abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock, error) {
+	block := s.blockStore.LoadBlock(height)
+	if block == nil {
+		return abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}, fmt.Errorf("block %d not found in the block store, available heights are [%d, %d]", height, s.blockStore.Base(), s.blockStore.Height())
+	}
+
+	res, err := s.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return abci.RequestFinalizeBlock{}, abci.ResponseFinalizeBlock{}, fmt.Errorf("failed to load the results of block %d: %w", height, err)
+	}
+
+	req := abci.RequestFinalizeBlock{
+		Txs:                block.Txs.ToSliceOfBytes(),
+		Hash:               block.Hash(),
+		Height:             block.Height,
+		Time:               block.Time,
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+	}
+	return req, *res, nil
+}
+
+// ContextAtHeight implements indexerdomain.BlockSource.
+func (s *nodeBlockSource) ContextAtHeight(height int64) (sdk.Context, error) {
+	blockMeta := s.blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return sdk.Context{}, fmt.Errorf("block %d not found in the block store, available heights are [%d, %d]", height, s.blockStore.Base(), s.blockStore.Height())
+	}
+
+	cacheMultiStore, err := s.multiStore.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, fmt.Errorf("failed to load the state at height %d, make sure it is not pruned: %w", height, err)
+	}
+
+	header := cmtproto.Header{
+		ChainID: blockMeta.Header.ChainID,
+		Height:  blockMeta.Header.Height,
+		Time:    blockMeta.Header.Time,
+	}
+	return sdk.NewContext(cacheMultiStore, header, false, s.logger), nil
+}
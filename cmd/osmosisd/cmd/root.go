@@ -741,6 +741,9 @@ is-enabled = "{{ .SidecarQueryServerConfig.IsEnabled }}"
 grpc-ingest-address = "{{ .SidecarQueryServerConfig.GRPCIngestAddress }}"
 # The maximum size of the GRPC message that can be received by the sqs service in bytes.
 grpc-ingest-max-call-size-bytes = "{{ .SidecarQueryServerConfig.GRPCIngestMaxCallSizeBytes }}"
+# If true, only the changed pools, ticks, balances and taker fees of every block are streamed to the sqs service.
+# Requires a version of the sqs service that supports delta streaming.
+grpc-ingest-delta-streaming = "{{ .SidecarQueryServerConfig.GRPCIngestDeltaStreaming }}"
 
 ###############################################################################
 ###              Osmosis Indexer Configuration                              ###
@@ -750,6 +753,10 @@ grpc-ingest-max-call-size-bytes = "{{ .SidecarQueryServerConfig.GRPCIngestMaxCal
 # The indexer service is disabled by default.
 is-enabled = "{{ .IndexerConfig.IsEnabled }}"
 
+# The backend the indexer publishes to: "pubsub" (Google Pub/Sub), "kafka", "nats" (JetStream) or "file".
+# The kafka and nats publishers send the messages in batches from a background worker.
+publisher = "{{ .IndexerConfig.Publisher }}"
+
 # Max publish delay in seconds for the indexer service.
 # Mitigate the issue of messages remaining pending when the publishing rate is low,
 # ensuring timely delivery and preventing messages from appearing undelivered
@@ -758,6 +765,18 @@ max-publish-delay = "{{ .IndexerConfig.MaxPublishDelay }}"
 # The GCP project id to use for the indexer service.
 gcp-project-id = "{{ .IndexerConfig.GCPProjectId }}"
 
+# The comma separated addresses of the Kafka seed brokers, e.g. "kafka-1:9092,kafka-2:9092", used by the kafka publisher.
+# The topics, named by the topic ids, must be provisioned beforehand.
+kafka-brokers = "{{ .IndexerConfig.KafkaBrokers }}"
+
+# The url of the NATS server, used by the nats publisher.
+# The JetStream streams capturing the topic ids, used as subjects, must be provisioned beforehand.
+nats-url = "{{ .IndexerConfig.NATSURL }}"
+
+# The path of the JSON lines file the file publisher appends to.
+# Each line is a {"topic": ..., "data": ...} record. Empty topic ids default to the data type, e.g. "block".
+file-path = "{{ .IndexerConfig.FilePath }}"
+
 # The topic id to use for the publishing block data
 block-topic-id = "{{ .IndexerConfig.BlockTopicId }}"
 
@@ -809,6 +828,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, t
 		// genutilcli.InitCmd(tempApp.ModuleBasics, osmosis.DefaultNodeHome),
 		forceprune(),
 		moduleHashByHeightQuery(newApp),
+		indexerBackfillCmd(),
 		InitCmd(tempApp.ModuleBasics, osmosis.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome, genutiltypes.DefaultMessageValidator, valOperAddressCodec),
 		ExportDeriveBalancesCmd(),
@@ -0,0 +1,744 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+// Files of the live delivery, in the data directory of the node.
+const (
+	DeliveryCheckpointFileName = "indexer-delivery-checkpoint.json"
+	DeliveryOutboxFileName     = "indexer-outbox.jsonl"
+)
+
+// Kinds of the messages recorded in the outbox.
+const (
+	messageKindBlock             = "block"
+	messageKindTransaction       = "transaction"
+	messageKindTokenSupply       = "token_supply"
+	messageKindTokenSupplyOffset = "token_supply_offset"
+	messageKindPair              = "pair"
+)
+
+// outboxRecord is a line of the outbox file: a message published but not acknowledged yet.
+type outboxRecord struct {
+	Height  int64           `json:"height"`
+	Kind    string          `json:"kind"`
+	Message json.RawMessage `json:"message"`
+}
+
+var (
+	_ domain.Publisher       = (*DeliveryPublisher)(nil)
+	_ domain.DeliveryTracker = (*DeliveryPublisher)(nil)
+)
+
+// Defaults of the background acknowledgement of the DeliveryPublisher.
+const (
+	// defaultMaxOutboxBytes bounds the size of the outbox files while the delivery is failing.
+	defaultMaxOutboxBytes = 256 << 20
+	// defaultMinRetryBackoff and defaultMaxRetryBackoff bound the delay before retrying a failed acknowledgement.
+	defaultMinRetryBackoff = time.Second
+	defaultMaxRetryBackoff = time.Minute
+	// acknowledgeTimeout bounds the time spent delivering and acknowledging the messages of a height.
+	acknowledgeTimeout = 5 * time.Minute
+)
+
+// sealedOutboxSuffix is appended to the outbox path to name the file of the messages being acknowledged.
+const sealedOutboxSuffix = ".sealed"
+
+// DeliveryPublisher wraps a publisher to deliver each message at least once, with an idempotency key
+// letting the consumers drop the redelivered duplicates:
+// - the block messages are keyed by chain-id/height,
+// - the transaction messages by chain-id/height/tx-index,
+// - the token supply, token supply offset and pair messages by chain-id/height/kind/denom or pool,
+// suffixed by the occurrence index when the same key is published several times at a height.
+//
+// Every message is recorded in an outbox file before it is published. Once a height is committed,
+// AcknowledgeHeight hands it to a background worker and returns, so that the commit never waits for the backend.
+// The worker moves the messages up to the height to a sealed outbox file, waits for the backend to acknowledge them,
+// checkpoints the height as delivered and removes the sealed file. When the delivery fails, the sealed messages
+// are redelivered by the next attempt, retried with an exponential backoff, and on Start.
+//
+// The outbox is bounded: while the delivery keeps failing and the outbox is full, the messages are still published
+// but not recorded, and the heights that could not be recorded are logged to be backfilled.
+type DeliveryPublisher struct {
+	client     domain.Publisher
+	checkpoint domain.HeightCheckpoint
+	outboxPath string
+	logger     log.Logger
+
+	maxOutboxBytes  int64
+	minRetryBackoff time.Duration
+	maxRetryBackoff time.Duration
+
+	// acks signals the worker that a height was acknowledged, stop stops it and done is closed once it returned.
+	acks      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	mu sync.Mutex
+	// outbox is the active outbox file, opened on first use.
+	outbox *os.File
+	// activeBytes and sealedBytes are the sizes of the active and sealed outbox files,
+	// and activeMaxHeight is the highest height recorded in the active one.
+	activeBytes     int64
+	sealedBytes     int64
+	activeMaxHeight int64
+	// unrecordedFromHeight is the first height whose messages could not be recorded in the full outbox, if any.
+	unrecordedFromHeight int64
+	// chainId and height identify the messages published with a context that is not an sdk.Context,
+	// i.e. by the write listeners while the block at height is executed.
+	chainId string
+	height  int64
+	// keyCounts counts the messages published per idempotency key at keyCountsHeight.
+	keyCounts       map[string]int
+	keyCountsHeight int64
+	// redeliver is set when a delivery failed, so that the outbox is redelivered on the next acknowledgement.
+	redeliver bool
+	// redeliverSealed is set when the messages of the sealed outbox must be redelivered.
+	redeliverSealed bool
+	// ackHeight is the last height acknowledged by the node, and deliveredHeight the last one checkpointed.
+	ackHeight       int64
+	deliveredHeight int64
+	// lastAcknowledgeErr is the error of the last acknowledgement of the worker.
+	lastAcknowledgeErr error
+}
+
+// NewDeliveryPublisher creates a new DeliveryPublisher publishing through the given client.
+// checkpoint persists the last delivered height and outboxPath is the path of the outbox file.
+// Without a checkpoint and an outbox path, the messages are only keyed, and AcknowledgeHeight synchronously
+// waits for the backend to acknowledge them.
+func NewDeliveryPublisher(client domain.Publisher, checkpoint domain.HeightCheckpoint, outboxPath string, logger log.Logger) *DeliveryPublisher {
+	return &DeliveryPublisher{
+		client:          client,
+		checkpoint:      checkpoint,
+		outboxPath:      outboxPath,
+		logger:          logger,
+		maxOutboxBytes:  defaultMaxOutboxBytes,
+		minRetryBackoff: defaultMinRetryBackoff,
+		maxRetryBackoff: defaultMaxRetryBackoff,
+		acks:            make(chan struct{}, 1),
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+		keyCounts:       make(map[string]int),
+	}
+}
+
+// Start sets the chain id and the last height committed by the node, then redelivers the messages
+// of the heights after the last delivered one that remain in the outbox.
+// The heights after the last delivered one that are not in the outbox cannot be redelivered and are logged,
+// so that they can be published with the indexer-backfill command.
+func (p *DeliveryPublisher) Start(ctx context.Context, chainId string, lastHeight int64) error {
+	p.mu.Lock()
+	p.chainId = chainId
+	p.height = lastHeight + 1
+	p.ackHeight = lastHeight
+	p.mu.Unlock()
+
+	if p.checkpoint == nil {
+		return nil
+	}
+
+	deliveredHeight, found, err := p.checkpoint.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load the indexer delivery checkpoint: %w", err)
+	}
+
+	p.mu.Lock()
+	p.deliveredHeight = deliveredHeight
+	err = p.statOutbox()
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	redeliveredHeights := make(map[int64]struct{})
+	for _, path := range []string{p.sealedOutboxPath(), p.outboxPath} {
+		if err := p.redeliverOutbox(ctx, path, deliveredHeight, redeliveredHeights); err != nil {
+			p.mu.Lock()
+			p.redeliver = true
+			p.mu.Unlock()
+			return err
+		}
+	}
+
+	if found && deliveredHeight < lastHeight {
+		missingHeights := lastHeight - deliveredHeight
+		for height := range redeliveredHeights {
+			if height > deliveredHeight && height <= lastHeight {
+				missingHeights--
+			}
+		}
+		if missingHeights > 0 {
+			p.logger.Error("Indexer messages of committed heights are missing from the outbox and were not redelivered, backfill them with the indexer-backfill command",
+				"from_height", deliveredHeight+1, "to_height", lastHeight, "missing_heights", missingHeights)
+		}
+	}
+
+	if len(redeliveredHeights) > 0 {
+		p.logger.Info("Redelivered the indexer messages remaining in the outbox", "from_height", deliveredHeight+1, "heights", len(redeliveredHeights))
+	}
+
+	// The messages after the last committed height belong to a block that was not committed and will be executed again.
+	p.mu.Lock()
+	err = p.sealOutbox(math.MaxInt64)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return p.acknowledge(ctx, lastHeight)
+}
+
+// AcknowledgeHeight implements domain.DeliveryTracker.
+// With an outbox, the messages are acknowledged by a background worker and AcknowledgeHeight returns immediately.
+// The acknowledgements failing in the background are logged and retried.
+func (p *DeliveryPublisher) AcknowledgeHeight(ctx context.Context, height int64) error {
+	if p.outboxPath == "" {
+		if err := p.acknowledge(ctx, height); err != nil {
+			return err
+		}
+		p.mu.Lock()
+		p.height = height + 1
+		p.mu.Unlock()
+		return nil
+	}
+
+	p.mu.Lock()
+	p.height = height + 1
+	p.ackHeight = height
+	p.mu.Unlock()
+
+	p.startOnce.Do(func() { go p.run() })
+	select {
+	case p.acks <- struct{}{}:
+	default:
+		// The worker was already signaled, and acknowledges the last height when it runs.
+	}
+	return nil
+}
+
+// run is the worker loop acknowledging the heights, retrying the failed acknowledgements with an exponential backoff.
+// A failed acknowledgement is retried for the last acknowledged height, so the heights acknowledged while
+// waiting for the retry are acknowledged together.
+func (p *DeliveryPublisher) run() {
+	defer close(p.done)
+
+	var (
+		backoff time.Duration
+		retry   <-chan time.Time
+	)
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-p.acks:
+			if retry != nil {
+				continue
+			}
+		case <-retry:
+		}
+
+		p.mu.Lock()
+		height := p.ackHeight
+		p.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), acknowledgeTimeout)
+		err := p.acknowledge(ctx, height)
+		cancel()
+
+		p.mu.Lock()
+		p.lastAcknowledgeErr = err
+		p.mu.Unlock()
+
+		if err == nil {
+			backoff = 0
+			retry = nil
+			continue
+		}
+
+		backoff = min(max(2*backoff, p.minRetryBackoff), p.maxRetryBackoff)
+		p.logger.Error("Failed to acknowledge the indexer messages, retrying", "height", height, "retry_in", backoff, "err", err)
+		retry = time.After(backoff)
+	}
+}
+
+// acknowledge seals the messages of the outbox up to the given height, redelivers them if a delivery failed,
+// and waits for the backend to acknowledge them. It then checkpoints the height and removes the sealed outbox.
+// Without an outbox, it only waits for the backend and checkpoints the height.
+func (p *DeliveryPublisher) acknowledge(ctx context.Context, height int64) error {
+	if p.outboxPath == "" {
+		if err := p.flush(ctx, height); err != nil {
+			return err
+		}
+		return p.saveCheckpoint(height)
+	}
+
+	p.mu.Lock()
+	err := p.sealOutbox(height)
+	if p.redeliver {
+		p.redeliver = false
+		p.redeliverSealed = true
+	}
+	redeliver := p.redeliverSealed
+	deliveredHeight := p.deliveredHeight
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	sealedPath := p.sealedOutboxPath()
+	if err := syncFile(sealedPath); err != nil {
+		return err
+	}
+
+	if redeliver {
+		if err := p.redeliverOutbox(ctx, sealedPath, deliveredHeight, make(map[int64]struct{})); err != nil {
+			return err
+		}
+	}
+
+	if err := p.flush(ctx, height); err != nil {
+		p.mu.Lock()
+		p.redeliverSealed = true
+		p.mu.Unlock()
+		return err
+	}
+
+	if err := p.saveCheckpoint(height); err != nil {
+		return err
+	}
+
+	if err := os.Remove(sealedPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.redeliverSealed = false
+	p.sealedBytes = 0
+	p.deliveredHeight = height
+	if p.unrecordedFromHeight != 0 {
+		p.logger.Error("Indexer messages were published without being recorded in the full outbox, backfill them with the indexer-backfill command if they are missing",
+			"from_height", p.unrecordedFromHeight, "to_height", p.height)
+		p.unrecordedFromHeight = 0
+	}
+	return nil
+}
+
+// flush waits for the backend to acknowledge the published messages.
+func (p *DeliveryPublisher) flush(ctx context.Context, height int64) error {
+	flusher, ok := p.client.(domain.Flusher)
+	if !ok {
+		return nil
+	}
+	if err := flusher.Flush(ctx); err != nil {
+		return fmt.Errorf("failed to deliver the indexer messages up to height %d, they will be redelivered: %w", height, err)
+	}
+	return nil
+}
+
+// saveCheckpoint checkpoints the height as delivered, if the deliveries are checkpointed.
+func (p *DeliveryPublisher) saveCheckpoint(height int64) error {
+	if p.checkpoint == nil {
+		return nil
+	}
+	if err := p.checkpoint.Save(height); err != nil {
+		return fmt.Errorf("failed to save the indexer delivery checkpoint: %w", err)
+	}
+	return nil
+}
+
+// sealedOutboxPath returns the path of the sealed outbox file.
+func (p *DeliveryPublisher) sealedOutboxPath() string {
+	return p.outboxPath + sealedOutboxSuffix
+}
+
+// statOutbox reads the sizes of the outbox files left by a previous run.
+// The caller must hold p.mu.
+func (p *DeliveryPublisher) statOutbox() error {
+	var err error
+	if p.activeBytes, err = fileSize(p.outboxPath); err != nil {
+		return err
+	}
+	if p.sealedBytes, err = fileSize(p.sealedOutboxPath()); err != nil {
+		return err
+	}
+	if p.activeBytes > 0 {
+		// The heights recorded by the previous run are unknown.
+		p.activeMaxHeight = math.MaxInt64
+	}
+	return nil
+}
+
+// sealOutbox moves the messages of the active outbox up to the given height to the sealed outbox,
+// keeping the later ones in the active outbox. The caller must hold p.mu.
+func (p *DeliveryPublisher) sealOutbox(height int64) error {
+	if p.outboxPath == "" || p.activeBytes == 0 {
+		return nil
+	}
+
+	if p.outbox != nil {
+		if err := p.outbox.Close(); err != nil {
+			return err
+		}
+		p.outbox = nil
+	}
+
+	// Without a sealed outbox and later messages, the active outbox is sealed as is.
+	if p.sealedBytes == 0 && p.activeMaxHeight <= height {
+		if err := os.Rename(p.outboxPath, p.sealedOutboxPath()); err != nil {
+			return err
+		}
+		p.sealedBytes = p.activeBytes
+		p.activeBytes = 0
+		p.activeMaxHeight = 0
+		return nil
+	}
+
+	active, err := os.ReadFile(p.outboxPath)
+	if err != nil {
+		return err
+	}
+
+	var sealed, kept []byte
+	var keptMaxHeight int64
+	for len(active) > 0 {
+		end := bytes.IndexByte(active, '\n')
+		if end < 0 {
+			// A partially written last line is a message that was never published.
+			break
+		}
+		line := active[:end+1]
+		active = active[end+1:]
+
+		var record outboxRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("invalid indexer outbox record: %w", err)
+		}
+		if record.Height <= height {
+			sealed = append(sealed, line...)
+		} else {
+			kept = append(kept, line...)
+			keptMaxHeight = max(keptMaxHeight, record.Height)
+		}
+	}
+
+	if err := appendFile(p.sealedOutboxPath(), sealed); err != nil {
+		return err
+	}
+	if len(kept) == 0 {
+		err = os.Remove(p.outboxPath)
+	} else {
+		err = os.WriteFile(p.outboxPath, kept, 0o644)
+	}
+	if err != nil {
+		return err
+	}
+	p.sealedBytes += int64(len(sealed))
+	p.activeBytes = int64(len(kept))
+	p.activeMaxHeight = keptMaxHeight
+	return nil
+}
+
+// redeliverOutbox publishes again the messages of the outbox file at path after the given height,
+// and adds the heights that were redelivered to redeliveredHeights.
+func (p *DeliveryPublisher) redeliverOutbox(ctx context.Context, path string, afterHeight int64, redeliveredHeights map[int64]struct{}) error {
+	if p.outboxPath == "" {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A partially written last line is a message that was never published.
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var record outboxRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("invalid indexer outbox record: %w", err)
+		}
+		if record.Height <= afterHeight {
+			continue
+		}
+
+		if err := p.publishRecord(ctx, record); err != nil {
+			return fmt.Errorf("failed to redeliver the indexer message %s of height %d: %w", record.Kind, record.Height, err)
+		}
+		redeliveredHeights[record.Height] = struct{}{}
+	}
+
+	return nil
+}
+
+// publishRecord publishes the message of the outbox record through the client.
+func (p *DeliveryPublisher) publishRecord(ctx context.Context, record outboxRecord) error {
+	switch record.Kind {
+	case messageKindBlock:
+		var block domain.Block
+		if err := json.Unmarshal(record.Message, &block); err != nil {
+			return err
+		}
+		return p.client.PublishBlock(ctx, block)
+	case messageKindTransaction:
+		var txn domain.Transaction
+		if err := json.Unmarshal(record.Message, &txn); err != nil {
+			return err
+		}
+		return p.client.PublishTransaction(ctx, txn)
+	case messageKindTokenSupply:
+		var tokenSupply domain.TokenSupply
+		if err := json.Unmarshal(record.Message, &tokenSupply); err != nil {
+			return err
+		}
+		return p.client.PublishTokenSupply(ctx, tokenSupply)
+	case messageKindTokenSupplyOffset:
+		var tokenSupplyOffset domain.TokenSupplyOffset
+		if err := json.Unmarshal(record.Message, &tokenSupplyOffset); err != nil {
+			return err
+		}
+		return p.client.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
+	case messageKindPair:
+		var pair domain.Pair
+		if err := json.Unmarshal(record.Message, &pair); err != nil {
+			return err
+		}
+		return p.client.PublishPair(ctx, pair)
+	default:
+		return fmt.Errorf("unknown message kind %q", record.Kind)
+	}
+}
+
+// appendOutbox records the message in the outbox, opening it on first use.
+// The message is not recorded if the outbox is full.
+func (p *DeliveryPublisher) appendOutbox(height int64, kind string, message any) error {
+	if p.outboxPath == "" {
+		return nil
+	}
+
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(outboxRecord{Height: height, Kind: kind, Message: messageBytes})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if p.activeBytes+p.sealedBytes+int64(len(line)) > p.maxOutboxBytes {
+		if p.unrecordedFromHeight == 0 {
+			p.unrecordedFromHeight = height
+			p.logger.Error("Indexer outbox is full, the messages are published without being recorded for redelivery",
+				"height", height, "max_outbox_bytes", p.maxOutboxBytes)
+		}
+		return nil
+	}
+
+	if p.outbox == nil {
+		file, err := os.OpenFile(p.outboxPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		p.outbox = file
+	}
+
+	n, err := p.outbox.Write(line)
+	p.activeBytes += int64(n)
+	p.activeMaxHeight = max(p.activeMaxHeight, height)
+	return err
+}
+
+// fileSize returns the size of the file at path, or 0 if it does not exist.
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// appendFile appends data to the file at path, creating it if it does not exist.
+func appendFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	return errors.Join(err, file.Close())
+}
+
+// syncFile commits the file at path to stable storage, if it exists.
+func syncFile(path string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return errors.Join(file.Sync(), file.Close())
+}
+
+// messageHeight returns the chain id and the height of a message published with the given context.
+// The messages published with an sdk.Context belong to its block, the others to the block being executed.
+func (p *DeliveryPublisher) messageHeight(ctx context.Context) (string, int64) {
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok && sdkCtx.BlockHeight() > 0 {
+		if sdkCtx.ChainID() != "" {
+			return sdkCtx.ChainID(), sdkCtx.BlockHeight()
+		}
+		return p.chainId, sdkCtx.BlockHeight()
+	}
+	return p.chainId, p.height
+}
+
+// idempotencyKey returns the idempotency key of a message published at the given height,
+// suffixed by its occurrence index if the key was already published at that height.
+func (p *DeliveryPublisher) idempotencyKey(height int64, key string) string {
+	if height != p.keyCountsHeight {
+		p.keyCounts = make(map[string]int)
+		p.keyCountsHeight = height
+	}
+
+	occurrence := p.keyCounts[key]
+	p.keyCounts[key]++
+	if occurrence > 0 {
+		return fmt.Sprintf("%s/%d", key, occurrence)
+	}
+	return key
+}
+
+// deliver publishes the message, flagging the outbox for redelivery if the publishing fails.
+func (p *DeliveryPublisher) deliver(publish func() error) error {
+	if err := publish(); err != nil {
+		p.mu.Lock()
+		p.redeliver = true
+		p.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// PublishBlock implements domain.Publisher.
+func (p *DeliveryPublisher) PublishBlock(ctx context.Context, block domain.Block) error {
+	p.mu.Lock()
+	chainId, height := p.messageHeight(ctx)
+	block.IdempotencyKey = p.idempotencyKey(height, fmt.Sprintf("%s/%d", chainId, height))
+	err := p.appendOutbox(height, messageKindBlock, block)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return p.deliver(func() error { return p.client.PublishBlock(ctx, block) })
+}
+
+// PublishTransaction implements domain.Publisher.
+func (p *DeliveryPublisher) PublishTransaction(ctx context.Context, txn domain.Transaction) error {
+	p.mu.Lock()
+	chainId, height := p.messageHeight(ctx)
+	txn.IdempotencyKey = p.idempotencyKey(height, fmt.Sprintf("%s/%d/%d", chainId, height, txn.TransactionIndexId))
+	err := p.appendOutbox(height, messageKindTransaction, txn)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return p.deliver(func() error { return p.client.PublishTransaction(ctx, txn) })
+}
+
+// PublishTokenSupply implements domain.Publisher.
+func (p *DeliveryPublisher) PublishTokenSupply(ctx context.Context, tokenSupply domain.TokenSupply) error {
+	p.mu.Lock()
+	chainId, height := p.messageHeight(ctx)
+	tokenSupply.IdempotencyKey = p.idempotencyKey(height, fmt.Sprintf("%s/%d/%s/%s", chainId, height, messageKindTokenSupply, tokenSupply.Denom))
+	err := p.appendOutbox(height, messageKindTokenSupply, tokenSupply)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return p.deliver(func() error { return p.client.PublishTokenSupply(ctx, tokenSupply) })
+}
+
+// PublishTokenSupplyOffset implements domain.Publisher.
+func (p *DeliveryPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSupplyOffset domain.TokenSupplyOffset) error {
+	p.mu.Lock()
+	chainId, height := p.messageHeight(ctx)
+	tokenSupplyOffset.IdempotencyKey = p.idempotencyKey(height, fmt.Sprintf("%s/%d/%s/%s", chainId, height, messageKindTokenSupplyOffset, tokenSupplyOffset.Denom))
+	err := p.appendOutbox(height, messageKindTokenSupplyOffset, tokenSupplyOffset)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return p.deliver(func() error { return p.client.PublishTokenSupplyOffset(ctx, tokenSupplyOffset) })
+}
+
+// PublishPair implements domain.Publisher.
+func (p *DeliveryPublisher) PublishPair(ctx context.Context, pair domain.Pair) error {
+	p.mu.Lock()
+	chainId, height := p.messageHeight(ctx)
+	pair.IdempotencyKey = p.idempotencyKey(height, fmt.Sprintf("%s/%d/%s/%d/%s/%s", chainId, height, messageKindPair, pair.PoolID, pair.Denom0, pair.Denom1))
+	err := p.appendOutbox(height, messageKindPair, pair)
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return p.deliver(func() error { return p.client.PublishPair(ctx, pair) })
+}
+
+// Close stops the background acknowledgement, then closes the outbox and the wrapped publisher if it holds resources.
+// The messages that were not acknowledged yet remain in the outbox and are redelivered on Start.
+func (p *DeliveryPublisher) Close() error {
+	p.stopOnce.Do(func() { close(p.stop) })
+	// Without a worker, there is nothing to wait for.
+	p.startOnce.Do(func() { close(p.done) })
+	<-p.done
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var errs []error
+	if p.outbox != nil {
+		errs = append(errs, p.outbox.Close())
+		p.outbox = nil
+	}
+	if closer, ok := p.client.(io.Closer); ok {
+		errs = append(errs, closer.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,219 @@
+package indexer_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer"
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	indexermocks "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain/mocks"
+	indexerservice "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service"
+)
+
+const defaultChainId = "osmosis-1"
+
+// flushingPublisherMock is a publisher mock acknowledging the messages asynchronously.
+// It is safe for concurrent use by the test and the background acknowledgement of the delivery publisher.
+type flushingPublisherMock struct {
+	*indexermocks.PublisherMock
+
+	mu                 sync.Mutex
+	forceFlushError    error
+	numFlushCalls      int
+	publishedBlockKeys []string
+}
+
+func (p *flushingPublisherMock) PublishBlock(ctx context.Context, block indexerdomain.Block) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.publishedBlockKeys = append(p.publishedBlockKeys, block.IdempotencyKey)
+	return nil
+}
+
+func (p *flushingPublisherMock) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.numFlushCalls++
+	return p.forceFlushError
+}
+
+func (p *flushingPublisherMock) setFlushError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.forceFlushError = err
+}
+
+func (p *flushingPublisherMock) flushCalls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.numFlushCalls
+}
+
+// blockPublishCount returns the number of times the block with the given idempotency key was published.
+func (p *flushingPublisherMock) blockPublishCount(key string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := 0
+	for _, publishedKey := range p.publishedBlockKeys {
+		if publishedKey == key {
+			count++
+		}
+	}
+	return count
+}
+
+// blockContext returns the context of the block at the given height.
+func blockContext(height int64) sdk.Context {
+	return sdk.Context{}.WithContext(context.Background()).WithChainID(defaultChainId).WithBlockHeight(height)
+}
+
+// newDeliveryPublisher creates a delivery publisher checkpointing and recording its outbox in the given directory.
+func newDeliveryPublisher(dir string, client indexerdomain.Publisher) (*indexer.DeliveryPublisher, indexerdomain.HeightCheckpoint) {
+	checkpoint := indexerservice.NewFileHeightCheckpoint(filepath.Join(dir, indexer.DeliveryCheckpointFileName))
+	return indexer.NewDeliveryPublisher(client, checkpoint, filepath.Join(dir, indexer.DeliveryOutboxFileName), log.NewNopLogger()), checkpoint
+}
+
+// TestDeliveryPublisher_IdempotencyKeys tests that every message is published with an idempotency key
+// derived from the chain id and the height of its block, and from the transaction index, denom or pool.
+func TestDeliveryPublisher_IdempotencyKeys(t *testing.T) {
+	client := &indexermocks.PublisherMock{}
+	publisher, _ := newDeliveryPublisher(t.TempDir(), client)
+	require.NoError(t, publisher.Start(context.Background(), defaultChainId, 9))
+
+	// Published by the write listeners, without an sdk.Context, while the block at height 10 is executed.
+	require.NoError(t, publisher.PublishTokenSupply(context.Background(), indexerdomain.TokenSupply{Denom: "uosmo", Supply: osmomath.NewInt(1)}))
+	require.Equal(t, "osmosis-1/10/token_supply/uosmo", client.CalledWithTokenSupply.IdempotencyKey)
+	require.NoError(t, publisher.PublishTokenSupply(context.Background(), indexerdomain.TokenSupply{Denom: "uosmo", Supply: osmomath.NewInt(2)}))
+	require.Equal(t, "osmosis-1/10/token_supply/uosmo/1", client.CalledWithTokenSupply.IdempotencyKey)
+
+	ctx := blockContext(10)
+	require.NoError(t, publisher.PublishBlock(ctx, indexerdomain.Block{ChainId: defaultChainId, Height: 10}))
+	require.Equal(t, "osmosis-1/10", client.CalledWithBlock.IdempotencyKey)
+	require.NoError(t, publisher.PublishTransaction(ctx, indexerdomain.Transaction{Height: 10, TransactionIndexId: 3}))
+	require.Equal(t, "osmosis-1/10/3", client.CalledWithTransaction.IdempotencyKey)
+	require.NoError(t, publisher.PublishTokenSupplyOffset(ctx, indexerdomain.TokenSupplyOffset{Denom: "uion", SupplyOffset: osmomath.NewInt(1)}))
+	require.Equal(t, "osmosis-1/10/token_supply_offset/uion", client.CalledWithTokenSupplyOffset.IdempotencyKey)
+	require.NoError(t, publisher.PublishPair(ctx, indexerdomain.Pair{PoolID: 1, Denom0: "uion", Denom1: "uosmo"}))
+	require.Equal(t, "osmosis-1/10/pair/1/uion/uosmo", client.CalledWithPair.IdempotencyKey)
+
+	// The occurrences are counted per height.
+	require.NoError(t, publisher.AcknowledgeHeight(ctx, 10))
+	require.NoError(t, publisher.PublishTokenSupply(context.Background(), indexerdomain.TokenSupply{Denom: "uosmo", Supply: osmomath.NewInt(3)}))
+	require.Equal(t, "osmosis-1/11/token_supply/uosmo", client.CalledWithTokenSupply.IdempotencyKey)
+}
+
+// TestDeliveryPublisher_Acknowledge tests that the heights are acknowledged in the background, that the delivered
+// heights are checkpointed, and that the messages of a failed delivery are redelivered until they are acknowledged.
+func TestDeliveryPublisher_Acknowledge(t *testing.T) {
+	dir := t.TempDir()
+	client := &flushingPublisherMock{PublisherMock: &indexermocks.PublisherMock{}}
+	publisher, checkpoint := newDeliveryPublisher(dir, client)
+	publisher.SetRetryBackoff(time.Millisecond, 10*time.Millisecond)
+	defer publisher.Close()
+	require.NoError(t, publisher.Start(context.Background(), defaultChainId, 9))
+
+	// The delivery of height 10 fails, without failing the acknowledgement, and is retried in the background.
+	client.setFlushError(errors.New("flush failed"))
+	require.NoError(t, publisher.PublishBlock(blockContext(10), indexerdomain.Block{Height: 10}))
+	require.NoError(t, publisher.AcknowledgeHeight(blockContext(10), 10))
+	require.Eventually(t, func() bool {
+		_, err := publisher.AcknowledgeStatus()
+		return err != nil && client.flushCalls() >= 3
+	}, 5*time.Second, time.Millisecond)
+
+	height, _, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), height)
+
+	// The block of height 10 is redelivered with the block of height 11 once the backend recovers.
+	require.NoError(t, publisher.PublishBlock(blockContext(11), indexerdomain.Block{Height: 11}))
+	require.NoError(t, publisher.AcknowledgeHeight(blockContext(11), 11))
+	client.setFlushError(nil)
+	require.Eventually(t, func() bool {
+		deliveredHeight, err := publisher.AcknowledgeStatus()
+		return err == nil && deliveredHeight == 11
+	}, 5*time.Second, time.Millisecond)
+	require.Greater(t, client.blockPublishCount("osmosis-1/10"), 1)
+	require.GreaterOrEqual(t, client.blockPublishCount("osmosis-1/11"), 1)
+
+	height, _, err = checkpoint.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), height)
+
+	// The acknowledged messages are removed from the outbox.
+	outboxPath := filepath.Join(dir, indexer.DeliveryOutboxFileName)
+	_, err = os.Stat(outboxPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, err = os.Stat(outboxPath + indexer.SealedOutboxSuffix)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// TestDeliveryPublisher_OutboxBound tests that the messages are still published, but not recorded, once the outbox is full.
+func TestDeliveryPublisher_OutboxBound(t *testing.T) {
+	dir := t.TempDir()
+	client := &flushingPublisherMock{PublisherMock: &indexermocks.PublisherMock{}}
+	publisher, checkpoint := newDeliveryPublisher(dir, client)
+	defer publisher.Close()
+	require.NoError(t, publisher.Start(context.Background(), defaultChainId, 9))
+	publisher.SetMaxOutboxBytes(1)
+
+	require.NoError(t, publisher.PublishBlock(blockContext(10), indexerdomain.Block{Height: 10}))
+	require.Equal(t, 1, client.blockPublishCount("osmosis-1/10"))
+	_, err := os.Stat(filepath.Join(dir, indexer.DeliveryOutboxFileName))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	require.NoError(t, publisher.AcknowledgeHeight(blockContext(10), 10))
+	require.Eventually(t, func() bool {
+		deliveredHeight, err := publisher.AcknowledgeStatus()
+		return err == nil && deliveredHeight == 10
+	}, 5*time.Second, time.Millisecond)
+
+	height, _, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+}
+
+// TestDeliveryPublisher_RedeliverOnStart tests that the messages published after the last acknowledged height
+// are redelivered with the same idempotency keys when the node restarts.
+func TestDeliveryPublisher_RedeliverOnStart(t *testing.T) {
+	dir := t.TempDir()
+
+	client := &indexermocks.PublisherMock{}
+	publisher, _ := newDeliveryPublisher(dir, client)
+	require.NoError(t, publisher.Start(context.Background(), defaultChainId, 9))
+	require.NoError(t, publisher.PublishBlock(blockContext(10), indexerdomain.Block{Height: 10}))
+	require.NoError(t, publisher.PublishTransaction(blockContext(10), indexerdomain.Transaction{Height: 10, TransactionIndexId: 0}))
+	// The node stops after committing height 10, before acknowledging it.
+	require.NoError(t, publisher.Close())
+
+	restartedClient := &indexermocks.PublisherMock{}
+	restartedPublisher, checkpoint := newDeliveryPublisher(dir, restartedClient)
+	require.NoError(t, restartedPublisher.Start(context.Background(), defaultChainId, 10))
+
+	require.Equal(t, 1, restartedClient.NumPublishBlockCalls)
+	require.Equal(t, "osmosis-1/10", restartedClient.CalledWithBlock.IdempotencyKey)
+	require.Equal(t, 1, restartedClient.NumPublishTransactionCalls)
+	require.Equal(t, "osmosis-1/10/0", restartedClient.CalledWithTransaction.IdempotencyKey)
+
+	height, found, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(10), height)
+
+	// Nothing is left to redeliver.
+	require.NoError(t, restartedPublisher.Close())
+	restartedClient = &indexermocks.PublisherMock{}
+	restartedPublisher, _ = newDeliveryPublisher(dir, restartedClient)
+	require.NoError(t, restartedPublisher.Start(context.Background(), defaultChainId, 10))
+	require.Equal(t, 0, restartedClient.NumPublishBlockCalls)
+}
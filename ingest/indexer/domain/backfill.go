@@ -0,0 +1,15 @@
+package domain
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockSource provides the committed blocks and state history replayed by a backfill.
+type BlockSource interface {
+	// LoadFinalizeBlock returns the FinalizeBlock request and response of the block at the given height.
+	LoadFinalizeBlock(height int64) (abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock, error)
+	// ContextAtHeight returns a context over the state committed at the given height,
+	// with the header of the block at that height.
+	ContextAtHeight(height int64) (sdk.Context, error)
+}
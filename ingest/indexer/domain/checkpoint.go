@@ -0,0 +1,10 @@
+package domain
+
+// HeightCheckpoint persists the last height whose data was fully published, so that an interrupted
+// publishing, e.g. a backfill or the live delivery after a restart, can resume from the next height.
+type HeightCheckpoint interface {
+	// Load returns the last checkpointed height, or false if there is no checkpoint.
+	Load() (height int64, found bool, err error)
+	// Save checkpoints the given height.
+	Save(height int64) error
+}
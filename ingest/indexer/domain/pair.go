@@ -18,6 +18,7 @@ type Pair struct {
 	PairCreatedAt        time.Time `json:"pair_created_at"`
 	PairCreatedAtHeight  uint64    `json:"pair_created_at_height"`
 	PairCreatedAtTxnHash string    `json:"pair_created_at_txn_hash"`
+	IdempotencyKey       string    `json:"idempotency_key"`
 }
 
 // ShouldFilterDenom returns true if the given denom should be filtered out.
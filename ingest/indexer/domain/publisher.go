@@ -33,3 +33,16 @@ type PairPublisher interface {
 	//   with the taker fee and spread factor, as well as the newly created pool metadata, if any.
 	PublishPoolPairs(ctx sdk.Context, pools []poolmanagertypes.PoolI, createdPoolIDs map[uint64]commondomain.PoolCreation) error
 }
+
+// Flusher is implemented by the publishers sending the messages asynchronously.
+type Flusher interface {
+	// Flush sends the pending messages and waits until the backend acknowledged all of them.
+	Flush(ctx context.Context) error
+}
+
+// DeliveryTracker is implemented by the publishers tracking the heights whose messages were delivered.
+type DeliveryTracker interface {
+	// AcknowledgeHeight marks the messages published up to the given height as complete. They are checkpointed
+	// as delivered once acknowledged by the backend, which the implementations may wait for in the background.
+	AcknowledgeHeight(ctx context.Context, height int64) error
+}
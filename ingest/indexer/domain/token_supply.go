@@ -7,13 +7,15 @@ import (
 )
 
 type TokenSupply struct {
-	Denom      string       `json:"denom"`
-	Supply     osmomath.Int `json:"supply"`
-	IngestedAt time.Time    `json:"ingested_at"`
+	Denom          string       `json:"denom"`
+	Supply         osmomath.Int `json:"supply"`
+	IngestedAt     time.Time    `json:"ingested_at"`
+	IdempotencyKey string       `json:"idempotency_key"`
 }
 
 type TokenSupplyOffset struct {
-	Denom        string       `json:"denom"`
-	SupplyOffset osmomath.Int `json:"supply_offset"`
-	IngestedAt   time.Time    `json:"ingested_at"`
+	Denom          string       `json:"denom"`
+	SupplyOffset   osmomath.Int `json:"supply_offset"`
+	IngestedAt     time.Time    `json:"ingested_at"`
+	IdempotencyKey string       `json:"idempotency_key"`
 }
@@ -25,4 +25,5 @@ type Transaction struct {
 	TransactionIndexId int            `json:"tx_index_id"`
 	Events             []EventWrapper `json:"events"`
 	IngestedAt         time.Time      `json:"ingested_at"`
+	IdempotencyKey     string         `json:"idempotency_key"`
 }
@@ -0,0 +1,25 @@
+package indexer
+
+import "time"
+
+const SealedOutboxSuffix = sealedOutboxSuffix
+
+// SetRetryBackoff sets the bounds of the delay before retrying a failed acknowledgement.
+func (p *DeliveryPublisher) SetRetryBackoff(minBackoff, maxBackoff time.Duration) {
+	p.minRetryBackoff = minBackoff
+	p.maxRetryBackoff = maxBackoff
+}
+
+// SetMaxOutboxBytes sets the maximum size of the outbox files.
+func (p *DeliveryPublisher) SetMaxOutboxBytes(maxOutboxBytes int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.maxOutboxBytes = maxOutboxBytes
+}
+
+// AcknowledgeStatus returns the last delivered height and the error of the last acknowledgement of the worker.
+func (p *DeliveryPublisher) AcknowledgeStatus() (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.deliveredHeight, p.lastAcknowledgeErr
+}
@@ -1,18 +1,34 @@
 package indexer
 
 import (
+	"fmt"
+	"strings"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
 	service "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service/client"
 )
 
+// Publisher backends the indexer can publish to.
+const (
+	PublisherPubSub = "pubsub"
+	PublisherKafka  = "kafka"
+	PublisherNATS   = "nats"
+	PublisherFile   = "file"
+)
+
 // Config defines the config for the indexer.
 type Config struct {
 	IsEnabled                bool   `mapstructure:"enabled"`
+	Publisher                string `mapstructure:"publisher"`
 	MaxPublishDelay          int    `mapstructure:"max-publish-delay"`
 	GCPProjectId             string `mapstructure:"gcp-project-id"`
+	KafkaBrokers             string `mapstructure:"kafka-brokers"`
+	NATSURL                  string `mapstructure:"nats-url"`
+	FilePath                 string `mapstructure:"file-path"`
 	BlockTopicId             string `mapstructure:"block-topic-id"`
 	TransactionTopicId       string `mapstructure:"transaction-topic-id"`
 	PoolTopicId              string `mapstructure:"pool-topic-id"`
@@ -29,8 +45,12 @@ const (
 // DefaultConfig defines the default config for the indexer client.
 var DefaultConfig = Config{
 	IsEnabled:                false,
+	Publisher:                PublisherPubSub,
 	MaxPublishDelay:          4,
 	GCPProjectId:             "",
+	KafkaBrokers:             "",
+	NATSURL:                  "",
+	FilePath:                 "",
 	BlockTopicId:             "",
 	TransactionTopicId:       "",
 	PoolTopicId:              "",
@@ -57,10 +77,21 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 	tokenSupplyOffsetTopicId := osmoutils.ParseString(opts, groupOptName, "token-supply-offset-topic-id")
 	pairTopicID := osmoutils.ParseString(opts, groupOptName, "pair-topic-id")
 
+	// The publisher backend options were added after the initial release of the indexer,
+	// so they are optional to keep existing app.toml files working.
+	publisher := parseOptionalString(opts, "publisher", PublisherPubSub)
+	kafkaBrokers := parseOptionalString(opts, "kafka-brokers", "")
+	natsURL := parseOptionalString(opts, "nats-url", "")
+	filePath := parseOptionalString(opts, "file-path", "")
+
 	return Config{
 		IsEnabled:                isEnabled,
+		Publisher:                publisher,
 		MaxPublishDelay:          maxPublishDelay,
 		GCPProjectId:             gcpProjectId,
+		KafkaBrokers:             kafkaBrokers,
+		NATSURL:                  natsURL,
+		FilePath:                 filePath,
 		BlockTopicId:             blockTopicId,
 		TransactionTopicId:       transactionTopicId,
 		PoolTopicId:              poolTopicId,
@@ -70,8 +101,62 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 	}
 }
 
-// Initialize initializes the indexer by creating a new PubSubClient and returning a new IndexerIngester.
-func (c Config) Initialize() domain.Publisher {
-	pubSubClient := service.NewPubSubCLient(c.MaxPublishDelay, c.GCPProjectId, c.BlockTopicId, c.TransactionTopicId, c.PoolTopicId, c.TokenSupplyTopicId, c.TokenSupplyOffsetTopicId, c.PairTopicId)
-	return NewIndexerPublisher(*pubSubClient)
+// parseOptionalString parses a string option of the indexer group, returning the default value if it is not set.
+func parseOptionalString(opts servertypes.AppOptions, optName, defaultValue string) string {
+	valueInterface := opts.Get(groupOptName + "." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value := cast.ToString(valueInterface)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// Initialize initializes the indexer by creating the client of the configured publisher backend
+// and returning a new IndexerPublisher.
+func (c Config) Initialize() (domain.Publisher, error) {
+	topics := service.TopicIds{
+		Block:             c.BlockTopicId,
+		Transaction:       c.TransactionTopicId,
+		TokenSupply:       c.TokenSupplyTopicId,
+		TokenSupplyOffset: c.TokenSupplyOffsetTopicId,
+		Pair:              c.PairTopicId,
+	}
+
+	switch c.Publisher {
+	case PublisherPubSub, "":
+		pubSubClient := service.NewPubSubCLient(c.MaxPublishDelay, c.GCPProjectId, c.BlockTopicId, c.TransactionTopicId, c.PoolTopicId, c.TokenSupplyTopicId, c.TokenSupplyOffsetTopicId, c.PairTopicId)
+		return NewIndexerPublisher(pubSubClient), nil
+	case PublisherKafka:
+		brokers := parseKafkaBrokers(c.KafkaBrokers)
+		if len(brokers) == 0 {
+			return nil, fmt.Errorf("kafka-brokers must be set for the %s publisher", PublisherKafka)
+		}
+		return NewIndexerPublisher(service.NewKafkaClient(brokers, topics)), nil
+	case PublisherNATS:
+		if c.NATSURL == "" {
+			return nil, fmt.Errorf("nats-url must be set for the %s publisher", PublisherNATS)
+		}
+		return NewIndexerPublisher(service.NewNATSClient(c.NATSURL, topics)), nil
+	case PublisherFile:
+		if c.FilePath == "" {
+			return nil, fmt.Errorf("file-path must be set for the %s publisher", PublisherFile)
+		}
+		return NewIndexerPublisher(service.NewFileClient(c.FilePath, topics)), nil
+	default:
+		return nil, fmt.Errorf("unknown indexer publisher %q, expected one of %s, %s, %s or %s", c.Publisher, PublisherPubSub, PublisherKafka, PublisherNATS, PublisherFile)
+	}
+}
+
+// parseKafkaBrokers parses the comma separated list of seed broker addresses.
+func parseKafkaBrokers(brokers string) []string {
+	parsed := []string{}
+	for _, broker := range strings.Split(brokers, ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			parsed = append(parsed, broker)
+		}
+	}
+	return parsed
 }
@@ -2,26 +2,27 @@ package indexer
 
 import (
 	"context"
+	"io"
 
 	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
-	service "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service/client"
 )
 
 // indexerIngester is an implementation of domain.Publisher.
 type indexerPublisher struct {
-	pubsubClient service.PubSubClient
+	client domain.Publisher
 }
 
-// NewIndexerPublisher creates a new IndexerPublisher with the given PubSubClient
-func NewIndexerPublisher(pubsubClient service.PubSubClient) domain.Publisher {
+// NewIndexerPublisher creates a new IndexerPublisher with the given publisher backend client,
+// e.g. a PubSubClient, KafkaClient, NATSClient or FileClient.
+func NewIndexerPublisher(client domain.Publisher) domain.Publisher {
 	return &indexerPublisher{
-		pubsubClient: pubsubClient,
+		client: client,
 	}
 }
 
 // PublishBlock implements domain.Publisher.
 func (i *indexerPublisher) PublishBlock(ctx context.Context, block domain.Block) error {
-	err := i.pubsubClient.PublishBlock(ctx, block)
+	err := i.client.PublishBlock(ctx, block)
 	if err != nil {
 		return err
 	}
@@ -30,7 +31,7 @@ func (i *indexerPublisher) PublishBlock(ctx context.Context, block domain.Block)
 
 // PublishTransaction implements domain.Publisher.
 func (i *indexerPublisher) PublishTransaction(ctx context.Context, txn domain.Transaction) error {
-	err := i.pubsubClient.PublishTransaction(ctx, txn)
+	err := i.client.PublishTransaction(ctx, txn)
 	if err != nil {
 		return err
 	}
@@ -39,7 +40,7 @@ func (i *indexerPublisher) PublishTransaction(ctx context.Context, txn domain.Tr
 
 // PublishTokenSupply implements domain.Publisher.
 func (i *indexerPublisher) PublishTokenSupply(ctx context.Context, tokenSupply domain.TokenSupply) error {
-	err := i.pubsubClient.PublishTokenSupply(ctx, tokenSupply)
+	err := i.client.PublishTokenSupply(ctx, tokenSupply)
 	if err != nil {
 		return err
 	}
@@ -48,7 +49,7 @@ func (i *indexerPublisher) PublishTokenSupply(ctx context.Context, tokenSupply d
 
 // PublishTokenSupplyOffset implements domain.Publisher.
 func (i *indexerPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSupplyOffset domain.TokenSupplyOffset) error {
-	err := i.pubsubClient.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
+	err := i.client.PublishTokenSupplyOffset(ctx, tokenSupplyOffset)
 	if err != nil {
 		return err
 	}
@@ -57,9 +58,26 @@ func (i *indexerPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSu
 
 // PublishPair implements domain.Publisher.
 func (i *indexerPublisher) PublishPair(ctx context.Context, pair domain.Pair) error {
-	err := i.pubsubClient.PublishPair(ctx, pair)
+	err := i.client.PublishPair(ctx, pair)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// Flush waits until the publisher backend client acknowledged the published messages,
+// if it sends them asynchronously. The other clients acknowledge each message when it is published.
+func (i *indexerPublisher) Flush(ctx context.Context) error {
+	if flusher, ok := i.client.(domain.Flusher); ok {
+		return flusher.Flush(ctx)
+	}
+	return nil
+}
+
+// Close closes the publisher backend client if it holds resources, e.g. a file or a connection.
+func (i *indexerPublisher) Close() error {
+	if closer, ok := i.client.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	commondomain "github.com/osmosis-labs/osmosis/v31/ingest/common/domain"
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/service/blockprocessor"
+	sqsdomain "github.com/osmosis-labs/osmosis/v31/ingest/sqs/domain"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// backfillLogInterval is the number of heights between two backfill progress logs.
+const backfillLogInterval = 1000
+
+// Backfiller replays a range of committed blocks from the local block store and state history,
+// and publishes the same data as the indexer streaming service does live:
+// - the blocks and their transactions, with the events enriched from the state committed at their height,
+// - the pairs of the pools created in the range,
+// - the token supplies and supply offsets that changed in the range.
+//
+// A fresh backfill first publishes all the pairs and token supplies of the state preceding the range,
+// as the streaming service does on cold start. The last fully published height is checkpointed after
+// each block, so that an interrupted backfill resumes from the next height.
+type Backfiller struct {
+	streamingService *indexerStreamingService
+
+	client        domain.Publisher
+	keepers       domain.Keepers
+	poolExtractor commondomain.PoolExtractor
+	poolTracker   sqsdomain.BlockPoolUpdateTracker
+	pairPublisher domain.PairPublisher
+
+	source     domain.BlockSource
+	checkpoint domain.HeightCheckpoint
+
+	// supplies and supplyOffsets are the token supplies and supply offsets of the last processed height,
+	// used to only publish the ones that changed.
+	supplies      map[string]osmomath.Int
+	supplyOffsets map[string]osmomath.Int
+
+	logger log.Logger
+}
+
+// NewBackfiller creates a new Backfiller.
+// source provides the blocks and the state history to replay.
+// checkpoint persists the progress of the backfill.
+// poolExtractor extracts all the pools for the initial pairs snapshot.
+// poolTracker tracks the pools created by the replayed transactions.
+func NewBackfiller(client domain.Publisher, source domain.BlockSource, checkpoint domain.HeightCheckpoint, poolExtractor commondomain.PoolExtractor, poolTracker sqsdomain.BlockPoolUpdateTracker, keepers domain.Keepers, txDecoder sdk.TxDecoder, logger log.Logger) *Backfiller {
+	// The blocks are replayed after the initial data is published, as in the streaming service after cold start.
+	blockProcessStrategyManager := commondomain.NewBlockProcessStrategyManager()
+	blockProcessStrategyManager.MarkInitialDataIngested()
+
+	return &Backfiller{
+		streamingService: New(nil, blockProcessStrategyManager, client, nil, poolExtractor, poolTracker, keepers, txDecoder, nil, logger),
+
+		client:        client,
+		keepers:       keepers,
+		poolExtractor: poolExtractor,
+		poolTracker:   poolTracker,
+		pairPublisher: blockprocessor.NewPairPublisher(client, keepers.PoolManagerKeeper),
+
+		source:     source,
+		checkpoint: checkpoint,
+
+		logger: logger,
+	}
+}
+
+// Run backfills the blocks from startHeight to endHeight, both inclusive, resuming from the checkpoint if any.
+func (b *Backfiller) Run(ctx context.Context, startHeight, endHeight int64) error {
+	if startHeight < 1 || endHeight < startHeight {
+		return fmt.Errorf("invalid backfill range [%d, %d]", startHeight, endHeight)
+	}
+
+	checkpointHeight, found, err := b.checkpoint.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load backfill checkpoint: %w", err)
+	}
+
+	if found {
+		if checkpointHeight < startHeight-1 || checkpointHeight > endHeight {
+			return fmt.Errorf("backfill checkpoint at height %d is outside of the range [%d, %d], remove it to start over", checkpointHeight, startHeight, endHeight)
+		}
+		b.logger.Info("Resuming indexer backfill from checkpoint", "height", checkpointHeight)
+
+		// The data up to the checkpoint was already published, only load the supplies to diff against.
+		if err := b.loadSupplies(checkpointHeight); err != nil {
+			return err
+		}
+	} else {
+		checkpointHeight = startHeight - 1
+		if err := b.publishInitialData(checkpointHeight); err != nil {
+			return err
+		}
+		if err := b.acknowledgeHeight(ctx, checkpointHeight); err != nil {
+			return err
+		}
+		if err := b.checkpoint.Save(checkpointHeight); err != nil {
+			return fmt.Errorf("failed to save backfill checkpoint: %w", err)
+		}
+	}
+
+	for height := checkpointHeight + 1; height <= endHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := b.processHeight(height); err != nil {
+			return fmt.Errorf("failed to backfill height %d: %w", height, err)
+		}
+		if err := b.acknowledgeHeight(ctx, height); err != nil {
+			return err
+		}
+		if err := b.checkpoint.Save(height); err != nil {
+			return fmt.Errorf("failed to save backfill checkpoint: %w", err)
+		}
+
+		if height%backfillLogInterval == 0 {
+			b.logger.Info("Indexer backfill progress", "height", height, "end_height", endHeight)
+		}
+	}
+
+	b.logger.Info("Finished indexer backfill", "start_height", startHeight, "end_height", endHeight)
+	return nil
+}
+
+// acknowledgeHeight waits until the messages published up to the given height are delivered,
+// if the publisher tracks their delivery, before the height is checkpointed.
+func (b *Backfiller) acknowledgeHeight(ctx context.Context, height int64) error {
+	deliveryTracker, ok := b.client.(domain.DeliveryTracker)
+	if !ok {
+		return nil
+	}
+	return deliveryTracker.AcknowledgeHeight(ctx, height)
+}
+
+// publishInitialData publishes all the pairs and token supplies of the state committed at the given height.
+// There is no state to publish before the first block.
+func (b *Backfiller) publishInitialData(height int64) error {
+	b.supplies = make(map[string]osmomath.Int)
+	b.supplyOffsets = make(map[string]osmomath.Int)
+	if height < 1 {
+		return nil
+	}
+
+	sdkCtx, err := b.source.ContextAtHeight(height)
+	if err != nil {
+		return err
+	}
+
+	blockProcessor := blockprocessor.NewBlockProcessor(commondomain.NewBlockProcessStrategyManager(), b.client, b.poolExtractor, b.keepers, backfillNodeStatusChecker{}, nil)
+	if err := blockProcessor.ProcessBlock(sdkCtx); err != nil {
+		return fmt.Errorf("failed to publish the initial data at height %d: %w", height, err)
+	}
+
+	b.supplies, b.supplyOffsets = b.getSupplies(sdkCtx)
+	return nil
+}
+
+// loadSupplies loads the token supplies committed at the given height without publishing them.
+func (b *Backfiller) loadSupplies(height int64) error {
+	b.supplies = make(map[string]osmomath.Int)
+	b.supplyOffsets = make(map[string]osmomath.Int)
+	if height < 1 {
+		return nil
+	}
+
+	sdkCtx, err := b.source.ContextAtHeight(height)
+	if err != nil {
+		return err
+	}
+	b.supplies, b.supplyOffsets = b.getSupplies(sdkCtx)
+	return nil
+}
+
+// processHeight publishes the block at the given height, its transactions, the pairs of the pools
+// it created and the token supplies it changed.
+func (b *Backfiller) processHeight(height int64) error {
+	req, res, err := b.source.LoadFinalizeBlock(height)
+	if err != nil {
+		return err
+	}
+	sdkCtx, err := b.source.ContextAtHeight(height)
+	if err != nil {
+		return err
+	}
+
+	// The gas consumed by the block is not persisted, it is approximated by the gas used by its transactions.
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	for _, txResult := range res.TxResults {
+		gasMeter.ConsumeGas(uint64(txResult.GasUsed), "backfill")
+	}
+	sdkCtx = sdkCtx.WithGasMeter(gasMeter)
+
+	defer b.poolTracker.Reset()
+
+	if err := b.streamingService.ListenFinalizeBlock(sdkCtx, req, res); err != nil {
+		return err
+	}
+
+	if err := b.publishCreatedPools(sdkCtx); err != nil {
+		return err
+	}
+
+	return b.publishChangedSupplies(sdkCtx)
+}
+
+// publishCreatedPools publishes the pairs of the pools created in the block, tracked while publishing its transactions.
+func (b *Backfiller) publishCreatedPools(ctx sdk.Context) error {
+	createdPoolIDs := b.poolTracker.GetCreatedPoolIDs()
+	if len(createdPoolIDs) == 0 {
+		return nil
+	}
+
+	pools := make([]poolmanagertypes.PoolI, 0, len(createdPoolIDs))
+	for poolID := range createdPoolIDs {
+		pool, err := b.keepers.PoolManagerKeeper.GetPool(ctx, poolID)
+		if err != nil {
+			return err
+		}
+		pools = append(pools, pool)
+	}
+
+	return b.pairPublisher.PublishPoolPairs(ctx, pools, createdPoolIDs)
+}
+
+// publishChangedSupplies publishes the token supplies and supply offsets that changed since the last processed height.
+func (b *Backfiller) publishChangedSupplies(ctx sdk.Context) error {
+	supplies, supplyOffsets := b.getSupplies(ctx)
+
+	for denom, supply := range supplies {
+		if previous, ok := b.supplies[denom]; ok && previous.Equal(supply) {
+			continue
+		}
+		if err := b.client.PublishTokenSupply(ctx, domain.TokenSupply{Denom: denom, Supply: supply}); err != nil {
+			return err
+		}
+	}
+	// Supplies burnt to zero are removed from the store.
+	for denom := range b.supplies {
+		if _, ok := supplies[denom]; !ok {
+			if err := b.client.PublishTokenSupply(ctx, domain.TokenSupply{Denom: denom, Supply: osmomath.ZeroInt()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for denom, supplyOffset := range supplyOffsets {
+		if previous, ok := b.supplyOffsets[denom]; ok && previous.Equal(supplyOffset) {
+			continue
+		}
+		if err := b.client.PublishTokenSupplyOffset(ctx, domain.TokenSupplyOffset{Denom: denom, SupplyOffset: supplyOffset}); err != nil {
+			return err
+		}
+	}
+	for denom := range b.supplyOffsets {
+		if _, ok := supplyOffsets[denom]; !ok {
+			if err := b.client.PublishTokenSupplyOffset(ctx, domain.TokenSupplyOffset{Denom: denom, SupplyOffset: osmomath.ZeroInt()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	b.supplies, b.supplyOffsets = supplies, supplyOffsets
+	return nil
+}
+
+// getSupplies returns the token supplies and the non-zero supply offsets of the denoms that are not filtered.
+func (b *Backfiller) getSupplies(ctx sdk.Context) (supplies, supplyOffsets map[string]osmomath.Int) {
+	supplies = make(map[string]osmomath.Int)
+	supplyOffsets = make(map[string]osmomath.Int)
+	b.keepers.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		if domain.ShouldFilterDenom(coin.Denom) {
+			return false
+		}
+		supplies[coin.Denom] = coin.Amount
+
+		supplyOffset := b.keepers.BankKeeper.GetSupplyOffset(ctx, coin.Denom)
+		if !supplyOffset.IsZero() {
+			supplyOffsets[coin.Denom] = supplyOffset
+		}
+		return false
+	})
+	return supplies, supplyOffsets
+}
+
+// backfillNodeStatusChecker reports the node as synced, the backfill only reads committed state.
+type backfillNodeStatusChecker struct{}
+
+// IsNodeSyncing implements commonservice.NodeStatusChecker.
+func (backfillNodeStatusChecker) IsNodeSyncing(ctx sdk.Context) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,183 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	commondomain "github.com/osmosis-labs/osmosis/v31/ingest/common/domain"
+	"github.com/osmosis-labs/osmosis/v31/ingest/common/pooltracker"
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	indexermocks "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain/mocks"
+	indexerservice "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service"
+	sqsmocks "github.com/osmosis-labs/osmosis/v31/ingest/sqs/domain/mocks"
+)
+
+var errBlockNotFound = errors.New("block not found")
+
+// blockSourceMock replays empty blocks over the suite context.
+// onHeight mutates the state before the block at the given height is loaded.
+type blockSourceMock struct {
+	ctx       sdk.Context
+	maxHeight int64
+	onHeight  map[int64]func()
+}
+
+func (m *blockSourceMock) LoadFinalizeBlock(height int64) (abcitypes.RequestFinalizeBlock, abcitypes.ResponseFinalizeBlock, error) {
+	if height > m.maxHeight {
+		return abcitypes.RequestFinalizeBlock{}, abcitypes.ResponseFinalizeBlock{}, errBlockNotFound
+	}
+	if f, ok := m.onHeight[height]; ok {
+		f()
+	}
+	return abcitypes.RequestFinalizeBlock{Height: height, Time: m.ctx.BlockTime()}, abcitypes.ResponseFinalizeBlock{}, nil
+}
+
+func (m *blockSourceMock) ContextAtHeight(height int64) (sdk.Context, error) {
+	return m.ctx.WithBlockHeight(height), nil
+}
+
+// heightCheckpointMock keeps the checkpoint in memory.
+type heightCheckpointMock struct {
+	height int64
+	found  bool
+}
+
+func (m *heightCheckpointMock) Load() (int64, bool, error) {
+	return m.height, m.found, nil
+}
+
+func (m *heightCheckpointMock) Save(height int64) error {
+	m.height, m.found = height, true
+	return nil
+}
+
+// TestBackfiller tests that the backfiller publishes the initial data and the blocks of the range,
+// only publishes the token supplies that changed, and resumes from the checkpoint.
+func (s *IndexerServiceTestSuite) TestBackfiller() {
+	const newDenom = "ubackfill"
+
+	tests := []struct {
+		name        string
+		checkpoint  *heightCheckpointMock
+		startHeight int64
+		endHeight   int64
+		maxHeight   int64
+
+		expectedBlocks            int
+		expectInitialSupplies     bool
+		expectedCheckpointHeight  int64
+		expectedCheckpointPresent bool
+		expectErr                 bool
+	}{
+		{
+			name:                      "fresh backfill",
+			checkpoint:                &heightCheckpointMock{},
+			startHeight:               2,
+			endHeight:                 4,
+			maxHeight:                 10,
+			expectedBlocks:            3,
+			expectInitialSupplies:     true,
+			expectedCheckpointHeight:  4,
+			expectedCheckpointPresent: true,
+		},
+		{
+			name:                      "resume from checkpoint",
+			checkpoint:                &heightCheckpointMock{height: 3, found: true},
+			startHeight:               2,
+			endHeight:                 4,
+			maxHeight:                 10,
+			expectedBlocks:            1,
+			expectedCheckpointHeight:  4,
+			expectedCheckpointPresent: true,
+		},
+		{
+			name:                      "checkpoint outside of the range",
+			checkpoint:                &heightCheckpointMock{height: 7, found: true},
+			startHeight:               2,
+			endHeight:                 4,
+			maxHeight:                 10,
+			expectedCheckpointHeight:  7,
+			expectedCheckpointPresent: true,
+			expectErr:                 true,
+		},
+		{
+			name:                      "missing block stops at the last published height",
+			checkpoint:                &heightCheckpointMock{},
+			startHeight:               2,
+			endHeight:                 6,
+			maxHeight:                 3,
+			expectedBlocks:            2,
+			expectInitialSupplies:     true,
+			expectedCheckpointHeight:  3,
+			expectedCheckpointPresent: true,
+			expectErr:                 true,
+		},
+		{
+			name:        "invalid range",
+			checkpoint:  &heightCheckpointMock{},
+			startHeight: 4,
+			endHeight:   2,
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Setup()
+
+			// Count the supplies published by the initial data.
+			initialSupplies := 0
+			s.App.BankKeeper.IterateTotalSupply(s.Ctx, func(coin sdk.Coin) bool {
+				if !indexerdomain.ShouldFilterDenom(coin.Denom) {
+					initialSupplies++
+				}
+				return false
+			})
+
+			source := &blockSourceMock{
+				ctx:       s.Ctx,
+				maxHeight: tc.maxHeight,
+				onHeight: map[int64]func(){
+					// Mint a new denom in the last block, so that its supply is published.
+					4: func() {
+						s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(newDenom, osmomath.NewInt(1000))))
+					},
+				},
+			}
+
+			publisherMock := &indexermocks.PublisherMock{}
+			keepers := indexerdomain.Keepers{
+				BankKeeper:        *s.App.BankKeeper,
+				PoolManagerKeeper: s.App.PoolManagerKeeper,
+			}
+			poolExtractorMock := &sqsmocks.PoolsExtractorMock{BlockPools: commondomain.BlockPools{}}
+
+			backfiller := indexerservice.NewBackfiller(publisherMock, source, tc.checkpoint, poolExtractorMock, pooltracker.NewMemory(), keepers, s.App.GetTxConfig().TxDecoder(), s.App.Logger())
+
+			err := backfiller.Run(context.Background(), tc.startHeight, tc.endHeight)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			s.Require().Equal(tc.expectedBlocks, publisherMock.NumPublishBlockCalls)
+			s.Require().Equal(tc.expectedCheckpointPresent, tc.checkpoint.found)
+			s.Require().Equal(tc.expectedCheckpointHeight, tc.checkpoint.height)
+
+			expectedSupplies := 0
+			if tc.expectInitialSupplies {
+				expectedSupplies += initialSupplies
+			}
+			if tc.endHeight >= 4 && tc.maxHeight >= 4 && !tc.expectErr {
+				expectedSupplies++
+				s.Require().Equal(newDenom, publisherMock.CalledWithTokenSupply.Denom)
+			}
+			s.Require().Equal(expectedSupplies, publisherMock.NumPublishTokenSupplyCalls)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBatchQueueSize is the number of messages that can be queued before publishing blocks.
+	defaultBatchQueueSize = 10_000
+	// defaultMaxBatchSize is the maximum number of messages sent to a topic in a single batch.
+	defaultMaxBatchSize = 500
+	// defaultBatchLinger is the time a message waits for more messages to batch with before being sent.
+	defaultBatchLinger = 200 * time.Millisecond
+)
+
+// errBatchPublisherClosed is returned when publishing to or flushing a closed batchPublisher.
+var errBatchPublisherClosed = errors.New("batch publisher is closed")
+
+// publishBatchFunc publishes the JSON encoded messages to the given topic, in order.
+type publishBatchFunc func(ctx context.Context, topicId string, data [][]byte) error
+
+// queuedMessage is a message waiting in the queue of a batchPublisher.
+type queuedMessage struct {
+	topicId string
+	data    []byte
+}
+
+// batchPublisher publishes messages off the caller's path: the messages are queued, and a background
+// worker sends them in batches per topic once a batch is full, once the oldest message waited for the
+// linger duration, or on Flush.
+//
+// Publishing only blocks when the queue is full. The errors of the batches sent in the background are
+// returned by the next Flush, which waits for every message queued before it to be sent.
+type batchPublisher struct {
+	publishBatch publishBatchFunc
+	maxBatchSize int
+	linger       time.Duration
+
+	queue   chan queuedMessage
+	flushes chan chan error
+	closing chan struct{}
+	closed  chan struct{}
+
+	startOnce sync.Once
+	closeOnce sync.Once
+}
+
+// newBatchPublisher creates a new batchPublisher sending the batches with publishBatch.
+func newBatchPublisher(publishBatch publishBatchFunc) *batchPublisher {
+	return &batchPublisher{
+		publishBatch: publishBatch,
+		maxBatchSize: defaultMaxBatchSize,
+		linger:       defaultBatchLinger,
+		queue:        make(chan queuedMessage, defaultBatchQueueSize),
+		flushes:      make(chan chan error),
+		closing:      make(chan struct{}),
+		closed:       make(chan struct{}),
+	}
+}
+
+// enqueue queues the message to be sent to the topic, starting the worker on first use.
+// It blocks while the queue is full, until the context is done.
+func (b *batchPublisher) enqueue(ctx context.Context, topicId string, data []byte) error {
+	b.startOnce.Do(func() { go b.run() })
+
+	select {
+	case <-b.closing:
+		return errBatchPublisherClosed
+	default:
+	}
+
+	select {
+	case b.queue <- queuedMessage{topicId: topicId, data: data}:
+		return nil
+	case <-b.closing:
+		return errBatchPublisherClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush sends the queued messages and waits until they are published.
+// It returns the errors of the batches sent since the last Flush.
+func (b *batchPublisher) Flush(ctx context.Context) error {
+	b.startOnce.Do(func() { go b.run() })
+
+	reply := make(chan error, 1)
+	select {
+	case b.flushes <- reply:
+	case <-b.closed:
+		return errBatchPublisherClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-reply:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close sends the queued messages and stops the worker.
+func (b *batchPublisher) Close() error {
+	b.startOnce.Do(func() { go b.run() })
+	b.closeOnce.Do(func() { close(b.closing) })
+	<-b.closed
+	return nil
+}
+
+// run is the worker loop sending the batches.
+func (b *batchPublisher) run() {
+	defer close(b.closed)
+
+	var (
+		batches = make(map[string][][]byte)
+		// topicIds are the topics with a pending batch, in the order they were first published to.
+		topicIds []string
+		errs     []error
+		linger   *time.Timer
+		lingerC  <-chan time.Time
+	)
+
+	add := func(message queuedMessage) {
+		if _, ok := batches[message.topicId]; !ok {
+			topicIds = append(topicIds, message.topicId)
+		}
+		batches[message.topicId] = append(batches[message.topicId], message.data)
+		if len(batches[message.topicId]) >= b.maxBatchSize {
+			errs = append(errs, b.send(message.topicId, batches[message.topicId])...)
+			batches[message.topicId] = batches[message.topicId][:0]
+		}
+		if lingerC == nil {
+			linger = time.NewTimer(b.linger)
+			lingerC = linger.C
+		}
+	}
+
+	sendAll := func() {
+		for _, topicId := range topicIds {
+			errs = append(errs, b.send(topicId, batches[topicId])...)
+		}
+		batches = make(map[string][][]byte)
+		topicIds = nil
+		if linger != nil {
+			linger.Stop()
+		}
+		lingerC = nil
+	}
+
+	// drainQueue adds the messages already queued, without waiting for more.
+	drainQueue := func() {
+		for {
+			select {
+			case message := <-b.queue:
+				add(message)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case message := <-b.queue:
+			add(message)
+		case <-lingerC:
+			sendAll()
+		case reply := <-b.flushes:
+			drainQueue()
+			sendAll()
+			reply <- joinBatchErrors(errs)
+			errs = nil
+		case <-b.closing:
+			drainQueue()
+			sendAll()
+			return
+		}
+	}
+}
+
+// send publishes the messages to the topic in batches of at most maxBatchSize messages.
+func (b *batchPublisher) send(topicId string, messages [][]byte) []error {
+	var errs []error
+	for start := 0; start < len(messages); start += b.maxBatchSize {
+		end := min(start+b.maxBatchSize, len(messages))
+		if err := b.publishBatch(context.Background(), topicId, messages[start:end]); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish %d messages to %s: %w", end-start, topicId, err))
+		}
+	}
+	return errs
+}
+
+// joinBatchErrors returns the first error of the batches, with the number of failed batches.
+func joinBatchErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d batches were not delivered: %w", len(errs), errs[0])
+}
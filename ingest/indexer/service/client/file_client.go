@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+// FileRecord is a line of the file written by the FileClient.
+type FileRecord struct {
+	Topic string          `json:"topic"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// FileClient is a client appending the published messages to a JSON lines file, one FileRecord per line.
+// It does not depend on any external service, which makes it suitable for end-to-end tests and local debugging.
+type FileClient struct {
+	topicPublisher
+
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ indexerdomain.Flusher = (*FileClient)(nil)
+
+// NewFileClient creates a new FileClient appending to the file at the given path.
+// Empty topic ids default to DefaultFileTopicIds.
+func NewFileClient(path string, topics TopicIds) *FileClient {
+	c := &FileClient{path: path}
+	c.topicPublisher = topicPublisher{
+		backend: "file",
+		topics:  topics.WithDefaults(DefaultFileTopicIds),
+		publish: c.append,
+	}
+	return c
+}
+
+// append writes the message as a single line to the file, opening it on first use.
+func (c *FileClient) append(_ context.Context, topicId string, data []byte) error {
+	line, err := json.Marshal(FileRecord{Topic: topicId, Data: data})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		file, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+		c.file = file
+	}
+
+	_, err = c.file.Write(line)
+	return err
+}
+
+// Flush implements domain.Flusher.
+// The messages are acknowledged once the file is synced to disk.
+func (c *FileClient) Flush(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+	return c.file.Sync()
+}
+
+// Close closes the underlying file.
+func (c *FileClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service/client"
+)
+
+// readFileRecords reads the records appended by the file client.
+func readFileRecords(t *testing.T, path string) []service.FileRecord {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []service.FileRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record service.FileRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+// TestFileClient tests that the file client appends one record per published message,
+// routed to the configured topic ids or to the default ones.
+func TestFileClient(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "indexer.jsonl")
+
+	client := service.NewFileClient(path, service.TopicIds{Block: "blocks"})
+	require.NoError(t, client.PublishBlock(ctx, indexerdomain.Block{ChainId: "osmosis-1", Height: 10}))
+	require.NoError(t, client.PublishTokenSupply(ctx, indexerdomain.TokenSupply{Denom: "uosmo", Supply: osmomath.NewInt(100)}))
+	require.NoError(t, client.PublishPair(ctx, indexerdomain.Pair{PoolID: 1, Denom0: "uosmo", Denom1: "uion"}))
+	require.NoError(t, client.Close())
+
+	// Publishing after closing reopens the file in append mode.
+	require.NoError(t, client.PublishBlock(ctx, indexerdomain.Block{ChainId: "osmosis-1", Height: 11}))
+	require.NoError(t, client.Close())
+
+	records := readFileRecords(t, path)
+	require.Len(t, records, 4)
+	require.Equal(t, []string{"blocks", "token_supply", "pair", "blocks"},
+		[]string{records[0].Topic, records[1].Topic, records[2].Topic, records[3].Topic})
+
+	var block indexerdomain.Block
+	require.NoError(t, json.Unmarshal(records[3].Data, &block))
+	require.Equal(t, uint64(11), block.Height)
+	require.False(t, block.IngestedAt.IsZero())
+
+	var tokenSupply indexerdomain.TokenSupply
+	require.NoError(t, json.Unmarshal(records[1].Data, &tokenSupply))
+	require.Equal(t, "uosmo", tokenSupply.Denom)
+	require.Equal(t, osmomath.NewInt(100), tokenSupply.Supply)
+}
+
+// TestFileClient_Concurrent tests that concurrently published messages are written as whole lines.
+func TestFileClient_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "indexer.jsonl")
+	client := service.NewFileClient(path, service.TopicIds{})
+
+	const numMessages = 100
+	var wg sync.WaitGroup
+	for i := 0; i < numMessages; i++ {
+		wg.Add(1)
+		go func(height uint64) {
+			defer wg.Done()
+			require.NoError(t, client.PublishBlock(ctx, indexerdomain.Block{Height: height}))
+		}(uint64(i))
+	}
+	wg.Wait()
+	require.NoError(t, client.Close())
+
+	records := readFileRecords(t, path)
+	require.Len(t, records, numMessages)
+	for _, record := range records {
+		require.Equal(t, "block", record.Topic)
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+// kafkaDeliveryTimeout bounds the time spent producing a batch of records, retries included.
+const kafkaDeliveryTimeout = 30 * time.Second
+
+// KafkaClient is a client for publishing messages to Kafka topics, producing to the brokers directly.
+// The topics are expected to be provisioned by the operator.
+//
+// The messages are queued and produced in batches by a background worker, so that publishing does not
+// wait for the brokers. A record is only considered delivered once the brokers acknowledged it, and
+// Flush returns the errors of the batches that were not.
+type KafkaClient struct {
+	topicPublisher
+	*batchPublisher
+
+	brokers []string
+
+	mu     sync.Mutex
+	client *kgo.Client
+}
+
+var _ indexerdomain.Flusher = (*KafkaClient)(nil)
+
+// NewKafkaClient creates a new KafkaClient producing records to the given topics through the given
+// seed brokers.
+func NewKafkaClient(brokers []string, topics TopicIds) *KafkaClient {
+	c := &KafkaClient{brokers: brokers}
+	c.batchPublisher = newBatchPublisher(c.produce)
+	c.topicPublisher = topicPublisher{
+		backend: "kafka",
+		topics:  topics,
+		publish: c.batchPublisher.enqueue,
+	}
+	return c
+}
+
+// produce produces the messages as records to the topic and waits for them to be acknowledged.
+// The records have no key, so that the client spreads them across the partitions of the topic.
+func (c *KafkaClient) produce(ctx context.Context, topic string, data [][]byte) error {
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, kafkaDeliveryTimeout)
+	defer cancel()
+
+	records := make([]*kgo.Record, len(data))
+	for i, value := range data {
+		records[i] = &kgo.Record{Topic: topic, Value: value}
+	}
+
+	var failed int
+	var firstErr error
+	for _, result := range client.ProduceSync(ctx, records...) {
+		if result.Err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = result.Err
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d records were not acknowledged: %w", failed, len(records), firstErr)
+	}
+	return nil
+}
+
+// getClient returns the Kafka client, creating it if not created yet.
+// The client connects to the seed brokers lazily, on the first produce.
+func (c *KafkaClient) getClient() (*kgo.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+	if len(c.brokers) == 0 {
+		return nil, fmt.Errorf("kafka brokers must be set")
+	}
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(c.brokers...),
+		kgo.ClientID("osmosis-indexer"),
+		kgo.RecordDeliveryTimeout(kafkaDeliveryTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.client = client
+	return client, nil
+}
+
+// Close produces the queued messages, then closes the connections to the brokers.
+func (c *KafkaClient) Close() error {
+	batchErr := c.batchPublisher.Close()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		return batchErr
+	}
+	c.client.Close()
+	c.client = nil
+	return batchErr
+}
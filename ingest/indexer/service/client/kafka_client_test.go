@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+	service "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service/client"
+)
+
+// TestKafkaClient tests that the kafka client produces the messages to the topic of their data type
+// on the brokers, and surfaces the errors on publish or on Flush.
+func TestKafkaClient(t *testing.T) {
+	topics := service.TopicIds{Block: "osmosis.blocks", Transaction: "osmosis.txs"}
+
+	tests := []struct {
+		name        string
+		noBrokers   bool
+		publish     func(client *service.KafkaClient) error
+		expectTopic string
+		expectErr   bool
+	}{
+		{
+			name: "block produced",
+			publish: func(client *service.KafkaClient) error {
+				return client.PublishBlock(context.Background(), indexerdomain.Block{ChainId: "osmosis-1", Height: 10})
+			},
+			expectTopic: "osmosis.blocks",
+		},
+		{
+			name: "transaction produced",
+			publish: func(client *service.KafkaClient) error {
+				return client.PublishTransaction(context.Background(), indexerdomain.Transaction{Height: 10})
+			},
+			expectTopic: "osmosis.txs",
+		},
+		{
+			name: "topic not configured",
+			publish: func(client *service.KafkaClient) error {
+				return client.PublishPair(context.Background(), indexerdomain.Pair{PoolID: 1})
+			},
+			expectErr: true,
+		},
+		{
+			name:      "brokers not configured",
+			noBrokers: true,
+			publish: func(client *service.KafkaClient) error {
+				return client.PublishBlock(context.Background(), indexerdomain.Block{Height: 10})
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := newKafkaCluster(t, "osmosis.blocks", "osmosis.txs")
+
+			brokers := cluster.ListenAddrs()
+			if tc.noBrokers {
+				brokers = nil
+			}
+			client := service.NewKafkaClient(brokers, topics)
+			defer client.Close()
+			err := tc.publish(client)
+			if err == nil {
+				err = client.Flush(context.Background())
+			}
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			records := consumeKafkaRecords(t, cluster, tc.expectTopic, 1)
+			var value map[string]any
+			require.NoError(t, json.Unmarshal(records[0].Value, &value))
+			require.Contains(t, value, "ingested_at")
+		})
+	}
+}
+
+// TestKafkaClient_Batching tests that the messages published to a topic between two flushes
+// are all produced, in order.
+func TestKafkaClient_Batching(t *testing.T) {
+	cluster := newKafkaCluster(t, "osmosis.blocks")
+
+	client := service.NewKafkaClient(cluster.ListenAddrs(), service.TopicIds{Block: "osmosis.blocks"})
+	defer client.Close()
+
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(t, client.PublishBlock(context.Background(), indexerdomain.Block{Height: height}))
+	}
+	require.NoError(t, client.Flush(context.Background()))
+
+	records := consumeKafkaRecords(t, cluster, "osmosis.blocks", 3)
+	for i, record := range records {
+		var block indexerdomain.Block
+		require.NoError(t, json.Unmarshal(record.Value, &block))
+		require.Equal(t, uint64(i+1), block.Height)
+	}
+}
+
+// newKafkaCluster starts an in-memory Kafka cluster with the given single partition topics.
+func newKafkaCluster(t *testing.T, topics ...string) *kfake.Cluster {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, topics...))
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+	return cluster
+}
+
+// consumeKafkaRecords consumes the first n records of the topic from the cluster.
+func consumeKafkaRecords(t *testing.T, cluster *kfake.Cluster, topic string, n int) []*kgo.Record {
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+	for len(records) < n {
+		fetches := consumer.PollFetches(ctx)
+		require.NoError(t, ctx.Err())
+		records = append(records, fetches.Records()...)
+	}
+	require.Len(t, records, n)
+	return records
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+// natsAckTimeout bounds the time spent waiting for the JetStream acknowledgements of a batch.
+const natsAckTimeout = 30 * time.Second
+
+// NATSClient is a client for publishing messages to NATS JetStream subjects.
+// The streams capturing the subjects are expected to be provisioned by the operator.
+//
+// The messages are queued and published in batches by a background worker with asynchronous JetStream
+// publishes, so that publishing does not wait for the server. A message is only considered delivered
+// once JetStream acknowledged it, and Flush returns the errors of the batches that were not.
+type NATSClient struct {
+	topicPublisher
+	*batchPublisher
+
+	url string
+
+	mu        sync.Mutex
+	conn      *nats.Conn
+	jetStream nats.JetStreamContext
+}
+
+var _ indexerdomain.Flusher = (*NATSClient)(nil)
+
+// NewNATSClient creates a new NATSClient connecting to the NATS server at the given url
+// and publishing to the given subjects.
+func NewNATSClient(url string, subjects TopicIds) *NATSClient {
+	c := &NATSClient{url: url}
+	c.batchPublisher = newBatchPublisher(c.publishToSubject)
+	c.topicPublisher = topicPublisher{
+		backend: "nats",
+		topics:  subjects,
+		publish: c.batchPublisher.enqueue,
+	}
+	return c
+}
+
+// publishToSubject publishes the messages to the subject asynchronously and waits for their JetStream acknowledgements.
+func (c *NATSClient) publishToSubject(ctx context.Context, subject string, data [][]byte) error {
+	jetStream, err := c.getJetStream()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, natsAckTimeout)
+	defer cancel()
+
+	acks := make([]nats.PubAckFuture, 0, len(data))
+	for _, message := range data {
+		ack, err := jetStream.PublishAsync(subject, message)
+		if err != nil {
+			return err
+		}
+		acks = append(acks, ack)
+	}
+
+	var failed int
+	var firstErr error
+	for _, ack := range acks {
+		select {
+		case <-ack.Ok():
+		case err := <-ack.Err():
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the jetstream acknowledgements: %w", ctx.Err())
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d messages were not acknowledged: %w", failed, len(acks), firstErr)
+	}
+	return nil
+}
+
+// getJetStream returns the JetStream context, connecting to the NATS server if not connected yet.
+func (c *NATSClient) getJetStream() (nats.JetStreamContext, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.jetStream != nil {
+		return c.jetStream, nil
+	}
+
+	conn, err := nats.Connect(c.url, nats.Name("osmosis-indexer"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	jetStream, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	c.conn = conn
+	c.jetStream = jetStream
+	return jetStream, nil
+}
+
+// Close publishes the queued messages, then drains and closes the connection to the NATS server.
+func (c *NATSClient) Close() error {
+	batchErr := c.batchPublisher.Close()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return batchErr
+	}
+	err := c.conn.Drain()
+	c.conn = nil
+	c.jetStream = nil
+	return errors.Join(batchErr, err)
+}
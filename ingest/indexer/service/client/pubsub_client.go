@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/pubsub"
@@ -22,8 +24,17 @@ type PubSubClient struct {
 	tokenSupplyOffsetTopicId string
 	pairTopicId              string
 	pubsubClient             *pubsub.Client
+
+	// mu guards the topics and the pending results, as pairs are published concurrently.
+	mu sync.Mutex
+	// topics are the topics published to, created on first use.
+	topics map[string]*pubsub.Topic
+	// pending are the results of the messages published since the last Flush.
+	pending []*pubsub.PublishResult
 }
 
+var _ indexerdomain.Flusher = (*PubSubClient)(nil)
+
 // NewPubSubCLient creates a new PubSubClient.
 func NewPubSubCLient(maxPublishDelay int, projectId, blockTopicId, transactionTopicId, poolTopicId, tokenSupplyTopicId, tokenSupplyOffsetTopicId, pairTopicID string) *PubSubClient {
 	return &PubSubClient{
@@ -35,11 +46,15 @@ func NewPubSubCLient(maxPublishDelay int, projectId, blockTopicId, transactionTo
 		tokenSupplyTopicId:       tokenSupplyTopicId,
 		tokenSupplyOffsetTopicId: tokenSupplyOffsetTopicId,
 		pairTopicId:              pairTopicID,
+		topics:                   make(map[string]*pubsub.Topic),
 	}
 }
 
 // publish publishes a message to the PubSub topic.
 func (p *PubSubClient) publish(ctx context.Context, message any, topicId string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	// Create PubSub client if it doesn't exist
 	if p.pubsubClient == nil {
 		client, err := pubsub.NewClient(ctx, p.projectId)
@@ -59,12 +74,47 @@ func (p *PubSubClient) publish(ctx context.Context, message any, topicId string)
 	// For example, if only one message is published over a span of several minutes, the default DelayThreshold and CountThreshold values
 	// are high enough that the message may seem undelivered or lost.
 	// To mitigate this, it's essential to reduce the DelayThreshold to a lower value, such as 4 seconds, to ensure timely delivery.
-	topic := p.pubsubClient.Topic(topicId)
-	topic.PublishSettings.DelayThreshold = time.Duration(p.maxPublishDelay) * time.Second
-	topic.Publish(ctx, &pubsub.Message{
+	topic, ok := p.topics[topicId]
+	if !ok {
+		topic = p.pubsubClient.Topic(topicId)
+		topic.PublishSettings.DelayThreshold = time.Duration(p.maxPublishDelay) * time.Second
+		p.topics[topicId] = topic
+	}
+	result := topic.Publish(ctx, &pubsub.Message{
 		Data: msgBytes,
 	})
+	p.pending = append(p.pending, result)
+
+	return nil
+}
 
+// Flush implements domain.Flusher.
+// It sends the messages batched by the topics without waiting for the DelayThreshold,
+// and returns the first error reported for the messages published since the last Flush.
+func (p *PubSubClient) Flush(ctx context.Context) error {
+	p.mu.Lock()
+	pending := p.pending
+	p.pending = nil
+	topics := make([]*pubsub.Topic, 0, len(p.topics))
+	for _, topic := range p.topics {
+		topics = append(topics, topic)
+	}
+	p.mu.Unlock()
+
+	// The topics are flushed without holding the lock, so that publishing is not blocked meanwhile.
+	for _, topic := range topics {
+		topic.Flush()
+	}
+
+	var errs []error
+	for _, result := range pending {
+		if _, err := result.Get(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d messages were not delivered: %w", len(errs), len(pending), errs[0])
+	}
 	return nil
 }
 
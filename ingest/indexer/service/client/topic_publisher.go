@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	indexerdomain "github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+// TopicIds are the topics the indexer data is published to.
+// Depending on the publisher backend, they are Kafka topics, NATS subjects or file record types.
+type TopicIds struct {
+	Block             string
+	Transaction       string
+	TokenSupply       string
+	TokenSupplyOffset string
+	Pair              string
+}
+
+// DefaultFileTopicIds are the record types used by the file publisher when no topic id is configured.
+var DefaultFileTopicIds = TopicIds{
+	Block:             "block",
+	Transaction:       "transaction",
+	TokenSupply:       "token_supply",
+	TokenSupplyOffset: "token_supply_offset",
+	Pair:              "pair",
+}
+
+// WithDefaults returns the topic ids with the empty ones replaced by the given defaults.
+func (t TopicIds) WithDefaults(defaults TopicIds) TopicIds {
+	orDefault := func(topicId, defaultTopicId string) string {
+		if topicId == "" {
+			return defaultTopicId
+		}
+		return topicId
+	}
+	return TopicIds{
+		Block:             orDefault(t.Block, defaults.Block),
+		Transaction:       orDefault(t.Transaction, defaults.Transaction),
+		TokenSupply:       orDefault(t.TokenSupply, defaults.TokenSupply),
+		TokenSupplyOffset: orDefault(t.TokenSupplyOffset, defaults.TokenSupplyOffset),
+		Pair:              orDefault(t.Pair, defaults.Pair),
+	}
+}
+
+// publishFunc publishes, or queues for publishing, the JSON encoded message to the given topic.
+type publishFunc func(ctx context.Context, topicId string, data []byte) error
+
+// topicPublisher implements domain.Publisher on top of a publishFunc by routing each
+// kind of data to its topic. It is embedded by the publisher backends.
+type topicPublisher struct {
+	backend string
+	topics  TopicIds
+	publish publishFunc
+}
+
+var _ indexerdomain.Publisher = topicPublisher{}
+
+// publishToTopic marshals the message and publishes it to the topic, which must be set.
+func (p topicPublisher) publishToTopic(ctx context.Context, message any, topicId, topicName string) error {
+	if topicId == "" {
+		return fmt.Errorf("%s topic id must be set for the %s publisher", topicName, p.backend)
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return p.publish(ctx, topicId, data)
+}
+
+// PublishBlock implements domain.Publisher.
+func (p topicPublisher) PublishBlock(ctx context.Context, block indexerdomain.Block) error {
+	block.IngestedAt = time.Now().UTC()
+	return p.publishToTopic(ctx, block, p.topics.Block, "block")
+}
+
+// PublishTransaction implements domain.Publisher.
+func (p topicPublisher) PublishTransaction(ctx context.Context, txn indexerdomain.Transaction) error {
+	txn.IngestedAt = time.Now().UTC()
+	return p.publishToTopic(ctx, txn, p.topics.Transaction, "transaction")
+}
+
+// PublishTokenSupply implements domain.Publisher.
+func (p topicPublisher) PublishTokenSupply(ctx context.Context, tokenSupply indexerdomain.TokenSupply) error {
+	tokenSupply.IngestedAt = time.Now().UTC()
+	return p.publishToTopic(ctx, tokenSupply, p.topics.TokenSupply, "token supply")
+}
+
+// PublishTokenSupplyOffset implements domain.Publisher.
+func (p topicPublisher) PublishTokenSupplyOffset(ctx context.Context, tokenSupplyOffset indexerdomain.TokenSupplyOffset) error {
+	tokenSupplyOffset.IngestedAt = time.Now().UTC()
+	return p.publishToTopic(ctx, tokenSupplyOffset, p.topics.TokenSupplyOffset, "token supply offset")
+}
+
+// PublishPair implements domain.Publisher.
+func (p topicPublisher) PublishPair(ctx context.Context, pair indexerdomain.Pair) error {
+	pair.IngestedAt = time.Now().UTC()
+	return p.publishToTopic(ctx, pair, p.topics.Pair, "pair")
+}
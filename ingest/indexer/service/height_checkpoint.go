@@ -0,0 +1,73 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/osmosis-labs/osmosis/v31/ingest/indexer/domain"
+)
+
+var _ domain.HeightCheckpoint = (*fileHeightCheckpoint)(nil)
+
+// heightCheckpointData is the content of the height checkpoint file.
+type heightCheckpointData struct {
+	Height int64 `json:"height"`
+}
+
+// fileHeightCheckpoint persists the height checkpoint in a JSON file.
+type fileHeightCheckpoint struct {
+	path string
+}
+
+// NewFileHeightCheckpoint creates a height checkpoint persisted in the JSON file at the given path.
+func NewFileHeightCheckpoint(path string) domain.HeightCheckpoint {
+	return &fileHeightCheckpoint{path: path}
+}
+
+// Load implements domain.HeightCheckpoint.
+func (c *fileHeightCheckpoint) Load() (int64, bool, error) {
+	bz, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	var data heightCheckpointData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return 0, false, err
+	}
+	return data.Height, true, nil
+}
+
+// Save implements domain.HeightCheckpoint.
+// The checkpoint is written to a temporary file that is then renamed, so that an interruption
+// never leaves a partially written checkpoint.
+func (c *fileHeightCheckpoint) Save(height int64) error {
+	bz, err := json.Marshal(heightCheckpointData{Height: height})
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(bz); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), c.path)
+}
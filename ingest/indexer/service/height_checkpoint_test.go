@@ -0,0 +1,27 @@
+package service_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	indexerservice "github.com/osmosis-labs/osmosis/v31/ingest/indexer/service"
+)
+
+// TestFileHeightCheckpoint tests that the file height checkpoint round trips the saved height.
+func TestFileHeightCheckpoint(t *testing.T) {
+	checkpoint := indexerservice.NewFileHeightCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+	_, found, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, checkpoint.Save(10))
+	require.NoError(t, checkpoint.Save(11))
+
+	height, found, err := checkpoint.Load()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(11), height)
+}
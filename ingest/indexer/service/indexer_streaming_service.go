@@ -420,8 +420,9 @@ func (s *indexerStreamingService) ListenCommit(ctx context.Context, res abci.Res
 	// Process block.
 	if err := blockProcessor.ProcessBlock(sdkCtx); err != nil {
 		// In the case of full block processor, if any error is returned, including node is syncing or sync check fails,
-		// data is not marked as ingested and will be retried in the next block
-		return err
+		// data is not marked as ingested and will be retried in the next block.
+		// The messages already published for the block are still acknowledged.
+		return errors.Join(err, s.acknowledgeHeight(sdkCtx))
 	}
 
 	// If block processor is a full block processor, mark the initial data as ingested
@@ -429,7 +430,18 @@ func (s *indexerStreamingService) ListenCommit(ctx context.Context, res abci.Res
 		s.blockProcessStrategyManager.MarkInitialDataIngested()
 	}
 
-	return nil
+	return s.acknowledgeHeight(sdkCtx)
+}
+
+// acknowledgeHeight marks the messages published for the committed block, by the write listeners,
+// ListenFinalizeBlock and ListenCommit, as complete, if the publisher tracks their delivery.
+// Their delivery is acknowledged in the background, without delaying the commit.
+func (s *indexerStreamingService) acknowledgeHeight(ctx sdk.Context) error {
+	deliveryTracker, ok := s.client.(domain.DeliveryTracker)
+	if !ok {
+		return nil
+	}
+	return deliveryTracker.AcknowledgeHeight(ctx, ctx.BlockHeight())
 }
 
 // Stream implements baseapp.StreamingService.
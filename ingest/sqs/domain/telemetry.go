@@ -44,4 +44,12 @@ var (
 	// * err - the error returned
 	// * height - the height of the block being processed
 	SQSGRPCConnectionErrorMetricName = "sqs_grpc_connection_error"
+
+	// sqs_grpc_delta_resync
+	//
+	// counter that is increased if the sqs service requests a snapshot after failing to apply a block delta
+	//
+	// Has the following labels:
+	// * height - the height of the block being processed
+	SQSGRPCDeltaResyncMetricName = "sqs_grpc_delta_resync"
 )
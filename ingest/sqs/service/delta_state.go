@@ -0,0 +1,329 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	ingesttypes "github.com/osmosis-labs/osmosis/v31/ingest/types"
+	prototypes "github.com/osmosis-labs/osmosis/v31/ingest/types/proto/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// streamedPool is the data of a pool as streamed to SQS.
+type streamedPool struct {
+	id         uint64
+	chainModel []byte
+	// sqsModel is the serialized sqs pool model, including the balances.
+	sqsModel []byte
+	// sqsModelWithoutBalances is the serialized sqs pool model without the balances,
+	// used to detect the changes to the sqs model other than the balances.
+	sqsModelWithoutBalances []byte
+	balances                []byte
+	// tickModel is only set for concentrated pools.
+	tickModel *ingesttypes.TickModel
+	// denoms are the sorted denoms of the pool, whose pairs are charged taker fees.
+	denoms []string
+}
+
+// newStreamedPool serializes the pool data for streaming.
+func newStreamedPool(pool ingesttypes.PoolI, chainModel []byte) (streamedPool, error) {
+	sqsModel := pool.GetSQSPoolModel()
+	sqsModelBz, err := json.Marshal(sqsModel)
+	if err != nil {
+		return streamedPool{}, err
+	}
+
+	balancesBz, err := json.Marshal(sqsModel.Balances)
+	if err != nil {
+		return streamedPool{}, err
+	}
+
+	sqsModel.Balances = nil
+	sqsModelWithoutBalancesBz, err := json.Marshal(sqsModel)
+	if err != nil {
+		return streamedPool{}, err
+	}
+
+	streamed := streamedPool{
+		id:                      pool.GetId(),
+		chainModel:              chainModel,
+		sqsModel:                sqsModelBz,
+		sqsModelWithoutBalances: sqsModelWithoutBalancesBz,
+		balances:                balancesBz,
+		denoms:                  append([]string(nil), pool.GetPoolDenoms()...),
+	}
+
+	if pool.GetType() == poolmanagertypes.Concentrated {
+		streamed.tickModel, err = pool.GetTickModel()
+		if err != nil {
+			return streamedPool{}, err
+		}
+	}
+
+	return streamed, nil
+}
+
+// poolData returns the pool data sent for a pool streamed in full.
+func (p streamedPool) poolData() (*prototypes.PoolData, error) {
+	var tickModelBz []byte
+	if p.tickModel != nil {
+		var err error
+		tickModelBz, err = json.Marshal(p.tickModel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &prototypes.PoolData{
+		ChainModel: p.chainModel,
+		SqsModel:   p.sqsModel,
+		TickModel:  tickModelBz,
+	}, nil
+}
+
+// deltaState is the data acknowledged by SQS so far. The block deltas are computed against it.
+type deltaState struct {
+	pools     map[uint64]streamedPool
+	takerFees ingesttypes.TakerFeeMap
+}
+
+func newDeltaState() *deltaState {
+	return &deltaState{
+		pools:     map[uint64]streamedPool{},
+		takerFees: ingesttypes.TakerFeeMap{},
+	}
+}
+
+// computeBlockDelta returns the delta from the state to the given pools and taker fees, and the state
+// after the delta is applied. The state itself is not modified so that it can be kept if the delta is
+// not acknowledged.
+// The taker fees of the pairs that a pool stopped trading, and that no other pool trades, are removed.
+// If snapshot is true, the delta contains every pool and taker fee of the new state.
+func (s *deltaState) computeBlockDelta(height uint64, pools []streamedPool, takerFees ingesttypes.TakerFeeMap, snapshot bool) (*prototypes.BlockDelta, *deltaState, error) {
+	next := &deltaState{
+		pools:     make(map[uint64]streamedPool, len(s.pools)+len(pools)),
+		takerFees: make(ingesttypes.TakerFeeMap, len(s.takerFees)+len(takerFees)),
+	}
+	for id, pool := range s.pools {
+		next.pools[id] = pool
+	}
+	for pair, takerFee := range s.takerFees {
+		next.takerFees[pair] = takerFee
+	}
+
+	delta := &prototypes.BlockDelta{
+		BlockHeight: height,
+		IsSnapshot:  snapshot,
+	}
+
+	changedTakerFees := ingesttypes.TakerFeeMap{}
+	for pair, takerFee := range takerFees {
+		if previous, ok := s.takerFees[pair]; !ok || !previous.Equal(takerFee) {
+			changedTakerFees[pair] = takerFee
+		}
+		next.takerFees[pair] = takerFee
+	}
+
+	// removedPairs are the pairs that the pools stopped trading. Their taker fees are removed
+	// unless another pool still trades them.
+	removedPairs := map[ingesttypes.DenomPair]struct{}{}
+	for _, pool := range pools {
+		previous, ok := s.pools[pool.id]
+		next.pools[pool.id] = pool
+		if ok && !slices.Equal(previous.denoms, pool.denoms) {
+			currentPairs := denomPairs(pool.denoms)
+			for pair := range denomPairs(previous.denoms) {
+				if _, ok := currentPairs[pair]; !ok {
+					removedPairs[pair] = struct{}{}
+				}
+			}
+		}
+		if snapshot {
+			continue
+		}
+
+		if !ok {
+			poolData, err := pool.poolData()
+			if err != nil {
+				return nil, nil, err
+			}
+			delta.Pools = append(delta.Pools, poolData)
+			continue
+		}
+
+		poolDelta, err := diffPool(previous, pool)
+		if err != nil {
+			return nil, nil, err
+		}
+		if poolDelta != nil {
+			delta.PoolDeltas = append(delta.PoolDeltas, poolDelta)
+		}
+	}
+
+	removedTakerFeePairs := next.removeTakerFees(removedPairs, takerFees)
+
+	if snapshot {
+		ids := make([]uint64, 0, len(next.pools))
+		for id := range next.pools {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		delta.Pools = make([]*prototypes.PoolData, 0, len(ids))
+		for _, id := range ids {
+			poolData, err := next.pools[id].poolData()
+			if err != nil {
+				return nil, nil, err
+			}
+			delta.Pools = append(delta.Pools, poolData)
+		}
+
+		changedTakerFees = next.takerFees
+	}
+
+	if len(changedTakerFees) > 0 {
+		takerFeesBz, err := changedTakerFees.MarshalJSON()
+		if err != nil {
+			return nil, nil, err
+		}
+		delta.TakerFeesMap = takerFeesBz
+	}
+
+	if !snapshot {
+		delta.RemovedTakerFeePairs = removedTakerFeePairs
+	}
+
+	return delta, next, nil
+}
+
+// removeTakerFees removes the taker fees of the given pairs that are neither traded by a pool of the state
+// nor in the taker fees of the block, and returns the removed pairs formatted as the taker fee map keys, sorted.
+func (s *deltaState) removeTakerFees(pairs map[ingesttypes.DenomPair]struct{}, blockTakerFees ingesttypes.TakerFeeMap) []string {
+	for pair := range pairs {
+		if _, ok := s.takerFees[pair]; !ok {
+			delete(pairs, pair)
+		} else if _, ok := blockTakerFees[pair]; ok {
+			delete(pairs, pair)
+		}
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	for _, pool := range s.pools {
+		for pair := range denomPairs(pool.denoms) {
+			delete(pairs, pair)
+		}
+	}
+
+	removed := make([]string, 0, len(pairs))
+	for pair := range pairs {
+		delete(s.takerFees, pair)
+		removed = append(removed, fmt.Sprintf("%s|%s", pair.Denom0, pair.Denom1))
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// denomPairs returns the pairs of distinct denoms, in both directions, as the taker fees are charged.
+func denomPairs(denoms []string) map[ingesttypes.DenomPair]struct{} {
+	pairs := make(map[ingesttypes.DenomPair]struct{}, len(denoms)*len(denoms))
+	for i, denomI := range denoms {
+		for j, denomJ := range denoms {
+			if i != j {
+				pairs[ingesttypes.DenomPair{Denom0: denomI, Denom1: denomJ}] = struct{}{}
+			}
+		}
+	}
+	return pairs
+}
+
+// diffPool returns the changes from the previous to the current data of a pool,
+// or nil if the pool did not change.
+func diffPool(previous, current streamedPool) (*prototypes.PoolDelta, error) {
+	poolDelta := &prototypes.PoolDelta{PoolId: current.id}
+	changed := false
+
+	if !bytes.Equal(previous.chainModel, current.chainModel) {
+		poolDelta.ChainModel = current.chainModel
+		changed = true
+	}
+	if !bytes.Equal(previous.sqsModelWithoutBalances, current.sqsModelWithoutBalances) {
+		poolDelta.SqsModel = current.sqsModel
+		changed = true
+	}
+	if !bytes.Equal(previous.balances, current.balances) {
+		poolDelta.Balances = current.balances
+		changed = true
+	}
+
+	tickModelDelta, err := diffTickModel(previous.tickModel, current.tickModel)
+	if err != nil {
+		return nil, err
+	}
+	if tickModelDelta != nil {
+		poolDelta.TickModelDelta = tickModelDelta
+		changed = true
+	}
+
+	if !changed {
+		return nil, nil
+	}
+	return poolDelta, nil
+}
+
+// diffTickModel returns the changes from the previous to the current tick model,
+// or nil if the tick model did not change. The liquidity depth ranges are keyed by their lower tick.
+func diffTickModel(previous, current *ingesttypes.TickModel) (*prototypes.TickModelDelta, error) {
+	if current == nil {
+		return nil, nil
+	}
+	if previous == nil {
+		previous = &ingesttypes.TickModel{}
+	}
+
+	previousTicks := make(map[int64]ingesttypes.LiquidityDepthsWithRange, len(previous.Ticks))
+	for _, tick := range previous.Ticks {
+		previousTicks[tick.LowerTick] = tick
+	}
+
+	upsertedTicks := []ingesttypes.LiquidityDepthsWithRange{}
+	currentLowerTicks := make(map[int64]struct{}, len(current.Ticks))
+	for _, tick := range current.Ticks {
+		currentLowerTicks[tick.LowerTick] = struct{}{}
+
+		previousTick, ok := previousTicks[tick.LowerTick]
+		if !ok || previousTick.UpperTick != tick.UpperTick || !previousTick.LiquidityAmount.Equal(tick.LiquidityAmount) {
+			upsertedTicks = append(upsertedTicks, tick)
+		}
+	}
+
+	removedLowerTicks := []int64{}
+	for _, tick := range previous.Ticks {
+		if _, ok := currentLowerTicks[tick.LowerTick]; !ok {
+			removedLowerTicks = append(removedLowerTicks, tick.LowerTick)
+		}
+	}
+
+	if len(upsertedTicks) == 0 && len(removedLowerTicks) == 0 &&
+		previous.CurrentTickIndex == current.CurrentTickIndex && previous.HasNoLiquidity == current.HasNoLiquidity {
+		return nil, nil
+	}
+
+	tickModelDelta := &prototypes.TickModelDelta{
+		RemovedLowerTicks: removedLowerTicks,
+		CurrentTickIndex:  current.CurrentTickIndex,
+		HasNoLiquidity:    current.HasNoLiquidity,
+	}
+	if len(upsertedTicks) > 0 {
+		upsertedTicksBz, err := json.Marshal(upsertedTicks)
+		if err != nil {
+			return nil, err
+		}
+		tickModelDelta.UpsertedTicks = upsertedTicksBz
+	}
+	return tickModelDelta, nil
+}
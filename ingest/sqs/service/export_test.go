@@ -1,9 +1,17 @@
 package service
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type SQSStreamingService = sqsStreamingService
 
 func (s *sqsStreamingService) ProcessBlockRecoverError(ctx sdk.Context) error {
 	return s.processBlockRecoverError(ctx)
 }
+
+func (g *GRPCDeltaClient) SetPushTimeout(pushTimeout time.Duration) {
+	g.pushTimeout = pushTimeout
+}
@@ -65,7 +65,7 @@ func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []ingest
 		// Using the built-in GRPC retry back-off logic is likely to halt the serial system.
 		// As a result, we opt in for simply continuing to attempting to process the next block
 		// and retrying the connection and ingest
-		g.grpcConn, err = grpc.NewClient(g.grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(g.grpcMaxCallSizeBytes)), grpc.WithDisableRetry(), grpc.WithDisableRetry(), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+		g.grpcConn, err = newGRPCConn(g.grpcAddress, g.grpcMaxCallSizeBytes)
 		if err != nil {
 			shouldResetConnection = true
 			return err
@@ -108,6 +108,11 @@ func (g *GRPCClient) PushData(ctx context.Context, height uint64, pools []ingest
 	return nil
 }
 
+// newGRPCConn creates a connection to the sqs ingest service, without retries.
+func newGRPCConn(grpcAddress string, grpcMaxCallSizeBytes int) (*grpc.ClientConn, error) {
+	return grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(grpcMaxCallSizeBytes)), grpc.WithDisableRetry(), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+}
+
 // marshalPools marshals pools into a format that can be sent over gRPC.
 func (g *GRPCClient) marshalPools(pools []ingesttypes.PoolI) ([]*prototypes.PoolData, error) {
 	// Marshal pools
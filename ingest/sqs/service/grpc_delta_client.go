@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v31/ingest/sqs/domain"
+	ingesttypes "github.com/osmosis-labs/osmosis/v31/ingest/types"
+	prototypes "github.com/osmosis-labs/osmosis/v31/ingest/types/proto/types"
+)
+
+// defaultDeltaPushTimeout bounds the time spent opening the stream, sending a block delta
+// and waiting for its acknowledgement.
+const defaultDeltaPushTimeout = 10 * time.Second
+
+// GRPCDeltaClient pushes the block data to SQS as deltas over a bidirectional stream.
+// Only the pools, ticks, balances and taker fees that changed since the previous block are sent.
+// Every delta has a sequence number and is acknowledged by SQS before the next one is sent.
+// The first delta of a stream is a snapshot of all the streamed data. If SQS cannot apply a delta,
+// for example because it observed a gap in the sequence numbers, it requests a resync and the client
+// sends a snapshot.
+//
+// Every call on the stream is bound to a deadline. If SQS does not answer in time, the stream and
+// the connection are closed, and the client reconnects during the next block.
+type GRPCDeltaClient struct {
+	grpcAddress          string
+	grpcMaxCallSizeBytes int
+	grpcConn             *grpc.ClientConn
+	appCodec             codec.Codec
+	pushTimeout          time.Duration
+
+	stream       prototypes.SQSIngester_StreamBlockDeltasClient
+	cancelStream context.CancelFunc
+	// sequence is the sequence number of the last acknowledged delta in the stream.
+	sequence uint64
+	// state is the data acknowledged by SQS so far.
+	state *deltaState
+}
+
+var (
+	_ domain.SQSGRPClient = &GRPCDeltaClient{}
+)
+
+func NewGRPCDeltaClient(grpcAddress string, grpcMaxCallSizeBytes int, appCodec codec.Codec) *GRPCDeltaClient {
+	return &GRPCDeltaClient{
+		grpcAddress:          grpcAddress,
+		grpcMaxCallSizeBytes: grpcMaxCallSizeBytes,
+		appCodec:             appCodec,
+		pushTimeout:          defaultDeltaPushTimeout,
+		state:                newDeltaState(),
+	}
+}
+
+// PushData implements domain.SQSGRPClient.
+// It sends the delta of the pools and the taker fees and waits for its acknowledgement, for at most the push timeout.
+// If the stream fails or times out, it is closed and a new stream starting with a snapshot is opened during the next block.
+func (g *GRPCDeltaClient) PushData(ctx context.Context, height uint64, pools []ingesttypes.PoolI, takerFeesMap ingesttypes.TakerFeeMap) (err error) {
+	// If sqs service is unavailable, we should reset the connection
+	// and attempt to reconnect during the next block.
+	var shouldResetConnection bool
+
+	defer func() {
+		if err != nil {
+			// The acknowledgement of the delta in flight is unknown, so the stream cannot be reused.
+			g.closeStream()
+		}
+
+		if shouldResetConnection {
+			if g.grpcConn != nil {
+				g.grpcConn.Close()
+				g.grpcConn = nil
+			}
+
+			// Increase the counter for the grpc connection error
+			telemetry.IncrCounterWithLabels([]string{domain.SQSGRPCConnectionErrorMetricName}, 1, []metrics.Label{
+				telemetry.NewLabel("height", fmt.Sprintf("%d", height)),
+				telemetry.NewLabel("err", err.Error()),
+			})
+		}
+	}()
+
+	if g.grpcConn == nil {
+		g.grpcConn, err = newGRPCConn(g.grpcAddress, g.grpcMaxCallSizeBytes)
+		if err != nil {
+			shouldResetConnection = true
+			return err
+		}
+	}
+
+	streamedPools, err := g.newStreamedPools(pools)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.pushTimeout)
+	defer cancel()
+
+	if g.stream == nil {
+		// The stream outlives the block, so it is not bound to the block context.
+		// Only opening it, which waits for the connection, is bound to the push deadline.
+		streamCtx, cancel := context.WithCancel(context.Background())
+		g.cancelStream = cancel
+		g.sequence = 0
+		err = g.withDeadline(ctx, func() (err error) {
+			g.stream, err = prototypes.NewSQSIngesterClient(g.grpcConn).StreamBlockDeltas(streamCtx)
+			return err
+		})
+		if err != nil {
+			shouldResetConnection = shouldReconnect(err)
+			return err
+		}
+	}
+
+	// The first delta of a stream is a snapshot.
+	resync, err := g.sendBlockDelta(ctx, height, streamedPools, takerFeesMap, g.sequence == 0)
+	if err != nil {
+		shouldResetConnection = shouldReconnect(err)
+		return err
+	}
+
+	if resync {
+		telemetry.IncrCounterWithLabels([]string{domain.SQSGRPCDeltaResyncMetricName}, 1, []metrics.Label{
+			telemetry.NewLabel("height", fmt.Sprintf("%d", height)),
+		})
+
+		resync, err = g.sendBlockDelta(ctx, height, streamedPools, takerFeesMap, true)
+		if err != nil {
+			shouldResetConnection = shouldReconnect(err)
+			return err
+		}
+		if resync {
+			return fmt.Errorf("sqs could not apply the snapshot at height %d", height)
+		}
+	}
+
+	return nil
+}
+
+// sendBlockDelta sends the delta from the acknowledged state and waits for its acknowledgement until the context is done.
+// The state and the sequence number are only updated if the delta is applied.
+// Returns true if SQS requested a resync instead of applying the delta.
+func (g *GRPCDeltaClient) sendBlockDelta(ctx context.Context, height uint64, pools []streamedPool, takerFeesMap ingesttypes.TakerFeeMap, snapshot bool) (bool, error) {
+	delta, next, err := g.state.computeBlockDelta(height, pools, takerFeesMap, snapshot)
+	if err != nil {
+		return false, err
+	}
+	delta.Sequence = g.sequence + 1
+
+	var ack *prototypes.BlockDeltaAck
+	err = g.withDeadline(ctx, func() error {
+		if err := g.stream.Send(delta); err != nil {
+			// On io.EOF, the stream was closed by the server and the status is returned by Recv.
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+		}
+
+		var err error
+		ack, err = g.stream.Recv()
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// The delta sequence is not reused whether or not the delta was applied.
+	g.sequence = delta.Sequence
+
+	// A sequence number other than the one sent means that a delta was missed.
+	if ack.Resync || ack.Sequence != delta.Sequence {
+		return true, nil
+	}
+
+	g.state = next
+	return false, nil
+}
+
+// newStreamedPools serializes the pools for streaming.
+func (g *GRPCDeltaClient) newStreamedPools(pools []ingesttypes.PoolI) ([]streamedPool, error) {
+	streamedPools := make([]streamedPool, 0, len(pools))
+	for _, pool := range pools {
+		chainPoolBz, err := g.appCodec.MarshalInterfaceJSON(pool.GetUnderlyingPool())
+		if err != nil {
+			return nil, err
+		}
+
+		streamed, err := newStreamedPool(pool, chainPoolBz)
+		if err != nil {
+			return nil, err
+		}
+		streamedPools = append(streamedPools, streamed)
+	}
+	return streamedPools, nil
+}
+
+// withDeadline runs the call on the stream, cancelling the stream if the context is done before the call returns.
+func (g *GRPCDeltaClient) withDeadline(ctx context.Context, call func() error) error {
+	stop := context.AfterFunc(ctx, g.cancelStream)
+	err := call()
+	if !stop() {
+		return fmt.Errorf("sqs did not answer within the push timeout: %w", ctx.Err())
+	}
+	return err
+}
+
+// closeStream closes the stream so that a new one is opened during the next block.
+func (g *GRPCDeltaClient) closeStream() {
+	if g.cancelStream != nil {
+		g.cancelStream()
+	}
+	g.stream = nil
+	g.cancelStream = nil
+	g.sequence = 0
+}
+
+// shouldReconnect returns true if the error is the status.Unavailable grpc error, or if sqs did not answer in time.
+func shouldReconnect(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	status, ok := status.FromError(err)
+	return ok && status.Code() == codes.Unavailable
+}
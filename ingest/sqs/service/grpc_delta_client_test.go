@@ -0,0 +1,258 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/ingest/sqs/service"
+	ingesttypes "github.com/osmosis-labs/osmosis/v31/ingest/types"
+	prototypes "github.com/osmosis-labs/osmosis/v31/ingest/types/proto/types"
+)
+
+// mockDeltaIngester is an sqs ingest server recording the received block deltas.
+type mockDeltaIngester struct {
+	prototypes.UnimplementedSQSIngesterServer
+
+	mu     sync.Mutex
+	deltas []*prototypes.BlockDelta
+	// resyncSequences are the sequences of the deltas for which a resync is requested.
+	resyncSequences map[uint64]struct{}
+	// stallSequences are the sequences of the deltas that are never acknowledged.
+	stallSequences map[uint64]struct{}
+}
+
+// StreamBlockDeltas implements prototypes.SQSIngesterServer.
+func (m *mockDeltaIngester) StreamBlockDeltas(stream prototypes.SQSIngester_StreamBlockDeltasServer) error {
+	for {
+		delta, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		m.mu.Lock()
+		m.deltas = append(m.deltas, delta)
+		_, resync := m.resyncSequences[delta.Sequence]
+		_, stall := m.stallSequences[delta.Sequence]
+		delete(m.stallSequences, delta.Sequence)
+		m.mu.Unlock()
+
+		if stall {
+			<-stream.Context().Done()
+			return stream.Context().Err()
+		}
+
+		if err := stream.Send(&prototypes.BlockDeltaAck{Sequence: delta.Sequence, Resync: resync}); err != nil {
+			return err
+		}
+	}
+}
+
+// popDeltas returns the received deltas and clears them.
+func (m *mockDeltaIngester) popDeltas() []*prototypes.BlockDelta {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	deltas := m.deltas
+	m.deltas = nil
+	return deltas
+}
+
+// startMockDeltaIngester serves the mock ingester on a local port and returns its address.
+func (s *SQSServiceTestSuite) startMockDeltaIngester(ingester *mockDeltaIngester) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+
+	server := grpc.NewServer()
+	prototypes.RegisterSQSIngesterServer(server, ingester)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	s.T().Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+// This test validates that the delta client streams a snapshot first, then only the changes
+// of the following blocks, and that it sends a snapshot when the server requests a resync.
+func (s *SQSServiceTestSuite) TestGRPCDeltaClientPushData() {
+	s.Setup()
+
+	balancerPoolID := s.PrepareBalancerPool()
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+	concentratedPool := s.PrepareConcentratedPool()
+
+	var (
+		defaultBalances = sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(100)))
+		tickA           = ingesttypes.LiquidityDepthsWithRange{LowerTick: -100, UpperTick: 0, LiquidityAmount: osmomath.NewDec(10)}
+		tickB           = ingesttypes.LiquidityDepthsWithRange{LowerTick: 0, UpperTick: 100, LiquidityAmount: osmomath.NewDec(20)}
+		tickC           = ingesttypes.LiquidityDepthsWithRange{LowerTick: 100, UpperTick: 200, LiquidityAmount: osmomath.NewDec(30)}
+		takerFees       = ingesttypes.TakerFeeMap{{Denom0: "foo", Denom1: "bar"}: osmomath.MustNewDecFromStr("0.002")}
+	)
+
+	newPools := func(balances sdk.Coins, ticks []ingesttypes.LiquidityDepthsWithRange) []ingesttypes.PoolI {
+		cfmmPool := ingesttypes.NewPool(balancerPool, osmomath.ZeroDec(), balances)
+		clPool := ingesttypes.NewPool(concentratedPool, osmomath.ZeroDec(), defaultBalances)
+		clPool.TickModel = &ingesttypes.TickModel{Ticks: ticks, CurrentTickIndex: 1}
+		return []ingesttypes.PoolI{cfmmPool, clPool}
+	}
+
+	ingester := &mockDeltaIngester{resyncSequences: map[uint64]struct{}{4: {}}}
+	client := service.NewGRPCDeltaClient(s.startMockDeltaIngester(ingester), 1024*1024, s.App.AppCodec())
+
+	// The first block is streamed as a snapshot
+	err = client.PushData(s.Ctx, 1, newPools(defaultBalances, []ingesttypes.LiquidityDepthsWithRange{tickA, tickB}), takerFees)
+	s.Require().NoError(err)
+
+	deltas := ingester.popDeltas()
+	s.Require().Len(deltas, 1)
+	s.Require().Equal(uint64(1), deltas[0].Sequence)
+	s.Require().True(deltas[0].IsSnapshot)
+	s.Require().Len(deltas[0].Pools, 2)
+	s.Require().Empty(deltas[0].PoolDeltas)
+	s.Require().NotEmpty(deltas[0].TakerFeesMap)
+
+	// The second block only streams the changed balances and ticks
+	updatedTickA := tickA
+	updatedTickA.LiquidityAmount = osmomath.NewDec(15)
+	updatedBalances := sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(200)))
+	err = client.PushData(s.Ctx, 2, newPools(updatedBalances, []ingesttypes.LiquidityDepthsWithRange{updatedTickA, tickC}), takerFees)
+	s.Require().NoError(err)
+
+	deltas = ingester.popDeltas()
+	s.Require().Len(deltas, 1)
+	s.Require().Equal(uint64(2), deltas[0].Sequence)
+	s.Require().False(deltas[0].IsSnapshot)
+	s.Require().Empty(deltas[0].Pools)
+	s.Require().Empty(deltas[0].TakerFeesMap)
+	s.Require().Len(deltas[0].PoolDeltas, 2)
+
+	cfmmDelta := deltas[0].PoolDeltas[0]
+	s.Require().Equal(balancerPoolID, cfmmDelta.PoolId)
+	s.Require().Empty(cfmmDelta.ChainModel)
+	s.Require().Empty(cfmmDelta.SqsModel)
+	s.Require().Nil(cfmmDelta.TickModelDelta)
+	var balances sdk.Coins
+	s.Require().NoError(json.Unmarshal(cfmmDelta.Balances, &balances))
+	s.Require().Equal(updatedBalances, balances)
+
+	clDelta := deltas[0].PoolDeltas[1]
+	s.Require().Equal(concentratedPool.GetId(), clDelta.PoolId)
+	s.Require().Empty(clDelta.ChainModel)
+	s.Require().Empty(clDelta.Balances)
+	s.Require().NotNil(clDelta.TickModelDelta)
+	var upsertedTicks []ingesttypes.LiquidityDepthsWithRange
+	s.Require().NoError(json.Unmarshal(clDelta.TickModelDelta.UpsertedTicks, &upsertedTicks))
+	s.Require().Equal([]ingesttypes.LiquidityDepthsWithRange{updatedTickA, tickC}, upsertedTicks)
+	s.Require().Equal([]int64{tickB.LowerTick}, clDelta.TickModelDelta.RemovedLowerTicks)
+	s.Require().Equal(int64(1), clDelta.TickModelDelta.CurrentTickIndex)
+
+	// A block without changes streams an empty delta
+	err = client.PushData(s.Ctx, 3, newPools(updatedBalances, []ingesttypes.LiquidityDepthsWithRange{updatedTickA, tickC}), takerFees)
+	s.Require().NoError(err)
+
+	deltas = ingester.popDeltas()
+	s.Require().Len(deltas, 1)
+	s.Require().Equal(uint64(3), deltas[0].Sequence)
+	s.Require().Empty(deltas[0].Pools)
+	s.Require().Empty(deltas[0].PoolDeltas)
+
+	// The server requests a resync of the fourth delta, so a snapshot is sent
+	err = client.PushData(s.Ctx, 4, newPools(defaultBalances, []ingesttypes.LiquidityDepthsWithRange{updatedTickA, tickC}), takerFees)
+	s.Require().NoError(err)
+
+	deltas = ingester.popDeltas()
+	s.Require().Len(deltas, 2)
+	s.Require().Equal(uint64(4), deltas[0].Sequence)
+	s.Require().False(deltas[0].IsSnapshot)
+	s.Require().Equal(uint64(5), deltas[1].Sequence)
+	s.Require().True(deltas[1].IsSnapshot)
+	s.Require().Len(deltas[1].Pools, 2)
+	s.Require().NotEmpty(deltas[1].TakerFeesMap)
+}
+
+// This test validates that a delta that is not acknowledged in time fails the push without blocking,
+// and that the client reconnects and streams a snapshot during the next block.
+func (s *SQSServiceTestSuite) TestGRPCDeltaClientPushData_Timeout() {
+	s.Setup()
+
+	balancerPoolID := s.PrepareBalancerPool()
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+	pools := []ingesttypes.PoolI{ingesttypes.NewPool(balancerPool, osmomath.ZeroDec(), sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(100))))}
+
+	ingester := &mockDeltaIngester{stallSequences: map[uint64]struct{}{2: {}}}
+	client := service.NewGRPCDeltaClient(s.startMockDeltaIngester(ingester), 1024*1024, s.App.AppCodec())
+	client.SetPushTimeout(100 * time.Millisecond)
+
+	s.Require().NoError(client.PushData(s.Ctx, 1, pools, ingesttypes.TakerFeeMap{}))
+
+	// The second delta is never acknowledged
+	err = client.PushData(s.Ctx, 2, pools, ingesttypes.TakerFeeMap{})
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+
+	// The client reconnects and streams a snapshot
+	s.Require().NoError(client.PushData(s.Ctx, 3, pools, ingesttypes.TakerFeeMap{}))
+
+	deltas := ingester.popDeltas()
+	s.Require().Len(deltas, 3)
+	s.Require().Equal(uint64(1), deltas[2].Sequence)
+	s.Require().True(deltas[2].IsSnapshot)
+	s.Require().Equal(uint64(3), deltas[2].BlockHeight)
+}
+
+// This test validates that the taker fees of the pairs that no pool trades anymore are streamed as removed.
+func (s *SQSServiceTestSuite) TestGRPCDeltaClientPushData_RemovedTakerFees() {
+	s.Setup()
+
+	balancerPoolID := s.PrepareBalancerPool()
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+
+	newPool := func(denoms ...string) ingesttypes.PoolI {
+		pool := ingesttypes.NewPool(balancerPool, osmomath.ZeroDec(), sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(100))))
+		pool.SQSModel.PoolDenoms = denoms
+		return pool
+	}
+	newTakerFees := func(denoms ...string) ingesttypes.TakerFeeMap {
+		takerFees := ingesttypes.TakerFeeMap{}
+		for _, denomI := range denoms {
+			for _, denomJ := range denoms {
+				if denomI != denomJ {
+					takerFees.SetTakerFee(denomI, denomJ, osmomath.MustNewDecFromStr("0.002"))
+				}
+			}
+		}
+		return takerFees
+	}
+
+	ingester := &mockDeltaIngester{}
+	client := service.NewGRPCDeltaClient(s.startMockDeltaIngester(ingester), 1024*1024, s.App.AppCodec())
+
+	s.Require().NoError(client.PushData(s.Ctx, 1, []ingesttypes.PoolI{newPool("bar", "baz", "foo")}, newTakerFees("bar", "baz", "foo")))
+
+	// The pool stops trading baz
+	s.Require().NoError(client.PushData(s.Ctx, 2, []ingesttypes.PoolI{newPool("bar", "foo")}, newTakerFees("bar", "foo")))
+
+	deltas := ingester.popDeltas()
+	s.Require().Len(deltas, 2)
+	s.Require().Empty(deltas[0].RemovedTakerFeePairs)
+	s.Require().Equal([]string{"bar|baz", "baz|bar", "baz|foo", "foo|baz"}, deltas[1].RemovedTakerFeePairs)
+	s.Require().Empty(deltas[1].TakerFeesMap)
+
+	// The removed pairs are not streamed again
+	s.Require().NoError(client.PushData(s.Ctx, 3, []ingesttypes.PoolI{newPool("bar", "foo")}, newTakerFees("bar", "foo")))
+	deltas = ingester.popDeltas()
+	s.Require().Len(deltas, 1)
+	s.Require().Empty(deltas[0].RemovedTakerFeePairs)
+}
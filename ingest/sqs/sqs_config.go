@@ -16,6 +16,9 @@ type Config struct {
 	GRPCIngestAddress []string `mapstructure:"grpc-ingest-address"`
 	// GRPCIngestMaxCallSizeBytes defines the maximum size of a gRPC ingest call in bytes.
 	GRPCIngestMaxCallSizeBytes int `mapstructure:"grpc-ingest-max-call-size-bytes"`
+	// GRPCIngestDeltaStreaming defines if only the changes of every block are streamed to the sidecar query server,
+	// instead of pushing the changed pools in full.
+	GRPCIngestDeltaStreaming bool `mapstructure:"grpc-ingest-delta-streaming"`
 }
 
 const (
@@ -36,6 +39,7 @@ var DefaultConfig = Config{
 	// During normal operation, we should not approach even 1 MB since we are to stream only
 	// modified pools.
 	GRPCIngestMaxCallSizeBytes: 50 * 1024 * 1024,
+	GRPCIngestDeltaStreaming:   false,
 }
 
 // NewConfigFromOptions returns a new sidecar query server config from the given options.
@@ -52,9 +56,12 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 
 	grpcIngestMaxCallSizeBytes := osmoutils.ParseInt(opts, groupOptName, "grpc-ingest-max-call-size-bytes")
 
+	grpcIngestDeltaStreaming := osmoutils.ParseBool(opts, groupOptName, "grpc-ingest-delta-streaming", false)
+
 	return Config{
 		IsEnabled:                  isEnabled,
 		GRPCIngestAddress:          grpcIngestAddress,
 		GRPCIngestMaxCallSizeBytes: grpcIngestMaxCallSizeBytes,
+		GRPCIngestDeltaStreaming:   grpcIngestDeltaStreaming,
 	}
 }
@@ -195,10 +195,339 @@ func (m *ProcessBlockReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProcessBlockReply proto.InternalMessageInfo
 
+// TickModelDelta represents the changes to the tick model of a concentrated
+// liquidity pool.
+type TickModelDelta struct {
+	// upserted_ticks is the JSON array of the liquidity depth ranges that were
+	// added or changed, keyed by their lower tick.
+	UpsertedTicks []byte `protobuf:"bytes,1,opt,name=upserted_ticks,json=upsertedTicks,proto3" json:"upserted_ticks,omitempty"`
+	// removed_lower_ticks are the lower ticks of the liquidity depth ranges
+	// that were removed.
+	RemovedLowerTicks []int64 `protobuf:"varint,2,rep,packed,name=removed_lower_ticks,json=removedLowerTicks,proto3" json:"removed_lower_ticks,omitempty"`
+	// current_tick_index is the current tick index of the tick model.
+	CurrentTickIndex int64 `protobuf:"varint,3,opt,name=current_tick_index,json=currentTickIndex,proto3" json:"current_tick_index,omitempty"`
+	// has_no_liquidity is true if the pool has no liquidity.
+	HasNoLiquidity bool `protobuf:"varint,4,opt,name=has_no_liquidity,json=hasNoLiquidity,proto3" json:"has_no_liquidity,omitempty"`
+}
+
+func (m *TickModelDelta) Reset()         { *m = TickModelDelta{} }
+func (m *TickModelDelta) String() string { return proto.CompactTextString(m) }
+func (*TickModelDelta) ProtoMessage()    {}
+func (*TickModelDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fc800754937f999, []int{3}
+}
+func (m *TickModelDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickModelDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickModelDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickModelDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickModelDelta.Merge(m, src)
+}
+func (m *TickModelDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickModelDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickModelDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickModelDelta proto.InternalMessageInfo
+
+func (m *TickModelDelta) GetUpsertedTicks() []byte {
+	if m != nil {
+		return m.UpsertedTicks
+	}
+	return nil
+}
+
+func (m *TickModelDelta) GetRemovedLowerTicks() []int64 {
+	if m != nil {
+		return m.RemovedLowerTicks
+	}
+	return nil
+}
+
+func (m *TickModelDelta) GetCurrentTickIndex() int64 {
+	if m != nil {
+		return m.CurrentTickIndex
+	}
+	return 0
+}
+
+func (m *TickModelDelta) GetHasNoLiquidity() bool {
+	if m != nil {
+		return m.HasNoLiquidity
+	}
+	return false
+}
+
+// PoolDelta represents the changes to a pool that was previously streamed.
+// Only the changed fields are set.
+type PoolDelta struct {
+	// pool_id is the ID of the changed pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// chain_model is the chain representation model of the pool. It is set if
+	// it changed.
+	ChainModel []byte `protobuf:"bytes,2,opt,name=chain_model,json=chainModel,proto3" json:"chain_model,omitempty"`
+	// sqs_model is the sidecar query server model of the pool. It is set if any
+	// of its fields other than the balances changed.
+	SqsModel []byte `protobuf:"bytes,3,opt,name=sqs_model,json=sqsModel,proto3" json:"sqs_model,omitempty"`
+	// balances is the JSON representation of the pool balances. It is set if
+	// they changed.
+	Balances []byte `protobuf:"bytes,4,opt,name=balances,proto3" json:"balances,omitempty"`
+	// tick_model_delta is the change to the tick model of a concentrated
+	// liquidity pool. It is nil if the tick model did not change.
+	TickModelDelta *TickModelDelta `protobuf:"bytes,5,opt,name=tick_model_delta,json=tickModelDelta,proto3" json:"tick_model_delta,omitempty"`
+}
+
+func (m *PoolDelta) Reset()         { *m = PoolDelta{} }
+func (m *PoolDelta) String() string { return proto.CompactTextString(m) }
+func (*PoolDelta) ProtoMessage()    {}
+func (*PoolDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fc800754937f999, []int{4}
+}
+func (m *PoolDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDelta.Merge(m, src)
+}
+func (m *PoolDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDelta proto.InternalMessageInfo
+
+func (m *PoolDelta) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDelta) GetChainModel() []byte {
+	if m != nil {
+		return m.ChainModel
+	}
+	return nil
+}
+
+func (m *PoolDelta) GetSqsModel() []byte {
+	if m != nil {
+		return m.SqsModel
+	}
+	return nil
+}
+
+func (m *PoolDelta) GetBalances() []byte {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *PoolDelta) GetTickModelDelta() *TickModelDelta {
+	if m != nil {
+		return m.TickModelDelta
+	}
+	return nil
+}
+
+// BlockDelta is the change to the ingested data caused by a block.
+type BlockDelta struct {
+	// sequence is the sequence number of the delta in the stream. It starts at 1
+	// and is incremented by one for every delta.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// block_height is the height of the block being processed.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// is_snapshot is true if the delta contains the full ingested data, which
+	// replaces the data ingested so far.
+	IsSnapshot bool `protobuf:"varint,3,opt,name=is_snapshot,json=isSnapshot,proto3" json:"is_snapshot,omitempty"`
+	// pools are the pools streamed for the first time, or all pools if the delta
+	// is a snapshot.
+	Pools []*PoolData `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pool_deltas are the changes to the previously streamed pools.
+	PoolDeltas []*PoolDelta `protobuf:"bytes,5,rep,name=pool_deltas,json=poolDeltas,proto3" json:"pool_deltas,omitempty"`
+	// taker_fees_map is the map of the taker fees that were added or changed,
+	// or of all taker fees if the delta is a snapshot.
+	TakerFeesMap []byte `protobuf:"bytes,6,opt,name=taker_fees_map,json=takerFeesMap,proto3" json:"taker_fees_map,omitempty"`
+	// removed_taker_fee_pairs are the denom pairs, formatted as "denom0|denom1"
+	// like the keys of taker_fees_map, whose taker fees were removed because no
+	// pool trades the pair anymore. It is empty if the delta is a snapshot.
+	RemovedTakerFeePairs []string `protobuf:"bytes,7,rep,name=removed_taker_fee_pairs,json=removedTakerFeePairs,proto3" json:"removed_taker_fee_pairs,omitempty"`
+}
+
+func (m *BlockDelta) Reset()         { *m = BlockDelta{} }
+func (m *BlockDelta) String() string { return proto.CompactTextString(m) }
+func (*BlockDelta) ProtoMessage()    {}
+func (*BlockDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fc800754937f999, []int{5}
+}
+func (m *BlockDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDelta.Merge(m, src)
+}
+func (m *BlockDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDelta proto.InternalMessageInfo
+
+func (m *BlockDelta) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BlockDelta) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockDelta) GetIsSnapshot() bool {
+	if m != nil {
+		return m.IsSnapshot
+	}
+	return false
+}
+
+func (m *BlockDelta) GetPools() []*PoolData {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *BlockDelta) GetPoolDeltas() []*PoolDelta {
+	if m != nil {
+		return m.PoolDeltas
+	}
+	return nil
+}
+
+func (m *BlockDelta) GetTakerFeesMap() []byte {
+	if m != nil {
+		return m.TakerFeesMap
+	}
+	return nil
+}
+
+func (m *BlockDelta) GetRemovedTakerFeePairs() []string {
+	if m != nil {
+		return m.RemovedTakerFeePairs
+	}
+	return nil
+}
+
+// BlockDeltaAck acknowledges a block delta.
+type BlockDeltaAck struct {
+	// sequence is the sequence number of the acknowledged delta.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// resync is true if the delta could not be applied, for example because
+	// a previous delta was missed. The next delta must be a snapshot.
+	Resync bool `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+}
+
+func (m *BlockDeltaAck) Reset()         { *m = BlockDeltaAck{} }
+func (m *BlockDeltaAck) String() string { return proto.CompactTextString(m) }
+func (*BlockDeltaAck) ProtoMessage()    {}
+func (*BlockDeltaAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fc800754937f999, []int{6}
+}
+func (m *BlockDeltaAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockDeltaAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockDeltaAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockDeltaAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDeltaAck.Merge(m, src)
+}
+func (m *BlockDeltaAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockDeltaAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDeltaAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDeltaAck proto.InternalMessageInfo
+
+func (m *BlockDeltaAck) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BlockDeltaAck) GetResync() bool {
+	if m != nil {
+		return m.Resync
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PoolData)(nil), "osmosis.ingest.v1beta1.PoolData")
 	proto.RegisterType((*ProcessBlockRequest)(nil), "osmosis.ingest.v1beta1.ProcessBlockRequest")
 	proto.RegisterType((*ProcessBlockReply)(nil), "osmosis.ingest.v1beta1.ProcessBlockReply")
+	proto.RegisterType((*TickModelDelta)(nil), "osmosis.ingest.v1beta1.TickModelDelta")
+	proto.RegisterType((*PoolDelta)(nil), "osmosis.ingest.v1beta1.PoolDelta")
+	proto.RegisterType((*BlockDelta)(nil), "osmosis.ingest.v1beta1.BlockDelta")
+	proto.RegisterType((*BlockDeltaAck)(nil), "osmosis.ingest.v1beta1.BlockDeltaAck")
 }
 
 func init() {
@@ -206,29 +535,51 @@ func init() {
 }
 
 var fileDescriptor_1fc800754937f999 = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x93, 0xbf, 0xbf, 0xd2, 0x4e, 0x82, 0xe0, 0x14, 0xa4, 0x28, 0xc6, 0x1a, 0x5d, 0x54,
-	0xc4, 0x84, 0xb6, 0xe0, 0xca, 0x55, 0x11, 0xd1, 0x45, 0xa1, 0xa6, 0xe0, 0xc2, 0x4d, 0x98, 0xc4,
-	0x6b, 0x12, 0x9a, 0x74, 0xd2, 0xdc, 0x69, 0xa5, 0x6f, 0xe1, 0xca, 0x67, 0x72, 0xd9, 0xa5, 0x4b,
-	0x69, 0x5f, 0x44, 0x3a, 0x9d, 0x82, 0xa2, 0x82, 0xbb, 0x9c, 0xef, 0x9c, 0xdc, 0x3b, 0x87, 0x4b,
-	0x8e, 0x38, 0x66, 0x1c, 0x13, 0x74, 0x93, 0x61, 0x04, 0x28, 0xdc, 0x49, 0x33, 0x00, 0xc1, 0x9a,
-	0x4a, 0x3a, 0x79, 0xc1, 0x05, 0xa7, 0x3b, 0x2a, 0xe4, 0x28, 0xaa, 0x42, 0x76, 0x44, 0xca, 0x3d,
-	0xce, 0xd3, 0x4b, 0x26, 0x18, 0x3d, 0x20, 0x46, 0x18, 0xb3, 0x64, 0xe8, 0x67, 0xfc, 0x01, 0xd2,
-	0x9a, 0x5e, 0xd7, 0x1b, 0xa6, 0x47, 0x24, 0xea, 0x2e, 0x09, 0xdd, 0x23, 0x15, 0x1c, 0xa1, 0xb2,
-	0xff, 0x49, 0xbb, 0x8c, 0x23, 0x5c, 0x99, 0xfb, 0x84, 0x88, 0x24, 0x1c, 0x28, 0xb7, 0x24, 0xdd,
-	0xca, 0x92, 0x48, 0xdb, 0x7e, 0xd1, 0x49, 0xb5, 0x57, 0xf0, 0x10, 0x10, 0x3b, 0x29, 0x0f, 0x07,
-	0x1e, 0x8c, 0xc6, 0x80, 0x82, 0x1e, 0x12, 0x33, 0x58, 0x6a, 0x3f, 0x86, 0x24, 0x8a, 0x85, 0xdc,
-	0xfa, 0xdf, 0x33, 0x24, 0xbb, 0x96, 0x88, 0x1e, 0x93, 0x2d, 0xc1, 0x06, 0x50, 0xf8, 0x8f, 0x00,
-	0xe8, 0x67, 0x2c, 0x57, 0xbb, 0x4d, 0x49, 0xaf, 0x00, 0xb0, 0xcb, 0x72, 0x7a, 0x4e, 0x36, 0x72,
-	0xce, 0x53, 0xac, 0x95, 0xea, 0xa5, 0x86, 0xd1, 0xaa, 0x3b, 0x3f, 0x37, 0x76, 0xd6, 0x75, 0xbd,
-	0x55, 0xdc, 0xae, 0x92, 0xed, 0xaf, 0xef, 0xca, 0xd3, 0x69, 0xeb, 0x89, 0x18, 0xfd, 0xdb, 0xfe,
-	0x8d, 0xfc, 0x13, 0x0a, 0x1a, 0x13, 0xf3, 0x73, 0x86, 0x9e, 0xfe, 0x3a, 0xfc, 0x7b, 0xc3, 0xdd,
-	0x93, 0xbf, 0x85, 0xf3, 0x74, 0x6a, 0x6b, 0x9d, 0xbb, 0xd7, 0xb9, 0xa5, 0xcf, 0xe6, 0x96, 0xfe,
-	0x3e, 0xb7, 0xf4, 0xe7, 0x85, 0xa5, 0xcd, 0x16, 0x96, 0xf6, 0xb6, 0xb0, 0xb4, 0xfb, 0x8b, 0x28,
-	0x11, 0xf1, 0x38, 0x70, 0x42, 0x9e, 0xb9, 0x6a, 0xe0, 0x59, 0xca, 0x02, 0x5c, 0x0b, 0x77, 0xd2,
-	0x5e, 0xdf, 0xdc, 0x15, 0xd3, 0x1c, 0xd0, 0x95, 0x97, 0x5f, 0x7d, 0x07, 0x9b, 0x52, 0xb4, 0x3f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xae, 0x45, 0x50, 0x9c, 0x2d, 0x02, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xe3, 0x36, 0x4d, 0x6e, 0xd2, 0xa8, 0x9d, 0x3e, 0xb5, 0x51, 0x9e, 0x5e, 0x9a, 0xfa,
+	0xbd, 0x3e, 0x19, 0x01, 0x09, 0x6d, 0x05, 0x2b, 0x36, 0x2d, 0x15, 0xa2, 0x52, 0x8b, 0x82, 0x53,
+	0xb1, 0x60, 0x63, 0x4d, 0xec, 0x21, 0xb6, 0xe2, 0x78, 0x1c, 0xdf, 0x49, 0x21, 0xff, 0x82, 0x15,
+	0x7f, 0x87, 0x15, 0x12, 0xcb, 0x2e, 0xd9, 0x20, 0xa1, 0xf6, 0x8f, 0xa0, 0x99, 0x8c, 0x1b, 0xfa,
+	0x09, 0xec, 0x7c, 0xcf, 0x39, 0x63, 0xdf, 0x73, 0xef, 0xf1, 0xc0, 0xbf, 0x1c, 0x87, 0x1c, 0x43,
+	0x6c, 0x87, 0x71, 0x9f, 0xa1, 0x68, 0x9f, 0x6c, 0xf5, 0x98, 0xa0, 0x5b, 0xba, 0x6c, 0x25, 0x29,
+	0x17, 0x9c, 0xac, 0x6a, 0x51, 0x4b, 0xa3, 0x5a, 0x64, 0xf5, 0xa1, 0xd8, 0xe1, 0x3c, 0xda, 0xa7,
+	0x82, 0x92, 0x75, 0x28, 0x7b, 0x01, 0x0d, 0x63, 0x77, 0xc8, 0x7d, 0x16, 0xd5, 0x8c, 0xa6, 0x61,
+	0x57, 0x1c, 0x50, 0xd0, 0x91, 0x44, 0xc8, 0xdf, 0x50, 0xc2, 0x11, 0x6a, 0x3a, 0xaf, 0xe8, 0x22,
+	0x8e, 0x70, 0x4a, 0xfe, 0x03, 0x20, 0x42, 0x6f, 0xa0, 0x59, 0x53, 0xb1, 0x25, 0x89, 0x28, 0xda,
+	0xfa, 0x68, 0xc0, 0x4a, 0x27, 0xe5, 0x1e, 0x43, 0xdc, 0x8b, 0xb8, 0x37, 0x70, 0xd8, 0x68, 0xcc,
+	0x50, 0x90, 0x0d, 0xa8, 0xf4, 0x64, 0xed, 0x06, 0x2c, 0xec, 0x07, 0x42, 0x7d, 0x75, 0xce, 0x29,
+	0x2b, 0xec, 0x85, 0x82, 0xc8, 0x7f, 0x50, 0x15, 0x74, 0xc0, 0x52, 0xf7, 0x2d, 0x63, 0xe8, 0x0e,
+	0x69, 0xa2, 0xbf, 0x5d, 0x51, 0xe8, 0x73, 0xc6, 0xf0, 0x88, 0x26, 0xe4, 0x09, 0xcc, 0x27, 0x9c,
+	0x47, 0x58, 0x33, 0x9b, 0xa6, 0x5d, 0xde, 0x6e, 0xb6, 0x6e, 0x76, 0xdc, 0xca, 0xec, 0x3a, 0x53,
+	0xb9, 0xb5, 0x02, 0xcb, 0x97, 0xfb, 0x4a, 0xa2, 0x89, 0xf5, 0xc9, 0x80, 0xea, 0x71, 0xd6, 0xfb,
+	0x3e, 0x8b, 0x04, 0x25, 0x9b, 0x50, 0x1d, 0x27, 0xc8, 0x52, 0xc1, 0x7c, 0x57, 0xda, 0x42, 0x3d,
+	0xa0, 0xc5, 0x0c, 0x95, 0x7a, 0x24, 0x2d, 0x58, 0x49, 0xd9, 0x90, 0x9f, 0x30, 0xdf, 0x8d, 0xf8,
+	0x3b, 0x96, 0x6a, 0x6d, 0xbe, 0x69, 0xda, 0xa6, 0xb3, 0xac, 0xa9, 0x43, 0xc9, 0x4c, 0xf5, 0x0f,
+	0x80, 0x78, 0xe3, 0x34, 0x65, 0xb1, 0x50, 0x4a, 0x37, 0x8c, 0x7d, 0xf6, 0x5e, 0x8d, 0xcf, 0x74,
+	0x96, 0x34, 0x23, 0x95, 0x07, 0x12, 0x27, 0x36, 0x2c, 0x05, 0x14, 0xdd, 0x98, 0xbb, 0x51, 0x38,
+	0x1a, 0x87, 0x7e, 0x28, 0x26, 0xb5, 0xb9, 0xa6, 0x61, 0x17, 0x9d, 0x6a, 0x40, 0xf1, 0x25, 0x3f,
+	0xcc, 0x50, 0xeb, 0xd4, 0x80, 0x92, 0xb2, 0xaa, 0x9a, 0x5f, 0x83, 0x05, 0xe9, 0xd6, 0x0d, 0x7d,
+	0x3d, 0xe0, 0x82, 0x2c, 0x0f, 0xfc, 0xab, 0x3b, 0xcf, 0xdf, 0xbd, 0x73, 0xf3, 0xca, 0xce, 0xeb,
+	0x50, 0xec, 0xd1, 0x88, 0xc6, 0x1e, 0x43, 0xd5, 0x46, 0xc5, 0xb9, 0xa8, 0x49, 0x07, 0x96, 0x66,
+	0x79, 0x70, 0x7d, 0xd9, 0x46, 0x6d, 0xbe, 0x69, 0xd8, 0xe5, 0xed, 0xff, 0x6f, 0x5b, 0xcd, 0xe5,
+	0x89, 0x3b, 0x55, 0x71, 0xa9, 0xb6, 0x3e, 0xe7, 0x01, 0xd4, 0x8e, 0xa6, 0x9e, 0xea, 0x50, 0x44,
+	0x19, 0xa2, 0xd8, 0x63, 0xda, 0xd4, 0x45, 0x7d, 0x2d, 0x55, 0xf9, 0xeb, 0xa9, 0x5a, 0x87, 0x72,
+	0x88, 0x2e, 0xc6, 0x34, 0xc1, 0x80, 0x0b, 0x65, 0xad, 0xe8, 0x40, 0x88, 0x5d, 0x8d, 0xcc, 0x02,
+	0x35, 0xf7, 0x47, 0x81, 0x22, 0x7b, 0x50, 0x56, 0xb3, 0x56, 0x96, 0xb1, 0x36, 0xaf, 0x4e, 0x6f,
+	0xdc, 0x79, 0x5a, 0xd9, 0x85, 0x24, 0x7b, 0xc4, 0x1b, 0x22, 0x5f, 0xb8, 0x21, 0xf2, 0x8f, 0x61,
+	0x2d, 0xcb, 0xda, 0x85, 0xda, 0x4d, 0x68, 0x98, 0x62, 0x6d, 0xa1, 0x69, 0xda, 0x25, 0xe7, 0x2f,
+	0x4d, 0x1f, 0xeb, 0x53, 0x1d, 0xc9, 0x59, 0xcf, 0x60, 0x71, 0x36, 0xc6, 0x5d, 0x6f, 0x70, 0xe7,
+	0x24, 0x57, 0xa1, 0x90, 0x32, 0x9c, 0xc4, 0x9e, 0x9a, 0x61, 0xd1, 0xd1, 0xd5, 0xf6, 0x37, 0x03,
+	0xca, 0xdd, 0x57, 0xdd, 0x03, 0xe5, 0x86, 0xa5, 0x24, 0x80, 0xca, 0xcf, 0xbf, 0x11, 0xb9, 0x7f,
+	0xab, 0xe1, 0xeb, 0x97, 0x40, 0xfd, 0xde, 0xef, 0x89, 0xe5, 0x9f, 0x99, 0x23, 0x3e, 0x2c, 0x77,
+	0x45, 0xca, 0xe8, 0x70, 0x66, 0x02, 0x89, 0x75, 0xdb, 0x1b, 0x66, 0xa2, 0xfa, 0xe6, 0xaf, 0x35,
+	0xbb, 0xde, 0xc0, 0xca, 0xd9, 0xc6, 0x23, 0x63, 0xef, 0xf5, 0x97, 0xb3, 0x86, 0x71, 0x7a, 0xd6,
+	0x30, 0xbe, 0x9f, 0x35, 0x8c, 0x0f, 0xe7, 0x8d, 0xdc, 0xe9, 0x79, 0x23, 0xf7, 0xf5, 0xbc, 0x91,
+	0x7b, 0xf3, 0xb4, 0x1f, 0x8a, 0x60, 0xdc, 0x6b, 0x79, 0x7c, 0xd8, 0xd6, 0x2f, 0x7c, 0x18, 0xd1,
+	0x1e, 0x66, 0x45, 0xfb, 0x64, 0x27, 0xbb, 0x7c, 0xdb, 0x62, 0x92, 0x30, 0x6c, 0xab, 0x2b, 0x78,
+	0xfa, 0xdc, 0x2b, 0xa8, 0x62, 0xe7, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0x1b, 0x76, 0x21,
+	0xb6, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +596,9 @@ const _ = grpc.SupportPackageIsVersion4
 type SQSIngesterClient interface {
 	// ProcessBlock processes a block from the Osmosis node.
 	ProcessBlock(ctx context.Context, in *ProcessBlockRequest, opts ...grpc.CallOption) (*ProcessBlockReply, error)
+	// StreamBlockDeltas streams the changes of every block from the Osmosis
+	// node. Each block delta is acknowledged before the next one is sent.
+	StreamBlockDeltas(ctx context.Context, opts ...grpc.CallOption) (SQSIngester_StreamBlockDeltasClient, error)
 }
 
 type sQSIngesterClient struct {
@@ -264,10 +618,44 @@ func (c *sQSIngesterClient) ProcessBlock(ctx context.Context, in *ProcessBlockRe
 	return out, nil
 }
 
+func (c *sQSIngesterClient) StreamBlockDeltas(ctx context.Context, opts ...grpc.CallOption) (SQSIngester_StreamBlockDeltasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SQSIngester_serviceDesc.Streams[0], "/osmosis.ingest.v1beta1.SQSIngester/StreamBlockDeltas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sQSIngesterStreamBlockDeltasClient{stream}
+	return x, nil
+}
+
+type SQSIngester_StreamBlockDeltasClient interface {
+	Send(*BlockDelta) error
+	Recv() (*BlockDeltaAck, error)
+	grpc.ClientStream
+}
+
+type sQSIngesterStreamBlockDeltasClient struct {
+	grpc.ClientStream
+}
+
+func (x *sQSIngesterStreamBlockDeltasClient) Send(m *BlockDelta) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sQSIngesterStreamBlockDeltasClient) Recv() (*BlockDeltaAck, error) {
+	m := new(BlockDeltaAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SQSIngesterServer is the server API for SQSIngester service.
 type SQSIngesterServer interface {
 	// ProcessBlock processes a block from the Osmosis node.
 	ProcessBlock(context.Context, *ProcessBlockRequest) (*ProcessBlockReply, error)
+	// StreamBlockDeltas streams the changes of every block from the Osmosis
+	// node. Each block delta is acknowledged before the next one is sent.
+	StreamBlockDeltas(SQSIngester_StreamBlockDeltasServer) error
 }
 
 // UnimplementedSQSIngesterServer can be embedded to have forward compatible implementations.
@@ -277,6 +665,9 @@ type UnimplementedSQSIngesterServer struct {
 func (*UnimplementedSQSIngesterServer) ProcessBlock(ctx context.Context, req *ProcessBlockRequest) (*ProcessBlockReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessBlock not implemented")
 }
+func (*UnimplementedSQSIngesterServer) StreamBlockDeltas(srv SQSIngester_StreamBlockDeltasServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockDeltas not implemented")
+}
 
 func RegisterSQSIngesterServer(s grpc1.Server, srv SQSIngesterServer) {
 	s.RegisterService(&_SQSIngester_serviceDesc, srv)
@@ -300,6 +691,33 @@ func _SQSIngester_ProcessBlock_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQSIngester_StreamBlockDeltas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SQSIngesterServer).StreamBlockDeltas(&sQSIngesterStreamBlockDeltasServer{stream})
+}
+
+type SQSIngester_StreamBlockDeltasServer interface {
+	Send(*BlockDeltaAck) error
+	Recv() (*BlockDelta, error)
+	grpc.ServerStream
+}
+
+type sQSIngesterStreamBlockDeltasServer struct {
+	grpc.ServerStream
+}
+
+func (x *sQSIngesterStreamBlockDeltasServer) Send(m *BlockDeltaAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sQSIngesterStreamBlockDeltasServer) Recv() (*BlockDelta, error) {
+	m := new(BlockDelta)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var SQSIngester_serviceDesc = _SQSIngester_serviceDesc
 var _SQSIngester_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ingest.v1beta1.SQSIngester",
 	HandlerType: (*SQSIngesterServer)(nil),
@@ -309,7 +727,14 @@ var _SQSIngester_serviceDesc = grpc.ServiceDesc{
 			Handler:    _SQSIngester_ProcessBlock_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlockDeltas",
+			Handler:       _SQSIngester_StreamBlockDeltas_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "osmosis/ingest/v1beta1/ingest.proto",
 }
 
@@ -429,39 +854,289 @@ func (m *ProcessBlockReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIngest(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIngest(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TickModelDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TickModelDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickModelDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainModel)
-	if l > 0 {
-		n += 1 + l + sovIngest(uint64(l))
+	if m.HasNoLiquidity {
+		i--
+		if m.HasNoLiquidity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.SqsModel)
-	if l > 0 {
-		n += 1 + l + sovIngest(uint64(l))
+	if m.CurrentTickIndex != 0 {
+		i = encodeVarintIngest(dAtA, i, uint64(m.CurrentTickIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TickModel)
-	if l > 0 {
-		n += 1 + l + sovIngest(uint64(l))
+	if len(m.RemovedLowerTicks) > 0 {
+		dAtA2 := make([]byte, len(m.RemovedLowerTicks)*10)
+		var j1 int
+		for _, num1 := range m.RemovedLowerTicks {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintIngest(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.UpsertedTicks) > 0 {
+		i -= len(m.UpsertedTicks)
+		copy(dAtA[i:], m.UpsertedTicks)
+		i = encodeVarintIngest(dAtA, i, uint64(len(m.UpsertedTicks)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProcessBlockRequest) Size() (n int) {
+func (m *PoolDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickModelDelta != nil {
+		{
+			size, err := m.TickModelDelta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIngest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Balances) > 0 {
+		i -= len(m.Balances)
+		copy(dAtA[i:], m.Balances)
+		i = encodeVarintIngest(dAtA, i, uint64(len(m.Balances)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SqsModel) > 0 {
+		i -= len(m.SqsModel)
+		copy(dAtA[i:], m.SqsModel)
+		i = encodeVarintIngest(dAtA, i, uint64(len(m.SqsModel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainModel) > 0 {
+		i -= len(m.ChainModel)
+		copy(dAtA[i:], m.ChainModel)
+		i = encodeVarintIngest(dAtA, i, uint64(len(m.ChainModel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintIngest(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedTakerFeePairs) > 0 {
+		for iNdEx := len(m.RemovedTakerFeePairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedTakerFeePairs[iNdEx])
+			copy(dAtA[i:], m.RemovedTakerFeePairs[iNdEx])
+			i = encodeVarintIngest(dAtA, i, uint64(len(m.RemovedTakerFeePairs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TakerFeesMap) > 0 {
+		i -= len(m.TakerFeesMap)
+		copy(dAtA[i:], m.TakerFeesMap)
+		i = encodeVarintIngest(dAtA, i, uint64(len(m.TakerFeesMap)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PoolDeltas) > 0 {
+		for iNdEx := len(m.PoolDeltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIngest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIngest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IsSnapshot {
+		i--
+		if m.IsSnapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintIngest(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIngest(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockDeltaAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockDeltaAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockDeltaAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resync {
+		i--
+		if m.Resync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintIngest(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIngest(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIngest(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainModel)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	l = len(m.SqsModel)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	l = len(m.TickModel)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessBlockRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -480,25 +1155,672 @@ func (m *ProcessBlockRequest) Size() (n int) {
 			n += 1 + l + sovIngest(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ProcessBlockReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TickModelDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpsertedTicks)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	if len(m.RemovedLowerTicks) > 0 {
+		l = 0
+		for _, e := range m.RemovedLowerTicks {
+			l += sovIngest(uint64(e))
+		}
+		n += 1 + sovIngest(uint64(l)) + l
+	}
+	if m.CurrentTickIndex != 0 {
+		n += 1 + sovIngest(uint64(m.CurrentTickIndex))
+	}
+	if m.HasNoLiquidity {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovIngest(uint64(m.PoolId))
+	}
+	l = len(m.ChainModel)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	l = len(m.SqsModel)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	l = len(m.Balances)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	if m.TickModelDelta != nil {
+		l = m.TickModelDelta.Size()
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovIngest(uint64(m.Sequence))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovIngest(uint64(m.BlockHeight))
+	}
+	if m.IsSnapshot {
+		n += 2
+	}
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovIngest(uint64(l))
+		}
+	}
+	if len(m.PoolDeltas) > 0 {
+		for _, e := range m.PoolDeltas {
+			l = e.Size()
+			n += 1 + l + sovIngest(uint64(l))
+		}
+	}
+	l = len(m.TakerFeesMap)
+	if l > 0 {
+		n += 1 + l + sovIngest(uint64(l))
+	}
+	if len(m.RemovedTakerFeePairs) > 0 {
+		for _, s := range m.RemovedTakerFeePairs {
+			l = len(s)
+			n += 1 + l + sovIngest(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockDeltaAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovIngest(uint64(m.Sequence))
+	}
+	if m.Resync {
+		n += 2
+	}
+	return n
+}
+
+func sovIngest(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIngest(x uint64) (n int) {
+	return sovIngest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIngest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainModel", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainModel = append(m.ChainModel[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainModel == nil {
+				m.ChainModel = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqsModel", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SqsModel = append(m.SqsModel[:0], dAtA[iNdEx:postIndex]...)
+			if m.SqsModel == nil {
+				m.SqsModel = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickModel", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickModel = append(m.TickModel[:0], dAtA[iNdEx:postIndex]...)
+			if m.TickModel == nil {
+				m.TickModel = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIngest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIngest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesMap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesMap = append(m.TakerFeesMap[:0], dAtA[iNdEx:postIndex]...)
+			if m.TakerFeesMap == nil {
+				m.TakerFeesMap = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolData{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIngest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessBlockReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIngest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessBlockReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessBlockReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIngest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickModelDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIngest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickModelDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickModelDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpsertedTicks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpsertedTicks = append(m.UpsertedTicks[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpsertedTicks == nil {
+				m.UpsertedTicks = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIngest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RemovedLowerTicks = append(m.RemovedLowerTicks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIngest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIngest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIngest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RemovedLowerTicks) == 0 {
+					m.RemovedLowerTicks = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIngest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RemovedLowerTicks = append(m.RemovedLowerTicks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedLowerTicks", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTickIndex", wireType)
+			}
+			m.CurrentTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasNoLiquidity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasNoLiquidity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIngest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ProcessBlockReply) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovIngest(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIngest(x uint64) (n int) {
-	return sovIngest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PoolData) Unmarshal(dAtA []byte) error {
+func (m *PoolDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -521,13 +1843,32 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolData: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainModel", wireType)
 			}
@@ -561,7 +1902,7 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				m.ChainModel = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SqsModel", wireType)
 			}
@@ -595,9 +1936,9 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				m.SqsModel = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -624,9 +1965,45 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickModel = append(m.TickModel[:0], dAtA[iNdEx:postIndex]...)
-			if m.TickModel == nil {
-				m.TickModel = []byte{}
+			m.Balances = append(m.Balances[:0], dAtA[iNdEx:postIndex]...)
+			if m.Balances == nil {
+				m.Balances = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickModelDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TickModelDelta == nil {
+				m.TickModelDelta = &TickModelDelta{}
+			}
+			if err := m.TickModelDelta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -650,7 +2027,7 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *BlockDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -673,13 +2050,32 @@ func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -698,7 +2094,95 @@ func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSnapshot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSnapshot = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolData{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDeltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIngest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIngest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDeltas = append(m.PoolDeltas, &PoolDelta{})
+			if err := m.PoolDeltas[len(m.PoolDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesMap", wireType)
 			}
@@ -732,11 +2216,11 @@ func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
 				m.TakerFeesMap = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedTakerFeePairs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIngest
@@ -746,25 +2230,23 @@ func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIngest
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIngest
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &PoolData{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemovedTakerFeePairs = append(m.RemovedTakerFeePairs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -787,7 +2269,7 @@ func (m *ProcessBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessBlockReply) Unmarshal(dAtA []byte) error {
+func (m *BlockDeltaAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -810,12 +2292,51 @@ func (m *ProcessBlockReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessBlockReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockDeltaAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessBlockReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockDeltaAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIngest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIngest(dAtA[iNdEx:])
@@ -0,0 +1,219 @@
+package ibc_hooks_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	ibchooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
+	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	ibchookstypes "github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// setupNativeSwap creates the token0/stake and token1/stake pools on chain A and sends token0 to chain B,
+// so that chain B can send it back with an osmosis_swap memo. It returns the ibc denom of token0 on chain B.
+func (suite *HooksTestSuite) setupNativeSwap() string {
+	suite.fundAccount(suite.chainA, suite.chainA.SenderAccount.GetAddress())
+	suite.SetupPools(ChainA, []osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+	transferMsg := NewMsgTransfer(sdk.NewCoin("token0", osmomath.NewInt(2000)), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "channel-0", "")
+	_, _, _, err := suite.FullSend(transferMsg, AtoB)
+	suite.Require().NoError(err)
+
+	return suite.GetIBCDenom(ChainA, ChainB, "token0")
+}
+
+// nativeSwapMemo swaps token0 to token1 through stake and forwards the output over the channel
+func nativeSwapMemo(minOutputAmount, receiver, channel, recoveryAddr, extra string) string {
+	return fmt.Sprintf(`{"osmosis_swap":{"routes":[{"pool_id":1,"token_out_denom":"stake"},{"pool_id":2,"token_out_denom":"token1"}],"min_output_amount":"%s","receiver":"%s","channel":"%s","local_recovery_addr":"%s"%s}}`,
+		minOutputAmount, receiver, channel, recoveryAddr, extra)
+}
+
+func (suite *HooksTestSuite) TestNativeSwapAndForward() {
+	token0IBC := suite.setupNativeSwap()
+	receiver := suite.chainB.SenderAccounts[5].SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+
+	memo := nativeSwapMemo("1", receiver.String(), "channel-0", recoveryAddr.String(), "")
+	transferMsg := NewMsgTransfer(sdk.NewCoin(token0IBC, osmomath.NewInt(1000)), suite.chainB.SenderAccount.GetAddress().String(), recoveryAddr.String(), "channel-0", memo)
+	_, receiveResult, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+
+	var swapAck ibchookstypes.SwapAck
+	err = json.Unmarshal(suite.ackResult(ack), &swapAck)
+	suite.Require().NoError(err)
+	suite.Require().Equal("token1", swapAck.TokenOut.Denom)
+	suite.Require().True(swapAck.TokenOut.Amount.IsPositive())
+
+	// Relay the forward of the swap output to chain B
+	packet, err := ibctesting.ParsePacketFromEvents(receiveResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(swapAck.ForwardSequence, packet.Sequence)
+	_, forwardAck := suite.RelayPacket(packet, AtoB)
+	suite.Require().Contains(string(forwardAck), "result")
+
+	osmosisAppB := suite.chainB.GetOsmosisApp()
+	balance := osmosisAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, suite.GetIBCDenom(ChainA, ChainB, "token1"))
+	suite.Require().Equal(swapAck.TokenOut.Amount, balance.Amount)
+
+	// The recovery is removed once the forward has been acknowledged
+	_, found := suite.chainA.GetOsmosisApp().IBCHooksKeeper.GetSwapRecovery(suite.chainA.GetContext(), "channel-0", packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *HooksTestSuite) TestNativeSwapToLocalReceiver() {
+	token0IBC := suite.setupNativeSwap()
+	receiver := suite.chainA.SenderAccounts[5].SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+
+	memo := nativeSwapMemo("1", receiver.String(), "", recoveryAddr.String(), "")
+	transferMsg := NewMsgTransfer(sdk.NewCoin(token0IBC, osmomath.NewInt(1000)), suite.chainB.SenderAccount.GetAddress().String(), receiver.String(), "channel-0", memo)
+	_, _, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+
+	var swapAck ibchookstypes.SwapAck
+	err = json.Unmarshal(suite.ackResult(ack), &swapAck)
+	suite.Require().NoError(err)
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	balance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, "token1")
+	suite.Require().Equal(swapAck.TokenOut, balance)
+}
+
+// Test that the funds are returned to the sender if the swap fails
+func (suite *HooksTestSuite) TestNativeSwapBadSwap() {
+	token0IBC := suite.setupNativeSwap()
+	sender := suite.chainB.SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+
+	osmosisAppB := suite.chainB.GetOsmosisApp()
+	balanceBefore := osmosisAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, token0IBC)
+
+	// The min output amount is too high, so the swap fails
+	memo := nativeSwapMemo("50000", sender.String(), "channel-0", recoveryAddr.String(), "")
+	transferMsg := NewMsgTransfer(sdk.NewCoin(token0IBC, osmomath.NewInt(1000)), sender.String(), recoveryAddr.String(), "channel-0", memo)
+	_, _, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, fmt.Sprintf("ABCI code: %d", ibchookstypes.ErrSwapError.ABCICode()))
+
+	balanceAfter := osmosisAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), sender, token0IBC)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+}
+
+// Test that the swap output is sent to the recovery address if the forward fails on the receiving chain
+func (suite *HooksTestSuite) TestNativeSwapBadForwardAck() {
+	token0IBC := suite.setupNativeSwap()
+	receiver := suite.chainB.SenderAccounts[5].SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+
+	// The next memo makes the receive fail on chain B
+	memo := nativeSwapMemo("1", receiver.String(), "channel-0", recoveryAddr.String(), `,"next_memo":{"wasm":"bad wasm specifier"}`)
+	transferMsg := NewMsgTransfer(sdk.NewCoin(token0IBC, osmomath.NewInt(1000)), suite.chainB.SenderAccount.GetAddress().String(), recoveryAddr.String(), "channel-0", memo)
+	_, receiveResult, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+	suite.Require().Contains(ack, "result")
+
+	var swapAck ibchookstypes.SwapAck
+	err = json.Unmarshal(suite.ackResult(ack), &swapAck)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(receiveResult.GetEvents())
+	suite.Require().NoError(err)
+	_, forwardAck := suite.RelayPacket(packet, AtoB)
+	suite.Require().Contains(string(forwardAck), "error")
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	balance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recoveryAddr, "token1")
+	suite.Require().Equal(swapAck.TokenOut, balance)
+
+	intermediary, err := ibchookskeeper.DeriveIntermediateSender("channel-0", suite.chainB.SenderAccount.GetAddress().String(), "osmo")
+	suite.Require().NoError(err)
+	intermediaryBalance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(intermediary), "token1")
+	suite.Require().True(intermediaryBalance.IsZero())
+}
+
+// Test that the swap output is sent to the recovery address if the forward times out
+func (suite *HooksTestSuite) TestNativeSwapForwardTimeout() {
+	token0IBC := suite.setupNativeSwap()
+	receiver := suite.chainB.SenderAccounts[5].SenderAccount.GetAddress()
+	recoveryAddr := suite.chainA.SenderAccounts[8].SenderAccount.GetAddress()
+
+	timeout := suite.coordinator.CurrentTime.Add(time.Minute).UnixNano()
+	memo := nativeSwapMemo("1", receiver.String(), "channel-0", recoveryAddr.String(), fmt.Sprintf(`,"timeout_timestamp":%d`, timeout))
+	transferMsg := NewMsgTransfer(sdk.NewCoin(token0IBC, osmomath.NewInt(1000)), suite.chainB.SenderAccount.GetAddress().String(), recoveryAddr.String(), "channel-0", memo)
+	_, receiveResult, ack, err := suite.FullSend(transferMsg, BtoA)
+	suite.Require().NoError(err)
+
+	var swapAck ibchookstypes.SwapAck
+	err = json.Unmarshal(suite.ackResult(ack), &swapAck)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(receiveResult.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(timeout), packet.TimeoutTimestamp)
+
+	// Move chainB forward one block, an hour later
+	suite.chainB.NextBlock()
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	err = suite.pathAB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.pathAB.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	balance := osmosisApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recoveryAddr, "token1")
+	suite.Require().Equal(swapAck.TokenOut, balance)
+}
+
+// ackResult returns the result of a successful acknowledgement
+func (suite *HooksTestSuite) ackResult(ack string) []byte {
+	var result struct {
+		Result []byte `json:"result"`
+	}
+	err := json.Unmarshal([]byte(ack), &result)
+	suite.Require().NoError(err)
+	return result.Result
+}
+
+func (suite *HooksTestSuite) TestValidateAndParseSwapMemo() {
+	addr := suite.chainA.SenderAccount.GetAddress().String()
+	routes := `"routes":[{"pool_id":1,"token_out_denom":"stake"}]`
+
+	testCases := map[string]struct {
+		memo          string
+		isSwapRouted  bool
+		expectedError bool
+	}{
+		"no memo":             {memo: "", isSwapRouted: false},
+		"other key":           {memo: `{"wasm":{}}`, isSwapRouted: false},
+		"not a map":           {memo: `{"osmosis_swap":"swap"}`, isSwapRouted: true, expectedError: true},
+		"with wasm key":       {memo: fmt.Sprintf(`{"wasm":{},"osmosis_swap":{%s,"min_output_amount":"1","receiver":"%s","local_recovery_addr":"%s"}}`, routes, addr, addr), isSwapRouted: true, expectedError: true},
+		"valid local swap":    {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"%s","local_recovery_addr":"%s"}}`, routes, addr, addr), isSwapRouted: true},
+		"valid forward":       {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"cosmos1receiver","channel":"channel-0","next_memo":{"wasm":{}},"local_recovery_addr":"%s"}}`, routes, addr), isSwapRouted: true},
+		"no routes":           {memo: fmt.Sprintf(`{"osmosis_swap":{"min_output_amount":"1","receiver":"%s","local_recovery_addr":"%s"}}`, addr, addr), isSwapRouted: true, expectedError: true},
+		"no min output":       {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"receiver":"%s","local_recovery_addr":"%s"}}`, routes, addr, addr), isSwapRouted: true, expectedError: true},
+		"no recovery addr":    {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"%s"}}`, routes, addr), isSwapRouted: true, expectedError: true},
+		"bad local receiver":  {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"cosmos1receiver","local_recovery_addr":"%s"}}`, routes, addr), isSwapRouted: true, expectedError: true},
+		"unknown field":       {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"%s","local_recovery_addr":"%s","slippage":{}}}`, routes, addr, addr), isSwapRouted: true, expectedError: true},
+		"next memo not a map": {memo: fmt.Sprintf(`{"osmosis_swap":{%s,"min_output_amount":"1","receiver":"cosmos1receiver","channel":"channel-0","next_memo":"memo","local_recovery_addr":"%s"}}`, routes, addr), isSwapRouted: true, expectedError: true},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			isSwapRouted, _, err := ibchooks.ValidateAndParseSwapMemo(tc.memo)
+			suite.Require().Equal(tc.isSwapRouted, isSwapRouted)
+			if tc.expectedError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
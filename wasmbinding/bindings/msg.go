@@ -1,6 +1,10 @@
 package bindings
 
-import "github.com/osmosis-labs/osmosis/osmomath"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
 
 type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
@@ -15,6 +19,20 @@ type OsmosisMsg struct {
 	/// that they are the admin of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	/// Swap over one or more pools.
+	Swap *SwapMsg `json:"swap,omitempty"`
+	/// Swap an exact amount in, split over several routes.
+	SplitRouteSwapExactIn *SplitRouteSwapExactIn `json:"split_route_swap_exact_in,omitempty"`
+	/// Swap for an exact amount out, split over several routes.
+	SplitRouteSwapExactOut *SplitRouteSwapExactOut `json:"split_route_swap_exact_out,omitempty"`
+	/// Create a concentrated liquidity position.
+	CreatePosition *CreatePosition `json:"create_position,omitempty"`
+	/// Withdraw liquidity from a concentrated liquidity position owned by the contract.
+	WithdrawPosition *WithdrawPosition `json:"withdraw_position,omitempty"`
+	/// Lock tokens for a duration.
+	LockTokens *LockTokens `json:"lock_tokens,omitempty"`
+	/// Begin unlocking a lock owned by the contract.
+	BeginUnlocking *BeginUnlocking `json:"begin_unlocking,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -46,3 +64,96 @@ type BurnTokens struct {
 	// BurnFromAddress must be set to "" for now.
 	BurnFromAddress string `json:"burn_from_address"`
 }
+
+// SwapMsg swaps through the first pool, then through each step of the route.
+// The response data is a SwapResponse.
+type SwapMsg struct {
+	First  Swap                `json:"first"`
+	Route  []Step              `json:"route"`
+	Amount SwapAmountWithLimit `json:"amount"`
+}
+
+// SwapResponse is the response data of the swap messages.
+// Out is set for the exact in swaps, In for the exact out swaps.
+type SwapResponse struct {
+	Amount SwapAmount `json:"amount"`
+}
+
+// SplitRoute is a route of a split route swap, swapping the given amount
+// of input for the exact in swaps, or of output for the exact out swaps.
+type SplitRoute struct {
+	Route  []Step       `json:"route"`
+	Amount osmomath.Int `json:"amount"`
+}
+
+// SplitRouteSwapExactIn swaps the amounts of denom in of each route,
+// for at least min output of the last denom out of the routes.
+// The response data is a SwapResponse.
+type SplitRouteSwapExactIn struct {
+	DenomIn   string       `json:"denom_in"`
+	Routes    []SplitRoute `json:"routes"`
+	MinOutput osmomath.Int `json:"min_output"`
+}
+
+// SplitRouteSwapExactOut swaps at most max input of denom in,
+// for the amounts of the last denom out of each route.
+// The response data is a SwapResponse.
+type SplitRouteSwapExactOut struct {
+	DenomIn  string       `json:"denom_in"`
+	Routes   []SplitRoute `json:"routes"`
+	MaxInput osmomath.Int `json:"max_input"`
+}
+
+// CreatePosition creates a concentrated liquidity position between the lower and upper ticks.
+// The response data is a CreatePositionResponse.
+type CreatePosition struct {
+	PoolId          uint64       `json:"pool_id"`
+	LowerTick       int64        `json:"lower_tick"`
+	UpperTick       int64        `json:"upper_tick"`
+	TokensProvided  sdk.Coins    `json:"tokens_provided"`
+	TokenMinAmount0 osmomath.Int `json:"token_min_amount0"`
+	TokenMinAmount1 osmomath.Int `json:"token_min_amount1"`
+}
+
+type CreatePositionResponse struct {
+	PositionId       uint64       `json:"position_id"`
+	Amount0          osmomath.Int `json:"amount0"`
+	Amount1          osmomath.Int `json:"amount1"`
+	LiquidityCreated osmomath.Dec `json:"liquidity_created"`
+	LowerTick        int64        `json:"lower_tick"`
+	UpperTick        int64        `json:"upper_tick"`
+}
+
+// WithdrawPosition withdraws the liquidity amount from the position.
+// The response data is a WithdrawPositionResponse.
+type WithdrawPosition struct {
+	PositionId      uint64       `json:"position_id"`
+	LiquidityAmount osmomath.Dec `json:"liquidity_amount"`
+}
+
+type WithdrawPositionResponse struct {
+	Amount0 osmomath.Int `json:"amount0"`
+	Amount1 osmomath.Int `json:"amount1"`
+}
+
+// LockTokens locks the coins for the duration, in seconds.
+// The response data is a LockTokensResponse.
+type LockTokens struct {
+	Duration uint64    `json:"duration"`
+	Coins    sdk.Coins `json:"coins"`
+}
+
+type LockTokensResponse struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// BeginUnlocking begins unlocking the coins of the lock, or all of them if empty.
+// The response data is a BeginUnlockingResponse.
+type BeginUnlocking struct {
+	LockId uint64    `json:"lock_id"`
+	Coins  sdk.Coins `json:"coins"`
+}
+
+type BeginUnlockingResponse struct {
+	UnlockingLockId uint64 `json:"unlocking_lock_id"`
+}
@@ -0,0 +1,40 @@
+package bindings
+
+import "github.com/osmosis-labs/osmosis/osmomath"
+
+// Swap is the first pool of a swap, swapping denom in for denom out.
+type Swap struct {
+	PoolId   uint64 `json:"pool_id"`
+	DenomIn  string `json:"denom_in"`
+	DenomOut string `json:"denom_out"`
+}
+
+// Step is a pool of a swap route, swapping the denom out of the previous pool for denom out.
+type Step struct {
+	PoolId   uint64 `json:"pool_id"`
+	DenomOut string `json:"denom_out"`
+}
+
+// SwapAmount is the amount in or the amount out of a swap.
+type SwapAmount struct {
+	In  *osmomath.Int `json:"in,omitempty"`
+	Out *osmomath.Int `json:"out,omitempty"`
+}
+
+// SwapAmountWithLimit is the exact amount of a swap, with the limit of the other side.
+type SwapAmountWithLimit struct {
+	ExactIn  *ExactIn  `json:"exact_in,omitempty"`
+	ExactOut *ExactOut `json:"exact_out,omitempty"`
+}
+
+// ExactIn swaps the exact input for at least min output.
+type ExactIn struct {
+	Input     osmomath.Int `json:"input"`
+	MinOutput osmomath.Int `json:"min_output"`
+}
+
+// ExactOut swaps at most max input for the exact output.
+type ExactOut struct {
+	MaxInput osmomath.Int `json:"max_input"`
+	Output   osmomath.Int `json:"output"`
+}
@@ -8,6 +8,18 @@ type OsmosisQuery struct {
 	FullDenom *FullDenom `json:"full_denom,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the spot price of the swap denoms in the pool.
+	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
+	/// Estimates the result of a swap.
+	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
+	/// Returns the arithmetic TWAP of a pool between the start and end times.
+	ArithmeticTwap *ArithmeticTwap `json:"arithmetic_twap,omitempty"`
+	/// Returns the arithmetic TWAP of a pool from the start time until the current block time.
+	ArithmeticTwapToNow *ArithmeticTwapToNow `json:"arithmetic_twap_to_now,omitempty"`
+	/// Returns the geometric TWAP of a pool between the start and end times.
+	GeometricTwap *GeometricTwap `json:"geometric_twap,omitempty"`
+	/// Returns the geometric TWAP of a pool from the start time until the current block time.
+	GeometricTwapToNow *GeometricTwapToNow `json:"geometric_twap_to_now,omitempty"`
 }
 
 type FullDenom struct {
@@ -26,3 +38,71 @@ type DenomAdminResponse struct {
 type FullDenomResponse struct {
 	Denom string `json:"denom"`
 }
+
+// SpotPrice returns the price of denom out in denom in, in the first pool of the swap.
+// If with swap fee is set, the price is reduced by the spread factor of the pool.
+type SpotPrice struct {
+	Swap        Swap `json:"swap"`
+	WithSwapFee bool `json:"with_swap_fee"`
+}
+
+type SpotPriceResponse struct {
+	/// How many output we would get for 1 input
+	Price string `json:"price"`
+}
+
+// EstimateSwap estimates the swap of the amount through the first pool, then through each step of the route.
+// The amount in is set for exact in swaps, the amount out for exact out swaps. Taker fees are applied.
+type EstimateSwap struct {
+	Sender string     `json:"sender"`
+	First  Swap       `json:"first"`
+	Route  []Step     `json:"route"`
+	Amount SwapAmount `json:"amount"`
+}
+
+// EstimatePriceResponse returns the estimated amount out for exact in swaps, or amount in for exact out swaps.
+type EstimatePriceResponse struct {
+	Amount SwapAmount `json:"amount"`
+}
+
+// ArithmeticTwap is the arithmetic TWAP of the base asset in the quote asset of a pool.
+// Start and end times are unix timestamps in milliseconds.
+type ArithmeticTwap struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	StartTime       int64  `json:"start_time"`
+	EndTime         int64  `json:"end_time"`
+}
+
+type ArithmeticTwapToNow struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	StartTime       int64  `json:"start_time"`
+}
+
+type ArithmeticTwapResponse struct {
+	Twap string `json:"twap"`
+}
+
+// GeometricTwap is the geometric TWAP of the base asset in the quote asset of a pool.
+// Start and end times are unix timestamps in milliseconds.
+type GeometricTwap struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	StartTime       int64  `json:"start_time"`
+	EndTime         int64  `json:"end_time"`
+}
+
+type GeometricTwapToNow struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	StartTime       int64  `json:"start_time"`
+}
+
+type GeometricTwapResponse struct {
+	Twap string `json:"twap"`
+}
@@ -0,0 +1,18 @@
+package wasmbinding
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// GasCostPerRouteHop is charged for every pool a swap or swap estimate goes through,
+	// on top of the gas consumed by the pools themselves.
+	GasCostPerRouteHop = 10_000
+	// GasCostTwapQuery is charged for every TWAP query, which reads up to two historical records per denom pair.
+	GasCostTwapQuery = 20_000
+	// GasCostSpotPriceQuery is charged for every spot price query.
+	GasCostSpotPriceQuery = 10_000
+)
+
+// consumeRouteGas charges the gas of a route going through the given number of pools.
+func consumeRouteGas(ctx sdk.Context, numHops int) {
+	ctx.GasMeter().ConsumeGas(uint64(numHops)*GasCostPerRouteHop, "wasm swap route")
+}
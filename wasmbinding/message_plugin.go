@@ -2,6 +2,7 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -12,25 +13,37 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v31/wasmbinding/bindings"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v31/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v31/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, poolManager *poolmanager.Keeper, concentratedLiquidity *concentratedliquidity.Keeper, lockup *lockupkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:      old,
-			bank:         bank,
-			tokenFactory: tokenFactory,
+			wrapped:               old,
+			bank:                  bank,
+			tokenFactory:          tokenFactory,
+			poolManager:           poolManager,
+			concentratedLiquidity: concentratedLiquidity,
+			lockup:                lockup,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped      wasmkeeper.Messenger
-	bank         *bankkeeper.BaseKeeper
-	tokenFactory *tokenfactorykeeper.Keeper
+	wrapped               wasmkeeper.Messenger
+	bank                  *bankkeeper.BaseKeeper
+	tokenFactory          *tokenfactorykeeper.Keeper
+	poolManager           *poolmanager.Keeper
+	concentratedLiquidity *concentratedliquidity.Keeper
+	lockup                *lockupkeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -56,6 +69,27 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.BurnTokens != nil {
 			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
 		}
+		if contractMsg.Swap != nil {
+			return m.swapTokens(ctx, contractAddr, contractMsg.Swap)
+		}
+		if contractMsg.SplitRouteSwapExactIn != nil {
+			return m.splitRouteSwapExactIn(ctx, contractAddr, contractMsg.SplitRouteSwapExactIn)
+		}
+		if contractMsg.SplitRouteSwapExactOut != nil {
+			return m.splitRouteSwapExactOut(ctx, contractAddr, contractMsg.SplitRouteSwapExactOut)
+		}
+		if contractMsg.CreatePosition != nil {
+			return m.createPosition(ctx, contractAddr, contractMsg.CreatePosition)
+		}
+		if contractMsg.WithdrawPosition != nil {
+			return m.withdrawPosition(ctx, contractAddr, contractMsg.WithdrawPosition)
+		}
+		if contractMsg.LockTokens != nil {
+			return m.lockTokens(ctx, contractAddr, contractMsg.LockTokens)
+		}
+		if contractMsg.BeginUnlocking != nil {
+			return m.beginUnlocking(ctx, contractAddr, contractMsg.BeginUnlocking)
+		}
 	}
 
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
@@ -197,6 +231,306 @@ func PerformBurn(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 	return nil
 }
 
+// swapTokens swaps tokens through one or more pools.
+func (m *CustomMessenger) swapTokens(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformSwap(m.poolManager, ctx, contractAddr, swap)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform swap")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformSwap is used with swapTokens to validate the swap message and swap through the pool manager.
+func PerformSwap(p *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SwapMsg) (*bindings.SwapResponse, error) {
+	if swap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "swap null swap"}
+	}
+	consumeRouteGas(ctx, len(swap.Route)+1)
+
+	msgServer := poolmanager.NewMsgServerImpl(p)
+	switch {
+	case swap.Amount.ExactIn != nil:
+		sdkMsg := poolmanagertypes.MsgSwapExactAmountIn{
+			Sender:            contractAddr.String(),
+			Routes:            toSwapAmountInRoutes(swap.First, swap.Route),
+			TokenIn:           sdk.Coin{Denom: swap.First.DenomIn, Amount: swap.Amount.ExactIn.Input},
+			TokenOutMinAmount: swap.Amount.ExactIn.MinOutput,
+		}
+		if err := sdkMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		res, err := msgServer.SwapExactAmountIn(ctx, &sdkMsg)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "swapping exact amount in from message")
+		}
+		return &bindings.SwapResponse{Amount: bindings.SwapAmount{Out: &res.TokenOutAmount}}, nil
+	case swap.Amount.ExactOut != nil:
+		routes, tokenOutDenom := toSwapAmountOutRoutes(swap.First.DenomIn, append([]bindings.Step{{PoolId: swap.First.PoolId, DenomOut: swap.First.DenomOut}}, swap.Route...))
+		sdkMsg := poolmanagertypes.MsgSwapExactAmountOut{
+			Sender:           contractAddr.String(),
+			Routes:           routes,
+			TokenInMaxAmount: swap.Amount.ExactOut.MaxInput,
+			TokenOut:         sdk.Coin{Denom: tokenOutDenom, Amount: swap.Amount.ExactOut.Output},
+		}
+		if err := sdkMsg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		res, err := msgServer.SwapExactAmountOut(ctx, &sdkMsg)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "swapping exact amount out from message")
+		}
+		return &bindings.SwapResponse{Amount: bindings.SwapAmount{In: &res.TokenInAmount}}, nil
+	default:
+		return nil, wasmvmtypes.InvalidRequest{Err: "must support either Swap.ExactIn or Swap.ExactOut"}
+	}
+}
+
+// splitRouteSwapExactIn swaps an exact amount in over several routes.
+func (m *CustomMessenger) splitRouteSwapExactIn(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SplitRouteSwapExactIn) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformSplitRouteSwapExactIn(m.poolManager, ctx, contractAddr, swap)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform split route swap exact in")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformSplitRouteSwapExactIn is used with splitRouteSwapExactIn to validate the swap message and swap through the pool manager.
+func PerformSplitRouteSwapExactIn(p *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SplitRouteSwapExactIn) (*bindings.SwapResponse, error) {
+	if swap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "split route swap exact in null swap"}
+	}
+
+	routes := make([]poolmanagertypes.SwapAmountInSplitRoute, 0, len(swap.Routes))
+	for _, route := range swap.Routes {
+		consumeRouteGas(ctx, len(route.Route))
+		pools := make([]poolmanagertypes.SwapAmountInRoute, 0, len(route.Route))
+		for _, step := range route.Route {
+			pools = append(pools, poolmanagertypes.SwapAmountInRoute{PoolId: step.PoolId, TokenOutDenom: step.DenomOut})
+		}
+		routes = append(routes, poolmanagertypes.SwapAmountInSplitRoute{Pools: pools, TokenInAmount: route.Amount})
+	}
+
+	sdkMsg := poolmanagertypes.MsgSplitRouteSwapExactAmountIn{
+		Sender:            contractAddr.String(),
+		Routes:            routes,
+		TokenInDenom:      swap.DenomIn,
+		TokenOutMinAmount: swap.MinOutput,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := poolmanager.NewMsgServerImpl(p)
+	res, err := msgServer.SplitRouteSwapExactAmountIn(ctx, &sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "split route swapping exact amount in from message")
+	}
+	return &bindings.SwapResponse{Amount: bindings.SwapAmount{Out: &res.TokenOutAmount}}, nil
+}
+
+// splitRouteSwapExactOut swaps for an exact amount out over several routes.
+func (m *CustomMessenger) splitRouteSwapExactOut(ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SplitRouteSwapExactOut) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformSplitRouteSwapExactOut(m.poolManager, ctx, contractAddr, swap)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform split route swap exact out")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformSplitRouteSwapExactOut is used with splitRouteSwapExactOut to validate the swap message and swap through the pool manager.
+func PerformSplitRouteSwapExactOut(p *poolmanager.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, swap *bindings.SplitRouteSwapExactOut) (*bindings.SwapResponse, error) {
+	if swap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "split route swap exact out null swap"}
+	}
+
+	routes := make([]poolmanagertypes.SwapAmountOutSplitRoute, 0, len(swap.Routes))
+	tokenOutDenom := ""
+	for _, route := range swap.Routes {
+		consumeRouteGas(ctx, len(route.Route))
+		var pools []poolmanagertypes.SwapAmountOutRoute
+		pools, tokenOutDenom = toSwapAmountOutRoutes(swap.DenomIn, route.Route)
+		routes = append(routes, poolmanagertypes.SwapAmountOutSplitRoute{Pools: pools, TokenOutAmount: route.Amount})
+	}
+
+	sdkMsg := poolmanagertypes.MsgSplitRouteSwapExactAmountOut{
+		Sender:           contractAddr.String(),
+		Routes:           routes,
+		TokenOutDenom:    tokenOutDenom,
+		TokenInMaxAmount: swap.MaxInput,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := poolmanager.NewMsgServerImpl(p)
+	res, err := msgServer.SplitRouteSwapExactAmountOut(ctx, &sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "split route swapping exact amount out from message")
+	}
+	return &bindings.SwapResponse{Amount: bindings.SwapAmount{In: &res.TokenInAmount}}, nil
+}
+
+// createPosition creates a concentrated liquidity position owned by the contract.
+func (m *CustomMessenger) createPosition(ctx sdk.Context, contractAddr sdk.AccAddress, createPosition *bindings.CreatePosition) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformCreatePosition(m.concentratedLiquidity, ctx, contractAddr, createPosition)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform create position")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformCreatePosition is used with createPosition to validate the create position message and create it through concentrated liquidity.
+func PerformCreatePosition(cl *concentratedliquidity.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createPosition *bindings.CreatePosition) (*bindings.CreatePositionResponse, error) {
+	if createPosition == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "create position null create position"}
+	}
+
+	sdkMsg := cltypes.MsgCreatePosition{
+		PoolId:          createPosition.PoolId,
+		Sender:          contractAddr.String(),
+		LowerTick:       createPosition.LowerTick,
+		UpperTick:       createPosition.UpperTick,
+		TokensProvided:  createPosition.TokensProvided,
+		TokenMinAmount0: createPosition.TokenMinAmount0,
+		TokenMinAmount1: createPosition.TokenMinAmount1,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := concentratedliquidity.NewMsgServerImpl(cl)
+	res, err := msgServer.CreatePosition(ctx, &sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "creating position from message")
+	}
+	return &bindings.CreatePositionResponse{
+		PositionId:       res.PositionId,
+		Amount0:          res.Amount0,
+		Amount1:          res.Amount1,
+		LiquidityCreated: res.LiquidityCreated,
+		LowerTick:        res.LowerTick,
+		UpperTick:        res.UpperTick,
+	}, nil
+}
+
+// withdrawPosition withdraws liquidity from a concentrated liquidity position owned by the contract.
+func (m *CustomMessenger) withdrawPosition(ctx sdk.Context, contractAddr sdk.AccAddress, withdrawPosition *bindings.WithdrawPosition) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformWithdrawPosition(m.concentratedLiquidity, ctx, contractAddr, withdrawPosition)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform withdraw position")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformWithdrawPosition is used with withdrawPosition to validate the withdraw position message and withdraw through concentrated liquidity.
+func PerformWithdrawPosition(cl *concentratedliquidity.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, withdrawPosition *bindings.WithdrawPosition) (*bindings.WithdrawPositionResponse, error) {
+	if withdrawPosition == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "withdraw position null withdraw position"}
+	}
+
+	sdkMsg := cltypes.MsgWithdrawPosition{
+		PositionId:      withdrawPosition.PositionId,
+		Sender:          contractAddr.String(),
+		LiquidityAmount: withdrawPosition.LiquidityAmount,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := concentratedliquidity.NewMsgServerImpl(cl)
+	res, err := msgServer.WithdrawPosition(ctx, &sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "withdrawing position from message")
+	}
+	return &bindings.WithdrawPositionResponse{Amount0: res.Amount0, Amount1: res.Amount1}, nil
+}
+
+// lockTokens locks tokens of the contract.
+func (m *CustomMessenger) lockTokens(ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformLockTokens(m.lockup, ctx, contractAddr, lock)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform lock tokens")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformLockTokens is used with lockTokens to validate the lock message and lock through lockup.
+func PerformLockTokens(l *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) (*bindings.LockTokensResponse, error) {
+	if lock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lock tokens null lock"}
+	}
+
+	sdkMsg := lockuptypes.NewMsgLockTokens(contractAddr, time.Duration(lock.Duration)*time.Second, lock.Coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(l)
+	res, err := msgServer.LockTokens(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "locking tokens from message")
+	}
+	return &bindings.LockTokensResponse{LockId: res.ID}, nil
+}
+
+// beginUnlocking begins unlocking a lock of the contract.
+func (m *CustomMessenger) beginUnlocking(ctx sdk.Context, contractAddr sdk.AccAddress, unlock *bindings.BeginUnlocking) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	res, err := PerformBeginUnlocking(m.lockup, ctx, contractAddr, unlock)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform begin unlocking")
+	}
+	return marshalResponseData(res)
+}
+
+// PerformBeginUnlocking is used with beginUnlocking to validate the unlock message and begin unlocking through lockup.
+func PerformBeginUnlocking(l *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, unlock *bindings.BeginUnlocking) (*bindings.BeginUnlockingResponse, error) {
+	if unlock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "begin unlocking null unlock"}
+	}
+
+	sdkMsg := lockuptypes.NewMsgBeginUnlocking(contractAddr, unlock.LockId, unlock.Coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(l)
+	res, err := msgServer.BeginUnlocking(ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "beginning unlocking from message")
+	}
+	return &bindings.BeginUnlockingResponse{UnlockingLockId: res.UnlockingLockID}, nil
+}
+
+// toSwapAmountInRoutes converts the first pool and the steps of a swap to pool manager routes.
+func toSwapAmountInRoutes(first bindings.Swap, route []bindings.Step) []poolmanagertypes.SwapAmountInRoute {
+	routes := make([]poolmanagertypes.SwapAmountInRoute, 0, len(route)+1)
+	routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: first.PoolId, TokenOutDenom: first.DenomOut})
+	for _, step := range route {
+		routes = append(routes, poolmanagertypes.SwapAmountInRoute{PoolId: step.PoolId, TokenOutDenom: step.DenomOut})
+	}
+	return routes
+}
+
+// toSwapAmountOutRoutes converts the steps of a swap of denom in to pool manager exact out routes,
+// where each pool is given the denom out of the previous one. It returns the routes and the denom out of the last step.
+func toSwapAmountOutRoutes(denomIn string, route []bindings.Step) ([]poolmanagertypes.SwapAmountOutRoute, string) {
+	routes := make([]poolmanagertypes.SwapAmountOutRoute, 0, len(route))
+	for _, step := range route {
+		routes = append(routes, poolmanagertypes.SwapAmountOutRoute{PoolId: step.PoolId, TokenInDenom: denomIn})
+		denomIn = step.DenomOut
+	}
+	return routes, denomIn
+}
+
+// marshalResponseData returns the JSON of the response as the data of a dispatched message.
+func marshalResponseData(res any) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "marshal response data")
+	}
+	return nil, [][]byte{bz}, nil, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -2,21 +2,31 @@ package wasmbinding
 
 import (
 	"fmt"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/wasmbinding/bindings"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v31/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v31/x/twap"
 )
 
 type QueryPlugin struct {
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	poolManagerKeeper  *poolmanager.Keeper
+	twapKeeper         *twap.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(tfk *tokenfactorykeeper.Keeper, pmk *poolmanager.Keeper, tk *twap.Keeper) *QueryPlugin {
 	return &QueryPlugin{
 		tokenFactoryKeeper: tfk,
+		poolManagerKeeper:  pmk,
+		twapKeeper:         tk,
 	}
 }
 
@@ -29,3 +39,122 @@ func (qp QueryPlugin) GetDenomAdmin(ctx sdk.Context, denom string) (*bindings.De
 
 	return &bindings.DenomAdminResponse{Admin: metadata.Admin}, nil
 }
+
+// GetSpotPrice is a query to get the spot price of denom out in denom in, optionally reduced by the spread factor of the pool.
+func (qp QueryPlugin) GetSpotPrice(ctx sdk.Context, spotPrice *bindings.SpotPrice) (*bindings.SpotPriceResponse, error) {
+	if spotPrice == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "spot price null spot price"}
+	}
+	ctx.GasMeter().ConsumeGas(GasCostSpotPriceQuery, "wasm spot price query")
+
+	poolId := spotPrice.Swap.PoolId
+	price, err := qp.poolManagerKeeper.RouteCalculateSpotPrice(ctx, poolId, spotPrice.Swap.DenomIn, spotPrice.Swap.DenomOut)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "calculating spot price")
+	}
+
+	if spotPrice.WithSwapFee {
+		pool, err := qp.poolManagerKeeper.GetPool(ctx, poolId)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "getting pool")
+		}
+		price = price.MulDec(osmomath.OneDec().Sub(pool.GetSpreadFactor(ctx)))
+	}
+
+	return &bindings.SpotPriceResponse{Price: price.Dec().String()}, nil
+}
+
+// EstimateSwap is a query to estimate the amount out of an exact in swap, or the amount in of an exact out swap.
+// The estimation runs in a cache context, so that it never changes the state.
+func (qp QueryPlugin) EstimateSwap(ctx sdk.Context, estimateSwap *bindings.EstimateSwap) (*bindings.EstimatePriceResponse, error) {
+	if estimateSwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "estimate swap null estimate swap"}
+	}
+	if _, err := parseAddress(estimateSwap.Sender); err != nil {
+		return nil, err
+	}
+	consumeRouteGas(ctx, len(estimateSwap.Route)+1)
+
+	cacheCtx, _ := ctx.CacheContext()
+	amount := estimateSwap.Amount
+	switch {
+	case amount.In != nil:
+		routes := toSwapAmountInRoutes(estimateSwap.First, estimateSwap.Route)
+		tokenIn := sdk.Coin{Denom: estimateSwap.First.DenomIn, Amount: *amount.In}
+		tokenOutAmount, err := qp.poolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(cacheCtx, routes, tokenIn)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "estimating exact amount in swap")
+		}
+		return &bindings.EstimatePriceResponse{Amount: bindings.SwapAmount{Out: &tokenOutAmount}}, nil
+	case amount.Out != nil:
+		routes, tokenOutDenom := toSwapAmountOutRoutes(estimateSwap.First.DenomIn, append([]bindings.Step{{PoolId: estimateSwap.First.PoolId, DenomOut: estimateSwap.First.DenomOut}}, estimateSwap.Route...))
+		tokenOut := sdk.Coin{Denom: tokenOutDenom, Amount: *amount.Out}
+		tokenInAmount, err := qp.poolManagerKeeper.MultihopEstimateInGivenExactAmountOut(cacheCtx, routes, tokenOut)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "estimating exact amount out swap")
+		}
+		return &bindings.EstimatePriceResponse{Amount: bindings.SwapAmount{In: &tokenInAmount}}, nil
+	default:
+		return nil, wasmvmtypes.InvalidRequest{Err: "must provide either EstimateSwap.Amount.In or EstimateSwap.Amount.Out"}
+	}
+}
+
+// GetArithmeticTwap is a query to get the arithmetic TWAP of a pool between the start and end times.
+func (qp QueryPlugin) GetArithmeticTwap(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwap) (*bindings.ArithmeticTwapResponse, error) {
+	if arithmeticTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "arithmetic twap null arithmetic twap"}
+	}
+	ctx.GasMeter().ConsumeGas(GasCostTwapQuery, "wasm twap query")
+
+	startTime, endTime := time.UnixMilli(arithmeticTwap.StartTime), time.UnixMilli(arithmeticTwap.EndTime)
+	twap, err := qp.twapKeeper.GetArithmeticTwap(ctx, arithmeticTwap.PoolId, arithmeticTwap.BaseAssetDenom, arithmeticTwap.QuoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "getting arithmetic twap")
+	}
+	return &bindings.ArithmeticTwapResponse{Twap: twap.String()}, nil
+}
+
+// GetArithmeticTwapToNow is a query to get the arithmetic TWAP of a pool from the start time until the current block time.
+func (qp QueryPlugin) GetArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindings.ArithmeticTwapToNow) (*bindings.ArithmeticTwapResponse, error) {
+	if arithmeticTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "arithmetic twap to now null arithmetic twap"}
+	}
+	ctx.GasMeter().ConsumeGas(GasCostTwapQuery, "wasm twap query")
+
+	startTime := time.UnixMilli(arithmeticTwap.StartTime)
+	twap, err := qp.twapKeeper.GetArithmeticTwapToNow(ctx, arithmeticTwap.PoolId, arithmeticTwap.BaseAssetDenom, arithmeticTwap.QuoteAssetDenom, startTime)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "getting arithmetic twap to now")
+	}
+	return &bindings.ArithmeticTwapResponse{Twap: twap.String()}, nil
+}
+
+// GetGeometricTwap is a query to get the geometric TWAP of a pool between the start and end times.
+func (qp QueryPlugin) GetGeometricTwap(ctx sdk.Context, geometricTwap *bindings.GeometricTwap) (*bindings.GeometricTwapResponse, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "geometric twap null geometric twap"}
+	}
+	ctx.GasMeter().ConsumeGas(GasCostTwapQuery, "wasm twap query")
+
+	startTime, endTime := time.UnixMilli(geometricTwap.StartTime), time.UnixMilli(geometricTwap.EndTime)
+	twap, err := qp.twapKeeper.GetGeometricTwap(ctx, geometricTwap.PoolId, geometricTwap.BaseAssetDenom, geometricTwap.QuoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "getting geometric twap")
+	}
+	return &bindings.GeometricTwapResponse{Twap: twap.String()}, nil
+}
+
+// GetGeometricTwapToNow is a query to get the geometric TWAP of a pool from the start time until the current block time.
+func (qp QueryPlugin) GetGeometricTwapToNow(ctx sdk.Context, geometricTwap *bindings.GeometricTwapToNow) (*bindings.GeometricTwapResponse, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "geometric twap to now null geometric twap"}
+	}
+	ctx.GasMeter().ConsumeGas(GasCostTwapQuery, "wasm twap query")
+
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+	twap, err := qp.twapKeeper.GetGeometricTwapToNow(ctx, geometricTwap.PoolId, geometricTwap.BaseAssetDenom, geometricTwap.QuoteAssetDenom, startTime)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "getting geometric twap to now")
+	}
+	return &bindings.GeometricTwapResponse{Twap: twap.String()}, nil
+}
@@ -95,6 +95,84 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.SpotPrice != nil:
+			res, err := qp.GetSpotPrice(ctx, contractQuery.SpotPrice)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal SpotPriceResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.EstimateSwap != nil:
+			res, err := qp.EstimateSwap(ctx, contractQuery.EstimateSwap)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal EstimatePriceResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwap != nil:
+			res, err := qp.GetArithmeticTwap(ctx, contractQuery.ArithmeticTwap)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ArithmeticTwapResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwapToNow != nil:
+			res, err := qp.GetArithmeticTwapToNow(ctx, contractQuery.ArithmeticTwapToNow)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ArithmeticTwapResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwap != nil:
+			res, err := qp.GetGeometricTwap(ctx, contractQuery.GeometricTwap)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal GeometricTwapResponse response: %w", err)
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwapToNow != nil:
+			res, err := qp.GetGeometricTwapToNow(ctx, contractQuery.GeometricTwapToNow)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal GeometricTwapResponse response: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -0,0 +1,452 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/wasmbinding"
+	"github.com/osmosis-labs/osmosis/v31/wasmbinding/bindings"
+	"github.com/osmosis-labs/osmosis/v31/x/gamm/pool-models/balancer"
+)
+
+const (
+	denomA = "uatom"
+	denomB = "uion"
+	denomC = "uosmo"
+)
+
+type PoolBindingsTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	contract sdk.AccAddress
+	// poolAB holds 1:2 of denomA and denomB, poolBC and poolAC 1:1 of their denoms.
+	poolAB uint64
+	poolBC uint64
+	poolAC uint64
+}
+
+func TestPoolBindingsTestSuite(t *testing.T) {
+	suite.Run(t, new(PoolBindingsTestSuite))
+}
+
+func (s *PoolBindingsTestSuite) SetupTest() {
+	s.Setup()
+	s.contract = s.TestAccs[1]
+	s.poolAB = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denomA, 1_000_000_000), sdk.NewInt64Coin(denomB, 2_000_000_000))
+	s.poolBC = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denomB, 1_000_000_000), sdk.NewInt64Coin(denomC, 1_000_000_000))
+	s.poolAC = s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denomA, 1_000_000_000), sdk.NewInt64Coin(denomC, 1_000_000_000))
+	s.FundAcc(s.contract, sdk.NewCoins(sdk.NewInt64Coin(denomA, 10_000_000), sdk.NewInt64Coin(denomB, 10_000_000)))
+}
+
+func intPtr(i int64) *osmomath.Int {
+	v := osmomath.NewInt(i)
+	return &v
+}
+
+func (s *PoolBindingsTestSuite) TestPerformSwap() {
+	specs := map[string]struct {
+		swap          *bindings.SwapMsg
+		expIn, expOut bool
+		expErr        bool
+	}{
+		"exact in, single pool": {
+			swap: &bindings.SwapMsg{
+				First:  bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB},
+				Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1000), MinOutput: osmomath.NewInt(1)}},
+			},
+			expOut: true,
+		},
+		"exact in, multi hop": {
+			swap: &bindings.SwapMsg{
+				First:  bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB},
+				Route:  []bindings.Step{{PoolId: s.poolBC, DenomOut: denomC}},
+				Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1000), MinOutput: osmomath.NewInt(1)}},
+			},
+			expOut: true,
+		},
+		"exact in, min output not reached": {
+			swap: &bindings.SwapMsg{
+				First:  bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB},
+				Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1000), MinOutput: osmomath.NewInt(1_000_000)}},
+			},
+			expErr: true,
+		},
+		"exact out, multi hop": {
+			swap: &bindings.SwapMsg{
+				First:  bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB},
+				Route:  []bindings.Step{{PoolId: s.poolBC, DenomOut: denomC}},
+				Amount: bindings.SwapAmountWithLimit{ExactOut: &bindings.ExactOut{MaxInput: osmomath.NewInt(10_000), Output: osmomath.NewInt(1000)}},
+			},
+			expIn: true,
+		},
+		"no amount": {
+			swap: &bindings.SwapMsg{
+				First: bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB},
+			},
+			expErr: true,
+		},
+		"null swap": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			ctx, _ := s.Ctx.CacheContext()
+			res, err := wasmbinding.PerformSwap(s.App.PoolManagerKeeper, ctx, s.contract, spec.swap)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			balances := s.App.BankKeeper.GetAllBalances(ctx, s.contract)
+			denomOut := spec.swap.First.DenomOut
+			if len(spec.swap.Route) > 0 {
+				denomOut = spec.swap.Route[len(spec.swap.Route)-1].DenomOut
+			}
+			if spec.expOut {
+				s.Require().Nil(res.Amount.In)
+				s.Require().True(res.Amount.Out.IsPositive())
+				s.Require().Equal(int64(10_000_000-1000), balances.AmountOf(denomA).Int64())
+				if denomOut == denomC {
+					s.Require().Equal(*res.Amount.Out, balances.AmountOf(denomC))
+				}
+			}
+			if spec.expIn {
+				s.Require().Nil(res.Amount.Out)
+				s.Require().True(res.Amount.In.IsPositive())
+				s.Require().Equal(osmomath.NewInt(10_000_000).Sub(*res.Amount.In), balances.AmountOf(denomA))
+				s.Require().Equal(int64(1000), balances.AmountOf(denomOut).Int64())
+			}
+		})
+	}
+}
+
+func (s *PoolBindingsTestSuite) TestPerformSplitRouteSwap() {
+	routes := []bindings.SplitRoute{
+		{Route: []bindings.Step{{PoolId: s.poolAB, DenomOut: denomB}, {PoolId: s.poolBC, DenomOut: denomC}}, Amount: osmomath.NewInt(1000)},
+		{Route: []bindings.Step{{PoolId: s.poolAC, DenomOut: denomC}}, Amount: osmomath.NewInt(2000)},
+	}
+
+	s.Run("exact in", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		res, err := wasmbinding.PerformSplitRouteSwapExactIn(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SplitRouteSwapExactIn{
+			DenomIn:   denomA,
+			Routes:    routes,
+			MinOutput: osmomath.NewInt(1),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(*res.Amount.Out, s.App.BankKeeper.GetBalance(ctx, s.contract, denomC).Amount)
+		s.Require().Equal(int64(10_000_000-3000), s.App.BankKeeper.GetBalance(ctx, s.contract, denomA).Amount.Int64())
+	})
+
+	s.Run("exact out", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		res, err := wasmbinding.PerformSplitRouteSwapExactOut(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SplitRouteSwapExactOut{
+			DenomIn:  denomA,
+			Routes:   routes,
+			MaxInput: osmomath.NewInt(100_000),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int64(3000), s.App.BankKeeper.GetBalance(ctx, s.contract, denomC).Amount.Int64())
+		s.Require().Equal(osmomath.NewInt(10_000_000).Sub(*res.Amount.In), s.App.BankKeeper.GetBalance(ctx, s.contract, denomA).Amount)
+	})
+
+	s.Run("exact out, max input exceeded", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		_, err := wasmbinding.PerformSplitRouteSwapExactOut(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SplitRouteSwapExactOut{
+			DenomIn:  denomA,
+			Routes:   routes,
+			MaxInput: osmomath.NewInt(10),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("gas is charged per hop", func() {
+		ctx, _ := s.Ctx.CacheContext()
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := wasmbinding.PerformSplitRouteSwapExactIn(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SplitRouteSwapExactIn{
+			DenomIn:   denomA,
+			Routes:    routes,
+			MinOutput: osmomath.NewInt(1),
+		})
+		s.Require().NoError(err)
+		s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(3*wasmbinding.GasCostPerRouteHop))
+	})
+}
+
+func (s *PoolBindingsTestSuite) TestPerformCreateAndWithdrawPosition() {
+	pool := s.PrepareConcentratedPool()
+	s.FundAcc(s.contract, apptesting.DefaultCoins)
+
+	created, err := wasmbinding.PerformCreatePosition(s.App.ConcentratedLiquidityKeeper, s.Ctx, s.contract, &bindings.CreatePosition{
+		PoolId:          pool.GetId(),
+		LowerTick:       apptesting.DefaultLowerTick,
+		UpperTick:       apptesting.DefaultUpperTick,
+		TokensProvided:  apptesting.DefaultCoins,
+		TokenMinAmount0: osmomath.ZeroInt(),
+		TokenMinAmount1: osmomath.ZeroInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(created.LiquidityCreated.IsPositive())
+	s.Require().Equal(apptesting.DefaultLowerTick, created.LowerTick)
+	s.Require().Equal(apptesting.DefaultUpperTick, created.UpperTick)
+
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, created.PositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.contract.String(), position.Address)
+
+	// Only the owner can withdraw.
+	_, err = wasmbinding.PerformWithdrawPosition(s.App.ConcentratedLiquidityKeeper, s.Ctx, s.TestAccs[2], &bindings.WithdrawPosition{
+		PositionId:      created.PositionId,
+		LiquidityAmount: created.LiquidityCreated,
+	})
+	s.Require().Error(err)
+
+	withdrawn, err := wasmbinding.PerformWithdrawPosition(s.App.ConcentratedLiquidityKeeper, s.Ctx, s.contract, &bindings.WithdrawPosition{
+		PositionId:      created.PositionId,
+		LiquidityAmount: created.LiquidityCreated,
+	})
+	s.Require().NoError(err)
+	// The withdrawn amounts are rounded down.
+	s.Require().True(withdrawn.Amount0.LTE(created.Amount0))
+	s.Require().True(withdrawn.Amount1.LTE(created.Amount1))
+
+	_, err = wasmbinding.PerformCreatePosition(s.App.ConcentratedLiquidityKeeper, s.Ctx, s.contract, nil)
+	s.Require().Error(err)
+}
+
+func (s *PoolBindingsTestSuite) TestPerformLockAndBeginUnlocking() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denomA, 1000))
+
+	locked, err := wasmbinding.PerformLockTokens(s.App.LockupKeeper, s.Ctx, s.contract, &bindings.LockTokens{
+		Duration: 86400,
+		Coins:    coins,
+	})
+	s.Require().NoError(err)
+
+	lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, locked.LockId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.contract.String(), lock.Owner)
+	s.Require().Equal(24*time.Hour, lock.Duration)
+	s.Require().Equal(coins, lock.Coins)
+
+	// Only the owner can unlock.
+	_, err = wasmbinding.PerformBeginUnlocking(s.App.LockupKeeper, s.Ctx, s.TestAccs[2], &bindings.BeginUnlocking{LockId: locked.LockId})
+	s.Require().Error(err)
+
+	unlocking, err := wasmbinding.PerformBeginUnlocking(s.App.LockupKeeper, s.Ctx, s.contract, &bindings.BeginUnlocking{LockId: locked.LockId})
+	s.Require().NoError(err)
+
+	lock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, unlocking.UnlockingLockId)
+	s.Require().NoError(err)
+	s.Require().True(lock.IsUnlocking())
+
+	_, err = wasmbinding.PerformLockTokens(s.App.LockupKeeper, s.Ctx, s.contract, &bindings.LockTokens{Duration: 86400})
+	s.Require().Error(err)
+}
+
+func (s *PoolBindingsTestSuite) TestSpotPrice() {
+	poolWithFee := s.PrepareCustomBalancerPoolFromCoins(
+		sdk.NewCoins(sdk.NewInt64Coin(denomA, 1_000_000), sdk.NewInt64Coin(denomB, 2_000_000)),
+		balancer.PoolParams{SwapFee: osmomath.NewDecWithPrec(1, 2), ExitFee: osmomath.ZeroDec()},
+	)
+	queryPlugin := wasmbinding.NewQueryPlugin(s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.TwapKeeper)
+
+	specs := map[string]struct {
+		spotPrice *bindings.SpotPrice
+		expPrice  string
+		expErr    bool
+	}{
+		"without swap fee": {
+			spotPrice: &bindings.SpotPrice{Swap: bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB}},
+			expPrice:  "0.500000000000000000",
+		},
+		"inverse": {
+			spotPrice: &bindings.SpotPrice{Swap: bindings.Swap{PoolId: s.poolAB, DenomIn: denomB, DenomOut: denomA}},
+			expPrice:  "2.000000000000000000",
+		},
+		"with swap fee": {
+			spotPrice: &bindings.SpotPrice{Swap: bindings.Swap{PoolId: poolWithFee, DenomIn: denomB, DenomOut: denomA}, WithSwapFee: true},
+			expPrice:  "1.980000000000000000",
+		},
+		"denom not in pool": {
+			spotPrice: &bindings.SpotPrice{Swap: bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomC}},
+			expErr:    true,
+		},
+		"null spot price": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			res, err := queryPlugin.GetSpotPrice(s.Ctx, spec.spotPrice)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expPrice, res.Price)
+		})
+	}
+}
+
+func (s *PoolBindingsTestSuite) TestEstimateSwap() {
+	queryPlugin := wasmbinding.NewQueryPlugin(s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.TwapKeeper)
+	first := bindings.Swap{PoolId: s.poolAB, DenomIn: denomA, DenomOut: denomB}
+	route := []bindings.Step{{PoolId: s.poolBC, DenomOut: denomC}}
+
+	s.Run("exact in matches the swap", func() {
+		estimate, err := queryPlugin.EstimateSwap(s.Ctx, &bindings.EstimateSwap{
+			Sender: s.contract.String(),
+			First:  first,
+			Route:  route,
+			Amount: bindings.SwapAmount{In: intPtr(1000)},
+		})
+		s.Require().NoError(err)
+
+		ctx, _ := s.Ctx.CacheContext()
+		res, err := wasmbinding.PerformSwap(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SwapMsg{
+			First:  first,
+			Route:  route,
+			Amount: bindings.SwapAmountWithLimit{ExactIn: &bindings.ExactIn{Input: osmomath.NewInt(1000), MinOutput: osmomath.NewInt(1)}},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(*res.Amount.Out, *estimate.Amount.Out)
+	})
+
+	s.Run("exact out matches the swap", func() {
+		estimate, err := queryPlugin.EstimateSwap(s.Ctx, &bindings.EstimateSwap{
+			Sender: s.contract.String(),
+			First:  first,
+			Route:  route,
+			Amount: bindings.SwapAmount{Out: intPtr(1000)},
+		})
+		s.Require().NoError(err)
+
+		ctx, _ := s.Ctx.CacheContext()
+		res, err := wasmbinding.PerformSwap(s.App.PoolManagerKeeper, ctx, s.contract, &bindings.SwapMsg{
+			First:  first,
+			Route:  route,
+			Amount: bindings.SwapAmountWithLimit{ExactOut: &bindings.ExactOut{MaxInput: osmomath.NewInt(10_000), Output: osmomath.NewInt(1000)}},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(*res.Amount.In, *estimate.Amount.In)
+	})
+
+	s.Run("does not change the pools", func() {
+		liquidityBefore, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, s.poolAB)
+		s.Require().NoError(err)
+
+		_, err = queryPlugin.EstimateSwap(s.Ctx, &bindings.EstimateSwap{
+			Sender: s.contract.String(),
+			First:  first,
+			Amount: bindings.SwapAmount{In: intPtr(1000)},
+		})
+		s.Require().NoError(err)
+
+		liquidityAfter, err := s.App.PoolManagerKeeper.GetTotalPoolLiquidity(s.Ctx, s.poolAB)
+		s.Require().NoError(err)
+		s.Require().Equal(liquidityBefore, liquidityAfter)
+	})
+
+	s.Run("no amount", func() {
+		_, err := queryPlugin.EstimateSwap(s.Ctx, &bindings.EstimateSwap{Sender: s.contract.String(), First: first})
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid sender", func() {
+		_, err := queryPlugin.EstimateSwap(s.Ctx, &bindings.EstimateSwap{Sender: "invalid", First: first, Amount: bindings.SwapAmount{In: intPtr(1000)}})
+		s.Require().Error(err)
+	})
+}
+
+func (s *PoolBindingsTestSuite) TestTwap() {
+	queryPlugin := wasmbinding.NewQueryPlugin(s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.TwapKeeper)
+	// The times are given in milliseconds, start after the pool creation.
+	startTime := s.Ctx.BlockTime().Add(time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	endTime := startTime.Add(30 * time.Minute)
+
+	// The price of denomB in denomA has not changed since the pool was created.
+	expectedTwap := osmomath.NewDecWithPrec(5, 1)
+	requireTwap := func(twap string) {
+		actual, err := osmomath.NewDecFromStr(twap)
+		s.Require().NoError(err)
+		s.Require().True(actual.Sub(expectedTwap).Abs().LTE(osmomath.NewDecWithPrec(1, 9)), "expected %s, got %s", expectedTwap, actual)
+	}
+
+	arithmetic, err := queryPlugin.GetArithmeticTwap(s.Ctx, &bindings.ArithmeticTwap{
+		PoolId: s.poolAB, QuoteAssetDenom: denomA, BaseAssetDenom: denomB, StartTime: startTime.UnixMilli(), EndTime: endTime.UnixMilli(),
+	})
+	s.Require().NoError(err)
+	requireTwap(arithmetic.Twap)
+
+	arithmeticToNow, err := queryPlugin.GetArithmeticTwapToNow(s.Ctx, &bindings.ArithmeticTwapToNow{
+		PoolId: s.poolAB, QuoteAssetDenom: denomA, BaseAssetDenom: denomB, StartTime: startTime.UnixMilli(),
+	})
+	s.Require().NoError(err)
+	requireTwap(arithmeticToNow.Twap)
+
+	geometric, err := queryPlugin.GetGeometricTwap(s.Ctx, &bindings.GeometricTwap{
+		PoolId: s.poolAB, QuoteAssetDenom: denomA, BaseAssetDenom: denomB, StartTime: startTime.UnixMilli(), EndTime: endTime.UnixMilli(),
+	})
+	s.Require().NoError(err)
+	requireTwap(geometric.Twap)
+
+	geometricToNow, err := queryPlugin.GetGeometricTwapToNow(s.Ctx, &bindings.GeometricTwapToNow{
+		PoolId: s.poolAB, QuoteAssetDenom: denomA, BaseAssetDenom: denomB, StartTime: startTime.UnixMilli(),
+	})
+	s.Require().NoError(err)
+	requireTwap(geometricToNow.Twap)
+
+	// The end time cannot be in the future.
+	_, err = queryPlugin.GetArithmeticTwap(s.Ctx, &bindings.ArithmeticTwap{
+		PoolId: s.poolAB, QuoteAssetDenom: denomA, BaseAssetDenom: denomB, StartTime: startTime.UnixMilli(), EndTime: startTime.Add(2 * time.Hour).UnixMilli(),
+	})
+	s.Require().Error(err)
+
+	_, err = queryPlugin.GetGeometricTwapToNow(s.Ctx, nil)
+	s.Require().Error(err)
+}
+
+// TestJSONBindings tests the JSON format of the bindings, as sent by the contracts.
+func (s *PoolBindingsTestSuite) TestJSONBindings() {
+	queryPlugin := wasmbinding.NewQueryPlugin(s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.TwapKeeper)
+	querier := wasmbinding.CustomQuerier(queryPlugin)
+
+	bz, err := querier(s.Ctx, []byte(fmt.Sprintf(`{"spot_price":{"swap":{"pool_id":%d,"denom_in":"%s","denom_out":"%s"},"with_swap_fee":false}}`, s.poolAB, denomA, denomB)))
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"price":"0.500000000000000000"}`, string(bz))
+
+	bz, err = querier(s.Ctx, []byte(fmt.Sprintf(`{"estimate_swap":{"sender":"%s","first":{"pool_id":%d,"denom_in":"%s","denom_out":"%s"},"route":[],"amount":{"in":"1000"}}}`, s.contract, s.poolAB, denomA, denomB)))
+	s.Require().NoError(err)
+	var estimate bindings.EstimatePriceResponse
+	s.Require().NoError(json.Unmarshal(bz, &estimate))
+	s.Require().True(estimate.Amount.Out.IsPositive())
+
+	messenger := wasmbinding.CustomMessageDecorator(s.App.BankKeeper, s.App.TokenFactoryKeeper, s.App.PoolManagerKeeper, s.App.ConcentratedLiquidityKeeper, s.App.LockupKeeper)(nil)
+	msg := fmt.Sprintf(`{"swap":{"first":{"pool_id":%d,"denom_in":"%s","denom_out":"%s"},"route":[],"amount":{"exact_in":{"input":"1000","min_output":"1"}}}}`, s.poolAB, denomA, denomB)
+	_, data, _, err := messenger.DispatchMsg(s.Ctx, s.contract, "", wasmvmtypes.CosmosMsg{Custom: []byte(msg)})
+	s.Require().NoError(err)
+	s.Require().Len(data, 1)
+
+	var swapped bindings.SwapResponse
+	s.Require().NoError(json.Unmarshal(data[0], &swapped))
+	s.Require().Equal(*estimate.Amount.Out, *swapped.Amount.Out)
+
+	msg = fmt.Sprintf(`{"lock_tokens":{"duration":3600,"coins":[{"denom":"%s","amount":"1000"}]}}`, denomA)
+	_, data, _, err = messenger.DispatchMsg(s.Ctx, s.contract, "", wasmvmtypes.CosmosMsg{Custom: []byte(msg)})
+	s.Require().NoError(err)
+	s.Require().Regexp(`^\{"lock_id":[0-9]+\}$`, string(data[0]))
+}
@@ -82,7 +82,7 @@ func TestDenomAdmin(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, tfDenom)
 
-	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper)
+	queryPlugin := wasmbinding.NewQueryPlugin(app.TokenFactoryKeeper, app.PoolManagerKeeper, app.TwapKeeper)
 
 	testCases := []struct {
 		name        string
@@ -7,20 +7,28 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v31/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v31/x/tokenfactory/keeper"
+	"github.com/osmosis-labs/osmosis/v31/x/twap"
 )
 
 func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	poolManager *poolmanager.Keeper,
+	concentratedLiquidity *concentratedliquidity.Keeper,
+	lockup *lockupkeeper.Keeper,
+	twapKeeper *twap.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(tokenFactory, poolManager, twapKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactory),
+		CustomMessageDecorator(bank, tokenFactory, poolManager, concentratedLiquidity, lockup),
 	)
 
 	return []wasmkeeper.Option{
@@ -0,0 +1,425 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+const (
+	// autoCompoundBatchSize is the number of positions auto compounded between checks of the epoch work budget.
+	autoCompoundBatchSize = 10
+	// autoCompoundTwapWindow is the window of the arithmetic TWAP that the swaps of auto compounding are bounded against.
+	autoCompoundTwapWindow = time.Hour
+)
+
+// compoundPriceFunc returns the price of baseDenom in units of quoteDenom in the given pool,
+// which the swaps of compounding are bounded against.
+type compoundPriceFunc func(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (osmomath.Dec, error)
+
+// compoundSpotPrice returns the spot price of baseDenom in units of quoteDenom in the given pool.
+// It bounds the swaps of compounding and shifting run by the owner, who also sets the token min amounts
+// for the block the transaction is in.
+func (k Keeper) compoundSpotPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (osmomath.Dec, error) {
+	spotPrice, err := k.poolmanagerKeeper.RouteCalculateSpotPrice(ctx, poolId, quoteDenom, baseDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	return spotPrice.Dec(), nil
+}
+
+// autoCompoundTwapPrice returns the arithmetic TWAP of baseDenom in units of quoteDenom in the given pool
+// over autoCompoundTwapWindow. It bounds the swaps of auto compounding, which happen in the predictable
+// day epoch block where the spot prices can be moved right before them.
+func (k Keeper) autoCompoundTwapPrice(ctx sdk.Context, poolId uint64, quoteDenom, baseDenom string) (osmomath.Dec, error) {
+	if k.twapKeeper == nil {
+		return osmomath.Dec{}, errors.New("twap keeper is not set")
+	}
+	return k.twapKeeper.GetArithmeticTwapToNow(ctx, poolId, baseDenom, quoteDenom, ctx.BlockTime().Add(-autoCompoundTwapWindow))
+}
+
+// compoundPosition claims the spread rewards and incentives of the given position, swaps them into
+// the position's token ratio and adds them to the position via addToPosition, which replaces the
+// position with a new one.
+//
+// Rewards in denoms other than the pool's tokens are swapped into token1 via the poolmanager smart router.
+// Rewards that cannot be routed are left with the owner and returned as uncompounded.
+// The resulting token0 and token1 amounts are then rebalanced with a swap in the position's pool, so that
+// their ratio matches the ratio of the position's current amounts.
+// Every swap errors if it returns less than the amount implied by the prices returned by price along its
+// route, reduced by maxSlippage. Leftovers that do not fit the position due to rounding or price impact stay
+// with the owner.
+// The amounts added to the position must also be at least tokenMinAmount0 and tokenMinAmount1, which bound
+// the loss to a sandwiched spot price when compounding at the spot prices.
+//
+// Returns the new position id, the amounts held by the new position and the uncompounded rewards.
+// Returns error if
+// - the owner does not own the position
+// - no rewards could be compounded
+// - a rebalancing swap exceeds the max slippage
+// - the amounts added to the position are less than the token min amounts
+// - adding to the position fails, see addToPosition
+func (k Keeper) compoundPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, maxSlippage osmomath.Dec, tokenMinAmount0, tokenMinAmount1 osmomath.Int, price compoundPriceFunc) (uint64, osmomath.Int, osmomath.Int, sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+	if owner.String() != position.Address {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	spreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+	incentives, _, _, err := k.collectIncentives(ctx, owner, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+	rewards := spreadRewards.Add(incentives...)
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+
+	amount0 := rewards.AmountOf(pool.GetToken0())
+	amount1 := rewards.AmountOf(pool.GetToken1())
+	uncompoundedRewards := sdk.NewCoins()
+	for _, reward := range rewards {
+		if reward.Denom == pool.GetToken0() || reward.Denom == pool.GetToken1() {
+			continue
+		}
+
+		// Each reward is swapped in its own cache context, so that a reward that cannot be routed
+		// does not prevent the others from being compounded.
+		var amountOut osmomath.Int
+		err := osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
+			out, err := k.swapRewardToDenom(cacheCtx, owner, reward, pool.GetToken1(), maxSlippage, price)
+			amountOut = out
+			return err
+		})
+		if err != nil {
+			uncompoundedRewards = uncompoundedRewards.Add(reward)
+			continue
+		}
+		amount1 = amount1.Add(amountOut)
+	}
+
+	if amount0.IsZero() && amount1.IsZero() {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, types.NoRewardsToCompoundError{PositionId: positionId}
+	}
+
+	// Reward swaps may have been routed through the position's pool, so the pool is refetched.
+	pool, err = k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+	amount0, amount1, err = k.rebalanceToRangeRatio(ctx, owner, pool, position.LowerTick, position.UpperTick, position.Liquidity, amount0, amount1, maxSlippage, price)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+
+	newPositionId, newAmount0, newAmount1, err := k.addToPosition(ctx, owner, positionId, amount0, amount1, tokenMinAmount0, tokenMinAmount1)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCompoundPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		),
+	})
+
+	return newPositionId, newAmount0, newAmount1, uncompoundedRewards, nil
+}
+
+// swapRewardToDenom swaps the given reward into tokenOutDenom via the poolmanager smart router.
+// Errors if the amount out is less than the amount implied by the prices along the chosen
+// routes, reduced by maxSlippage, or if that amount is zero.
+func (k Keeper) swapRewardToDenom(ctx sdk.Context, owner sdk.AccAddress, reward sdk.Coin, tokenOutDenom string, maxSlippage osmomath.Dec, price compoundPriceFunc) (osmomath.Int, error) {
+	routes, _, err := k.poolmanagerKeeper.FindSmartRoute(ctx, reward, tokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	expectedAmountOut := osmomath.ZeroDec()
+	for _, route := range routes {
+		routeAmountOut := route.TokenInAmount.ToLegacyDec()
+		denomIn := reward.Denom
+		for _, hop := range route.Pools {
+			hopPrice, err := price(ctx, hop.PoolId, hop.TokenOutDenom, denomIn)
+			if err != nil {
+				return osmomath.Int{}, err
+			}
+			routeAmountOut = routeAmountOut.Mul(hopPrice)
+			denomIn = hop.TokenOutDenom
+		}
+		expectedAmountOut = expectedAmountOut.Add(routeAmountOut)
+	}
+	if expectedAmountOut.TruncateInt().IsZero() {
+		return osmomath.Int{}, fmt.Errorf("reward (%s) is worth less than one unit of %s", reward, tokenOutDenom)
+	}
+
+	tokenOutMinAmount := expectedAmountOut.Mul(osmomath.OneDec().Sub(maxSlippage)).TruncateInt()
+	return k.poolmanagerKeeper.SplitRouteExactAmountIn(ctx, owner, routes, reward.Denom, tokenOutMinAmount)
+}
+
+// rebalanceToRangeRatio swaps the excess of either token in the given pool so that amount0 and amount1 are in
+// the same ratio, by value at the price returned by priceFn, as the amounts held by the given liquidity in the
+// given tick range. Ranges above the current price hold only token0 and ranges below it only token1.
+// No swap is performed if the imbalance is worth less than one unit of the other token.
+// Errors if the swap returns less than the amount implied by that price, reduced by maxSlippage.
+// Returns the rebalanced amounts.
+func (k Keeper) rebalanceToRangeRatio(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, liquidity osmomath.Dec, amount0, amount1 osmomath.Int, maxSlippage osmomath.Dec, priceFn compoundPriceFunc) (osmomath.Int, osmomath.Int, error) {
+	price, err := priceFn(ctx, pool.GetId(), pool.GetToken1(), pool.GetToken0())
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if !price.IsPositive() {
+		return amount0, amount1, nil
+	}
+
+	rangeAmount0, rangeAmount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	rangeValue := rangeAmount0.Mul(price).Add(rangeAmount1)
+	if !rangeValue.IsPositive() {
+		return amount0, amount1, nil
+	}
+
+	// All values are denominated in token1.
+	totalValue := amount0.ToLegacyDec().Mul(price).Add(amount1.ToLegacyDec())
+	targetAmount1 := totalValue.Mul(rangeAmount1).Quo(rangeValue)
+
+	var tokenIn sdk.Coin
+	var tokenOutDenom string
+	var expectedAmountOut osmomath.Dec
+	if amount1.ToLegacyDec().GT(targetAmount1) {
+		tokenIn = sdk.NewCoin(pool.GetToken1(), amount1.ToLegacyDec().Sub(targetAmount1).TruncateInt())
+		tokenOutDenom = pool.GetToken0()
+		expectedAmountOut = tokenIn.Amount.ToLegacyDec().Quo(price)
+	} else {
+		tokenIn = sdk.NewCoin(pool.GetToken0(), targetAmount1.Sub(amount1.ToLegacyDec()).Quo(price).TruncateInt())
+		tokenOutDenom = pool.GetToken1()
+		expectedAmountOut = tokenIn.Amount.ToLegacyDec().Mul(price)
+	}
+	if tokenIn.Amount.IsZero() || expectedAmountOut.TruncateInt().IsZero() {
+		return amount0, amount1, nil
+	}
+
+	tokenOutMinAmount := expectedAmountOut.Mul(osmomath.OneDec().Sub(maxSlippage)).TruncateInt()
+	amountOut, _, err := k.poolmanagerKeeper.SwapExactAmountIn(ctx, owner, pool.GetId(), tokenIn, tokenOutDenom, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if tokenIn.Denom == pool.GetToken0() {
+		return amount0.Sub(tokenIn.Amount), amount1.Add(amountOut), nil
+	}
+	return amount0.Add(amountOut), amount1.Sub(tokenIn.Amount), nil
+}
+
+// GetAutoCompoundConfig returns the auto compound config of the given position.
+// Returns false if the position has not opted into auto compounding.
+func (k Keeper) GetAutoCompoundConfig(ctx sdk.Context, positionId uint64) (types.AutoCompoundConfig, bool, error) {
+	config := types.AutoCompoundConfig{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyAutoCompoundConfig(positionId), &config)
+	if err != nil || !found {
+		return types.AutoCompoundConfig{}, false, err
+	}
+	return config, true, nil
+}
+
+// SetPositionAutoCompound opts the given position in or out of being compounded by the module at the end of
+// every day epoch. Returns error if the owner does not own the position, if the position backs a limit order,
+// or if enabling with an invalid max slippage or negative token min amounts.
+func (k Keeper) SetPositionAutoCompound(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, enabled bool, maxSlippage osmomath.Dec, tokenMinAmount0, tokenMinAmount1 osmomath.Int) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if enabled {
+		if err := k.validatePositionDoesNotBackLimitOrder(ctx, positionId); err != nil {
+			return err
+		}
+		config := types.AutoCompoundConfig{
+			PositionId:      positionId,
+			Owner:           owner.String(),
+			MaxSlippage:     maxSlippage,
+			TokenMinAmount0: tokenMinAmount0,
+			TokenMinAmount1: tokenMinAmount1,
+		}
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		k.setAutoCompoundConfig(ctx, config)
+	} else {
+		k.deleteAutoCompoundConfig(ctx, positionId)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetPositionAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAutoCompoundEnabled, strconv.FormatBool(enabled)),
+		),
+	})
+	return nil
+}
+
+// setAutoCompoundConfig stores the given config under its position id.
+func (k Keeper) setAutoCompoundConfig(ctx sdk.Context, config types.AutoCompoundConfig) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyAutoCompoundConfig(config.PositionId), &config)
+}
+
+// deleteAutoCompoundConfig removes the config of the given position, if any.
+func (k Keeper) deleteAutoCompoundConfig(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundConfig(positionId))
+}
+
+// moveAutoCompoundConfig moves the auto compound config of the old position, if any, to the new position.
+func (k Keeper) moveAutoCompoundConfig(ctx sdk.Context, oldPositionId, newPositionId uint64) error {
+	config, found, err := k.GetAutoCompoundConfig(ctx, oldPositionId)
+	if err != nil || !found {
+		return err
+	}
+	k.deleteAutoCompoundConfig(ctx, oldPositionId)
+	config.PositionId = newPositionId
+	k.setAutoCompoundConfig(ctx, config)
+	return nil
+}
+
+// getAllAutoCompoundConfigs returns the auto compound configs of all positions.
+func (k Keeper) getAllAutoCompoundConfigs(ctx sdk.Context) ([]types.AutoCompoundConfig, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.AutoCompoundConfigPrefix, parseAutoCompoundConfigFromBz)
+}
+
+// parseAutoCompoundConfigFromBz parses and returns an auto compound config from a byte array.
+func parseAutoCompoundConfigFromBz(bz []byte) (types.AutoCompoundConfig, error) {
+	config := types.AutoCompoundConfig{}
+	if err := config.Unmarshal(bz); err != nil {
+		return types.AutoCompoundConfig{}, err
+	}
+	return config, nil
+}
+
+// getAutoCompoundConfigsInRange returns the auto compound configs of the positions with ids in
+// [startPositionId, endPositionId), in order of position id, up to limit configs.
+func (k Keeper) getAutoCompoundConfigsInRange(ctx sdk.Context, startPositionId, endPositionId uint64, limit int) ([]types.AutoCompoundConfig, error) {
+	numConfigs := 0
+	stopFn := func([]byte) bool {
+		numConfigs++
+		return numConfigs > limit
+	}
+	return osmoutils.GetIterValuesWithStop(ctx.KVStore(k.storeKey), types.KeyAutoCompoundConfig(startPositionId), types.KeyAutoCompoundConfig(endPositionId), false, stopFn, parseAutoCompoundConfigFromBz)
+}
+
+// getPendingAutoCompound returns the progress of the auto compounding of an ended epoch, if it is not done yet.
+func (k Keeper) getPendingAutoCompound(ctx sdk.Context) (types.PendingAutoCompound, bool, error) {
+	pendingAutoCompound := types.PendingAutoCompound{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPendingAutoCompound, &pendingAutoCompound)
+	return pendingAutoCompound, found, err
+}
+
+// setPendingAutoCompound sets the progress of the auto compounding of an ended epoch.
+func (k Keeper) setPendingAutoCompound(ctx sdk.Context, pendingAutoCompound types.PendingAutoCompound) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPendingAutoCompound, &pendingAutoCompound)
+}
+
+// deletePendingAutoCompound deletes the progress of the auto compounding of an ended epoch.
+func (k Keeper) deletePendingAutoCompound(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPendingAutoCompound)
+}
+
+// startAutoCompound records the auto compounding of the positions that exist at the end of the given epoch.
+// The positions are compounded by continueAutoCompound.
+func (k Keeper) startAutoCompound(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	k.setPendingAutoCompound(ctx, types.PendingAutoCompound{
+		EpochIdentifier: epochIdentifier,
+		EpochNumber:     epochNumber,
+		NextPositionId:  0,
+		EndPositionId:   k.GetNextPositionId(ctx),
+	})
+}
+
+// continueAutoCompound compounds the positions recorded by startAutoCompound for the given epoch that opted
+// into auto compounding, in batches of autoCompoundBatchSize positions, until the budget is exhausted.
+// Positions created since the epoch ended, including the ones replacing compounded positions, are skipped.
+// Returns true once all positions were compounded, or if there is no auto compounding for the epoch.
+func (k Keeper) continueAutoCompound(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochtypes.EpochWorkBudget) (bool, error) {
+	pendingAutoCompound, found, err := k.getPendingAutoCompound(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !found || pendingAutoCompound.EpochIdentifier != epochIdentifier || pendingAutoCompound.EpochNumber != epochNumber {
+		return true, nil
+	}
+
+	for {
+		configs, err := k.getAutoCompoundConfigsInRange(ctx, pendingAutoCompound.NextPositionId, pendingAutoCompound.EndPositionId, autoCompoundBatchSize)
+		if err != nil {
+			return false, err
+		}
+		for _, config := range configs {
+			k.autoCompoundPosition(ctx, config)
+		}
+		if len(configs) < autoCompoundBatchSize {
+			break
+		}
+		pendingAutoCompound.NextPositionId = configs[len(configs)-1].PositionId + 1
+
+		if budget.Exhausted(ctx) {
+			k.setPendingAutoCompound(ctx, pendingAutoCompound)
+			ctx.Logger().Info("x/concentrated-liquidity auto compounding continues in the next block", "module", types.ModuleName, "nextPositionId", pendingAutoCompound.NextPositionId, "height", ctx.BlockHeight())
+			return false, nil
+		}
+	}
+
+	k.deletePendingAutoCompound(ctx)
+	return true, nil
+}
+
+// autoCompoundPosition compounds the position of the given config, with its swaps bounded by the arithmetic TWAPs
+// of their pools rather than their spot prices, see autoCompoundTwapPrice.
+// The config is dropped if the position was withdrawn or transferred since opting in.
+// The position is compounded in its own cache context, so that a failure only skips that position.
+func (k Keeper) autoCompoundPosition(ctx sdk.Context, config types.AutoCompoundConfig) {
+	position, err := k.GetPosition(ctx, config.PositionId)
+	if err != nil || position.Address != config.Owner {
+		k.deleteAutoCompoundConfig(ctx, config.PositionId)
+		return
+	}
+	owner, err := sdk.AccAddressFromBech32(config.Owner)
+	if err != nil {
+		k.deleteAutoCompoundConfig(ctx, config.PositionId)
+		return
+	}
+
+	_ = osmoutils.ApplyFuncIfNoErrorLogToDebug(ctx, func(cacheCtx sdk.Context) error {
+		_, _, _, _, err := k.compoundPosition(cacheCtx, owner, config.PositionId, config.MaxSlippage, config.TokenMinAmount0, config.TokenMinAmount1, k.autoCompoundTwapPrice)
+		return err
+	})
+}
@@ -0,0 +1,376 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var (
+	compoundSpreadFactor    = osmomath.NewDecWithPrec(3, 3)
+	compoundMaxSlippage     = osmomath.NewDecWithPrec(1, 2)
+	compoundSwapAmount      = sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	compoundIncentiveCoin   = sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1_000_000_000_000))
+	compoundIncentiveRate   = osmomath.NewDec(10_000)
+	compoundIncentivePeriod = 1000 * time.Second
+)
+
+// setupCompoundPool creates a pool with a full range position owned by TestAccs[0] and another one owned by
+// TestAccs[1], so that the first position is never the last one in the pool. If withSpreadRewards is true, a
+// swap from USDC to ETH accrues spread rewards in USDC only, which makes a rebalancing swap necessary.
+// Returns the pool and the id of the first position.
+func (s *KeeperTestSuite) setupCompoundPool(withSpreadRewards bool) (types.ConcentratedPoolExtension, uint64) {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, compoundSpreadFactor)
+	positionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[0])
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	if withSpreadRewards {
+		s.FundAcc(s.TestAccs[2], sdk.NewCoins(compoundSwapAmount))
+		_, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, compoundSwapAmount, ETH, osmomath.OneInt(), compoundSpreadFactor)
+		s.Require().NoError(err)
+	}
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool, positionId
+}
+
+// recordCompoundPoolTwap records the current prices of the pools in twap in the next block, then moves the
+// block time past the auto compound TWAP window, so that the TWAPs are the current prices.
+func (s *KeeperTestSuite) recordCompoundPoolTwap() {
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(2 * cl.AutoCompoundTwapWindow))
+}
+
+// setupCompoundIncentives creates an incentive in the bond denom for the given pool and lets it emit.
+func (s *KeeperTestSuite) setupCompoundIncentives(poolId uint64) {
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(compoundIncentiveCoin))
+	_, err := s.App.ConcentratedLiquidityKeeper.CreateIncentive(s.Ctx, poolId, s.TestAccs[2], compoundIncentiveCoin, compoundIncentiveRate, s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+	s.Require().NoError(err)
+	s.AddBlockTime(compoundIncentivePeriod)
+}
+
+func (s *KeeperTestSuite) TestCompoundPosition() {
+	tests := map[string]struct {
+		withSpreadRewards   bool
+		withIncentives      bool
+		withIncentivesRoute bool
+		notOwner            bool
+		maxSlippage         osmomath.Dec
+		tokenMinAmount1     osmomath.Int
+
+		expectedUncompoundedDenoms []string
+		expectedSlippageError      bool
+		expectedMinAmountError     bool
+		expectedError              error
+	}{
+		"spread rewards only": {
+			withSpreadRewards: true,
+		},
+		"spread rewards and incentives routed to usdc": {
+			withSpreadRewards:   true,
+			withIncentives:      true,
+			withIncentivesRoute: true,
+		},
+		"incentives only, routed to usdc": {
+			withIncentives:      true,
+			withIncentivesRoute: true,
+		},
+		"incentives without a route are left with the owner": {
+			withSpreadRewards:          true,
+			withIncentives:             true,
+			expectedUncompoundedDenoms: []string{sdk.DefaultBondDenom},
+		},
+		"error: no rewards": {
+			expectedError: types.NoRewardsToCompoundError{PositionId: 1},
+		},
+		"error: incentives without a route and no spread rewards": {
+			withIncentives: true,
+			expectedError:  types.NoRewardsToCompoundError{PositionId: 1},
+		},
+		"error: sender does not own the position": {
+			withSpreadRewards: true,
+			notOwner:          true,
+		},
+		"error: rebalancing swap exceeds a zero max slippage": {
+			withSpreadRewards:     true,
+			maxSlippage:           osmomath.ZeroDec(),
+			expectedSlippageError: true,
+		},
+		"error: compounded amount is less than the token min amount": {
+			withSpreadRewards:      true,
+			tokenMinAmount1:        compoundSwapAmount.Amount,
+			expectedMinAmountError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool, positionId := s.setupCompoundPool(tc.withSpreadRewards)
+			if tc.withIncentivesRoute {
+				s.PrepareBalancerPoolWithCoins(sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1_000_000_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000_000)))
+			}
+			if tc.withIncentives {
+				s.setupCompoundIncentives(pool.GetId())
+			}
+
+			owner := s.TestAccs[0]
+			sender := owner
+			expectedError := tc.expectedError
+			if tc.notOwner {
+				sender = s.TestAccs[1]
+				expectedError = types.NotPositionOwnerError{PositionId: positionId, Address: sender.String()}
+			}
+			maxSlippage := compoundMaxSlippage
+			if !tc.maxSlippage.IsNil() {
+				maxSlippage = tc.maxSlippage
+			}
+			tokenMinAmount1 := osmomath.ZeroInt()
+			if !tc.tokenMinAmount1.IsNil() {
+				tokenMinAmount1 = tc.tokenMinAmount1
+			}
+
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			newPositionId, amount0, amount1, uncompounded, err := clKeeper.CompoundPosition(s.Ctx, sender, positionId, maxSlippage, osmomath.ZeroInt(), tokenMinAmount1)
+			if tc.expectedSlippageError {
+				s.Require().ErrorAs(err, &types.AmountLessThanMinError{})
+				return
+			}
+			if tc.expectedMinAmountError {
+				s.Require().ErrorAs(err, &types.InsufficientLiquidityCreatedError{})
+				return
+			}
+			if expectedError != nil {
+				s.Require().ErrorContains(err, expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// the position was replaced by one with more liquidity
+			_, err = clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().Error(err)
+			newPosition, err := clKeeper.GetPosition(s.Ctx, newPositionId)
+			s.Require().NoError(err)
+			s.Require().True(newPosition.Liquidity.GT(position.Liquidity))
+			s.Require().Equal(position.LowerTick, newPosition.LowerTick)
+			s.Require().Equal(position.UpperTick, newPosition.UpperTick)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			s.Require().True(amount0.IsPositive())
+			s.Require().True(amount1.IsPositive())
+
+			// unrouted incentives are left with the owner
+			s.Require().Len(uncompounded, len(tc.expectedUncompoundedDenoms))
+			for _, denom := range tc.expectedUncompoundedDenoms {
+				s.Require().True(uncompounded.AmountOf(denom).IsPositive())
+				s.Require().Equal(balancesBefore.AmountOf(denom).Add(uncompounded.AmountOf(denom)), s.App.BankKeeper.GetBalance(s.Ctx, owner, denom).Amount)
+			}
+			if tc.withIncentivesRoute {
+				s.Require().Equal(balancesBefore.AmountOf(sdk.DefaultBondDenom), s.App.BankKeeper.GetBalance(s.Ctx, owner, sdk.DefaultBondDenom).Amount)
+			}
+
+			// the rebalancing leaves at most dust of the pool tokens with the owner
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			spotPrice, err := pool.SpotPrice(s.Ctx, USDC, ETH)
+			s.Require().NoError(err)
+			leftoverValue := s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount.Sub(balancesBefore.AmountOf(ETH)).ToLegacyDec().Mul(spotPrice.Dec()).
+				Add(s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount.Sub(balancesBefore.AmountOf(USDC)).ToLegacyDec())
+			newPositionValue := amount0.ToLegacyDec().Mul(spotPrice.Dec()).Add(amount1.ToLegacyDec())
+			compoundedValue := newPositionValue.Mul(osmomath.OneDec().Sub(position.Liquidity.Quo(newPosition.Liquidity)))
+			s.Require().True(compoundedValue.IsPositive())
+			s.Require().True(leftoverValue.LTE(compoundedValue.Mul(osmomath.NewDecWithPrec(1, 2))), "leftover %s, compounded %s", leftoverValue, compoundedValue)
+			if tc.withSpreadRewards {
+				s.Require().True(spreadRewards.AmountOf(USDC).IsPositive())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetPositionAutoCompound() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	_, positionId := s.setupCompoundPool(false)
+
+	// only the owner can opt in
+	err := clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], positionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().ErrorContains(err, types.NotPositionOwnerError{PositionId: positionId, Address: s.TestAccs[1].String()}.Error())
+
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, true, osmomath.OneDec(), osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().ErrorContains(err, types.InvalidMaxSlippageError{MaxSlippage: osmomath.OneDec()}.Error())
+
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, true, compoundMaxSlippage, osmomath.NewInt(-1), osmomath.ZeroInt())
+	s.Require().ErrorContains(err, "token min amount 0 must not be negative")
+
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, true, compoundMaxSlippage, osmomath.NewInt(10), osmomath.NewInt(20))
+	s.Require().NoError(err)
+	config, found, err := clKeeper.GetAutoCompoundConfig(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.AutoCompoundConfig{
+		PositionId:      positionId,
+		Owner:           s.TestAccs[0].String(),
+		MaxSlippage:     compoundMaxSlippage,
+		TokenMinAmount0: osmomath.NewInt(10),
+		TokenMinAmount1: osmomath.NewInt(20),
+	}, config)
+
+	// the opt-in follows the position when it is added to
+	s.FundAcc(s.TestAccs[0], DefaultCoins)
+	newPositionId, _, _, err := clKeeper.AddToPosition(s.Ctx, s.TestAccs[0], positionId, DefaultAmt0, DefaultAmt1, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	_, found, err = clKeeper.GetAutoCompoundConfig(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	config, found, err = clKeeper.GetAutoCompoundConfig(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(newPositionId, config.PositionId)
+
+	// opting out ignores the max slippage and the token min amounts
+	err = clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], newPositionId, false, osmomath.Dec{}, osmomath.Int{}, osmomath.Int{})
+	s.Require().NoError(err)
+	_, found, err = clKeeper.GetAutoCompoundConfig(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundPositionsAfterEpochEnd() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool, compoundedPositionId := s.setupCompoundPool(true)
+	s.recordCompoundPoolTwap()
+
+	// positions created after the swap have no rewards, and one of them is then withdrawn
+	idlePositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	withdrawnPositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], compoundedPositionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], idlePositionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], withdrawnPositionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+	withdrawnPosition, err := clKeeper.GetPosition(s.Ctx, withdrawnPositionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, s.TestAccs[1], withdrawnPositionId, withdrawnPosition.Liquidity)
+	s.Require().NoError(err)
+
+	// other epochs do not compound
+	epochHooks := clKeeper.EpochHooks().(epochtypes.EpochWorkHooks)
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, "week", 1))
+	done, err := epochHooks.ContinueEpochWork(s.Ctx, "week", 1, epochtypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().True(done)
+	configs, err := clKeeper.GetAllAutoCompoundConfigs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(configs, 3)
+
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, "day", 1))
+	done, err = epochHooks.ContinueEpochWork(s.Ctx, "day", 1, epochtypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, found, err := clKeeper.GetPendingAutoCompound(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	// the compounded position was replaced and its opt-in moved to the new position,
+	// the idle position is kept and the withdrawn position's opt-in was dropped
+	_, err = clKeeper.GetPosition(s.Ctx, compoundedPositionId)
+	s.Require().Error(err)
+	configs, err = clKeeper.GetAllAutoCompoundConfigs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(configs, 2)
+	s.Require().Equal(idlePositionId, configs[0].PositionId)
+	s.Require().Greater(configs[1].PositionId, withdrawnPositionId)
+	s.Require().Equal(s.TestAccs[0].String(), configs[1].Owner)
+	newPosition, err := clKeeper.GetPosition(s.Ctx, configs[1].PositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.TestAccs[0].String(), newPosition.Address)
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundBoundedByTwap() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool, positionId := s.setupCompoundPool(true)
+	s.recordCompoundPoolTwap()
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[0], positionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+
+	// the price of ETH is pushed up in the epoch block, so that the rebalancing swap of the USDC spread rewards
+	// into ETH returns less than at the TWAP
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(compoundSwapAmount))
+	_, err := clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, compoundSwapAmount, ETH, osmomath.OneInt(), compoundSpreadFactor)
+	s.Require().NoError(err)
+
+	// bounded by the spot price, the swap would be accepted
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, _, _, _, err = clKeeper.CompoundPosition(cacheCtx, s.TestAccs[0], positionId, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	// auto compounding is bounded by the TWAP, so the position is skipped and keeps its opt-in
+	epochHooks := clKeeper.EpochHooks().(epochtypes.EpochWorkHooks)
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, "day", 1))
+	done, err := epochHooks.ContinueEpochWork(s.Ctx, "day", 1, epochtypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, err = clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, found, err := clKeeper.GetAutoCompoundConfig(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestAutoCompoundEpochWorkBudget() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool, _ := s.setupCompoundPool(false)
+
+	// more opted in positions than a batch, without rewards so that compounding them fails
+	numPositions := cl.AutoCompoundBatchSize + 1
+	positionIds := make([]uint64, numPositions)
+	for i := range positionIds {
+		positionIds[i] = s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+		s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], positionIds[i], true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+	}
+
+	epochHooks := clKeeper.EpochHooks().(epochtypes.EpochWorkHooks)
+	s.Require().NoError(epochHooks.AfterEpochEnd(s.Ctx, "day", 1))
+	pending, found, err := clKeeper.GetPendingAutoCompound(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(clKeeper.GetNextPositionId(s.Ctx), pending.EndPositionId)
+
+	// a position opting in after the epoch ended is compounded at the end of the next epoch
+	latePositionId := s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+	s.Require().NoError(clKeeper.SetPositionAutoCompound(s.Ctx, s.TestAccs[1], latePositionId, true, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt()))
+
+	// an exhausted budget stops after the first batch
+	done, err := epochHooks.ContinueEpochWork(s.Ctx, "day", 1, epochtypes.NewEpochWorkBudget(s.Ctx, 1))
+	s.Require().NoError(err)
+	s.Require().False(done)
+	pending, found, err = clKeeper.GetPendingAutoCompound(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(positionIds[numPositions-2]+1, pending.NextPositionId)
+
+	// the work of another epoch is done right away
+	done, err = epochHooks.ContinueEpochWork(s.Ctx, "day", 2, epochtypes.NewEpochWorkBudget(s.Ctx, 1))
+	s.Require().NoError(err)
+	s.Require().True(done)
+
+	done, err = epochHooks.ContinueEpochWork(s.Ctx, "day", 1, epochtypes.NewEpochWorkBudget(s.Ctx, 1))
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, found, err = clKeeper.GetPendingAutoCompound(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	s.Require().GreaterOrEqual(latePositionId, pending.EndPositionId)
+}
@@ -7,6 +7,7 @@ import (
 const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
+	FlagDynamicSpreadFactorConfigs = "dynamic-spread-factor-configs"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -23,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetEffectiveSpreadFactor)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +149,34 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetUserLimitOrders() (*osmocli.QueryDescriptor, *queryproto.UserLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "user-limit-orders",
+			Short: "Query user's limit orders with the given status (0 = open, 1 = filled)",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-limit-orders osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 0`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+			CustomFlagOverrides: poolIdFlagOverride,
+		},
+		&queryproto.UserLimitOrdersRequest{}
+}
+
+func GetPoolLimitOrders() (*osmocli.QueryDescriptor, *queryproto.PoolLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "pool-limit-orders",
+			Short: "Query pool's limit orders with the given status (0 = open, 1 = filled)",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-limit-orders 1 0`,
+		},
+		&queryproto.PoolLimitOrdersRequest{}
+}
+
+func GetEffectiveSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.EffectiveSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "effective-spread-factor",
+		Short: "Query the spread factor charged on swaps not moving the price of the given pool at the current block",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} effective-spread-factor 1`,
+	}, &queryproto.EffectiveSpreadFactorRequest{}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -15,6 +16,7 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
@@ -30,6 +32,12 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCompoundPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewSetPositionAutoCompoundCmd)
+	osmocli.AddTxCmd(txCmd, NewShiftPositionCmd)
 	return txCmd
 }
 
@@ -70,6 +78,57 @@ func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	}, &types.MsgWithdrawPosition{}
 }
 
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order",
+		Short:   "place a limit order selling the given token at the given tick",
+		Long:    "orders selling token0 must be placed above the current tick, orders selling token1 at or below it. The order is filled once a swap crosses it by one tick spacing.",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 100000 1000000uosmo --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-limit-order",
+		Short:   "cancel an open limit order and withdraw its liquidity",
+		Example: "osmosisd tx concentratedliquidity cancel-limit-order 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order",
+		Short:   "claim a crossed limit order that was not filled by the swap crossing it",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
+
+func NewCompoundPositionCmd() (*osmocli.TxCliDesc, *types.MsgCompoundPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "compound-position",
+		Short:   "claim a position's spread rewards and incentives and add them back to the position",
+		Long:    "rewards are swapped into the position's token ratio, every swap returning at least the amount implied by spot prices reduced by the max slippage. The position is replaced by a new one, which must hold at least the given token min amounts more than the old one.",
+		Example: "osmosisd tx concentratedliquidity compound-position 1 0.01 1000 1000 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCompoundPosition{}
+}
+
+func NewSetPositionAutoCompoundCmd() (*osmocli.TxCliDesc, *types.MsgSetPositionAutoCompound) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-position-auto-compound",
+		Short:   "opt a position in or out of being compounded at the end of every day epoch",
+		Example: "osmosisd tx concentratedliquidity set-position-auto-compound 1 true 0.01 1000 1000 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetPositionAutoCompound{}
+}
+
+func NewShiftPositionCmd() (*osmocli.TxCliDesc, *types.MsgShiftPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "shift-position",
+		Short:   "move an existing concentrated liquidity position to a new tick range",
+		Long:    "the withdrawn amounts are swapped into the new range's token ratio, returning at least the amount implied by the spot price reduced by the max slippage. The position is replaced by a new one that keeps its join time and unclaimed rewards.",
+		Example: "osmosisd tx concentratedliquidity shift-position 1 \"[-69082]\" 69082 0.01 0 0 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgShiftPosition{}
+}
+
 func NewCollectSpreadRewardsCmd() (*osmocli.TxCliDesc, *types.MsgCollectSpreadRewards) {
 	return &osmocli.TxCliDesc{
 		Use:     "collect-spread-rewards",
@@ -205,3 +264,114 @@ func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSp
 
 	return poolIdToTickSpacingRecords, nil
 }
+
+func NewSetDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to opt pools into a volatility-dependent spread factor",
+		Long: strings.TrimSpace(`Submit a proposal to opt pools into a volatility-dependent spread factor.
+
+Passing in FlagDynamicSpreadFactorConfigs separated by commas would be parsed automatically to groups of
+(poolId, volatilityMultiplier, maxSpreadFactor, twapWindow) configs.
+Ex) --dynamic-spread-factor-configs=1,0.5,0.01,1h,5,0,0,0s -> [(poolId 1, volatilityMultiplier 0.5, maxSpreadFactor 0.01, twapWindow 1h), (poolId 5 opted out)]
+Note: A zero volatility multiplier opts the pool out. The max spread factor must not be below the pool's spread factor.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseDynamicSpreadFactorConfigsArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagDynamicSpreadFactorConfigs, "", "The dynamic spread factor configs array")
+
+	return cmd
+}
+
+func parseDynamicSpreadFactorConfigsArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := parseDynamicSpreadFactorConfigs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SetDynamicSpreadFactorProposal{
+		Title:       title,
+		Description: description,
+		Configs:     configs,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorConfigs(cmd *cobra.Command) ([]types.DynamicSpreadFactorConfig, error) {
+	configsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(configsStr, ",")
+
+	if len(fields)%4 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorConfigs must be a list of groups of poolId, volatilityMultiplier, maxSpreadFactor and twapWindow")
+	}
+
+	configs := []types.DynamicSpreadFactorConfig{}
+	for i := 0; i < len(fields); i += 4 {
+		poolId, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		volatilityMultiplier, err := osmomath.NewDecFromStr(fields[i+1])
+		if err != nil {
+			return nil, err
+		}
+		maxSpreadFactor, err := osmomath.NewDecFromStr(fields[i+2])
+		if err != nil {
+			return nil, err
+		}
+		twapWindow, err := time.ParseDuration(fields[i+3])
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, types.DynamicSpreadFactorConfig{
+			PoolId:               poolId,
+			VolatilityMultiplier: volatilityMultiplier,
+			MaxSpreadFactor:      maxSpreadFactor,
+			TwapWindow:           twapWindow,
+		})
+	}
+
+	return configs, nil
+}
@@ -41,6 +41,16 @@ func (q Querier) UserPositions(grpcCtx context.Context,
 	return q.Q.UserPositions(ctx, *req)
 }
 
+func (q Querier) UserLimitOrders(grpcCtx context.Context,
+	req *queryproto.UserLimitOrdersRequest,
+) (*queryproto.UserLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.UserLimitOrders(ctx, *req)
+}
+
 func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	req *queryproto.TickAccumulatorTrackersRequest,
 ) (*queryproto.TickAccumulatorTrackersResponse, error) {
@@ -71,6 +81,16 @@ func (q Querier) Pools(grpcCtx context.Context,
 	return q.Q.Pools(ctx, *req)
 }
 
+func (q Querier) PoolLimitOrders(grpcCtx context.Context,
+	req *queryproto.PoolLimitOrdersRequest,
+) (*queryproto.PoolLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolLimitOrders(ctx, *req)
+}
+
 func (q Querier) PoolAccumulatorRewards(grpcCtx context.Context,
 	req *queryproto.PoolAccumulatorRewardsRequest,
 ) (*queryproto.PoolAccumulatorRewardsResponse, error) {
@@ -151,6 +171,16 @@ func (q Querier) GetTotalLiquidity(grpcCtx context.Context,
 	return q.Q.GetTotalLiquidity(ctx, *req)
 }
 
+func (q Querier) EffectiveSpreadFactor(grpcCtx context.Context,
+	req *queryproto.EffectiveSpreadFactorRequest,
+) (*queryproto.EffectiveSpreadFactorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EffectiveSpreadFactor(ctx, *req)
+}
+
 func (q Querier) ClaimableSpreadRewards(grpcCtx context.Context,
 	req *queryproto.ClaimableSpreadRewardsRequest,
 ) (*queryproto.ClaimableSpreadRewardsResponse, error) {
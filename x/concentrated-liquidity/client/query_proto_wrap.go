@@ -10,6 +10,7 @@ import (
 	cl "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
 	clquery "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 )
 
 // Querier defines a wrapper around the x/concentrated-liquidity keeper providing gRPC method
@@ -335,3 +336,59 @@ func (q Querier) NumPoolPositions(ctx sdk.Context, req clquery.NumPoolPositionsR
 		PositionCount: uint64(len(positionIDs)),
 	}, nil
 }
+
+// UserLimitOrders returns the limit orders of the specified address with the specified status.
+// If pool id is zero, returns orders across all pools.
+func (q Querier) UserLimitOrders(ctx sdk.Context, req clquery.UserLimitOrdersRequest) (*clquery.UserLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	limitOrders, pageRes, err := q.Keeper.GetUserLimitOrders(ctx, sdkAddr, req.PoolId, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserLimitOrdersResponse{
+		LimitOrders: limitOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// PoolLimitOrders returns the limit orders in the specified pool with the specified status.
+func (q Querier) PoolLimitOrders(ctx sdk.Context, req clquery.PoolLimitOrdersRequest) (*clquery.PoolLimitOrdersResponse, error) {
+	limitOrders, pageRes, err := q.Keeper.GetPoolLimitOrders(ctx, req.PoolId, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PoolLimitOrdersResponse{
+		LimitOrders: limitOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// EffectiveSpreadFactor returns the spread factor a swap in the specified pool would be charged at the current block.
+func (q Querier) EffectiveSpreadFactor(ctx sdk.Context, req clquery.EffectiveSpreadFactorRequest) (*clquery.EffectiveSpreadFactorResponse, error) {
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	config, found, err := q.Keeper.GetDynamicSpreadFactorConfig(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var configRes *types.DynamicSpreadFactorConfig
+	if found {
+		configRes = &config
+	}
+
+	return &clquery.EffectiveSpreadFactorResponse{
+		BaseSpreadFactor:          pool.GetSpreadFactor(ctx),
+		EffectiveSpreadFactor:     q.Keeper.GetEffectiveSpreadFactor(ctx, pool),
+		DynamicSpreadFactorConfig: configRes,
+	}, nil
+}
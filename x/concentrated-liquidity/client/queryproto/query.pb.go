@@ -1717,6 +1717,338 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
+// =============================== UserLimitOrders
+type UserLimitOrdersRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// pool_id filters the orders by pool. Zero returns orders in all pools.
+	PoolId     uint64                  `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Status     types1.LimitOrderStatus `protobuf:"varint,3,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.LimitOrderStatus" json:"status,omitempty" yaml:"status"`
+	Pagination *query.PageRequest      `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserLimitOrdersRequest) Reset()         { *m = UserLimitOrdersRequest{} }
+func (m *UserLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersRequest) ProtoMessage()    {}
+func (*UserLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *UserLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersRequest.Merge(m, src)
+}
+func (m *UserLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UserLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *UserLimitOrdersRequest) GetStatus() types1.LimitOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.LimitOrderOpen
+}
+
+func (m *UserLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type UserLimitOrdersResponse struct {
+	LimitOrders []types1.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserLimitOrdersResponse) Reset()         { *m = UserLimitOrdersResponse{} }
+func (m *UserLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersResponse) ProtoMessage()    {}
+func (*UserLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *UserLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserLimitOrdersResponse) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *UserLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PoolLimitOrders
+type PoolLimitOrdersRequest struct {
+	PoolId     uint64                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Status     types1.LimitOrderStatus `protobuf:"varint,2,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.LimitOrderStatus" json:"status,omitempty" yaml:"status"`
+	Pagination *query.PageRequest      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PoolLimitOrdersRequest) Reset()         { *m = PoolLimitOrdersRequest{} }
+func (m *PoolLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolLimitOrdersRequest) ProtoMessage()    {}
+func (*PoolLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *PoolLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolLimitOrdersRequest.Merge(m, src)
+}
+func (m *PoolLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *PoolLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolLimitOrdersRequest) GetStatus() types1.LimitOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types1.LimitOrderOpen
+}
+
+func (m *PoolLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type PoolLimitOrdersResponse struct {
+	LimitOrders []types1.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PoolLimitOrdersResponse) Reset()         { *m = PoolLimitOrdersResponse{} }
+func (m *PoolLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolLimitOrdersResponse) ProtoMessage()    {}
+func (*PoolLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *PoolLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolLimitOrdersResponse.Merge(m, src)
+}
+func (m *PoolLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *PoolLimitOrdersResponse) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *PoolLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== EffectiveSpreadFactor
+type EffectiveSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *EffectiveSpreadFactorRequest) Reset()         { *m = EffectiveSpreadFactorRequest{} }
+func (m *EffectiveSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorRequest) ProtoMessage()    {}
+func (*EffectiveSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type EffectiveSpreadFactorResponse struct {
+	// base_spread_factor is the static spread factor of the pool.
+	BaseSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_spread_factor,json=baseSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_spread_factor" yaml:"base_spread_factor"`
+	// effective_spread_factor is the spread factor charged at the current block
+	// on swaps that do not move the price. Swaps moving the price are charged
+	// more.
+	EffectiveSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=effective_spread_factor,json=effectiveSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_spread_factor" yaml:"effective_spread_factor"`
+	// dynamic_spread_factor_config is unset if the pool does not use a dynamic
+	// spread factor.
+	DynamicSpreadFactorConfig *types1.DynamicSpreadFactorConfig `protobuf:"bytes,3,opt,name=dynamic_spread_factor_config,json=dynamicSpreadFactorConfig,proto3" json:"dynamic_spread_factor_config,omitempty" yaml:"dynamic_spread_factor_config"`
+}
+
+func (m *EffectiveSpreadFactorResponse) Reset()         { *m = EffectiveSpreadFactorResponse{} }
+func (m *EffectiveSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorResponse) ProtoMessage()    {}
+func (*EffectiveSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorResponse) GetDynamicSpreadFactorConfig() *types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
@@ -1752,6 +2084,12 @@ func init() {
 	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
 	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
 	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*UserLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersRequest")
+	proto.RegisterType((*UserLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersResponse")
+	proto.RegisterType((*PoolLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolLimitOrdersRequest")
+	proto.RegisterType((*PoolLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolLimitOrdersResponse")
+	proto.RegisterType((*EffectiveSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorRequest")
+	proto.RegisterType((*EffectiveSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorResponse")
 }
 
 func init() {
@@ -1759,155 +2097,178 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0x3f, 0x9b, 0x79, 0x71, 0x9c, 0xa4, 0xec, 0xd8, 0xce, 0x24, 0x99, 0xc9, 0x16,
-	0x84, 0xb5, 0x48, 0x32, 0x43, 0xfe, 0x08, 0xf9, 0xdb, 0xc4, 0x63, 0xaf, 0xa3, 0xd1, 0x3a, 0x5e,
-	0xa7, 0x13, 0x03, 0x42, 0x88, 0xde, 0x9e, 0xee, 0xf2, 0xb8, 0x35, 0x3d, 0x5d, 0xe3, 0xee, 0xea,
-	0x24, 0x66, 0x89, 0xb4, 0xda, 0x3d, 0x22, 0xc1, 0x22, 0xae, 0x08, 0x09, 0x71, 0x41, 0x2b, 0x8e,
-	0x5c, 0xe0, 0x82, 0xe0, 0x80, 0x22, 0x0e, 0xab, 0x95, 0x10, 0x12, 0xda, 0xc3, 0x2c, 0x24, 0x1c,
-	0x90, 0x16, 0x90, 0x30, 0x17, 0x8e, 0xa8, 0xab, 0xab, 0x7f, 0x66, 0xa6, 0xc7, 0xee, 0x19, 0x9b,
-	0x13, 0x27, 0x4f, 0xf5, 0xab, 0xf7, 0xde, 0xf7, 0x7e, 0xea, 0x75, 0xbd, 0xd7, 0x86, 0x8b, 0xcc,
-	0x6d, 0x32, 0xd7, 0x74, 0xcb, 0x3a, 0xb3, 0x75, 0x6a, 0x73, 0x47, 0xe3, 0xd4, 0xb0, 0xcc, 0x75,
-	0xcf, 0x34, 0x4c, 0xbe, 0x51, 0x7e, 0x7c, 0xb1, 0x46, 0xb9, 0x76, 0xb1, 0xbc, 0xee, 0x51, 0x67,
-	0xa3, 0xd4, 0x72, 0x18, 0x67, 0xf8, 0xac, 0x64, 0x29, 0xa5, 0xb2, 0x94, 0x24, 0x4b, 0x7e, 0xa2,
-	0xce, 0xea, 0x4c, 0x70, 0x94, 0xfd, 0x5f, 0x01, 0x73, 0xfe, 0x8b, 0x5b, 0xeb, 0x6b, 0x69, 0x8e,
-	0xd6, 0x74, 0xe5, 0xde, 0xab, 0xd9, 0xb0, 0x71, 0x53, 0x6f, 0xa8, 0xa6, 0xbd, 0x1a, 0xaa, 0x28,
-	0xe8, 0x82, 0xaf, 0x5c, 0xd3, 0x5c, 0x1a, 0x6d, 0xd2, 0x99, 0x69, 0x87, 0x10, 0x92, 0x74, 0x61,
-	0x58, 0xb4, 0xab, 0xa5, 0xd5, 0x4d, 0x5b, 0xe3, 0x26, 0x0b, 0xf7, 0x9e, 0xaa, 0x33, 0x56, 0xb7,
-	0x68, 0x59, 0x6b, 0x99, 0x65, 0xcd, 0xb6, 0x19, 0x17, 0xc4, 0x10, 0xe0, 0x09, 0x49, 0x15, 0xab,
-	0x9a, 0xb7, 0x5a, 0xd6, 0xec, 0x8d, 0x90, 0x14, 0x28, 0x51, 0x03, 0x07, 0x04, 0x0b, 0x49, 0xba,
-	0x92, 0xcd, 0xac, 0x16, 0x73, 0xcd, 0x04, 0x92, 0x5b, 0xd9, 0xb8, 0x4c, 0x41, 0x34, 0x1f, 0x53,
-	0xd5, 0xa1, 0x3a, 0x73, 0x8c, 0x80, 0x9b, 0xfc, 0x12, 0xc1, 0xc4, 0x8a, 0x4b, 0x9d, 0x65, 0x29,
-	0xd4, 0x55, 0xe8, 0xba, 0x47, 0x5d, 0x8e, 0xcf, 0xc3, 0x2b, 0x9a, 0x61, 0x38, 0xd4, 0x75, 0xa7,
-	0xd1, 0x19, 0x34, 0x93, 0xab, 0xe0, 0xcd, 0x76, 0x71, 0x6c, 0x43, 0x6b, 0x5a, 0x37, 0x88, 0x24,
-	0x10, 0x25, 0xdc, 0x82, 0xcf, 0xc1, 0x2b, 0x2d, 0xc6, 0x2c, 0xd5, 0x34, 0xa6, 0x47, 0xce, 0xa0,
-	0x99, 0x7d, 0xc9, 0xdd, 0x92, 0x40, 0x94, 0x03, 0xfe, 0xaf, 0xaa, 0x81, 0x17, 0x00, 0x62, 0x7f,
-	0x4e, 0xef, 0x3d, 0x83, 0x66, 0x0e, 0x5d, 0xfa, 0x42, 0x49, 0xba, 0xc2, 0x77, 0x7e, 0x29, 0xc8,
-	0x2a, 0x09, 0xbd, 0xb4, 0xac, 0xd5, 0xa9, 0x84, 0xa5, 0x24, 0x38, 0xc9, 0x6f, 0x11, 0x1c, 0xef,
-	0xc2, 0xee, 0xb6, 0x98, 0xed, 0x52, 0xfc, 0x36, 0xe4, 0x42, 0x2f, 0xf9, 0xf0, 0xf7, 0xce, 0x1c,
-	0xba, 0x74, 0xab, 0x94, 0x29, 0x3b, 0x4b, 0x0b, 0x9e, 0x65, 0x85, 0x02, 0x2b, 0x0e, 0xd5, 0x1a,
-	0x06, 0x7b, 0x62, 0x57, 0xf6, 0x3d, 0x6f, 0x17, 0xf7, 0x28, 0xb1, 0x50, 0x7c, 0xaf, 0xc3, 0x86,
-	0x11, 0x61, 0xc3, 0x6b, 0xdb, 0xda, 0x10, 0xc0, 0xeb, 0x30, 0x62, 0x09, 0xc6, 0x23, 0x75, 0x1b,
-	0x55, 0x23, 0x74, 0xff, 0x35, 0x38, 0x14, 0x2a, 0xf3, 0x9d, 0x8a, 0x84, 0x53, 0x27, 0x37, 0xdb,
-	0x45, 0x1c, 0x3a, 0x35, 0x22, 0x12, 0x05, 0xc2, 0x55, 0xd5, 0x20, 0x8f, 0x61, 0xa2, 0x53, 0x9e,
-	0x74, 0xc9, 0xb7, 0xe0, 0x60, 0xb8, 0x4b, 0x48, 0xdb, 0x1d, 0x8f, 0x44, 0x32, 0xc9, 0x02, 0x4c,
-	0x2d, 0x79, 0xcd, 0x65, 0xc6, 0xac, 0x9e, 0x54, 0x4a, 0x24, 0x07, 0xda, 0x2e, 0x39, 0xc8, 0x37,
-	0x61, 0xba, 0x57, 0x8e, 0xb4, 0xe1, 0x2e, 0x8c, 0x45, 0x76, 0xeb, 0xcc, 0xb3, 0xb9, 0x94, 0x77,
-	0x62, 0xb3, 0x5d, 0x3c, 0xde, 0xe5, 0x17, 0x41, 0x27, 0xca, 0xe1, 0xf0, 0xc1, 0x9c, 0x58, 0x7f,
-	0x15, 0x46, 0x7d, 0xd1, 0x11, 0xb4, 0x85, 0x94, 0x30, 0x0e, 0x93, 0x8a, 0xdf, 0x47, 0x70, 0x58,
-	0x0a, 0x96, 0x58, 0xaf, 0xc2, 0x7e, 0xdf, 0xa2, 0x30, 0xfd, 0x26, 0x4a, 0x41, 0x49, 0x28, 0x85,
-	0x25, 0xa1, 0x34, 0x6b, 0x6f, 0x54, 0x72, 0xbf, 0xff, 0xc5, 0x85, 0xfd, 0x3e, 0x5f, 0x55, 0x09,
-	0x76, 0xef, 0x5e, 0x5e, 0x1d, 0x81, 0xc3, 0xcb, 0xa2, 0x66, 0x4a, 0xb8, 0x64, 0x05, 0xc6, 0xc2,
-	0x07, 0x12, 0xe2, 0x1c, 0x1c, 0x08, 0xca, 0xaa, 0x4c, 0x88, 0xb3, 0xdb, 0x24, 0x44, 0xc0, 0x2e,
-	0x23, 0x2f, 0x59, 0xc9, 0x87, 0x08, 0x8e, 0x3e, 0x32, 0xf5, 0xc6, 0x62, 0xb8, 0x6d, 0x89, 0x72,
-	0xfc, 0x36, 0x1c, 0x8e, 0xd8, 0x54, 0x9b, 0x72, 0x59, 0x42, 0x6e, 0xfa, 0x9c, 0x9f, 0xb4, 0x8b,
-	0x27, 0x03, 0x7b, 0x5c, 0xa3, 0x51, 0x32, 0x59, 0xb9, 0xa9, 0xf1, 0xb5, 0xd2, 0x22, 0xad, 0x6b,
-	0xfa, 0xc6, 0x3c, 0xd5, 0x37, 0xdb, 0xc5, 0x89, 0x20, 0x94, 0x1d, 0x12, 0x88, 0x32, 0x6a, 0x25,
-	0x35, 0x5c, 0x01, 0x90, 0xe5, 0xdd, 0xa0, 0x4f, 0x85, 0x9f, 0xf6, 0x56, 0x8e, 0x6f, 0xb6, 0x8b,
-	0xc7, 0x02, 0xde, 0x98, 0x46, 0x94, 0x9c, 0xbf, 0xa8, 0x8a, 0xdf, 0xff, 0x40, 0x30, 0x15, 0x01,
-	0x9d, 0xa7, 0x2d, 0xbe, 0xf6, 0x35, 0x93, 0xaf, 0x29, 0x9a, 0x5d, 0xa7, 0x78, 0x15, 0x8e, 0xc6,
-	0x1a, 0xb5, 0x66, 0x94, 0x5e, 0x3b, 0x84, 0x7d, 0x24, 0x5a, 0xcf, 0x0a, 0x99, 0x3e, 0x72, 0x8b,
-	0x3d, 0xa1, 0x8e, 0xea, 0xc3, 0xea, 0x45, 0x1e, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0xdf, 0xbb, 0x3e,
-	0x97, 0xd7, 0x6a, 0x85, 0x5c, 0x7b, 0xbb, 0xb9, 0x62, 0x1a, 0x51, 0x72, 0x62, 0xe1, 0x73, 0x91,
-	0x4f, 0x47, 0xa0, 0x90, 0x0c, 0x4c, 0xd5, 0x9e, 0x37, 0x1d, 0xaa, 0xfb, 0x09, 0x32, 0xcc, 0xe1,
-	0xc4, 0x25, 0x38, 0xc8, 0x59, 0x83, 0xda, 0xaa, 0x19, 0xe4, 0x66, 0xae, 0x32, 0xbe, 0xd9, 0x2e,
-	0x1e, 0x91, 0x3e, 0x97, 0x14, 0xa2, 0xbc, 0x22, 0x7e, 0x56, 0x6d, 0x1f, 0xb5, 0xcb, 0x35, 0x87,
-	0xf7, 0x41, 0x1d, 0xd3, 0x88, 0x92, 0x13, 0x0b, 0x61, 0xeb, 0x75, 0x18, 0xf5, 0x5c, 0xaa, 0xea,
-	0x9e, 0xb4, 0x76, 0xdf, 0x19, 0x34, 0x73, 0xb0, 0x32, 0xb5, 0xd9, 0x2e, 0x8e, 0x4b, 0x6b, 0x13,
-	0x54, 0xa2, 0x80, 0xe7, 0xd2, 0x39, 0x2f, 0x72, 0x53, 0x8d, 0x79, 0xb6, 0x11, 0x30, 0xee, 0xef,
-	0x56, 0x18, 0xd3, 0x88, 0x92, 0x13, 0x8b, 0xa4, 0x42, 0x9b, 0xa9, 0xe2, 0xd9, 0xf4, 0x81, 0x34,
-	0x85, 0x21, 0x35, 0x50, 0xb8, 0xc4, 0x2a, 0x62, 0xf1, 0x93, 0xbd, 0x50, 0xec, 0xeb, 0x61, 0x79,
-	0xce, 0xd6, 0x92, 0x99, 0x65, 0xf8, 0x59, 0x17, 0x56, 0x85, 0x6b, 0x19, 0x4b, 0x70, 0xf7, 0x01,
-	0x93, 0x67, 0x30, 0xce, 0x2d, 0x91, 0xcb, 0x2e, 0x7e, 0x15, 0x46, 0x75, 0xcf, 0x71, 0xa8, 0xcd,
-	0x13, 0xd9, 0xa5, 0x1c, 0x92, 0xcf, 0x84, 0xad, 0x16, 0x1c, 0x0b, 0xb7, 0x44, 0xdc, 0x22, 0x32,
-	0xb9, 0xca, 0x9d, 0x6c, 0x79, 0x3e, 0x1d, 0xf8, 0xa4, 0x47, 0x0a, 0x51, 0x8e, 0xca, 0x67, 0x11,
-	0x54, 0xfc, 0x1e, 0x02, 0x1c, 0x6e, 0x74, 0xd7, 0x1d, 0xae, 0xb6, 0x1c, 0x53, 0xa7, 0x22, 0xa2,
-	0xb9, 0xca, 0x23, 0xa9, 0xaf, 0x5c, 0x37, 0xf9, 0x9a, 0x57, 0x2b, 0xe9, 0xac, 0x59, 0x96, 0xfe,
-	0xb8, 0x60, 0x69, 0x35, 0x37, 0x5c, 0x88, 0xbf, 0x02, 0x46, 0xc5, 0xac, 0x07, 0x18, 0x4e, 0x74,
-	0x62, 0x88, 0x45, 0xc7, 0x20, 0x1e, 0xae, 0x3b, 0x7c, 0x59, 0x3c, 0x7a, 0x13, 0x4e, 0x45, 0x88,
-	0x96, 0x83, 0x93, 0x21, 0x8e, 0xfc, 0x50, 0xef, 0xa7, 0x5f, 0x23, 0x38, 0xdd, 0x47, 0x9a, 0x0c,
-	0x77, 0x0d, 0x72, 0xb1, 0x67, 0x83, 0x38, 0xbf, 0x9e, 0x31, 0xce, 0x7d, 0x6a, 0x53, 0x78, 0xfd,
-	0x88, 0x18, 0xf0, 0x0d, 0x18, 0xad, 0x79, 0x7a, 0x83, 0xf2, 0x8e, 0x02, 0x98, 0xc8, 0xd8, 0x24,
-	0x95, 0x28, 0x87, 0x82, 0x65, 0x50, 0x04, 0xbf, 0x0e, 0xa7, 0xe7, 0x2c, 0xcd, 0x6c, 0x6a, 0x35,
-	0x8b, 0x3e, 0x6c, 0x39, 0x54, 0x33, 0x14, 0xfa, 0x44, 0x73, 0x0c, 0x77, 0xc7, 0x77, 0x8f, 0x1f,
-	0x23, 0x28, 0xf4, 0x13, 0x2d, 0x9d, 0xf3, 0x1d, 0x98, 0xd6, 0xc3, 0x1d, 0xaa, 0x2b, 0xb6, 0xa8,
-	0x4e, 0xb0, 0x47, 0xfa, 0xea, 0x44, 0xc7, 0xdb, 0x2e, 0xf4, 0xcc, 0x1c, 0x33, 0xed, 0xca, 0x6b,
-	0xbe, 0x1b, 0x36, 0xdb, 0xc5, 0xa2, 0x8c, 0x7e, 0x1f, 0x41, 0x44, 0x99, 0xd4, 0x53, 0x51, 0x90,
-	0x15, 0xc8, 0x47, 0xf8, 0xaa, 0xe1, 0x85, 0x78, 0xe7, 0x76, 0xbf, 0x3f, 0x02, 0x27, 0x53, 0xe5,
-	0x4a, 0xa3, 0xd7, 0x61, 0x22, 0xc6, 0x1a, 0x5d, 0xc4, 0x33, 0x18, 0xfc, 0x39, 0x69, 0xf0, 0xc9,
-	0x6e, 0x83, 0x63, 0x21, 0x44, 0x19, 0xd7, 0x7b, 0x55, 0xfb, 0x2a, 0x57, 0x99, 0xb3, 0x4a, 0x4d,
-	0x4e, 0x8d, 0xa4, 0xca, 0x91, 0x01, 0x55, 0xa6, 0x09, 0x21, 0xca, 0x78, 0xf4, 0x38, 0x56, 0x49,
-	0x16, 0xe1, 0xb4, 0x7f, 0x95, 0x99, 0xd5, 0x75, 0xaf, 0xe9, 0x59, 0x1a, 0x67, 0x4e, 0x57, 0x5e,
-	0x0d, 0x74, 0xce, 0x7e, 0x33, 0x02, 0x85, 0x7e, 0xe2, 0xa4, 0x5b, 0x3f, 0x40, 0x70, 0xb2, 0x23,
-	0xf2, 0x6a, 0xdd, 0x61, 0x4f, 0xf8, 0x9a, 0x5a, 0xb7, 0x58, 0x4d, 0xb3, 0xa4, 0x7b, 0x4f, 0xa5,
-	0xda, 0x3a, 0x4f, 0x75, 0x61, 0xee, 0x65, 0xdf, 0xdc, 0x0f, 0x3f, 0x2d, 0x9e, 0x4b, 0xd4, 0x20,
-	0xd9, 0x06, 0x06, 0x7f, 0x2e, 0xb8, 0x46, 0xa3, 0xcc, 0x37, 0x5a, 0xd4, 0x0d, 0x79, 0x5c, 0x65,
-	0xda, 0x4d, 0x64, 0xd5, 0x3d, 0xa1, 0xf3, 0x9e, 0x50, 0x89, 0xbf, 0x8b, 0x60, 0xc2, 0x6b, 0x71,
-	0xb3, 0x49, 0xbb, 0xb0, 0x04, 0x7e, 0xbf, 0x92, 0xb1, 0x0e, 0xac, 0x08, 0x11, 0x8f, 0x1c, 0x4d,
-	0x6f, 0x50, 0xa7, 0x3b, 0x24, 0x69, 0xf2, 0x89, 0x82, 0x83, 0xc7, 0x49, 0x34, 0xe4, 0x7d, 0x04,
-	0x05, 0xbf, 0x3e, 0x25, 0x7c, 0x28, 0x65, 0x0e, 0x15, 0x93, 0x21, 0x2f, 0x5d, 0x9f, 0x8d, 0x40,
-	0xb1, 0x2f, 0x0a, 0x19, 0xca, 0xe7, 0x08, 0xae, 0xa7, 0x86, 0x92, 0xb5, 0xc4, 0x39, 0xa3, 0xaa,
-	0x11, 0xbe, 0x56, 0x55, 0xb6, 0xaa, 0x5a, 0x9a, 0xcb, 0x55, 0xee, 0x68, 0x8f, 0xa9, 0xe3, 0xfe,
-	0x2f, 0x03, 0x7d, 0xa9, 0x37, 0xd0, 0x6f, 0x49, 0x40, 0xd1, 0x6b, 0xfe, 0xad, 0xd5, 0x45, 0xcd,
-	0xe5, 0x8f, 0x42, 0x30, 0xf8, 0x19, 0x1c, 0x91, 0x11, 0xe2, 0xd2, 0xca, 0x1d, 0x05, 0xbf, 0x20,
-	0x83, 0x3f, 0xd9, 0x11, 0xfc, 0x50, 0x34, 0x51, 0xc6, 0xbc, 0xe4, 0x76, 0x97, 0x7c, 0x0f, 0xc1,
-	0x54, 0x74, 0x28, 0x15, 0xd1, 0xea, 0x0f, 0x17, 0xec, 0xdd, 0x6a, 0x8d, 0x3e, 0x42, 0x30, 0xdd,
-	0x0b, 0x48, 0xc6, 0xdd, 0x84, 0x63, 0xdd, 0x83, 0x89, 0xb0, 0x2c, 0x7e, 0x39, 0xa3, 0xbb, 0xba,
-	0x64, 0xcb, 0x77, 0xe5, 0x51, 0xb3, 0x4b, 0xe5, 0xee, 0x75, 0x56, 0xef, 0x22, 0x38, 0x37, 0xb7,
-	0x70, 0xff, 0xbe, 0xe8, 0xdb, 0x8c, 0x45, 0xd3, 0x6e, 0x2c, 0x38, 0xac, 0x39, 0x97, 0x00, 0x19,
-	0x50, 0x42, 0xaf, 0x3f, 0x80, 0x89, 0xa4, 0x05, 0x6a, 0x67, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2,
-	0x8b, 0x28, 0x58, 0xef, 0x91, 0x4c, 0x4c, 0x38, 0x9f, 0x0d, 0x81, 0x74, 0xf3, 0x75, 0x18, 0xd5,
-	0x57, 0x9b, 0xcd, 0x2e, 0xd5, 0x89, 0xeb, 0x42, 0x92, 0x4a, 0x14, 0xf0, 0x97, 0x52, 0xd5, 0x7d,
-	0x38, 0xbd, 0xe2, 0x52, 0x67, 0xc5, 0xae, 0x31, 0xdb, 0x30, 0xed, 0xfa, 0xce, 0x06, 0x45, 0xe4,
-	0xa7, 0x08, 0x0a, 0xfd, 0xe4, 0x49, 0xb0, 0xef, 0x22, 0xc8, 0x47, 0x83, 0x16, 0xf5, 0x89, 0xc9,
-	0xd7, 0xd4, 0x16, 0x75, 0x4c, 0x66, 0xa8, 0x16, 0xd3, 0x1b, 0x32, 0x3b, 0x6e, 0x67, 0xcc, 0x8e,
-	0x50, 0xbc, 0x7f, 0x97, 0x5a, 0x16, 0x52, 0x16, 0x99, 0xde, 0x90, 0x49, 0x32, 0x15, 0xa9, 0xe9,
-	0x24, 0x93, 0x3c, 0x4c, 0xdf, 0xa3, 0xfc, 0x11, 0xe3, 0x9a, 0x15, 0x5d, 0xc9, 0xc2, 0x3e, 0xfa,
-	0x07, 0x08, 0x4e, 0xa4, 0x10, 0x25, 0x78, 0x0e, 0x47, 0xb8, 0x4f, 0x51, 0xbb, 0xaf, 0x80, 0x5b,
-	0xbc, 0x72, 0xbf, 0x24, 0x4b, 0xd3, 0x4c, 0x86, 0xd2, 0x14, 0xd4, 0xa5, 0x31, 0xde, 0xa1, 0x9d,
-	0x6c, 0x22, 0x28, 0x2c, 0x79, 0xcd, 0x25, 0xfa, 0x94, 0x57, 0x6d, 0x93, 0x9b, 0x9a, 0x65, 0x7e,
-	0x9b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x07, 0xc6, 0xc2, 0x6e, 0x4e, 0x35, 0xa8, 0xcd, 0x9a,
-	0xb2, 0xdb, 0x4b, 0x0c, 0x5a, 0x3a, 0xe9, 0x44, 0x19, 0x95, 0x3d, 0xdf, 0xbc, 0xbf, 0xc4, 0x35,
-	0xc8, 0xdb, 0x5e, 0x53, 0xb5, 0xe9, 0x53, 0xff, 0x0e, 0x1a, 0x21, 0x12, 0x5d, 0x89, 0x2b, 0xda,
-	0x8d, 0x7d, 0x95, 0xb3, 0x9b, 0xed, 0xe2, 0xab, 0x81, 0xb0, 0xfe, 0x7b, 0x89, 0x32, 0x65, 0xa7,
-	0x1b, 0x46, 0x7e, 0x34, 0x02, 0xc5, 0xbe, 0x46, 0xff, 0xdf, 0xb7, 0x5e, 0x97, 0xfe, 0x95, 0x87,
-	0xfd, 0x0f, 0xfc, 0x8a, 0x86, 0x7f, 0x86, 0x40, 0x0c, 0x99, 0x5c, 0x7c, 0x39, 0xf3, 0xa9, 0x89,
-	0x67, 0x64, 0xf9, 0x2b, 0x83, 0x31, 0x05, 0x9e, 0x27, 0x57, 0xde, 0xfb, 0xc3, 0x5f, 0x7f, 0x38,
-	0x52, 0xc2, 0xe7, 0xcb, 0x59, 0xa7, 0xda, 0x3e, 0xc0, 0x9f, 0x23, 0x38, 0x10, 0x8c, 0x99, 0x70,
-	0x66, 0xb5, 0xc9, 0x29, 0x57, 0xfe, 0xea, 0x80, 0x5c, 0x12, 0xed, 0x55, 0x81, 0xb6, 0x8c, 0x2f,
-	0x64, 0x45, 0x1b, 0x60, 0xfc, 0x08, 0xc1, 0xe1, 0x8e, 0x09, 0x34, 0xbe, 0x99, 0xf5, 0x25, 0x9f,
-	0x32, 0x73, 0xcf, 0xdf, 0x1a, 0x8e, 0x59, 0xda, 0x50, 0x11, 0x36, 0xdc, 0xc2, 0x37, 0xca, 0x83,
-	0x7d, 0x47, 0x70, 0xcb, 0xef, 0xc8, 0xea, 0xfc, 0x0c, 0x7f, 0x86, 0xe0, 0x78, 0x6a, 0x77, 0x8b,
-	0xe7, 0x06, 0x6d, 0x61, 0x53, 0x3a, 0xed, 0xfc, 0xfc, 0xce, 0x84, 0x48, 0x43, 0xef, 0x09, 0x43,
-	0x67, 0xf1, 0x9d, 0x8c, 0x86, 0xc6, 0x15, 0x20, 0x1c, 0x92, 0xa9, 0x8e, 0xb0, 0xe9, 0xdf, 0xc9,
-	0x71, 0x60, 0xe7, 0xf0, 0x06, 0xbf, 0x31, 0x28, 0xd4, 0xd4, 0xf1, 0x5a, 0x7e, 0x61, 0xa7, 0x62,
-	0xa4, 0xcd, 0x55, 0x61, 0xf3, 0x1c, 0x9e, 0x1d, 0xd8, 0x66, 0x5b, 0x8c, 0x01, 0xe2, 0xfb, 0x33,
-	0xfe, 0x27, 0x82, 0xc9, 0xf4, 0x2e, 0x1d, 0x67, 0x8d, 0xcf, 0x96, 0xf3, 0x83, 0xfc, 0x1b, 0x3b,
-	0x94, 0x32, 0x64, 0x98, 0xfb, 0x8d, 0x03, 0xf0, 0x5f, 0x10, 0x8c, 0xa7, 0xb4, 0xe7, 0x78, 0x76,
-	0x50, 0x9c, 0x3d, 0x23, 0x83, 0x7c, 0x65, 0x27, 0x22, 0xa4, 0x9d, 0x73, 0xc2, 0xce, 0xdb, 0xf8,
-	0xe6, 0xc0, 0x76, 0xc6, 0x2d, 0x39, 0xfe, 0x1d, 0x82, 0xd1, 0xe4, 0x77, 0x1f, 0x7c, 0x63, 0xc0,
-	0x0b, 0x52, 0xe2, 0xe3, 0x53, 0xfe, 0xe6, 0x50, 0xbc, 0xd2, 0x9c, 0xdb, 0xc2, 0x9c, 0x6b, 0xf8,
-	0xea, 0x80, 0x65, 0x48, 0xad, 0x6d, 0xa8, 0xa6, 0x81, 0xff, 0x86, 0x60, 0x32, 0xbd, 0xef, 0xcf,
-	0x9c, 0x9d, 0x5b, 0x4e, 0x21, 0x32, 0x67, 0xe7, 0xd6, 0xc3, 0x07, 0x32, 0x2b, 0xcc, 0xbc, 0x89,
-	0xaf, 0x0f, 0xf0, 0x7e, 0x53, 0x35, 0x5f, 0x5e, 0x94, 0x97, 0x7f, 0x44, 0x70, 0xb4, 0xbb, 0x33,
-	0xc2, 0xaf, 0x0f, 0xd7, 0xf6, 0x44, 0xe6, 0xdd, 0x19, 0x9a, 0x5f, 0x1a, 0x76, 0x57, 0x18, 0x76,
-	0x03, 0x7f, 0xa5, 0x3c, 0xdc, 0x87, 0x65, 0x17, 0xff, 0x1d, 0xc1, 0x54, 0x9f, 0x86, 0x3f, 0x73,
-	0x59, 0xdd, 0x7a, 0x6c, 0x91, 0xb9, 0xac, 0x6e, 0x33, 0x77, 0x18, 0xf8, 0x9d, 0x29, 0x5e, 0x1e,
-	0x41, 0x14, 0xc3, 0x16, 0x1c, 0xff, 0x6a, 0x04, 0x3e, 0x9f, 0xa5, 0x1b, 0xc3, 0x4a, 0xd6, 0x62,
-	0x91, 0xbd, 0xb9, 0xcc, 0x3f, 0xdc, 0x55, 0x99, 0xd2, 0x2b, 0xa6, 0xf0, 0x8a, 0x8e, 0xb5, 0xac,
-	0x15, 0x29, 0xd1, 0x3d, 0xaa, 0x96, 0x69, 0x37, 0xd4, 0x55, 0x87, 0x35, 0xd5, 0x24, 0x53, 0xf9,
-	0x9d, 0xb4, 0xee, 0xf6, 0x19, 0xfe, 0x0f, 0x82, 0xc9, 0xf4, 0x7e, 0x30, 0xf3, 0x71, 0xdf, 0xb2,
-	0x3d, 0xcd, 0x7c, 0xdc, 0xb7, 0x6e, 0x4a, 0xc9, 0x03, 0xe1, 0x92, 0x37, 0x71, 0x35, 0xa3, 0x4b,
-	0x3c, 0x97, 0x3a, 0xaa, 0x17, 0xca, 0x53, 0xd3, 0xee, 0x5a, 0x9f, 0x20, 0x38, 0xd6, 0xd3, 0x48,
-	0xe2, 0xac, 0xe7, 0xb7, 0x5f, 0x7f, 0x9a, 0xbf, 0x3b, 0xbc, 0x80, 0x21, 0x0f, 0x45, 0x9d, 0x72,
-	0xb5, 0xab, 0xe9, 0x15, 0x57, 0xab, 0x3e, 0xcd, 0x59, 0xe6, 0x1a, 0xb0, 0x75, 0x47, 0x9b, 0xb9,
-	0x06, 0x6c, 0xd3, 0x23, 0x0e, 0x7c, 0xb5, 0xea, 0xdf, 0xac, 0x56, 0xd6, 0x9e, 0xbf, 0x28, 0xa0,
-	0x8f, 0x5f, 0x14, 0xd0, 0x9f, 0x5f, 0x14, 0xd0, 0x07, 0x2f, 0x0b, 0x7b, 0x3e, 0x7e, 0x59, 0xd8,
-	0xf3, 0xa7, 0x97, 0x85, 0x3d, 0xdf, 0x58, 0xda, 0xee, 0x1b, 0xd7, 0xe3, 0xcb, 0x17, 0xcb, 0x4f,
-	0x3b, 0x34, 0x5f, 0x88, 0x55, 0xeb, 0x96, 0x49, 0x6d, 0x1e, 0xfc, 0x4f, 0x52, 0xf0, 0x0f, 0x04,
-	0x07, 0xc4, 0x9f, 0xcb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xc8, 0xb0, 0x89, 0xa7, 0x25,
-	0x00, 0x00,
+	// 2723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5b, 0x6c, 0x1b, 0x59,
+	0xf9, 0xef, 0x38, 0x6d, 0x76, 0xfd, 0xe5, 0xda, 0x93, 0xbb, 0xdb, 0xda, 0xdd, 0xb3, 0xff, 0xfd,
+	0x6f, 0xc4, 0x6e, 0x6d, 0x7a, 0xa3, 0xf4, 0x92, 0xb6, 0x71, 0xd2, 0x94, 0x6c, 0xd3, 0x34, 0x9d,
+	0x36, 0x80, 0x56, 0x88, 0xd9, 0xf1, 0xcc, 0xb1, 0x33, 0xf2, 0x78, 0xc6, 0x99, 0x39, 0x93, 0x36,
+	0x2c, 0x95, 0x56, 0xbb, 0x8f, 0x48, 0xb0, 0x88, 0x57, 0x04, 0x5a, 0xf1, 0x82, 0x2a, 0x1e, 0x79,
+	0x01, 0x24, 0x10, 0x3c, 0xa0, 0x8a, 0x87, 0xd5, 0x4a, 0x08, 0x81, 0x56, 0xc8, 0x0b, 0x2d, 0x0f,
+	0x48, 0x0b, 0x48, 0x18, 0x1e, 0x78, 0x44, 0x73, 0xe6, 0xcc, 0x78, 0x6c, 0x8f, 0x9d, 0xb1, 0x93,
+	0x45, 0x42, 0x3c, 0x25, 0x67, 0xce, 0xf9, 0x2e, 0xbf, 0xef, 0xfb, 0xce, 0xed, 0x77, 0x0c, 0xa7,
+	0x4d, 0xbb, 0x62, 0xda, 0x9a, 0x9d, 0x53, 0x4c, 0x43, 0x21, 0x06, 0xb5, 0x64, 0x4a, 0x54, 0x5d,
+	0xdb, 0x76, 0x34, 0x55, 0xa3, 0xbb, 0xb9, 0x9d, 0xd3, 0x05, 0x42, 0xe5, 0xd3, 0xb9, 0x6d, 0x87,
+	0x58, 0xbb, 0xd9, 0xaa, 0x65, 0x52, 0x13, 0xbd, 0xc4, 0x45, 0xb2, 0x91, 0x22, 0x59, 0x2e, 0x92,
+	0x9a, 0x2c, 0x99, 0x25, 0x93, 0x49, 0xe4, 0xdc, 0xff, 0x3c, 0xe1, 0xd4, 0xa7, 0xba, 0xdb, 0xab,
+	0xca, 0x96, 0x5c, 0xb1, 0xf9, 0xd8, 0xf3, 0xf1, 0x7c, 0xa3, 0x9a, 0x52, 0x96, 0x34, 0xa3, 0xe8,
+	0x9b, 0x48, 0x2b, 0x4c, 0x2e, 0x57, 0x90, 0x6d, 0x12, 0x0c, 0x52, 0x4c, 0xcd, 0xf0, 0x5d, 0x08,
+	0xf7, 0x33, 0x60, 0xc1, 0xa8, 0xaa, 0x5c, 0xd2, 0x0c, 0x99, 0x6a, 0xa6, 0x3f, 0xf6, 0x78, 0xc9,
+	0x34, 0x4b, 0x3a, 0xc9, 0xc9, 0x55, 0x2d, 0x27, 0x1b, 0x86, 0x49, 0x59, 0xa7, 0xef, 0xe0, 0x1c,
+	0xef, 0x65, 0xad, 0x82, 0x53, 0xcc, 0xc9, 0xc6, 0xae, 0xdf, 0xe5, 0x19, 0x91, 0xbc, 0x00, 0x78,
+	0x0d, 0xde, 0x75, 0x2e, 0x1e, 0xac, 0xaa, 0x69, 0x6b, 0x21, 0x4f, 0xae, 0xc4, 0x93, 0xd2, 0x58,
+	0xa7, 0xb6, 0x43, 0x24, 0x8b, 0x28, 0xa6, 0xa5, 0x72, 0xe9, 0x0b, 0xf1, 0xa4, 0x75, 0xad, 0xa2,
+	0x51, 0xc9, 0xb4, 0x54, 0x62, 0x71, 0xc1, 0xc5, 0x78, 0x82, 0xea, 0xae, 0x21, 0x57, 0x34, 0x45,
+	0xb2, 0xab, 0x16, 0x91, 0x55, 0xa9, 0x28, 0x2b, 0xd4, 0xe4, 0x2a, 0xf0, 0x8f, 0x04, 0x98, 0xdc,
+	0xb4, 0x89, 0xb5, 0xc1, 0x01, 0xd9, 0x22, 0xd9, 0x76, 0x88, 0x4d, 0xd1, 0xab, 0xf0, 0x9c, 0xac,
+	0xaa, 0x16, 0xb1, 0xed, 0x59, 0xe1, 0xa4, 0x30, 0x9f, 0xcc, 0xa3, 0x7a, 0x2d, 0x33, 0xba, 0x2b,
+	0x57, 0xf4, 0x4b, 0x98, 0x77, 0x60, 0xd1, 0x1f, 0x82, 0x5e, 0x81, 0xe7, 0xaa, 0xa6, 0xa9, 0x4b,
+	0x9a, 0x3a, 0x9b, 0x38, 0x29, 0xcc, 0x1f, 0x0e, 0x8f, 0xe6, 0x1d, 0x58, 0x1c, 0x74, 0xff, 0x5b,
+	0x55, 0xd1, 0x0a, 0x40, 0x23, 0x97, 0xb3, 0x03, 0x27, 0x85, 0xf9, 0xa1, 0x33, 0xff, 0x9f, 0xe5,
+	0x69, 0x70, 0x13, 0x9f, 0xf5, 0x2a, 0x9a, 0xfb, 0x9f, 0xdd, 0x90, 0x4b, 0x84, 0xbb, 0x25, 0x86,
+	0x24, 0xf1, 0x2f, 0x04, 0x98, 0x6a, 0xf1, 0xdd, 0xae, 0x9a, 0x86, 0x4d, 0xd0, 0x1b, 0x90, 0xf4,
+	0x33, 0xe4, 0xba, 0x3f, 0x30, 0x3f, 0x74, 0xe6, 0x4a, 0x36, 0xd6, 0xcc, 0xc8, 0xae, 0x38, 0xba,
+	0xee, 0x2b, 0xcc, 0x5b, 0x44, 0x2e, 0xab, 0xe6, 0x03, 0x23, 0x7f, 0xf8, 0x49, 0x2d, 0x73, 0x48,
+	0x6c, 0x28, 0x45, 0x37, 0x9b, 0x30, 0x24, 0x18, 0x86, 0x97, 0xf7, 0xc4, 0xe0, 0xb9, 0xd7, 0x04,
+	0x62, 0x1d, 0x26, 0x02, 0x73, 0xbb, 0xab, 0xaa, 0x1f, 0xfe, 0x0b, 0x30, 0xe4, 0x1b, 0x73, 0x83,
+	0x2a, 0xb0, 0xa0, 0x4e, 0xd7, 0x6b, 0x19, 0xe4, 0x07, 0x35, 0xe8, 0xc4, 0x22, 0xf8, 0xad, 0x55,
+	0x15, 0xef, 0xc0, 0x64, 0xb3, 0x3e, 0x1e, 0x92, 0x2f, 0xc3, 0xf3, 0xfe, 0x28, 0xa6, 0xed, 0x60,
+	0x22, 0x12, 0xe8, 0xc4, 0x2b, 0x30, 0xb3, 0xee, 0x54, 0x36, 0x4c, 0x53, 0x6f, 0x2b, 0xa5, 0x50,
+	0x71, 0x08, 0x7b, 0x15, 0x07, 0xfe, 0x12, 0xcc, 0xb6, 0xeb, 0xe1, 0x18, 0xae, 0xc3, 0x68, 0x80,
+	0x5b, 0x31, 0x1d, 0x83, 0x72, 0x7d, 0x73, 0xf5, 0x5a, 0x66, 0xaa, 0x25, 0x2e, 0xac, 0x1f, 0x8b,
+	0x23, 0xfe, 0x87, 0x25, 0xd6, 0xfe, 0x3c, 0x0c, 0xbb, 0xaa, 0x03, 0xd7, 0x56, 0x22, 0xd2, 0xd8,
+	0x4f, 0x29, 0x7e, 0x43, 0x80, 0x11, 0xae, 0x98, 0xfb, 0x7a, 0x1e, 0x8e, 0xb8, 0x88, 0xfc, 0xf2,
+	0x9b, 0xcc, 0x7a, 0xcb, 0x51, 0xd6, 0x5f, 0x8e, 0xb2, 0x8b, 0xc6, 0x6e, 0x3e, 0xf9, 0xab, 0x1f,
+	0x9e, 0x3a, 0xe2, 0xca, 0xad, 0x8a, 0xde, 0xe8, 0x83, 0xab, 0xab, 0x31, 0x18, 0xd9, 0x60, 0xeb,
+	0x35, 0x77, 0x17, 0x6f, 0xc2, 0xa8, 0xff, 0x81, 0xbb, 0xb8, 0x04, 0x83, 0xde, 0x92, 0xce, 0x0b,
+	0xe2, 0xa5, 0x3d, 0x0a, 0xc2, 0x13, 0xe7, 0x99, 0xe7, 0xa2, 0xf8, 0xb1, 0x00, 0xe3, 0xf7, 0x35,
+	0xa5, 0xbc, 0xe6, 0x0f, 0x5b, 0x27, 0x14, 0xbd, 0x01, 0x23, 0x81, 0x98, 0x64, 0x10, 0xca, 0x97,
+	0x90, 0xcb, 0xae, 0xe4, 0x87, 0xb5, 0xcc, 0x31, 0x0f, 0x8f, 0xad, 0x96, 0xb3, 0x9a, 0x99, 0xab,
+	0xc8, 0x74, 0x2b, 0xbb, 0x46, 0x4a, 0xb2, 0xb2, 0xbb, 0x4c, 0x94, 0x7a, 0x2d, 0x33, 0xe9, 0xa5,
+	0xb2, 0x49, 0x03, 0x16, 0x87, 0xf5, 0xb0, 0x85, 0x73, 0x00, 0x7c, 0x6b, 0x51, 0xc9, 0x43, 0x16,
+	0xa7, 0x81, 0xfc, 0x54, 0xbd, 0x96, 0x39, 0xea, 0xc9, 0x36, 0xfa, 0xb0, 0x98, 0x74, 0x1b, 0xab,
+	0xec, 0xff, 0xbf, 0x0a, 0x30, 0x13, 0x38, 0xba, 0x4c, 0xaa, 0x74, 0xeb, 0x0b, 0x1a, 0xdd, 0x12,
+	0x65, 0xa3, 0x44, 0x50, 0x11, 0xc6, 0x1b, 0x16, 0xe5, 0x4a, 0x50, 0x5e, 0xfb, 0x74, 0x7b, 0x2c,
+	0x68, 0x2f, 0x32, 0x9d, 0xae, 0xe7, 0xba, 0xf9, 0x80, 0x58, 0x92, 0xeb, 0x56, 0xbb, 0xe7, 0x8d,
+	0x3e, 0x2c, 0x26, 0x59, 0xc3, 0x8d, 0xae, 0x2b, 0xe5, 0x54, 0xab, 0xbe, 0xd4, 0x40, 0xab, 0x54,
+	0xa3, 0x0f, 0x8b, 0x49, 0xd6, 0x70, 0xa5, 0xf0, 0x47, 0x09, 0x48, 0x87, 0x13, 0xb3, 0x6a, 0x2c,
+	0x6b, 0x16, 0x51, 0xdc, 0x02, 0xe9, 0x67, 0x72, 0xa2, 0x2c, 0x3c, 0x4f, 0xcd, 0x32, 0x31, 0x24,
+	0xcd, 0xab, 0xcd, 0x64, 0x7e, 0xa2, 0x5e, 0xcb, 0x8c, 0xf1, 0x98, 0xf3, 0x1e, 0x2c, 0x3e, 0xc7,
+	0xfe, 0x5d, 0x35, 0x5c, 0xaf, 0x6d, 0x2a, 0x5b, 0xb4, 0x83, 0xd7, 0x8d, 0x3e, 0x2c, 0x26, 0x59,
+	0x83, 0x61, 0xbd, 0x08, 0xc3, 0x8e, 0x4d, 0x24, 0xc5, 0xe1, 0x68, 0x0f, 0x9f, 0x14, 0xe6, 0x9f,
+	0xcf, 0xcf, 0xd4, 0x6b, 0x99, 0x09, 0x8e, 0x36, 0xd4, 0x8b, 0x45, 0x70, 0x6c, 0xb2, 0xe4, 0x04,
+	0x61, 0x2a, 0x98, 0x8e, 0xa1, 0x7a, 0x82, 0x47, 0x5a, 0x0d, 0x36, 0xfa, 0xb0, 0x98, 0x64, 0x8d,
+	0xb0, 0x41, 0xc3, 0x94, 0xd8, 0xb7, 0xd9, 0xc1, 0x28, 0x83, 0x7e, 0xaf, 0x67, 0x70, 0xdd, 0xcc,
+	0xb3, 0xc6, 0x7b, 0x03, 0x90, 0xe9, 0x18, 0x61, 0x3e, 0xcf, 0xb6, 0xc2, 0x95, 0xa5, 0xba, 0x55,
+	0xe7, 0xaf, 0x0a, 0x17, 0x62, 0x2e, 0xc1, 0xad, 0x13, 0x8c, 0xcf, 0xc1, 0x46, 0x6d, 0xb1, 0x5a,
+	0xb6, 0xd1, 0x0b, 0x30, 0xac, 0x38, 0x96, 0x45, 0x0c, 0x1a, 0xaa, 0x2e, 0x71, 0x88, 0x7f, 0x63,
+	0x58, 0x75, 0x38, 0xea, 0x0f, 0x09, 0xa4, 0x59, 0x66, 0x92, 0xf9, 0x6b, 0xf1, 0xea, 0x7c, 0xd6,
+	0x8b, 0x49, 0x9b, 0x16, 0x2c, 0x8e, 0xf3, 0x6f, 0x81, 0xab, 0xe8, 0x6d, 0x01, 0x90, 0x3f, 0xd0,
+	0xde, 0xb6, 0xa8, 0x54, 0xb5, 0x34, 0x85, 0xb0, 0x8c, 0x26, 0xf3, 0xf7, 0xb9, 0xbd, 0x5c, 0x49,
+	0xa3, 0x5b, 0x4e, 0x21, 0xab, 0x98, 0x95, 0x1c, 0x8f, 0xc7, 0x29, 0x5d, 0x2e, 0xd8, 0x7e, 0x83,
+	0xfd, 0x65, 0x6e, 0xe4, 0xb5, 0x92, 0xe7, 0xc3, 0x5c, 0xb3, 0x0f, 0x0d, 0xd5, 0x0d, 0x27, 0xee,
+	0x6d, 0x5b, 0x74, 0x83, 0x7d, 0xba, 0x05, 0xc7, 0x03, 0x8f, 0x36, 0xbc, 0x99, 0xc1, 0xa6, 0x7c,
+	0x5f, 0xfb, 0xd3, 0xcf, 0x04, 0x38, 0xd1, 0x41, 0x1b, 0x4f, 0x77, 0x01, 0x92, 0x8d, 0xc8, 0x7a,
+	0x79, 0xbe, 0x1a, 0x33, 0xcf, 0x1d, 0xd6, 0x26, 0xff, 0xf8, 0x11, 0x08, 0xa0, 0x4b, 0x30, 0x5c,
+	0x70, 0x94, 0x32, 0xa1, 0x4d, 0x0b, 0x60, 0xa8, 0x62, 0xc3, 0xbd, 0x58, 0x1c, 0xf2, 0x9a, 0xde,
+	0x22, 0xf8, 0x45, 0x38, 0xb1, 0xa4, 0xcb, 0x5a, 0x45, 0x2e, 0xe8, 0xe4, 0x1e, 0x3b, 0x12, 0x8a,
+	0xe4, 0x81, 0x6c, 0xa9, 0xf6, 0xbe, 0xcf, 0x1e, 0xdf, 0x11, 0x20, 0xdd, 0x49, 0x35, 0x0f, 0xce,
+	0x57, 0x61, 0x56, 0xf1, 0x47, 0xf8, 0x07, 0x52, 0xcb, 0x1b, 0xc3, 0x63, 0x35, 0xd7, 0xb4, 0xdb,
+	0xf9, 0x91, 0x59, 0x32, 0x35, 0x23, 0xff, 0xb2, 0x1b, 0x86, 0x7a, 0x2d, 0x93, 0xe1, 0xd9, 0xef,
+	0xa0, 0x08, 0x8b, 0xd3, 0x4a, 0xa4, 0x17, 0x78, 0x13, 0x52, 0x81, 0x7f, 0xab, 0xfe, 0x61, 0x7c,
+	0xff, 0xb8, 0xdf, 0x49, 0xc0, 0xb1, 0x48, 0xbd, 0x1c, 0xf4, 0x36, 0x4c, 0x36, 0x7c, 0x0d, 0x2e,
+	0x01, 0x31, 0x00, 0xbf, 0xc8, 0x01, 0x1f, 0x6b, 0x05, 0xdc, 0x50, 0x82, 0xc5, 0x09, 0xa5, 0xdd,
+	0xb4, 0x6b, 0xb2, 0x68, 0x5a, 0x45, 0xa2, 0x51, 0xa2, 0x86, 0x4d, 0x26, 0x7a, 0x34, 0x19, 0xa5,
+	0x04, 0x8b, 0x13, 0xc1, 0xe7, 0x86, 0x49, 0xbc, 0x06, 0x27, 0xdc, 0xa3, 0xcc, 0xa2, 0xa2, 0x38,
+	0x15, 0x47, 0x97, 0xa9, 0x69, 0xb5, 0xd4, 0x55, 0x4f, 0xf3, 0xec, 0xe7, 0x09, 0x48, 0x77, 0x52,
+	0xc7, 0xc3, 0xfa, 0xae, 0x00, 0xc7, 0x9a, 0x32, 0x2f, 0x95, 0x2c, 0xf3, 0x01, 0xdd, 0x92, 0x4a,
+	0xba, 0x59, 0x90, 0x75, 0x1e, 0xde, 0xe3, 0x91, 0x58, 0x97, 0x89, 0xc2, 0xe0, 0x9e, 0x75, 0xe1,
+	0x3e, 0xfe, 0x28, 0xf3, 0x4a, 0x68, 0x0d, 0xe2, 0x57, 0x50, 0xef, 0xcf, 0x29, 0x5b, 0x2d, 0xe7,
+	0xe8, 0x6e, 0x95, 0xd8, 0xbe, 0x8c, 0x2d, 0xce, 0xda, 0xa1, 0xaa, 0xba, 0xc9, 0x6c, 0xde, 0x64,
+	0x26, 0xd1, 0xd7, 0x04, 0x98, 0x74, 0xaa, 0x54, 0xab, 0x90, 0x16, 0x5f, 0xbc, 0xb8, 0x9f, 0x8b,
+	0xb9, 0x0e, 0x6c, 0x32, 0x15, 0xf7, 0x2d, 0x59, 0x29, 0x13, 0xab, 0x35, 0x25, 0x51, 0xfa, 0xb1,
+	0x88, 0xbc, 0xcf, 0x61, 0x6f, 0xf0, 0x3b, 0x02, 0xa4, 0xdd, 0xf5, 0x29, 0x14, 0x43, 0xae, 0xb3,
+	0xaf, 0x9c, 0xf4, 0x79, 0xe8, 0xfa, 0x38, 0x01, 0x99, 0x8e, 0x5e, 0xf0, 0x54, 0x3e, 0x11, 0xe0,
+	0x62, 0x64, 0x2a, 0xcd, 0x2a, 0x9b, 0x67, 0x44, 0x52, 0xfd, 0x6d, 0x55, 0x32, 0x8b, 0x92, 0x2e,
+	0xdb, 0x54, 0xa2, 0x96, 0xbc, 0x43, 0x2c, 0xfb, 0x93, 0x4c, 0xf4, 0x99, 0xf6, 0x44, 0xdf, 0xe1,
+	0x0e, 0x05, 0xdb, 0xfc, 0x9d, 0xe2, 0x9a, 0x6c, 0xd3, 0xfb, 0xbe, 0x33, 0xe8, 0x11, 0x8c, 0xf1,
+	0x0c, 0x51, 0x8e, 0x72, 0x5f, 0xc9, 0x4f, 0xf3, 0xe4, 0x4f, 0x37, 0x25, 0xdf, 0x57, 0x8d, 0xc5,
+	0x51, 0x27, 0x3c, 0xdc, 0xc6, 0x5f, 0x17, 0x60, 0x26, 0x98, 0x94, 0x22, 0xa3, 0x19, 0xfa, 0x4b,
+	0xf6, 0x41, 0x5d, 0x8d, 0xde, 0x17, 0x60, 0xb6, 0xdd, 0x21, 0x9e, 0x77, 0x0d, 0x8e, 0xb6, 0x92,
+	0x22, 0xfe, 0xb2, 0xf8, 0x99, 0x98, 0xe1, 0x6a, 0xd1, 0xcd, 0xf7, 0xca, 0x71, 0xad, 0xc5, 0xe4,
+	0xc1, 0xdd, 0xac, 0xde, 0x12, 0xe0, 0x95, 0xa5, 0x95, 0xdb, 0xb7, 0xd9, 0xbd, 0x4d, 0x5d, 0xd3,
+	0x8c, 0xf2, 0x8a, 0x65, 0x56, 0x96, 0x42, 0x4e, 0x7a, 0x3d, 0x7e, 0xd4, 0xef, 0xc2, 0x64, 0x18,
+	0x81, 0xd4, 0x9c, 0x82, 0x4c, 0x68, 0x79, 0x8f, 0x18, 0x85, 0x45, 0xa4, 0xb4, 0x69, 0xc6, 0x1a,
+	0xbc, 0x1a, 0xcf, 0x03, 0x1e, 0xe6, 0x8b, 0x30, 0xac, 0x14, 0x2b, 0x95, 0x16, 0xd3, 0xa1, 0xe3,
+	0x42, 0xb8, 0x17, 0x8b, 0xe0, 0x36, 0xb9, 0xa9, 0xdb, 0x70, 0x62, 0xd3, 0x26, 0xd6, 0xa6, 0x51,
+	0x30, 0x0d, 0x55, 0x33, 0x4a, 0xfb, 0x23, 0x8a, 0xf0, 0xf7, 0x04, 0x48, 0x77, 0xd2, 0xc7, 0x9d,
+	0x7d, 0x4b, 0x80, 0x54, 0x40, 0xb4, 0x48, 0x0f, 0x34, 0xba, 0x25, 0x55, 0x89, 0xa5, 0x99, 0xaa,
+	0xa4, 0x9b, 0x4a, 0x99, 0x57, 0xc7, 0x42, 0xcc, 0xea, 0xf0, 0xd5, 0xbb, 0x67, 0xa9, 0x0d, 0xa6,
+	0x65, 0xcd, 0x54, 0xca, 0xbc, 0x48, 0x66, 0x02, 0x33, 0xcd, 0xdd, 0x38, 0x05, 0xb3, 0x37, 0x09,
+	0xbd, 0x6f, 0x52, 0x59, 0x0f, 0x8e, 0x64, 0xfe, 0x3d, 0xfa, 0x9b, 0x02, 0xcc, 0x45, 0x74, 0x72,
+	0xe7, 0x29, 0x8c, 0x51, 0xb7, 0x47, 0x6a, 0x3d, 0x02, 0x76, 0xd9, 0x72, 0x3f, 0xcd, 0x97, 0xa6,
+	0xf9, 0x18, 0x4b, 0x93, 0xb7, 0x2e, 0x8d, 0xd2, 0x26, 0xeb, 0xb8, 0x2e, 0x40, 0x7a, 0xdd, 0xa9,
+	0xac, 0x93, 0x87, 0x74, 0xd5, 0xd0, 0xa8, 0x26, 0xeb, 0xda, 0x57, 0x08, 0xbb, 0xdb, 0xf4, 0x37,
+	0xf7, 0xaf, 0xc1, 0xa8, 0x7f, 0x9b, 0x93, 0x54, 0x62, 0x98, 0x15, 0x7e, 0xdb, 0x0b, 0x11, 0x2d,
+	0xcd, 0xfd, 0x58, 0x1c, 0xe6, 0x77, 0xbe, 0x65, 0xb7, 0x89, 0x0a, 0x90, 0x32, 0x9c, 0x8a, 0x64,
+	0x90, 0x87, 0xee, 0x19, 0x34, 0xf0, 0x88, 0xdd, 0x4a, 0x6c, 0x76, 0xdd, 0x38, 0x9c, 0x7f, 0xa9,
+	0x5e, 0xcb, 0xbc, 0xe0, 0x29, 0xeb, 0x3c, 0x16, 0x8b, 0x33, 0x46, 0x34, 0x30, 0xfc, 0xed, 0x04,
+	0x64, 0x3a, 0x82, 0xfe, 0x9f, 0xbf, 0x7a, 0xe1, 0xef, 0x26, 0x60, 0xda, 0x9d, 0x69, 0x6b, 0x5a,
+	0x45, 0xa3, 0x77, 0x2c, 0x35, 0xb4, 0xe9, 0x7f, 0x82, 0xdc, 0x6e, 0x01, 0x06, 0x6d, 0x2a, 0x53,
+	0xc7, 0x4b, 0xf2, 0x68, 0xec, 0x30, 0x37, 0xbc, 0xbc, 0xc7, 0xc4, 0xf3, 0x47, 0xeb, 0xb5, 0xcc,
+	0x48, 0x40, 0x13, 0x50, 0xc7, 0xc6, 0x22, 0xd7, 0xdc, 0xb2, 0x33, 0x1d, 0xee, 0x7b, 0x67, 0xfa,
+	0xa9, 0x00, 0x33, 0x6d, 0x11, 0xe2, 0x85, 0xf3, 0x3a, 0x0c, 0x87, 0xf8, 0x76, 0xbf, 0x68, 0x4e,
+	0xf7, 0x8c, 0x86, 0x97, 0xcb, 0x90, 0xde, 0xb0, 0x71, 0x70, 0x3b, 0xd1, 0xdf, 0x05, 0x98, 0x76,
+	0x97, 0xe9, 0x88, 0x14, 0xf7, 0x34, 0xdd, 0x1b, 0x49, 0x4b, 0xfc, 0x87, 0x92, 0x36, 0xb0, 0xaf,
+	0xa4, 0xb5, 0x61, 0xfe, 0x6f, 0x4a, 0xda, 0x2d, 0x38, 0x7e, 0xa3, 0x58, 0x74, 0xcf, 0x8e, 0x3b,
+	0xfc, 0x76, 0xba, 0xc2, 0x1e, 0x64, 0xfa, 0xba, 0x25, 0xfd, 0x64, 0x00, 0x4e, 0x74, 0xd0, 0xc6,
+	0x63, 0x62, 0x00, 0x72, 0xbd, 0x6b, 0x7e, 0xfc, 0xe1, 0xd3, 0xfe, 0x7a, 0xbc, 0x55, 0x87, 0x93,
+	0x2d, 0xed, 0x6a, 0xb0, 0x38, 0xee, 0x7e, 0x0c, 0xdb, 0x45, 0x8f, 0x60, 0x86, 0xf8, 0x0e, 0xb5,
+	0x18, 0xf5, 0xf6, 0x90, 0x1b, 0xf1, 0x8c, 0xa6, 0x3d, 0xa3, 0x1d, 0x74, 0x61, 0x71, 0x8a, 0x44,
+	0xc1, 0x46, 0x8f, 0x05, 0x38, 0x1e, 0xf9, 0xde, 0x25, 0x29, 0xa6, 0x51, 0xd4, 0x4a, 0xbc, 0xf2,
+	0xae, 0xc7, 0xac, 0x89, 0x65, 0x4f, 0x55, 0xd8, 0xc4, 0x12, 0xd3, 0x93, 0x7f, 0xb9, 0x5e, 0xcb,
+	0xbc, 0xe8, 0xf9, 0xd8, 0xcd, 0x1e, 0x16, 0xe7, 0xd4, 0x4e, 0x3a, 0xce, 0xbc, 0x77, 0x12, 0x8e,
+	0xdc, 0x75, 0xab, 0x06, 0x7d, 0x5f, 0x00, 0xf6, 0x0e, 0x60, 0xa3, 0xb3, 0xb1, 0x0f, 0x36, 0x8d,
+	0x67, 0x8c, 0xd4, 0xb9, 0xde, 0x84, 0xbc, 0xd2, 0xc0, 0xe7, 0xde, 0xfe, 0xf5, 0x9f, 0xbe, 0x95,
+	0xc8, 0xa2, 0x57, 0x73, 0x71, 0x1f, 0x3d, 0x5d, 0x07, 0x7f, 0x20, 0xc0, 0xa0, 0xf7, 0x12, 0x80,
+	0x62, 0x9b, 0x0d, 0x3f, 0x44, 0xa4, 0xce, 0xf7, 0x28, 0xc5, 0xbd, 0x3d, 0xcf, 0xbc, 0xcd, 0xa1,
+	0x53, 0x71, 0xbd, 0xf5, 0x7c, 0x7c, 0x5f, 0x80, 0x91, 0xa6, 0x47, 0x42, 0x74, 0x39, 0xee, 0x3d,
+	0x2c, 0xe2, 0x59, 0x34, 0x75, 0xa5, 0x3f, 0x61, 0x8e, 0x21, 0xcf, 0x30, 0x5c, 0x41, 0x97, 0x72,
+	0xbd, 0x3d, 0x33, 0xdb, 0xb9, 0x37, 0xf9, 0x6e, 0xfc, 0x08, 0x7d, 0x2c, 0xc0, 0x54, 0x24, 0x01,
+	0x89, 0x96, 0x7a, 0x65, 0x19, 0x23, 0xc8, 0xd0, 0xd4, 0xf2, 0xfe, 0x94, 0x70, 0xa0, 0x37, 0x19,
+	0xd0, 0x45, 0x74, 0x2d, 0x17, 0xf7, 0x6d, 0xdb, 0x3f, 0xa4, 0xf9, 0xef, 0x18, 0x92, 0xc5, 0x30,
+	0xfd, 0x23, 0xfc, 0x62, 0xd3, 0xcc, 0xaf, 0xa3, 0x1b, 0xbd, 0xba, 0x1a, 0xf9, 0x02, 0x92, 0x5a,
+	0xd9, 0xaf, 0x1a, 0x8e, 0x79, 0x95, 0x61, 0x5e, 0x42, 0x8b, 0x3d, 0x63, 0x36, 0x18, 0x53, 0xdb,
+	0xa0, 0x38, 0xd0, 0xdf, 0x04, 0x98, 0x8e, 0x26, 0x52, 0x51, 0xdc, 0xfc, 0x74, 0xa5, 0x78, 0x53,
+	0x37, 0xf6, 0xa9, 0xa5, 0xcf, 0x34, 0x77, 0x62, 0x6c, 0xd1, 0x1f, 0x05, 0x98, 0x88, 0x60, 0x50,
+	0xd1, 0x62, 0xaf, 0x7e, 0xb6, 0xb1, 0xba, 0xa9, 0xfc, 0x7e, 0x54, 0x70, 0x9c, 0x4b, 0x0c, 0xe7,
+	0x02, 0xba, 0xdc, 0x33, 0xce, 0x06, 0x6b, 0x8a, 0x7e, 0x29, 0xc0, 0x70, 0xf8, 0x69, 0x1e, 0x5d,
+	0xea, 0xf1, 0x0e, 0x1b, 0xfa, 0x7d, 0x40, 0xea, 0x72, 0x5f, 0xb2, 0x1c, 0xce, 0x02, 0x83, 0x73,
+	0x01, 0x9d, 0xef, 0x71, 0x19, 0x92, 0x0a, 0xbb, 0x92, 0xa6, 0xa2, 0x3f, 0xf3, 0x63, 0x67, 0x3b,
+	0x35, 0x1b, 0xbb, 0x3a, 0xbb, 0x12, 0xc5, 0xb1, 0xab, 0xb3, 0x3b, 0x3f, 0x8c, 0x17, 0x19, 0xcc,
+	0xcb, 0xe8, 0x62, 0x0f, 0xfb, 0x9b, 0x24, 0xbb, 0xfa, 0x82, 0xba, 0xfc, 0x8d, 0x00, 0xe3, 0xad,
+	0xe4, 0x15, 0xba, 0xda, 0x1f, 0x33, 0x15, 0xc0, 0xbb, 0xd6, 0xb7, 0x3c, 0x07, 0x76, 0x9d, 0x01,
+	0xbb, 0x84, 0x3e, 0x9b, 0xeb, 0xef, 0x77, 0x47, 0x36, 0xfa, 0x8b, 0x00, 0x33, 0x1d, 0x38, 0xd9,
+	0xd8, 0xcb, 0x6a, 0x77, 0x66, 0x39, 0xf6, 0xb2, 0xba, 0x07, 0x35, 0xdc, 0xf3, 0x9e, 0xc9, 0x36,
+	0x0f, 0x2f, 0x8b, 0x3e, 0x4b, 0x8a, 0x7e, 0x9c, 0x80, 0xff, 0x8b, 0x43, 0x98, 0x21, 0x31, 0xee,
+	0x62, 0x11, 0x9f, 0xff, 0x4b, 0xdd, 0x3b, 0x50, 0x9d, 0x3c, 0x2a, 0x1a, 0x8b, 0x8a, 0x82, 0xe4,
+	0xb8, 0x2b, 0x52, 0x88, 0xe0, 0x93, 0x74, 0xcd, 0x28, 0x4b, 0x45, 0xcb, 0xac, 0x48, 0x61, 0xa1,
+	0xdc, 0x9b, 0x51, 0x04, 0xe4, 0x23, 0xf4, 0x2f, 0xc1, 0x23, 0x12, 0xda, 0x29, 0xbb, 0xd8, 0xd3,
+	0xbd, 0x2b, 0x83, 0x18, 0x7b, 0xba, 0x77, 0xe7, 0x0d, 0xf1, 0x5d, 0x16, 0x92, 0x5b, 0x68, 0x35,
+	0x66, 0x48, 0x1c, 0x9b, 0x58, 0x92, 0xe3, 0xeb, 0x93, 0xa2, 0xce, 0x5a, 0x1f, 0x0a, 0x70, 0xb4,
+	0x8d, 0xeb, 0x43, 0x71, 0xe7, 0x6f, 0x27, 0x0a, 0x31, 0x75, 0xbd, 0x7f, 0x05, 0x7d, 0x4e, 0x8a,
+	0x12, 0xa1, 0x52, 0x0b, 0x2f, 0xc9, 0x8e, 0x56, 0x1d, 0xf8, 0xb3, 0xd8, 0x6b, 0x40, 0x77, 0xd2,
+	0x31, 0xf6, 0x1a, 0xb0, 0x07, 0x8d, 0xd7, 0xf3, 0xd1, 0xaa, 0x33, 0x9f, 0x88, 0x7e, 0x2b, 0xc0,
+	0x58, 0x0b, 0xe9, 0x83, 0x16, 0x7a, 0x28, 0xc0, 0x76, 0xae, 0x25, 0x75, 0xb5, 0x5f, 0x71, 0x8e,
+	0xee, 0x06, 0x43, 0x77, 0x0d, 0x2d, 0xe4, 0x7a, 0xfe, 0x21, 0x68, 0xb8, 0x58, 0x7f, 0x2f, 0xc0,
+	0x58, 0x0b, 0x33, 0x82, 0x16, 0x7a, 0xd8, 0x49, 0xf7, 0x81, 0xac, 0x03, 0x21, 0x83, 0x5f, 0x63,
+	0xc8, 0x96, 0x51, 0xbe, 0x97, 0x1d, 0xb8, 0x19, 0x5e, 0xb0, 0x0c, 0xfd, 0x53, 0x80, 0xa9, 0x48,
+	0xaa, 0x23, 0xf6, 0xbd, 0xa7, 0x1b, 0xed, 0x12, 0xfb, 0xde, 0xd3, 0x95, 0x6d, 0xc1, 0x1b, 0x0c,
+	0xf0, 0x6b, 0xe8, 0x73, 0x31, 0x01, 0x77, 0xa0, 0x37, 0x1a, 0xb0, 0xf3, 0x5b, 0x4f, 0x9e, 0xa6,
+	0x85, 0x0f, 0x9e, 0xa6, 0x85, 0x3f, 0x3c, 0x4d, 0x0b, 0xef, 0x3e, 0x4b, 0x1f, 0xfa, 0xe0, 0x59,
+	0xfa, 0xd0, 0xef, 0x9e, 0xa5, 0x0f, 0xbd, 0xbe, 0xbe, 0xd7, 0xcf, 0x66, 0x76, 0xce, 0x9e, 0xce,
+	0x3d, 0x6c, 0x72, 0xe0, 0x54, 0xc3, 0x03, 0x45, 0xd7, 0x88, 0x41, 0xbd, 0x9f, 0x58, 0x7b, 0xbf,
+	0x49, 0x1c, 0x64, 0x7f, 0xce, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xec, 0xd2, 0xe2, 0x76,
+	0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1962,6 +2323,16 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// UserLimitOrders returns the limit orders of the given address with the
+	// given status, optionally filtered by pool.
+	UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error)
+	// PoolLimitOrders returns the limit orders in the given pool with the
+	// given status.
+	PoolLimitOrders(ctx context.Context, in *PoolLimitOrdersRequest, opts ...grpc.CallOption) (*PoolLimitOrdersResponse, error)
+	// EffectiveSpreadFactor returns the spread factor a swap in the given pool
+	// that does not move its price would be charged at the current block, along
+	// with the pool's dynamic spread factor configuration if it has one.
+	EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error)
 }
 
 type queryClient struct {
@@ -2107,6 +2478,33 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error) {
+	out := new(UserLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolLimitOrders(ctx context.Context, in *PoolLimitOrdersRequest, opts ...grpc.CallOption) (*PoolLimitOrdersResponse, error) {
+	out := new(PoolLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error) {
+	out := new(EffectiveSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2149,6 +2547,16 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// UserLimitOrders returns the limit orders of the given address with the
+	// given status, optionally filtered by pool.
+	UserLimitOrders(context.Context, *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error)
+	// PoolLimitOrders returns the limit orders in the given pool with the
+	// given status.
+	PoolLimitOrders(context.Context, *PoolLimitOrdersRequest) (*PoolLimitOrdersResponse, error)
+	// EffectiveSpreadFactor returns the spread factor a swap in the given pool
+	// that does not move its price would be charged at the current block, along
+	// with the pool's dynamic spread factor configuration if it has one.
+	EffectiveSpreadFactor(context.Context, *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2200,6 +2608,15 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) UserLimitOrders(ctx context.Context, req *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) PoolLimitOrders(ctx context.Context, req *PoolLimitOrdersRequest) (*PoolLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveSpreadFactor(ctx context.Context, req *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSpreadFactor not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2475,42 +2892,97 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "LiquidityPerTickRange",
-			Handler:    _Query_LiquidityPerTickRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableSpreadRewards",
-			Handler:    _Query_ClaimableSpreadRewards_Handler,
-		},
-		{
-			MethodName: "ClaimableIncentives",
-			Handler:    _Query_ClaimableIncentives_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
+func _Query_UserLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserLimitOrders(ctx, req.(*UserLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolLimitOrders(ctx, req.(*PoolLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, req.(*EffectiveSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRange",
+			Handler:    _Query_LiquidityPerTickRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableSpreadRewards",
+			Handler:    _Query_ClaimableSpreadRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
 		{
 			MethodName: "PoolAccumulatorRewards",
 			Handler:    _Query_PoolAccumulatorRewards_Handler,
@@ -2539,6 +3011,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "UserLimitOrders",
+			Handler:    _Query_UserLimitOrders_Handler,
+		},
+		{
+			MethodName: "PoolLimitOrders",
+			Handler:    _Query_PoolLimitOrders_Handler,
+		},
+		{
+			MethodName: "EffectiveSpreadFactor",
+			Handler:    _Query_EffectiveSpreadFactor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3846,107 +4330,385 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UserLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UserLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (m *PoolLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *PoolLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NumPoolPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NumPoolPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionCount != 0 {
-		n += 1 + sovQuery(uint64(m.PositionCount))
+func (m *PoolLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSpreadFactorConfig != nil {
+		{
+			size, err := m.DynamicSpreadFactorConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.EffectiveSpreadFactor.Size()
+		i -= size
+		if _, err := m.EffectiveSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseSpreadFactor.Size()
+		i -= size
+		if _, err := m.BaseSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *NumPoolPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *NumPoolPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionCount != 0 {
+		n += 1 + sovQuery(uint64(m.PositionCount))
+	}
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Pagination != nil {
@@ -4385,33 +5147,142 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UserLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *UserLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicSpreadFactorConfig != nil {
+		l = m.DynamicSpreadFactorConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
@@ -7677,6 +8548,749 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UserLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.LimitOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.LimitOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveSpreadFactorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveSpreadFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSpreadFactorConfig == nil {
+				m.DynamicSpreadFactorConfig = &types1.DynamicSpreadFactorConfig{}
+			}
+			if err := m.DynamicSpreadFactorConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
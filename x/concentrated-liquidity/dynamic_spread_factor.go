@@ -0,0 +1,173 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v31/x/twap/types"
+)
+
+// GetDynamicSpreadFactorConfig returns the dynamic spread factor config of the given pool.
+// Returns false if the pool has not opted into the dynamic spread factor.
+func (k Keeper) GetDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorConfig, bool, error) {
+	config := types.DynamicSpreadFactorConfig{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorConfig(poolId), &config)
+	if err != nil || !found {
+		return types.DynamicSpreadFactorConfig{}, false, err
+	}
+	return config, true, nil
+}
+
+// SetDynamicSpreadFactorConfigs opts the pools of the given configs into the dynamic spread factor,
+// or updates their existing configs. Configs with a zero volatility multiplier opt their pool out.
+// Returns an error if a pool does not exist or if a max spread factor is below the pool's spread factor.
+func (k Keeper) SetDynamicSpreadFactorConfigs(ctx sdk.Context, configs []types.DynamicSpreadFactorConfig) error {
+	for _, config := range configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		pool, err := k.getPoolById(ctx, config.PoolId)
+		if err != nil {
+			return err
+		}
+
+		if !config.IsEnabled() {
+			k.deleteDynamicSpreadFactorConfig(ctx, config.PoolId)
+			continue
+		}
+
+		poolSpreadFactor := pool.GetSpreadFactor(ctx)
+		if config.MaxSpreadFactor.LT(poolSpreadFactor) {
+			return types.MaxSpreadFactorBelowPoolSpreadFactorError{PoolId: config.PoolId, MaxSpreadFactor: config.MaxSpreadFactor, PoolSpreadFactor: poolSpreadFactor}
+		}
+
+		k.setDynamicSpreadFactorConfig(ctx, config)
+	}
+	return nil
+}
+
+// setDynamicSpreadFactorConfig stores the given config under its pool id.
+func (k Keeper) setDynamicSpreadFactorConfig(ctx sdk.Context, config types.DynamicSpreadFactorConfig) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorConfig(config.PoolId), &config)
+}
+
+// deleteDynamicSpreadFactorConfig removes the config of the given pool, if any.
+func (k Keeper) deleteDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactorConfig(poolId))
+}
+
+// getAllDynamicSpreadFactorConfigs returns the dynamic spread factor configs of all pools.
+func (k Keeper) getAllDynamicSpreadFactorConfigs(ctx sdk.Context) ([]types.DynamicSpreadFactorConfig, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorConfigPrefix, parseDynamicSpreadFactorConfigFromBz)
+}
+
+// parseDynamicSpreadFactorConfigFromBz parses and returns a dynamic spread factor config from a byte array.
+func parseDynamicSpreadFactorConfigFromBz(bz []byte) (types.DynamicSpreadFactorConfig, error) {
+	config := types.DynamicSpreadFactorConfig{}
+	if err := config.Unmarshal(bz); err != nil {
+		return types.DynamicSpreadFactorConfig{}, err
+	}
+	return config, nil
+}
+
+// GetEffectiveSpreadFactor returns the spread factor charged at the current block on swaps in the given pool
+// that do not move its price. Swaps that move the price can be charged more, see getSwapSpreadFactor.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension) osmomath.Dec {
+	return k.getEffectiveSpreadFactor(ctx, pool, pool.GetSpreadFactor(ctx))
+}
+
+// getEffectiveSpreadFactor returns the spread factor to charge in the given pool, without accounting for
+// the price move of a swap. For pools that have not opted into the dynamic spread factor, this is the
+// base spread factor. Otherwise, the base spread factor is increased by the volatility multiplier times
+// the realized volatility of the pool's spot price over the configured window, capped at the config's
+// max spread factor. The effective spread factor is never below the base spread factor.
+// If the volatility cannot be measured, for example because the pool is younger than the TWAP window,
+// the base spread factor is returned.
+func (k Keeper) getEffectiveSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, baseSpreadFactor osmomath.Dec) osmomath.Dec {
+	config, found := k.getEnabledDynamicSpreadFactorConfig(ctx, pool.GetId(), baseSpreadFactor)
+	if !found {
+		return baseSpreadFactor
+	}
+
+	volatility, err := k.getRecentVolatility(ctx, pool, config)
+	if err != nil {
+		ctx.Logger().Debug("failed to measure pool volatility, using base spread factor", "pool_id", pool.GetId(), "error", err)
+		return baseSpreadFactor
+	}
+
+	return config.SpreadFactorAt(baseSpreadFactor, volatility)
+}
+
+// getSwapSpreadFactor returns the spread factor to charge on a swap in the given pool.
+// For pools that have opted into the dynamic spread factor, the volatility is the larger of the realized
+// volatility over the configured window and the absolute log return of the swap's own price move, so that
+// a swap moving the price away pays for it, rather than only the swaps after it.
+// The price move is estimated by computeSwap, which computes the swap at the given spread factor and is
+// called on a cache context with the base spread factor. It is only called for pools using a dynamic
+// spread factor. If the price move cannot be estimated, only the realized volatility is accounted for.
+func (k Keeper) getSwapSpreadFactor(ctx sdk.Context, poolId uint64, baseSpreadFactor osmomath.Dec, computeSwap func(ctx sdk.Context, spreadFactor osmomath.Dec) (PoolUpdates, error)) osmomath.Dec {
+	config, found := k.getEnabledDynamicSpreadFactorConfig(ctx, poolId, baseSpreadFactor)
+	if !found {
+		return baseSpreadFactor
+	}
+
+	pool, err := k.getPoolForSwap(ctx, poolId)
+	if err != nil {
+		return baseSpreadFactor
+	}
+
+	volatility, err := k.getRecentVolatility(ctx, pool, config)
+	if err != nil {
+		ctx.Logger().Debug("failed to measure pool volatility, using base spread factor", "pool_id", poolId, "error", err)
+		return baseSpreadFactor
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	poolUpdates, err := computeSwap(cacheCtx, baseSpreadFactor)
+	if err != nil {
+		ctx.Logger().Debug("failed to estimate the price move of the swap", "pool_id", poolId, "error", err)
+		return config.SpreadFactorAt(baseSpreadFactor, volatility)
+	}
+	swapLogReturn, err := sqrtPriceLogReturn(pool.GetCurrentSqrtPrice(), poolUpdates.NewSqrtPrice)
+	if err != nil {
+		ctx.Logger().Debug("failed to estimate the price move of the swap", "pool_id", poolId, "error", err)
+		return config.SpreadFactorAt(baseSpreadFactor, volatility)
+	}
+
+	return config.SpreadFactorAt(baseSpreadFactor, osmomath.MaxDec(volatility, swapLogReturn.Abs()))
+}
+
+// getEnabledDynamicSpreadFactorConfig returns the dynamic spread factor config of the given pool.
+// Returns false if the pool has not opted into the dynamic spread factor, or if the config can not
+// charge more than the base spread factor.
+func (k Keeper) getEnabledDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64, baseSpreadFactor osmomath.Dec) (types.DynamicSpreadFactorConfig, bool) {
+	config, found, err := k.GetDynamicSpreadFactorConfig(ctx, poolId)
+	if err != nil || !found || !config.IsEnabled() || k.twapKeeper == nil {
+		return types.DynamicSpreadFactorConfig{}, false
+	}
+	if config.MaxSpreadFactor.LTE(baseSpreadFactor) {
+		return types.DynamicSpreadFactorConfig{}, false
+	}
+	return config, true
+}
+
+// getRecentVolatility returns the realized volatility of the pool's spot price over the config's window,
+// i.e. the standard deviation of the log returns of the spot price between the blocks it changed in.
+func (k Keeper) getRecentVolatility(ctx sdk.Context, pool types.ConcentratedPoolExtension, config types.DynamicSpreadFactorConfig) (osmomath.Dec, error) {
+	return k.twapKeeper.GetRealizedVolatility(ctx, pool.GetId(), pool.GetToken0(), pool.GetToken1(), ctx.BlockTime().Add(-config.TwapWindow), ctx.BlockTime())
+}
+
+// sqrtPriceLogReturn returns the natural log return of the price when the sqrt price moves
+// from sqrtPriceBefore to sqrtPriceAfter, i.e. ln(P_after / P_before) = 2 ln(sqrtP_after / sqrtP_before).
+func sqrtPriceLogReturn(sqrtPriceBefore, sqrtPriceAfter osmomath.BigDec) (osmomath.Dec, error) {
+	if !sqrtPriceBefore.IsPositive() || !sqrtPriceAfter.IsPositive() {
+		return osmomath.Dec{}, fmt.Errorf("sqrt prices must be positive, got %s and %s", sqrtPriceBefore, sqrtPriceAfter)
+	}
+	// log returns are in base 2, ln(x) = log_{2}{x} * ln(2)
+	return sqrtPriceAfter.Quo(sqrtPriceBefore).LogBase2().Dec().MulInt64(2).Mul(twaptypes.Ln2), nil
+}
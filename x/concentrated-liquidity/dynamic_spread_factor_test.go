@@ -0,0 +1,276 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v31/x/gamm/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v31/x/twap/types"
+)
+
+var (
+	dynamicSpreadFactorBase       = osmomath.NewDecWithPrec(3, 3)
+	dynamicSpreadFactorMax        = osmomath.NewDecWithPrec(5, 2)
+	dynamicSpreadFactorWindow     = time.Hour
+	dynamicSpreadFactorSwapAmount = sdk.NewCoin(ETH, osmomath.NewInt(10_000))
+)
+
+func defaultDynamicSpreadFactorConfig(poolId uint64) types.DynamicSpreadFactorConfig {
+	return types.DynamicSpreadFactorConfig{
+		PoolId:               poolId,
+		VolatilityMultiplier: osmomath.NewDecWithPrec(5, 1),
+		MaxSpreadFactor:      dynamicSpreadFactorMax,
+		TwapWindow:           dynamicSpreadFactorWindow,
+	}
+}
+
+// setupDynamicSpreadFactorPool creates a pool with a full range position and records its price in twap
+// in the next block, since twap flags the pool creation block as erroneous for lack of liquidity.
+// It then moves the block time past the default TWAP window, so that the pool has a price history.
+func (s *KeeperTestSuite) setupDynamicSpreadFactorPool() types.ConcentratedPoolExtension {
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, dynamicSpreadFactorBase)
+	s.CreateFullRangePosition(pool, DefaultCoins)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(2 * dynamicSpreadFactorWindow))
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *KeeperTestSuite) TestSetDynamicSpreadFactorConfigs() {
+	tests := map[string]struct {
+		config        func(poolId uint64) types.DynamicSpreadFactorConfig
+		expectedFound bool
+		expectedError error
+	}{
+		"valid config": {
+			config:        defaultDynamicSpreadFactorConfig,
+			expectedFound: true,
+		},
+		"max spread factor equal to the pool's spread factor": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				config := defaultDynamicSpreadFactorConfig(poolId)
+				config.MaxSpreadFactor = dynamicSpreadFactorBase
+				return config
+			},
+			expectedFound: true,
+		},
+		"zero volatility multiplier opts the pool out": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				return types.DynamicSpreadFactorConfig{PoolId: poolId, VolatilityMultiplier: osmomath.ZeroDec()}
+			},
+			expectedFound: false,
+		},
+		"error: pool does not exist": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				return defaultDynamicSpreadFactorConfig(poolId + 1)
+			},
+			expectedError: types.PoolNotFoundError{PoolId: 2},
+		},
+		"error: max spread factor below the pool's spread factor": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				config := defaultDynamicSpreadFactorConfig(poolId)
+				config.MaxSpreadFactor = osmomath.NewDecWithPrec(1, 3)
+				return config
+			},
+			expectedError: types.MaxSpreadFactorBelowPoolSpreadFactorError{PoolId: 1, MaxSpreadFactor: osmomath.NewDecWithPrec(1, 3), PoolSpreadFactor: dynamicSpreadFactorBase},
+		},
+		"error: zero twap window": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				config := defaultDynamicSpreadFactorConfig(poolId)
+				config.TwapWindow = 0
+				return config
+			},
+			expectedError: types.InvalidDynamicSpreadFactorConfigError{PoolId: 1, Reason: "twap window must be positive"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, dynamicSpreadFactorBase)
+
+			// every case starts from an opted in pool so that opting out can be observed
+			err := s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactorConfigs(s.Ctx, []types.DynamicSpreadFactorConfig{defaultDynamicSpreadFactorConfig(pool.GetId())})
+			s.Require().NoError(err)
+
+			config := tc.config(pool.GetId())
+			err = s.App.ConcentratedLiquidityKeeper.SetDynamicSpreadFactorConfigs(s.Ctx, []types.DynamicSpreadFactorConfig{config})
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			storedConfig, found, err := s.App.ConcentratedLiquidityKeeper.GetDynamicSpreadFactorConfig(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedFound, found)
+			if tc.expectedFound {
+				s.Require().Equal(config, storedConfig)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetEffectiveSpreadFactor() {
+	tests := map[string]struct {
+		config      func(poolId uint64) types.DynamicSpreadFactorConfig
+		whipsaw     bool
+		expectedMax bool
+		expectBase  bool
+	}{
+		"no config": {
+			whipsaw:    true,
+			expectBase: true,
+		},
+		"price did not move": {
+			config:     defaultDynamicSpreadFactorConfig,
+			expectBase: true,
+		},
+		"price moved back and forth": {
+			config:  defaultDynamicSpreadFactorConfig,
+			whipsaw: true,
+		},
+		"price moved back and forth, capped at the max spread factor": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				config := defaultDynamicSpreadFactorConfig(poolId)
+				config.VolatilityMultiplier = osmomath.NewDec(100)
+				return config
+			},
+			whipsaw:     true,
+			expectedMax: true,
+		},
+		"twap window longer than the pool's history falls back to the base spread factor": {
+			config: func(poolId uint64) types.DynamicSpreadFactorConfig {
+				config := defaultDynamicSpreadFactorConfig(poolId)
+				config.TwapWindow = 1000 * dynamicSpreadFactorWindow
+				return config
+			},
+			whipsaw:    true,
+			expectBase: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.setupDynamicSpreadFactorPool()
+
+			if tc.whipsaw {
+				s.whipsawPrice(pool.GetId())
+			}
+
+			if tc.config != nil {
+				err := clKeeper.SetDynamicSpreadFactorConfigs(s.Ctx, []types.DynamicSpreadFactorConfig{tc.config(pool.GetId())})
+				s.Require().NoError(err)
+			}
+
+			pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			effectiveSpreadFactor := clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool)
+
+			switch {
+			case tc.expectBase:
+				s.Require().Equal(dynamicSpreadFactorBase, effectiveSpreadFactor)
+			case tc.expectedMax:
+				s.Require().Equal(dynamicSpreadFactorMax, effectiveSpreadFactor)
+			default:
+				config := tc.config(pool.GetId())
+				volatility, err := s.App.TwapKeeper.GetRealizedVolatility(s.Ctx, pool.GetId(), ETH, USDC, s.Ctx.BlockTime().Add(-config.TwapWindow), s.Ctx.BlockTime())
+				s.Require().NoError(err)
+				s.Require().True(volatility.IsPositive())
+				s.Require().Equal(dynamicSpreadFactorBase.Add(config.VolatilityMultiplier.Mul(volatility)), effectiveSpreadFactor)
+				s.Require().True(effectiveSpreadFactor.LT(dynamicSpreadFactorMax))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapChargesDynamicSpreadFactor() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.setupDynamicSpreadFactorPool()
+	config := defaultDynamicSpreadFactorConfig(pool.GetId())
+	err := clKeeper.SetDynamicSpreadFactorConfigs(s.Ctx, []types.DynamicSpreadFactorConfig{config})
+	s.Require().NoError(err)
+
+	// the price did not move over the window, so only the price move of a swap raises its spread factor
+	s.Require().Equal(dynamicSpreadFactorBase, clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool))
+
+	// the price move of the swap is estimated at the base spread factor
+	tokenIn := dynamicSpreadFactorSwapAmount
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, tokenIn.Amount.MulRaw(2))))
+	cacheCtx, _ := s.Ctx.CacheContext()
+	err = clKeeper.SetDynamicSpreadFactorConfigs(cacheCtx, []types.DynamicSpreadFactorConfig{{PoolId: pool.GetId(), VolatilityMultiplier: osmomath.ZeroDec()}})
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(cacheCtx, s.TestAccs[1], pool, tokenIn, USDC, osmomath.OneInt(), dynamicSpreadFactorBase)
+	s.Require().NoError(err)
+	movedPool, err := clKeeper.GetConcentratedPoolById(cacheCtx, pool.GetId())
+	s.Require().NoError(err)
+	// ln(P_after / P_before) = 2 log_{2}(sqrtP_after / sqrtP_before) ln(2)
+	logReturn := movedPool.GetCurrentSqrtPrice().Quo(pool.GetCurrentSqrtPrice()).LogBase2().Dec().MulInt64(2).Mul(twaptypes.Ln2)
+	expectedSpreadFactor := config.SpreadFactorAt(dynamicSpreadFactorBase, logReturn.Abs())
+	s.Require().True(expectedSpreadFactor.GT(dynamicSpreadFactorBase))
+	s.Require().True(expectedSpreadFactor.LT(dynamicSpreadFactorMax))
+
+	// estimates reflect the spread factor of the swap even though the base spread factor is requested
+	estimatedOut, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, pool, tokenIn, USDC, dynamicSpreadFactorBase)
+	s.Require().NoError(err)
+	cacheCtx, _ = s.Ctx.CacheContext()
+	err = clKeeper.SetDynamicSpreadFactorConfigs(cacheCtx, []types.DynamicSpreadFactorConfig{{PoolId: pool.GetId(), VolatilityMultiplier: osmomath.ZeroDec()}})
+	s.Require().NoError(err)
+	expectedOut, err := clKeeper.CalcOutAmtGivenIn(cacheCtx, pool, tokenIn, USDC, expectedSpreadFactor)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedOut, estimatedOut)
+
+	// the swap moving the price away from its history pays for its price move, and emits its spread factor
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	tokenOutAmount, err := clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, USDC, osmomath.OneInt(), dynamicSpreadFactorBase)
+	s.Require().NoError(err)
+	s.Require().Equal(estimatedOut.Amount, tokenOutAmount)
+
+	swapEvent := s.FindEvent(s.Ctx.EventManager().ABCIEvents(), gammtypes.TypeEvtTokenSwapped)
+	s.Require().Equal(expectedSpreadFactor.String(), s.ExtractAttributes(swapEvent)[gammtypes.AttributeKeySpreadFactor])
+
+	// a swap moving the price less pays less
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	smallTokenIn := sdk.NewCoin(ETH, tokenIn.Amount.QuoRaw(100))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, smallTokenIn, USDC, osmomath.OneInt(), dynamicSpreadFactorBase)
+	s.Require().NoError(err)
+
+	swapEvent = s.FindEvent(s.Ctx.EventManager().ABCIEvents(), gammtypes.TypeEvtTokenSwapped)
+	smallSwapSpreadFactor := osmomath.MustNewDecFromStr(s.ExtractAttributes(swapEvent)[gammtypes.AttributeKeySpreadFactor])
+	s.Require().True(smallSwapSpreadFactor.GT(dynamicSpreadFactorBase))
+	s.Require().True(smallSwapSpreadFactor.LT(expectedSpreadFactor))
+}
+
+// whipsawPrice swaps back and forth in the pool over several blocks, recording its price in twap at the
+// end of each block, so that the pool has a positive realized volatility without a net price move.
+func (s *KeeperTestSuite) whipsawPrice(poolId uint64) {
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	tokenIn := dynamicSpreadFactorSwapAmount
+	for i := 0; i < 4; i++ {
+		tokenOutDenom := USDC
+		if tokenIn.Denom == USDC {
+			tokenOutDenom = ETH
+		}
+
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		tokenOutAmount, err := clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+
+		s.App.TwapKeeper.EndBlock(s.Ctx)
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+		tokenIn = sdk.NewCoin(tokenOutDenom, tokenOutAmount)
+	}
+}
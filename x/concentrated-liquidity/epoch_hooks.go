@@ -0,0 +1,52 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// dayEpochIdentifier is the epoch at the end of which opted in positions are compounded
+// and filled limit orders past their retention are pruned.
+const dayEpochIdentifier = "day"
+
+var _ epochtypes.EpochWorkHooks = EpochHooks{}
+
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the wrapper struct.
+func (k Keeper) EpochHooks() epochtypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart is a no-op.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd starts compounding the positions that opted into auto compounding at the end of every day epoch.
+// The positions are compounded by ContinueEpochWork, which may split the work across blocks.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == dayEpochIdentifier {
+		h.k.startAutoCompound(ctx, epochIdentifier, epochNumber)
+	}
+	return nil
+}
+
+// ContinueEpochWork implements epochtypes.EpochWorkHooks.
+// At the end of the day epoch, the filled limit orders past their retention are pruned once auto compounding is done.
+func (h EpochHooks) ContinueEpochWork(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochtypes.EpochWorkBudget) (bool, error) {
+	done, err := h.k.continueAutoCompound(ctx, epochIdentifier, epochNumber, budget)
+	if err != nil || !done || epochIdentifier != dayEpochIdentifier {
+		return done, err
+	}
+	return h.k.pruneFilledLimitOrders(ctx, budget)
+}
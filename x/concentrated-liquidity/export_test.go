@@ -14,7 +14,9 @@ import (
 )
 
 const (
-	Uint64Bytes = uint64Bytes
+	Uint64Bytes            = uint64Bytes
+	AutoCompoundBatchSize  = autoCompoundBatchSize
+	AutoCompoundTwapWindow = autoCompoundTwapWindow
 )
 
 var (
@@ -361,3 +363,19 @@ func ScaleDownSpreadRewardAmount(incentiveAmount osmomath.Int, scalingFactor osm
 func (k Keeper) RedepositForfeitedIncentives(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, scaledForfeitedIncentivesByUptime []sdk.Coins, totalForefeitedIncentives sdk.Coins) error {
 	return k.redepositForfeitedIncentives(ctx, poolId, owner, scaledForfeitedIncentivesByUptime, totalForefeitedIncentives)
 }
+
+func (k Keeper) CompoundPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, maxSlippage osmomath.Dec, tokenMinAmount0, tokenMinAmount1 osmomath.Int) (uint64, osmomath.Int, osmomath.Int, sdk.Coins, error) {
+	return k.compoundPosition(ctx, owner, positionId, maxSlippage, tokenMinAmount0, tokenMinAmount1, k.compoundSpotPrice)
+}
+
+func (k Keeper) GetPendingAutoCompound(ctx sdk.Context) (types.PendingAutoCompound, bool, error) {
+	return k.getPendingAutoCompound(ctx)
+}
+
+func (k Keeper) GetAllAutoCompoundConfigs(ctx sdk.Context) ([]types.AutoCompoundConfig, error) {
+	return k.getAllAutoCompoundConfigs(ctx)
+}
+
+func (k Keeper) ShiftPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, maxSlippage osmomath.Dec, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	return k.shiftPosition(ctx, owner, positionId, lowerTick, upperTick, maxSlippage, amount0Min, amount1Min)
+}
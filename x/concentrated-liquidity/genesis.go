@@ -101,6 +101,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set limit orders after the pools so that the fill tick index can be derived from the pool's token0
+	k.SetNextLimitOrderId(ctx, genState.NextLimitOrderId)
+	for _, limitOrder := range genState.LimitOrders {
+		pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+
+		err = k.setLimitOrder(ctx, limitOrder, limitOrder.TokenIn.Denom == pool.GetToken0())
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, config := range genState.DynamicSpreadFactorConfigs {
+		k.setDynamicSpreadFactorConfig(ctx, config)
+	}
+
+	for _, config := range genState.AutoCompoundConfigs {
+		k.setAutoCompoundConfig(ctx, config)
+	}
+
+	if genState.PendingAutoCompound != nil {
+		k.setPendingAutoCompound(ctx, *genState.PendingAutoCompound)
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 
@@ -233,6 +259,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	limitOrders, err := k.getAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	dynamicSpreadFactorConfigs, err := k.getAllDynamicSpreadFactorConfigs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	autoCompoundConfigs, err := k.getAllAutoCompoundConfigs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingAutoCompound *types.PendingAutoCompound
+	if pending, found, err := k.getPendingAutoCompound(ctx); err != nil {
+		panic(err)
+	} else if found {
+		pendingAutoCompound = &pending
+	}
+
 	// Get the incentive pool ID migration threshold
 	incentivesAccumulatorPoolIDMigrationThreshold, err := k.GetIncentivePoolIDMigrationThreshold(ctx)
 	if err != nil {
@@ -253,6 +301,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
 		IncentivesAccumulatorPoolIdMigrationThreshold: incentivesAccumulatorPoolIDMigrationThreshold,
 		SpreadFactorPoolIdMigrationThreshold:          spreadFactorPoolIdMigrationThreshold,
+		LimitOrders:                                   limitOrders,
+		NextLimitOrderId:                              k.GetNextLimitOrderId(ctx),
+		DynamicSpreadFactorConfigs:                    dynamicSpreadFactorConfigs,
+		AutoCompoundConfigs:                           autoCompoundConfigs,
+		PendingAutoCompound:                           pendingAutoCompound,
 	}
 }
 
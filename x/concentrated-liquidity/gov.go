@@ -15,11 +15,18 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSetDynamicSpreadFactorProposal handles a set dynamic spread factor proposal to the corresponding keeper method.
+func (k Keeper) HandleSetDynamicSpreadFactorProposal(ctx sdk.Context, p *types.SetDynamicSpreadFactorProposal) error {
+	return k.SetDynamicSpreadFactorConfigs(ctx, p.Configs)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
 		case *types.TickSpacingDecreaseProposal:
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
+		case *types.SetDynamicSpreadFactorProposal:
+			return k.HandleSetDynamicSpreadFactorProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
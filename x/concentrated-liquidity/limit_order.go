@@ -0,0 +1,483 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdkprefix "cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// filledLimitOrderPruneBatchSize is the number of filled limit orders pruned between checks of the epoch work budget.
+const filledLimitOrderPruneBatchSize = 100
+
+// GetNextLimitOrderId returns the next limit order id.
+func (k Keeper) GetNextLimitOrderId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextLimitOrderId := gogotypes.UInt64Value{}
+	osmoutils.MustGet(store, types.KeyNextGlobalLimitOrderId, &nextLimitOrderId)
+	return nextLimitOrderId.Value
+}
+
+// SetNextLimitOrderId sets the next limit order id.
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyNextGlobalLimitOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+// PlaceLimitOrder sells tokenIn at the price of tickIndex or better by creating a single-sided position
+// that is one tick spacing wide. An order selling token0 spans [tickIndex, tickIndex + tickSpacing] and
+// must be placed above the current tick. An order selling token1 spans [tickIndex - tickSpacing, tickIndex]
+// and must be placed at or below the current tick.
+// The position is owned by the sender, but can only be withdrawn through CancelLimitOrder until the order is filled.
+// Once a swap moves the price past the far edge of the range, the position is withdrawn to the owner,
+// either by the swap or by the owner claiming it. See fillCrossedLimitOrders and ClaimLimitOrder.
+// Returns error if:
+// - tokenIn is not one of the pool's tokens
+// - tickIndex is on the wrong side of the current tick for the token being sold
+// - creating the backing position fails
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (types.LimitOrder, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	var tokenOutDenom string
+	switch tokenIn.Denom {
+	case pool.GetToken0():
+		tokenOutDenom = pool.GetToken1()
+	case pool.GetToken1():
+		tokenOutDenom = pool.GetToken0()
+	default:
+		return types.LimitOrder{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+	isSellingToken0 := tokenIn.Denom == pool.GetToken0()
+
+	// The order must be entirely out of range so that the position holds only the token being sold.
+	tickSpacing := int64(pool.GetTickSpacing())
+	currentTick := pool.GetCurrentTick()
+	lowerTick, upperTick := tickIndex-tickSpacing, tickIndex
+	if isSellingToken0 {
+		if tickIndex <= currentTick {
+			return types.LimitOrder{}, types.LimitOrderTickOnWrongSideError{TickIndex: tickIndex, CurrentTick: currentTick, IsSellingToken0: isSellingToken0}
+		}
+		lowerTick, upperTick = tickIndex, tickIndex+tickSpacing
+	} else if tickIndex > currentTick {
+		return types.LimitOrder{}, types.LimitOrderTickOnWrongSideError{TickIndex: tickIndex, CurrentTick: currentTick, IsSellingToken0: isSellingToken0}
+	}
+
+	positionData, err := k.CreatePosition(ctx, poolId, owner, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	amountIn := positionData.Amount1
+	if isSellingToken0 {
+		amountIn = positionData.Amount0
+	}
+
+	orderId := k.GetNextLimitOrderId(ctx)
+	k.SetNextLimitOrderId(ctx, orderId+1)
+
+	order := types.LimitOrder{
+		OrderId:       orderId,
+		Owner:         owner.String(),
+		PoolId:        poolId,
+		PositionId:    positionData.ID,
+		LowerTick:     positionData.LowerTick,
+		UpperTick:     positionData.UpperTick,
+		TokenIn:       sdk.NewCoin(tokenIn.Denom, amountIn),
+		TokenOutDenom: tokenOutDenom,
+		Status:        types.LimitOrderOpen,
+		PlacedTime:    ctx.BlockTime(),
+	}
+	if err := k.setLimitOrder(ctx, order, isSellingToken0); err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPlaceLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(order.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTickIndex, strconv.FormatInt(tickIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, order.TokenIn.String()),
+	))
+
+	return order, nil
+}
+
+// CancelLimitOrder withdraws the full liquidity of an open limit order to its owner and deletes the order.
+// If the price is within the order's range, the owner receives both tokens.
+// Returns error if the order does not exist, is not owned by owner or was already filled.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Owner != owner.String() {
+		return nil, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+
+	if order.Status != types.LimitOrderOpen {
+		return nil, types.LimitOrderNotOpenError{OrderId: orderId, Status: order.Status}
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.deleteLimitOrder(ctx, order, order.TokenIn.Denom == pool.GetToken0())
+
+	tokensOut, err := k.withdrawLimitOrderPosition(ctx, order, pool.GetToken0(), pool.GetToken1())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCancelLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	))
+
+	return tokensOut, nil
+}
+
+// ClaimLimitOrder fills an open limit order whose range the price has fully crossed, withdrawing its position
+// to the owner. Crossed orders beyond the MaxLimitOrderFillsPerSwap filled by a swap are settled this way,
+// so that the owner rather than the swapper pays for the withdrawal.
+// Returns error if the order does not exist, is not owned by owner, is not open or the price has not crossed it.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coins, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Owner != owner.String() {
+		return nil, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+
+	if order.Status != types.LimitOrderOpen {
+		return nil, types.LimitOrderNotOpenError{OrderId: orderId, Status: order.Status}
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	isSellingToken0 := order.TokenIn.Denom == pool.GetToken0()
+	fillTick := limitOrderFillTick(order, isSellingToken0)
+	isCrossed, err := isLimitOrderCrossed(pool.GetCurrentSqrtPrice(), fillTick, isSellingToken0)
+	if err != nil {
+		return nil, err
+	}
+	if !isCrossed {
+		return nil, types.LimitOrderNotCrossedError{OrderId: orderId, FillTick: fillTick}
+	}
+
+	return k.fillLimitOrder(ctx, orderId, pool.GetToken0(), pool.GetToken1())
+}
+
+// fillCrossedLimitOrders fills the open limit orders in the pool whose range was fully crossed
+// by the swap that moved the pool to its current sqrt price, up to MaxLimitOrderFillsPerSwap orders.
+// A swap of token0 for token1 lowers the price and may only cross orders selling token1.
+// A swap of token1 for token0 raises the price and may only cross orders selling token0.
+// Since an order is always placed out of range, iterating the fill tick index in the direction
+// of the swap visits the crossed orders first.
+//
+// Filling withdraws the backing position to the order owner, so the filled liquidity
+// stops earning and cannot be swapped back by a later swap in the opposite direction.
+// The number of fills is capped so that placing many small orders on a tick cannot make swaps
+// through it arbitrarily expensive. The remaining crossed orders, furthest from the current price first,
+// are filled by later swaps in the same direction or claimed by their owners.
+func (k Keeper) fillCrossedLimitOrders(ctx sdk.Context, pool types.ConcentratedPoolExtension, zeroForOne bool) error {
+	isSellingToken0 := !zeroForOne
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+
+	fillTickStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.KeyLimitOrdersByFillTick(pool.GetId(), isSellingToken0))
+	iterator := fillTickStore.Iterator(nil, nil)
+	if !isSellingToken0 {
+		iterator = fillTickStore.ReverseIterator(nil, nil)
+	}
+
+	crossedOrderIds := []uint64{}
+	for ; iterator.Valid() && len(crossedOrderIds) < types.MaxLimitOrderFillsPerSwap; iterator.Next() {
+		// key is encoded as tick index (9 bytes) || order id (8 bytes)
+		key := iterator.Key()
+		fillTick, err := types.TickIndexFromBytes(key[:len(key)-uint64Bytes])
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+
+		isCrossed, err := isLimitOrderCrossed(currentSqrtPrice, fillTick, isSellingToken0)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		if !isCrossed {
+			break
+		}
+
+		crossedOrderIds = append(crossedOrderIds, sdk.BigEndianToUint64(key[len(key)-uint64Bytes:]))
+	}
+	iterator.Close()
+
+	for _, orderId := range crossedOrderIds {
+		if _, err := k.fillLimitOrder(ctx, orderId, pool.GetToken0(), pool.GetToken1()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isLimitOrderCrossed returns whether the given sqrt price is past the fill tick of an order,
+// meaning the order is fully converted into the token being bought.
+func isLimitOrderCrossed(currentSqrtPrice osmomath.BigDec, fillTick int64, isSellingToken0 bool) (bool, error) {
+	fillSqrtPrice, err := math.TickToSqrtPrice(fillTick)
+	if err != nil {
+		return false, err
+	}
+
+	if isSellingToken0 {
+		return currentSqrtPrice.GTE(fillSqrtPrice), nil
+	}
+	return currentSqrtPrice.LTE(fillSqrtPrice), nil
+}
+
+// fillLimitOrder withdraws the position backing the given open order to its owner and marks the order as filled.
+// Returns the withdrawn tokens.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, orderId uint64, token0, token1 string) (sdk.Coins, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	isSellingToken0 := order.TokenIn.Denom == token0
+	k.deleteLimitOrder(ctx, order, isSellingToken0)
+
+	tokensOut, err := k.withdrawLimitOrderPosition(ctx, order, token0, token1)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = types.LimitOrderFilled
+	order.TokensOut = tokensOut
+	order.FilledTime = ctx.BlockTime()
+	if err := k.setLimitOrder(ctx, order, isSellingToken0); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFillLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimitOrderId, strconv.FormatUint(orderId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+	))
+
+	return tokensOut, nil
+}
+
+// withdrawLimitOrderPosition withdraws all liquidity of the position backing the given order to the order owner.
+// Returns the withdrawn tokens.
+func (k Keeper) withdrawLimitOrderPosition(ctx sdk.Context, order types.LimitOrder, token0, token1 string) (sdk.Coins, error) {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := k.GetPosition(ctx, order.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, order.PositionId, position.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(token0, amount0), sdk.NewCoin(token1, amount1)), nil
+}
+
+// GetLimitOrder returns the limit order with the given id.
+// Returns error if the order does not exist.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	order := types.LimitOrder{}
+	found, err := osmoutils.Get(store, types.KeyLimitOrder(orderId), &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !found {
+		return types.LimitOrder{}, types.LimitOrderNotFoundError{OrderId: orderId}
+	}
+	return order, nil
+}
+
+// getLimitOrderIdForPosition returns the id of the open limit order backed by the given position.
+// Returns false if the position does not back an open limit order.
+func (k Keeper) getLimitOrderIdForPosition(ctx sdk.Context, positionId uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLimitOrderIdForPosition(positionId))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// validatePositionDoesNotBackLimitOrder returns an error if the given position backs an open limit order.
+// Such positions may only be withdrawn by cancelling or filling the order.
+func (k Keeper) validatePositionDoesNotBackLimitOrder(ctx sdk.Context, positionId uint64) error {
+	if orderId, ok := k.getLimitOrderIdForPosition(ctx, positionId); ok {
+		return types.PositionBacksLimitOrderError{PositionId: positionId, OrderId: orderId}
+	}
+	return nil
+}
+
+// setLimitOrder writes the order and its owner and pool indexes to state.
+// Open orders are additionally indexed by fill tick and by their backing position.
+func (k Keeper) setLimitOrder(ctx sdk.Context, order types.LimitOrder, isSellingToken0 bool) error {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLimitOrder(order.OrderId), &order)
+	store.Set(types.KeyLimitOrderByOwner(owner, order.PoolId, order.Status, order.OrderId), []byte{})
+	store.Set(types.KeyLimitOrderByPool(order.PoolId, order.Status, order.OrderId), []byte{})
+
+	if order.Status == types.LimitOrderOpen {
+		store.Set(types.KeyLimitOrderByFillTick(order.PoolId, isSellingToken0, limitOrderFillTick(order, isSellingToken0), order.OrderId), []byte{})
+		store.Set(types.KeyLimitOrderIdForPosition(order.PositionId), sdk.Uint64ToBigEndian(order.OrderId))
+	} else {
+		store.Set(types.KeyLimitOrderByFilledTime(order.FilledTime, order.OrderId), []byte{})
+	}
+	return nil
+}
+
+// deleteLimitOrder removes the order and all of its indexes from state.
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, order types.LimitOrder, isSellingToken0 bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLimitOrder(order.OrderId))
+	store.Delete(types.KeyLimitOrderByOwner(sdk.MustAccAddressFromBech32(order.Owner), order.PoolId, order.Status, order.OrderId))
+	store.Delete(types.KeyLimitOrderByPool(order.PoolId, order.Status, order.OrderId))
+
+	if order.Status == types.LimitOrderOpen {
+		store.Delete(types.KeyLimitOrderByFillTick(order.PoolId, isSellingToken0, limitOrderFillTick(order, isSellingToken0), order.OrderId))
+		store.Delete(types.KeyLimitOrderIdForPosition(order.PositionId))
+	} else {
+		store.Delete(types.KeyLimitOrderByFilledTime(order.FilledTime, order.OrderId))
+	}
+}
+
+// pruneFilledLimitOrders deletes the limit orders filled more than FilledLimitOrderRetention ago,
+// oldest first, in batches of filledLimitOrderPruneBatchSize orders until the budget is exhausted.
+// Returns true once no such order is left.
+func (k Keeper) pruneFilledLimitOrders(ctx sdk.Context, budget epochtypes.EpochWorkBudget) (bool, error) {
+	// keys of orders filled before the cutoff sort before the cutoff time, whatever their order id
+	end := types.KeyLimitOrderByFilledTime(ctx.BlockTime().Add(-types.FilledLimitOrderRetention), 0)
+
+	for {
+		orderIds := []uint64{}
+		iterator := ctx.KVStore(k.storeKey).Iterator(types.LimitOrderFilledTimePrefix, end)
+		for ; iterator.Valid() && len(orderIds) < filledLimitOrderPruneBatchSize; iterator.Next() {
+			key := iterator.Key()
+			orderIds = append(orderIds, sdk.BigEndianToUint64(key[len(key)-uint64Bytes:]))
+		}
+		iterator.Close()
+
+		for _, orderId := range orderIds {
+			order, err := k.GetLimitOrder(ctx, orderId)
+			if err != nil {
+				return false, err
+			}
+			// the fill tick index is only kept for open orders, so the token sold does not matter
+			k.deleteLimitOrder(ctx, order, false)
+		}
+		if len(orderIds) < filledLimitOrderPruneBatchSize {
+			return true, nil
+		}
+
+		if budget.Exhausted(ctx) {
+			ctx.Logger().Info("x/concentrated-liquidity pruning of filled limit orders continues in the next block", "module", types.ModuleName, "height", ctx.BlockHeight())
+			return false, nil
+		}
+	}
+}
+
+// limitOrderFillTick returns the tick that has to be crossed for the order to be fully converted
+// into the token being bought.
+func limitOrderFillTick(order types.LimitOrder, isSellingToken0 bool) int64 {
+	if isSellingToken0 {
+		return order.UpperTick
+	}
+	return order.LowerTick
+}
+
+// GetUserLimitOrders returns the limit orders of the given address with the given status.
+// If poolId is zero, returns orders across all pools.
+func (k Keeper) GetUserLimitOrders(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, status types.LimitOrderStatus, pagination *query.PageRequest) ([]types.LimitOrder, *query.PageResponse, error) {
+	prefix := types.KeyLimitOrdersByOwner(owner, status)
+	if poolId != 0 {
+		prefix = types.KeyLimitOrdersByOwnerAndPool(owner, poolId, status)
+	}
+	return k.paginateLimitOrders(ctx, prefix, pagination)
+}
+
+// GetPoolLimitOrders returns the limit orders in the given pool with the given status.
+func (k Keeper) GetPoolLimitOrders(ctx sdk.Context, poolId uint64, status types.LimitOrderStatus, pagination *query.PageRequest) ([]types.LimitOrder, *query.PageResponse, error) {
+	return k.paginateLimitOrders(ctx, types.KeyLimitOrdersByPool(poolId, status), pagination)
+}
+
+// paginateLimitOrders paginates over an order index whose keys end with the order id.
+func (k Keeper) paginateLimitOrders(ctx sdk.Context, prefix []byte, pagination *query.PageRequest) ([]types.LimitOrder, *query.PageResponse, error) {
+	indexStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
+
+	orders := []types.LimitOrder{}
+	pageRes, err := query.Paginate(indexStore, pagination, func(key, _ []byte) error {
+		order, err := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(key[len(key)-uint64Bytes:]))
+		if err != nil {
+			return err
+		}
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, pageRes, nil
+}
+
+// getAllLimitOrders returns all open and filled limit orders for export genesis.
+func (k Keeper) getAllLimitOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, parseLimitOrderFromBz)
+}
+
+// parseLimitOrderFromBz parses and returns a limit order from a byte array.
+func parseLimitOrderFromBz(bz []byte) (types.LimitOrder, error) {
+	order := types.LimitOrder{}
+	if err := order.Unmarshal(bz); err != nil {
+		return types.LimitOrder{}, err
+	}
+	return order, nil
+}
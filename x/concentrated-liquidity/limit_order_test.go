@@ -0,0 +1,418 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var (
+	// limitOrderCoin0 and limitOrderCoin1 are worth roughly the same at the default price.
+	limitOrderCoin0 = sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	limitOrderCoin1 = sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))
+	// limitOrderSwapAmount0 and limitOrderSwapAmount1 move the price of a pool with a default
+	// full range position well past one tick spacing, filling any limit order in the way.
+	limitOrderSwapAmount0 = sdk.NewCoin(ETH, osmomath.NewInt(10_000))
+	limitOrderSwapAmount1 = sdk.NewCoin(USDC, osmomath.NewInt(50_000_000))
+)
+
+// setupLimitOrderPool creates a default pool with a full range position and returns the pool
+// along with the tick spacing aligned ticks directly above and at or below the current tick.
+func (s *KeeperTestSuite) setupLimitOrderPool() (types.ConcentratedPoolExtension, int64, int64) {
+	pool := s.PrepareConcentratedPool()
+	s.CreateFullRangePosition(pool, DefaultCoins)
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	tickSpacing := int64(pool.GetTickSpacing())
+	tickAtOrBelow := pool.GetCurrentTick() / tickSpacing * tickSpacing
+	return pool, tickAtOrBelow + tickSpacing, tickAtOrBelow
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		sellToken0    bool
+		tickOffset    int64
+		tokenInDenom  string
+		expectedError error
+	}{
+		"sell token0 above current tick": {
+			sellToken0: true,
+		},
+		"sell token1 at or below current tick": {
+			sellToken0: false,
+		},
+		"error: sell token0 at or below current tick": {
+			sellToken0:    true,
+			tickOffset:    -int64(DefaultTickSpacing),
+			expectedError: types.LimitOrderTickOnWrongSideError{},
+		},
+		"error: sell token1 above current tick": {
+			sellToken0:    false,
+			tickOffset:    int64(DefaultTickSpacing),
+			expectedError: types.LimitOrderTickOnWrongSideError{},
+		},
+		"error: token in not in pool": {
+			sellToken0:    true,
+			tokenInDenom:  FOO,
+			expectedError: types.TokenInDenomNotInPoolError{TokenInDenom: FOO},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool, askTick, bidTick := s.setupLimitOrderPool()
+			owner := s.TestAccs[1]
+
+			tickIndex, tokenIn := bidTick, limitOrderCoin1
+			if tc.sellToken0 {
+				tickIndex, tokenIn = askTick, limitOrderCoin0
+			}
+			tickIndex += tc.tickOffset
+			if tc.tokenInDenom != "" {
+				tokenIn.Denom = tc.tokenInDenom
+			}
+			s.FundAcc(owner, sdk.NewCoins(tokenIn))
+
+			order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tickIndex, tokenIn)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The backing position is one tick spacing wide on the far side of the current price.
+			expectedLowerTick, expectedUpperTick := tickIndex-int64(DefaultTickSpacing), tickIndex
+			if tc.sellToken0 {
+				expectedLowerTick, expectedUpperTick = tickIndex, tickIndex+int64(DefaultTickSpacing)
+			}
+			s.Require().Equal(expectedLowerTick, order.LowerTick)
+			s.Require().Equal(expectedUpperTick, order.UpperTick)
+			s.Require().Equal(types.LimitOrderOpen, order.Status)
+			s.Require().Equal(owner.String(), order.Owner)
+			s.Require().Equal(tokenIn.Denom, order.TokenIn.Denom)
+			s.Require().True(order.TokenIn.Amount.LTE(tokenIn.Amount))
+
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, order.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), position.Address)
+
+			storedOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().NoError(err)
+			s.Require().Equal(order, storedOrder)
+			s.Require().Equal(order.OrderId+1, s.App.ConcentratedLiquidityKeeper.GetNextLimitOrderId(s.Ctx))
+
+			userOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, 0, types.LimitOrderOpen, nil)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.LimitOrder{order}, userOrders)
+
+			poolOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetPoolLimitOrders(s.Ctx, pool.GetId(), types.LimitOrderOpen, nil)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.LimitOrder{order}, poolOrders)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFillLimitOrder() {
+	tests := map[string]struct {
+		sellToken0 bool
+		// swapToward is true if the swap moves the price towards the order.
+		swapToward   bool
+		expectFilled bool
+	}{
+		"sell token0, price rises through order": {
+			sellToken0:   true,
+			swapToward:   true,
+			expectFilled: true,
+		},
+		"sell token1, price falls through order": {
+			sellToken0:   false,
+			swapToward:   true,
+			expectFilled: true,
+		},
+		"sell token0, price falls away from order": {
+			sellToken0: true,
+		},
+		"sell token1, price rises away from order": {
+			sellToken0: false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool, askTick, bidTick := s.setupLimitOrderPool()
+			owner := s.TestAccs[1]
+			swapper := s.TestAccs[2]
+
+			tickIndex, tokenIn, tokenOutDenom := bidTick, limitOrderCoin1, ETH
+			if tc.sellToken0 {
+				tickIndex, tokenIn, tokenOutDenom = askTick, limitOrderCoin0, USDC
+			}
+			s.FundAcc(owner, sdk.NewCoins(tokenIn))
+
+			order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tickIndex, tokenIn)
+			s.Require().NoError(err)
+
+			// Selling token1 for token0 raises the price, selling token0 for token1 lowers it.
+			swapIn := limitOrderSwapAmount0
+			if tc.sellToken0 == tc.swapToward {
+				swapIn = limitOrderSwapAmount1
+			}
+			s.FundAcc(swapper, sdk.NewCoins(swapIn))
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			swapOutDenom := USDC
+			if swapIn.Denom == USDC {
+				swapOutDenom = ETH
+			}
+			_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, swapOutDenom, osmomath.OneInt(), osmomath.ZeroDec())
+			s.Require().NoError(err)
+
+			updatedOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().NoError(err)
+
+			if !tc.expectFilled {
+				s.Require().Equal(order, updatedOrder)
+				_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, order.PositionId)
+				s.Require().NoError(err)
+				return
+			}
+
+			s.Require().Equal(types.LimitOrderFilled, updatedOrder.Status)
+			s.Require().Equal(s.Ctx.BlockTime(), updatedOrder.FilledTime)
+
+			// The order was fully converted into the token being bought and withdrawn to the owner.
+			s.Require().True(updatedOrder.TokensOut.AmountOf(tokenIn.Denom).IsZero())
+			s.Require().True(updatedOrder.TokensOut.AmountOf(tokenOutDenom).IsPositive())
+			s.Require().Equal(updatedOrder.TokensOut.AmountOf(tokenOutDenom), s.App.BankKeeper.GetBalance(s.Ctx, owner, tokenOutDenom).Amount)
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, order.PositionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: order.PositionId})
+
+			openOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetPoolLimitOrders(s.Ctx, pool.GetId(), types.LimitOrderOpen, nil)
+			s.Require().NoError(err)
+			s.Require().Empty(openOrders)
+
+			filledOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId(), types.LimitOrderFilled, nil)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.LimitOrder{updatedOrder}, filledOrders)
+
+			// A filled order can no longer be cancelled.
+			_, err = s.App.ConcentratedLiquidityKeeper.CancelLimitOrder(s.Ctx, owner, order.OrderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotOpenError{OrderId: order.OrderId, Status: types.LimitOrderFilled})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelLimitOrder() {
+	tests := map[string]struct {
+		notOwner      bool
+		expectedError error
+	}{
+		"owner cancels": {},
+		"error: not owner": {
+			notOwner:      true,
+			expectedError: types.NotLimitOrderOwnerError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool, askTick, _ := s.setupLimitOrderPool()
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(limitOrderCoin0))
+
+			order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, askTick, limitOrderCoin0)
+			s.Require().NoError(err)
+
+			sender := owner
+			if tc.notOwner {
+				sender = s.TestAccs[2]
+			}
+
+			tokensOut, err := s.App.ConcentratedLiquidityKeeper.CancelLimitOrder(s.Ctx, sender, order.OrderId)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorAs(err, &tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The order was never crossed, so the owner gets back the deposit minus rounding in favor of the pool.
+			s.Require().True(tokensOut.AmountOf(USDC).IsZero())
+			s.Require().True(tokensOut.AmountOf(ETH).LTE(order.TokenIn.Amount))
+			s.Require().True(tokensOut.AmountOf(ETH).GTE(order.TokenIn.Amount.SubRaw(1)))
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: order.OrderId})
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, order.PositionId)
+			s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: order.PositionId})
+
+			userOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, 0, types.LimitOrderOpen, nil)
+			s.Require().NoError(err)
+			s.Require().Empty(userOrders)
+		})
+	}
+}
+
+// TestClaimLimitOrder ensures that a swap fills at most MaxLimitOrderFillsPerSwap crossed orders,
+// and that the owners of the remaining crossed orders can claim them.
+func (s *KeeperTestSuite) TestClaimLimitOrder() {
+	s.SetupTest()
+	pool, askTick, _ := s.setupLimitOrderPool()
+	owner := s.TestAccs[1]
+	swapper := s.TestAccs[2]
+
+	orders := []types.LimitOrder{}
+	for i := 0; i < types.MaxLimitOrderFillsPerSwap+1; i++ {
+		s.FundAcc(owner, sdk.NewCoins(limitOrderCoin0))
+		order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, askTick, limitOrderCoin0)
+		s.Require().NoError(err)
+		orders = append(orders, order)
+	}
+	unfilledOrder := orders[len(orders)-1]
+
+	// The order was not crossed yet.
+	_, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, unfilledOrder.OrderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotCrossedError{OrderId: unfilledOrder.OrderId, FillTick: unfilledOrder.UpperTick})
+
+	// The swap has to move the price past the liquidity of all orders.
+	swapIn := sdk.NewCoin(USDC, limitOrderSwapAmount1.Amount.MulRaw(int64(len(orders)+1)))
+	s.FundAcc(swapper, sdk.NewCoins(swapIn))
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, swapIn, ETH, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+
+	filledOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetPoolLimitOrders(s.Ctx, pool.GetId(), types.LimitOrderFilled, nil)
+	s.Require().NoError(err)
+	s.Require().Len(filledOrders, types.MaxLimitOrderFillsPerSwap)
+
+	openOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetPoolLimitOrders(s.Ctx, pool.GetId(), types.LimitOrderOpen, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.LimitOrder{unfilledOrder}, openOrders)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, swapper, unfilledOrder.OrderId)
+	s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{OrderId: unfilledOrder.OrderId, Address: swapper.String()})
+
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC)
+	tokensOut, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, unfilledOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().True(tokensOut.AmountOf(ETH).IsZero())
+	s.Require().True(tokensOut.AmountOf(USDC).IsPositive())
+	s.Require().Equal(balanceBefore.Amount.Add(tokensOut.AmountOf(USDC)), s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount)
+
+	claimedOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, unfilledOrder.OrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.LimitOrderFilled, claimedOrder.Status)
+	s.Require().Equal(tokensOut, claimedOrder.TokensOut)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, unfilledOrder.OrderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotOpenError{OrderId: unfilledOrder.OrderId, Status: types.LimitOrderFilled})
+}
+
+// TestLimitOrderPositionCannotBeModified ensures that the position backing an open
+// limit order can only be withdrawn through the limit order itself.
+func (s *KeeperTestSuite) TestLimitOrderPositionCannotBeModified() {
+	s.SetupTest()
+	pool, askTick, _ := s.setupLimitOrderPool()
+	owner := s.TestAccs[1]
+	s.FundAcc(owner, sdk.NewCoins(limitOrderCoin0.Add(limitOrderCoin0)))
+
+	order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, askTick, limitOrderCoin0)
+	s.Require().NoError(err)
+
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, order.PositionId)
+	s.Require().NoError(err)
+
+	expectedError := types.PositionBacksLimitOrderError{PositionId: order.PositionId, OrderId: order.OrderId}
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+	_, err = msgServer.WithdrawPosition(s.Ctx, &types.MsgWithdrawPosition{
+		PositionId:      order.PositionId,
+		Sender:          owner.String(),
+		LiquidityAmount: position.Liquidity,
+	})
+	s.Require().ErrorIs(err, expectedError)
+
+	_, err = msgServer.AddToPosition(s.Ctx, &types.MsgAddToPosition{
+		PositionId: order.PositionId,
+		Sender:     owner.String(),
+		Amount0:    limitOrderCoin0.Amount,
+		Amount1:    osmomath.ZeroInt(),
+	})
+	s.Require().ErrorIs(err, expectedError)
+
+	_, err = msgServer.TransferPositions(s.Ctx, &types.MsgTransferPositions{
+		PositionIds: []uint64{order.PositionId},
+		Sender:      owner.String(),
+		NewOwner:    s.TestAccs[2].String(),
+	})
+	s.Require().ErrorIs(err, expectedError)
+}
+
+func (s *KeeperTestSuite) TestPruneFilledLimitOrders() {
+	s.SetupTest()
+	pool, askTick, _ := s.setupLimitOrderPool()
+	owner := s.TestAccs[1]
+	swapper := s.TestAccs[2]
+
+	s.FundAcc(owner, sdk.NewCoins(limitOrderCoin0))
+	order, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, askTick, limitOrderCoin0)
+	s.Require().NoError(err)
+
+	s.FundAcc(swapper, sdk.NewCoins(limitOrderSwapAmount1))
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, limitOrderSwapAmount1, ETH, osmomath.OneInt(), osmomath.ZeroDec())
+	s.Require().NoError(err)
+	filledTime := s.Ctx.BlockTime()
+
+	epochHooks := s.App.ConcentratedLiquidityKeeper.EpochHooks().(epochtypes.EpochWorkHooks)
+	continueDayEpochWork := func() {
+		done, err := epochHooks.ContinueEpochWork(s.Ctx, "day", 1, epochtypes.UnlimitedEpochWorkBudget())
+		s.Require().NoError(err)
+		s.Require().True(done)
+	}
+
+	// the filled order is kept within the retention
+	s.Ctx = s.Ctx.WithBlockTime(filledTime.Add(types.FilledLimitOrderRetention))
+	continueDayEpochWork()
+	filledOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+	s.Require().NoError(err)
+	s.Require().Equal(types.LimitOrderFilled, filledOrder.Status)
+
+	// the work of other epochs does not prune
+	s.Ctx = s.Ctx.WithBlockTime(filledTime.Add(types.FilledLimitOrderRetention + time.Second))
+	done, err := epochHooks.ContinueEpochWork(s.Ctx, "week", 1, epochtypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+	s.Require().NoError(err)
+
+	// the filled order is pruned with all of its indexes past the retention
+	continueDayEpochWork()
+	_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, order.OrderId)
+	s.Require().Error(err)
+	filledOrders, _, err := s.App.ConcentratedLiquidityKeeper.GetUserLimitOrders(s.Ctx, owner, pool.GetId(), types.LimitOrderFilled, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(filledOrders)
+	filledOrders, _, err = s.App.ConcentratedLiquidityKeeper.GetPoolLimitOrders(s.Ctx, pool.GetId(), types.LimitOrderFilled, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(filledOrders)
+	iterator := storetypes.KVStorePrefixIterator(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), types.LimitOrderFilledTimePrefix)
+	defer iterator.Close()
+	s.Require().False(iterator.Valid())
+}
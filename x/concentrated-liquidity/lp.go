@@ -407,6 +407,10 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.ErrZeroLiquidity
 	}
 
+	if err := k.validatePositionDoesNotBackLimitOrder(ctx, positionId); err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	// If the position is superfluid staked, return error.
 	// This path is handled separately in the superfluid module.
 	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
@@ -452,6 +456,11 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	// The new position keeps the auto compound opt-in of the position it replaces.
+	if err := k.moveAutoCompoundConfig(ctx, positionId, newPositionData.ID); err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
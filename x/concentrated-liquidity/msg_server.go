@@ -95,6 +95,11 @@ func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWi
 		return nil, err
 	}
 
+	// Positions backing open limit orders may only be withdrawn by cancelling the order.
+	if err := server.keeper.validatePositionDoesNotBackLimitOrder(ctx, msg.PositionId); err != nil {
+		return nil, err
+	}
+
 	amount0, amount1, err := server.keeper.WithdrawPosition(ctx, sender, msg.PositionId, msg.LiquidityAmount)
 	if err != nil {
 		return nil, err
@@ -198,3 +203,124 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// PlaceLimitOrder places a limit order backed by a single-sided position that is one tick spacing wide.
+// See keeper.PlaceLimitOrder for details.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := server.keeper.PlaceLimitOrder(ctx, msg.PoolId, sender, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: place limit order event is emitted in keeper.PlaceLimitOrder(...)
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: order.OrderId, PositionId: order.PositionId, TokenIn: order.TokenIn}, nil
+}
+
+// CancelLimitOrder withdraws an open limit order to its owner and deletes it.
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.CancelLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: cancel limit order event is emitted in keeper.CancelLimitOrder(...)
+
+	return &types.MsgCancelLimitOrderResponse{TokensOut: tokensOut}, nil
+}
+
+// ClaimLimitOrder withdraws a crossed limit order to its owner and marks it as filled.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: fill limit order event is emitted in keeper.ClaimLimitOrder(...)
+
+	return &types.MsgClaimLimitOrderResponse{TokensOut: tokensOut}, nil
+}
+
+// CompoundPosition claims the position's spread rewards and incentives, swaps them into the position's
+// token ratio and adds them to the position. See keeper.compoundPosition for details.
+func (server msgServer) CompoundPosition(goCtx context.Context, msg *types.MsgCompoundPosition) (*types.MsgCompoundPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, amount0, amount1, uncompoundedRewards, err := server.keeper.compoundPosition(ctx, sender, msg.PositionId, msg.MaxSlippage, msg.TokenMinAmount0, msg.TokenMinAmount1, server.keeper.compoundSpotPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: compound position event is emitted in keeper.compoundPosition(...)
+
+	return &types.MsgCompoundPositionResponse{PositionId: positionId, Amount0: amount0, Amount1: amount1, UncompoundedRewards: uncompoundedRewards}, nil
+}
+
+// SetPositionAutoCompound opts a position in or out of being compounded by the module at the end of every day epoch.
+func (server msgServer) SetPositionAutoCompound(goCtx context.Context, msg *types.MsgSetPositionAutoCompound) (*types.MsgSetPositionAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetPositionAutoCompound(ctx, sender, msg.PositionId, msg.Enabled, msg.MaxSlippage, msg.TokenMinAmount0, msg.TokenMinAmount1); err != nil {
+		return nil, err
+	}
+
+	// Note: set position auto compound event is emitted in keeper.SetPositionAutoCompound(...)
+
+	return &types.MsgSetPositionAutoCompoundResponse{}, nil
+}
+
+// ShiftPosition moves the position to a new tick range, keeping its join time and unclaimed rewards.
+// See keeper.shiftPosition for details.
+func (server msgServer) ShiftPosition(goCtx context.Context, msg *types.MsgShiftPosition) (*types.MsgShiftPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPositionData, err := server.keeper.shiftPosition(ctx, sender, msg.PositionId, msg.LowerTick, msg.UpperTick, msg.MaxSlippage, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note: shift position event is emitted in keeper.shiftPosition(...)
+
+	return &types.MsgShiftPositionResponse{
+		PositionId:       newPositionData.ID,
+		Amount0:          newPositionData.Amount0,
+		Amount1:          newPositionData.Amount1,
+		LiquidityCreated: newPositionData.Liquidity,
+	}, nil
+}
@@ -708,6 +708,10 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 			return types.LockNotMatureError{PositionId: position.PositionId, LockId: lockId}
 		}
 
+		if err := k.validatePositionDoesNotBackLimitOrder(ctx, positionId); err != nil {
+			return err
+		}
+
 		// Since the caller can be either the owner or the governance module (verified above), we can safely utilize the address directly from the position.
 		positionOwnerAddr := sdk.MustAccAddressFromBech32(position.Address)
 
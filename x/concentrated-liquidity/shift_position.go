@@ -0,0 +1,220 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+// shiftPosition moves the liquidity of the position with the given position id to the given tick range.
+// It withdraws the position in full, swaps the withdrawn amounts in the position's pool so that their ratio
+// matches the new range, and creates a new position in that range. Like addToPosition, this replaces the
+// position with a new one.
+//
+// Unlike withdrawing and creating a position separately, the position's unclaimed spread rewards and incentives
+// are not collected but carried over to the new position, and the new position keeps the join time of the old
+// one. As a result, no incentives are forfeited and the position's uptime is not reset.
+// The rebalancing swap errors if it returns less than the amount implied by the spot price, reduced by maxSlippage.
+// Amounts that do not fit the new position due to rounding or price impact stay with the owner.
+//
+// Returns the new position data.
+// Returns error if
+// - the owner does not own the position
+// - the new tick range is invalid or equal to the position's range
+// - the position backs a limit order or is superfluid staked
+// - the position is the last position in the pool
+// - the rebalancing swap exceeds the max slippage
+// - the new position holds less than amount0Min or amount1Min
+func (k Keeper) shiftPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, lowerTick, upperTick int64, maxSlippage osmomath.Dec, amount0Min, amount1Min osmomath.Int) (CreatePositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if owner.String() != position.Address {
+		return CreatePositionData{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+	if position.LowerTick == lowerTick && position.UpperTick == upperTick {
+		return CreatePositionData{}, types.ShiftToSameRangeError{PositionId: positionId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	if err := k.validatePositionDoesNotBackLimitOrder(ctx, positionId); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// If the position is superfluid staked, return error.
+	// This path is handled separately in the superfluid module.
+	positionHasUnderlyingLock, _, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if positionHasUnderlyingLock {
+		return CreatePositionData{}, types.PositionSuperfluidStakedError{PositionId: positionId}
+	}
+
+	// Detach the unclaimed rewards before withdrawing, so that the withdrawal neither collects nor forfeits them.
+	spreadRewards, incentivesByUptime, err := k.detachUnclaimedRewards(ctx, position)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	pool, err = k.GetConcentratedPoolById(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if !k.PoolHasPosition(ctx, pool) {
+		return CreatePositionData{}, types.ShiftLastPositionInPoolError{PoolId: position.PoolId, PositionId: positionId}
+	}
+
+	// The withdrawn liquidity is used as the reference for the new range's token ratio.
+	amount0, amount1, err = k.rebalanceToRangeRatio(ctx, owner, pool, lowerTick, upperTick, position.Liquidity, amount0, amount1, maxSlippage, k.compoundSpotPrice)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	tokensProvided := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	newPositionData, err := k.CreatePosition(ctx, position.PoolId, owner, tokensProvided, amount0Min, amount1Min, lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The new position keeps the join time of the position it replaces.
+	// SetPosition is not used since it would count full range liquidity twice.
+	newPosition, err := k.GetPosition(ctx, newPositionData.ID)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	newPosition.JoinTime = position.JoinTime
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionId(newPositionData.ID), &newPosition)
+
+	if err := k.attachUnclaimedRewards(ctx, position.PoolId, newPositionData.ID, spreadRewards, incentivesByUptime); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	// The new position keeps the auto compound opt-in of the position it replaces.
+	if err := k.moveAutoCompoundConfig(ctx, positionId, newPositionData.ID); err != nil {
+		return CreatePositionData{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtShiftPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionData.ID, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(newPositionData.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(newPositionData.UpperTick, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, newPositionData.Amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, newPositionData.Amount1.String()),
+		),
+	})
+
+	return newPositionData, nil
+}
+
+// detachUnclaimedRewards claims the unclaimed spread rewards and incentives of the given position in its pool's
+// accumulators without paying them out. The position's accumulator records are kept with no unclaimed rewards.
+// Returns the spread rewards and the incentives of every uptime accumulator, in the scaled form the accumulators use.
+// CONTRACT: the returned rewards must be attached to another position in the same pool via attachUnclaimedRewards,
+// since the tokens backing them stay in the pool's spread rewards and incentives addresses.
+func (k Keeper) detachUnclaimedRewards(ctx sdk.Context, position model.Position) (sdk.DecCoins, []sdk.DecCoins, error) {
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, position.PoolId)
+	if err != nil {
+		return nil, nil, err
+	}
+	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return nil, nil, err
+	}
+	spreadRewards, err := detachAccumRewards(spreadRewardAccumulator, types.KeySpreadRewardPositionAccumulator(position.PositionId), spreadRewardGrowthOutside)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Uptime accumulators are synced to the current block time so that incentives emitted until now are included.
+	if err := k.UpdatePoolUptimeAccumulatorsToNow(ctx, position.PoolId); err != nil {
+		return nil, nil, err
+	}
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, position.PoolId)
+	if err != nil {
+		return nil, nil, err
+	}
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(ctx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	positionName := string(types.KeyPositionId(position.PositionId))
+	incentivesByUptime := make([]sdk.DecCoins, len(uptimeAccumulators))
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		if !uptimeAccum.HasPosition(positionName) {
+			continue
+		}
+		incentivesByUptime[uptimeIndex], err = detachAccumRewards(uptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return spreadRewards, incentivesByUptime, nil
+}
+
+// detachAccumRewards claims the rewards of the given accumulator position, including the dust left by truncation,
+// and returns them without paying them out.
+func detachAccumRewards(accumulator *accum.AccumulatorObject, positionKey string, growthOutside sdk.DecCoins) (sdk.DecCoins, error) {
+	claimed, dust, err := updateAccumAndClaimRewards(accumulator, positionKey, growthOutside)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewDecCoinsFromCoins(claimed...).Add(dust...), nil
+}
+
+// attachUnclaimedRewards adds rewards returned by detachUnclaimedRewards to the unclaimed rewards of the given
+// position, which must be in the pool the rewards were detached from.
+func (k Keeper) attachUnclaimedRewards(ctx sdk.Context, poolId, positionId uint64, spreadRewards sdk.DecCoins, incentivesByUptime []sdk.DecCoins) error {
+	if !spreadRewards.IsZero() {
+		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+		if err != nil {
+			return err
+		}
+		if err := spreadRewardAccumulator.AddToUnclaimedRewards(types.KeySpreadRewardPositionAccumulator(positionId), spreadRewards); err != nil {
+			return err
+		}
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	positionName := string(types.KeyPositionId(positionId))
+	for uptimeIndex, incentives := range incentivesByUptime {
+		if incentives.IsZero() {
+			continue
+		}
+		if err := uptimeAccumulators[uptimeIndex].AddToUnclaimedRewards(positionName, incentives); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
+)
+
+var (
+	shiftRangeWidth         = int64(10_000) * int64(DefaultTickSpacing)
+	shiftDeepLiquidityCoins = sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0.MulRaw(1000)), sdk.NewCoin(USDC, DefaultAmt1.MulRaw(1000)))
+)
+
+func (s *KeeperTestSuite) TestShiftPosition() {
+	tests := map[string]struct {
+		// lowerOffset and upperOffset are added to the pool's current tick, rounded down to the tick spacing.
+		lowerOffset int64
+		upperOffset int64
+		// sameRange shifts the position to its current full range.
+		sameRange        bool
+		notOwner         bool
+		withdrawOther    bool
+		maxSlippage      osmomath.Dec
+		amount1Min       osmomath.Int
+		expectOnlyToken0 bool
+		expectOnlyToken1 bool
+
+		expectedError   error
+		expectedErrorAs any
+	}{
+		"range around the current tick": {
+			lowerOffset: -shiftRangeWidth,
+			upperOffset: shiftRangeWidth,
+		},
+		"range above the current tick holds only token0": {
+			lowerOffset:      shiftRangeWidth,
+			upperOffset:      2 * shiftRangeWidth,
+			expectOnlyToken0: true,
+		},
+		"range below the current tick holds only token1": {
+			lowerOffset:      -2 * shiftRangeWidth,
+			upperOffset:      -shiftRangeWidth,
+			expectOnlyToken1: true,
+		},
+		"error: sender does not own the position": {
+			lowerOffset: -shiftRangeWidth,
+			upperOffset: shiftRangeWidth,
+			notOwner:    true,
+		},
+		"error: same range": {
+			sameRange:     true,
+			expectedError: types.ShiftToSameRangeError{PositionId: 1, LowerTick: DefaultMinTick, UpperTick: DefaultMaxTick},
+		},
+		"error: tick not a multiple of the tick spacing": {
+			lowerOffset:     -shiftRangeWidth + 1,
+			upperOffset:     shiftRangeWidth,
+			expectedErrorAs: &types.TickSpacingError{},
+		},
+		"error: last position in the pool": {
+			lowerOffset:   -shiftRangeWidth,
+			upperOffset:   shiftRangeWidth,
+			withdrawOther: true,
+			expectedError: types.ShiftLastPositionInPoolError{PoolId: 1, PositionId: 1},
+		},
+		"error: rebalancing swap exceeds a zero max slippage": {
+			lowerOffset:     -shiftRangeWidth,
+			upperOffset:     shiftRangeWidth,
+			maxSlippage:     osmomath.ZeroDec(),
+			expectedErrorAs: &types.AmountLessThanMinError{},
+		},
+		"error: new position holds less than the minimum amount": {
+			lowerOffset:     -shiftRangeWidth,
+			upperOffset:     shiftRangeWidth,
+			amount1Min:      DefaultAmt1.MulRaw(10),
+			expectedErrorAs: &types.InsufficientLiquidityCreatedError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool, positionId := s.setupCompoundPool(true)
+			s.setupCompoundIncentives(pool.GetId())
+
+			if tc.withdrawOther {
+				otherPosition, err := clKeeper.GetPosition(s.Ctx, positionId+1)
+				s.Require().NoError(err)
+				_, _, err = clKeeper.WithdrawPosition(s.Ctx, s.TestAccs[1], otherPosition.PositionId, otherPosition.Liquidity)
+				s.Require().NoError(err)
+			} else {
+				// Deep liquidity keeps the price impact of the rebalancing swap small.
+				s.SetupPosition(pool.GetId(), s.TestAccs[2], shiftDeepLiquidityCoins, DefaultMinTick, DefaultMaxTick, false)
+			}
+
+			pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			currentTick := pool.GetCurrentTick() / int64(DefaultTickSpacing) * int64(DefaultTickSpacing)
+			lowerTick, upperTick := currentTick+tc.lowerOffset, currentTick+tc.upperOffset
+			if tc.sameRange {
+				lowerTick, upperTick = DefaultMinTick, DefaultMaxTick
+			}
+
+			owner := s.TestAccs[0]
+			sender := owner
+			expectedError := tc.expectedError
+			if tc.notOwner {
+				sender = s.TestAccs[1]
+				expectedError = types.NotPositionOwnerError{PositionId: positionId, Address: sender.String()}
+			}
+			maxSlippage := compoundMaxSlippage
+			if !tc.maxSlippage.IsNil() {
+				maxSlippage = tc.maxSlippage
+			}
+			amount1Min := osmomath.ZeroInt()
+			if !tc.amount1Min.IsNil() {
+				amount1Min = tc.amount1Min
+			}
+
+			position, err := clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			spreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			incentives, forfeitedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			newPositionData, err := clKeeper.ShiftPosition(s.Ctx, sender, positionId, lowerTick, upperTick, maxSlippage, osmomath.ZeroInt(), amount1Min)
+			if tc.expectedErrorAs != nil {
+				s.Require().ErrorAs(err, tc.expectedErrorAs)
+				return
+			}
+			if expectedError != nil {
+				s.Require().ErrorContains(err, expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+
+			// the position was replaced by one in the new range that keeps its join time
+			_, err = clKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().Error(err)
+			newPosition, err := clKeeper.GetPosition(s.Ctx, newPositionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			s.Require().Equal(lowerTick, newPosition.LowerTick)
+			s.Require().Equal(upperTick, newPosition.UpperTick)
+			s.Require().Equal(position.JoinTime, newPosition.JoinTime)
+			s.Require().Equal(newPositionData.Liquidity, newPosition.Liquidity)
+			if tc.expectOnlyToken0 {
+				s.Require().True(newPositionData.Amount1.IsZero())
+			}
+			if tc.expectOnlyToken1 {
+				s.Require().True(newPositionData.Amount0.IsZero())
+			}
+
+			// unclaimed rewards were carried over rather than paid out
+			s.Require().True(spreadRewards.AmountOf(USDC).IsPositive())
+			s.Require().True(incentives.AmountOf(sdk.DefaultBondDenom).IsPositive())
+			newSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, newPositionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(spreadRewards, newSpreadRewards)
+			newIncentives, newForfeitedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, newPositionData.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(incentives, newIncentives)
+			s.Require().Equal(forfeitedIncentives, newForfeitedIncentives)
+			s.Require().Equal(balancesBefore.AmountOf(sdk.DefaultBondDenom), s.App.BankKeeper.GetBalance(s.Ctx, owner, sdk.DefaultBondDenom).Amount)
+
+			// the rebalancing leaves at most dust of the pool tokens with the owner
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			spotPrice, err := pool.SpotPrice(s.Ctx, USDC, ETH)
+			s.Require().NoError(err)
+			leftoverValue := s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount.Sub(balancesBefore.AmountOf(ETH)).ToLegacyDec().Mul(spotPrice.Dec()).
+				Add(s.App.BankKeeper.GetBalance(s.Ctx, owner, USDC).Amount.Sub(balancesBefore.AmountOf(USDC)).ToLegacyDec())
+			newPositionValue := newPositionData.Amount0.ToLegacyDec().Mul(spotPrice.Dec()).Add(newPositionData.Amount1.ToLegacyDec())
+			s.Require().True(leftoverValue.LTE(newPositionValue.Mul(osmomath.NewDecWithPrec(1, 2))), "leftover %s, new position %s", leftoverValue, newPositionValue)
+		})
+	}
+}
+
+// TestShiftPositionKeepsUptime checks that incentives with a min uptime longer than the position's age are
+// carried over rather than forfeited, and become claimable once the original position would have qualified.
+func (s *KeeperTestSuite) TestShiftPositionKeepsUptime() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	clParams := clKeeper.GetParams(s.Ctx)
+	clParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour * 24}
+	clKeeper.SetParams(s.Ctx, clParams)
+
+	pool, positionId := s.setupCompoundPool(false)
+	s.SetupPosition(pool.GetId(), s.TestAccs[2], shiftDeepLiquidityCoins, DefaultMinTick, DefaultMaxTick, false)
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(compoundIncentiveCoin))
+	_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[2], compoundIncentiveCoin, compoundIncentiveRate, s.Ctx.BlockTime(), time.Hour*24)
+	s.Require().NoError(err)
+	s.AddBlockTime(compoundIncentivePeriod)
+
+	// the incentives would be forfeited if the position were withdrawn now
+	incentives, forfeitedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(incentives.IsZero())
+	s.Require().True(forfeitedIncentives.AmountOf(sdk.DefaultBondDenom).IsPositive())
+
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	currentTick := pool.GetCurrentTick() / int64(DefaultTickSpacing) * int64(DefaultTickSpacing)
+	newPositionData, err := clKeeper.ShiftPosition(s.Ctx, s.TestAccs[0], positionId, currentTick-shiftRangeWidth, currentTick+shiftRangeWidth, compoundMaxSlippage, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+
+	newIncentives, newForfeitedIncentives, err := clKeeper.GetClaimableIncentives(s.Ctx, newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(newIncentives.IsZero())
+	s.Require().Equal(forfeitedIncentives, newForfeitedIncentives)
+
+	// once the original join time is a day old, nothing is forfeited
+	s.AddBlockTime(time.Hour * 24)
+	collected, forfeited, _, err := clKeeper.CollectIncentives(s.Ctx, s.TestAccs[0], newPositionData.ID)
+	s.Require().NoError(err)
+	s.Require().True(forfeited.IsZero())
+	s.Require().True(collected.AmountOf(sdk.DefaultBondDenom).GTE(forfeitedIncentives.AmountOf(sdk.DefaultBondDenom)))
+}
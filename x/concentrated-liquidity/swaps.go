@@ -73,6 +73,9 @@ type SwapResult struct {
 	AmountIn      osmomath.Int
 	AmountOut     osmomath.Int
 	SpreadRewards osmomath.Dec
+	// SpreadFactor is the spread factor that was charged on the swap.
+	// It differs from the requested spread factor for pools using a dynamic spread factor.
+	SpreadFactor osmomath.Dec
 }
 
 // swapNoProgressLimit is the maximum number of iterations that can be performed
@@ -97,9 +100,10 @@ func newSwapState(specifiedAmount osmomath.Int, p types.ConcentratedPoolExtensio
 }
 
 type SwapDetails struct {
-	Sender   sdk.AccAddress
-	TokenIn  sdk.Coin
-	TokenOut sdk.Coin
+	Sender       sdk.AccAddress
+	TokenIn      sdk.Coin
+	TokenOut     sdk.Coin
+	SpreadFactor osmomath.Dec
 }
 
 type PoolUpdates struct {
@@ -287,7 +291,7 @@ func (k Keeper) swapOutAmtGivenIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -319,7 +323,7 @@ func (k *Keeper) swapInAmtGivenOut(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -417,6 +421,27 @@ func (k Keeper) computeOutAmtGivenIn(
 	spreadFactor osmomath.Dec,
 	priceLimit osmomath.BigDec,
 	updateAccumulators bool,
+) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
+	// Pools using a dynamic spread factor charge more than the requested spread factor when volatile,
+	// or when the swap itself moves the price.
+	spreadFactor = k.getSwapSpreadFactor(ctx, poolId, spreadFactor, func(ctx sdk.Context, spreadFactor osmomath.Dec) (PoolUpdates, error) {
+		_, poolUpdates, err := k.computeOutAmtGivenInAtSpreadFactor(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit, false)
+		return poolUpdates, err
+	})
+
+	return k.computeOutAmtGivenInAtSpreadFactor(ctx, poolId, tokenInMin, tokenOutDenom, spreadFactor, priceLimit, updateAccumulators)
+}
+
+// computeOutAmtGivenInAtSpreadFactor is computeOutAmtGivenIn charging the given spread factor,
+// regardless of the pool's dynamic spread factor.
+func (k Keeper) computeOutAmtGivenInAtSpreadFactor(
+	ctx sdk.Context,
+	poolId uint64,
+	tokenInMin sdk.Coin,
+	tokenOutDenom string,
+	spreadFactor osmomath.Dec,
+	priceLimit osmomath.BigDec,
+	updateAccumulators bool,
 ) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
 	p, spreadRewardAccumulator, err := k.swapSetup(ctx, poolId, tokenInMin.Denom, tokenOutDenom, updateAccumulators)
 	if err != nil {
@@ -551,6 +576,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		SpreadFactor:  spreadFactor,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -566,6 +592,27 @@ func (k Keeper) computeInAmtGivenOut(
 	priceLimit osmomath.BigDec,
 	poolId uint64,
 	updateAccumulators bool,
+) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
+	// Pools using a dynamic spread factor charge more than the requested spread factor when volatile,
+	// or when the swap itself moves the price.
+	spreadFactor = k.getSwapSpreadFactor(ctx, poolId, spreadFactor, func(ctx sdk.Context, spreadFactor osmomath.Dec) (PoolUpdates, error) {
+		_, poolUpdates, err := k.computeInAmtGivenOutAtSpreadFactor(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, poolId, false)
+		return poolUpdates, err
+	})
+
+	return k.computeInAmtGivenOutAtSpreadFactor(ctx, desiredTokenOut, tokenInDenom, spreadFactor, priceLimit, poolId, updateAccumulators)
+}
+
+// computeInAmtGivenOutAtSpreadFactor is computeInAmtGivenOut charging the given spread factor,
+// regardless of the pool's dynamic spread factor.
+func (k Keeper) computeInAmtGivenOutAtSpreadFactor(
+	ctx sdk.Context,
+	desiredTokenOut sdk.Coin,
+	tokenInDenom string,
+	spreadFactor osmomath.Dec,
+	priceLimit osmomath.BigDec,
+	poolId uint64,
+	updateAccumulators bool,
 ) (swapResult SwapResult, poolUpdates PoolUpdates, err error) {
 	p, spreadRewardAccumulator, err := k.swapSetup(ctx, poolId, tokenInDenom, desiredTokenOut.Denom, updateAccumulators)
 	if err != nil {
@@ -695,6 +742,7 @@ func (k Keeper) computeInAmtGivenOut(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		SpreadFactor:  spreadFactor,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -829,9 +877,10 @@ func (k Keeper) updatePoolForSwap(
 	// since poolmanager has many swap wrapper APIs that we would need to consider.
 	// Search for references to this function to see where else it is used.
 	// Each new pool module will have to emit this event separately
-	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
+	events.EmitSwapEventWithSpreadFactor(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut}, swapDetails.SpreadFactor)
 
-	return err
+	// Withdraw the limit orders that were fully crossed by this swap to their owners.
+	return k.fillCrossedLimitOrders(ctx, pool, getZeroForOne(swapDetails.TokenIn.Denom, pool.GetToken0()))
 }
 
 func getZeroForOne(inDenom, asset0 string) bool {
@@ -943,7 +992,8 @@ func (k Keeper) ComputeMaxInAmtGivenMaxTicksCrossed(
 	}
 
 	// Setup the swap strategy
-	swapStrategy, _, err := k.setupSwapStrategy(p, p.GetSpreadFactor(cacheCtx), tokenInDenom, osmomath.ZeroBigDec())
+	spreadFactor := k.getEffectiveSpreadFactor(cacheCtx, p, p.GetSpreadFactor(cacheCtx))
+	swapStrategy, _, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, osmomath.ZeroBigDec())
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
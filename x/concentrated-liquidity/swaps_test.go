@@ -3274,7 +3274,7 @@ func (s *KeeperTestSuite) TestUpdatePoolForSwap() {
 
 			expectedSpreadFactors := tc.tokenIn.Amount.ToLegacyDec().Mul(pool.GetSpreadFactor(s.Ctx)).Ceil()
 			expectedSpreadFactorsCoins := sdk.NewCoins(sdk.NewCoin(tc.tokenIn.Denom, expectedSpreadFactors.TruncateInt()))
-			swapDetails := cl.SwapDetails{sender, tc.tokenIn, tc.tokenOut}
+			swapDetails := cl.SwapDetails{sender, tc.tokenIn, tc.tokenOut, tc.spreadFactor}
 			poolUpdates := cl.PoolUpdates{tc.newCurrentTick, tc.newLiquidity, tc.newSqrtPrice}
 			err = s.Clk.UpdatePoolForSwap(s.Ctx, pool, swapDetails, poolUpdates, expectedSpreadFactors)
 
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// ValidateMaxSlippage returns an error if the given max slippage is not in [0, 1).
+func ValidateMaxSlippage(maxSlippage osmomath.Dec) error {
+	if maxSlippage.IsNil() || maxSlippage.IsNegative() || maxSlippage.GTE(osmomath.OneDec()) {
+		return InvalidMaxSlippageError{MaxSlippage: maxSlippage}
+	}
+	return nil
+}
+
+// ValidateTokenMinAmounts returns an error if either of the given token min amounts is nil or negative.
+func ValidateTokenMinAmounts(tokenMinAmount0, tokenMinAmount1 osmomath.Int) error {
+	if tokenMinAmount0.IsNil() || tokenMinAmount0.IsNegative() {
+		return fmt.Errorf("token min amount 0 must not be negative, given: %s", tokenMinAmount0)
+	}
+	if tokenMinAmount1.IsNil() || tokenMinAmount1.IsNegative() {
+		return fmt.Errorf("token min amount 1 must not be negative, given: %s", tokenMinAmount1)
+	}
+	return nil
+}
+
+// Validate returns an error if the config is not well formed.
+func (c AutoCompoundConfig) Validate() error {
+	if c.PositionId == 0 {
+		return fmt.Errorf("auto compound config position id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Owner); err != nil {
+		return fmt.Errorf("invalid auto compound config owner (%s)", err)
+	}
+	if err := ValidateTokenMinAmounts(c.TokenMinAmount0, c.TokenMinAmount1); err != nil {
+		return err
+	}
+	return ValidateMaxSlippage(c.MaxSlippage)
+}
+
+// Validate returns an error if the pending auto compound is not well formed.
+func (p PendingAutoCompound) Validate() error {
+	if p.EpochIdentifier == "" {
+		return fmt.Errorf("pending auto compound epoch identifier must not be empty")
+	}
+	if p.NextPositionId > p.EndPositionId {
+		return fmt.Errorf("pending auto compound next position id (%d) must not exceed its end position id (%d)", p.NextPositionId, p.EndPositionId)
+	}
+	return nil
+}
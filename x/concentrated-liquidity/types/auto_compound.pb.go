@@ -0,0 +1,848 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/auto_compound.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AutoCompoundConfig opts a position into having its spread rewards and
+// incentives compounded back into it by the module at the end of every day
+// epoch. The config follows the position across the new position ids created
+// by compounding, and is dropped once the position is withdrawn or
+// transferred.
+type AutoCompoundConfig struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	// owner is the position owner at the time of opting in.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// max_slippage bounds every swap performed while compounding, relative to
+	// the amount out implied by the arithmetic TWAPs over the last hour along
+	// the swap route.
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage" yaml:"max_slippage"`
+	// token_min_amount0 and token_min_amount1 are the minimum amounts of token0
+	// and token1 that every compounding must add to the position. Compounding
+	// below them is skipped, and the rewards keep accruing. They can be zero,
+	// since the swaps are already bounded by the TWAPs.
+	TokenMinAmount0 cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
+	TokenMinAmount1 cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=cosmossdk.io/math.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+}
+
+func (m *AutoCompoundConfig) Reset()         { *m = AutoCompoundConfig{} }
+func (m *AutoCompoundConfig) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundConfig) ProtoMessage()    {}
+func (*AutoCompoundConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8172876ca7b28712, []int{0}
+}
+func (m *AutoCompoundConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundConfig.Merge(m, src)
+}
+func (m *AutoCompoundConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundConfig proto.InternalMessageInfo
+
+func (m *AutoCompoundConfig) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *AutoCompoundConfig) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// PendingAutoCompound holds the progress of the auto compounding of an ended
+// day epoch, which is split across blocks.
+type PendingAutoCompound struct {
+	// epoch_identifier is the identifier of the ended epoch.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the ended epoch.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// next_position_id is the position id from which the configs are left to
+	// compound.
+	NextPositionId uint64 `protobuf:"varint,3,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty"`
+	// end_position_id is the next position id at the end of the epoch. The
+	// positions created since, including the ones created by compounding, are
+	// compounded at the end of the next epoch.
+	EndPositionId uint64 `protobuf:"varint,4,opt,name=end_position_id,json=endPositionId,proto3" json:"end_position_id,omitempty"`
+}
+
+func (m *PendingAutoCompound) Reset()         { *m = PendingAutoCompound{} }
+func (m *PendingAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*PendingAutoCompound) ProtoMessage()    {}
+func (*PendingAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8172876ca7b28712, []int{1}
+}
+func (m *PendingAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAutoCompound.Merge(m, src)
+}
+func (m *PendingAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAutoCompound proto.InternalMessageInfo
+
+func (m *PendingAutoCompound) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PendingAutoCompound) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PendingAutoCompound) GetNextPositionId() uint64 {
+	if m != nil {
+		return m.NextPositionId
+	}
+	return 0
+}
+
+func (m *PendingAutoCompound) GetEndPositionId() uint64 {
+	if m != nil {
+		return m.EndPositionId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AutoCompoundConfig)(nil), "osmosis.concentratedliquidity.v1beta1.AutoCompoundConfig")
+	proto.RegisterType((*PendingAutoCompound)(nil), "osmosis.concentratedliquidity.v1beta1.PendingAutoCompound")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/auto_compound.proto", fileDescriptor_8172876ca7b28712)
+}
+
+var fileDescriptor_8172876ca7b28712 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0x63, 0x92, 0x22, 0xf5, 0x12, 0x48, 0xb8, 0x02, 0xb2, 0x40, 0xb2, 0x8b, 0x25, 0x50,
+	0x18, 0x6a, 0x63, 0x75, 0x40, 0xcd, 0xd6, 0x94, 0x25, 0x12, 0xa0, 0xca, 0x6c, 0x08, 0x64, 0x9d,
+	0x7d, 0x57, 0xe7, 0xd4, 0xdc, 0xfd, 0x4d, 0x7c, 0x2e, 0xc9, 0x5b, 0xf0, 0x08, 0x8c, 0xbc, 0x00,
+	0xef, 0xd0, 0xb1, 0x23, 0x62, 0xb0, 0x50, 0xb2, 0x30, 0xe7, 0x09, 0x50, 0xce, 0x2e, 0x75, 0x95,
+	0x6e, 0xdd, 0xfc, 0xff, 0xdd, 0xf7, 0xfd, 0x3f, 0x59, 0xdf, 0x1d, 0x3a, 0x80, 0x4c, 0x40, 0xc6,
+	0x33, 0x2f, 0x06, 0x19, 0x33, 0xa9, 0xa6, 0x44, 0x31, 0x3a, 0xe1, 0x5f, 0x72, 0x4e, 0xb9, 0x9a,
+	0x7b, 0x67, 0x7e, 0xc4, 0x14, 0xf1, 0x3d, 0x92, 0x2b, 0x08, 0x63, 0x10, 0x29, 0xe4, 0x92, 0xba,
+	0xe9, 0x14, 0x14, 0xe0, 0xe7, 0x95, 0xd5, 0xbd, 0xd1, 0xea, 0x56, 0xd6, 0x27, 0x0f, 0x13, 0x48,
+	0x40, 0x3b, 0xbc, 0xf5, 0x57, 0x69, 0x76, 0x7e, 0x34, 0x11, 0x3e, 0xcc, 0x15, 0x1c, 0x55, 0x3b,
+	0x8f, 0x40, 0x9e, 0xf0, 0x04, 0xbf, 0x46, 0xed, 0x14, 0x32, 0xae, 0x38, 0xc8, 0x90, 0x53, 0xd3,
+	0xd8, 0x35, 0xfa, 0xad, 0xe1, 0xe3, 0x55, 0x61, 0xe3, 0x39, 0x11, 0x93, 0x81, 0x53, 0x3b, 0x74,
+	0x02, 0x74, 0x39, 0x8d, 0x28, 0x7e, 0x81, 0xb6, 0xe0, 0xab, 0x64, 0x53, 0xf3, 0xce, 0xae, 0xd1,
+	0xdf, 0x1e, 0xf6, 0x56, 0x85, 0xdd, 0x29, 0x2d, 0x1a, 0x3b, 0x41, 0x79, 0x8c, 0x3f, 0xa3, 0x8e,
+	0x20, 0xb3, 0x30, 0x9b, 0xf0, 0x34, 0x25, 0x09, 0x33, 0x9b, 0x5a, 0x3e, 0x38, 0x2f, 0xec, 0xc6,
+	0xef, 0xc2, 0x7e, 0x1a, 0xeb, 0x7f, 0xca, 0xe8, 0xa9, 0xcb, 0xc1, 0x13, 0x44, 0x8d, 0xdd, 0xb7,
+	0x2c, 0x21, 0xf1, 0xfc, 0x0d, 0x8b, 0x57, 0x85, 0xbd, 0x53, 0x6e, 0xac, 0x2f, 0x70, 0x82, 0xb6,
+	0x20, 0xb3, 0x0f, 0xd5, 0x84, 0x19, 0x7a, 0xa0, 0xe0, 0x94, 0xc9, 0x50, 0x70, 0x19, 0x12, 0x01,
+	0xb9, 0x54, 0xaf, 0xcc, 0x96, 0xce, 0x38, 0xa8, 0x32, 0x1e, 0x6d, 0x66, 0x8c, 0xa4, 0x5a, 0x15,
+	0xb6, 0x59, 0x6e, 0xdf, 0xf0, 0x3b, 0x41, 0x57, 0xb3, 0x77, 0x5c, 0x1e, 0x96, 0xe4, 0xa6, 0x18,
+	0xdf, 0xdc, 0xba, 0x55, 0x8c, 0xbf, 0x11, 0xe3, 0x0f, 0x5a, 0x7f, 0xbf, 0xdb, 0x86, 0xf3, 0xd3,
+	0x40, 0x3b, 0xc7, 0x4c, 0x52, 0x2e, 0x93, 0x7a, 0x63, 0xf8, 0x25, 0xea, 0xb1, 0x14, 0xe2, 0x71,
+	0xc8, 0x29, 0x93, 0x8a, 0x9f, 0x70, 0x36, 0xd5, 0x85, 0x6d, 0x07, 0x5d, 0xcd, 0x47, 0xff, 0x31,
+	0x7e, 0x86, 0x3a, 0xa5, 0x54, 0xe6, 0x22, 0xaa, 0x4a, 0x6a, 0x06, 0x6d, 0xcd, 0xde, 0x6b, 0x84,
+	0xfb, 0xa8, 0x27, 0xd9, 0x4c, 0x85, 0xf5, 0xfa, 0xd7, 0xe5, 0xb4, 0x82, 0xfb, 0x6b, 0x7e, 0x5c,
+	0xaf, 0xba, 0xcb, 0x24, 0xbd, 0x26, 0x6c, 0x69, 0xe1, 0x3d, 0x26, 0xe9, 0x95, 0x6e, 0xf8, 0xe9,
+	0x7c, 0x61, 0x19, 0x17, 0x0b, 0xcb, 0xf8, 0xb3, 0xb0, 0x8c, 0x6f, 0x4b, 0xab, 0x71, 0xb1, 0xb4,
+	0x1a, 0xbf, 0x96, 0x56, 0xe3, 0xe3, 0x30, 0xe1, 0x6a, 0x9c, 0x47, 0x6e, 0x0c, 0xc2, 0xab, 0x2e,
+	0xf1, 0xde, 0x84, 0x44, 0xd9, 0xe5, 0xe0, 0x9d, 0xed, 0xfb, 0xde, 0xec, 0xda, 0x93, 0xd8, 0xbb,
+	0x7a, 0x13, 0x6a, 0x9e, 0xb2, 0x2c, 0xba, 0xab, 0xef, 0xf1, 0xfe, 0xbf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x4d, 0xc5, 0xf5, 0x60, 0x41, 0x03, 0x00, 0x00,
+}
+
+func (this *AutoCompoundConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundConfig)
+	if !ok {
+		that2, ok := that.(AutoCompoundConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PositionId != that1.PositionId {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.MaxSlippage.Equal(that1.MaxSlippage) {
+		return false
+	}
+	if !this.TokenMinAmount0.Equal(that1.TokenMinAmount0) {
+		return false
+	}
+	if !this.TokenMinAmount1.Equal(that1.TokenMinAmount1) {
+		return false
+	}
+	return true
+}
+func (m *AutoCompoundConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoCompound(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoCompound(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAutoCompound(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndPositionId != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.EndPositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextPositionId != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintAutoCompound(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintAutoCompound(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAutoCompound(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAutoCompound(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AutoCompoundConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovAutoCompound(uint64(m.PositionId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovAutoCompound(uint64(l))
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovAutoCompound(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovAutoCompound(uint64(l))
+	return n
+}
+
+func (m *PendingAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovAutoCompound(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovAutoCompound(uint64(m.EpochNumber))
+	}
+	if m.NextPositionId != 0 {
+		n += 1 + sovAutoCompound(uint64(m.NextPositionId))
+	}
+	if m.EndPositionId != 0 {
+		n += 1 + sovAutoCompound(uint64(m.EndPositionId))
+	}
+	return n
+}
+
+func sovAutoCompound(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAutoCompound(x uint64) (n int) {
+	return sovAutoCompound(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AutoCompoundConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoCompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPositionId", wireType)
+			}
+			m.NextPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPositionId", wireType)
+			}
+			m.EndPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAutoCompound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAutoCompound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAutoCompound(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAutoCompound
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAutoCompound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAutoCompound
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAutoCompound
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAutoCompound
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAutoCompound        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAutoCompound          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAutoCompound = fmt.Errorf("proto: unexpected end of group")
+)
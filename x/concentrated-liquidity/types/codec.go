@@ -18,11 +18,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/cl-place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "osmosis/cl-cancel-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/cl-claim-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCompoundPosition{}, "osmosis/cl-compound-position", nil)
+	cdc.RegisterConcrete(&MsgSetPositionAutoCompound{}, "osmosis/cl-set-position-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgShiftPosition{}, "osmosis/cl-shift-position", nil)
 
 	// gov proposals
 	// TODO: Keeping CreateConcentratedLiquidityPoolsProposal here for now, until clarity on removing messages from codec. We already removed the functionality in a previous PR.
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SetDynamicSpreadFactorProposal{}, "osmosis/cl-set-dynamic-sf-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,11 +46,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimLimitOrder{},
+		&MsgCompoundPosition{},
+		&MsgSetPositionAutoCompound{},
+		&MsgShiftPosition{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&TickSpacingDecreaseProposal{},
+		&SetDynamicSpreadFactorProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,6 +28,12 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// MaxLimitOrderFillsPerSwap is the maximum number of crossed limit orders a swap fills.
+	// Crossed orders beyond it are left to later swaps or to their owners to claim.
+	MaxLimitOrderFillsPerSwap = 10
+	// FilledLimitOrderRetention is how long a filled limit order is kept for its owner to query.
+	// Filled orders older than it are pruned at the end of the day epoch.
+	FilledLimitOrderRetention = 7 * 24 * time.Hour
 )
 
 var (
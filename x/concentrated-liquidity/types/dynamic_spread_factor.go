@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// IsEnabled returns true if the config opts its pool into the dynamic spread factor.
+// A config with a zero volatility multiplier is treated as an opt-out.
+func (c DynamicSpreadFactorConfig) IsEnabled() bool {
+	return !c.VolatilityMultiplier.IsNil() && c.VolatilityMultiplier.IsPositive()
+}
+
+// Validate returns an error if the config is not well formed.
+// Opt-out configs only need a valid pool id.
+func (c DynamicSpreadFactorConfig) Validate() error {
+	if c.PoolId == 0 {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "pool id must be positive"}
+	}
+	if c.VolatilityMultiplier.IsNil() || c.VolatilityMultiplier.IsNegative() {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "volatility multiplier must be non-negative"}
+	}
+	if !c.IsEnabled() {
+		return nil
+	}
+	if c.MaxSpreadFactor.IsNil() || c.MaxSpreadFactor.IsNegative() || c.MaxSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "max spread factor must be in [0, 1)"}
+	}
+	if c.TwapWindow <= 0 {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "twap window must be positive"}
+	}
+	return nil
+}
+
+// SpreadFactorAt returns the base spread factor increased by the volatility multiplier times the given
+// volatility, capped at the max spread factor.
+func (c DynamicSpreadFactorConfig) SpreadFactorAt(baseSpreadFactor, volatility osmomath.Dec) osmomath.Dec {
+	return osmomath.MinDec(baseSpreadFactor.Add(c.VolatilityMultiplier.Mul(volatility)), c.MaxSpreadFactor)
+}
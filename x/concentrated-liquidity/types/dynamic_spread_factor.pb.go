@@ -0,0 +1,510 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicSpreadFactorConfig opts a concentrated liquidity pool into a
+// volatility-dependent spread factor. The spread factor charged on a swap is
+// the base spread factor plus volatility_multiplier times the larger of the
+// realized volatility of the spot price over twap_window and the absolute log
+// return of the swap's own price move, capped at max_spread_factor.
+type DynamicSpreadFactorConfig struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// volatility_multiplier is the spread factor added per unit of volatility.
+	VolatilityMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+	// max_spread_factor is the upper bound of the effective spread factor.
+	MaxSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_spread_factor,json=maxSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_spread_factor" yaml:"max_spread_factor"`
+	// twap_window is the period over which the realized volatility is measured.
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+}
+
+func (m *DynamicSpreadFactorConfig) Reset()         { *m = DynamicSpreadFactorConfig{} }
+func (m *DynamicSpreadFactorConfig) String() string { return proto.CompactTextString(m) }
+func (*DynamicSpreadFactorConfig) ProtoMessage()    {}
+func (*DynamicSpreadFactorConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81bebf9355d0ef5b, []int{0}
+}
+func (m *DynamicSpreadFactorConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSpreadFactorConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSpreadFactorConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSpreadFactorConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSpreadFactorConfig.Merge(m, src)
+}
+func (m *DynamicSpreadFactorConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSpreadFactorConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSpreadFactorConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSpreadFactorConfig proto.InternalMessageInfo
+
+func (m *DynamicSpreadFactorConfig) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *DynamicSpreadFactorConfig) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicSpreadFactorConfig)(nil), "osmosis.concentratedliquidity.v1beta1.DynamicSpreadFactorConfig")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/dynamic_spread_factor.proto", fileDescriptor_81bebf9355d0ef5b)
+}
+
+var fileDescriptor_81bebf9355d0ef5b = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xec, 0x52, 0x31, 0x05, 0xc5, 0x50, 0x21, 0xad, 0x92, 0x2c, 0x01, 0x61, 0x41,
+	0x3a, 0xc3, 0xda, 0x5b, 0x2f, 0x62, 0xba, 0x08, 0x82, 0x5e, 0xd6, 0x83, 0x50, 0x84, 0x30, 0x99,
+	0x99, 0x4d, 0x87, 0xce, 0xe4, 0xc5, 0x64, 0xb2, 0xbb, 0xf9, 0x16, 0x7a, 0xf3, 0xe8, 0xc7, 0xe9,
+	0xb1, 0x47, 0xf1, 0x10, 0x65, 0xf7, 0xe2, 0x79, 0x3f, 0x81, 0xec, 0x24, 0xb5, 0x2d, 0xf6, 0xd0,
+	0xdb, 0xbc, 0xff, 0xe3, 0xfd, 0x7f, 0x6f, 0xfe, 0x3c, 0xe7, 0x35, 0x94, 0x1a, 0x4a, 0x59, 0x12,
+	0x06, 0x19, 0x13, 0x99, 0x29, 0xa8, 0x11, 0x5c, 0xc9, 0xcf, 0x95, 0xe4, 0xd2, 0xd4, 0x64, 0x36,
+	0x4a, 0x84, 0xa1, 0x23, 0xc2, 0xeb, 0x8c, 0x6a, 0xc9, 0xe2, 0x32, 0x2f, 0x04, 0xe5, 0xf1, 0x94,
+	0x32, 0x03, 0x05, 0xce, 0x0b, 0x30, 0xe0, 0x3e, 0xef, 0x2c, 0xf0, 0xad, 0x16, 0xb8, 0xb3, 0xd8,
+	0xdf, 0x4d, 0x21, 0x05, 0x3b, 0x41, 0x36, 0xaf, 0x76, 0x78, 0xdf, 0x4f, 0x01, 0x52, 0x25, 0x88,
+	0xad, 0x92, 0x6a, 0x4a, 0x78, 0x55, 0x50, 0x23, 0x21, 0x6b, 0xfb, 0xe1, 0xd7, 0x2d, 0x67, 0x6f,
+	0xdc, 0xc2, 0x3f, 0x58, 0xf6, 0x1b, 0x8b, 0x3e, 0x86, 0x6c, 0x2a, 0x53, 0xf7, 0x85, 0x73, 0x3f,
+	0x07, 0x50, 0xb1, 0xe4, 0x1e, 0x1a, 0xa0, 0x61, 0x3f, 0x72, 0xd7, 0x4d, 0xf0, 0xb0, 0xa6, 0x5a,
+	0x1d, 0x85, 0x5d, 0x23, 0x9c, 0x6c, 0x6f, 0x5e, 0x6f, 0xb9, 0xbb, 0x70, 0x9e, 0xcc, 0x40, 0x51,
+	0x23, 0x95, 0x34, 0x75, 0xac, 0x2b, 0x65, 0x64, 0xae, 0xa4, 0x28, 0xbc, 0x7b, 0x03, 0x34, 0x7c,
+	0x10, 0x1d, 0x9f, 0x37, 0x41, 0xef, 0x67, 0x13, 0x3c, 0x65, 0xf6, 0x3f, 0x25, 0x3f, 0xc3, 0x12,
+	0x88, 0xa6, 0xe6, 0x14, 0xbf, 0x13, 0x29, 0x65, 0xf5, 0x58, 0xb0, 0x75, 0x13, 0x3c, 0x6b, 0xdd,
+	0x6f, 0x75, 0x0a, 0x27, 0xbb, 0x57, 0xfa, 0xfb, 0x7f, 0xb2, 0x7b, 0xe6, 0x3c, 0xd6, 0x74, 0x71,
+	0x33, 0x3c, 0x6f, 0xcb, 0x52, 0x5f, 0xdd, 0x8d, 0xea, 0xb5, 0xd4, 0xff, 0x5c, 0xc2, 0xc9, 0x23,
+	0x4d, 0x17, 0xd7, 0x93, 0x71, 0x4f, 0x9c, 0x1d, 0x33, 0xa7, 0x79, 0x3c, 0x97, 0x19, 0x87, 0xb9,
+	0xd7, 0x1f, 0xa0, 0xe1, 0xce, 0xcb, 0x3d, 0xdc, 0xe6, 0x8c, 0x2f, 0x73, 0xc6, 0xe3, 0x2e, 0xe7,
+	0xc8, 0xdf, 0x6c, 0xb0, 0x6e, 0x02, 0xb7, 0x45, 0x5c, 0x9b, 0x0d, 0xbf, 0xfd, 0x0a, 0xd0, 0xc4,
+	0xd9, 0x28, 0x1f, 0xad, 0x70, 0xd4, 0xff, 0xf3, 0x3d, 0x40, 0xd1, 0xa7, 0xf3, 0xa5, 0x8f, 0x2e,
+	0x96, 0x3e, 0xfa, 0xbd, 0xf4, 0xd1, 0x97, 0x95, 0xdf, 0xbb, 0x58, 0xf9, 0xbd, 0x1f, 0x2b, 0xbf,
+	0x77, 0x12, 0xa5, 0xd2, 0x9c, 0x56, 0x09, 0x66, 0xa0, 0x49, 0x77, 0x15, 0x07, 0x8a, 0x26, 0xe5,
+	0x65, 0x41, 0x66, 0x87, 0x23, 0xb2, 0xb8, 0x71, 0x6b, 0x07, 0x57, 0xc7, 0x66, 0xea, 0x5c, 0x94,
+	0xc9, 0xb6, 0x5d, 0xf1, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x80, 0x89, 0x8d, 0x9a,
+	0x02, 0x00, 0x00,
+}
+
+func (this *DynamicSpreadFactorConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicSpreadFactorConfig)
+	if !ok {
+		that2, ok := that.(DynamicSpreadFactorConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if !this.VolatilityMultiplier.Equal(that1.VolatilityMultiplier) {
+		return false
+	}
+	if !this.MaxSpreadFactor.Equal(that1.MaxSpreadFactor) {
+		return false
+	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	return true
+}
+func (m *DynamicSpreadFactorConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicSpreadFactorConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSpreadFactorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSpreadFactor.Size()
+		i -= size
+		if _, err := m.MaxSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintDynamicSpreadFactor(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicSpreadFactor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicSpreadFactor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicSpreadFactorConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovDynamicSpreadFactor(uint64(m.PoolId))
+	}
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = m.MaxSpreadFactor.Size()
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovDynamicSpreadFactor(uint64(l))
+	return n
+}
+
+func sovDynamicSpreadFactor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicSpreadFactor(x uint64) (n int) {
+	return sovDynamicSpreadFactor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicSpreadFactorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicSpreadFactorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicSpreadFactorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicSpreadFactor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicSpreadFactor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicSpreadFactor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicSpreadFactor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicSpreadFactor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicSpreadFactor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicSpreadFactor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicSpreadFactor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicSpreadFactor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicSpreadFactor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicSpreadFactor = fmt.Errorf("proto: unexpected end of group")
+)
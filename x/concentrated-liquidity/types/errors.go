@@ -956,3 +956,122 @@ type InvalidForfeitedIncentivesLengthError struct {
 func (e InvalidForfeitedIncentivesLengthError) Error() string {
 	return fmt.Sprintf("attempted to redeposit incorrectly constructed forfeited incentives slice. forfeited incentives must have an entry for each supported uptime. forfeit entries: %d, expected: %d", e.ForfeitedIncentivesLength, e.ExpectedLength)
 }
+
+type InvalidNextLimitOrderIdError struct {
+	NextLimitOrderId uint64
+}
+
+func (e InvalidNextLimitOrderIdError) Error() string {
+	return fmt.Sprintf("invalid next limit order id (%d), must be positive", e.NextLimitOrderId)
+}
+
+type LimitOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order with id (%d) not found", e.OrderId)
+}
+
+type NotLimitOrderOwnerError struct {
+	OrderId uint64
+	Address string
+}
+
+func (e NotLimitOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of limit order ID (%d)", e.Address, e.OrderId)
+}
+
+type LimitOrderNotOpenError struct {
+	OrderId uint64
+	Status  LimitOrderStatus
+}
+
+func (e LimitOrderNotOpenError) Error() string {
+	return fmt.Sprintf("limit order ID (%d) is not open, status: %s", e.OrderId, e.Status)
+}
+
+type LimitOrderNotCrossedError struct {
+	OrderId  uint64
+	FillTick int64
+}
+
+func (e LimitOrderNotCrossedError) Error() string {
+	return fmt.Sprintf("limit order ID (%d) cannot be claimed, the price has not crossed its fill tick (%d)", e.OrderId, e.FillTick)
+}
+
+type LimitOrderTickOnWrongSideError struct {
+	TickIndex       int64
+	CurrentTick     int64
+	IsSellingToken0 bool
+}
+
+func (e LimitOrderTickOnWrongSideError) Error() string {
+	if e.IsSellingToken0 {
+		return fmt.Sprintf("limit order selling token0 must be placed above the current tick (%d), got tick (%d)", e.CurrentTick, e.TickIndex)
+	}
+	return fmt.Sprintf("limit order selling token1 must be placed at or below the current tick (%d), got tick (%d)", e.CurrentTick, e.TickIndex)
+}
+
+type PositionBacksLimitOrderError struct {
+	PositionId uint64
+	OrderId    uint64
+}
+
+func (e PositionBacksLimitOrderError) Error() string {
+	return fmt.Sprintf("position ID (%d) backs open limit order ID (%d) and can only be modified by cancelling the order", e.PositionId, e.OrderId)
+}
+
+type InvalidDynamicSpreadFactorConfigError struct {
+	PoolId uint64
+	Reason string
+}
+
+func (e InvalidDynamicSpreadFactorConfigError) Error() string {
+	return fmt.Sprintf("invalid dynamic spread factor config for pool ID (%d): %s", e.PoolId, e.Reason)
+}
+
+type MaxSpreadFactorBelowPoolSpreadFactorError struct {
+	PoolId           uint64
+	MaxSpreadFactor  osmomath.Dec
+	PoolSpreadFactor osmomath.Dec
+}
+
+func (e MaxSpreadFactorBelowPoolSpreadFactorError) Error() string {
+	return fmt.Sprintf("max spread factor (%s) is below the spread factor (%s) of pool ID (%d)", e.MaxSpreadFactor, e.PoolSpreadFactor, e.PoolId)
+}
+
+type InvalidMaxSlippageError struct {
+	MaxSlippage osmomath.Dec
+}
+
+func (e InvalidMaxSlippageError) Error() string {
+	return fmt.Sprintf("max slippage must be in [0, 1), got (%s)", e.MaxSlippage)
+}
+
+type NoRewardsToCompoundError struct {
+	PositionId uint64
+}
+
+func (e NoRewardsToCompoundError) Error() string {
+	return fmt.Sprintf("position ID (%d) has no rewards that can be compounded", e.PositionId)
+}
+
+type ShiftLastPositionInPoolError struct {
+	PoolId     uint64
+	PositionId uint64
+}
+
+func (e ShiftLastPositionInPoolError) Error() string {
+	return fmt.Sprintf("Cannot shift a position if it is the last position in the pool. Pool id (%d), position ID (%d).", e.PoolId, e.PositionId)
+}
+
+type ShiftToSameRangeError struct {
+	PositionId uint64
+	LowerTick  int64
+	UpperTick  int64
+}
+
+func (e ShiftToSameRangeError) Error() string {
+	return fmt.Sprintf("position ID (%d) is already in the range lower tick (%d), upper tick (%d)", e.PositionId, e.LowerTick, e.UpperTick)
+}
@@ -15,6 +15,12 @@ const (
 	TypeEvtTransferPositions         = "transfer_positions"
 	TypeEvtInitTick                  = "init_tick"
 	TypeEvtRemoveTick                = "remove_tick"
+	TypeEvtPlaceLimitOrder           = "place_limit_order"
+	TypeEvtCancelLimitOrder          = "cancel_limit_order"
+	TypeEvtFillLimitOrder            = "fill_limit_order"
+	TypeEvtCompoundPosition          = "compound_position"
+	TypeEvtSetPositionAutoCompound   = "set_position_auto_compound"
+	TypeEvtShiftPosition             = "shift_position"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -50,4 +56,6 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyLimitOrderId                                       = "limit_order_id"
+	AttributeKeyAutoCompoundEnabled                                = "auto_compound_enabled"
 )
@@ -36,6 +36,10 @@ type PoolManagerKeeper interface {
 	CreatePool(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (uint64, error)
 	GetNextPoolId(ctx sdk.Context) uint64
 	CreateConcentratedPoolAsPoolManager(ctx sdk.Context, msg poolmanagertypes.CreatePoolMsg) (poolmanagertypes.PoolI, error)
+	RouteCalculateSpotPrice(ctx sdk.Context, poolId uint64, quoteAssetDenom string, baseAssetDenom string) (osmomath.BigDec, error)
+	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount osmomath.Int) (osmomath.Int, sdk.Coin, error)
+	FindSmartRoute(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) ([]poolmanagertypes.SwapAmountInSplitRoute, osmomath.Int, error)
+	SplitRouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []poolmanagertypes.SwapAmountInSplitRoute, tokenInDenom string, tokenOutMinAmount osmomath.Int) (osmomath.Int, error)
 }
 
 type GAMMKeeper interface {
@@ -71,6 +75,13 @@ type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
+// TwapKeeper defines the twap functionality needed to measure the recent volatility of a pool
+// and to bound the swaps of auto compounding.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (osmomath.Dec, error)
+	GetRealizedVolatility(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time) (osmomath.Dec, error)
+}
+
 // ContractKeeper handles logic related to CosmWasm contract interactions.
 type ContractKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
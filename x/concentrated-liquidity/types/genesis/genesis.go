@@ -14,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 		// By default, the migration threshold is set to 0, which means all pools are migrated.
 		IncentivesAccumulatorPoolIdMigrationThreshold: 0,
 		SpreadFactorPoolIdMigrationThreshold:          0,
+		NextLimitOrderId:                              1,
 	}
 }
 
@@ -28,5 +29,23 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	if gs.NextLimitOrderId == 0 {
+		return types.InvalidNextLimitOrderIdError{NextLimitOrderId: gs.NextLimitOrderId}
+	}
+	for _, config := range gs.DynamicSpreadFactorConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, config := range gs.AutoCompoundConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
+	if gs.PendingAutoCompound != nil {
+		if err := gs.PendingAutoCompound.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -253,6 +253,14 @@ type GenesisState struct {
 	NextIncentiveRecordId                         uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
 	IncentivesAccumulatorPoolIdMigrationThreshold uint64         `protobuf:"varint,6,opt,name=incentives_accumulator_pool_id_migration_threshold,json=incentivesAccumulatorPoolIdMigrationThreshold,proto3" json:"incentives_accumulator_pool_id_migration_threshold,omitempty" yaml:"incentives_accumulator_pool_id_migration_threshold"`
 	SpreadFactorPoolIdMigrationThreshold          uint64         `protobuf:"varint,7,opt,name=spread_factor_pool_id_migration_threshold,json=spreadFactorPoolIdMigrationThreshold,proto3" json:"spread_factor_pool_id_migration_threshold,omitempty" yaml:"spread_factor_pool_id_migration_threshold"`
+	// limit_orders contains both open and filled limit orders.
+	LimitOrders                []types1.LimitOrder                `protobuf:"bytes,8,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	NextLimitOrderId           uint64                             `protobuf:"varint,9,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty" yaml:"next_limit_order_id"`
+	DynamicSpreadFactorConfigs []types1.DynamicSpreadFactorConfig `protobuf:"bytes,10,rep,name=dynamic_spread_factor_configs,json=dynamicSpreadFactorConfigs,proto3" json:"dynamic_spread_factor_configs" yaml:"dynamic_spread_factor_configs"`
+	AutoCompoundConfigs        []types1.AutoCompoundConfig        `protobuf:"bytes,11,rep,name=auto_compound_configs,json=autoCompoundConfigs,proto3" json:"auto_compound_configs" yaml:"auto_compound_configs"`
+	// pending_auto_compound is the progress of the auto compounding of an ended
+	// epoch, if it is not done yet.
+	PendingAutoCompound *types1.PendingAutoCompound `protobuf:"bytes,12,opt,name=pending_auto_compound,json=pendingAutoCompound,proto3" json:"pending_auto_compound,omitempty" yaml:"pending_auto_compound"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -337,6 +345,41 @@ func (m *GenesisState) GetSpreadFactorPoolIdMigrationThreshold() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderId() uint64 {
+	if m != nil {
+		return m.NextLimitOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDynamicSpreadFactorConfigs() []types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundConfigs() []types1.AutoCompoundConfig {
+	if m != nil {
+		return m.AutoCompoundConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAutoCompound() *types1.PendingAutoCompound {
+	if m != nil {
+		return m.PendingAutoCompound
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -403,67 +446,80 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x36, 0xb6, 0x9b, 0x8c, 0xdd, 0x92, 0x8e, 0x52, 0xb2, 0x0d, 0xaa, 0xd7, 0x4c, 0x89,
-	0x94, 0x82, 0xe2, 0x25, 0x76, 0x40, 0x02, 0xc1, 0x21, 0x2e, 0x14, 0x19, 0x04, 0x44, 0x43, 0xb8,
-	0xf0, 0x65, 0xc6, 0xbb, 0x63, 0x67, 0xe8, 0x7a, 0xc7, 0xec, 0x8c, 0x43, 0x7c, 0xe5, 0x8e, 0x84,
-	0x38, 0xf1, 0x13, 0xf8, 0x01, 0x48, 0x9c, 0xb9, 0x55, 0x88, 0x43, 0x8f, 0x9c, 0x56, 0x28, 0x39,
-	0x70, 0xf7, 0x2f, 0x40, 0x3b, 0x1f, 0xfe, 0x08, 0x4e, 0xe2, 0x70, 0x9b, 0xf1, 0xfb, 0x3e, 0xcf,
-	0xfb, 0xcc, 0xfb, 0xe5, 0x05, 0x75, 0x2e, 0x7a, 0x5c, 0x30, 0xe1, 0x07, 0x3c, 0x0e, 0x68, 0x2c,
-	0x13, 0x22, 0x69, 0x18, 0xb1, 0x6f, 0x07, 0x2c, 0x64, 0x72, 0xe8, 0x1f, 0xef, 0xb6, 0xa9, 0x24,
-	0xbb, 0x7e, 0x97, 0xc6, 0x54, 0x30, 0x51, 0xed, 0x27, 0x5c, 0x72, 0xb8, 0x65, 0x40, 0xd5, 0xb9,
-	0xa0, 0xaa, 0x01, 0x6d, 0xae, 0x77, 0x79, 0x97, 0x2b, 0x84, 0x9f, 0x9d, 0x34, 0x78, 0xf3, 0x5e,
-	0xa0, 0xd0, 0x2d, 0x6d, 0xd0, 0x17, 0x6b, 0xea, 0x72, 0xde, 0x8d, 0xa8, 0xaf, 0x6e, 0xed, 0x41,
-	0xc7, 0x27, 0xf1, 0xd0, 0x98, 0x5e, 0xb4, 0x3a, 0x49, 0x10, 0x0c, 0x7a, 0x63, 0x5d, 0xea, 0x66,
-	0x5c, 0x5e, 0xbe, 0xfc, 0x29, 0x7d, 0x92, 0x90, 0x9e, 0x8d, 0xb4, 0xb7, 0xd8, 0xb3, 0xfb, 0x5c,
-	0x30, 0xc9, 0x78, 0x6c, 0x50, 0xaf, 0x2d, 0x86, 0x92, 0x2c, 0x78, 0xd2, 0x62, 0x71, 0xc7, 0xbe,
-	0xf8, 0xad, 0xc5, 0x60, 0x4c, 0x19, 0xd9, 0x31, 0x6d, 0x25, 0x34, 0xe0, 0x49, 0xa8, 0xd1, 0xe8,
-	0x4f, 0x07, 0xac, 0x3c, 0x1e, 0x44, 0xd1, 0x21, 0x0b, 0x9e, 0xc0, 0x57, 0xc0, 0xcd, 0x3e, 0xe7,
-	0x51, 0x8b, 0x85, 0xae, 0x53, 0x71, 0xb6, 0x73, 0x0d, 0x38, 0x4a, 0xbd, 0xdb, 0x43, 0xd2, 0x8b,
-	0xde, 0x44, 0xc6, 0x80, 0x70, 0x21, 0x3b, 0x35, 0x43, 0xb8, 0x07, 0x80, 0x91, 0x12, 0xd2, 0x13,
-	0xf7, 0x46, 0xc5, 0xd9, 0x5e, 0x6e, 0xdc, 0x1d, 0xa5, 0xde, 0x1d, 0xed, 0x3f, 0xb1, 0x21, 0xbc,
-	0x9a, 0x5d, 0x9a, 0xd9, 0x19, 0x7e, 0x09, 0x72, 0x99, 0x76, 0x77, 0xb9, 0xe2, 0x6c, 0x17, 0x6b,
-	0x7e, 0x75, 0xa1, 0x5a, 0x57, 0x0f, 0x15, 0xbe, 0xc3, 0x1b, 0xee, 0xd3, 0xd4, 0x5b, 0x1a, 0xa5,
-	0xde, 0xda, 0x4c, 0x90, 0x0e, 0x47, 0x58, 0xd1, 0xa2, 0xdf, 0x72, 0x60, 0xe5, 0x80, 0xf3, 0xe8,
-	0x1d, 0x22, 0x09, 0xac, 0x83, 0x5c, 0xa6, 0x55, 0xbd, 0xa5, 0x58, 0x5b, 0xaf, 0xea, 0xfa, 0x57,
-	0x6d, 0xfd, 0xab, 0xfb, 0xf1, 0xb0, 0xb1, 0xfa, 0xc7, 0xaf, 0x3b, 0xf9, 0x0c, 0xd1, 0xc4, 0xca,
-	0x19, 0x7e, 0x0e, 0xf2, 0x19, 0xab, 0x70, 0x6f, 0x54, 0x96, 0xaf, 0xa1, 0xd0, 0xe6, 0xb0, 0xb1,
-	0x6e, 0x14, 0x96, 0x26, 0x0a, 0x05, 0xc2, 0x9a, 0x13, 0xfe, 0xec, 0x80, 0x7b, 0xa2, 0x9f, 0x50,
-	0x12, 0xb6, 0x12, 0xfa, 0x1d, 0x49, 0xc2, 0x96, 0x6a, 0xb1, 0x41, 0x44, 0x24, 0x4f, 0x4c, 0x4e,
-	0x6a, 0x0b, 0x46, 0xdc, 0xcf, 0x90, 0x1f, 0xb7, 0xbf, 0xa1, 0x81, 0x6c, 0x6c, 0x9b, 0xa0, 0x15,
-	0x1d, 0xf4, 0xc2, 0x10, 0x08, 0x6f, 0x68, 0x1b, 0x56, 0xa6, 0xfd, 0x89, 0x05, 0xfe, 0xe4, 0x80,
-	0x8d, 0x71, 0x8f, 0x88, 0x69, 0x90, 0x70, 0x73, 0x2a, 0x15, 0xff, 0x47, 0xd8, 0x96, 0x11, 0x76,
-	0x5f, 0x0b, 0x9b, 0x1f, 0x00, 0xe1, 0xe7, 0x27, 0x86, 0x29, 0x4d, 0x02, 0x32, 0x70, 0xe7, 0x7c,
-	0xdf, 0x0a, 0x37, 0xaf, 0xd4, 0xbc, 0xbe, 0xa0, 0x9a, 0xa6, 0xc5, 0x63, 0x05, 0x6f, 0xe4, 0x32,
-	0x45, 0x78, 0x8d, 0xcd, 0xfe, 0x2c, 0xd0, 0xef, 0x37, 0x40, 0xe9, 0xc0, 0x0c, 0xa4, 0xea, 0x9e,
-	0x0f, 0xc0, 0x8a, 0x1d, 0x50, 0xd3, 0x41, 0x8b, 0xf6, 0x82, 0xa5, 0xc1, 0x63, 0x82, 0x6c, 0xb2,
-	0x22, 0x9e, 0xf5, 0x6a, 0xa8, 0x26, 0x65, 0x66, 0xb2, 0x8c, 0x01, 0xe1, 0x42, 0x76, 0x6a, 0x86,
-	0xf0, 0x6b, 0xb0, 0x39, 0xa7, 0x82, 0xe6, 0xfd, 0xa6, 0x4b, 0xee, 0x8f, 0xb5, 0xe8, 0x25, 0x65,
-	0x63, 0xcf, 0xbc, 0xf2, 0xbf, 0xc5, 0xd6, 0x66, 0xf8, 0x29, 0x58, 0x1f, 0xf4, 0x25, 0xeb, 0xd1,
-	0x19, 0x6a, 0x5b, 0xe8, 0x85, 0xb8, 0xa1, 0x26, 0x98, 0x62, 0x15, 0xe8, 0x9f, 0x3c, 0x28, 0xbd,
-	0xa7, 0x77, 0xf9, 0x27, 0x92, 0x48, 0x0a, 0x1f, 0x81, 0x82, 0x5e, 0x8c, 0x26, 0x83, 0x5b, 0x57,
-	0x64, 0xf0, 0x40, 0x39, 0x9b, 0x08, 0x06, 0x0a, 0x31, 0x58, 0x55, 0xcb, 0x27, 0x24, 0x92, 0x5c,
-	0x73, 0x2a, 0xed, 0x2a, 0x30, 0x8c, 0x2b, 0x7d, 0xbb, 0x1a, 0xbe, 0x02, 0xb7, 0x6c, 0x6d, 0x34,
-	0xef, 0xb2, 0xe2, 0xad, 0x5f, 0xb3, 0xc2, 0x53, 0xdc, 0xa5, 0xfe, 0x74, 0xf3, 0xbc, 0x0b, 0xd6,
-	0x62, 0x7a, 0x22, 0x5b, 0xe3, 0x20, 0x2c, 0x74, 0x73, 0xaa, 0xf0, 0x2f, 0x8c, 0x52, 0x6f, 0x43,
-	0x17, 0xfe, 0xbc, 0x07, 0xc2, 0xb7, 0xb3, 0x9f, 0x2c, 0x79, 0x33, 0x84, 0x5f, 0x00, 0x57, 0x39,
-	0x9d, 0x1f, 0x82, 0x8c, 0x2e, 0xaf, 0xe8, 0x1e, 0x8c, 0x52, 0xcf, 0x9b, 0xa2, 0x9b, 0xe3, 0x89,
-	0xf0, 0xdd, 0xcc, 0x74, 0x6e, 0x10, 0x9a, 0x21, 0xfc, 0xc5, 0x01, 0xb5, 0xf9, 0x13, 0xd9, 0x32,
-	0xdb, 0xbe, 0xd5, 0x63, 0xdd, 0x84, 0x28, 0x79, 0xf2, 0x28, 0xa1, 0xe2, 0x88, 0x47, 0xa1, 0x5b,
-	0x50, 0x81, 0xdf, 0x1e, 0xa5, 0xde, 0x1b, 0x97, 0x4d, 0xf5, 0x65, 0x1c, 0x08, 0xef, 0xcc, 0x9d,
-	0x78, 0xb5, 0x88, 0xc3, 0x0f, 0x2d, 0xe0, 0xd0, 0xfa, 0xc3, 0x1f, 0x1c, 0xf0, 0xd0, 0xcc, 0x44,
-	0x87, 0x04, 0x57, 0x29, 0xbc, 0xa9, 0x14, 0xee, 0x8d, 0x52, 0xef, 0xd5, 0x99, 0x85, 0x78, 0x35,
-	0x14, 0xe1, 0x97, 0xb4, 0xef, 0x63, 0xe5, 0x7a, 0x91, 0x1e, 0xf4, 0xbd, 0x03, 0x8a, 0x53, 0x7b,
-	0x0e, 0x3e, 0x00, 0xb9, 0x98, 0xf4, 0xa8, 0x6a, 0xf3, 0xd5, 0xc6, 0x73, 0xa3, 0xd4, 0x2b, 0x9a,
-	0xa2, 0x90, 0x1e, 0x45, 0x58, 0x19, 0xe1, 0x47, 0xe0, 0x96, 0x1e, 0xb7, 0x80, 0xc7, 0x92, 0xc6,
-	0x52, 0xad, 0x82, 0x62, 0xed, 0xe1, 0x05, 0xe3, 0x36, 0x95, 0x97, 0x47, 0x1a, 0x80, 0x4b, 0xca,
-	0xc3, 0xdc, 0x1a, 0xe1, 0xd3, 0xd3, 0xb2, 0xf3, 0xec, 0xb4, 0xec, 0xfc, 0x7d, 0x5a, 0x76, 0x7e,
-	0x3c, 0x2b, 0x2f, 0x3d, 0x3b, 0x2b, 0x2f, 0xfd, 0x75, 0x56, 0x5e, 0xfa, 0xec, 0xfd, 0x2e, 0x93,
-	0x47, 0x83, 0x76, 0x35, 0xe0, 0x3d, 0xdf, 0x90, 0xef, 0x44, 0xa4, 0x2d, 0xec, 0xc5, 0x3f, 0xae,
-	0xef, 0xfa, 0x27, 0x33, 0x5f, 0x0c, 0x3b, 0x93, 0x4f, 0x06, 0x39, 0xec, 0x53, 0x61, 0x3f, 0xca,
-	0xda, 0x05, 0xf5, 0x7f, 0x59, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xaf, 0xc8, 0x75, 0xcc,
-	0x09, 0x00, 0x00,
+	// 1167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0x26, 0x4e, 0x9a, 0x8c, 0xdd, 0xfe, 0xd3, 0x49, 0xf2, 0xcf, 0x36, 0x10, 0xdb, 0x6c,
+	0x13, 0x29, 0x05, 0x62, 0x93, 0x17, 0x40, 0xa9, 0x40, 0x22, 0x4e, 0x29, 0x32, 0x50, 0x1a, 0x4d,
+	0xc3, 0xa5, 0xbc, 0x2c, 0xe3, 0xdd, 0xb1, 0x33, 0x74, 0x77, 0x67, 0xd9, 0x19, 0x87, 0xf8, 0xca,
+	0x1d, 0xa9, 0x82, 0x0b, 0x1f, 0x81, 0x2b, 0x12, 0x12, 0x67, 0x6e, 0x15, 0xe2, 0xd0, 0x23, 0x27,
+	0x0b, 0x25, 0x1f, 0x00, 0xc9, 0x9f, 0x00, 0xed, 0xcc, 0xac, 0xbd, 0x4e, 0x9c, 0x74, 0xc3, 0x6d,
+	0xc7, 0xcf, 0xf3, 0xfb, 0x3d, 0xbf, 0x99, 0xe7, 0x65, 0xc6, 0x60, 0x8b, 0x71, 0x9f, 0x71, 0xca,
+	0xab, 0x0e, 0x0b, 0x1c, 0x12, 0x88, 0x08, 0x0b, 0xe2, 0x7a, 0xf4, 0x9b, 0x36, 0x75, 0xa9, 0xe8,
+	0x54, 0x8f, 0x36, 0x1a, 0x44, 0xe0, 0x8d, 0x6a, 0x8b, 0x04, 0x84, 0x53, 0x5e, 0x09, 0x23, 0x26,
+	0x18, 0x5c, 0xd5, 0xa0, 0xca, 0x48, 0x50, 0x45, 0x83, 0x96, 0xe6, 0x5b, 0xac, 0xc5, 0x24, 0xa2,
+	0x1a, 0x7f, 0x29, 0xf0, 0xd2, 0x2d, 0x47, 0xa2, 0x6d, 0x65, 0x50, 0x8b, 0xc4, 0xd4, 0x62, 0xac,
+	0xe5, 0x91, 0xaa, 0x5c, 0x35, 0xda, 0xcd, 0x2a, 0x0e, 0x3a, 0xda, 0xf4, 0x4a, 0xa2, 0x13, 0x3b,
+	0x4e, 0xdb, 0xef, 0xeb, 0x92, 0x2b, 0xed, 0xf2, 0xea, 0xe5, 0x5b, 0x09, 0x71, 0x84, 0xfd, 0x24,
+	0xd2, 0x76, 0xb6, 0x6d, 0x87, 0x8c, 0x53, 0x41, 0x59, 0xa0, 0x51, 0x6f, 0x66, 0x43, 0x09, 0xea,
+	0x3c, 0xb1, 0x69, 0xd0, 0x4c, 0x76, 0xfc, 0x4e, 0x36, 0x18, 0x95, 0x46, 0x7a, 0x44, 0xec, 0x88,
+	0x38, 0x2c, 0x72, 0x35, 0xfa, 0xed, 0x6c, 0x68, 0x8f, 0xfa, 0x54, 0xd8, 0x2c, 0x72, 0x49, 0xa4,
+	0x81, 0xbb, 0xd9, 0x80, 0x6e, 0x27, 0xc0, 0x3e, 0x75, 0x6c, 0x1e, 0x46, 0x04, 0xbb, 0x76, 0x13,
+	0x3b, 0x82, 0x25, 0x14, 0x3b, 0xd9, 0x28, 0x70, 0x5b, 0x30, 0xdb, 0x61, 0x7e, 0xc8, 0xda, 0x81,
+	0x96, 0x6d, 0xfd, 0x69, 0x80, 0xe9, 0xfb, 0x6d, 0xcf, 0x3b, 0xa0, 0xce, 0x13, 0xf8, 0x1a, 0xb8,
+	0x16, 0x32, 0xe6, 0xd9, 0xd4, 0x35, 0x8d, 0xb2, 0xb1, 0x96, 0xab, 0xc1, 0x5e, 0xb7, 0x74, 0xa3,
+	0x83, 0x7d, 0xef, 0xae, 0xa5, 0x0d, 0x16, 0x9a, 0x8a, 0xbf, 0xea, 0x2e, 0xdc, 0x06, 0x40, 0x9f,
+	0xa0, 0x4b, 0x8e, 0xcd, 0xf1, 0xb2, 0xb1, 0x36, 0x51, 0x5b, 0xe8, 0x75, 0x4b, 0x37, 0x95, 0xff,
+	0xc0, 0x66, 0xa1, 0x99, 0x78, 0x51, 0x8f, 0xbf, 0xe1, 0x17, 0x20, 0x17, 0x1f, 0xb9, 0x39, 0x51,
+	0x36, 0xd6, 0xf2, 0x9b, 0xd5, 0x4a, 0xa6, 0x12, 0xad, 0x1c, 0x48, 0x7c, 0x93, 0xd5, 0xcc, 0x67,
+	0xdd, 0xd2, 0x58, 0xaf, 0x5b, 0x9a, 0x1d, 0x0a, 0xd2, 0x64, 0x16, 0x92, 0xb4, 0xd6, 0x6f, 0x39,
+	0x30, 0xbd, 0xcf, 0x98, 0x77, 0x0f, 0x0b, 0x0c, 0xb7, 0x40, 0x2e, 0xd6, 0x2a, 0xf7, 0x92, 0xdf,
+	0x9c, 0xaf, 0xa8, 0xb2, 0xad, 0x24, 0x65, 0x5b, 0xd9, 0x0d, 0x3a, 0xb5, 0x99, 0x3f, 0x7e, 0x5d,
+	0x9f, 0x8c, 0x11, 0x75, 0x24, 0x9d, 0xe1, 0x67, 0x60, 0x32, 0x66, 0xe5, 0xe6, 0x78, 0x79, 0xe2,
+	0x0a, 0x0a, 0x93, 0x33, 0xac, 0xcd, 0x6b, 0x85, 0x85, 0x81, 0x42, 0x6e, 0x21, 0xc5, 0x09, 0x7f,
+	0x32, 0xc0, 0x2d, 0x9d, 0xc0, 0x88, 0x7c, 0x8b, 0x23, 0xd7, 0x96, 0x9d, 0xd1, 0xf6, 0xb0, 0x60,
+	0x91, 0x3e, 0x93, 0xcd, 0x8c, 0x11, 0x77, 0x63, 0xe4, 0xc3, 0xc6, 0xd7, 0xc4, 0x11, 0xb5, 0x35,
+	0x1d, 0xb4, 0xac, 0x82, 0x5e, 0x18, 0xc2, 0x42, 0x8b, 0xca, 0x86, 0xa4, 0x69, 0x77, 0x60, 0x81,
+	0x3f, 0x18, 0x60, 0xb1, 0x5f, 0xda, 0x3c, 0x0d, 0xe2, 0x66, 0x4e, 0x1e, 0xc5, 0x7f, 0x11, 0xb6,
+	0xaa, 0x85, 0x2d, 0x2b, 0x61, 0xa3, 0x03, 0x58, 0xe8, 0xff, 0x03, 0x43, 0x4a, 0x13, 0x87, 0x14,
+	0xdc, 0x3c, 0xdb, 0x6e, 0xdc, 0x9c, 0x94, 0x6a, 0xde, 0xca, 0xa8, 0xa6, 0x9e, 0xe0, 0x91, 0x84,
+	0xd7, 0x72, 0xb1, 0x22, 0x34, 0x4b, 0x87, 0x7f, 0xe6, 0xd6, 0xef, 0xe3, 0xa0, 0xb0, 0xaf, 0xe7,
+	0x88, 0xac, 0x9e, 0x8f, 0xc0, 0x74, 0x32, 0x57, 0x74, 0x05, 0x65, 0xad, 0x85, 0x84, 0x06, 0xf5,
+	0x09, 0xe2, 0xce, 0xf2, 0x58, 0x5c, 0xab, 0xae, 0xec, 0x94, 0xa1, 0xce, 0xd2, 0x06, 0x0b, 0x4d,
+	0xc5, 0x5f, 0x75, 0x17, 0x7e, 0x05, 0x96, 0x46, 0x64, 0x50, 0xef, 0x5f, 0x57, 0xc9, 0x72, 0x5f,
+	0x8b, 0x9a, 0xad, 0x49, 0xec, 0xa1, 0x5d, 0x9e, 0x4f, 0xb6, 0x32, 0xc3, 0x4f, 0xc1, 0x7c, 0x3b,
+	0x14, 0xd4, 0x27, 0x43, 0xd4, 0x49, 0xa2, 0x33, 0x71, 0x43, 0x45, 0x90, 0x62, 0xe5, 0xd6, 0x3f,
+	0x00, 0x14, 0x3e, 0x50, 0x57, 0xd0, 0x23, 0x81, 0x05, 0x81, 0x7b, 0x60, 0x4a, 0xcd, 0x73, 0x7d,
+	0x82, 0xab, 0x2f, 0x38, 0xc1, 0x7d, 0xe9, 0xac, 0x23, 0x68, 0x28, 0x44, 0x60, 0x46, 0x0e, 0x1f,
+	0x17, 0x0b, 0x7c, 0xc5, 0xae, 0x4c, 0x46, 0x81, 0x66, 0x9c, 0x0e, 0x93, 0xd1, 0xf0, 0x25, 0xb8,
+	0x9e, 0xe4, 0x46, 0xf1, 0x4e, 0x48, 0xde, 0xad, 0x2b, 0x66, 0x38, 0xc5, 0x5d, 0x08, 0xd3, 0xc5,
+	0xf3, 0x3e, 0x98, 0x0d, 0xc8, 0xb1, 0xb0, 0xfb, 0x41, 0xa8, 0x6b, 0xe6, 0x64, 0xe2, 0x5f, 0xea,
+	0x75, 0x4b, 0x8b, 0x2a, 0xf1, 0x67, 0x3d, 0x2c, 0x74, 0x23, 0xfe, 0x29, 0x21, 0xaf, 0xbb, 0xf0,
+	0x73, 0x60, 0x4a, 0xa7, 0xb3, 0x4d, 0x10, 0xd3, 0x4d, 0x4a, 0xba, 0xdb, 0xbd, 0x6e, 0xa9, 0x94,
+	0xa2, 0x1b, 0xe1, 0x69, 0xa1, 0x85, 0xd8, 0x74, 0xa6, 0x11, 0xea, 0x2e, 0xfc, 0xd9, 0x00, 0x9b,
+	0xa3, 0x3b, 0xd2, 0xd6, 0xd3, 0xde, 0xf6, 0x69, 0x2b, 0xc2, 0x52, 0x9e, 0x38, 0x8c, 0x08, 0x3f,
+	0x64, 0x9e, 0x6b, 0x4e, 0xc9, 0xc0, 0xef, 0xf6, 0xba, 0xa5, 0x9d, 0xcb, 0xba, 0xfa, 0x32, 0x0e,
+	0x0b, 0xad, 0x8f, 0xec, 0x78, 0x39, 0x88, 0xdd, 0x07, 0x09, 0xe0, 0x20, 0xf1, 0x87, 0xdf, 0x1b,
+	0xe0, 0xce, 0xd0, 0xcd, 0x77, 0xa9, 0xc2, 0x6b, 0x52, 0xe1, 0x76, 0xaf, 0x5b, 0x7a, 0x63, 0x68,
+	0x20, 0xbe, 0x18, 0x6a, 0xa1, 0x15, 0xe5, 0x7b, 0x5f, 0xba, 0x5e, 0xa8, 0xe7, 0x31, 0x28, 0xa4,
+	0x6e, 0x72, 0x6e, 0x4e, 0xcb, 0xf2, 0xd9, 0xc8, 0x58, 0x3e, 0x1f, 0xc7, 0xd0, 0x87, 0x31, 0x52,
+	0x17, 0x4f, 0xde, 0xeb, 0xff, 0xc2, 0xe1, 0x03, 0x30, 0x27, 0x53, 0x99, 0x0a, 0x10, 0xe7, 0x7b,
+	0x46, 0x6e, 0xaa, 0xd8, 0xeb, 0x96, 0x96, 0x52, 0xf9, 0x1e, 0x76, 0xb2, 0x90, 0x2c, 0xbb, 0x01,
+	0x7f, 0xdd, 0x85, 0xbf, 0x18, 0x60, 0x79, 0xe4, 0xe3, 0xc1, 0x76, 0x58, 0xd0, 0xa4, 0x2d, 0x6e,
+	0x02, 0x29, 0xfe, 0xbd, 0x8c, 0xe2, 0xef, 0x29, 0xae, 0x47, 0xa9, 0x63, 0xda, 0x93, 0x44, 0xb5,
+	0xd7, 0xf5, 0xb0, 0x5f, 0x51, 0xfa, 0x2e, 0x0d, 0x6a, 0xa1, 0x25, 0xf7, 0x22, 0x22, 0x0e, 0x7f,
+	0x34, 0xc0, 0xc2, 0xd0, 0x6b, 0xa5, 0xaf, 0x35, 0x2f, 0xb5, 0xee, 0x64, 0xbd, 0x8a, 0xda, 0x82,
+	0xed, 0x69, 0x0a, 0x2d, 0x72, 0x45, 0x8b, 0x7c, 0x59, 0x89, 0x1c, 0x19, 0xc5, 0x42, 0x73, 0xf8,
+	0x1c, 0x92, 0xc3, 0xa7, 0x06, 0x58, 0x08, 0x49, 0xe0, 0xd2, 0xa0, 0x65, 0x0f, 0xe1, 0xcc, 0x82,
+	0x9c, 0x6e, 0x77, 0xb3, 0x4e, 0x0f, 0xc5, 0x91, 0x16, 0x57, 0x2b, 0x0f, 0x24, 0x8d, 0x0c, 0x61,
+	0xa1, 0xb9, 0xf0, 0x3c, 0xcc, 0xfa, 0xce, 0x00, 0xf9, 0xd4, 0x7d, 0x0b, 0x6f, 0x83, 0x5c, 0x80,
+	0x7d, 0x22, 0xc7, 0xed, 0x4c, 0xed, 0x7f, 0xbd, 0x6e, 0x29, 0xaf, 0x8b, 0x05, 0xfb, 0xc4, 0x42,
+	0xd2, 0x08, 0x3f, 0x01, 0xd7, 0xd5, 0xd8, 0x77, 0x58, 0x20, 0x48, 0x20, 0xe4, 0x95, 0x94, 0xdf,
+	0xbc, 0x73, 0xc1, 0xd8, 0x4f, 0xf5, 0xe7, 0x9e, 0x02, 0xa0, 0x82, 0xf4, 0xd0, 0xab, 0x9a, 0xfb,
+	0xec, 0xa4, 0x68, 0x3c, 0x3f, 0x29, 0x1a, 0x7f, 0x9f, 0x14, 0x8d, 0xa7, 0xa7, 0xc5, 0xb1, 0xe7,
+	0xa7, 0xc5, 0xb1, 0xbf, 0x4e, 0x8b, 0x63, 0x8f, 0x3f, 0x6c, 0x51, 0x71, 0xd8, 0x6e, 0x54, 0x1c,
+	0xe6, 0x57, 0x35, 0xf9, 0xba, 0x87, 0x1b, 0x3c, 0x59, 0x54, 0x8f, 0xb6, 0x36, 0xaa, 0xc7, 0x43,
+	0xcf, 0xd6, 0xf5, 0xc1, 0xbb, 0x55, 0x74, 0x42, 0xc2, 0x93, 0xff, 0x34, 0x8d, 0x29, 0xf9, 0x6e,
+	0xdb, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x3e, 0xde, 0x1f, 0x0b, 0x0d, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -680,6 +736,65 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingAutoCompound != nil {
+		{
+			size, err := m.PendingAutoCompound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AutoCompoundConfigs) > 0 {
+		for iNdEx := len(m.AutoCompoundConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DynamicSpreadFactorConfigs) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactorConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactorConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SpreadFactorPoolIdMigrationThreshold))
 		i--
@@ -900,6 +1015,31 @@ func (m *GenesisState) Size() (n int) {
 	if m.SpreadFactorPoolIdMigrationThreshold != 0 {
 		n += 1 + sovGenesis(uint64(m.SpreadFactorPoolIdMigrationThreshold))
 	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderId))
+	}
+	if len(m.DynamicSpreadFactorConfigs) > 0 {
+		for _, e := range m.DynamicSpreadFactorConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundConfigs) > 0 {
+		for _, e := range m.AutoCompoundConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingAutoCompound != nil {
+		l = m.PendingAutoCompound.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1646,6 +1786,163 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderId", wireType)
+			}
+			m.NextLimitOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactorConfigs = append(m.DynamicSpreadFactorConfigs, types1.DynamicSpreadFactorConfig{})
+			if err := m.DynamicSpreadFactorConfigs[len(m.DynamicSpreadFactorConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundConfigs = append(m.AutoCompoundConfigs, types1.AutoCompoundConfig{})
+			if err := m.AutoCompoundConfigs[len(m.AutoCompoundConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAutoCompound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingAutoCompound == nil {
+				m.PendingAutoCompound = &types1.PendingAutoCompound{}
+			}
+			if err := m.PendingAutoCompound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
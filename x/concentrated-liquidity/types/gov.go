@@ -8,15 +8,18 @@ import (
 )
 
 const (
-	ProposalTypeTickSpacingDecrease = "TickSpacingDecrease"
+	ProposalTypeTickSpacingDecrease    = "TickSpacingDecrease"
+	ProposalTypeSetDynamicSpreadFactor = "SetDynamicSpreadFactor"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDynamicSpreadFactor)
 }
 
 var (
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetDynamicSpreadFactorProposal{}
 )
 
 // String returns a string containing the pool incentives proposal.
@@ -94,3 +97,65 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetDynamicSpreadFactorProposal(title, description string, configs []DynamicSpreadFactorConfig) govtypesv1.Content {
+	return &SetDynamicSpreadFactorProposal{
+		Title:       title,
+		Description: description,
+		Configs:     configs,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetDynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Configs) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := make(map[uint64]bool, len(p.Configs))
+	for _, config := range p.Configs {
+		if seenPoolIds[config.PoolId] {
+			return fmt.Errorf("duplicate config for pool ID %d", config.PoolId)
+		}
+		seenPoolIds[config.PoolId] = true
+
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a string containing the set dynamic spread factor proposal.
+func (p SetDynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, config := range p.Configs {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, VolatilityMultiplier: %s, MaxSpreadFactor: %s, TwapWindow: %s) ", config.PoolId, config.VolatilityMultiplier, config.MaxSpreadFactor, config.TwapWindow)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Spread Factor Proposal:
+Title:       %s
+Description: %s
+Records:     %s
+`, p.Title, p.Description, recordsStr))
+	return b.String()
+}
@@ -163,6 +163,49 @@ func (m *PoolIdToTickSpacingRecord) GetNewTickSpacing() uint64 {
 	return 0
 }
 
+// SetDynamicSpreadFactorProposal is a gov Content type for opting pools into
+// a volatility-dependent spread factor, or updating their configuration.
+// A config with a zero volatility multiplier opts its pool out. The proposal
+// will fail if one of the pools does not exist or if the max spread factor is
+// below the pool's spread factor.
+type SetDynamicSpreadFactorProposal struct {
+	Title       string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Configs     []DynamicSpreadFactorConfig `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs"`
+}
+
+func (m *SetDynamicSpreadFactorProposal) Reset()      { *m = SetDynamicSpreadFactorProposal{} }
+func (*SetDynamicSpreadFactorProposal) ProtoMessage() {}
+func (*SetDynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicSpreadFactorProposal proto.InternalMessageInfo
+
 type PoolRecord struct {
 	Denom0       string                      `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
 	Denom1       string                      `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
@@ -174,7 +217,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,6 +271,7 @@ func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
+	proto.RegisterType((*SetDynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetDynamicSpreadFactorProposal")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
 
@@ -236,42 +280,45 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x5b, 0x27, 0xc0, 0x25, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84, 0x14,
-	0x86, 0xda, 0x98, 0x6e, 0x61, 0x41, 0x69, 0x85, 0x04, 0xea, 0x50, 0x99, 0x4e, 0x08, 0x29, 0x5c,
-	0xce, 0x87, 0x7b, 0x8a, 0xe3, 0xe7, 0xfa, 0xae, 0x29, 0xf9, 0x0f, 0x90, 0x60, 0x60, 0x64, 0xcc,
-	0x9f, 0xd3, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0xbf, 0x00, 0xe5, 0x2e, 0x3f, 0x9c,
-	0x42, 0x25, 0x10, 0x9b, 0xef, 0xee, 0xfb, 0xbe, 0xf7, 0x7d, 0xef, 0xf9, 0x21, 0x0f, 0x78, 0x0f,
-	0x38, 0xe3, 0x1e, 0x81, 0x84, 0xd0, 0x44, 0x64, 0x58, 0xd0, 0x30, 0x66, 0x67, 0xe7, 0x2c, 0x64,
-	0x62, 0xe0, 0xf5, 0xfd, 0x0e, 0x15, 0xd8, 0xf7, 0x22, 0xe8, 0xbb, 0x69, 0x06, 0x02, 0xcc, 0x87,
-	0x73, 0x82, 0xfb, 0x47, 0x82, 0x3b, 0x27, 0xec, 0x56, 0x22, 0x88, 0x40, 0x32, 0xbc, 0xd9, 0x97,
-	0x22, 0x3b, 0x13, 0x1d, 0x35, 0x0e, 0x32, 0x8a, 0x05, 0x3d, 0xc8, 0xb1, 0x8f, 0x16, 0xec, 0x63,
-	0x80, 0x98, 0x1f, 0x67, 0x90, 0x02, 0xc7, 0xb1, 0x59, 0x41, 0x05, 0xc1, 0x44, 0x4c, 0xab, 0x7a,
-	0x5d, 0x6f, 0xdc, 0x09, 0xd4, 0xc1, 0xac, 0xa3, 0x52, 0x48, 0x39, 0xc9, 0x58, 0x2a, 0x18, 0x24,
-	0xd5, 0x0d, 0xf9, 0x96, 0xbf, 0x32, 0xcf, 0x50, 0x39, 0x05, 0x88, 0xdb, 0x19, 0x25, 0x90, 0x85,
-	0xbc, 0xba, 0x59, 0xdf, 0x6c, 0x94, 0x9e, 0xf8, 0xee, 0x5f, 0x19, 0x77, 0x67, 0x1e, 0x02, 0xc9,
-	0x6c, 0xd5, 0x2e, 0x47, 0xb6, 0x36, 0x1d, 0xd9, 0xf7, 0x07, 0xb8, 0x17, 0x37, 0x9d, 0xbc, 0xa8,
-	0x13, 0x94, 0xd2, 0x25, 0x90, 0x37, 0xcb, 0x1f, 0x86, 0xb6, 0xf6, 0x65, 0x68, 0x6b, 0x3f, 0x86,
-	0xb6, 0xee, 0xfc, 0xd4, 0x51, 0xed, 0x84, 0x91, 0xee, 0xab, 0x14, 0x13, 0x96, 0x44, 0x87, 0x94,
-	0x64, 0x14, 0x73, 0xfa, 0xdf, 0xc1, 0x3e, 0xea, 0xc8, 0x96, 0x26, 0x58, 0xd8, 0x16, 0xd0, 0x16,
-	0x8c, 0x74, 0xdb, 0x5c, 0xd5, 0xb8, 0x16, 0xf6, 0xd9, 0x3f, 0x84, 0x7d, 0x11, 0x9e, 0x40, 0xce,
-	0xed, 0x3c, 0xbb, 0x31, 0xcb, 0x1e, 0xec, 0xa6, 0x37, 0x01, 0xae, 0x67, 0x0e, 0xd1, 0x83, 0x1b,
-	0xc5, 0xcc, 0x1d, 0x74, 0x6b, 0xee, 0x5b, 0x46, 0x36, 0x82, 0xa2, 0xd2, 0x35, 0x1b, 0x68, 0x3b,
-	0xa1, 0x17, 0x6b, 0x49, 0x64, 0x70, 0x23, 0xb8, 0x9b, 0xd0, 0x8b, 0x9c, 0x50, 0xd3, 0x90, 0x55,
-	0x3e, 0x6d, 0x20, 0xb4, 0x1a, 0x90, 0xf9, 0x08, 0x15, 0x43, 0x9a, 0x40, 0xef, 0xb1, 0xea, 0x64,
-	0xeb, 0xde, 0x74, 0x64, 0x6f, 0xa9, 0x61, 0xa9, 0x7b, 0x27, 0x98, 0x03, 0x96, 0x50, 0x5f, 0x35,
-	0xf6, 0x37, 0xa8, 0xbf, 0x80, 0xfa, 0x66, 0x13, 0x95, 0xd7, 0x0c, 0x6d, 0xce, 0x0c, 0xb5, 0x76,
-	0x56, 0x3f, 0x42, 0xfe, 0xd5, 0x09, 0x4a, 0x62, 0x65, 0xd3, 0x7c, 0x8b, 0xb6, 0x78, 0x9a, 0x51,
-	0x1c, 0xb6, 0xdf, 0x61, 0x22, 0x20, 0xab, 0x16, 0x64, 0xb5, 0xa7, 0xb3, 0x6e, 0x7e, 0x1b, 0xd9,
-	0x35, 0x22, 0xe7, 0xc2, 0xc3, 0xae, 0xcb, 0xc0, 0xeb, 0x61, 0x71, 0xea, 0x1e, 0xd1, 0x08, 0x93,
-	0xc1, 0x21, 0x25, 0xd3, 0x91, 0x5d, 0x51, 0xfa, 0x6b, 0x0a, 0x4e, 0x50, 0x56, 0xe7, 0xe7, 0xf2,
-	0xa8, 0x1a, 0xf1, 0xd2, 0xb8, 0x6d, 0x6c, 0x17, 0x5a, 0x6f, 0x2e, 0xc7, 0x96, 0x7e, 0x35, 0xb6,
-	0xf4, 0xef, 0x63, 0x4b, 0xff, 0x3c, 0xb1, 0xb4, 0xab, 0x89, 0xa5, 0x7d, 0x9d, 0x58, 0xda, 0xeb,
-	0x56, 0xc4, 0xc4, 0xe9, 0x79, 0xc7, 0x25, 0xd0, 0x5b, 0x6c, 0xf8, 0x5e, 0x8c, 0x3b, 0x7c, 0xb9,
-	0xee, 0xfd, 0x7d, 0xdf, 0x7b, 0xbf, 0xb6, 0xf4, 0x7b, 0xab, 0xad, 0x17, 0x83, 0x94, 0xf2, 0x4e,
-	0x51, 0xee, 0xec, 0xfe, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0xce, 0x43, 0x80, 0x23, 0x04,
-	0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xb5, 0x5b, 0xb7, 0x85, 0x4b, 0x8a, 0x8a, 0xa9, 0xd4, 0xd0, 0x48, 0x76, 0x64, 0x09, 0x29,
+	0x0c, 0xb5, 0x31, 0xdd, 0xc2, 0x02, 0x49, 0x84, 0x04, 0xea, 0x50, 0x39, 0x9d, 0x10, 0x92, 0x7b,
+	0x39, 0x5f, 0xdd, 0x53, 0x6c, 0x9f, 0xeb, 0xbb, 0xa6, 0xe4, 0x1f, 0x20, 0xc1, 0xc0, 0xc8, 0x98,
+	0x9f, 0x93, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0x5f, 0x80, 0x72, 0x76, 0x1a, 0x27,
+	0x4a, 0xa5, 0x56, 0xdd, 0x72, 0x77, 0xdf, 0xf7, 0xbe, 0xf7, 0x5e, 0x9e, 0x3f, 0x60, 0x51, 0x16,
+	0x52, 0x46, 0x98, 0x85, 0x68, 0x84, 0x70, 0xc4, 0x13, 0xc8, 0xb1, 0x17, 0x90, 0x8b, 0x4b, 0xe2,
+	0x11, 0xde, 0xb3, 0xba, 0x76, 0x1b, 0x73, 0x68, 0x5b, 0x3e, 0xed, 0x9a, 0x71, 0x42, 0x39, 0x55,
+	0x5f, 0x64, 0x0d, 0xe6, 0xca, 0x06, 0x33, 0x6b, 0xd8, 0xdf, 0xf5, 0xa9, 0x4f, 0x45, 0x87, 0x35,
+	0xfd, 0x95, 0x36, 0xef, 0xbf, 0xbb, 0xdb, 0x34, 0xaf, 0x17, 0xc1, 0x90, 0x20, 0x97, 0xc5, 0x09,
+	0x86, 0x9e, 0x7b, 0x06, 0x11, 0xa7, 0x49, 0x0a, 0x61, 0x8c, 0x65, 0x50, 0x6d, 0x24, 0x18, 0x72,
+	0xdc, 0xc8, 0x61, 0x1c, 0xcd, 0x30, 0x8e, 0x29, 0x0d, 0xd8, 0x71, 0x42, 0x63, 0xca, 0x60, 0xa0,
+	0xee, 0x82, 0x0d, 0x4e, 0x78, 0x80, 0x4b, 0x72, 0x45, 0xae, 0x3e, 0x76, 0xd2, 0x83, 0x5a, 0x01,
+	0x05, 0x0f, 0x33, 0x94, 0x90, 0x98, 0x13, 0x1a, 0x95, 0xd6, 0xc4, 0x5b, 0xfe, 0x4a, 0xbd, 0x00,
+	0xc5, 0x98, 0xd2, 0xc0, 0x4d, 0x30, 0xa2, 0x89, 0xc7, 0x4a, 0xeb, 0x95, 0xf5, 0x6a, 0xe1, 0xb5,
+	0x6d, 0xde, 0x49, 0xbb, 0x39, 0xe5, 0xe0, 0x88, 0xce, 0x7a, 0x79, 0x30, 0xd4, 0xa5, 0xc9, 0x50,
+	0x7f, 0xd6, 0x83, 0x61, 0x50, 0x33, 0xf2, 0xa0, 0x86, 0x53, 0x88, 0x6f, 0x0a, 0x59, 0xad, 0xf8,
+	0xb5, 0xaf, 0x4b, 0x3f, 0xfb, 0xba, 0xf4, 0xb7, 0xaf, 0xcb, 0xc6, 0x3f, 0x19, 0x94, 0x4f, 0x08,
+	0xea, 0xb4, 0x62, 0x88, 0x48, 0xe4, 0x37, 0x31, 0x4a, 0x30, 0x64, 0xf8, 0xc1, 0xc2, 0xbe, 0xc9,
+	0x40, 0x17, 0x24, 0x88, 0xe7, 0x72, 0xea, 0x72, 0x82, 0x3a, 0x2e, 0x4b, 0x67, 0x2c, 0x89, 0x7d,
+	0x7b, 0x0f, 0xb1, 0x1f, 0xbc, 0x13, 0x9a, 0x63, 0x9b, 0x69, 0x57, 0xa6, 0xda, 0x9d, 0xfd, 0xf8,
+	0xb6, 0x82, 0x65, 0xcd, 0x1e, 0x78, 0x7e, 0x2b, 0x98, 0xba, 0x07, 0xb6, 0x32, 0xde, 0x42, 0xb2,
+	0xe2, 0x6c, 0xa6, 0xb8, 0x6a, 0x15, 0xec, 0x44, 0xf8, 0x6a, 0x41, 0x89, 0x10, 0xae, 0x38, 0x4f,
+	0x22, 0x7c, 0x95, 0x03, 0xaa, 0x29, 0x62, 0xca, 0x40, 0x06, 0x5a, 0x0b, 0xf3, 0x66, 0x1a, 0xb1,
+	0x96, 0x48, 0xd8, 0x7b, 0x11, 0xb0, 0x07, 0x9b, 0x7b, 0x0a, 0xb6, 0x10, 0x8d, 0xce, 0x88, 0x7f,
+	0x5f, 0x0f, 0x57, 0x90, 0x69, 0x08, 0xa0, 0xcc, 0xc3, 0x19, 0xec, 0x92, 0x61, 0xdf, 0xd7, 0x00,
+	0x98, 0x67, 0x4d, 0x7d, 0x09, 0x36, 0x3d, 0x1c, 0xd1, 0xf0, 0x55, 0xca, 0xbb, 0xfe, 0x74, 0x32,
+	0xd4, 0xb7, 0xd3, 0xdc, 0xa5, 0xf7, 0x86, 0x93, 0x15, 0xdc, 0x94, 0xda, 0xa5, 0xb5, 0x95, 0xa5,
+	0xf6, 0xac, 0xd4, 0x56, 0x6b, 0xa0, 0xb8, 0xe0, 0xed, 0xfa, 0xd4, 0xdb, 0xfa, 0xde, 0x3c, 0xd3,
+	0xf9, 0x57, 0xc3, 0x29, 0xf0, 0xb9, 0xe3, 0xea, 0x29, 0xd8, 0x5e, 0xf8, 0x84, 0x4b, 0x1b, 0x62,
+	0xda, 0x9b, 0xa9, 0xa8, 0xdf, 0x43, 0xbd, 0x8c, 0x84, 0x3d, 0xcc, 0xeb, 0x98, 0x84, 0x5a, 0x21,
+	0xe4, 0xe7, 0xe6, 0x11, 0xf6, 0x21, 0xea, 0x35, 0x31, 0x9a, 0x0c, 0xf5, 0xdd, 0x14, 0x7f, 0x01,
+	0xc1, 0x70, 0x8a, 0x2c, 0xe7, 0x52, 0xfa, 0x9f, 0x7e, 0x54, 0x1e, 0x29, 0x3b, 0x1b, 0xf5, 0xcf,
+	0x83, 0x91, 0x26, 0x5f, 0x8f, 0x34, 0xf9, 0xcf, 0x48, 0x93, 0x7f, 0x8c, 0x35, 0xe9, 0x7a, 0xac,
+	0x49, 0xbf, 0xc6, 0x9a, 0xf4, 0xa9, 0xee, 0x13, 0x7e, 0x7e, 0xd9, 0x36, 0x11, 0x0d, 0x67, 0xfb,
+	0xee, 0x20, 0x80, 0x6d, 0x36, 0x3b, 0x58, 0xdd, 0x43, 0xdb, 0xfa, 0xb2, 0xb0, 0x94, 0x0e, 0xe6,
+	0x5b, 0x89, 0xf7, 0x62, 0xcc, 0xda, 0x9b, 0x62, 0xfd, 0x1c, 0xfe, 0x1f, 0x00, 0xd2, 0x07, 0xb5,
+	0xa1, 0x31, 0x05, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -371,6 +418,41 @@ func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetDynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(SetDynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Configs) != len(that1.Configs) {
+		return false
+	}
+	for i := range this.Configs {
+		if !this.Configs[i].Equal(&that1.Configs[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *PoolRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -539,6 +621,57 @@ func (m *PoolIdToTickSpacingRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -663,6 +796,29 @@ func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	return n
 }
 
+func (m *SetDynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PoolRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1075,6 +1231,154 @@ func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, DynamicSpreadFactorConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,10 +2,12 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 )
 
@@ -48,3 +50,64 @@ func TestTickSpacingDecreaseProposalMarshalUnmarshal(t *testing.T) {
 		require.Equal(t, *test.proposal, decoded)
 	}
 }
+
+func TestSetDynamicSpreadFactorProposalValidateBasic(t *testing.T) {
+	validConfig := types.DynamicSpreadFactorConfig{
+		PoolId:               1,
+		VolatilityMultiplier: osmomath.NewDecWithPrec(5, 1),
+		MaxSpreadFactor:      osmomath.NewDecWithPrec(1, 2),
+		TwapWindow:           time.Hour,
+	}
+	withConfig := func(modify func(*types.DynamicSpreadFactorConfig)) types.DynamicSpreadFactorConfig {
+		config := validConfig
+		modify(&config)
+		return config
+	}
+
+	tests := map[string]struct {
+		configs     []types.DynamicSpreadFactorConfig
+		expectError bool
+	}{
+		"valid config": {
+			configs: []types.DynamicSpreadFactorConfig{validConfig},
+		},
+		"opt-out config without bounds": {
+			configs: []types.DynamicSpreadFactorConfig{{PoolId: 1, VolatilityMultiplier: osmomath.ZeroDec()}},
+		},
+		"no configs": {
+			expectError: true,
+		},
+		"duplicate pool id": {
+			configs:     []types.DynamicSpreadFactorConfig{validConfig, validConfig},
+			expectError: true,
+		},
+		"zero pool id": {
+			configs:     []types.DynamicSpreadFactorConfig{withConfig(func(c *types.DynamicSpreadFactorConfig) { c.PoolId = 0 })},
+			expectError: true,
+		},
+		"negative volatility multiplier": {
+			configs:     []types.DynamicSpreadFactorConfig{withConfig(func(c *types.DynamicSpreadFactorConfig) { c.VolatilityMultiplier = osmomath.NewDec(-1) })},
+			expectError: true,
+		},
+		"max spread factor of one": {
+			configs:     []types.DynamicSpreadFactorConfig{withConfig(func(c *types.DynamicSpreadFactorConfig) { c.MaxSpreadFactor = osmomath.OneDec() })},
+			expectError: true,
+		},
+		"zero twap window": {
+			configs:     []types.DynamicSpreadFactorConfig{withConfig(func(c *types.DynamicSpreadFactorConfig) { c.TwapWindow = 0 })},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			proposal := types.NewSetDynamicSpreadFactorProposal("title", "description", tc.configs)
+			err := proposal.ValidateBasic()
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
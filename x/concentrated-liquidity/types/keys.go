@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -53,6 +55,20 @@ var (
 	KeyIncentiveAccumulatorMigrationThreshold    = []byte{0x15}
 	KeySpreadRewardAccumulatorMigrationThreshold = []byte{0x16}
 
+	KeyNextGlobalLimitOrderId = []byte{0x17}
+	LimitOrderPrefix          = []byte{0x18}
+	LimitOrderOwnerPrefix     = []byte{0x19}
+	LimitOrderPoolPrefix      = []byte{0x1A}
+	LimitOrderFillTickPrefix  = []byte{0x1B}
+	LimitOrderPositionPrefix  = []byte{0x1C}
+
+	DynamicSpreadFactorConfigPrefix = []byte{0x1D}
+
+	AutoCompoundConfigPrefix = []byte{0x1E}
+	KeyPendingAutoCompound   = []byte{0x1F}
+
+	LimitOrderFilledTimePrefix = []byte{0x20}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + Uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -279,6 +295,89 @@ func KeyBalancerFullRange(clPoolId, balancerPoolId, uptimeIndex uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s%d%s%d", BalancerFullRangePrefix, KeySeparator, clPoolId, KeySeparator, balancerPoolId, KeySeparator, uptimeIndex))
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrder returns the key used to store the limit order with the given id.
+func KeyLimitOrder(orderId uint64) []byte {
+	return append(append([]byte{}, LimitOrderPrefix...), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyLimitOrdersByOwner returns the prefix key of all limit orders of the given owner with the given status.
+// This key can be used to iterate over a user's orders across all pools.
+func KeyLimitOrdersByOwner(owner sdk.AccAddress, status LimitOrderStatus) []byte {
+	key := append([]byte{}, LimitOrderOwnerPrefix...)
+	key = append(key, byte(status))
+	return append(key, address.MustLengthPrefix(owner)...)
+}
+
+// KeyLimitOrdersByOwnerAndPool returns the prefix key of all limit orders of the given owner
+// in the given pool with the given status.
+func KeyLimitOrdersByOwnerAndPool(owner sdk.AccAddress, poolId uint64, status LimitOrderStatus) []byte {
+	return append(KeyLimitOrdersByOwner(owner, status), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyLimitOrderByOwner returns the full key linking an owner to one of its limit orders.
+func KeyLimitOrderByOwner(owner sdk.AccAddress, poolId uint64, status LimitOrderStatus, orderId uint64) []byte {
+	return append(KeyLimitOrdersByOwnerAndPool(owner, poolId, status), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyLimitOrdersByPool returns the prefix key of all limit orders in the given pool with the given status.
+func KeyLimitOrdersByPool(poolId uint64, status LimitOrderStatus) []byte {
+	key := append([]byte{}, LimitOrderPoolPrefix...)
+	key = append(key, byte(status))
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyLimitOrderByPool returns the full key linking a pool to one of its limit orders.
+func KeyLimitOrderByPool(poolId uint64, status LimitOrderStatus, orderId uint64) []byte {
+	return append(KeyLimitOrdersByPool(poolId, status), sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyLimitOrdersByFillTick returns the prefix key of the open limit orders in the given pool
+// on the given side of the book. Keys under this prefix are ordered by fill tick, so
+// orders selling token0 can be iterated in the order a rising price crosses them and
+// orders selling token1 in reverse.
+func KeyLimitOrdersByFillTick(poolId uint64, isSellingToken0 bool) []byte {
+	key := append([]byte{}, LimitOrderFillTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	if isSellingToken0 {
+		return append(key, 0x01)
+	}
+	return append(key, 0x00)
+}
+
+// KeyLimitOrderByFillTick returns the full key of an open limit order in the fill tick index.
+func KeyLimitOrderByFillTick(poolId uint64, isSellingToken0 bool, fillTick int64, orderId uint64) []byte {
+	key := append(KeyLimitOrdersByFillTick(poolId, isSellingToken0), TickIndexToBytes(fillTick)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyLimitOrderByFilledTime returns the full key of a filled limit order in the filled time index.
+func KeyLimitOrderByFilledTime(filledTime time.Time, orderId uint64) []byte {
+	key := append([]byte{}, LimitOrderFilledTimePrefix...)
+	key = append(key, sdk.FormatTimeBytes(filledTime)...)
+	return append(key, sdk.Uint64ToBigEndian(orderId)...)
+}
+
+// KeyLimitOrderIdForPosition returns the key linking a position to the open limit order it backs.
+func KeyLimitOrderIdForPosition(positionId uint64) []byte {
+	return append(append([]byte{}, LimitOrderPositionPrefix...), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// Dynamic Spread Factor Prefix Keys
+
+// KeyDynamicSpreadFactorConfig returns the key used to store the dynamic spread factor config of the given pool.
+func KeyDynamicSpreadFactorConfig(poolId uint64) []byte {
+	return append(append([]byte{}, DynamicSpreadFactorConfigPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Auto Compound Prefix Keys
+
+// KeyAutoCompoundConfig returns the key used to store the auto compound config of the given position.
+func KeyAutoCompoundConfig(positionId uint64) []byte {
+	return append(append([]byte{}, AutoCompoundConfigPrefix...), sdk.Uint64ToBigEndian(positionId)...)
+}
+
 // Helper Functions
 func GetPoolIdFromShareDenom(denom string) (uint64, error) {
 	if !strings.HasPrefix(denom, ConcentratedLiquidityTokenPrefix) {
@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/limit_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitOrderStatus is the lifecycle state of a limit order.
+type LimitOrderStatus int32
+
+const (
+	// LimitOrderOpen is an order whose liquidity is still in the pool.
+	LimitOrderOpen LimitOrderStatus = 0
+	// LimitOrderFilled is an order whose fill tick was crossed by a swap and
+	// whose proceeds were withdrawn to the owner. Filled orders are kept for a
+	// week for their owners to query, then pruned at the end of the day epoch.
+	LimitOrderFilled LimitOrderStatus = 1
+)
+
+var LimitOrderStatus_name = map[int32]string{
+	0: "LimitOrderOpen",
+	1: "LimitOrderFilled",
+}
+
+var LimitOrderStatus_value = map[string]int32{
+	"LimitOrderOpen":   0,
+	"LimitOrderFilled": 1,
+}
+
+func (x LimitOrderStatus) String() string {
+	return proto.EnumName(LimitOrderStatus_name, int32(x))
+}
+
+func (LimitOrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_86b1e750dd898c01, []int{0}
+}
+
+// LimitOrder is a single-sided, single-tick-width position that is
+// automatically withdrawn once a swap crosses its fill tick.
+// An order selling token0 occupies [tick_index, tick_index + tick_spacing]
+// and fills when the price rises through its upper tick. An order selling
+// token1 occupies [tick_index - tick_spacing, tick_index] and fills when the
+// price falls through its lower tick.
+type LimitOrder struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId  uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// position_id is the id of the position backing the order. The position
+	// no longer exists once the order is filled.
+	PositionId uint64 `protobuf:"varint,4,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	LowerTick  int64  `protobuf:"varint,5,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick  int64  `protobuf:"varint,6,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// token_in is the amount actually deposited into the backing position.
+	TokenIn       types.Coin       `protobuf:"bytes,7,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom string           `protobuf:"bytes,8,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	Status        LimitOrderStatus `protobuf:"varint,9,opt,name=status,proto3,enum=osmosis.concentratedliquidity.v1beta1.LimitOrderStatus" json:"status,omitempty" yaml:"status"`
+	PlacedTime    time.Time        `protobuf:"bytes,10,opt,name=placed_time,json=placedTime,proto3,stdtime" json:"placed_time" yaml:"placed_time"`
+	// tokens_out is the liquidity withdrawn to the owner when the order was
+	// filled. Spread rewards and incentives are paid out separately by the
+	// withdrawal. Empty for open orders.
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
+	// filled_time is the block time at which the order was filled. Zero for
+	// open orders.
+	FilledTime time.Time `protobuf:"bytes,12,opt,name=filled_time,json=filledTime,proto3,stdtime" json:"filled_time" yaml:"filled_time"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86b1e750dd898c01, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetStatus() LimitOrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LimitOrderOpen
+}
+
+func (m *LimitOrder) GetPlacedTime() time.Time {
+	if m != nil {
+		return m.PlacedTime
+	}
+	return time.Time{}
+}
+
+func (m *LimitOrder) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetFilledTime() time.Time {
+	if m != nil {
+		return m.FilledTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.concentratedliquidity.v1beta1.LimitOrderStatus", LimitOrderStatus_name, LimitOrderStatus_value)
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/limit_order.proto", fileDescriptor_86b1e750dd898c01)
+}
+
+var fileDescriptor_86b1e750dd898c01 = []byte{
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0xaf, 0x6d, 0xd2, 0x4c, 0x7a, 0x49, 0xe7, 0x2b, 0xc5, 0x64, 0x61, 0x47, 0x96,
+	0x40, 0x11, 0xa8, 0x63, 0xb5, 0x45, 0xaa, 0xc4, 0xd2, 0x5c, 0xa4, 0x48, 0xa0, 0x48, 0xa6, 0x2b,
+	0x40, 0x8a, 0x7c, 0x99, 0x86, 0x51, 0x6c, 0x8f, 0xc9, 0x8c, 0x5b, 0xba, 0x62, 0xcb, 0xb2, 0xef,
+	0xc0, 0x8e, 0x27, 0xe9, 0xb2, 0x4b, 0x56, 0x2e, 0x6a, 0x37, 0xac, 0xfd, 0x04, 0x68, 0x66, 0xec,
+	0x38, 0x54, 0x08, 0xc1, 0x2a, 0x73, 0x2e, 0xbf, 0x93, 0xf9, 0x9f, 0x73, 0x3c, 0xe0, 0x90, 0xb2,
+	0x98, 0x32, 0xc2, 0xec, 0x80, 0x26, 0x01, 0x4e, 0xf8, 0xcc, 0xe3, 0x38, 0x8c, 0xc8, 0x87, 0x8c,
+	0x84, 0x84, 0x9f, 0xd9, 0x27, 0x7b, 0x3e, 0xe6, 0xde, 0x9e, 0x1d, 0x91, 0x98, 0xf0, 0x31, 0x9d,
+	0x85, 0x78, 0x86, 0xd2, 0x19, 0xe5, 0x14, 0xde, 0x2f, 0x41, 0xf4, 0x5b, 0x10, 0x95, 0x60, 0x6f,
+	0x7b, 0x42, 0x27, 0x54, 0x12, 0xb6, 0x38, 0x29, 0xb8, 0x67, 0x4e, 0x28, 0x9d, 0x44, 0xd8, 0x96,
+	0x96, 0x9f, 0x1d, 0xdb, 0x9c, 0xc4, 0x98, 0x71, 0x2f, 0x4e, 0xcb, 0x04, 0x23, 0x90, 0xe5, 0x6d,
+	0xdf, 0x63, 0x78, 0x7e, 0x89, 0x80, 0x92, 0x44, 0xc5, 0xad, 0x1f, 0x4d, 0x00, 0x5e, 0x8a, 0x3b,
+	0x8d, 0xc4, 0x95, 0x20, 0x02, 0xab, 0xf2, 0x6e, 0x63, 0x12, 0xea, 0x5a, 0x5f, 0x1b, 0x2c, 0x3b,
+	0xff, 0x17, 0xb9, 0xb9, 0x79, 0xe6, 0xc5, 0xd1, 0x13, 0xab, 0x8a, 0x58, 0x6e, 0x4b, 0x1e, 0x87,
+	0x21, 0x7c, 0x00, 0x56, 0xe8, 0x69, 0x82, 0x67, 0xfa, 0x7f, 0x7d, 0x6d, 0xd0, 0x76, 0xba, 0x45,
+	0x6e, 0xae, 0x95, 0xc9, 0xc2, 0x6d, 0xb9, 0x2a, 0x0c, 0x1f, 0x81, 0x56, 0x4a, 0x69, 0x24, 0xca,
+	0x2e, 0xc9, 0xb2, 0xb0, 0xc8, 0xcd, 0x0d, 0x95, 0x59, 0x06, 0x2c, 0xb7, 0x29, 0x4e, 0xc3, 0x10,
+	0x1e, 0x82, 0x4e, 0x4a, 0x19, 0xe1, 0x84, 0x26, 0x02, 0x58, 0x96, 0xc0, 0x4e, 0x91, 0x9b, 0xb0,
+	0x02, 0xe6, 0x41, 0xcb, 0x05, 0x95, 0x35, 0x0c, 0xe1, 0x63, 0x00, 0x22, 0x7a, 0x8a, 0x67, 0x63,
+	0x4e, 0x82, 0xa9, 0xbe, 0xd2, 0xd7, 0x06, 0x4b, 0xce, 0x9d, 0x22, 0x37, 0xb7, 0x14, 0x57, 0xc7,
+	0x2c, 0xb7, 0x2d, 0x8d, 0x23, 0x12, 0x4c, 0x05, 0x95, 0xa5, 0x69, 0x45, 0x35, 0x6f, 0x53, 0x75,
+	0xcc, 0x72, 0xdb, 0xd2, 0x90, 0xd4, 0x2b, 0xb0, 0xca, 0xe9, 0x14, 0x27, 0x63, 0x92, 0xe8, 0xad,
+	0xbe, 0x36, 0xe8, 0xec, 0xdf, 0x43, 0xaa, 0xd7, 0x48, 0xf4, 0xba, 0x9a, 0x1b, 0x7a, 0x4a, 0x49,
+	0xe2, 0xdc, 0xbd, 0xc8, 0xcd, 0x46, 0xdd, 0xc8, 0x0a, 0xb4, 0xdc, 0x96, 0x3c, 0x0e, 0x13, 0xe8,
+	0x80, 0x4d, 0xe5, 0xa5, 0x19, 0x1f, 0x87, 0x38, 0xa1, 0xb1, 0xbe, 0x2a, 0x5b, 0xda, 0x2b, 0x72,
+	0x73, 0x67, 0x11, 0x9b, 0x27, 0x58, 0xee, 0xba, 0xf4, 0x8c, 0x32, 0xfe, 0x4c, 0xd8, 0xd0, 0x07,
+	0x4d, 0xc6, 0x3d, 0x9e, 0x31, 0xbd, 0xdd, 0xd7, 0x06, 0x1b, 0xfb, 0x87, 0xe8, 0xaf, 0x56, 0x0b,
+	0xd5, 0xf3, 0x7f, 0x2d, 0x71, 0x67, 0xab, 0xc8, 0xcd, 0x75, 0xf5, 0x9f, 0xaa, 0xa0, 0xe5, 0x96,
+	0x95, 0xe1, 0x5b, 0xd0, 0x49, 0x23, 0x2f, 0xc0, 0xe1, 0x58, 0x6c, 0x9a, 0x0e, 0xa4, 0xf2, 0x1e,
+	0x52, 0x6b, 0x88, 0xaa, 0x35, 0x44, 0x47, 0xd5, 0x1a, 0x3a, 0x46, 0x29, 0xbd, 0x9a, 0x5d, 0x0d,
+	0x5b, 0xe7, 0x57, 0xa6, 0xe6, 0x02, 0xe5, 0x11, 0x00, 0xfc, 0x04, 0x80, 0x54, 0xc4, 0x84, 0x48,
+	0xbd, 0xd3, 0x5f, 0xfa, 0x73, 0x57, 0x9f, 0x97, 0xa5, 0xb7, 0x16, 0xda, 0x23, 0x51, 0xeb, 0xeb,
+	0x95, 0x39, 0x98, 0x10, 0xfe, 0x3e, 0xf3, 0x51, 0x40, 0x63, 0xbb, 0xfc, 0x06, 0xd4, 0xcf, 0x2e,
+	0x0b, 0xa7, 0x36, 0x3f, 0x4b, 0x31, 0x93, 0x55, 0x98, 0xdb, 0x56, 0xe0, 0x28, 0xe3, 0x42, 0xdd,
+	0x31, 0x89, 0xa2, 0x4a, 0xdd, 0xda, 0xbf, 0xaa, 0x5b, 0x80, 0x4b, 0x75, 0xca, 0x23, 0x80, 0x87,
+	0x0e, 0xe8, 0xde, 0xee, 0x34, 0x84, 0x60, 0xa3, 0xf6, 0x8d, 0x52, 0x9c, 0x74, 0x1b, 0x70, 0x7b,
+	0x31, 0xef, 0x85, 0xe4, 0xbb, 0x5a, 0x6f, 0xf9, 0xf3, 0x17, 0xa3, 0xe1, 0xbc, 0xbb, 0xb8, 0x36,
+	0xb4, 0xcb, 0x6b, 0x43, 0xfb, 0x7e, 0x6d, 0x68, 0xe7, 0x37, 0x46, 0xe3, 0xf2, 0xc6, 0x68, 0x7c,
+	0xbb, 0x31, 0x1a, 0x6f, 0x9c, 0x05, 0xbd, 0xe5, 0xd8, 0x77, 0x23, 0xcf, 0x67, 0x95, 0x61, 0x9f,
+	0x1c, 0xec, 0xd9, 0x1f, 0x7f, 0x79, 0x9d, 0x76, 0xeb, 0xe7, 0x49, 0xf6, 0xc3, 0x6f, 0x4a, 0x85,
+	0x07, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xda, 0xff, 0x37, 0x05, 0xcc, 0x04, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FilledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FilledTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLimitOrder(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PlacedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlacedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLimitOrder(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if m.Status != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UpperTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.OrderId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PositionId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.UpperTick))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovLimitOrder(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlacedTime)
+	n += 1 + l + sovLimitOrder(uint64(l))
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovLimitOrder(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FilledTime)
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func sovLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitOrder(x uint64) (n int) {
+	return sovLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LimitOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PlacedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FilledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
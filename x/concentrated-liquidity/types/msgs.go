@@ -19,6 +19,12 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceLimitOrder         = "place-limit-order"
+	TypeMsgCancelLimitOrder        = "cancel-limit-order"
+	TypeMsgClaimLimitOrder         = "claim-limit-order"
+	TypeMsgCompoundPosition        = "compound-position"
+	TypeMsgSetPositionAutoCompound = "set-position-auto-compound"
+	TypeMsgShiftPosition           = "shift-position"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -239,3 +245,160 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return fmt.Errorf("Invalid token in (%s)", msg.TokenIn)
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCompoundPosition{}
+
+func (msg MsgCompoundPosition) Route() string { return RouterKey }
+func (msg MsgCompoundPosition) Type() string  { return TypeMsgCompoundPosition }
+func (msg MsgCompoundPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if err := ValidateTokenMinAmounts(msg.TokenMinAmount0, msg.TokenMinAmount1); err != nil {
+		return err
+	}
+
+	return ValidateMaxSlippage(msg.MaxSlippage)
+}
+
+func (msg MsgCompoundPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPositionAutoCompound{}
+
+func (msg MsgSetPositionAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetPositionAutoCompound) Type() string  { return TypeMsgSetPositionAutoCompound }
+func (msg MsgSetPositionAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.Enabled {
+		if err := ValidateTokenMinAmounts(msg.TokenMinAmount0, msg.TokenMinAmount1); err != nil {
+			return err
+		}
+		return ValidateMaxSlippage(msg.MaxSlippage)
+	}
+
+	return nil
+}
+
+func (msg MsgSetPositionAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgShiftPosition{}
+
+func (msg MsgShiftPosition) Route() string { return RouterKey }
+func (msg MsgShiftPosition) Type() string  { return TypeMsgShiftPosition }
+func (msg MsgShiftPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId <= 0 {
+		return fmt.Errorf("Invalid position id (%s)", strconv.FormatUint(msg.PositionId, 10))
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if msg.TokenMinAmount0.IsNil() || msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNil() || msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return ValidateMaxSlippage(msg.MaxSlippage)
+}
+
+func (msg MsgShiftPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -439,3 +439,222 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgCompoundPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgCompoundPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero max slippage",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				MaxSlippage:     osmomath.ZeroDec(),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          invalidAddr.String(),
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil max slippage",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative max slippage",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				MaxSlippage:     osmomath.NewDecWithPrec(-1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token min amount",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.NewInt(-1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil token min amount",
+			msg: types.MsgCompoundPosition{
+				PositionId:  1,
+				Sender:      addr1,
+				MaxSlippage: osmomath.NewDecWithPrec(1, 2),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max slippage of one",
+			msg: types.MsgCompoundPosition{
+				PositionId:      1,
+				Sender:          addr1,
+				MaxSlippage:     osmomath.OneDec(),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCompoundPosition)
+	}
+}
+
+func TestMsgSetPositionAutoCompound(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgSetPositionAutoCompound
+		expectPass bool
+	}{
+		{
+			name: "proper msg enabling",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId:      1,
+				Sender:          addr1,
+				Enabled:         true,
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "disabling ignores the max slippage and the token min amounts",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId:      1,
+				Sender:          invalidAddr.String(),
+				Enabled:         true,
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "enabling with a negative token min amount",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId:      1,
+				Sender:          addr1,
+				Enabled:         true,
+				MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+				TokenMinAmount0: osmomath.NewInt(-1),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "enabling with max slippage above one",
+			msg: types.MsgSetPositionAutoCompound{
+				PositionId:      1,
+				Sender:          addr1,
+				Enabled:         true,
+				MaxSlippage:     osmomath.NewDec(2),
+				TokenMinAmount0: osmomath.ZeroInt(),
+				TokenMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetPositionAutoCompound)
+	}
+}
+
+func TestMsgShiftPosition(t *testing.T) {
+	validMsg := func() types.MsgShiftPosition {
+		return types.MsgShiftPosition{
+			PositionId:      1,
+			Sender:          addr1,
+			LowerTick:       -100,
+			UpperTick:       100,
+			MaxSlippage:     osmomath.NewDecWithPrec(1, 2),
+			TokenMinAmount0: osmomath.ZeroInt(),
+			TokenMinAmount1: osmomath.ZeroInt(),
+		}
+	}
+	tests := []struct {
+		name       string
+		modify     func(*types.MsgShiftPosition)
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			modify:     func(*types.MsgShiftPosition) {},
+			expectPass: true,
+		},
+		{
+			name:   "invalid sender",
+			modify: func(msg *types.MsgShiftPosition) { msg.Sender = invalidAddr.String() },
+		},
+		{
+			name:   "zero position id",
+			modify: func(msg *types.MsgShiftPosition) { msg.PositionId = 0 },
+		},
+		{
+			name:   "lower tick equal to upper tick",
+			modify: func(msg *types.MsgShiftPosition) { msg.LowerTick = msg.UpperTick },
+		},
+		{
+			name:   "negative token min amount 0",
+			modify: func(msg *types.MsgShiftPosition) { msg.TokenMinAmount0 = osmomath.NewInt(-1) },
+		},
+		{
+			name:   "nil token min amount 1",
+			modify: func(msg *types.MsgShiftPosition) { msg.TokenMinAmount1 = osmomath.Int{} },
+		},
+		{
+			name:   "max slippage of one",
+			modify: func(msg *types.MsgShiftPosition) { msg.MaxSlippage = osmomath.OneDec() },
+		},
+	}
+	for _, test := range tests {
+		msg := validMsg()
+		test.modify(&msg)
+		runValidateBasicTest(t, test.name, &msg, test.expectPass, types.TypeMsgShiftPosition)
+	}
+}
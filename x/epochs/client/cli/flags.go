@@ -0,0 +1,15 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagIdentifier = "identifier"
+)
+
+func FlagSetIdentifier() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagIdentifier, "", "The identifier of the epoch")
+	return fs
+}
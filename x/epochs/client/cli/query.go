@@ -2,6 +2,7 @@ package cli
 
 import (
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -12,6 +13,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdEpochInfos)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdCurrentEpoch)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdPendingEpochWork)
+	cmd.AddCommand(
+		osmocli.GetParams[*types.QueryParamsRequest](
+			types.ModuleName, types.NewQueryClient),
+	)
 
 	return cmd
 }
@@ -34,3 +40,14 @@ func GetCmdCurrentEpoch() (*osmocli.QueryDescriptor, *types.QueryCurrentEpochReq
 {{.CommandPrefix}} day`,
 	}, &types.QueryCurrentEpochRequest{}
 }
+
+func GetCmdPendingEpochWork() (*osmocli.QueryDescriptor, *types.QueryPendingEpochWorkRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pending-epoch-work",
+		Short: "Query the unfinished work of epoch hooks, optionally for a specified identifier.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} --identifier=day`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetIdentifier()}},
+		CustomFlagOverrides: map[string]string{"identifier": FlagIdentifier},
+	}, &types.QueryPendingEpochWorkRequest{}
+}
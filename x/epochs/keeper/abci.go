@@ -13,6 +13,12 @@ import (
 // BeginBlocker of epochs module.
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// Continue the unfinished work of epochs that ended in earlier blocks before ending any new epoch.
+	// All epoch work in this block shares the budget, including the work other modules bound by GetBlockEpochWorkBudget.
+	budget := k.startBlockEpochWorkBudget(ctx)
+	k.continuePendingEpochWork(ctx, budget)
+
 	k.IterateEpochInfo(ctx, func(index int64, epochInfo types.EpochInfo) (stop bool) {
 		logger := k.Logger(ctx)
 
@@ -43,7 +49,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 					sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
 				),
 			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			k.afterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, budget)
 			epochInfo.CurrentEpoch += 1
 			epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
 			logger.Info(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// GetPendingEpochWork returns the unfinished work of epoch hooks for the given epoch identifier,
+// or for all epoch identifiers if it is empty.
+func (k Keeper) GetPendingEpochWork(ctx sdk.Context, identifier string) []types.EpochWork {
+	prefix := types.KeyPrefixEpochWork
+	if identifier != "" {
+		prefix = types.KeyEpochWorkPrefix(identifier)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	pendingEpochWork := []types.EpochWork{}
+	for ; iterator.Valid(); iterator.Next() {
+		epochWork := types.EpochWork{}
+		err := proto.Unmarshal(iterator.Value(), &epochWork)
+		if err != nil {
+			panic(err)
+		}
+		pendingEpochWork = append(pendingEpochWork, epochWork)
+	}
+	return pendingEpochWork
+}
+
+// setEpochWork sets the unfinished work of an epoch hook.
+func (k Keeper) setEpochWork(ctx sdk.Context, epochWork types.EpochWork) {
+	store := ctx.KVStore(k.storeKey)
+	value, err := proto.Marshal(&epochWork)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.KeyEpochWork(epochWork.EpochIdentifier, epochWork.ModuleName), value)
+}
+
+// deleteEpochWork deletes the unfinished work of an epoch hook.
+func (k Keeper) deleteEpochWork(ctx sdk.Context, epochWork types.EpochWork) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyEpochWork(epochWork.EpochIdentifier, epochWork.ModuleName))
+}
+
+// GetBlockEpochWorkBudget returns the epoch work budget of the current block, shared by all epoch work in the block.
+// Epoch work that other modules do outside of the epoch hooks, e.g. in their begin blocker, should be bounded by it,
+// so that the epoch work of a block stays within EpochWorkGasPerBlock.
+// If the budget of the block was not started by the begin blocker, a new budget is returned.
+func (k Keeper) GetBlockEpochWorkBudget(ctx sdk.Context) types.EpochWorkBudget {
+	gasLimit := k.GetParams(ctx).EpochWorkGasPerBlock
+	bz := ctx.TransientStore(k.transientKey).Get(types.KeyEpochWorkGasStart)
+	if bz == nil {
+		return types.NewEpochWorkBudget(ctx, gasLimit)
+	}
+	return types.NewEpochWorkBudgetFrom(sdk.BigEndianToUint64(bz), gasLimit)
+}
+
+// startBlockEpochWorkBudget starts the epoch work budget of the current block from the gas consumed so far.
+func (k Keeper) startBlockEpochWorkBudget(ctx sdk.Context) types.EpochWorkBudget {
+	gasStart := ctx.GasMeter().GasConsumed()
+	ctx.TransientStore(k.transientKey).Set(types.KeyEpochWorkGasStart, sdk.Uint64ToBigEndian(gasStart))
+	return types.NewEpochWorkBudgetFrom(gasStart, k.GetParams(ctx).EpochWorkGasPerBlock)
+}
+
+// startEpochWork records the work of the given hook for the epoch that just ended, and continues it within
+// the given budget.
+// CONTRACT: the unfinished work of earlier epochs with the same identifier was finished.
+func (k Keeper) startEpochWork(ctx sdk.Context, hook types.EpochWorkHooks, identifier string, epochNumber int64, budget types.EpochWorkBudget) {
+	epochWork := types.EpochWork{
+		ModuleName:      hook.GetModuleName(),
+		EpochIdentifier: identifier,
+		EpochNumber:     epochNumber,
+		StartHeight:     ctx.BlockHeight(),
+	}
+	k.setEpochWork(ctx, epochWork)
+	if !budget.Exhausted(ctx) {
+		k.continueEpochWork(ctx, epochWork, budget)
+	}
+}
+
+// continuePendingEpochWork continues the unfinished work of ended epochs, in the order of their keys,
+// until the given budget is exhausted.
+// The first unfinished work is always continued, so that work progresses however small the budget is.
+func (k Keeper) continuePendingEpochWork(ctx sdk.Context, budget types.EpochWorkBudget) {
+	for i, epochWork := range k.GetPendingEpochWork(ctx, "") {
+		if i > 0 && budget.Exhausted(ctx) {
+			return
+		}
+		k.continueEpochWork(ctx, epochWork, budget)
+	}
+}
+
+// finishPendingEpochWork finishes the unfinished work of ended epochs with the given identifier,
+// regardless of the epoch work budget.
+// Work that a hook does not finish with an unlimited budget is dropped.
+func (k Keeper) finishPendingEpochWork(ctx sdk.Context, identifier string) {
+	for _, epochWork := range k.GetPendingEpochWork(ctx, identifier) {
+		if done := k.continueEpochWork(ctx, epochWork, types.UnlimitedEpochWorkBudget()); !done {
+			k.Logger(ctx).Error(fmt.Sprintf("dropping unfinished epoch work of module %s for epoch %s %d", epochWork.ModuleName, epochWork.EpochIdentifier, epochWork.EpochNumber))
+			k.deleteEpochWork(ctx, epochWork)
+		}
+	}
+}
+
+// continueEpochWork continues the given unfinished work of an epoch hook within the given budget,
+// and returns whether it is done.
+// The work is dropped if the hook is not registered, or errors or panics, in which case its changes are reverted.
+func (k Keeper) continueEpochWork(ctx sdk.Context, epochWork types.EpochWork, budget types.EpochWorkBudget) (done bool) {
+	hook, found := k.getEpochWorkHook(epochWork.ModuleName)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("dropping epoch work of module %s without an epoch work hook", epochWork.ModuleName))
+		k.deleteEpochWork(ctx, epochWork)
+		return true
+	}
+
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		var err error
+		done, err = hook.ContinueEpochWork(cacheCtx, epochWork.EpochIdentifier, epochWork.EpochNumber, budget)
+		return err
+	})
+	epochWork.NumBlocks += 1
+	if err != nil {
+		telemetry.IncrCounterWithLabels([]string{types.EpochHookFailedMetricName}, 1, []metrics.Label{
+			{
+				Name:  "module_name",
+				Value: epochWork.ModuleName,
+			},
+			{
+				Name:  "error",
+				Value: err.Error(),
+			},
+			{
+				Name:  "is_before_hook",
+				Value: strconv.FormatBool(false),
+			},
+		})
+		k.Logger(ctx).Error(fmt.Sprintf("error in epoch work of module %s, dropping it: %v", epochWork.ModuleName, err))
+		k.deleteEpochWork(ctx, epochWork)
+		return true
+	}
+
+	if !done {
+		k.setEpochWork(ctx, epochWork)
+		return false
+	}
+
+	k.deleteEpochWork(ctx, epochWork)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochWorkDone,
+			sdk.NewAttribute(types.AttributeModuleName, epochWork.ModuleName),
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochWork.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochWork.EpochNumber, 10)),
+			sdk.NewAttribute(types.AttributeNumBlocks, strconv.FormatUint(epochWork.NumBlocks, 10)),
+		),
+	)
+	return true
+}
+
+// getEpochWorkHook returns the registered epoch hook of the given module if it implements EpochWorkHooks.
+func (k Keeper) getEpochWorkHook(moduleName string) (types.EpochWorkHooks, bool) {
+	for _, hook := range types.GetEpochWorkHooks(k.hooks) {
+		if hook.GetModuleName() == moduleName {
+			return hook, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+const (
+	epochWorkModuleName = "dummy"
+	epochWorkGasPerStep = 100_000
+	epochWorkSteps      = 10
+)
+
+var _ types.EpochWorkHooks = &dummyEpochWorkHook{}
+
+// dummyEpochWorkHook is an epoch hook whose work for an ended epoch is epochWorkSteps steps
+// using epochWorkGasPerStep gas each.
+type dummyEpochWorkHook struct {
+	stepsLeft   int
+	stepsDone   int
+	shouldError bool
+}
+
+// GetModuleName implements types.EpochHooks.
+func (*dummyEpochWorkHook) GetModuleName() string {
+	return epochWorkModuleName
+}
+
+func (hook *dummyEpochWorkHook) AfterEpochEnd(_ sdk.Context, _ string, _ int64) error {
+	hook.stepsLeft = epochWorkSteps
+	return nil
+}
+
+func (*dummyEpochWorkHook) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
+}
+
+func (hook *dummyEpochWorkHook) ContinueEpochWork(ctx sdk.Context, _ string, _ int64, budget types.EpochWorkBudget) (bool, error) {
+	if hook.shouldError {
+		return false, errors.New("dummyEpochWorkHook is erroring")
+	}
+	for hook.stepsLeft > 0 {
+		ctx.GasMeter().ConsumeGas(epochWorkGasPerStep, "epoch work step")
+		hook.stepsLeft--
+		hook.stepsDone++
+		if budget.Exhausted(ctx) {
+			break
+		}
+	}
+	return hook.stepsLeft == 0, nil
+}
+
+func (s *KeeperTestSuite) setupEpochWork(hook *dummyEpochWorkHook, epochWorkGasPerBlock uint64) (sdk.Context, *epochskeeper.Keeper) {
+	ctx, epochsKeeper := Setup(hook)
+	epochsKeeper.SetParams(ctx, types.NewParams(epochWorkGasPerBlock))
+	return ctx, epochsKeeper
+}
+
+func (s *KeeperTestSuite) TestEpochWork() {
+	tests := map[string]struct {
+		epochWorkGasPerBlock uint64
+		shouldError          bool
+		// expectedStepsPerBlock is the number of steps done in each block, starting with the block the epoch ends in.
+		expectedStepsPerBlock []int
+	}{
+		"no budget finishes the work in the epoch's block": {
+			expectedStepsPerBlock: []int{10},
+		},
+		"budget splits the work across blocks": {
+			// a step is done as long as the budget is not exhausted, so 2.5 steps of gas allow for 3 steps
+			epochWorkGasPerBlock:  epochWorkGasPerStep*2 + epochWorkGasPerStep/2,
+			expectedStepsPerBlock: []int{3, 3, 3, 1},
+		},
+		"budget smaller than a step still makes progress": {
+			// recording the work exhausts the budget of the epoch's block
+			epochWorkGasPerBlock:  1,
+			expectedStepsPerBlock: []int{0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		"erroring work is dropped": {
+			shouldError:           true,
+			expectedStepsPerBlock: []int{0},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			hook := &dummyEpochWorkHook{shouldError: tc.shouldError}
+			ctx, epochsKeeper := s.setupEpochWork(hook, tc.epochWorkGasPerBlock)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			epochsKeeper.AfterEpochEnd(ctx, "day", 1)
+			// the work is continued in every block with steps done
+			expectedNumBlocks := uint64(0)
+			for block, expectedSteps := range tc.expectedStepsPerBlock {
+				if expectedSteps > 0 {
+					expectedNumBlocks++
+				}
+				if block > 0 {
+					ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+					stepsDoneBefore := hook.stepsDone
+					epochsKeeper.BeginBlocker(ctx)
+					s.Require().Equal(expectedSteps, hook.stepsDone-stepsDoneBefore, "block %d", block)
+				} else {
+					s.Require().Equal(expectedSteps, hook.stepsDone, "block %d", block)
+				}
+
+				isLastBlock := block == len(tc.expectedStepsPerBlock)-1
+				pendingEpochWork := epochsKeeper.GetPendingEpochWork(ctx, "day")
+				if isLastBlock {
+					s.Require().Empty(pendingEpochWork)
+				} else {
+					s.Require().Equal([]types.EpochWork{{
+						ModuleName:      epochWorkModuleName,
+						EpochIdentifier: "day",
+						EpochNumber:     1,
+						StartHeight:     ctx.BlockHeight() - int64(block),
+						NumBlocks:       expectedNumBlocks,
+					}}, pendingEpochWork)
+				}
+			}
+
+			expectedDoneEvents := 1
+			if tc.shouldError {
+				expectedDoneEvents = 0
+			}
+			s.Require().Len(eventsOfType(ctx, types.EventTypeEpochWorkDone), expectedDoneEvents)
+		})
+	}
+}
+
+// TestEpochWorkFinishedBeforeNextEpoch checks that unfinished work is finished regardless of the budget
+// before the hook's next AfterEpochEnd for the same identifier, but not for another identifier.
+func (s *KeeperTestSuite) TestEpochWorkFinishedBeforeNextEpoch() {
+	hook := &dummyEpochWorkHook{}
+	ctx, epochsKeeper := s.setupEpochWork(hook, epochWorkGasPerStep/2)
+
+	epochsKeeper.AfterEpochEnd(ctx, "day", 1)
+	s.Require().Equal(1, hook.stepsDone)
+
+	epochsKeeper.AfterEpochEnd(ctx, "week", 1)
+	s.Require().Equal(2, hook.stepsDone)
+	s.Require().Len(epochsKeeper.GetPendingEpochWork(ctx, ""), 2)
+
+	epochsKeeper.AfterEpochEnd(ctx, "day", 2)
+	// the remaining 9 steps of the day epoch work, which exhaust the budget for the new one
+	s.Require().Equal(11, hook.stepsDone)
+	pendingEpochWork := epochsKeeper.GetPendingEpochWork(ctx, "")
+	s.Require().Len(pendingEpochWork, 2)
+	s.Require().Equal(int64(2), pendingEpochWork[0].EpochNumber)
+	s.Require().Equal("day", pendingEpochWork[0].EpochIdentifier)
+	s.Require().Equal("week", pendingEpochWork[1].EpochIdentifier)
+}
+
+// TestBlockEpochWorkBudget checks that the budget returned by GetBlockEpochWorkBudget is what the epoch work
+// done in the begin blocker left of the block's budget.
+func (s *KeeperTestSuite) TestBlockEpochWorkBudget() {
+	hook := &dummyEpochWorkHook{}
+	ctx, epochsKeeper := s.setupEpochWork(hook, epochWorkGasPerStep*5+epochWorkGasPerStep/2)
+
+	// without the begin blocker, the budget starts when requested
+	s.Require().False(epochsKeeper.GetBlockEpochWorkBudget(ctx).Exhausted(ctx))
+
+	epochsKeeper.AfterEpochEnd(ctx, "day", 1)
+	s.Require().Equal(6, hook.stepsDone)
+
+	// the remaining 4 steps are done in the begin blocker of the next block, leaving over a step of the budget
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	epochsKeeper.BeginBlocker(ctx)
+	s.Require().Equal(epochWorkSteps, hook.stepsDone)
+	s.Require().False(epochsKeeper.GetBlockEpochWorkBudget(ctx).Exhausted(ctx))
+
+	ctx.GasMeter().ConsumeGas(epochWorkGasPerStep, "epoch work step")
+	s.Require().False(epochsKeeper.GetBlockEpochWorkBudget(ctx).Exhausted(ctx))
+	ctx.GasMeter().ConsumeGas(epochWorkGasPerStep, "epoch work step")
+	s.Require().True(epochsKeeper.GetBlockEpochWorkBudget(ctx).Exhausted(ctx))
+}
+
+func (s *KeeperTestSuite) TestQueryPendingEpochWork() {
+	hook := &dummyEpochWorkHook{}
+	ctx, epochsKeeper := s.setupEpochWork(hook, 1)
+	querier := epochskeeper.NewQuerier(*epochsKeeper)
+
+	epochsKeeper.AfterEpochEnd(ctx, "day", 1)
+	epochsKeeper.AfterEpochEnd(ctx, "week", 3)
+
+	res, err := querier.PendingEpochWork(ctx, &types.QueryPendingEpochWorkRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingEpochWork, 2)
+
+	res, err = querier.PendingEpochWork(ctx, &types.QueryPendingEpochWorkRequest{Identifier: "week"})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingEpochWork, 1)
+	s.Require().Equal(int64(3), res.PendingEpochWork[0].EpochNumber)
+
+	paramsRes, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewParams(1), paramsRes.Params)
+}
+
+func eventsOfType(ctx sdk.Context, eventType string) []sdk.Event {
+	events := []sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -8,17 +8,25 @@ import (
 
 // InitGenesis sets epoch info from genesis
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	for _, epoch := range genState.Epochs {
 		err := k.AddEpochInfo(ctx, epoch)
 		if err != nil {
 			panic(err)
 		}
 	}
+
+	for _, epochWork := range genState.PendingEpochWork {
+		k.setEpochWork(ctx, epochWork)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Epochs = k.AllEpochInfos(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.PendingEpochWork = k.GetPendingEpochWork(ctx, "")
 	return genesis
 }
@@ -53,3 +53,25 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// Params returns the parameters of the epochs module.
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: q.Keeper.GetParams(ctx),
+	}, nil
+}
+
+// PendingEpochWork returns the unfinished work of epoch hooks, for all epoch identifiers if none is given.
+func (q Querier) PendingEpochWork(c context.Context, req *types.QueryPendingEpochWorkRequest) (*types.QueryPendingEpochWorkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingEpochWorkResponse{
+		PendingEpochWork: q.Keeper.GetPendingEpochWork(ctx, req.Identifier),
+	}, nil
+}
@@ -2,12 +2,27 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // AfterEpochEnd gets called at the end of the epoch, end of epoch is the timestamp of first block produced after epoch duration.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	// Error is not handled as AfterEpochEnd Hooks use osmoutils.ApplyFuncIfNoError()
-	_ = k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	k.afterEpochEnd(ctx, identifier, epochNumber, types.NewEpochWorkBudget(ctx, k.GetParams(ctx).EpochWorkGasPerBlock))
+}
+
+// afterEpochEnd runs the AfterEpochEnd hooks. The work of a hook that splits it across blocks is started
+// within the given budget right after its AfterEpochEnd, so that with enough budget the hooks run in the same
+// order as if none of them split its work.
+// Unfinished work of earlier epochs with the same identifier is finished first, regardless of the budget.
+func (k Keeper) afterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64, budget types.EpochWorkBudget) {
+	k.finishPendingEpochWork(ctx, identifier)
+	// Errors are not handled as AfterEpochEnd Hooks use osmoutils.ApplyFuncIfNoError()
+	types.AsMultiEpochHooks(k.hooks).AfterEpochEndWithCallback(ctx, identifier, epochNumber, func(hook types.EpochHooks) {
+		if workHook, ok := hook.(types.EpochWorkHooks); ok {
+			k.startEpochWork(ctx, workHook, identifier, epochNumber, budget)
+		}
+	})
 }
 
 // BeforeEpochStart new epoch is next block of epoch end block
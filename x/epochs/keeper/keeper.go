@@ -10,22 +10,42 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	storetypes "cosmossdk.io/store/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
 	Keeper struct {
-		storeKey storetypes.StoreKey
-		hooks    types.EpochHooks
+		storeKey     storetypes.StoreKey
+		transientKey *storetypes.TransientStoreKey
+		paramSpace   paramtypes.Subspace
+		hooks        types.EpochHooks
 	}
 )
 
-// NewKeeper returns a new keeper by codec and storeKey inputs.
-func NewKeeper(storeKey storetypes.StoreKey) *Keeper {
+// NewKeeper returns a new keeper by storeKey, transientKey and paramSpace inputs.
+func NewKeeper(storeKey storetypes.StoreKey, transientKey *storetypes.TransientStoreKey, paramSpace paramtypes.Subspace) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		storeKey: storeKey,
+		storeKey:     storeKey,
+		transientKey: transientKey,
+		paramSpace:   paramSpace,
 	}
 }
 
+// GetParams returns the params of the epochs module.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params of the epochs module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 // Set the gamm hooks.
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -6,9 +6,11 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/stretchr/testify/suite"
 
@@ -46,11 +48,20 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
-func Setup() (sdk.Context, *epochskeeper.Keeper) {
+func Setup(hooks ...types.EpochHooks) (sdk.Context, *epochskeeper.Keeper) {
 	epochsStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
-	ctx := testutil.DefaultContext(epochsStoreKey, storetypes.NewTransientStoreKey("transient_test"))
-	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey)
-	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
+	paramsStoreKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	epochsTransientStoreKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
+	paramsTransientStoreKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: epochsStoreKey, paramstypes.StoreKey: paramsStoreKey},
+		map[string]*storetypes.TransientStoreKey{types.TransientStoreKey: epochsTransientStoreKey, paramstypes.TStoreKey: paramsTransientStoreKey},
+		nil,
+	)
+	interfaceRegistry := cdcutil.CodecOptions{AccAddressPrefix: "osmo", ValAddressPrefix: "osmovaloper"}.NewInterfaceRegistry()
+	paramSpace := paramstypes.NewSubspace(codec.NewProtoCodec(interfaceRegistry), codec.NewLegacyAmino(), paramsStoreKey, paramsTransientStoreKey, types.ModuleName)
+	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey, epochsTransientStoreKey, paramSpace)
+	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks(hooks...))
 	ctx.WithBlockHeight(1).WithChainID("osmosis-1").WithBlockTime(time.Now().UTC())
 	epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
 	SetEpochStartTime(ctx, epochsKeeper)
@@ -1,9 +1,13 @@
 package types
 
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd      = "epoch_end"
+	EventTypeEpochStart    = "epoch_start"
+	EventTypeEpochWorkDone = "epoch_work_done"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeModuleName      = "module_name"
+	AttributeNumBlocks       = "num_blocks"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -9,7 +10,7 @@ import (
 const DefaultIndex uint64 = 1
 
 func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+	return &GenesisState{Epochs: epochs, Params: DefaultParams()}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
@@ -35,6 +36,40 @@ func (gs GenesisState) Validate() error {
 		}
 		epochIdentifiers[epoch.Identifier] = true
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	epochWorkKeys := map[string]bool{}
+	for _, epochWork := range gs.PendingEpochWork {
+		if err := epochWork.Validate(); err != nil {
+			return err
+		}
+		if !epochIdentifiers[epochWork.EpochIdentifier] {
+			return fmt.Errorf("pending epoch work for unknown epoch identifier %s", epochWork.EpochIdentifier)
+		}
+		key := string(KeyEpochWork(epochWork.EpochIdentifier, epochWork.ModuleName))
+		if epochWorkKeys[key] {
+			return fmt.Errorf("duplicate pending epoch work of module %s for epoch identifier %s", epochWork.ModuleName, epochWork.EpochIdentifier)
+		}
+		epochWorkKeys[key] = true
+	}
+	return nil
+}
+
+// Validate validates the unfinished work of an epoch hook.
+func (epochWork EpochWork) Validate() error {
+	if epochWork.ModuleName == "" {
+		return errors.New("epoch work module name should NOT be empty")
+	}
+	if err := ValidateEpochIdentifierString(epochWork.EpochIdentifier); err != nil {
+		return err
+	}
+	if epochWork.EpochNumber <= 0 {
+		return errors.New("epoch work EpochNumber must be positive")
+	}
+	if epochWork.StartHeight < 0 {
+		return errors.New("epoch work StartHeight must be non-negative")
+	}
 	return nil
 }
 
@@ -155,16 +155,152 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+// Params holds parameters for the epochs module.
+type Params struct {
+	// epoch_work_gas_per_block is the gas that hooks implementing EpochWorkHooks
+	// may use in a block to continue the work of ended epochs. Work that does
+	// not fit is resumed in the next block. Zero means no limit, so that all
+	// work is done in the block the epoch ends in.
+	EpochWorkGasPerBlock uint64 `protobuf:"varint,1,opt,name=epoch_work_gas_per_block,json=epochWorkGasPerBlock,proto3" json:"epoch_work_gas_per_block,omitempty" yaml:"epoch_work_gas_per_block"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochWorkGasPerBlock() uint64 {
+	if m != nil {
+		return m.EpochWorkGasPerBlock
+	}
+	return 0
+}
+
+// EpochWork describes the unfinished work of an epoch hook for an ended
+// epoch, which is continued in the following blocks.
+type EpochWork struct {
+	// module_name is the name of the module whose hook has unfinished work.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// epoch_identifier is the identifier of the ended epoch.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the ended epoch.
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// start_height is the height of the block the epoch ended in.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_blocks is the number of blocks the work has been continued in.
+	NumBlocks uint64 `protobuf:"varint,5,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+}
+
+func (m *EpochWork) Reset()         { *m = EpochWork{} }
+func (m *EpochWork) String() string { return proto.CompactTextString(m) }
+func (*EpochWork) ProtoMessage()    {}
+func (*EpochWork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd2db84ad8300ca, []int{2}
+}
+func (m *EpochWork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochWork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochWork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochWork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochWork.Merge(m, src)
+}
+func (m *EpochWork) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochWork) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochWork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochWork proto.InternalMessageInfo
+
+func (m *EpochWork) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *EpochWork) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *EpochWork) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochWork) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochWork) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// params are the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pending_epoch_work is the unfinished work of epoch hooks.
+	PendingEpochWork []EpochWork `protobuf:"bytes,3,rep,name=pending_epoch_work,json=pendingEpochWork,proto3" json:"pending_epoch_work"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7dd2db84ad8300ca, []int{1}
+	return fileDescriptor_7dd2db84ad8300ca, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,8 +336,24 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingEpochWork() []EpochWork {
+	if m != nil {
+		return m.PendingEpochWork
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "osmosis.epochs.v1beta1.EpochInfo")
+	proto.RegisterType((*Params)(nil), "osmosis.epochs.v1beta1.Params")
+	proto.RegisterType((*EpochWork)(nil), "osmosis.epochs.v1beta1.EpochWork")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.epochs.v1beta1.GenesisState")
 }
 
@@ -210,37 +362,49 @@ func init() {
 }
 
 var fileDescriptor_7dd2db84ad8300ca = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0x4a, 0xea, 0x3b, 0x04, 0x44, 0xc7, 0x11, 0x2a, 0x91, 0x84, 0xc0, 0x10,
-	0x09, 0x70, 0xd4, 0x83, 0x09, 0x06, 0xa4, 0x02, 0xe2, 0x60, 0x41, 0x4a, 0x19, 0x10, 0x4b, 0x95,
-	0xb4, 0x6e, 0x62, 0xa9, 0x89, 0xa3, 0xf8, 0x05, 0xd1, 0x8d, 0x8f, 0xd0, 0x91, 0x8f, 0x74, 0xe3,
-	0x8d, 0x4c, 0x05, 0xb5, 0x1b, 0xe3, 0x7d, 0x02, 0x14, 0xdb, 0x29, 0x85, 0x3b, 0xc4, 0x16, 0xfb,
-	0xff, 0x7b, 0xff, 0xbf, 0xdf, 0xd3, 0x0b, 0xbe, 0xcf, 0x45, 0xc6, 0x05, 0x13, 0x01, 0x2d, 0xf8,
-	0x24, 0x15, 0xc1, 0xa7, 0x41, 0x4c, 0x21, 0x1a, 0x04, 0x09, 0xcd, 0xa9, 0x60, 0x82, 0x14, 0x25,
-	0x07, 0x6e, 0x1e, 0x6a, 0x8a, 0x28, 0x8a, 0x68, 0xaa, 0x7f, 0x90, 0xf0, 0x84, 0x4b, 0x24, 0xa8,
-	0xbf, 0x14, 0xdd, 0xb7, 0x13, 0xce, 0x93, 0x39, 0x0d, 0xe4, 0x29, 0xae, 0x66, 0xc1, 0xb4, 0x2a,
-	0x23, 0x60, 0x3c, 0xd7, 0xba, 0xf3, 0xb7, 0x0e, 0x2c, 0xa3, 0x02, 0xa2, 0xac, 0x50, 0x80, 0xb7,
-	0xec, 0xe0, 0xde, 0xab, 0x3a, 0xe9, 0x4d, 0x3e, 0xe3, 0xa6, 0x8d, 0x31, 0x9b, 0xd2, 0x1c, 0xd8,
-	0x8c, 0xd1, 0xd2, 0x42, 0x2e, 0xf2, 0x7b, 0xe1, 0xce, 0x8d, 0xf9, 0x01, 0x63, 0x01, 0x51, 0x09,
-	0xe3, 0xda, 0xc6, 0xba, 0xe4, 0x22, 0x7f, 0xef, 0xa8, 0x4f, 0x54, 0x06, 0x69, 0x32, 0xc8, 0xfb,
-	0x26, 0x63, 0x78, 0xe7, 0x64, 0xe5, 0xb4, 0xce, 0x56, 0xce, 0x8d, 0x45, 0x94, 0xcd, 0x9f, 0x7a,
-	0xbf, 0x6b, 0xbd, 0xe5, 0x77, 0x07, 0x85, 0x3d, 0x79, 0x51, 0xe3, 0x66, 0x8a, 0x8d, 0xe6, 0xe9,
-	0x56, 0x5b, 0xfa, 0xde, 0x3e, 0xe7, 0xfb, 0x52, 0x03, 0xc3, 0x41, 0x6d, 0xfb, 0x73, 0xe5, 0x98,
-	0x4d, 0xc9, 0x43, 0x9e, 0x31, 0xa0, 0x59, 0x01, 0x8b, 0xb3, 0x95, 0x73, 0x4d, 0x85, 0x35, 0x9a,
-	0xf7, 0xb5, 0x8e, 0xda, 0xba, 0x9b, 0xf7, 0xf0, 0xd5, 0x49, 0x55, 0x96, 0x34, 0x87, 0xb1, 0x1c,
-	0xb1, 0xd5, 0x71, 0x91, 0xdf, 0x0e, 0xf7, 0xf5, 0xa5, 0x1c, 0x86, 0xf9, 0x05, 0x61, 0xeb, 0x0f,
-	0x6a, 0xbc, 0xd3, 0xf7, 0xe5, 0xff, 0xf6, 0xfd, 0x40, 0xf7, 0xed, 0xa8, 0xa7, 0xfc, 0xcb, 0x49,
-	0x4d, 0xe1, 0xe6, 0x6e, 0xf2, 0x68, 0x3b, 0x91, 0x27, 0xf8, 0x50, 0xf1, 0x13, 0x5e, 0xe5, 0xc0,
-	0xf2, 0x44, 0x15, 0xd2, 0xa9, 0xd5, 0x75, 0x91, 0x6f, 0x84, 0x07, 0x52, 0x7d, 0xa1, 0xc5, 0x91,
-	0xd2, 0xcc, 0x67, 0xb8, 0x7f, 0x51, 0x5a, 0x4a, 0x59, 0x92, 0x82, 0x65, 0xc8, 0x56, 0x6f, 0x9d,
-	0x0b, 0x3c, 0x96, 0xf2, 0xdb, 0x8e, 0x71, 0xe5, 0xba, 0xe1, 0xbd, 0xc3, 0xfb, 0xaf, 0xd5, 0x4a,
-	0x8e, 0x20, 0x02, 0x6a, 0x3e, 0xc7, 0x5d, 0xb5, 0x8b, 0x16, 0x72, 0xdb, 0xfe, 0xde, 0xd1, 0x5d,
-	0x72, 0xf1, 0x8a, 0x92, 0xed, 0x1e, 0x0d, 0x3b, 0x75, 0xff, 0xa1, 0x2e, 0x1b, 0x1e, 0x9f, 0xac,
-	0x6d, 0x74, 0xba, 0xb6, 0xd1, 0x8f, 0xb5, 0x8d, 0x96, 0x1b, 0xbb, 0x75, 0xba, 0xb1, 0x5b, 0xdf,
-	0x36, 0x76, 0xeb, 0x23, 0x49, 0x18, 0xa4, 0x55, 0x4c, 0x26, 0x3c, 0x0b, 0xb4, 0xe9, 0xa3, 0x79,
-	0x14, 0x8b, 0xe6, 0x10, 0x7c, 0x6e, 0x7e, 0x16, 0x58, 0x14, 0x54, 0xc4, 0x5d, 0x39, 0xeb, 0xc7,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe7, 0xa2, 0xc2, 0x4b, 0x03, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xce, 0x36, 0xf9, 0xf3, 0x27, 0x9b, 0xa2, 0x96, 0x55, 0x29, 0x26, 0x52, 0xed, 0xd4, 0xe5,
+	0x10, 0x04, 0xd8, 0x6a, 0xe1, 0x04, 0x48, 0x48, 0x01, 0xd4, 0x96, 0x43, 0x55, 0xb9, 0x20, 0x10,
+	0x1c, 0xac, 0x75, 0xb2, 0x75, 0xac, 0x66, 0xbd, 0xd6, 0xee, 0x1a, 0xe8, 0x8d, 0x47, 0xe8, 0x91,
+	0x87, 0xe1, 0x01, 0x7a, 0xec, 0x91, 0x53, 0x40, 0x2d, 0x27, 0x8e, 0x7d, 0x02, 0xe4, 0xdd, 0x75,
+	0x93, 0xd2, 0x56, 0x70, 0x8b, 0x67, 0xbe, 0xf9, 0xbe, 0x99, 0xd9, 0x6f, 0x02, 0x6f, 0x33, 0x41,
+	0x99, 0x48, 0x84, 0x4f, 0x32, 0xd6, 0x1f, 0x0a, 0xff, 0xc3, 0x6a, 0x44, 0x24, 0x5e, 0xf5, 0x63,
+	0x92, 0x12, 0x91, 0x08, 0x2f, 0xe3, 0x4c, 0x32, 0xb4, 0x68, 0x50, 0x9e, 0x46, 0x79, 0x06, 0xd5,
+	0x5e, 0x88, 0x59, 0xcc, 0x14, 0xc4, 0x2f, 0x7e, 0x69, 0x74, 0xdb, 0x8e, 0x19, 0x8b, 0x47, 0xc4,
+	0x57, 0x5f, 0x51, 0xbe, 0xeb, 0x0f, 0x72, 0x8e, 0x65, 0xc2, 0x52, 0x93, 0x77, 0xfe, 0xcc, 0xcb,
+	0x84, 0x12, 0x21, 0x31, 0xcd, 0x34, 0xc0, 0x3d, 0xa8, 0xc1, 0xe6, 0x8b, 0x42, 0x69, 0x33, 0xdd,
+	0x65, 0xc8, 0x86, 0x30, 0x19, 0x90, 0x54, 0x26, 0xbb, 0x09, 0xe1, 0x16, 0xe8, 0x80, 0x6e, 0x33,
+	0x98, 0x8a, 0xa0, 0xb7, 0x10, 0x0a, 0x89, 0xb9, 0x0c, 0x0b, 0x1a, 0x6b, 0xa6, 0x03, 0xba, 0xad,
+	0xb5, 0xb6, 0xa7, 0x35, 0xbc, 0x52, 0xc3, 0x7b, 0x55, 0x6a, 0xf4, 0x96, 0x0e, 0xc7, 0x4e, 0xe5,
+	0x74, 0xec, 0x5c, 0xdf, 0xc7, 0x74, 0xf4, 0xc8, 0x9d, 0xd4, 0xba, 0x07, 0xdf, 0x1d, 0x10, 0x34,
+	0x55, 0xa0, 0x80, 0xa3, 0x21, 0x6c, 0x94, 0xad, 0x5b, 0x55, 0xc5, 0x7b, 0xeb, 0x02, 0xef, 0x73,
+	0x03, 0xe8, 0xad, 0x16, 0xb4, 0xbf, 0xc6, 0x0e, 0x2a, 0x4b, 0xee, 0x31, 0x9a, 0x48, 0x42, 0x33,
+	0xb9, 0x7f, 0x3a, 0x76, 0xe6, 0xb4, 0x58, 0x99, 0x73, 0xbf, 0x14, 0x52, 0x67, 0xec, 0x68, 0x05,
+	0x5e, 0xeb, 0xe7, 0x9c, 0x93, 0x54, 0x86, 0x6a, 0xc5, 0x56, 0xad, 0x03, 0xba, 0xd5, 0x60, 0xd6,
+	0x04, 0xd5, 0x32, 0xd0, 0x67, 0x00, 0xad, 0x73, 0xa8, 0x70, 0x6a, 0xee, 0xff, 0xfe, 0x3a, 0xf7,
+	0x5d, 0x33, 0xb7, 0xa3, 0x5b, 0xb9, 0x8a, 0x49, 0x6f, 0xe1, 0xc6, 0xb4, 0xf2, 0xce, 0xd9, 0x46,
+	0x1e, 0xc2, 0x45, 0x8d, 0xef, 0xb3, 0x3c, 0x95, 0x49, 0x1a, 0xeb, 0x42, 0x32, 0xb0, 0xea, 0x1d,
+	0xd0, 0x6d, 0x04, 0x0b, 0x2a, 0xfb, 0xcc, 0x24, 0x77, 0x74, 0x0e, 0x3d, 0x86, 0xed, 0xcb, 0xd4,
+	0x86, 0x24, 0x89, 0x87, 0xd2, 0x6a, 0xa8, 0x51, 0x6f, 0x5e, 0x10, 0xdc, 0x50, 0xe9, 0x97, 0xb5,
+	0xc6, 0xff, 0xf3, 0x0d, 0x97, 0xc0, 0xfa, 0x36, 0xe6, 0x98, 0x0a, 0xf4, 0x1e, 0x5a, 0x9a, 0xe4,
+	0x23, 0xe3, 0x7b, 0x61, 0x8c, 0x45, 0x98, 0x11, 0x1e, 0x46, 0x23, 0xd6, 0xdf, 0x53, 0xe6, 0xa8,
+	0xf5, 0x56, 0x26, 0x43, 0x5e, 0x85, 0x74, 0x4d, 0xa7, 0x6f, 0x18, 0xdf, 0x5b, 0xc7, 0x62, 0x9b,
+	0xf0, 0x9e, 0x0a, 0x7f, 0x05, 0xc6, 0x79, 0x45, 0x02, 0x39, 0xb0, 0x45, 0xd9, 0x20, 0x1f, 0x91,
+	0x30, 0xc5, 0x94, 0x94, 0xd6, 0xd3, 0xa1, 0x2d, 0x4c, 0x09, 0xba, 0x03, 0xe7, 0xb5, 0xc2, 0x94,
+	0x41, 0x67, 0x14, 0x6a, 0x4e, 0xc5, 0x37, 0x27, 0x2e, 0x5d, 0x86, 0xb3, 0x1a, 0x9a, 0xe6, 0x34,
+	0x22, 0x5c, 0xf9, 0xa9, 0x1a, 0xb4, 0x54, 0x6c, 0x4b, 0x85, 0x0a, 0xc8, 0xb9, 0xc5, 0x68, 0x0f,
+	0xb4, 0xc4, 0x64, 0x19, 0x68, 0x09, 0xc2, 0x34, 0xa7, 0x7a, 0x06, 0xa1, 0xde, 0xbc, 0x16, 0x34,
+	0xd3, 0x9c, 0xaa, 0xee, 0x85, 0xfb, 0x13, 0xc0, 0xd9, 0x75, 0x7d, 0xb9, 0x3b, 0x12, 0x4b, 0x82,
+	0x9e, 0xc2, 0xba, 0x3e, 0x59, 0x0b, 0x74, 0xaa, 0xdd, 0xd6, 0xda, 0xb2, 0x77, 0xf9, 0x25, 0x7b,
+	0x67, 0xe7, 0xd6, 0xab, 0x15, 0x36, 0x09, 0x4c, 0x19, 0x7a, 0x02, 0xeb, 0x99, 0xda, 0xbb, 0x39,
+	0x2c, 0xfb, 0x2a, 0x02, 0xfd, 0x3a, 0x65, 0xb5, 0xae, 0x41, 0xaf, 0x21, 0xca, 0x48, 0x3a, 0x28,
+	0x7c, 0x32, 0x79, 0x09, 0xab, 0xfa, 0x0f, 0xad, 0x14, 0xfb, 0x37, 0x64, 0xf3, 0x86, 0x62, 0x12,
+	0xdf, 0x38, 0x3c, 0xb6, 0xc1, 0xd1, 0xb1, 0x0d, 0x7e, 0x1c, 0xdb, 0xe0, 0xe0, 0xc4, 0xae, 0x1c,
+	0x9d, 0xd8, 0x95, 0x6f, 0x27, 0x76, 0xe5, 0x9d, 0x17, 0x27, 0x72, 0x98, 0x47, 0x5e, 0x9f, 0x51,
+	0xdf, 0xd0, 0xdf, 0x1f, 0xe1, 0x48, 0x94, 0x1f, 0xfe, 0xa7, 0xf2, 0x8f, 0x4e, 0xee, 0x67, 0x44,
+	0x44, 0x75, 0x75, 0x27, 0x0f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x32, 0xcf, 0x6a, 0x1e, 0x07,
+	0x05, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -317,6 +481,86 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochWorkGasPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochWorkGasPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochWork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochWork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochWork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -337,6 +581,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingEpochWork) > 0 {
+		for iNdEx := len(m.PendingEpochWork) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingEpochWork[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -393,6 +661,44 @@ func (m *EpochInfo) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochWorkGasPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochWorkGasPerBlock))
+	}
+	return n
+}
+
+func (m *EpochWork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.NumBlocks))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -405,6 +711,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingEpochWork) > 0 {
+		for _, e := range m.PendingEpochWork {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -653,6 +967,246 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochWorkGasPerBlock", wireType)
+			}
+			m.EpochWorkGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochWorkGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochWork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochWork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochWork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -716,6 +1270,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochWork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingEpochWork = append(m.PendingEpochWork, EpochWork{})
+			if err := m.PendingEpochWork[len(m.PendingEpochWork)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
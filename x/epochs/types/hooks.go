@@ -20,6 +20,71 @@ type EpochHooks interface {
 	GetModuleName() string
 }
 
+// EpochWorkHooks is implemented by epoch hooks that split the work of an epoch end across blocks.
+// After the hook's AfterEpochEnd, the epochs module calls ContinueEpochWork in the same block and in every
+// following block, within the block's epoch work budget, until the hook reports the work as done.
+// Unfinished work is done regardless of the budget before the hook's next AfterEpochEnd for the same identifier.
+type EpochWorkHooks interface {
+	EpochHooks
+	// ContinueEpochWork continues the work of the given ended epoch and returns whether all of it is done.
+	// It should stop once budget is exhausted, but make progress on every call, so that the work completes
+	// even if a single step needs more gas than the budget. If there is no work for the epoch, for example
+	// since AfterEpochEnd failed, it should return done.
+	ContinueEpochWork(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget EpochWorkBudget) (done bool, err error)
+}
+
+// EpochWorkBudget bounds the gas epoch hooks may use in a block to continue the work of ended epochs.
+// The budget is in gas rather than time, since time is not deterministic across nodes.
+type EpochWorkBudget struct {
+	gasStart uint64
+	gasLimit uint64
+}
+
+// NewEpochWorkBudget returns a budget of the given gas, counted from the gas consumed in ctx so far.
+// A zero gas limit is never exhausted.
+func NewEpochWorkBudget(ctx sdk.Context, gasLimit uint64) EpochWorkBudget {
+	return NewEpochWorkBudgetFrom(ctx.GasMeter().GasConsumed(), gasLimit)
+}
+
+// NewEpochWorkBudgetFrom returns a budget of the given gas, counted from the given gas consumed.
+// A zero gas limit is never exhausted.
+func NewEpochWorkBudgetFrom(gasStart, gasLimit uint64) EpochWorkBudget {
+	return EpochWorkBudget{
+		gasStart: gasStart,
+		gasLimit: gasLimit,
+	}
+}
+
+// UnlimitedEpochWorkBudget returns a budget that is never exhausted.
+func UnlimitedEpochWorkBudget() EpochWorkBudget {
+	return EpochWorkBudget{}
+}
+
+// Exhausted returns whether the gas consumed in ctx since the budget was created reached its limit.
+func (b EpochWorkBudget) Exhausted(ctx sdk.Context) bool {
+	return b.gasLimit != 0 && ctx.GasMeter().GasConsumed()-b.gasStart >= b.gasLimit
+}
+
+// AsMultiEpochHooks returns the given hooks as MultiEpochHooks, wrapping them if they are a single hook.
+func AsMultiEpochHooks(hooks EpochHooks) MultiEpochHooks {
+	multiHooks, ok := hooks.(MultiEpochHooks)
+	if !ok {
+		multiHooks = NewMultiEpochHooks(hooks)
+	}
+	return multiHooks
+}
+
+// GetEpochWorkHooks returns the hooks among the given ones that implement EpochWorkHooks, in array sequence.
+func GetEpochWorkHooks(hooks EpochHooks) []EpochWorkHooks {
+	workHooks := []EpochWorkHooks{}
+	for _, hook := range AsMultiEpochHooks(hooks) {
+		if workHook, ok := hook.(EpochWorkHooks); ok {
+			workHooks = append(workHooks, workHook)
+		}
+	}
+	return workHooks
+}
+
 const (
 	// flag indicating whether this is a before epoch hook
 	isBeforeEpoch = true
@@ -47,6 +112,15 @@ func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string,
 	return nil
 }
 
+// AfterEpochEndWithCallback is AfterEpochEnd, calling the given function after each hook.
+// This lets the work of a hook implementing EpochWorkHooks start before the next hook runs.
+func (h MultiEpochHooks) AfterEpochEndWithCallback(ctx sdk.Context, epochIdentifier string, epochNumber int64, callback func(hook EpochHooks)) {
+	for _, hook := range h {
+		panicCatchingEpochHook(ctx, hook.AfterEpochEnd, epochIdentifier, epochNumber, hook.GetModuleName(), !isBeforeEpoch)
+		callback(hook)
+	}
+}
+
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting.
 func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for _, hook := range h {
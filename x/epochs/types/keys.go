@@ -7,6 +7,9 @@ const (
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
 
+	// TransientStoreKey defines the transient store key, for the state of the current block.
+	TransientStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for slashing.
 	RouterKey = ModuleName
 
@@ -14,9 +17,31 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs.
-var KeyPrefixEpoch = []byte{0x01}
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs.
+	KeyPrefixEpoch = []byte{0x01}
+
+	// KeyPrefixEpochWork defines prefix key for storing the unfinished work of epoch hooks.
+	KeyPrefixEpochWork = []byte{0x02}
+
+	// KeyEpochWorkGasStart defines the transient key of the gas consumed in the current block
+	// when its epoch work budget started.
+	KeyEpochWorkGasStart = []byte{0x01}
+
+	// KeySeparator defines the separator between the components of a key.
+	KeySeparator = "|"
+)
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// KeyEpochWork returns the key of the unfinished work of the given module's epoch hook for the given epoch identifier.
+func KeyEpochWork(epochIdentifier, moduleName string) []byte {
+	return append(KeyEpochWorkPrefix(epochIdentifier), []byte(moduleName)...)
+}
+
+// KeyEpochWorkPrefix returns the prefix of the keys of the unfinished work of epoch hooks for the given epoch identifier.
+func KeyEpochWorkPrefix(epochIdentifier string) []byte {
+	return append(append([]byte{}, KeyPrefixEpochWork...), []byte(epochIdentifier+KeySeparator)...)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyEpochWorkGasPerBlock = []byte("EpochWorkGasPerBlock")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamKeyTable for epochs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(epochWorkGasPerBlock uint64) Params {
+	return Params{
+		EpochWorkGasPerBlock: epochWorkGasPerBlock,
+	}
+}
+
+// DefaultParams returns the default epochs module parameters.
+// By default, epoch work is not limited, so that all of it is done in the block the epoch ends in.
+func DefaultParams() Params {
+	return Params{
+		EpochWorkGasPerBlock: 0,
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return validateEpochWorkGasPerBlock(p.EpochWorkGasPerBlock)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEpochWorkGasPerBlock, &p.EpochWorkGasPerBlock, validateEpochWorkGasPerBlock),
+	}
+}
+
+func validateEpochWorkGasPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -198,11 +198,183 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryPendingEpochWorkRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryPendingEpochWorkRequest) Reset()         { *m = QueryPendingEpochWorkRequest{} }
+func (m *QueryPendingEpochWorkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingEpochWorkRequest) ProtoMessage()    {}
+func (*QueryPendingEpochWorkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{6}
+}
+func (m *QueryPendingEpochWorkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingEpochWorkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingEpochWorkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingEpochWorkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingEpochWorkRequest.Merge(m, src)
+}
+func (m *QueryPendingEpochWorkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingEpochWorkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingEpochWorkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingEpochWorkRequest proto.InternalMessageInfo
+
+func (m *QueryPendingEpochWorkRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+type QueryPendingEpochWorkResponse struct {
+	PendingEpochWork []EpochWork `protobuf:"bytes,1,rep,name=pending_epoch_work,json=pendingEpochWork,proto3" json:"pending_epoch_work"`
+}
+
+func (m *QueryPendingEpochWorkResponse) Reset()         { *m = QueryPendingEpochWorkResponse{} }
+func (m *QueryPendingEpochWorkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingEpochWorkResponse) ProtoMessage()    {}
+func (*QueryPendingEpochWorkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82bf2f47d6aaa9fa, []int{7}
+}
+func (m *QueryPendingEpochWorkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingEpochWorkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingEpochWorkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingEpochWorkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingEpochWorkResponse.Merge(m, src)
+}
+func (m *QueryPendingEpochWorkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingEpochWorkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingEpochWorkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingEpochWorkResponse proto.InternalMessageInfo
+
+func (m *QueryPendingEpochWorkResponse) GetPendingEpochWork() []EpochWork {
+	if m != nil {
+		return m.PendingEpochWork
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "osmosis.epochs.v1beta1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "osmosis.epochs.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.epochs.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.epochs.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingEpochWorkRequest)(nil), "osmosis.epochs.v1beta1.QueryPendingEpochWorkRequest")
+	proto.RegisterType((*QueryPendingEpochWorkResponse)(nil), "osmosis.epochs.v1beta1.QueryPendingEpochWorkResponse")
 }
 
 func init() {
@@ -210,33 +382,41 @@ func init() {
 }
 
 var fileDescriptor_82bf2f47d6aaa9fa = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc7, 0x33, 0xad, 0x16, 0x1c, 0xeb, 0x65, 0x90, 0x1a, 0x83, 0x8c, 0x35, 0xfe, 0x2b, 0x42,
-	0x67, 0x6c, 0xbd, 0x79, 0x51, 0x2a, 0x82, 0x1e, 0xcd, 0xb1, 0x17, 0x49, 0xe2, 0x34, 0x1d, 0x68,
-	0x67, 0xd2, 0xcc, 0x44, 0xec, 0xd5, 0x27, 0x10, 0xc4, 0x17, 0xf0, 0x59, 0xf6, 0xd0, 0x63, 0x61,
-	0x2f, 0x7b, 0x5a, 0x96, 0x76, 0x1f, 0x64, 0xc9, 0x64, 0x5a, 0xba, 0x6c, 0xb2, 0xec, 0xde, 0x92,
-	0x99, 0xcf, 0xf7, 0xcf, 0xef, 0x97, 0x40, 0x5f, 0xaa, 0xb9, 0x54, 0x5c, 0x51, 0x96, 0xca, 0x78,
-	0xaa, 0xe8, 0xcf, 0x41, 0xc4, 0x74, 0x38, 0xa0, 0x8b, 0x9c, 0x65, 0x4b, 0x92, 0x66, 0x52, 0x4b,
-	0xd4, 0xb1, 0x0c, 0x29, 0x19, 0x62, 0x19, 0xef, 0x61, 0x22, 0x13, 0x69, 0x10, 0x5a, 0x3c, 0x95,
-	0xb4, 0xf7, 0x24, 0x91, 0x32, 0x99, 0x31, 0x1a, 0xa6, 0x9c, 0x86, 0x42, 0x48, 0x1d, 0x6a, 0x2e,
-	0x85, 0xb2, 0xb7, 0x6f, 0x62, 0x63, 0x46, 0xa3, 0x50, 0xb1, 0x32, 0x64, 0x1f, 0x99, 0x86, 0x09,
-	0x17, 0x06, 0xb6, 0xec, 0x8b, 0x9a, 0x6e, 0x09, 0x13, 0xac, 0xa8, 0x63, 0x28, 0xdf, 0x85, 0x9d,
-	0x6f, 0x85, 0xcf, 0x67, 0x03, 0x7d, 0x15, 0x13, 0x19, 0xb0, 0x45, 0xce, 0x94, 0xf6, 0xc7, 0xf0,
-	0xd1, 0x95, 0x1b, 0x95, 0x4a, 0xa1, 0x18, 0xfa, 0x00, 0x5b, 0xa5, 0xa9, 0x0b, 0xba, 0xcd, 0xde,
-	0xfd, 0xe1, 0x33, 0x52, 0x3d, 0x23, 0x31, 0xda, 0x42, 0x3a, 0xba, 0xb3, 0x3a, 0x7d, 0xea, 0x04,
-	0x56, 0xe6, 0xbf, 0x87, 0xae, 0xf1, 0xfe, 0x94, 0x67, 0x19, 0x13, 0xda, 0x60, 0x36, 0x17, 0x61,
-	0x08, 0xf9, 0x0f, 0x26, 0x34, 0x9f, 0x70, 0x96, 0xb9, 0xa0, 0x0b, 0x7a, 0xf7, 0x82, 0x83, 0x13,
-	0xff, 0x23, 0x7c, 0x5c, 0xa1, 0xb5, 0xcd, 0x9e, 0xc3, 0x07, 0x71, 0x79, 0xfe, 0xdd, 0x44, 0x19,
-	0x7d, 0x33, 0x68, 0xc7, 0x07, 0xf0, 0xf0, 0xa8, 0x01, 0xef, 0x1a, 0x0b, 0xf4, 0x0f, 0x40, 0xb8,
-	0xef, 0xa8, 0x10, 0xa9, 0x9b, 0xa3, 0x7a, 0x45, 0x1e, 0xbd, 0x31, 0x5f, 0xd6, 0xf3, 0x5f, 0xfd,
-	0x3e, 0x3e, 0xff, 0xdb, 0xe8, 0x22, 0x4c, 0x6b, 0x3e, 0x4e, 0xf9, 0x8a, 0xfe, 0x03, 0xd8, 0x3e,
-	0x9c, 0x0f, 0xbd, 0xbd, 0x36, 0xa9, 0x62, 0x8d, 0xde, 0xe0, 0x16, 0x0a, 0xdb, 0xae, 0x6f, 0xda,
-	0xbd, 0x46, 0x2f, 0xeb, 0xda, 0x5d, 0x5a, 0xed, 0xe8, 0xcb, 0x6a, 0x83, 0xc1, 0x7a, 0x83, 0xc1,
-	0xd9, 0x06, 0x83, 0x3f, 0x5b, 0xec, 0xac, 0xb7, 0xd8, 0x39, 0xd9, 0x62, 0x67, 0x4c, 0x12, 0xae,
-	0xa7, 0x79, 0x44, 0x62, 0x39, 0xdf, 0x59, 0xf5, 0x67, 0x61, 0xa4, 0xf6, 0xbe, 0xbf, 0x76, 0xce,
-	0x7a, 0x99, 0x32, 0x15, 0xb5, 0xcc, 0xbf, 0xf8, 0xee, 0x22, 0x00, 0x00, 0xff, 0xff, 0x94, 0xf9,
-	0xbd, 0x8a, 0x4f, 0x03, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0x58, 0x1b, 0xf0, 0x6b, 0x85, 0x32, 0x96, 0x1a, 0x97, 0xba, 0xc6, 0xf5, 0x5f, 0x89,
+	0x76, 0xd7, 0x44, 0xbd, 0x88, 0xa8, 0x54, 0x04, 0xbd, 0x69, 0x44, 0x84, 0x5e, 0xca, 0x66, 0x3b,
+	0xdd, 0x2e, 0x6d, 0x66, 0xa6, 0x3b, 0x93, 0x6a, 0xaf, 0x1e, 0x04, 0x6f, 0x82, 0xf8, 0x02, 0xbe,
+	0x82, 0x2f, 0xd1, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x07, 0x29, 0xfb, 0xcd, 0x24, 0x24, 0xed,
+	0x6e, 0xd8, 0xde, 0x76, 0xbf, 0xf9, 0xfd, 0xfb, 0x76, 0x7e, 0x2c, 0x78, 0x42, 0x75, 0x85, 0x4a,
+	0x54, 0xc0, 0xa4, 0x88, 0xb6, 0x55, 0xb0, 0xdf, 0xec, 0x30, 0x1d, 0x36, 0x83, 0xbd, 0x1e, 0x4b,
+	0x0f, 0x7c, 0x99, 0x0a, 0x2d, 0xe8, 0x92, 0xc5, 0xf8, 0x06, 0xe3, 0x5b, 0x8c, 0xb3, 0x18, 0x8b,
+	0x58, 0x20, 0x24, 0xc8, 0x9e, 0x0c, 0xda, 0x59, 0x8e, 0x85, 0x88, 0x77, 0x59, 0x10, 0xca, 0x24,
+	0x08, 0x39, 0x17, 0x3a, 0xd4, 0x89, 0xe0, 0xca, 0x9e, 0x36, 0x22, 0x14, 0x0b, 0x3a, 0xa1, 0x62,
+	0xc6, 0x64, 0x64, 0x29, 0xc3, 0x38, 0xe1, 0x08, 0xb6, 0xd8, 0x9b, 0x05, 0xd9, 0x62, 0xc6, 0x59,
+	0x16, 0x07, 0x51, 0x5e, 0x0d, 0x96, 0xde, 0x66, 0x3a, 0x2f, 0x11, 0xf4, 0x9a, 0x6f, 0x89, 0x36,
+	0xdb, 0xeb, 0x31, 0xa5, 0xbd, 0x75, 0xb8, 0x7c, 0xea, 0x44, 0x49, 0xc1, 0x15, 0xa3, 0xcf, 0xa0,
+	0x6a, 0x44, 0x6b, 0xa4, 0x3e, 0xb3, 0x32, 0xd7, 0xba, 0xee, 0xe7, 0xef, 0xe8, 0x23, 0x37, 0xa3,
+	0xae, 0x9d, 0x3f, 0xfc, 0x7b, 0xad, 0xd2, 0xb6, 0x34, 0xef, 0x31, 0xd4, 0x50, 0xfb, 0x45, 0x2f,
+	0x4d, 0x19, 0xd7, 0x08, 0xb3, 0xbe, 0xd4, 0x05, 0x48, 0x36, 0x19, 0xd7, 0xc9, 0x56, 0xc2, 0xd2,
+	0x1a, 0xa9, 0x93, 0x95, 0x0b, 0xed, 0xb1, 0x89, 0xf7, 0x1c, 0xae, 0xe4, 0x70, 0x6d, 0xb2, 0x1b,
+	0x70, 0x31, 0x32, 0xf3, 0x0d, 0xb4, 0x42, 0xfe, 0x4c, 0x7b, 0x3e, 0x1a, 0x03, 0x7b, 0x8b, 0x40,
+	0x51, 0xe1, 0x4d, 0x98, 0x86, 0x5d, 0x35, 0xdc, 0xf7, 0x1d, 0x5c, 0x9a, 0x98, 0x5a, 0xc5, 0x27,
+	0x50, 0x95, 0x38, 0x41, 0xa9, 0xb9, 0x96, 0x5b, 0xb4, 0xab, 0xe1, 0x0d, 0x17, 0x35, 0x1c, 0xef,
+	0x29, 0x2c, 0x1b, 0x51, 0xc6, 0x37, 0x13, 0x1e, 0xa3, 0xff, 0x07, 0x91, 0xee, 0x94, 0x5d, 0x76,
+	0x1f, 0xae, 0x16, 0xf0, 0x6d, 0xbc, 0xf7, 0x40, 0xa5, 0x39, 0x33, 0x0b, 0x6f, 0x7c, 0x14, 0xe9,
+	0x4e, 0xa9, 0x6b, 0xc9, 0x64, 0x6c, 0xda, 0x05, 0x79, 0x42, 0xbe, 0xf5, 0x65, 0x16, 0x66, 0xd1,
+	0x98, 0xfe, 0x20, 0x00, 0xa3, 0x6b, 0x54, 0xd4, 0x2f, 0xd2, 0xcc, 0x6f, 0x91, 0x13, 0x94, 0xc6,
+	0x9b, 0x85, 0xbc, 0xdb, 0x9f, 0x7f, 0xff, 0xff, 0x7e, 0xae, 0x4e, 0xdd, 0xa0, 0xa0, 0xbf, 0xe6,
+	0x95, 0xfe, 0x24, 0x30, 0x3f, 0x5e, 0x01, 0x7a, 0x7f, 0xaa, 0x53, 0x4e, 0xd3, 0x9c, 0xe6, 0x19,
+	0x18, 0x36, 0xdd, 0x2a, 0xa6, 0xbb, 0x43, 0x6f, 0x15, 0xa5, 0x9b, 0x68, 0x1f, 0xfd, 0x4a, 0xa0,
+	0x6a, 0x7a, 0x41, 0x1b, 0x53, 0xcd, 0x26, 0xaa, 0xe8, 0xdc, 0x2d, 0x85, 0x2d, 0xfb, 0xc1, 0x4c,
+	0x15, 0xe9, 0x2f, 0x02, 0x0b, 0x27, 0x6b, 0x44, 0x1f, 0x4e, 0x77, 0xca, 0x6f, 0xad, 0xf3, 0xe8,
+	0x8c, 0x2c, 0x9b, 0xb4, 0x85, 0x49, 0xef, 0xd1, 0x46, 0x61, 0xd2, 0x53, 0x4d, 0x5e, 0x7b, 0x75,
+	0xd8, 0x77, 0xc9, 0x51, 0xdf, 0x25, 0xff, 0xfa, 0x2e, 0xf9, 0x36, 0x70, 0x2b, 0x47, 0x03, 0xb7,
+	0xf2, 0x67, 0xe0, 0x56, 0xd6, 0xfd, 0x38, 0xd1, 0xdb, 0xbd, 0x8e, 0x1f, 0x89, 0xee, 0x50, 0x6f,
+	0x75, 0x37, 0xec, 0xa8, 0x91, 0xf8, 0xa7, 0xa1, 0xbc, 0x3e, 0x90, 0x4c, 0x75, 0xaa, 0xf8, 0xc3,
+	0x7b, 0x70, 0x1c, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x2a, 0x19, 0x53, 0xb4, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +435,11 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// Params returns the parameters of the epochs module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingEpochWork returns the unfinished work of epoch hooks, optionally
+	// filtered by epoch identifier.
+	PendingEpochWork(ctx context.Context, in *QueryPendingEpochWorkRequest, opts ...grpc.CallOption) (*QueryPendingEpochWorkResponse, error)
 }
 
 type queryClient struct {
@@ -283,12 +468,35 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.epochs.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingEpochWork(ctx context.Context, in *QueryPendingEpochWorkRequest, opts ...grpc.CallOption) (*QueryPendingEpochWorkResponse, error) {
+	out := new(QueryPendingEpochWorkResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.epochs.v1beta1.Query/PendingEpochWork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// Params returns the parameters of the epochs module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingEpochWork returns the unfinished work of epoch hooks, optionally
+	// filtered by epoch identifier.
+	PendingEpochWork(context.Context, *QueryPendingEpochWorkRequest) (*QueryPendingEpochWorkResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -301,6 +509,12 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PendingEpochWork(ctx context.Context, req *QueryPendingEpochWorkRequest) (*QueryPendingEpochWorkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingEpochWork not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -342,6 +556,43 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.epochs.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingEpochWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingEpochWorkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingEpochWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.epochs.v1beta1.Query/PendingEpochWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingEpochWork(ctx, req.(*QueryPendingEpochWorkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.epochs.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -354,6 +605,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingEpochWork",
+			Handler:    _Query_PendingEpochWork_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/epochs/v1beta1/query.proto",
@@ -477,6 +736,129 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingEpochWorkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingEpochWorkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingEpochWorkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingEpochWorkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingEpochWorkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingEpochWorkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingEpochWork) > 0 {
+		for iNdEx := len(m.PendingEpochWork) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingEpochWork[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -537,11 +919,59 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingEpochWorkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingEpochWorkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingEpochWork) > 0 {
+		for _, e := range m.PendingEpochWork {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -828,6 +1258,305 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingEpochWorkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingEpochWorkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingEpochWorkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingEpochWorkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingEpochWorkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingEpochWorkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEpochWork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingEpochWork = append(m.PendingEpochWork, EpochWork{})
+			if err := m.PendingEpochWork[len(m.PendingEpochWork)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,10 @@ const (
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors                 = "scaling-factors"
 	FlagScalingFactorControllerAddress = "scaling-factor-controller-address"
+	// Will be parsed to uint64.
+	FlagFutureAmplification = "future-amplification"
+	// Will be parsed to time.Time.
+	FlagFutureTime = "future-time"
 
 	FlagMigrationRecords = "migration-records"
 
@@ -63,6 +67,7 @@ type createStableswapPoolInputs struct {
 	FutureGovernor          string `json:"future-governor"`
 	ScalingFactorController string `json:"scaling-factor-controller"`
 	ScalingFactors          string `json:"scaling-factors"`
+	Amplification           string `json:"amplification"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -120,6 +125,13 @@ func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetRampAmplification() *flag.FlagSet {
+	fs := FlagSetJustPoolId()
+	fs.Uint64(FlagFutureAmplification, 0, "The amplification at the end of the ramp")
+	fs.String(FlagFutureTime, "", "The end time of the ramp in RFC3339 format")
+	return fs
+}
+
 func FlagSetMigratePosition() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum tokens out")
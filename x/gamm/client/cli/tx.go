@@ -41,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewCreatePoolCmd().BuildCommandCustomFn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapRampAmplificationCmd(),
 	)
 	return txCmd
 }
@@ -69,6 +70,14 @@ For stableswap (demonstrating need for a 1:1000 scaling factor, see doc)
 	"future-governor": "168h",
 	"scaling-factors": "1000,1"
 }
+
+For stableswap with an amplification (omit or set to 0 for the legacy stableswap curve)
+{
+	"initial-deposit": "1000000uusdc,1000000uusdt",
+	"swap-fee": "0.01",
+	"future-governor": "168h",
+	"amplification": "100"
+}
 `,
 		NumArgs:          0,
 		ParseAndBuildMsg: BuildCreatePoolCmd,
@@ -184,6 +193,22 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampAmplificationCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:              "ramp-amplification --pool-id=[pool-id] --future-amplification=[future-amplification] --future-time=[future-time]",
+		Short:            "linearly ramp the amplification of a stableswap pool",
+		Example:          "osmosisd ramp-amplification --pool-id=1 --future-amplification=200 --future-time=2024-01-02T15:04:05Z",
+		NumArgs:          0,
+		ParseAndBuildMsg: NewStableSwapRampAmplificationMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetRampAmplification())
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagFutureAmplification)
+	_ = cmd.MarkFlagRequired(FlagFutureTime)
+	return cmd
+}
+
 // NewCmdSubmitReplaceMigrationRecordsProposal implements a command handler for replace migration records proposal
 func NewCmdSubmitReplaceMigrationRecordsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -531,6 +556,14 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		}
 	}
 
+	amplification := uint64(0)
+	if flags.Amplification != "" {
+		amplification, err = strconv.ParseUint(flags.Amplification, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &stableswap.MsgCreateStableswapPool{
 		Sender:                  clientCtx.GetFromAddress().String(),
 		PoolParams:              poolParams,
@@ -538,6 +571,7 @@ func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, fs *flag.FlagSet)
 		ScalingFactors:          scalingFactors,
 		ScalingFactorController: flags.ScalingFactorController,
 		FuturePoolGovernor:      flags.FutureGovernor,
+		Amplification:           amplification,
 	}, nil
 }
 
@@ -683,6 +717,37 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 	return msg, nil
 }
 
+func NewStableSwapRampAmplificationMsg(clientCtx client.Context, _args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	futureAmplification, err := fs.GetUint64(FlagFutureAmplification)
+	if err != nil {
+		return nil, err
+	}
+
+	futureTimeStr, err := fs.GetString(FlagFutureTime)
+	if err != nil {
+		return nil, err
+	}
+
+	futureTime, err := time.Parse(time.RFC3339, futureTimeStr)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse time: %w", err)
+	}
+
+	msg := &stableswap.MsgStableSwapRampAmplification{
+		Sender:              clientCtx.GetFromAddress().String(),
+		PoolID:              poolID,
+		FutureAmplification: futureAmplification,
+		FutureTime:          futureTime,
+	}
+
+	return msg, nil
+}
+
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
 // Returns error if parsing fails.
 func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
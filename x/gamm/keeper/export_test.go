@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -22,6 +24,10 @@ func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
 
+func (k Keeper) RampStableSwapAmplification(ctx sdk.Context, poolId uint64, futureAmplification uint64, futureTime time.Time, sender string) error {
+	return k.rampStableSwapAmplification(ctx, poolId, futureAmplification, futureTime, sender)
+}
+
 func (k Keeper) SetStableSwapScalingFactorController(ctx sdk.Context, poolId uint64, controllerAddress string) error {
 	return k.setStableSwapScalingFactorController(ctx, poolId, controllerAddress)
 }
@@ -63,6 +63,16 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampAmplification(goCtx context.Context, msg *stableswap.MsgStableSwapRampAmplification) (*stableswap.MsgStableSwapRampAmplificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapAmplification(ctx, msg.PoolID, msg.FutureAmplification, msg.FutureTime, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampAmplificationResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
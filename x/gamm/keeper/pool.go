@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -328,6 +329,25 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+// rampStableSwapAmplification starts a linear ramp of the stable swap amplification.
+// errors if the pool does not exist, the sender is not the scaling factor controller, the ramp is invalid,
+// or due to other internal errors.
+func (k Keeper) rampStableSwapAmplification(ctx sdk.Context, poolId uint64, futureAmplification uint64, futureTime time.Time, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.RampAmplification(ctx, futureAmplification, futureTime, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
 // setStableSwapScalingFactorController updates the scaling factor controller address for a stable swap pool
 // errors if the pool does not exist or is not a stable swap pool
 func (k Keeper) setStableSwapScalingFactorController(ctx sdk.Context, poolId uint64, controllerAddress string) error {
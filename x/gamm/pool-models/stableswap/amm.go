@@ -2,7 +2,9 @@ package stableswap
 
 import (
 	"errors"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -38,12 +40,17 @@ func cfmmConstantMultiNoVY(xReserve, yReserve, wSumSquares osmomath.BigDec) osmo
 // So we solve the following expression for `a`:
 // xy(x^2 + y^2 + w) = (x - a)(y + b)((x - a)^2 + (y + b)^2 + w)
 // with w set to 0 for 2 asset pools
-func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec) osmomath.BigDec {
+// If the amplification is positive, the amplified curve CFMM is solved instead, see solveAmplifiedCfmm.
+// The amplification is scaled by AmplificationPrecision.
+func solveCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec, amplification uint64) (osmomath.BigDec, error) {
+	if amplification > 0 {
+		return solveAmplifiedCfmm(xReserve, yReserve, remReserves, yIn, amplification)
+	}
 	wSumSquares := osmomath.ZeroBigDec()
 	for _, assetReserve := range remReserves {
 		wSumSquares = wSumSquares.Add(assetReserve.Mul(assetReserve))
 	}
-	return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn)
+	return solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn), nil
 }
 
 // $$k_{target} = \frac{x_0 y_0 (x_0^2 + y_0^2 + w)}{y_f} - (x_0 (y_f^2 + w) + x_0^3)$$
@@ -156,7 +163,124 @@ func solveCFMMBinarySearchMulti(xReserve, yReserve, wSumSquares, yIn osmomath.Bi
 	return xOut
 }
 
-func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice osmomath.Dec, err error) {
+// The amplified CFMM is curve's StableSwap invariant, for n assets with reserves x_i, sum S and product P:
+// A n S + D = A n D + D^{n+1} / (n^n P)
+// where A is the amplification coefficient and D is the total amount of assets when they have an equal price.
+// Like in curve's implementation, A stands for A' n^{n-1} of the whitepaper's A' n^n S + D = A' n^n D + D^{n+1} / (n^n P).
+// The larger A, the closer the CFMM is to the constant sum x + y = D around the balanced point.
+// Both D and the reserve x_f solving the invariant are computed with Newton's method.
+var (
+	// newtonTolerance is the relative tolerance of the Newton iterations, which converge once a step is below it.
+	newtonTolerance = osmomath.NewBigDecWithPrec(1, 28)
+	// newtonMaxIterations is the number of Newton iterations after which the solvers give up and error.
+	newtonMaxIterations = 256
+)
+
+// solveAmplifiedCfmm solves the amplified CFMM for the amount `a` of x that leaves the pool
+// when `b` units of y are added to the pool, keeping D constant:
+// D(x, y, rem...) = D(x - a, y + b, rem...)
+// It errors if Newton's method does not converge, so that the swap fails instead of the chain halting.
+func solveAmplifiedCfmm(xReserve, yReserve osmomath.BigDec, remReserves []osmomath.BigDec, yIn osmomath.BigDec, amplification uint64) (osmomath.BigDec, error) {
+	if !xReserve.IsPositive() || !yReserve.IsPositive() {
+		panic("invalid input: reserves and input must be positive")
+	} else if yIn.Abs().GTE(yReserve) {
+		panic("cannot input more than pool reserves")
+	}
+	for _, reserve := range remReserves {
+		if !reserve.IsPositive() {
+			panic("invalid input: reserves must be positive")
+		}
+	}
+
+	reserves := append([]osmomath.BigDec{xReserve, yReserve}, remReserves...)
+	d, err := amplifiedCfmmD(reserves, amplification)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	yFinal := yReserve.Add(yIn)
+	xFinal, err := amplifiedCfmmReserve(d, append([]osmomath.BigDec{yFinal}, remReserves...), amplification)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	// Like in solveCFMMBinarySearchMulti, we always round x_final up so that x_out is under-estimated
+	// for positive yIn, and |x_out| is over-estimated for negative yIn.
+	// The error of Newton's method is below its last step, so we round up by the tolerance.
+	xFinal = xFinal.Add(xFinal.Mul(newtonTolerance))
+
+	xOut := xReserve.Sub(xFinal)
+	if xOut.Abs().GTE(xReserve) {
+		panic("invalid output: greater than full pool reserves")
+	}
+	return xOut, nil
+}
+
+// annFromAmplification returns A n for n assets and the amplification scaled by AmplificationPrecision.
+func annFromAmplification(n int64, amplification uint64) osmomath.BigDec {
+	return osmomath.NewBigDec(n).MulInt64(int64(amplification)).QuoInt64(AmplificationPrecision)
+}
+
+// amplifiedCfmmD computes D for the given reserves with Newton's method:
+// D_{i+1} = (A n S + n D_P) D_i / ((A n - 1) D_i + (n + 1) D_P)
+// where D_P = D_i^{n+1} / (n^n P), computed iteratively to avoid overflows.
+func amplifiedCfmmD(reserves []osmomath.BigDec, amplification uint64) (osmomath.BigDec, error) {
+	n := int64(len(reserves))
+	sum := osmomath.ZeroBigDec()
+	for _, reserve := range reserves {
+		sum = sum.Add(reserve)
+	}
+	ann := annFromAmplification(n, amplification)
+
+	d := sum
+	for i := 0; i < newtonMaxIterations; i++ {
+		dP := d
+		for _, reserve := range reserves {
+			dP = dP.Mul(d).Quo(reserve.MulInt64(n))
+		}
+
+		prevD := d
+		numerator := ann.Mul(sum).Add(dP.MulInt64(n)).Mul(d)
+		denominator := ann.Sub(one).Mul(d).Add(dP.MulInt64(n + 1))
+		d = numerator.Quo(denominator)
+
+		if d.Sub(prevD).Abs().LTE(d.Mul(newtonTolerance)) {
+			return d, nil
+		}
+	}
+	return osmomath.BigDec{}, errorsmod.Wrapf(types.ErrAmplifiedCfmmNotConverged, "D after %d iterations", newtonMaxIterations)
+}
+
+// amplifiedCfmmReserve computes the reserve x that satisfies the amplified CFMM for D,
+// given the other reserves, with Newton's method on x^2 + (b - D) x = c:
+// x_{i+1} = (x_i^2 + c) / (2 x_i + b - D)
+// where b = S' + D / (A n) and c = D^{n+1} / (n^n P' A n), with S' and P' the sum and product of the other reserves.
+func amplifiedCfmmReserve(d osmomath.BigDec, otherReserves []osmomath.BigDec, amplification uint64) (osmomath.BigDec, error) {
+	n := int64(len(otherReserves) + 1)
+	ann := annFromAmplification(n, amplification)
+
+	c := d
+	sum := osmomath.ZeroBigDec()
+	for _, reserve := range otherReserves {
+		sum = sum.Add(reserve)
+		c = c.Mul(d).Quo(reserve.MulInt64(n))
+	}
+	c = c.Mul(d).Quo(ann.MulInt64(n))
+	b := sum.Add(d.Quo(ann))
+
+	x := d
+	for i := 0; i < newtonMaxIterations; i++ {
+		prevX := x
+		x = x.Mul(x).Add(c).Quo(x.MulInt64(2).Add(b).Sub(d))
+
+		if x.Sub(prevX).Abs().LTE(x.Mul(newtonTolerance)) {
+			return x, nil
+		}
+	}
+	return osmomath.BigDec{}, errorsmod.Wrapf(types.ErrAmplifiedCfmmNotConverged, "reserve after %d iterations", newtonMaxIterations)
+}
+
+func (p Pool) spotPrice(quoteDenom, baseDenom string, amplification uint64) (spotPrice osmomath.Dec, err error) {
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 spread factor, at the current liquidity.
 	// The spot price of the pool is then lim a -> 0, f_{y -> x}(a) / a
@@ -164,7 +288,8 @@ func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice osmomath.Dec, e
 	// The spot price equation of y in terms of x is X_SUPPLY/Y_SUPPLY.
 	// You can work out that it follows from the above relation!
 	//
-	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2).
+	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2),
+	// or the amplified CFMM if the pool has an amplification.
 	// Or we can sidestep this, by just picking a small value a, and computing f_{y -> x}(a) / a,
 	// and accept the precision error.
 
@@ -172,7 +297,7 @@ func (p Pool) spotPrice(quoteDenom, baseDenom string) (spotPrice osmomath.Dec, e
 	// xReserve & yReserve.
 	a := osmomath.OneInt()
 
-	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(baseDenom, a), quoteDenom, osmomath.ZeroDec())
+	res, err := p.calcOutAmtGivenIn(sdk.NewCoin(baseDenom, a), quoteDenom, osmomath.ZeroDec(), amplification)
 	// fmt.Println("spot price res", res)
 	return res, err
 }
@@ -182,7 +307,7 @@ func oneMinus(spreadFactor osmomath.Dec) osmomath.BigDec {
 }
 
 // calcOutAmtGivenIn calculate amount of specified denom to output from a pool in osmomath.Dec given the input `tokenIn`
-func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFactor osmomath.Dec, amplification uint64) (osmomath.Dec, error) {
 	// round liquidity down, and round token in down
 	reserves, err := p.scaledSortedPoolReserves(tokenIn.Denom, tokenOutDenom, osmomath.RoundDown)
 	if err != nil {
@@ -198,14 +323,17 @@ func (p Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, spreadFa
 	ammIn := tokenInDec.Mul(oneMinus(spreadFactor))
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
 	// fmt.Printf("outSupply %s, inSupply %s, remReservs %s, ammIn %s\n ", tokenOutSupply, tokenInSupply, remReserves, ammIn)
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn)
+	cfmmOut, err := solveCfmm(tokenOutSupply, tokenInSupply, remReserves, ammIn, amplification)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
 	// fmt.Println("cfmmout ", cfmmOut)
 	outAmt := p.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
 
 // calcInAmtGivenOut calculates exact input amount given the desired output and return as a decimal
-func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadFactor osmomath.Dec) (osmomath.Dec, error) {
+func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadFactor osmomath.Dec, amplification uint64) (osmomath.Dec, error) {
 	// round liquidity down, and round token out up
 	reserves, err := p.scaledSortedPoolReserves(tokenInDenom, tokenOut.Denom, osmomath.RoundDown)
 	if err != nil {
@@ -219,7 +347,10 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg())
+	cfmmIn, err := solveCfmm(tokenInSupply, tokenOutSupply, remReserves, tokenOutAmount.Neg(), amplification)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
 	// returned cfmmIn is negative, representing we need to add this many tokens to pool.
 	// We invert that negative here.
 	cfmmIn = cfmmIn.Neg()
@@ -231,10 +362,12 @@ func (p *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, spreadF
 
 // calcSingleAssetJoinShares calculates the number of LP shares that
 // should be granted given the passed in single-token input (non-mutative)
-func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor osmomath.Dec) (osmomath.Int, error) {
+func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor osmomath.Dec, amplification uint64) (osmomath.Int, error) {
 	poolWithAddedLiquidityAndShares := func(newLiquidity sdk.Coin, newShares osmomath.Int) types.CFMMPoolI {
 		paCopy := p.Copy()
 		paCopy.updatePoolForJoin(sdk.NewCoins(newLiquidity), newShares)
+		// The binary search swaps against the copy without a block time, so we pin its amplification.
+		paCopy.AmplificationRamp = NewConstantAmplificationRamp(amplification, time.Time{})
 		return &paCopy
 	}
 
@@ -289,7 +422,7 @@ func (p *Pool) joinPoolSharesInternal(ctx sdk.Context, tokensIn sdk.Coins, sprea
 	}
 
 	if len(tokensIn) == 1 && tokensIn[0].Amount.GT(osmomath.OneInt()) {
-		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], spreadFactor)
+		numShares, err = p.calcSingleAssetJoinShares(tokensIn[0], spreadFactor, p.GetAmplification(ctx))
 		if err != nil {
 			return osmomath.ZeroInt(), sdk.NewCoins(), err
 		}
@@ -10,7 +10,14 @@ import (
 func BenchmarkCFMM(b *testing.B) {
 	// Uses solveCfmm
 	for i := 0; i < b.N; i++ {
-		runCalcCFMM(solveCfmm)
+		runCalcCFMM(solveCfmm, 0)
+	}
+}
+
+func BenchmarkAmplifiedCFMM(b *testing.B) {
+	// Uses solveCfmm with an amplification
+	for i := 0; i < b.N; i++ {
+		runCalcCFMM(solveCfmm, 100*AmplificationPrecision)
 	}
 }
 
@@ -20,11 +27,11 @@ func BenchmarkBinarySearchMultiAsset(b *testing.B) {
 	}
 }
 
-func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
+func runCalcCFMM(solve func(osmomath.BigDec, osmomath.BigDec, []osmomath.BigDec, osmomath.BigDec, uint64) (osmomath.BigDec, error), amplification uint64) {
 	xReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yReserve := osmomath.NewBigDec(rand.Int63n(100000) + 50000)
 	yIn := osmomath.NewBigDec(rand.Int63n(100000))
-	solve(xReserve, yReserve, []osmomath.BigDec{}, yIn)
+	_, _ = solve(xReserve, yReserve, []osmomath.BigDec{}, yIn, amplification)
 }
 
 func runCalcTwoAsset(solve func(osmomath.BigDec, osmomath.BigDec, osmomath.BigDec) osmomath.BigDec) {
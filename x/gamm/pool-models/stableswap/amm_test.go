@@ -439,7 +439,8 @@ func TestCFMMInvariantTwoAssets(t *testing.T) {
 
 				// using two-asset cfmm
 				k0 := cfmmConstant(test.xReserve, test.yReserve)
-				xOut := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut, err := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, 0)
+				require.NoError(t, err)
 
 				k1 := cfmmConstant(test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn))
 				osmomath.DecApproxEq(t, k0, k1, kErrTolerance)
@@ -575,7 +576,8 @@ func TestCFMMInvariantMultiAssets(t *testing.T) {
 
 				// using multi-asset cfmm
 				k2 := cfmmConstantMulti(test.xReserve, test.yReserve, uReserve, wSumSquares)
-				xOut2 := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn)
+				xOut2, err := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, 0)
+				require.NoError(t, err)
 				k3 := cfmmConstantMulti(test.xReserve.Sub(xOut2), test.yReserve.Add(test.yIn), uReserve, wSumSquares)
 				osmomath.DecApproxEq(t, k2, k3, kErrTolerance)
 			}
@@ -720,6 +722,83 @@ func TestCFMMInvariantMultiAssetsBinarySearch(t *testing.T) {
 	}
 }
 
+func TestAmplifiedCFMMInvariant(t *testing.T) {
+	// D is preserved up to the rounding of x_final, which is relative to the reserves
+	dErrTolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 18)}
+
+	tests := make(map[string]CFMMTestCase, len(twoAssetCFMMTestCases)+len(multiAssetCFMMTestCases))
+	for name, test := range twoAssetCFMMTestCases {
+		tests["two assets: "+name] = test
+	}
+	for name, test := range multiAssetCFMMTestCases {
+		tests["multi assets: "+name] = test
+	}
+
+	for _, amplification := range []uint64{1 * AmplificationPrecision, 100 * AmplificationPrecision, MaxAmplification * AmplificationPrecision} {
+		for name, test := range tests {
+			t.Run(fmt.Sprintf("A = %d, %s", amplification, name), func(t *testing.T) {
+				// system under test
+				sut := func() {
+					reserves := append([]osmomath.BigDec{test.xReserve, test.yReserve}, test.remReserves...)
+					d0, err := amplifiedCfmmD(reserves, amplification)
+					require.NoError(t, err)
+
+					xOut, err := solveCfmm(test.xReserve, test.yReserve, test.remReserves, test.yIn, amplification)
+					require.NoError(t, err)
+
+					reserves = append([]osmomath.BigDec{test.xReserve.Sub(xOut), test.yReserve.Add(test.yIn)}, test.remReserves...)
+					d1, err := amplifiedCfmmD(reserves, amplification)
+					require.NoError(t, err)
+					require.Equal(t, 0, dErrTolerance.CompareBigDec(d0, d1), "d0 %s, d1 %s", d0, d1)
+
+					// x_out is rounded in the pool's favor, so D never decreases
+					require.True(t, d1.GTE(d0), "d0 %s, d1 %s", d0, d1)
+				}
+
+				osmoassert.ConditionalPanic(t, test.expectPanic, sut)
+			})
+		}
+	}
+}
+
+func TestAmplifiedCFMMNotConverged(t *testing.T) {
+	defer func(maxIterations int) { newtonMaxIterations = maxIterations }(newtonMaxIterations)
+	newtonMaxIterations = 1
+
+	pool := poolStructFromAssets(twoUnevenStablePoolAssets, defaultTwoAssetScalingFactors)
+	pool.AmplificationRamp = NewConstantAmplificationRamp(100*AmplificationPrecision, defaultRampStartTime)
+	ctx := sdk.Context{}.WithBlockTime(defaultRampStartTime)
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+
+	// the swaps fail instead of panicking, and leave the pool unchanged
+	_, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000)), "foo", defaultSpreadFactor)
+	require.ErrorIs(t, err, types.ErrAmplifiedCfmmNotConverged)
+	_, err = pool.SwapInAmtGivenOut(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000000)), "bar", defaultSpreadFactor)
+	require.ErrorIs(t, err, types.ErrAmplifiedCfmmNotConverged)
+	require.Equal(t, liquidity, pool.GetTotalPoolLiquidity(ctx))
+}
+
+func TestAmplifiedCFMMSpotPrice(t *testing.T) {
+	// the higher the amplification, the closer the price of a balanced pool to 1
+	// and the less the price of an imbalanced pool moves away from 1
+	balancedPool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+	imbalancedPool := poolStructFromAssets(twoUnevenStablePoolAssets, defaultTwoAssetScalingFactors)
+	denoms := twoUnevenStablePoolAssets.Denoms()
+
+	prevDistance := osmomath.OneDec()
+	for _, amplification := range []uint64{1, 10, 100, 1000, MaxAmplification} {
+		balancedSpotPrice, err := balancedPool.spotPrice(denoms[0], denoms[1], amplification*AmplificationPrecision)
+		require.NoError(t, err)
+		osmoassert.DecApproxEq(t, osmomath.OneDec(), balancedSpotPrice, osmomath.NewDecWithPrec(1, 6))
+
+		imbalancedSpotPrice, err := imbalancedPool.spotPrice(denoms[0], denoms[1], amplification*AmplificationPrecision)
+		require.NoError(t, err)
+		distance := imbalancedSpotPrice.Sub(osmomath.OneDec()).Abs()
+		require.True(t, distance.LT(prevDistance), "A = %d, spot price %s", amplification, imbalancedSpotPrice)
+		prevDistance = distance
+	}
+}
+
 func (suite *StableSwapTestSuite) Test_StableSwap_CalculateAmountOutAndIn_InverseRelationship() {
 	type testcase struct {
 		denomOut       string
@@ -1068,7 +1147,7 @@ func TestCalcSingleAssetJoinShares(t *testing.T) {
 			ctx := sdk.Context{}
 			p := poolStructFromAssets(tc.poolAssets, tc.scalingFactors)
 
-			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor)
+			shares, err := p.calcSingleAssetJoinShares(tc.tokenIn, tc.spreadFactor, 0)
 			require.NoError(t, err, "test: %s", name)
 
 			p.updatePoolForJoin(sdk.Coins{tc.tokenIn}, shares)
@@ -0,0 +1,109 @@
+package stableswap
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/gamm/types"
+)
+
+const (
+	// AmplificationPrecision is the scale of the amplification stored in a ramp and used by the amplified CFMM,
+	// like curve's A_PRECISION. Ramps are interpolated in this unit, so that the amplification of a low ramp
+	// moves in small steps rather than by whole numbers.
+	AmplificationPrecision = 100
+	// MaxAmplification is the maximum amplification coefficient of a stableswap pool.
+	MaxAmplification = 1_000_000
+	// MaxAmplificationChange is the maximum factor by which a single ramp can increase or decrease the amplification.
+	MaxAmplificationChange = 10
+	// MinAmplificationRampDuration is the minimum duration of an amplification ramp.
+	// Together with MaxAmplificationChange, it bounds the rate at which the amplification can change.
+	MinAmplificationRampDuration = 24 * time.Hour
+)
+
+// NewConstantAmplificationRamp returns a ramp that keeps the amplification constant from the given time on.
+// The amplification is scaled by AmplificationPrecision.
+func NewConstantAmplificationRamp(amplification uint64, t time.Time) AmplificationRamp {
+	return AmplificationRamp{
+		InitialAmplification: amplification,
+		InitialTime:          t,
+		FutureAmplification:  amplification,
+		FutureTime:           t,
+	}
+}
+
+// AmplificationAt returns the amplification coefficient of the ramp at the given time, scaled by
+// AmplificationPrecision, linearly interpolated between the initial and the future amplification and truncated.
+func (r AmplificationRamp) AmplificationAt(t time.Time) uint64 {
+	if !t.Before(r.FutureTime) {
+		return r.FutureAmplification
+	}
+	if !t.After(r.InitialTime) {
+		return r.InitialAmplification
+	}
+
+	elapsed := osmomath.NewInt(t.Sub(r.InitialTime).Nanoseconds())
+	duration := osmomath.NewInt(r.FutureTime.Sub(r.InitialTime).Nanoseconds())
+	initial := osmomath.NewIntFromUint64(r.InitialAmplification)
+	future := osmomath.NewIntFromUint64(r.FutureAmplification)
+
+	// initial + (future - initial) * elapsed / duration
+	return initial.Add(future.Sub(initial).Mul(elapsed).Quo(duration)).Uint64()
+}
+
+// GetAmplification returns the amplification coefficient of the pool at the block time, scaled by AmplificationPrecision.
+func (p Pool) GetAmplification(ctx sdk.Context) uint64 {
+	return p.AmplificationRamp.AmplificationAt(ctx.BlockTime())
+}
+
+// RampAmplification starts a linear ramp of the pool's amplification from its current value to
+// futureAmplification at futureTime. futureAmplification is a whole amplification coefficient,
+// which the ramp scales by AmplificationPrecision.
+// A pool without an amplification uses the legacy CFMM, whose prices differ from the amplified CFMM's
+// away from balance, so it cannot be ramped: switching curves would make the price jump.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor
+func (p *Pool) RampAmplification(ctx sdk.Context, futureAmplification uint64, futureTime time.Time, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	currentAmplification := p.GetAmplification(ctx)
+	if currentAmplification == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp, "pool %d has no amplification to ramp", p.Id)
+	}
+
+	if err := validateAmplification(futureAmplification); err != nil {
+		return err
+	}
+	futureAmplification *= AmplificationPrecision
+
+	if futureTime.Before(ctx.BlockTime().Add(MinAmplificationRampDuration)) {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp,
+			"ramp must last at least %s, ends at %s", MinAmplificationRampDuration, futureTime)
+	}
+
+	if futureAmplification > currentAmplification*MaxAmplificationChange ||
+		futureAmplification*MaxAmplificationChange < currentAmplification {
+		return errorsmod.Wrapf(types.ErrInvalidAmplificationRamp,
+			"amplification can change by at most a factor of %d, got %d to %d scaled by %d", MaxAmplificationChange, currentAmplification, futureAmplification, AmplificationPrecision)
+	}
+
+	p.AmplificationRamp = AmplificationRamp{
+		InitialAmplification: currentAmplification,
+		InitialTime:          ctx.BlockTime(),
+		FutureAmplification:  futureAmplification,
+		FutureTime:           futureTime,
+	}
+	return nil
+}
+
+// validateAmplification returns an error if the amplification is not within [1, MaxAmplification].
+func validateAmplification(amplification uint64) error {
+	if amplification == 0 || amplification > MaxAmplification {
+		return errorsmod.Wrapf(types.ErrInvalidAmplification, "amplification must be between 1 and %d, got %d", MaxAmplification, amplification)
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v31/x/gamm/types"
+)
+
+var defaultRampStartTime = time.Unix(1_700_000_000, 0).UTC()
+
+func TestAmplificationAt(t *testing.T) {
+	ramp := AmplificationRamp{
+		InitialAmplification: 100,
+		InitialTime:          defaultRampStartTime,
+		FutureAmplification:  200,
+		FutureTime:           defaultRampStartTime.Add(100 * time.Hour),
+	}
+	decreasingRamp := AmplificationRamp{
+		InitialAmplification: 200,
+		InitialTime:          defaultRampStartTime,
+		FutureAmplification:  100,
+		FutureTime:           defaultRampStartTime.Add(100 * time.Hour),
+	}
+
+	tests := map[string]struct {
+		ramp     AmplificationRamp
+		time     time.Time
+		expected uint64
+	}{
+		"before the ramp": {
+			ramp:     ramp,
+			time:     defaultRampStartTime.Add(-time.Hour),
+			expected: 100,
+		},
+		"zero time": {
+			ramp:     ramp,
+			time:     time.Time{},
+			expected: 100,
+		},
+		"start of the ramp": {
+			ramp:     ramp,
+			time:     defaultRampStartTime,
+			expected: 100,
+		},
+		"during the ramp": {
+			ramp:     ramp,
+			time:     defaultRampStartTime.Add(25 * time.Hour),
+			expected: 125,
+		},
+		"during the ramp, truncated": {
+			ramp:     ramp,
+			time:     defaultRampStartTime.Add(25*time.Hour + 59*time.Minute),
+			expected: 125,
+		},
+		"during a decreasing ramp": {
+			ramp:     decreasingRamp,
+			time:     defaultRampStartTime.Add(25 * time.Hour),
+			expected: 175,
+		},
+		"end of the ramp": {
+			ramp:     ramp,
+			time:     defaultRampStartTime.Add(100 * time.Hour),
+			expected: 200,
+		},
+		"after the ramp": {
+			ramp:     ramp,
+			time:     defaultRampStartTime.Add(1000 * time.Hour),
+			expected: 200,
+		},
+		"during a low ramp, interpolated in scaled units": {
+			ramp: AmplificationRamp{
+				InitialAmplification: 1 * AmplificationPrecision,
+				InitialTime:          defaultRampStartTime,
+				FutureAmplification:  10 * AmplificationPrecision,
+				FutureTime:           defaultRampStartTime.Add(MinAmplificationRampDuration),
+			},
+			time:     defaultRampStartTime.Add(MinAmplificationRampDuration / 10),
+			expected: 190,
+		},
+		"constant ramp": {
+			ramp:     NewConstantAmplificationRamp(100, defaultRampStartTime),
+			time:     defaultRampStartTime.Add(time.Hour),
+			expected: 100,
+		},
+		"no amplification": {
+			ramp:     AmplificationRamp{},
+			time:     defaultRampStartTime,
+			expected: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.ramp.AmplificationAt(tc.time))
+		})
+	}
+}
+
+func TestRampAmplification(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+
+	failPk := ed25519.GenPrivKey().PubKey()
+	failAddr := sdk.AccAddress(failPk.Address())
+
+	blockTime := defaultRampStartTime.Add(time.Hour)
+
+	tests := map[string]struct {
+		amplification       uint64
+		futureAmplification uint64
+		futureTime          time.Time
+		sender              string
+		expError            error
+	}{
+		"Sender is not scaling factor governor in pool": {
+			amplification:       100,
+			futureAmplification: 200,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              failAddr.String(),
+			expError:            types.ErrNotScalingFactorGovernor,
+		},
+		"Ramp from no amplification": {
+			amplification:       0,
+			futureAmplification: MaxAmplificationChange,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplificationRamp,
+		},
+		"Zero future amplification": {
+			amplification:       100,
+			futureAmplification: 0,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplification,
+		},
+		"Future amplification above max": {
+			amplification:       MaxAmplification,
+			futureAmplification: MaxAmplification + 1,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplification,
+		},
+		"Ramp is too short": {
+			amplification:       100,
+			futureAmplification: 200,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration - time.Second),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplificationRamp,
+		},
+		"Ramp increases the amplification too much": {
+			amplification:       100,
+			futureAmplification: 100*MaxAmplificationChange + 1,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplificationRamp,
+		},
+		"Ramp decreases the amplification too much": {
+			amplification:       100,
+			futureAmplification: 100/MaxAmplificationChange - 1,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+			expError:            types.ErrInvalidAmplificationRamp,
+		},
+		"Valid ramp increasing the amplification by the max change": {
+			amplification:       100,
+			futureAmplification: 100 * MaxAmplificationChange,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+		},
+		"Valid ramp decreasing the amplification by the max change": {
+			amplification:       100,
+			futureAmplification: 100 / MaxAmplificationChange,
+			futureTime:          blockTime.Add(MinAmplificationRampDuration),
+			sender:              addr.String(),
+		},
+		"Valid long ramp": {
+			amplification:       100,
+			futureAmplification: 150,
+			futureTime:          blockTime.Add(30 * MinAmplificationRampDuration),
+			sender:              addr.String(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.ScalingFactorController = addr.String()
+			pool.AmplificationRamp = NewConstantAmplificationRamp(tc.amplification*AmplificationPrecision, defaultRampStartTime)
+
+			err := pool.RampAmplification(ctx, tc.futureAmplification, tc.futureTime, tc.sender)
+			if tc.expError != nil {
+				require.ErrorIs(t, err, tc.expError)
+				require.Equal(t, NewConstantAmplificationRamp(tc.amplification*AmplificationPrecision, defaultRampStartTime), pool.AmplificationRamp)
+				return
+			}
+			require.NoError(t, err)
+
+			// the ramp starts from the current amplification at the block time
+			require.Equal(t, tc.amplification*AmplificationPrecision, pool.GetAmplification(ctx))
+			require.Equal(t, tc.futureAmplification*AmplificationPrecision, pool.GetAmplification(ctx.WithBlockTime(tc.futureTime)))
+			require.Equal(t, AmplificationRamp{
+				InitialAmplification: tc.amplification * AmplificationPrecision,
+				InitialTime:          blockTime,
+				FutureAmplification:  tc.futureAmplification * AmplificationPrecision,
+				FutureTime:           tc.futureTime,
+			}, pool.AmplificationRamp)
+		})
+	}
+}
+
+func TestAmplifiedSwapUsesBlockTimeAmplification(t *testing.T) {
+	pool := poolStructFromAssets(twoUnevenStablePoolAssets, defaultTwoAssetScalingFactors)
+	pool.AmplificationRamp = AmplificationRamp{
+		InitialAmplification: 10 * AmplificationPrecision,
+		InitialTime:          defaultRampStartTime,
+		FutureAmplification:  100 * AmplificationPrecision,
+		FutureTime:           defaultRampStartTime.Add(MinAmplificationRampDuration),
+	}
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 1000000))
+
+	calcOut := func(blockTime time.Time) sdk.Coin {
+		ctx := sdk.Context{}.WithBlockTime(blockTime)
+		tokenOut, err := pool.CalcOutAmtGivenIn(ctx, tokenIn, "foo", defaultSpreadFactor)
+		require.NoError(t, err)
+		return tokenOut
+	}
+
+	// the amplification at the block time is used, so the outputs match the pinned amplifications
+	for _, blockTime := range []time.Time{defaultRampStartTime, defaultRampStartTime.Add(MinAmplificationRampDuration / 2), defaultRampStartTime.Add(MinAmplificationRampDuration)} {
+		amplification := pool.AmplificationRamp.AmplificationAt(blockTime)
+		expectedOut, err := pool.calcOutAmtGivenIn(tokenIn[0], "foo", defaultSpreadFactor, amplification)
+		require.NoError(t, err)
+		require.Equal(t, expectedOut.TruncateInt(), calcOut(blockTime).Amount)
+	}
+
+	// bar is scarce in the pool, and a higher amplification keeps its price closer to 1, so it gives less foo out
+	require.True(t, calcOut(defaultRampStartTime.Add(MinAmplificationRampDuration)).Amount.LT(calcOut(defaultRampStartTime).Amount))
+}
+
+func TestAmplifiedSingleAssetJoin(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockTime(defaultRampStartTime.Add(MinAmplificationRampDuration / 2))
+	pool := poolStructFromAssets(twoUnevenStablePoolAssets, defaultTwoAssetScalingFactors)
+	pool.AmplificationRamp = AmplificationRamp{
+		InitialAmplification: 10 * AmplificationPrecision,
+		InitialTime:          defaultRampStartTime,
+		FutureAmplification:  100 * AmplificationPrecision,
+		FutureTime:           defaultRampStartTime.Add(MinAmplificationRampDuration),
+	}
+	tokenIn := sdk.NewInt64Coin("bar", 1000000)
+
+	shares, err := pool.JoinPool(ctx, sdk.NewCoins(tokenIn), osmomath.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, shares.IsPositive())
+
+	// exiting and swapping back to the input asset gives at most the input back
+	exitedCoins, err := pool.ExitPool(ctx, shares, osmomath.ZeroDec())
+	require.NoError(t, err)
+	swappedOut, err := pool.SwapOutAmtGivenIn(ctx, sdk.NewCoins(sdk.NewCoin("foo", exitedCoins.AmountOf("foo"))), "bar", osmomath.ZeroDec())
+	require.NoError(t, err)
+	totalOut := exitedCoins.AmountOf("bar").Add(swappedOut.Amount)
+	require.True(t, totalOut.LTE(tokenIn.Amount), "total out %s, token in %s", totalOut, tokenIn.Amount)
+	osmoassert.Equal(t, osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 6)}, tokenIn.Amount, totalOut)
+}
+
+func TestAmplificationRampSpotPriceContinuity(t *testing.T) {
+	pool := poolStructFromAssets(twoUnevenStablePoolAssets, defaultTwoAssetScalingFactors)
+	pool.AmplificationRamp = AmplificationRamp{
+		InitialAmplification: 1 * AmplificationPrecision,
+		InitialTime:          defaultRampStartTime,
+		FutureAmplification:  10 * AmplificationPrecision,
+		FutureTime:           defaultRampStartTime.Add(MinAmplificationRampDuration),
+	}
+	blockDuration := 5 * time.Second
+
+	spotPrice := func(blockTime time.Time) osmomath.BigDec {
+		price, err := pool.SpotPrice(sdk.Context{}.WithBlockTime(blockTime), "foo", "bar")
+		require.NoError(t, err)
+		return price
+	}
+
+	// the low amplifications change the most relative to their value, and the interpolation in scaled units
+	// keeps the spot price change of a block to a small fraction of the change over the whole ramp
+	rampChange := spotPrice(pool.AmplificationRamp.FutureTime).Sub(spotPrice(defaultRampStartTime)).Abs()
+	maxBlockChange := rampChange.QuoInt64(100)
+	for blockTime := defaultRampStartTime; blockTime.Before(defaultRampStartTime.Add(time.Hour)); blockTime = blockTime.Add(blockDuration) {
+		blockChange := spotPrice(blockTime.Add(blockDuration)).Sub(spotPrice(blockTime)).Abs()
+		require.True(t, blockChange.LTE(maxBlockChange), "block at %s changes the spot price by %s", blockTime, blockChange)
+	}
+}
@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +14,7 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampAmplification    = "stable_swap_ramp_amplification"
 )
 
 var (
@@ -77,6 +80,14 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 		return err
 	}
 
+	// validation for amplification
+	// The message's amplification must be zero (legacy CFMM) or a valid amplification
+	if msg.Amplification != 0 {
+		if err = validateAmplification(msg.Amplification); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -113,6 +124,10 @@ func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolId uint64) (p
 		return nil, err
 	}
 
+	if msg.Amplification != 0 {
+		stableswapPool.AmplificationRamp = NewConstantAmplificationRamp(msg.Amplification*AmplificationPrecision, ctx.BlockTime())
+	}
+
 	return &stableswapPool, nil
 }
 
@@ -161,3 +176,43 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampAmplification{}
+
+// Implement sdk.Msg
+func NewMsgStableSwapRampAmplification(
+	sender string,
+	poolID uint64,
+	futureAmplification uint64,
+	futureTime time.Time,
+) MsgStableSwapRampAmplification {
+	return MsgStableSwapRampAmplification{
+		Sender:              sender,
+		PoolID:              poolID,
+		FutureAmplification: futureAmplification,
+		FutureTime:          futureTime,
+	}
+}
+
+func (msg MsgStableSwapRampAmplification) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampAmplification) Type() string { return TypeMsgStableSwapRampAmplification }
+func (msg MsgStableSwapRampAmplification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateAmplification(msg.FutureAmplification)
+}
+
+func (msg MsgStableSwapRampAmplification) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
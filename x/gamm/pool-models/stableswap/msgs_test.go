@@ -74,6 +74,30 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid amplification",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = 100
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "max amplification",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = stableswap.MaxAmplification
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "amplification above max",
+			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
+				msg.Amplification = stableswap.MaxAmplification + 1
+				return msg
+			}),
+			expectPass: false,
+		},
 		{
 			name: "invalid sender",
 			msg: updateMsg(func(msg stableswap.MsgCreateStableswapPool) stableswap.MsgCreateStableswapPool {
@@ -323,6 +347,18 @@ func (suite *TestSuite) TestMsgCreateStableswapPool() {
 			},
 			poolId: 1,
 		},
+		"success test with amplification": {
+			msg: stableswap.MsgCreateStableswapPool{
+				Sender:                  suite.TestAccs[0].String(),
+				PoolParams:              validParams,
+				InitialPoolLiquidity:    validInitialLiquidity,
+				ScalingFactors:          validScalingFactors,
+				FuturePoolGovernor:      "",
+				ScalingFactorController: "",
+				Amplification:           100,
+			},
+			poolId: 1,
+		},
 		"error test - more scaling factors than initial liquidity": {
 			msg: stableswap.MsgCreateStableswapPool{
 				Sender:                  suite.TestAccs[0].String(),
@@ -354,6 +390,10 @@ func (suite *TestSuite) TestMsgCreateStableswapPool() {
 
 			suite.Require().Equal(tc.msg.InitialPoolLiquidity, cfmmPool.GetTotalPoolLiquidity(suite.Ctx))
 			suite.Require().Equal(types.InitPoolSharesSupply, cfmmPool.GetTotalShares())
+
+			stableswapPool, ok := pool.(*stableswap.Pool)
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.msg.Amplification*stableswap.AmplificationPrecision, stableswapPool.GetAmplification(suite.Ctx))
 		})
 	}
 }
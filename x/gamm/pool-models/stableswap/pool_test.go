@@ -1356,7 +1356,7 @@ func TestStableswapSpotPrice(t *testing.T) {
 				if (tc.expectedPrice != osmomath.Dec{}) {
 					expectedSpotPrice = tc.expectedPrice
 				} else {
-					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, osmomath.ZeroDec())
+					expectedSpotPrice, err = p.calcOutAmtGivenIn(sdk.NewInt64Coin(tc.baseDenom, 1), tc.quoteDenom, osmomath.ZeroDec(), 0)
 					require.NoError(t, err)
 				}
 
@@ -13,17 +13,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -98,6 +101,9 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification_ramp is the linear ramp of the pool's amplification
+	// coefficient. An amplification of zero uses the legacy solidly CFMM.
+	AmplificationRamp AmplificationRamp `protobuf:"bytes,9,opt,name=amplification_ramp,json=amplificationRamp,proto3" json:"amplification_ramp" yaml:"amplification_ramp"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -132,9 +138,82 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// AmplificationRamp linearly ramps the amplification coefficient of a
+// stableswap pool from initial_amplification at initial_time to
+// future_amplification at future_time. The amplifications are scaled by
+// AmplificationPrecision (100).
+type AmplificationRamp struct {
+	InitialAmplification uint64    `protobuf:"varint,1,opt,name=initial_amplification,json=initialAmplification,proto3" json:"initial_amplification,omitempty" yaml:"initial_amplification"`
+	InitialTime          time.Time `protobuf:"bytes,2,opt,name=initial_time,json=initialTime,proto3,stdtime" json:"initial_time" yaml:"initial_time"`
+	FutureAmplification  uint64    `protobuf:"varint,3,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty" yaml:"future_amplification"`
+	FutureTime           time.Time `protobuf:"bytes,4,opt,name=future_time,json=futureTime,proto3,stdtime" json:"future_time" yaml:"future_time"`
+}
+
+func (m *AmplificationRamp) Reset()         { *m = AmplificationRamp{} }
+func (m *AmplificationRamp) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRamp) ProtoMessage()    {}
+func (*AmplificationRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b99ab4400f54fe92, []int{2}
+}
+func (m *AmplificationRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRamp.Merge(m, src)
+}
+func (m *AmplificationRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRamp proto.InternalMessageInfo
+
+func (m *AmplificationRamp) GetInitialAmplification() uint64 {
+	if m != nil {
+		return m.InitialAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetInitialTime() time.Time {
+	if m != nil {
+		return m.InitialTime
+	}
+	return time.Time{}
+}
+
+func (m *AmplificationRamp) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetFutureTime() time.Time {
+	if m != nil {
+		return m.FutureTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*AmplificationRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.AmplificationRamp")
 }
 
 func init() {
@@ -142,50 +221,61 @@ func init() {
 }
 
 var fileDescriptor_b99ab4400f54fe92 = []byte{
-	// 686 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbd, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc0, 0xe3, 0x36, 0x6d, 0xfa, 0xba, 0xef, 0x9b, 0xea, 0x35, 0x95, 0x70, 0x1b, 0x61, 0xa7,
-	0x16, 0x45, 0x51, 0x45, 0x7c, 0x84, 0x4a, 0x1d, 0x3a, 0x41, 0x8a, 0x8a, 0x90, 0x2a, 0x54, 0xdc,
-	0x09, 0x18, 0xc2, 0xd9, 0xbe, 0x38, 0xa7, 0xda, 0x39, 0xe3, 0x3b, 0x97, 0x66, 0x61, 0x46, 0x4c,
-	0x8c, 0x8c, 0x9d, 0x99, 0x18, 0xf8, 0x23, 0x2a, 0x58, 0x3a, 0xa2, 0x0e, 0x06, 0xb5, 0x03, 0x7b,
-	0xfe, 0x02, 0x74, 0xe7, 0xcb, 0x57, 0x0b, 0x15, 0x62, 0x49, 0xee, 0xf9, 0xfa, 0x3d, 0x1f, 0xf7,
-	0xf8, 0xd4, 0x7b, 0x84, 0x46, 0x84, 0x62, 0x0a, 0x02, 0x18, 0x45, 0x20, 0x26, 0x24, 0x8c, 0x88,
-	0x8f, 0x42, 0x0a, 0x28, 0x83, 0x6e, 0x88, 0xe8, 0x2b, 0x18, 0x83, 0x83, 0x86, 0x8b, 0x18, 0x6c,
-	0x8c, 0xa9, 0x5a, 0xdc, 0xd1, 0x8e, 0x13, 0xc2, 0x88, 0xb6, 0x26, 0x09, 0x36, 0x27, 0xd8, 0x23,
-	0x82, 0x3d, 0x72, 0xb7, 0x25, 0x61, 0x79, 0xc9, 0x13, 0xce, 0x2d, 0x11, 0x09, 0x72, 0x21, 0xc7,
-	0x2c, 0x2f, 0x06, 0x24, 0x20, 0xb9, 0x9e, 0x9f, 0xa4, 0xf6, 0x7f, 0x18, 0xe1, 0x2e, 0x01, 0xe2,
-	0x57, 0xaa, 0x8c, 0x80, 0x90, 0x20, 0x44, 0x40, 0x48, 0x6e, 0xda, 0x06, 0x7e, 0x9a, 0x40, 0x86,
-	0x49, 0x57, 0xda, 0xcd, 0x8b, 0x76, 0x86, 0x23, 0x44, 0x19, 0x8c, 0xe2, 0x01, 0x20, 0xcf, 0x0b,
-	0x60, 0xca, 0x3a, 0xc3, 0xde, 0xb8, 0x70, 0xc1, 0xee, 0x42, 0x8a, 0x86, 0x76, 0x8f, 0x60, 0x99,
-	0xc0, 0x3a, 0x55, 0x54, 0x75, 0x97, 0x90, 0x70, 0x17, 0x26, 0x30, 0xa2, 0xda, 0x13, 0x75, 0x4e,
-	0x8c, 0xa4, 0x8d, 0x90, 0xae, 0x54, 0x95, 0xda, 0x3f, 0xcd, 0x8d, 0xe3, 0xcc, 0x2c, 0x9c, 0x66,
-	0x66, 0x25, 0x07, 0x51, 0x7f, 0xdf, 0xc6, 0x04, 0x44, 0x90, 0x75, 0xec, 0x1d, 0x14, 0x40, 0xaf,
-	0xf7, 0x00, 0x79, 0xfd, 0xcc, 0x5c, 0xe8, 0xc1, 0x28, 0xdc, 0xb4, 0x06, 0xc1, 0x96, 0x53, 0xe2,
-	0xc7, 0x6d, 0x84, 0x38, 0x12, 0x1d, 0x62, 0x26, 0x90, 0x53, 0x7f, 0x81, 0x1c, 0x04, 0x5b, 0x4e,
-	0x89, 0x1f, 0xb7, 0x11, 0xda, 0xbc, 0xf5, 0xf6, 0xc7, 0xc7, 0xb5, 0x95, 0x89, 0xcb, 0xde, 0x1b,
-	0xde, 0xcf, 0xa8, 0x1b, 0xeb, 0xcb, 0x8c, 0x5a, 0xe4, 0xa2, 0x76, 0x5b, 0x2d, 0x41, 0xdf, 0x4f,
-	0x10, 0xa5, 0xb2, 0x2b, 0xad, 0x9f, 0x99, 0xe5, 0x9c, 0x2f, 0x0d, 0x96, 0x33, 0x70, 0xd1, 0xca,
-	0xea, 0x14, 0xf6, 0x45, 0xad, 0x45, 0x67, 0x0a, 0xfb, 0xda, 0x6b, 0x75, 0x9e, 0x6f, 0x42, 0x2b,
-	0x16, 0x54, 0x7d, 0xba, 0xaa, 0xd4, 0xe6, 0xef, 0x6e, 0xd8, 0x7f, 0xbe, 0x2a, 0xf6, 0xa8, 0xa6,
-	0xe6, 0x2a, 0x6f, 0xbe, 0x9f, 0x99, 0x37, 0xe4, 0xc0, 0x26, 0xd7, 0x50, 0xe6, 0xb0, 0x1c, 0x35,
-	0x1e, 0xbf, 0x94, 0xc5, 0x76, 0xca, 0xd2, 0x04, 0xe5, 0x2e, 0x01, 0x39, 0x40, 0x49, 0x97, 0x24,
-	0x7a, 0x51, 0xb4, 0x62, 0xf6, 0x33, 0xb3, 0x92, 0xc3, 0x7e, 0xe5, 0x65, 0x39, 0x5a, 0xae, 0xe6,
-	0x35, 0x3c, 0x94, 0x4a, 0xed, 0xa9, 0xfa, 0x2f, 0x23, 0x0c, 0x86, 0x2d, 0xda, 0x81, 0x09, 0xa2,
-	0xfa, 0x8c, 0xe8, 0x69, 0xc9, 0x96, 0x5b, 0xcc, 0xb7, 0x65, 0x58, 0xfc, 0x16, 0xc1, 0xdd, 0x66,
-	0x45, 0x96, 0x7d, 0x2d, 0xcf, 0x34, 0x1e, 0x6c, 0x39, 0xf3, 0x42, 0xdc, 0x13, 0x92, 0x96, 0xa8,
-	0x65, 0x51, 0x40, 0x88, 0x5f, 0xa6, 0xd8, 0xc7, 0xac, 0xa7, 0xcf, 0x56, 0xa7, 0xaf, 0x86, 0xdf,
-	0xe1, 0xf0, 0x0f, 0xdf, 0xcc, 0x5a, 0x80, 0x59, 0x27, 0x75, 0x6d, 0x8f, 0x44, 0xf2, 0x7b, 0x92,
-	0x7f, 0x75, 0xea, 0xef, 0x03, 0xd6, 0x8b, 0x11, 0x15, 0x01, 0xd4, 0xf9, 0x8f, 0xa7, 0xd8, 0x19,
-	0x64, 0xd0, 0x1e, 0xab, 0x0b, 0xd4, 0x83, 0x21, 0xee, 0x06, 0xad, 0x36, 0xf4, 0x18, 0x49, 0xa8,
-	0x5e, 0xaa, 0x4e, 0xd7, 0x8a, 0xcd, 0xd5, 0x7e, 0x66, 0xae, 0x5c, 0x9a, 0xf4, 0x05, 0x5f, 0xcb,
-	0x29, 0x4b, 0xcd, 0x76, 0xae, 0xd0, 0x5e, 0xa8, 0x4b, 0x93, 0x3e, 0x2d, 0x8f, 0x74, 0x59, 0x42,
-	0xc2, 0x10, 0x25, 0xfa, 0x9c, 0x18, 0xfb, 0xcd, 0x7e, 0x66, 0x56, 0x25, 0xf9, 0x77, 0xae, 0x96,
-	0x73, 0x7d, 0x02, 0xbc, 0x35, 0xb4, 0x6c, 0x36, 0xde, 0x1c, 0x99, 0x85, 0xf7, 0x47, 0x66, 0xe1,
-	0xf3, 0xa7, 0xfa, 0x0c, 0xbf, 0x9a, 0x47, 0x7c, 0xa7, 0x2b, 0x57, 0xec, 0x74, 0xf3, 0xf9, 0xf1,
-	0x99, 0xa1, 0x9c, 0x9c, 0x19, 0xca, 0xf7, 0x33, 0x43, 0x79, 0x77, 0x6e, 0x14, 0x4e, 0xce, 0x8d,
-	0xc2, 0xd7, 0x73, 0xa3, 0xf0, 0xec, 0xfe, 0xd8, 0xdc, 0x24, 0xa1, 0x1e, 0x42, 0x97, 0x0e, 0x04,
-	0x70, 0xb0, 0xde, 0x00, 0x87, 0xa3, 0x57, 0xb1, 0x7e, 0xe9, 0x59, 0x74, 0x67, 0xc5, 0x73, 0xb0,
-	0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x32, 0xbb, 0x90, 0xef, 0x43, 0x05, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x77, 0xd3, 0xdd, 0x76, 0x52, 0xb6, 0xda, 0xe9, 0x22, 0xbc, 0x1b, 0xf0, 0x64, 0x2d,
+	0x8a, 0xa2, 0x8a, 0xd8, 0xa4, 0x95, 0x7a, 0x58, 0x09, 0x89, 0xba, 0x68, 0x11, 0x52, 0x85, 0x8a,
+	0x0b, 0x07, 0xa8, 0x84, 0x99, 0xd8, 0x13, 0x67, 0x54, 0x3b, 0x63, 0x3c, 0x93, 0xa5, 0xb9, 0x70,
+	0x46, 0x5c, 0xe8, 0x91, 0x63, 0xcf, 0x9c, 0x38, 0xf0, 0x47, 0x54, 0x9c, 0x7a, 0x44, 0x95, 0x70,
+	0xd1, 0xee, 0x81, 0x7b, 0xae, 0x5c, 0xd0, 0x8c, 0xc7, 0x49, 0x9c, 0x94, 0xe5, 0xc7, 0x25, 0x99,
+	0xf7, 0xe6, 0x7b, 0xdf, 0xfb, 0x66, 0xde, 0x7b, 0x63, 0xf0, 0x1e, 0xe3, 0x29, 0xe3, 0x94, 0xbb,
+	0x31, 0x4e, 0x53, 0x37, 0x63, 0x2c, 0x49, 0x59, 0x44, 0x12, 0xee, 0x72, 0x81, 0x07, 0x09, 0xe1,
+	0x5f, 0xe3, 0xcc, 0x3d, 0xe9, 0x0f, 0x88, 0xc0, 0xfd, 0x25, 0x57, 0x20, 0x81, 0x4e, 0x96, 0x33,
+	0xc1, 0xe0, 0x75, 0xcd, 0xe0, 0x48, 0x06, 0x67, 0xc1, 0xe0, 0x2c, 0xe0, 0x8e, 0x66, 0x38, 0xd8,
+	0x0f, 0x15, 0x38, 0x50, 0x91, 0x6e, 0x69, 0x94, 0x34, 0x07, 0x7b, 0x31, 0x8b, 0x59, 0xe9, 0x97,
+	0x2b, 0xed, 0xdd, 0xc5, 0x29, 0x1d, 0x33, 0x57, 0xfd, 0x6a, 0x97, 0x15, 0x33, 0x16, 0x27, 0xc4,
+	0x55, 0xd6, 0x60, 0x32, 0x74, 0xa3, 0x49, 0x8e, 0x05, 0x65, 0x63, 0xbd, 0x8f, 0x56, 0xf7, 0x05,
+	0x4d, 0x09, 0x17, 0x38, 0xcd, 0x2a, 0x82, 0x32, 0xaf, 0x8b, 0x27, 0x62, 0x34, 0x3f, 0x9b, 0x34,
+	0x56, 0xf6, 0x07, 0x98, 0x93, 0xf9, 0x7e, 0xc8, 0xa8, 0x4e, 0x60, 0x3f, 0x37, 0x00, 0xb8, 0xc7,
+	0x58, 0x72, 0x0f, 0xe7, 0x38, 0xe5, 0xf0, 0x63, 0x70, 0x51, 0x5d, 0xc9, 0x90, 0x10, 0xd3, 0xe8,
+	0x18, 0xdd, 0x4b, 0xde, 0xad, 0xa7, 0x05, 0x6a, 0x3c, 0x2f, 0x50, 0xbb, 0x24, 0xe2, 0xd1, 0x43,
+	0x87, 0x32, 0x37, 0xc5, 0x62, 0xe4, 0xdc, 0x25, 0x31, 0x0e, 0xa7, 0xef, 0x93, 0x70, 0x56, 0xa0,
+	0x2b, 0x53, 0x9c, 0x26, 0x47, 0x76, 0x15, 0x6c, 0xfb, 0xdb, 0x72, 0x79, 0x4c, 0x88, 0xa4, 0x24,
+	0x8f, 0xa8, 0x50, 0x94, 0x1b, 0xff, 0x83, 0xb2, 0x0a, 0xb6, 0xfd, 0x6d, 0xb9, 0x3c, 0x26, 0xe4,
+	0xe8, 0xad, 0xef, 0xfe, 0xf8, 0xe9, 0xfa, 0x61, 0xad, 0xd8, 0xf7, 0xe7, 0xf5, 0x59, 0x9c, 0xc6,
+	0xfe, 0x6d, 0x0b, 0x34, 0xa5, 0x09, 0xdf, 0x06, 0xdb, 0x38, 0x8a, 0x72, 0xc2, 0xb9, 0x3e, 0x15,
+	0x9c, 0x15, 0x68, 0xa7, 0xe4, 0xd7, 0x1b, 0xb6, 0x5f, 0x41, 0xe0, 0x0e, 0xd8, 0xa0, 0x91, 0xd2,
+	0xda, 0xf4, 0x37, 0x68, 0x04, 0xbf, 0x01, 0x2d, 0xd9, 0x09, 0x41, 0xa6, 0x58, 0xcd, 0xcd, 0x8e,
+	0xd1, 0x6d, 0xdd, 0xb8, 0xe5, 0xfc, 0xfb, 0x56, 0x71, 0x16, 0x9a, 0xbc, 0x6b, 0xf2, 0xf0, 0xb3,
+	0x02, 0xbd, 0xa1, 0x2f, 0xac, 0xde, 0x86, 0x3a, 0x87, 0xed, 0x83, 0x6c, 0xb9, 0x28, 0x7b, 0xc3,
+	0x89, 0x98, 0xe4, 0xa4, 0x84, 0xc4, 0xec, 0x84, 0xe4, 0x63, 0x96, 0x9b, 0x4d, 0x75, 0x14, 0x34,
+	0x2b, 0x50, 0xbb, 0x24, 0x7b, 0x19, 0xca, 0xf6, 0x61, 0xe9, 0x96, 0x1a, 0x3e, 0xd0, 0x4e, 0xf8,
+	0x19, 0xb8, 0x2c, 0x98, 0xc0, 0x49, 0xc0, 0x47, 0x38, 0x27, 0xdc, 0xbc, 0xa0, 0xce, 0xb4, 0xef,
+	0xe8, 0x2e, 0x96, 0xdd, 0x32, 0x17, 0x7f, 0x87, 0xd1, 0xb1, 0xd7, 0xd6, 0xb2, 0xaf, 0x96, 0x99,
+	0x96, 0x83, 0x6d, 0xbf, 0xa5, 0xcc, 0xfb, 0xca, 0x82, 0x39, 0xd8, 0x51, 0x02, 0x12, 0xfa, 0xd5,
+	0x84, 0x46, 0x54, 0x4c, 0xcd, 0xad, 0xce, 0xe6, 0xf9, 0xe4, 0xef, 0x48, 0xf2, 0x1f, 0x5f, 0xa0,
+	0x6e, 0x4c, 0xc5, 0x68, 0x32, 0x70, 0x42, 0x96, 0xea, 0x79, 0xd2, 0x7f, 0x3d, 0x1e, 0x3d, 0x74,
+	0xc5, 0x34, 0x23, 0x5c, 0x05, 0x70, 0xff, 0x15, 0x99, 0xe2, 0x6e, 0x95, 0x01, 0x7e, 0x04, 0xae,
+	0xf0, 0x10, 0x27, 0x74, 0x1c, 0x07, 0x43, 0x1c, 0x0a, 0x96, 0x73, 0x73, 0xbb, 0xb3, 0xd9, 0x6d,
+	0x7a, 0xd7, 0x66, 0x05, 0x3a, 0x5c, 0xbb, 0xe9, 0x15, 0xac, 0xed, 0xef, 0x68, 0xcf, 0x71, 0xe9,
+	0x80, 0x5f, 0x82, 0xfd, 0x3a, 0x26, 0x08, 0xd9, 0x58, 0xe4, 0x2c, 0x49, 0x48, 0x6e, 0x5e, 0x54,
+	0xd7, 0xfe, 0xe6, 0xac, 0x40, 0x1d, 0xcd, 0xfc, 0x77, 0x50, 0xdb, 0x7f, 0xad, 0x46, 0x7c, 0x67,
+	0xbe, 0x03, 0xbf, 0x37, 0x00, 0xc4, 0x69, 0x96, 0xd0, 0x21, 0x0d, 0xd5, 0xc0, 0x07, 0x39, 0x4e,
+	0x33, 0xf3, 0x92, 0xaa, 0xc3, 0xbb, 0xff, 0xa5, 0xb7, 0x6e, 0x2f, 0xb3, 0xf8, 0x38, 0xcd, 0xbc,
+	0x43, 0x5d, 0xab, 0x7d, 0xdd, 0xe0, 0x6b, 0x69, 0x6c, 0x7f, 0x17, 0xaf, 0x46, 0x1d, 0xf5, 0xbf,
+	0x7d, 0x82, 0x1a, 0x3f, 0x3c, 0x41, 0x8d, 0x5f, 0x7e, 0xee, 0x5d, 0x90, 0xcd, 0xf2, 0xa1, 0x9c,
+	0xb2, 0xf6, 0x39, 0x53, 0x66, 0xff, 0xb9, 0x01, 0x76, 0xd7, 0xd2, 0xc3, 0x4f, 0xc1, 0xab, 0x74,
+	0x4c, 0x05, 0xc5, 0x49, 0x50, 0xcb, 0xa2, 0x46, 0xaf, 0xe9, 0x75, 0x66, 0x05, 0x7a, 0xbd, 0x54,
+	0xf6, 0x52, 0x98, 0xed, 0xef, 0x69, 0x7f, 0x8d, 0x1a, 0x7e, 0x01, 0x2e, 0x57, 0x78, 0xf9, 0x08,
+	0xaa, 0xf9, 0x6c, 0xdd, 0x38, 0x70, 0xca, 0x17, 0xd2, 0xa9, 0x5e, 0x48, 0xe7, 0x93, 0xea, 0x85,
+	0xf4, 0x50, 0xbd, 0x67, 0x97, 0xa3, 0xed, 0xc7, 0x2f, 0x90, 0xe1, 0xb7, 0xb4, 0x4b, 0x86, 0x40,
+	0x7f, 0x3e, 0x65, 0x75, 0xd5, 0x9b, 0x4a, 0xf5, 0xfa, 0x94, 0xad, 0x88, 0xbe, 0x5a, 0xba, 0xeb,
+	0x9a, 0x1f, 0x80, 0x96, 0x46, 0x2b, 0xc9, 0xcd, 0x7f, 0x94, 0x6c, 0x69, 0xc9, 0xb0, 0x96, 0x6a,
+	0xa1, 0x18, 0x94, 0x1e, 0x19, 0xe0, 0x3d, 0x78, 0x7a, 0x6a, 0x19, 0xcf, 0x4e, 0x2d, 0xe3, 0xf7,
+	0x53, 0xcb, 0x78, 0x7c, 0x66, 0x35, 0x9e, 0x9d, 0x59, 0x8d, 0x5f, 0xcf, 0xac, 0xc6, 0xe7, 0xb7,
+	0x97, 0xe6, 0x48, 0xd7, 0xaf, 0x97, 0xe0, 0x01, 0xaf, 0x0c, 0xf7, 0xe4, 0x66, 0xdf, 0x7d, 0xb4,
+	0xf8, 0x4a, 0xf6, 0xd6, 0x3e, 0x93, 0x83, 0x2d, 0x25, 0xee, 0xe6, 0x5f, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x65, 0x2a, 0x52, 0x54, 0x53, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -251,6 +341,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AmplificationRamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -259,20 +359,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ScalingFactors)*10)
+		var j2 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -332,6 +432,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AmplificationRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmplificationRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmplificationRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FutureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FutureTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.FutureAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.InitialTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.InitialAmplification != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.InitialAmplification))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -394,6 +543,27 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	l = m.AmplificationRamp.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
+func (m *AmplificationRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.InitialAmplification))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.FutureAmplification != 0 {
+		n += 1 + sovStableswapPool(uint64(m.FutureAmplification))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FutureTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
 	return n
 }
 
@@ -841,6 +1011,193 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmplificationRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmplification", wireType)
+			}
+			m.InitialAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.InitialTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FutureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
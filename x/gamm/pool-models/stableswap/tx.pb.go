@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,9 @@ type MsgCreateStableswapPool struct {
 	ScalingFactors          []uint64                                 `protobuf:"varint,4,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
 	FuturePoolGovernor      string                                   `protobuf:"bytes,5,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
 	ScalingFactorController string                                   `protobuf:"bytes,6,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// amplification is the initial amplification coefficient of the pool.
+	// Zero uses the legacy solidly CFMM.
+	Amplification uint64 `protobuf:"varint,7,opt,name=amplification,proto3" json:"amplification,omitempty" yaml:"amplification"`
 }
 
 func (m *MsgCreateStableswapPool) Reset()         { *m = MsgCreateStableswapPool{} }
@@ -117,6 +124,13 @@ func (m *MsgCreateStableswapPool) GetScalingFactorController() string {
 	return ""
 }
 
+func (m *MsgCreateStableswapPool) GetAmplification() uint64 {
+	if m != nil {
+		return m.Amplification
+	}
+	return 0
+}
+
 // Returns a poolID with custom poolName.
 type MsgCreateStableswapPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -264,11 +278,122 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Linearly ramps the stableswap amplification coefficient from its
+// current value to future_amplification at future_time.
+type MsgStableSwapRampAmplification struct {
+	Sender              string    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID              uint64    `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	FutureAmplification uint64    `protobuf:"varint,3,opt,name=future_amplification,json=futureAmplification,proto3" json:"future_amplification,omitempty" yaml:"future_amplification"`
+	FutureTime          time.Time `protobuf:"bytes,4,opt,name=future_time,json=futureTime,proto3,stdtime" json:"future_time" yaml:"future_time"`
+}
+
+func (m *MsgStableSwapRampAmplification) Reset()         { *m = MsgStableSwapRampAmplification{} }
+func (m *MsgStableSwapRampAmplification) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplification) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{4}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplification.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplification proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampAmplification) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampAmplification) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetFutureAmplification() uint64 {
+	if m != nil {
+		return m.FutureAmplification
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampAmplification) GetFutureTime() time.Time {
+	if m != nil {
+		return m.FutureTime
+	}
+	return time.Time{}
+}
+
+type MsgStableSwapRampAmplificationResponse struct {
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Reset() {
+	*m = MsgStableSwapRampAmplificationResponse{}
+}
+func (m *MsgStableSwapRampAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampAmplificationResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a59a47ae7445405, []int{5}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampAmplificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampAmplification)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplification")
+	proto.RegisterType((*MsgStableSwapRampAmplificationResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampAmplificationResponse")
 }
 
 func init() {
@@ -276,51 +401,61 @@ func init() {
 }
 
 var fileDescriptor_3a59a47ae7445405 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8f, 0x93, 0x7c, 0xf3, 0x15, 0x57, 0x01, 0xaa, 0x15, 0xb5, 0x69, 0x90, 0xec, 0xe0, 0x32,
-	0xa4, 0x45, 0xb6, 0x49, 0x23, 0x31, 0x74, 0x40, 0x34, 0x41, 0x45, 0x15, 0x44, 0x2a, 0xae, 0x58,
-	0x60, 0x08, 0x17, 0xfb, 0x6a, 0x0e, 0x6c, 0x9f, 0xf1, 0x5d, 0xd2, 0x76, 0xa4, 0x23, 0x13, 0x7f,
-	0x06, 0x62, 0xea, 0x5f, 0x81, 0x3a, 0x76, 0x64, 0x0a, 0x28, 0x1d, 0xba, 0x67, 0x63, 0x43, 0x3e,
-	0x5f, 0x7e, 0x41, 0x42, 0x09, 0xea, 0x12, 0x3b, 0xef, 0xde, 0xe7, 0xf3, 0x79, 0xef, 0xf3, 0x9e,
-	0x0f, 0x54, 0x09, 0xf5, 0x09, 0xc5, 0xd4, 0x74, 0xa1, 0xef, 0x9b, 0x21, 0x21, 0x9e, 0x4f, 0x1c,
-	0xe4, 0x51, 0x93, 0x32, 0xd8, 0xf2, 0x10, 0x3d, 0x80, 0xa1, 0xd9, 0xa9, 0xb4, 0x10, 0x83, 0x15,
-	0x93, 0x1d, 0x1a, 0x61, 0x44, 0x18, 0x91, 0xd7, 0x05, 0xc8, 0x88, 0x41, 0xc6, 0x08, 0x64, 0x8c,
-	0x40, 0x86, 0x00, 0x15, 0x15, 0x9b, 0x27, 0x9b, 0x2d, 0x48, 0xd1, 0x90, 0xc9, 0x26, 0x38, 0x48,
-	0xb8, 0x8a, 0x79, 0x97, 0xb8, 0x84, 0xbf, 0x9a, 0xf1, 0x9b, 0x88, 0x2e, 0x42, 0x1f, 0x07, 0xc4,
-	0xe4, 0xbf, 0x22, 0xf4, 0x70, 0x8e, 0x4a, 0x47, 0xa1, 0x66, 0x9c, 0x28, 0x18, 0x96, 0x45, 0x29,
-	0x3e, 0x75, 0xcd, 0x4e, 0x25, 0x7e, 0x24, 0x07, 0x5a, 0x3f, 0x0b, 0x96, 0x1b, 0xd4, 0xad, 0x47,
-	0x08, 0x32, 0xb4, 0x37, 0xc4, 0xee, 0x12, 0xe2, 0xc9, 0x6b, 0x20, 0x47, 0x51, 0xe0, 0xa0, 0xa8,
-	0x20, 0x95, 0xa4, 0xf2, 0xb5, 0xda, 0x62, 0xbf, 0xab, 0x5e, 0x3f, 0x82, 0xbe, 0xb7, 0xa9, 0x25,
-	0x71, 0xcd, 0x12, 0x09, 0x32, 0x01, 0x0b, 0xb1, 0x5a, 0x33, 0x84, 0x11, 0xf4, 0x69, 0x21, 0x5d,
-	0x92, 0xca, 0x0b, 0x1b, 0xf7, 0x8d, 0xbf, 0x37, 0xcb, 0x88, 0x15, 0x77, 0x39, 0xba, 0xb6, 0xd4,
-	0xef, 0xaa, 0x72, 0xa2, 0x33, 0x46, 0xaa, 0x59, 0x20, 0x1c, 0xe6, 0xc8, 0xef, 0x25, 0xb0, 0x84,
-	0x03, 0xcc, 0x30, 0xf4, 0x78, 0x9f, 0x4d, 0x0f, 0xbf, 0x6b, 0x63, 0x07, 0xb3, 0xa3, 0x42, 0xa6,
-	0x94, 0x29, 0x2f, 0x6c, 0xac, 0x18, 0x49, 0xcb, 0x46, 0xec, 0xfe, 0x50, 0xa5, 0x4e, 0x70, 0x50,
-	0xbb, 0x77, 0xda, 0x55, 0x53, 0x9f, 0xbf, 0xa9, 0x65, 0x17, 0xb3, 0xd7, 0xed, 0x96, 0x61, 0x13,
-	0xdf, 0x14, 0xfe, 0x24, 0x0f, 0x9d, 0x3a, 0x6f, 0x4d, 0x76, 0x14, 0x22, 0xca, 0x01, 0xd4, 0xca,
-	0x0b, 0xa9, 0xb8, 0xc8, 0xa7, 0x03, 0x21, 0xb9, 0x01, 0x6e, 0x52, 0x1b, 0x7a, 0x38, 0x70, 0x9b,
-	0xfb, 0xd0, 0x66, 0x24, 0xa2, 0x85, 0x6c, 0x29, 0x53, 0xce, 0xd6, 0xee, 0xf4, 0xbb, 0x6a, 0x49,
-	0x18, 0x35, 0x1a, 0xc7, 0x64, 0xae, 0x66, 0xdd, 0x10, 0x81, 0xed, 0x04, 0x2b, 0x3f, 0x03, 0xf9,
-	0xfd, 0x36, 0x6b, 0x47, 0x28, 0x69, 0xc8, 0x25, 0x1d, 0x14, 0x05, 0x24, 0x2a, 0xfc, 0xc7, 0xcd,
-	0x57, 0xfb, 0x5d, 0xf5, 0x56, 0xc2, 0x39, 0x2d, 0x4b, 0xb3, 0xe4, 0x24, 0x1c, 0x97, 0xf8, 0x58,
-	0x04, 0xe5, 0x57, 0x60, 0x65, 0x52, 0xb5, 0x69, 0x93, 0x80, 0x45, 0xc4, 0xf3, 0x50, 0x54, 0xc8,
-	0x71, 0xde, 0xf1, 0x5a, 0x67, 0xa5, 0x6a, 0xd6, 0xf2, 0x44, 0xad, 0xf5, 0xe1, 0xc9, 0x66, 0xf5,
-	0xf8, 0xe2, 0x64, 0x5d, 0x6c, 0xc1, 0x87, 0x8b, 0x93, 0xf5, 0xd5, 0x89, 0x55, 0xb5, 0xf9, 0x5a,
-	0xe9, 0x23, 0x13, 0xf4, 0xb8, 0x68, 0x6d, 0x1b, 0xa8, 0x33, 0x76, 0xce, 0x42, 0x34, 0x24, 0x01,
-	0x45, 0xf2, 0x2a, 0xf8, 0x9f, 0xf7, 0x87, 0x1d, 0xbe, 0x7c, 0xd9, 0x1a, 0xe8, 0x75, 0xd5, 0x5c,
-	0x9c, 0xb2, 0xf3, 0xc8, 0xca, 0xc5, 0x47, 0x3b, 0x8e, 0x76, 0x9c, 0x06, 0xb7, 0x1b, 0xd4, 0x4d,
-	0x28, 0xf6, 0x0e, 0x60, 0xb8, 0xe5, 0xbc, 0x69, 0x53, 0xb6, 0x37, 0xe9, 0xeb, 0x1c, 0x6b, 0x3c,
-	0xa6, 0x9a, 0x9e, 0xa5, 0x3a, 0x6d, 0xec, 0x99, 0x7f, 0x1f, 0xfb, 0xe6, 0x83, 0x5f, 0x1c, 0x34,
-	0x26, 0x1c, 0x1c, 0xb3, 0x0e, 0xf2, 0xe6, 0x74, 0x01, 0xd7, 0x85, 0xb6, 0x76, 0x17, 0xac, 0x5d,
-	0xea, 0xc1, 0xc0, 0xd6, 0x8d, 0x1f, 0x69, 0x90, 0x69, 0x50, 0x57, 0xfe, 0x24, 0x81, 0xfc, 0xd4,
-	0x6f, 0xbe, 0x3e, 0xcf, 0x37, 0x3b, 0x63, 0x88, 0xc5, 0x27, 0x57, 0x40, 0x32, 0xdc, 0x84, 0x2f,
-	0x12, 0x50, 0x2e, 0x99, 0x70, 0x63, 0x4e, 0xbd, 0x3f, 0xd3, 0x15, 0x9f, 0x5f, 0x29, 0xdd, 0xa0,
-	0x91, 0xda, 0xcb, 0xd3, 0x9e, 0x22, 0x9d, 0xf5, 0x14, 0xe9, 0x7b, 0x4f, 0x91, 0x3e, 0x9e, 0x2b,
-	0xa9, 0xb3, 0x73, 0x25, 0xf5, 0xf5, 0x5c, 0x49, 0xbd, 0xd8, 0x1a, 0xbb, 0x88, 0x84, 0xb4, 0xee,
-	0xc1, 0x16, 0x1d, 0xfc, 0x31, 0x3b, 0xd5, 0x8a, 0x79, 0x38, 0xba, 0xfd, 0xf5, 0xdf, 0xae, 0xff,
-	0x56, 0x8e, 0x5f, 0xe7, 0xd5, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x78, 0x9c, 0x23, 0xd5,
-	0x06, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x93, 0x34, 0x15, 0x13, 0x15, 0x54, 0x13, 0x75, 0xdd, 0x54, 0xb2, 0x83, 0x8b, 0x50,
+	0x1a, 0x64, 0x9b, 0x6c, 0x24, 0x0e, 0x39, 0x54, 0xac, 0x83, 0x8a, 0x0a, 0x44, 0x2a, 0x5e, 0xb8,
+	0xd0, 0x43, 0x98, 0x38, 0x13, 0x33, 0x60, 0x7b, 0x8c, 0x67, 0x92, 0x76, 0x8f, 0xf4, 0xc8, 0xa9,
+	0x7f, 0x06, 0xe2, 0x54, 0x71, 0xe3, 0xc0, 0x15, 0xf5, 0xd8, 0x23, 0x17, 0xb2, 0x28, 0x7b, 0xd8,
+	0x7b, 0xfe, 0x02, 0xe4, 0xf1, 0xc4, 0x89, 0x77, 0x37, 0xbb, 0x64, 0x95, 0xcb, 0xc6, 0x7e, 0xf3,
+	0xde, 0xf7, 0xbd, 0xf9, 0xde, 0x8f, 0x35, 0xe8, 0x10, 0x1a, 0x10, 0x8a, 0xa9, 0xe5, 0xc1, 0x20,
+	0xb0, 0x22, 0x42, 0xfc, 0x80, 0x8c, 0x90, 0x4f, 0x2d, 0xca, 0xe0, 0xd0, 0x47, 0xf4, 0x19, 0x8c,
+	0xac, 0x69, 0x7b, 0x88, 0x18, 0x6c, 0x5b, 0xec, 0xb9, 0x19, 0xc5, 0x84, 0x11, 0xb9, 0x25, 0x82,
+	0xcc, 0x24, 0xc8, 0x5c, 0x05, 0x99, 0xab, 0x20, 0x53, 0x04, 0xd5, 0x55, 0x97, 0x3b, 0x5b, 0x43,
+	0x48, 0x51, 0x86, 0xe4, 0x12, 0x1c, 0xa6, 0x58, 0xf5, 0x9a, 0x47, 0x3c, 0xc2, 0x1f, 0xad, 0xe4,
+	0x49, 0x58, 0x35, 0x8f, 0x10, 0xcf, 0x47, 0x16, 0x7f, 0x1b, 0x4e, 0xc6, 0x16, 0xc3, 0x01, 0xa2,
+	0x0c, 0x06, 0x91, 0x70, 0xb8, 0x0d, 0x03, 0x1c, 0x12, 0x8b, 0xff, 0x15, 0xa6, 0x4f, 0xb6, 0xb8,
+	0xca, 0xca, 0x34, 0x48, 0x1c, 0x05, 0xc2, 0x9e, 0xc8, 0x35, 0xa0, 0x9e, 0x35, 0x6d, 0x27, 0x3f,
+	0xe9, 0x81, 0xfe, 0xfb, 0x0d, 0xb0, 0xd7, 0xa7, 0x5e, 0x2f, 0x46, 0x90, 0xa1, 0xc3, 0x2c, 0xf6,
+	0x09, 0x21, 0xbe, 0xfc, 0x00, 0x54, 0x28, 0x0a, 0x47, 0x28, 0x56, 0xa4, 0x86, 0xd4, 0x7c, 0xcb,
+	0xbe, 0xbd, 0x98, 0x69, 0xb7, 0x8e, 0x60, 0xe0, 0x77, 0xf5, 0xd4, 0xae, 0x3b, 0xc2, 0x41, 0x26,
+	0xa0, 0x9a, 0xb0, 0x0d, 0x22, 0x18, 0xc3, 0x80, 0x2a, 0xc5, 0x86, 0xd4, 0xac, 0xee, 0x7f, 0x6c,
+	0xfe, 0x7f, 0x35, 0xcd, 0x84, 0xf1, 0x09, 0x8f, 0xb6, 0xef, 0x2c, 0x66, 0x9a, 0x9c, 0xf2, 0xac,
+	0x81, 0xea, 0x0e, 0x88, 0x32, 0x1f, 0xf9, 0x67, 0x09, 0xdc, 0xc1, 0x21, 0x66, 0x18, 0xfa, 0xfc,
+	0x9e, 0x03, 0x1f, 0xff, 0x34, 0xc1, 0x23, 0xcc, 0x8e, 0x94, 0x52, 0xa3, 0xd4, 0xac, 0xee, 0xdf,
+	0x35, 0xd3, 0x2b, 0x9b, 0x49, 0x79, 0x32, 0x96, 0x1e, 0xc1, 0xa1, 0xfd, 0xd1, 0xeb, 0x99, 0x56,
+	0xf8, 0xed, 0x58, 0x6b, 0x7a, 0x98, 0x7d, 0x3f, 0x19, 0x9a, 0x2e, 0x09, 0x2c, 0xa1, 0x4f, 0xfa,
+	0x63, 0xd0, 0xd1, 0x8f, 0x16, 0x3b, 0x8a, 0x10, 0xe5, 0x01, 0xd4, 0xa9, 0x09, 0xaa, 0x24, 0xc9,
+	0x2f, 0x97, 0x44, 0x72, 0x1f, 0xbc, 0x43, 0x5d, 0xe8, 0xe3, 0xd0, 0x1b, 0x8c, 0xa1, 0xcb, 0x48,
+	0x4c, 0x95, 0x72, 0xa3, 0xd4, 0x2c, 0xdb, 0xef, 0x2f, 0x66, 0x5a, 0x43, 0x08, 0xb5, 0x2a, 0x47,
+	0xde, 0x57, 0x77, 0xde, 0x16, 0x86, 0x47, 0x69, 0xac, 0xfc, 0x15, 0xa8, 0x8d, 0x27, 0x6c, 0x12,
+	0xa3, 0xf4, 0x42, 0x1e, 0x99, 0xa2, 0x38, 0x24, 0xb1, 0x72, 0x83, 0x8b, 0xaf, 0x2d, 0x66, 0xda,
+	0xbd, 0x14, 0xf3, 0x22, 0x2f, 0xdd, 0x91, 0x53, 0x73, 0x92, 0xe2, 0x67, 0xc2, 0x28, 0x7f, 0x07,
+	0xee, 0xe6, 0x59, 0x07, 0x2e, 0x09, 0x59, 0x4c, 0x7c, 0x1f, 0xc5, 0x4a, 0x85, 0xe3, 0xae, 0xe7,
+	0xba, 0xc9, 0x55, 0x77, 0xf6, 0x72, 0xb9, 0xf6, 0xb2, 0x13, 0xf9, 0x21, 0xb8, 0x05, 0x83, 0xc8,
+	0xc7, 0x63, 0xec, 0x42, 0x86, 0x49, 0xa8, 0xdc, 0x6c, 0x48, 0xcd, 0xb2, 0xad, 0x2c, 0x66, 0x5a,
+	0x2d, 0x45, 0xcd, 0x1d, 0xeb, 0x4e, 0xde, 0xbd, 0xdb, 0x79, 0x71, 0xfa, 0xaa, 0x25, 0xba, 0xe8,
+	0x97, 0xd3, 0x57, 0xad, 0xfb, 0xb9, 0x56, 0x77, 0x79, 0x5b, 0x1a, 0x2b, 0x11, 0x8d, 0xe4, 0xd2,
+	0xfa, 0x23, 0xa0, 0x6d, 0xe8, 0x59, 0x07, 0xd1, 0x88, 0x84, 0x14, 0xc9, 0xf7, 0xc1, 0x4d, 0xae,
+	0x0f, 0x1e, 0xf1, 0xe6, 0x2d, 0xdb, 0x60, 0x3e, 0xd3, 0x2a, 0x89, 0xcb, 0xe3, 0x4f, 0x9d, 0x4a,
+	0x72, 0xf4, 0x78, 0xa4, 0xbf, 0x28, 0x82, 0xf7, 0xfa, 0xd4, 0x4b, 0x21, 0x0e, 0x9f, 0xc1, 0xe8,
+	0x60, 0xf4, 0xc3, 0x84, 0xb2, 0xc3, 0x7c, 0x5d, 0xb6, 0x18, 0x83, 0x35, 0xd6, 0xe2, 0x26, 0xd6,
+	0x8b, 0xda, 0xa6, 0x74, 0xfd, 0xb6, 0xe9, 0x3e, 0x3c, 0xa3, 0xa0, 0x99, 0x53, 0x70, 0x4d, 0x3a,
+	0xc8, 0x2f, 0x67, 0x88, 0x70, 0x43, 0x70, 0xeb, 0x1f, 0x82, 0x07, 0x57, 0x6a, 0xb0, 0x94, 0x55,
+	0xff, 0xa7, 0x08, 0xd4, 0x9c, 0xb7, 0x03, 0x83, 0xe8, 0x60, 0xbd, 0xa2, 0x3b, 0x97, 0xcb, 0xc9,
+	0xc6, 0x22, 0xdf, 0x68, 0x25, 0x1e, 0x71, 0x7e, 0x2c, 0xce, 0xf4, 0xdb, 0xbb, 0xa9, 0x39, 0x9f,
+	0xe3, 0x53, 0x50, 0x15, 0xde, 0xc9, 0xf6, 0x55, 0xca, 0x7c, 0x5d, 0xd5, 0xcd, 0x74, 0x35, 0x9b,
+	0xcb, 0xd5, 0x6c, 0x7e, 0xbd, 0x5c, 0xcd, 0xb6, 0x9a, 0xac, 0x8c, 0xd5, 0x5a, 0x5a, 0x0b, 0xd6,
+	0x5f, 0x1e, 0x6b, 0x92, 0x03, 0x52, 0x4b, 0x12, 0xd0, 0xed, 0x9e, 0x29, 0x48, 0x6b, 0x53, 0x41,
+	0x62, 0x18, 0x44, 0x46, 0x3e, 0xdd, 0x26, 0xf8, 0xe0, 0x72, 0x79, 0x97, 0x95, 0xd8, 0xff, 0xa3,
+	0x0c, 0x4a, 0x7d, 0xea, 0xc9, 0xbf, 0x4a, 0xa0, 0x76, 0xe1, 0xf6, 0xee, 0x6d, 0xb3, 0x7d, 0x37,
+	0x8c, 0x53, 0xfd, 0x8b, 0x1d, 0x80, 0x64, 0x33, 0xf9, 0x97, 0x04, 0xd4, 0x2b, 0x66, 0xad, 0xbf,
+	0x25, 0xdf, 0xe5, 0x70, 0xf5, 0x6f, 0x76, 0x0a, 0x97, 0x5d, 0xe4, 0x4f, 0x09, 0xdc, 0xbb, 0x6c,
+	0x04, 0x3e, 0xbf, 0x36, 0xed, 0x39, 0xac, 0xba, 0xb3, 0x3b, 0xac, 0x65, 0xfe, 0xf6, 0xd3, 0xd7,
+	0x73, 0x55, 0x7a, 0x33, 0x57, 0xa5, 0x7f, 0xe7, 0xaa, 0xf4, 0xf2, 0x44, 0x2d, 0xbc, 0x39, 0x51,
+	0x0b, 0x7f, 0x9f, 0xa8, 0x85, 0x6f, 0x0f, 0xd6, 0xfe, 0x25, 0x0a, 0x5e, 0xc3, 0x87, 0x43, 0xba,
+	0x7c, 0xb1, 0xa6, 0x9d, 0xb6, 0xf5, 0x7c, 0xf5, 0x1d, 0x62, 0x9c, 0xfb, 0x10, 0x19, 0x56, 0xf8,
+	0xf8, 0x74, 0xfe, 0x1b, 0x00, 0xe4, 0x2b, 0x4e, 0x24, 0x80, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +472,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 type msgClient struct {
@@ -365,10 +501,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampAmplification(ctx context.Context, in *MsgStableSwapRampAmplification, opts ...grpc.CallOption) (*MsgStableSwapRampAmplificationResponse, error) {
+	out := new(MsgStableSwapRampAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampAmplification(context.Context, *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -381,6 +527,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampAmplification(ctx context.Context, req *MsgStableSwapRampAmplification) (*MsgStableSwapRampAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampAmplification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -422,6 +571,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampAmplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampAmplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampAmplification(ctx, req.(*MsgStableSwapRampAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -434,6 +601,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampAmplification",
+			Handler:    _Msg_StableSwapRampAmplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/poolmodels/stableswap/v1beta1/tx.proto",
@@ -459,6 +630,11 @@ func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Amplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -631,6 +807,77 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapRampAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FutureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FutureTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.FutureAmplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureAmplification))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -677,6 +924,9 @@ func (m *MsgCreateStableswapPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Amplification != 0 {
+		n += 1 + sovTx(uint64(m.Amplification))
+	}
 	return n
 }
 
@@ -724,6 +974,36 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if m.FutureAmplification != 0 {
+		n += 1 + sovTx(uint64(m.FutureAmplification))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FutureTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1001,6 +1281,25 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1318,6 +1617,209 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampAmplification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureAmplification", wireType)
+			}
+			m.FutureAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureAmplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FutureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampAmplificationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
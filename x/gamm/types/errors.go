@@ -108,4 +108,7 @@ var (
 	ErrHitMinScaledAssets         = errorsmod.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
 	ErrNoGaugeToRedirect          = errorsmod.Register(ModuleName, 67, "could not find gauge to redirect")
 	ErrMustHaveTwoDenoms          = errorsmod.Register(ModuleName, 68, "can only have 2 denoms in CL pool")
+	ErrInvalidAmplification       = errorsmod.Register(ModuleName, 69, "invalid stableswap amplification")
+	ErrInvalidAmplificationRamp   = errorsmod.Register(ModuleName, 70, "invalid stableswap amplification ramp")
+	ErrAmplifiedCfmmNotConverged  = errorsmod.Register(ModuleName, 71, "stableswap amplified cfmm did not converge")
 )
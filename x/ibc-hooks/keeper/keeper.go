@@ -90,6 +90,10 @@ func GetPacketAckKey(channel string, packetSequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s::%d::ack", channel, packetSequence))
 }
 
+func GetSwapRecoveryKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s::%d::swap_recovery", channel, packetSequence))
+}
+
 func GeneratePacketAckValue(packet channeltypes.Packet, contract string) ([]byte, error) {
 	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidContractAddr, contract)
@@ -175,6 +179,37 @@ func (k Keeper) DeletePacketAckActor(ctx sdk.Context, channel string, packetSequ
 	store.Delete(GetPacketAckKey(channel, packetSequence))
 }
 
+// StoreSwapRecovery stores where the funds of the forward of a swap output go if the forward fails or times out
+func (k Keeper) StoreSwapRecovery(ctx sdk.Context, channel string, packetSequence uint64, recovery types.SwapRecovery) error {
+	bz, err := json.Marshal(recovery)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSwapRecoveryKey(channel, packetSequence), bz)
+	return nil
+}
+
+// GetSwapRecovery returns the recovery of the forward of a swap output, and false if the packet is not a forward
+func (k Keeper) GetSwapRecovery(ctx sdk.Context, channel string, packetSequence uint64) (types.SwapRecovery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetSwapRecoveryKey(channel, packetSequence))
+	if bz == nil {
+		return types.SwapRecovery{}, false
+	}
+	var recovery types.SwapRecovery
+	if err := json.Unmarshal(bz, &recovery); err != nil {
+		return types.SwapRecovery{}, false
+	}
+	return recovery, true
+}
+
+// DeleteSwapRecovery deletes the recovery from storage once the forward has completed
+func (k Keeper) DeleteSwapRecovery(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetSwapRecoveryKey(channel, packetSequence))
+}
+
 // DeriveIntermediateSender derives the sender address to be used when calling wasm hooks
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
 	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
@@ -0,0 +1,182 @@
+package ibc_hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
+	"github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
+)
+
+// SwapConfigured returns true if the keepers needed to execute the osmosis_swap memos are set
+func (h WasmHooks) SwapConfigured() bool {
+	return h.ibcHooksKeeper != nil && h.PoolManagerKeeper != nil && h.TransferKeeper != nil && h.BankKeeper != nil
+}
+
+// onRecvSwapPacket swaps the funds received by the packet and forwards the output as instructed by the
+// osmosis_swap memo. If the swap or the forward can't be executed, an error ack is returned so the funds
+// are returned to the sender. If the forward fails later on, OnAcknowledgementPacketOverride and
+// OnTimeoutPacketOverride send the output to the local recovery address.
+func (h WasmHooks) onRecvSwapPacket(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, data transfertypes.FungibleTokenPacketData, swap types.OsmosisSwap) ibcexported.Acknowledgement {
+	// The funds are received by the intermediary account of the sender, which executes the swap and the forward
+	channel := packet.GetDestChannel()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, data.GetSender(), h.bech32PrefixAccAddr)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, data.GetSender(), err.Error()))
+	}
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	// Execute the receive
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := osmomath.NewIntFromString(data.GetAmount())
+	if !ok {
+		// This should never happen, as it should've been caught in the underlying call to OnRecvPacket,
+		// but returning here for completeness
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+
+	// The packet's denom is the denom in the sender chain. This needs to be converted to the local denom.
+	denom := osmoutils.MustExtractDenomFromPacketOnRecv(packet)
+	sender := sdk.MustAccAddressFromBech32(senderBech32)
+
+	tokenOutAmount, err := h.PoolManagerKeeper.RouteExactAmountIn(ctx, sender, swap.Routes, sdk.NewCoin(denom, amount), swap.MinOutputAmount)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrSwapError, err.Error())
+	}
+	tokenOut := sdk.NewCoin(swap.OutputDenom(), tokenOutAmount)
+
+	swapAck := types.SwapAck{TokenOut: tokenOut, IbcAck: ack.Acknowledgement()}
+	if swap.Channel == "" {
+		err = h.BankKeeper.SendCoins(ctx, sender, sdk.MustAccAddressFromBech32(swap.Receiver), sdk.NewCoins(tokenOut))
+	} else {
+		swapAck.ForwardSequence, err = h.forwardSwapOutput(ctx, senderBech32, swap, tokenOut)
+	}
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrForwardError, err.Error())
+	}
+
+	bz, err = json.Marshal(swapAck)
+	if err != nil {
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+// forwardSwapOutput sends the swap output over the channel of the memo and stores where the output goes
+// if the forward fails.
+func (h WasmHooks) forwardSwapOutput(ctx sdk.Context, sender string, swap types.OsmosisSwap, tokenOut sdk.Coin) (uint64, error) {
+	timeoutTimestamp := swap.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(types.DefaultSwapForwardTimeout).UnixNano())
+	}
+
+	msg := transfertypes.NewMsgTransfer(transfertypes.PortID, swap.Channel, tokenOut, sender, swap.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, string(swap.NextMemo))
+	res, err := h.TransferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	err = h.ibcHooksKeeper.StoreSwapRecovery(ctx, swap.Channel, res.Sequence, types.SwapRecovery{
+		Sender:            sender,
+		LocalRecoveryAddr: swap.LocalRecoveryAddr,
+		Funds:             tokenOut,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// recoverSwapOutput sends the output of a swap to its local recovery address once its forward has failed.
+// The transfer module has refunded the output to the intermediary account that forwarded it by then.
+func (h WasmHooks) recoverSwapOutput(ctx sdk.Context, packet channeltypes.Packet, failed bool) {
+	if !h.SwapConfigured() {
+		return
+	}
+	recovery, found := h.ibcHooksKeeper.GetSwapRecovery(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		// Not the forward of a swap output
+		return
+	}
+	h.ibcHooksKeeper.DeleteSwapRecovery(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !failed {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(recovery.Sender)
+	if err == nil {
+		var recoveryAddr sdk.AccAddress
+		recoveryAddr, err = sdk.AccAddressFromBech32(recovery.LocalRecoveryAddr)
+		if err == nil {
+			err = h.BankKeeper.SendCoins(ctx, sender, recoveryAddr, sdk.NewCoins(recovery.Funds))
+		}
+	}
+	if err != nil {
+		// The funds stay in the intermediary account. Failing here would prevent the refund of the transfer module.
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				"ibc-swap-recovery-error",
+				sdk.NewAttribute("sender", recovery.Sender),
+				sdk.NewAttribute("recovery_addr", recovery.LocalRecoveryAddr),
+				sdk.NewAttribute("funds", recovery.Funds.String()),
+				sdk.NewAttribute("error", err.Error()),
+			),
+		})
+	}
+}
+
+// ValidateAndParseSwapMemo returns the swap instructions of the osmosis_swap key of the memo
+func ValidateAndParseSwapMemo(memo string) (isSwapRouted bool, swap types.OsmosisSwap, err error) {
+	isSwapRouted, metadata := jsonStringHasKey(memo, types.IBCSwapKey)
+	if !isSwapRouted {
+		return isSwapRouted, swap, nil
+	}
+
+	if _, ok := metadata["wasm"]; ok {
+		return isSwapRouted, swap,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "the wasm and osmosis_swap keys cannot be used together")
+	}
+
+	// Make sure the osmosis_swap key is a map. If it isn't, return an error
+	if _, ok := metadata[types.IBCSwapKey].(map[string]interface{}); !ok {
+		return isSwapRouted, swap,
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "osmosis_swap metadata is not a valid JSON map object")
+	}
+
+	// Parse the raw memo again, as numbers in the generic map lose precision
+	var swapMemo struct {
+		OsmosisSwap json.RawMessage `json:"osmosis_swap"`
+	}
+	if err := json.Unmarshal([]byte(memo), &swapMemo); err != nil {
+		return isSwapRouted, swap, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(swapMemo.OsmosisSwap))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&swap); err != nil {
+		return isSwapRouted, types.OsmosisSwap{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+
+	if err := swap.ValidateBasic(); err != nil {
+		return isSwapRouted, types.OsmosisSwap{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+	}
+	return isSwapRouted, swap, nil
+}
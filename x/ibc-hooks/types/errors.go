@@ -18,4 +18,6 @@ var (
 	ErrAsyncAckNotAllowed  = errorsmod.Register("wasm-hooks", 9, "contract not allowed to send async acks")
 	ErrAckPacketMismatch   = errorsmod.Register("wasm-hooks", 10, "packet does not match the expected packet")
 	ErrInvalidContractAddr = errorsmod.Register("wasm-hooks", 11, "invalid contract address")
+	ErrSwapError           = errorsmod.Register("wasm-hooks", 12, "swap error")
+	ErrForwardError        = errorsmod.Register("wasm-hooks", 13, "cannot forward the swap output")
 )
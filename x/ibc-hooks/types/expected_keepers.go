@@ -1,10 +1,15 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 type ChannelKeeper interface {
@@ -14,3 +19,17 @@ type ChannelKeeper interface {
 	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
 	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error
 }
+
+// PoolManagerKeeper swaps the funds of the osmosis_swap memos
+type PoolManagerKeeper interface {
+	RouteExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, routes []SwapAmountInRoute, tokenIn sdk.Coin, tokenOutMinAmount osmomath.Int) (tokenOutAmount osmomath.Int, err error)
+}
+
+// TransferKeeper forwards the output of the osmosis_swap memos
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
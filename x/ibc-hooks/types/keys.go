@@ -7,6 +7,7 @@ const (
 
 	IBCCallbackKey = "ibc_callback"
 	IBCAsyncAckKey = "ibc_async_ack"
+	IBCSwapKey     = "osmosis_swap"
 
 	MsgEmitAckKey           = "emit_ack"
 	AttributeSender         = "sender"
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DefaultSwapForwardTimeout is the timeout of the forward of a swap output when the memo does not set one.
+// It matches the packet lifetime of the crosschain-swaps contract.
+const DefaultSwapForwardTimeout = 7 * 24 * time.Hour
+
+// Swap: The following types represent the native swap-and-forward instructions of the osmosis_swap memo key
+
+// SwapAmountInRoute is a hop of the swap, as in x/poolmanager
+type SwapAmountInRoute struct {
+	PoolId        uint64 `json:"pool_id"`
+	TokenOutDenom string `json:"token_out_denom"`
+}
+
+// OsmosisSwap is the content of the osmosis_swap memo key. The received funds are swapped through the routes
+// and the output is forwarded to the receiver over the channel, or sent to the receiver on this chain if there
+// is no channel. If the forward fails or times out, the output is sent to the local recovery address.
+type OsmosisSwap struct {
+	Routes            []SwapAmountInRoute `json:"routes"`
+	MinOutputAmount   osmomath.Int        `json:"min_output_amount"`
+	Receiver          string              `json:"receiver"`
+	Channel           string              `json:"channel,omitempty"`
+	NextMemo          json.RawMessage     `json:"next_memo,omitempty"`
+	TimeoutTimestamp  uint64              `json:"timeout_timestamp,omitempty"`
+	LocalRecoveryAddr string              `json:"local_recovery_addr"`
+}
+
+// ValidateBasic checks the swap instructions, without checking the pools of the routes exist
+func (s OsmosisSwap) ValidateBasic() error {
+	if len(s.Routes) == 0 {
+		return fmt.Errorf("routes cannot be empty")
+	}
+	for _, route := range s.Routes {
+		if err := sdk.ValidateDenom(route.TokenOutDenom); err != nil {
+			return fmt.Errorf("invalid route token out denom: %w", err)
+		}
+	}
+	if s.MinOutputAmount.IsNil() || !s.MinOutputAmount.IsPositive() {
+		return fmt.Errorf("min_output_amount must be positive")
+	}
+	if s.Receiver == "" {
+		return fmt.Errorf("receiver cannot be empty")
+	}
+	if s.Channel == "" {
+		// The output is sent on this chain
+		if _, err := sdk.AccAddressFromBech32(s.Receiver); err != nil {
+			return fmt.Errorf("receiver is not a valid bech32 address: %w", err)
+		}
+		if len(s.NextMemo) != 0 {
+			return fmt.Errorf("next_memo cannot be set without a channel")
+		}
+	} else if err := host.ChannelIdentifierValidator(s.Channel); err != nil {
+		return fmt.Errorf("invalid channel: %w", err)
+	}
+	if len(s.NextMemo) != 0 {
+		var nextMemo map[string]interface{}
+		if err := json.Unmarshal(s.NextMemo, &nextMemo); err != nil {
+			return fmt.Errorf("next_memo is not a valid JSON map object")
+		}
+	}
+	if _, err := sdk.AccAddressFromBech32(s.LocalRecoveryAddr); err != nil {
+		return fmt.Errorf("local_recovery_addr is not a valid bech32 address: %w", err)
+	}
+	return nil
+}
+
+// OutputDenom returns the denom of the swap output
+func (s OsmosisSwap) OutputDenom() string {
+	return s.Routes[len(s.Routes)-1].TokenOutDenom
+}
+
+// SwapAck is the response to be stored when a native swap is executed
+type SwapAck struct {
+	TokenOut        sdk.Coin `json:"token_out"`
+	ForwardSequence uint64   `json:"forward_sequence,omitempty"`
+	IbcAck          []byte   `json:"ibc_ack"`
+}
+
+// SwapRecovery is stored for the forward of a swap output, so the output can be sent to the recovery address
+// if the forward fails or times out
+type SwapRecovery struct {
+	Sender            string   `json:"sender"`
+	LocalRecoveryAddr string   `json:"local_recovery_addr"`
+	Funds             sdk.Coin `json:"funds"`
+}
@@ -28,6 +28,11 @@ type WasmHooks struct {
 	ContractKeeper      *wasmkeeper.Keeper
 	ibcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
+
+	// The keepers used by the osmosis_swap memos. They need to be set after the transfer keeper is created.
+	PoolManagerKeeper types.PoolManagerKeeper
+	TransferKeeper    types.TransferKeeper
+	BankKeeper        types.BankKeeper
 }
 
 func NewWasmHooks(ibcHooksKeeper *keeper.Keeper, contractKeeper *wasmkeeper.Keeper, bech32PrefixAccAddr string) WasmHooks {
@@ -43,15 +48,27 @@ func (h WasmHooks) ProperlyConfigured() bool {
 }
 
 func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
-	if !h.ProperlyConfigured() {
-		// Not configured
-		return im.App.OnRecvPacket(ctx, packet, relayer)
-	}
 	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	// Native swaps don't need the contract keeper
+	if h.SwapConfigured() {
+		isSwapRouted, swap, err := ValidateAndParseSwapMemo(data.GetMemo())
+		if isSwapRouted {
+			if err != nil {
+				return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+			}
+			return h.onRecvSwapPacket(im, ctx, packet, relayer, data, swap)
+		}
+	}
+
+	if !h.ProperlyConfigured() {
+		// Not configured
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
 	// Validate the memo
 	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
 	if !isWasmRouted {
@@ -302,6 +319,8 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
+	h.recoverSwapOutput(ctx, packet, osmoutils.IsAckError(acknowledgement))
+
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
@@ -349,6 +368,8 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return err
 	}
 
+	h.recoverSwapOutput(ctx, packet, true)
+
 	if !h.ProperlyConfigured() {
 		// Not configured. Return from the underlying implementation
 		return nil
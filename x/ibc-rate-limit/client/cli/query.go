@@ -12,6 +12,10 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdRateLimits)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllRateLimits)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdDenomRestrictions)
+
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -19,3 +23,28 @@ func GetQueryCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdRateLimits() (*osmocli.QueryDescriptor, *queryproto.RateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limits",
+		Short: "Query the rate limits of the transfers of a denom through a channel",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} channel-0 uosmo`,
+	}, &queryproto.RateLimitsRequest{}
+}
+
+func GetCmdAllRateLimits() (*osmocli.QueryDescriptor, *queryproto.AllRateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-rate-limits",
+		Short: "Query the rate limits of every channel and denom",
+	}, &queryproto.AllRateLimitsRequest{}
+}
+
+func GetCmdDenomRestrictions() (*osmocli.QueryDescriptor, *queryproto.DenomRestrictionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-restrictions",
+		Short: "Query the channels allowed to send a denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} transfer/channel-0/uatom`,
+	}, &queryproto.DenomRestrictionsRequest{}
+}
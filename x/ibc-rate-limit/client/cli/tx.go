@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
+)
+
+// NewCmdAddRateLimitProposal implements a command handler for the add rate limit proposal
+func NewCmdAddRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rate-limit-proposal [channel-id] [denom] [quotas] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to set the rate limits of the transfers of a denom through a channel",
+		Long: strings.TrimSpace(`Submit a proposal to set the rate limits of the transfers of a denom through a channel.
+
+Passing in quotas separated by commas would be parsed automatically to quotas of name, max send percentage, max recv percentage and duration.
+Ex) add-rate-limit-proposal channel-0 uosmo weekly,10,10,168h,daily,5,5,24h ->
+[weekly: 10% sent and 10% received per 168h]
+[daily: 5% sent and 5% received per 24h]
+
+The "any" channel id applies the rate limits to every channel.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quotas, err := ParseQuotas(args[2])
+			if err != nil {
+				return err
+			}
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewAddRateLimitProposal(title, description, args[0], args[1], quotas)
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdRemoveRateLimitProposal implements a command handler for the remove rate limit proposal
+func NewCmdRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit-proposal [channel-id] [denom] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Example: "remove-rate-limit-proposal channel-0 uosmo --from val --chain-id osmosis-1",
+		Short:   "Submit a proposal to remove the rate limits of the transfers of a denom through a channel",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewRemoveRateLimitProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdResetRateLimitProposal implements a command handler for the reset rate limit proposal
+func NewCmdResetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reset-rate-limit-proposal [channel-id] [denom] [quota-name] [flags]",
+		Args:    cobra.ExactArgs(3),
+		Example: "reset-rate-limit-proposal channel-0 uosmo weekly --from val --chain-id osmosis-1",
+		Short:   "Submit a proposal to empty the flow of a quota, starting a new period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewResetRateLimitProposal(title, description, args[0], args[1], args[2])
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdEditRateLimitProposal implements a command handler for the edit rate limit proposal
+func NewCmdEditRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "edit-rate-limit-proposal [channel-id] [denom] [quota] [flags]",
+		Args:    cobra.ExactArgs(3),
+		Example: "edit-rate-limit-proposal channel-0 uosmo weekly,20,20,168h --from val --chain-id osmosis-1",
+		Short:   "Submit a proposal to replace the quota of the same name, keeping the flow of its current period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			quotas, err := ParseQuotas(args[2])
+			if err != nil {
+				return err
+			}
+			if len(quotas) != 1 {
+				return fmt.Errorf("expected a single quota, got %d", len(quotas))
+			}
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewEditRateLimitProposal(title, description, args[0], args[1], quotas[0])
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSetDenomRestrictionsProposal implements a command handler for the set denom restrictions proposal
+func NewCmdSetDenomRestrictionsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-denom-restrictions-proposal [denom] [allowed-channels] [flags]",
+		Args:    cobra.ExactArgs(2),
+		Example: "set-denom-restrictions-proposal transfer/channel-0/uatom channel-0,channel-1 --from val --chain-id osmosis-1",
+		Short:   "Submit a proposal to only allow the sends of a denom through the allowed channels",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allowedChannels := strings.Split(args[1], ",")
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewSetDenomRestrictionsProposal(title, description, args[0], allowedChannels)
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdUnsetDenomRestrictionsProposal implements a command handler for the unset denom restrictions proposal
+func NewCmdUnsetDenomRestrictionsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unset-denom-restrictions-proposal [denom] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Example: "unset-denom-restrictions-proposal transfer/channel-0/uatom --from val --chain-id osmosis-1",
+		Short:   "Submit a proposal to remove the channel restrictions of a denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypesv1beta1.Content {
+				return types.NewUnsetDenomRestrictionsProposal(title, description, args[0])
+			})
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+// submitProposal submits a legacy proposal with the content built from the title and summary flags.
+func submitProposal(cmd *cobra.Command, buildContent func(title, description string) govtypesv1beta1.Content) error {
+	clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+	if err != nil {
+		return err
+	}
+
+	contentMsg, err := v1.NewLegacyContent(buildContent(proposalTitle, summary), authority.String())
+	if err != nil {
+		return err
+	}
+
+	msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
+
+// ParseQuotas parses a comma separated list of quota names, max send percentages,
+// max recv percentages and durations.
+func ParseQuotas(arg string) ([]types.Quota, error) {
+	records := strings.Split(arg, ",")
+	if len(records)%4 != 0 {
+		return nil, fmt.Errorf("quotas must be a list of name, max send percentage, max recv percentage and duration separated by commas")
+	}
+
+	quotas := []types.Quota{}
+	for i := 0; i < len(records); i += 4 {
+		maxPercentageSend, err := strconv.ParseUint(records[i+1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		maxPercentageRecv, err := strconv.ParseUint(records[i+2], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		duration, err := time.ParseDuration(records[i+3])
+		if err != nil {
+			return nil, err
+		}
+
+		quotas = append(quotas, types.Quota{
+			Name:              records[i],
+			MaxPercentageSend: uint32(maxPercentageSend),
+			MaxPercentageRecv: uint32(maxPercentageRecv),
+			Duration:          duration,
+		})
+	}
+	return quotas, nil
+}
@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RateLimits(grpcCtx context.Context,
+	req *queryproto.RateLimitsRequest,
+) (*queryproto.RateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RateLimits(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,3 +41,23 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) DenomRestrictions(grpcCtx context.Context,
+	req *queryproto.DenomRestrictionsRequest,
+) (*queryproto.DenomRestrictionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.DenomRestrictions(ctx, *req)
+}
+
+func (q Querier) AllRateLimits(grpcCtx context.Context,
+	req *queryproto.AllRateLimitsRequest,
+) (*queryproto.AllRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AllRateLimits(ctx, *req)
+}
+
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	AddRateLimitProposalHandler           = govclient.NewProposalHandler(cli.NewCmdAddRateLimitProposal)
+	RemoveRateLimitProposalHandler        = govclient.NewProposalHandler(cli.NewCmdRemoveRateLimitProposal)
+	ResetRateLimitProposalHandler         = govclient.NewProposalHandler(cli.NewCmdResetRateLimitProposal)
+	EditRateLimitProposalHandler          = govclient.NewProposalHandler(cli.NewCmdEditRateLimitProposal)
+	SetDenomRestrictionsProposalHandler   = govclient.NewProposalHandler(cli.NewCmdSetDenomRestrictionsProposal)
+	UnsetDenomRestrictionsProposalHandler = govclient.NewProposalHandler(cli.NewCmdUnsetDenomRestrictionsProposal)
+)
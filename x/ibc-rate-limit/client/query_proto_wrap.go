@@ -19,3 +19,24 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) RateLimits(ctx sdk.Context,
+	req queryproto.RateLimitsRequest,
+) (*queryproto.RateLimitsResponse, error) {
+	rateLimits, err := q.K.GetRateLimits(ctx, req.ChannelId, req.Denom)
+	return &queryproto.RateLimitsResponse{RateLimits: rateLimits}, err
+}
+
+func (q Querier) AllRateLimits(ctx sdk.Context,
+	req queryproto.AllRateLimitsRequest,
+) (*queryproto.AllRateLimitsResponse, error) {
+	pathRateLimits, err := q.K.GetAllPathRateLimits(ctx)
+	return &queryproto.AllRateLimitsResponse{PathRateLimits: pathRateLimits}, err
+}
+
+func (q Querier) DenomRestrictions(ctx sdk.Context,
+	req queryproto.DenomRestrictionsRequest,
+) (*queryproto.DenomRestrictionsResponse, error) {
+	allowedChannels, err := q.K.GetDenomRestrictions(ctx, req.Denom)
+	return &queryproto.DenomRestrictionsResponse{AllowedChannels: allowedChannels}, err
+}
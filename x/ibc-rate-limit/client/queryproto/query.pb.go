@@ -114,9 +114,289 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+// RateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type RateLimitsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{2}
+}
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+func (m *RateLimitsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type RateLimitsResponse struct {
+	RateLimits []types.RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+}
+
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{3}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetRateLimits() []types.RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+// AllRateLimitsRequest is the request type for the Query/AllRateLimits RPC
+// method.
+type AllRateLimitsRequest struct {
+}
+
+func (m *AllRateLimitsRequest) Reset()         { *m = AllRateLimitsRequest{} }
+func (m *AllRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*AllRateLimitsRequest) ProtoMessage()    {}
+func (*AllRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{4}
+}
+func (m *AllRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRateLimitsRequest.Merge(m, src)
+}
+func (m *AllRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllRateLimitsRequest proto.InternalMessageInfo
+
+// AllRateLimitsResponse is the response type for the Query/AllRateLimits RPC
+// method.
+type AllRateLimitsResponse struct {
+	PathRateLimits []types.PathRateLimits `protobuf:"bytes,1,rep,name=path_rate_limits,json=pathRateLimits,proto3" json:"path_rate_limits" yaml:"path_rate_limits"`
+}
+
+func (m *AllRateLimitsResponse) Reset()         { *m = AllRateLimitsResponse{} }
+func (m *AllRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*AllRateLimitsResponse) ProtoMessage()    {}
+func (*AllRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{5}
+}
+func (m *AllRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllRateLimitsResponse.Merge(m, src)
+}
+func (m *AllRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllRateLimitsResponse proto.InternalMessageInfo
+
+func (m *AllRateLimitsResponse) GetPathRateLimits() []types.PathRateLimits {
+	if m != nil {
+		return m.PathRateLimits
+	}
+	return nil
+}
+
+// DenomRestrictionsRequest is the request type for the Query/DenomRestrictions
+// RPC method.
+type DenomRestrictionsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *DenomRestrictionsRequest) Reset()         { *m = DenomRestrictionsRequest{} }
+func (m *DenomRestrictionsRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomRestrictionsRequest) ProtoMessage()    {}
+func (*DenomRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{6}
+}
+func (m *DenomRestrictionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRestrictionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRestrictionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRestrictionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRestrictionsRequest.Merge(m, src)
+}
+func (m *DenomRestrictionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRestrictionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRestrictionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRestrictionsRequest proto.InternalMessageInfo
+
+func (m *DenomRestrictionsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DenomRestrictionsResponse is the response type for the
+// Query/DenomRestrictions RPC method.
+type DenomRestrictionsResponse struct {
+	AllowedChannels []string `protobuf:"bytes,1,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty" yaml:"allowed_channels"`
+}
+
+func (m *DenomRestrictionsResponse) Reset()         { *m = DenomRestrictionsResponse{} }
+func (m *DenomRestrictionsResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomRestrictionsResponse) ProtoMessage()    {}
+func (*DenomRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{7}
+}
+func (m *DenomRestrictionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRestrictionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRestrictionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRestrictionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRestrictionsResponse.Merge(m, src)
+}
+func (m *DenomRestrictionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRestrictionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRestrictionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRestrictionsResponse proto.InternalMessageInfo
+
+func (m *DenomRestrictionsResponse) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.ibcratelimit.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.ibcratelimit.v1beta1.ParamsResponse")
+	proto.RegisterType((*RateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsResponse")
+	proto.RegisterType((*AllRateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.AllRateLimitsRequest")
+	proto.RegisterType((*AllRateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.AllRateLimitsResponse")
+	proto.RegisterType((*DenomRestrictionsRequest)(nil), "osmosis.ibcratelimit.v1beta1.DenomRestrictionsRequest")
+	proto.RegisterType((*DenomRestrictionsResponse)(nil), "osmosis.ibcratelimit.v1beta1.DenomRestrictionsResponse")
 }
 
 func init() {
@@ -124,28 +404,48 @@ func init() {
 }
 
 var fileDescriptor_6904fea69f32464e = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0x2b, 0x31,
-	0x18, 0xc5, 0x27, 0x97, 0x6b, 0x17, 0x23, 0x2a, 0x0c, 0x2e, 0xa4, 0x94, 0x28, 0x45, 0xa4, 0xfe,
-	0x69, 0x42, 0xdb, 0x37, 0xe8, 0xc2, 0xb5, 0x16, 0x57, 0xae, 0x4c, 0x86, 0x10, 0x03, 0x33, 0xf9,
-	0xa6, 0x93, 0xb4, 0xe8, 0xd6, 0x27, 0x10, 0xba, 0xf6, 0x7d, 0xba, 0x2c, 0xb8, 0x71, 0x25, 0xd2,
-	0xfa, 0x20, 0x32, 0x49, 0x5a, 0x8a, 0x48, 0xe9, 0x6a, 0x26, 0xc9, 0xef, 0x9c, 0xef, 0xe4, 0x24,
-	0x6e, 0x81, 0xc9, 0xc1, 0x28, 0x43, 0x15, 0x4f, 0x4b, 0x66, 0x45, 0xa6, 0x72, 0x65, 0xe9, 0xb8,
-	0xc3, 0x85, 0x65, 0x1d, 0x3a, 0x1c, 0x89, 0xf2, 0x99, 0x14, 0x25, 0x58, 0x48, 0x1a, 0x81, 0x24,
-	0xeb, 0x24, 0x09, 0x64, 0xfd, 0x50, 0x82, 0x04, 0x07, 0xd2, 0xea, 0xcf, 0x6b, 0xea, 0x0d, 0x09,
-	0x20, 0x33, 0x41, 0x59, 0xa1, 0x28, 0xd3, 0x1a, 0x2c, 0xb3, 0x0a, 0xb4, 0x09, 0xa7, 0x17, 0xa9,
-	0xb3, 0xa4, 0x9c, 0x19, 0xe1, 0x47, 0xad, 0x06, 0x17, 0x4c, 0x2a, 0xed, 0xe0, 0xc0, 0x9e, 0x6f,
-	0xcc, 0x59, 0xb0, 0x92, 0xe5, 0xc1, 0xb6, 0x79, 0x10, 0xef, 0xdd, 0xb8, 0xf5, 0x40, 0x0c, 0x47,
-	0xc2, 0xd8, 0xe6, 0x5d, 0xbc, 0xbf, 0xdc, 0x30, 0x05, 0x68, 0x23, 0x92, 0x7e, 0x5c, 0xf3, 0x92,
-	0x23, 0x74, 0x82, 0x5a, 0xbb, 0xdd, 0x53, 0xb2, 0xe9, 0x72, 0xc4, 0xab, 0xfb, 0xff, 0xa7, 0x9f,
-	0xc7, 0xd1, 0x20, 0x28, 0xbb, 0x6f, 0x28, 0xde, 0xb9, 0xad, 0x42, 0x27, 0x13, 0x14, 0xd7, 0x3c,
-	0x92, 0x5c, 0x6e, 0x63, 0x14, 0x72, 0xd5, 0xaf, 0xb6, 0x83, 0x7d, 0xe6, 0x26, 0x79, 0x79, 0xff,
-	0x9e, 0xfc, 0x6b, 0x25, 0x67, 0x74, 0xad, 0x8a, 0x76, 0x25, 0x6b, 0xff, 0x55, 0x46, 0xff, 0x61,
-	0x3a, 0xc7, 0x68, 0x36, 0xc7, 0xe8, 0x6b, 0x8e, 0xd1, 0xeb, 0x02, 0x47, 0xb3, 0x05, 0x8e, 0x3e,
-	0x16, 0x38, 0xba, 0xbf, 0x96, 0xca, 0x3e, 0x8e, 0x38, 0x49, 0x21, 0x5f, 0x7a, 0xb5, 0x33, 0xc6,
-	0xcd, 0xca, 0x78, 0xdc, 0xeb, 0xd0, 0xa7, 0xdf, 0xf6, 0x69, 0xa6, 0x84, 0xb6, 0xfe, 0x9d, 0x5c,
-	0xd1, 0xbc, 0xe6, 0x3e, 0xbd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x98, 0x22, 0x66, 0x44,
-	0x02, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0x8f, 0x0b, 0xad, 0xd4, 0x2b, 0xfd, 0x77, 0x6a, 0xa1, 0x98, 0xca, 0x41, 0x27, 0x54, 0x02,
+	0x6d, 0x6c, 0x9a, 0x54, 0x80, 0x3a, 0x81, 0x41, 0x95, 0x90, 0x18, 0xc0, 0x62, 0x62, 0x09, 0x67,
+	0xe7, 0xe4, 0x58, 0xb2, 0x7d, 0xae, 0x7d, 0x6d, 0x29, 0x88, 0x85, 0x27, 0xa8, 0xd4, 0xe7, 0x60,
+	0xe0, 0x0d, 0x18, 0x18, 0x3a, 0x56, 0x62, 0x61, 0x8a, 0x50, 0xcb, 0x13, 0xf4, 0x09, 0x90, 0xef,
+	0x2e, 0xb6, 0x9b, 0x06, 0x27, 0x99, 0x92, 0xdc, 0xfd, 0xfe, 0x7d, 0x9f, 0xbf, 0x2f, 0x06, 0x35,
+	0x9a, 0x04, 0x34, 0xf1, 0x12, 0xc3, 0xb3, 0x9d, 0x18, 0x33, 0xe2, 0x7b, 0x81, 0xc7, 0x8c, 0xfd,
+	0x4d, 0x9b, 0x30, 0xbc, 0x69, 0xec, 0xee, 0x91, 0xf8, 0x50, 0x8f, 0x62, 0xca, 0x28, 0x5c, 0x95,
+	0x48, 0xbd, 0x88, 0xd4, 0x25, 0x52, 0x5d, 0x72, 0xa9, 0x4b, 0x39, 0xd0, 0x48, 0xbf, 0x09, 0x8e,
+	0xba, 0xea, 0x52, 0xea, 0xfa, 0xc4, 0xc0, 0x91, 0x67, 0xe0, 0x30, 0xa4, 0x0c, 0x33, 0x8f, 0x86,
+	0x89, 0xbc, 0x7d, 0xe8, 0x70, 0x49, 0xc3, 0xc6, 0x09, 0x11, 0x56, 0x99, 0x71, 0x84, 0x5d, 0x2f,
+	0xe4, 0x60, 0x89, 0x7d, 0x50, 0x9a, 0x33, 0xc2, 0x31, 0x0e, 0x7a, 0xb2, 0xf5, 0x52, 0x68, 0x7a,
+	0xd2, 0x12, 0xd9, 0x39, 0x1c, 0xcd, 0x83, 0xd9, 0x37, 0x9c, 0x6e, 0x91, 0xdd, 0x3d, 0x92, 0x30,
+	0xf4, 0x0e, 0xcc, 0xf5, 0x0e, 0x92, 0x88, 0x86, 0x09, 0x81, 0x26, 0x98, 0x12, 0x0e, 0x2b, 0xca,
+	0x5d, 0xa5, 0x36, 0xd3, 0xb8, 0xa7, 0x97, 0xf5, 0x42, 0x17, 0x6c, 0xf3, 0xfa, 0x49, 0xb7, 0x5a,
+	0xb1, 0x24, 0x13, 0xed, 0x82, 0x45, 0x0b, 0x33, 0xf2, 0x3a, 0x45, 0xf6, 0xac, 0xe0, 0x16, 0x00,
+	0x4e, 0x07, 0x87, 0x21, 0xf1, 0x5b, 0x5e, 0x9b, 0x8b, 0x4f, 0x9b, 0xcb, 0x17, 0xdd, 0xea, 0xe2,
+	0x21, 0x0e, 0xfc, 0x6d, 0x94, 0xdf, 0x21, 0x6b, 0x5a, 0xfe, 0x78, 0xd5, 0x86, 0x6b, 0x60, 0xb2,
+	0x4d, 0x42, 0x1a, 0xac, 0x4c, 0x70, 0xc2, 0xc2, 0x45, 0xb7, 0x7a, 0x43, 0x10, 0xf8, 0x31, 0xb2,
+	0xc4, 0x35, 0xfa, 0x04, 0x60, 0xd1, 0x52, 0x16, 0xd3, 0x06, 0x33, 0x79, 0x0f, 0xd2, 0x8a, 0xae,
+	0xd5, 0x66, 0x1a, 0xf7, 0xcb, 0x2b, 0xca, 0x64, 0x4c, 0x35, 0x2d, 0xea, 0xa2, 0x5b, 0x85, 0xc2,
+	0xb0, 0xa0, 0x84, 0x2c, 0x10, 0x67, 0x6e, 0xe8, 0x26, 0x58, 0x7a, 0xee, 0xfb, 0x57, 0x2a, 0x46,
+	0x47, 0x0a, 0x58, 0xee, 0xbb, 0x90, 0xb9, 0x0e, 0xc0, 0x42, 0x84, 0x59, 0xa7, 0x75, 0x35, 0xdc,
+	0xc6, 0xb0, 0x76, 0xb3, 0x4e, 0xae, 0x67, 0x56, 0x65, 0xc2, 0x5b, 0x22, 0x61, 0xbf, 0x26, 0xb2,
+	0xe6, 0xa2, 0x4b, 0x04, 0x64, 0x82, 0x95, 0x97, 0x69, 0xbf, 0x2c, 0x92, 0xb0, 0xd8, 0x73, 0xf8,
+	0x84, 0xf6, 0x1e, 0x50, 0xd6, 0x6a, 0xa5, 0xbc, 0xd5, 0x0e, 0xb8, 0x3d, 0x40, 0x43, 0x56, 0xb6,
+	0x03, 0x16, 0xb0, 0xef, 0xd3, 0x03, 0xd2, 0x6e, 0xc9, 0x87, 0x28, 0x2a, 0x9b, 0x36, 0xef, 0xe4,
+	0x39, 0xfb, 0x11, 0xc8, 0x9a, 0x97, 0x47, 0x2f, 0xe4, 0x49, 0xe3, 0xe7, 0x24, 0x98, 0x7c, 0x9b,
+	0xae, 0x09, 0x3c, 0x56, 0xc0, 0x94, 0x98, 0x32, 0xb8, 0x3e, 0xca, 0x2c, 0xca, 0x72, 0xd4, 0x8d,
+	0xd1, 0xc0, 0x22, 0x37, 0xd2, 0xbf, 0xfe, 0xfa, 0x7b, 0x3c, 0x51, 0x83, 0x6b, 0x46, 0x61, 0xa3,
+	0xea, 0x29, 0xad, 0x3e, 0x68, 0xfd, 0xe0, 0x77, 0x05, 0x80, 0xbc, 0xaf, 0xd0, 0x18, 0x71, 0xa6,
+	0xb2, 0x74, 0x8f, 0x46, 0x27, 0xc8, 0x84, 0xcf, 0x78, 0xc2, 0x6d, 0xf8, 0x74, 0x58, 0xc2, 0xc2,
+	0x00, 0x18, 0x9f, 0xf3, 0xb5, 0xfa, 0x02, 0xbf, 0x29, 0x60, 0xf6, 0xd2, 0x3c, 0xc2, 0x46, 0x79,
+	0x8a, 0x41, 0x53, 0xad, 0x36, 0xc7, 0xe2, 0xc8, 0xf0, 0x4d, 0x1e, 0xbe, 0x0e, 0xd7, 0xc7, 0x08,
+	0x0f, 0x7f, 0x28, 0x60, 0xf1, 0xca, 0xa4, 0xc1, 0xc7, 0xe5, 0xfe, 0xff, 0x1b, 0x6f, 0xf5, 0xc9,
+	0xd8, 0x3c, 0x99, 0x7d, 0x9b, 0x67, 0xdf, 0x82, 0x8d, 0x61, 0xd9, 0xf9, 0x7a, 0xb4, 0xe2, 0x82,
+	0x86, 0xf9, 0xe1, 0xe4, 0x4c, 0x53, 0x4e, 0xcf, 0x34, 0xe5, 0xcf, 0x99, 0xa6, 0x1c, 0x9d, 0x6b,
+	0x95, 0xd3, 0x73, 0xad, 0xf2, 0xfb, 0x5c, 0xab, 0xbc, 0xdf, 0x71, 0x3d, 0xd6, 0xd9, 0xb3, 0x75,
+	0x87, 0x06, 0x3d, 0xdd, 0xba, 0x8f, 0xed, 0x24, 0x33, 0xd9, 0x6f, 0x6e, 0x1a, 0x1f, 0xfb, 0xad,
+	0x1c, 0xdf, 0x23, 0x21, 0x13, 0x2f, 0x10, 0xfe, 0x97, 0x6e, 0x4f, 0xf1, 0x8f, 0xe6, 0xbf, 0x01,
+	0x00, 0x6e, 0xfe, 0xd8, 0x53, 0xdd, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +463,13 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimits returns the rate limits of the transfers of a denom through a
+	// channel.
+	RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	// AllRateLimits returns the rate limits of every path.
+	AllRateLimits(ctx context.Context, in *AllRateLimitsRequest, opts ...grpc.CallOption) (*AllRateLimitsResponse, error)
+	// DenomRestrictions returns the channels allowed to send a denom.
+	DenomRestrictions(ctx context.Context, in *DenomRestrictionsRequest, opts ...grpc.CallOption) (*DenomRestrictionsResponse, error)
 }
 
 type queryClient struct {
@@ -182,11 +489,45 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRateLimits(ctx context.Context, in *AllRateLimitsRequest, opts ...grpc.CallOption) (*AllRateLimitsResponse, error) {
+	out := new(AllRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/AllRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRestrictions(ctx context.Context, in *DenomRestrictionsRequest, opts ...grpc.CallOption) (*DenomRestrictionsResponse, error) {
+	out := new(DenomRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/DenomRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimits returns the rate limits of the transfers of a denom through a
+	// channel.
+	RateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
+	// AllRateLimits returns the rate limits of every path.
+	AllRateLimits(context.Context, *AllRateLimitsRequest) (*AllRateLimitsResponse, error)
+	// DenomRestrictions returns the channels allowed to send a denom.
+	DenomRestrictions(context.Context, *DenomRestrictionsRequest) (*DenomRestrictionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -196,6 +537,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) AllRateLimits(ctx context.Context, req *AllRateLimitsRequest) (*AllRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) DenomRestrictions(ctx context.Context, req *DenomRestrictionsRequest) (*DenomRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRestrictions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -219,6 +569,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/AllRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRateLimits(ctx, req.(*AllRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/DenomRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRestrictions(ctx, req.(*DenomRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -227,6 +631,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "AllRateLimits",
+			Handler:    _Query_AllRateLimits_Handler,
+		},
+		{
+			MethodName: "DenomRestrictions",
+			Handler:    _Query_DenomRestrictions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/ibcratelimit/v1beta1/query.proto",
@@ -288,35 +704,315 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *RateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AllRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PathRateLimits) > 0 {
+		for iNdEx := len(m.PathRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRestrictionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRestrictionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRestrictionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRestrictionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRestrictionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRestrictionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathRateLimits) > 0 {
+		for _, e := range m.PathRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomRestrictionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomRestrictionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovQuery(x uint64) (n int) {
@@ -458,6 +1154,502 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, types.RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathRateLimits = append(m.PathRateLimits, types.PathRateLimits{})
+			if err := m.PathRateLimits[len(m.PathRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRestrictionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRestrictionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRestrictionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRestrictionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRestrictionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRestrictionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,170 @@
+package ibc_rate_limit
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
+)
+
+// The namespaces of the rate limiting contract maps imported into the module state.
+const (
+	contractRateLimitsNamespace       = "flow"
+	contractDenomRestrictionNamespace = "acfd"
+)
+
+// ContractStateIterator iterates over the raw state of a contract, as the wasm keeper does.
+type ContractStateIterator interface {
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// contractRateLimit is the JSON representation of a rate limit in the contract state.
+type contractRateLimit struct {
+	Quota struct {
+		Name              string        `json:"name"`
+		MaxPercentageSend uint32        `json:"max_percentage_send"`
+		MaxPercentageRecv uint32        `json:"max_percentage_recv"`
+		Duration          uint64        `json:"duration"`
+		ChannelValue      *osmomath.Int `json:"channel_value"`
+	} `json:"quota"`
+	Flow struct {
+		Inflow    osmomath.Int `json:"inflow"`
+		Outflow   osmomath.Int `json:"outflow"`
+		PeriodEnd uint64       `json:"period_end,string"`
+	} `json:"flow"`
+}
+
+func (r contractRateLimit) toRateLimit() types.RateLimit {
+	channelValue := osmomath.ZeroInt()
+	if r.Quota.ChannelValue != nil {
+		channelValue = *r.Quota.ChannelValue
+	}
+	return types.RateLimit{
+		Quota: types.Quota{
+			Name:              r.Quota.Name,
+			MaxPercentageSend: r.Quota.MaxPercentageSend,
+			MaxPercentageRecv: r.Quota.MaxPercentageRecv,
+			Duration:          time.Duration(r.Quota.Duration) * time.Second,
+		},
+		Flow: types.Flow{
+			Inflow:    r.Flow.Inflow,
+			Outflow:   r.Flow.Outflow,
+			PeriodEnd: time.Unix(0, int64(r.Flow.PeriodEnd)).UTC(),
+		},
+		ChannelValue: channelValue,
+	}
+}
+
+// ImportContractState copies the rate limits and the denom restrictions of the configured rate limiting
+// contract into the module state, then unsets the contract address param so that the module enforces them.
+// It does nothing if no contract is configured.
+func (i *ICS4Wrapper) ImportContractState(ctx sdk.Context, contractState ContractStateIterator) error {
+	contract := i.GetContractAddress(ctx)
+	if contract == "" {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	pathRateLimits, denomRestrictions, err := parseContractState(ctx, contractState, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range pathRateLimits {
+		i.setRateLimits(ctx, path.ChannelId, path.Denom, path.RateLimits)
+	}
+	for _, restriction := range denomRestrictions {
+		i.setDenomRestrictions(ctx, restriction.Denom, restriction.AllowedChannels)
+	}
+
+	params := i.GetParams(ctx)
+	params.ContractAddress = ""
+	i.SetParams(ctx, params)
+	return nil
+}
+
+func parseContractState(ctx sdk.Context, contractState ContractStateIterator, contractAddr sdk.AccAddress) ([]types.PathRateLimits, []types.DenomRestriction, error) {
+	var (
+		pathRateLimits    []types.PathRateLimits
+		denomRestrictions []types.DenomRestriction
+		err               error
+	)
+
+	contractState.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+		if rest, ok := trimNamespace(key, contractRateLimitsNamespace); ok {
+			channelId, denom, ok := splitCompositeKey(rest)
+			if !ok {
+				err = types.ErrBadMessage.Wrapf("invalid contract rate limits key %X", key)
+				return true
+			}
+
+			var rateLimits []contractRateLimit
+			if err = json.Unmarshal(value, &rateLimits); err != nil {
+				return true
+			}
+			// Paths whose rate limits were all removed are saved with no rate limits
+			if len(rateLimits) == 0 {
+				return false
+			}
+
+			path := types.PathRateLimits{ChannelId: channelId, Denom: denom}
+			for _, rateLimit := range rateLimits {
+				path.RateLimits = append(path.RateLimits, rateLimit.toRateLimit())
+			}
+			pathRateLimits = append(pathRateLimits, path)
+		} else if denom, ok := trimNamespace(key, contractDenomRestrictionNamespace); ok {
+			var allowedChannels []string
+			if err = json.Unmarshal(value, &allowedChannels); err != nil {
+				return true
+			}
+			if len(allowedChannels) == 0 {
+				return false
+			}
+
+			denomRestrictions = append(denomRestrictions, types.DenomRestriction{
+				Denom:           string(denom),
+				AllowedChannels: allowedChannels,
+			})
+		}
+		return false
+	})
+	return pathRateLimits, denomRestrictions, err
+}
+
+// trimNamespace returns the key without the length-prefixed namespace of a contract map,
+// and false if the key is not in the namespace.
+func trimNamespace(key []byte, namespace string) ([]byte, bool) {
+	prefix := lengthPrefixed([]byte(namespace))
+	if !bytes.HasPrefix(key, prefix) {
+		return nil, false
+	}
+	return key[len(prefix):], true
+}
+
+// splitCompositeKey splits the (channel id, denom) key of a contract map, where the
+// channel id is length-prefixed.
+func splitCompositeKey(key []byte) (channelId, denom string, ok bool) {
+	if len(key) < 2 {
+		return "", "", false
+	}
+	length := int(binary.BigEndian.Uint16(key))
+	if len(key) < 2+length {
+		return "", "", false
+	}
+	return string(key[2 : 2+length]), string(key[2+length:]), true
+}
+
+func lengthPrefixed(bz []byte) []byte {
+	prefixed := make([]byte, 2, 2+len(bz))
+	binary.BigEndian.PutUint16(prefixed, uint16(len(bz)))
+	return append(prefixed, bz...)
+}
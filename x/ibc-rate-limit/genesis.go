@@ -7,14 +7,32 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address and the rate limits of the module.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	i.SetParams(ctx, genState.Params)
+
+	for _, pathRateLimits := range genState.PathRateLimits {
+		i.setRateLimits(ctx, pathRateLimits.ChannelId, pathRateLimits.Denom, pathRateLimits.RateLimits)
+	}
+	for _, restriction := range genState.DenomRestrictions {
+		i.setDenomRestrictions(ctx, restriction.Denom, restriction.AllowedChannels)
+	}
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	pathRateLimits, err := i.GetAllPathRateLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+	denomRestrictions, err := i.GetAllDenomRestrictions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:            i.GetParams(ctx),
+		PathRateLimits:    pathRateLimits,
+		DenomRestrictions: denomRestrictions,
 	}
 }
@@ -2,10 +2,12 @@ package ibc_rate_limit_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
 )
@@ -31,6 +33,24 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		Params: types.Params{
 			ContractAddress: testAddress,
 		},
+		PathRateLimits: []types.PathRateLimits{
+			{
+				ChannelId: "channel-0",
+				Denom:     "uosmo",
+				RateLimits: []types.RateLimit{{
+					Quota: types.Quota{Name: "weekly", MaxPercentageSend: 5, MaxPercentageRecv: 5, Duration: 7 * 24 * time.Hour},
+					Flow: types.Flow{
+						Inflow:    osmomath.NewInt(10),
+						Outflow:   osmomath.NewInt(20),
+						PeriodEnd: time.Unix(1700000000, 0).UTC(),
+					},
+					ChannelValue: osmomath.NewInt(1000),
+				}},
+			},
+		},
+		DenomRestrictions: []types.DenomRestriction{
+			{Denom: "uosmo", AllowedChannels: []string{"channel-0", "channel-1"}},
+		},
 	}
 
 	k.InitGenesis(suite.Ctx, initialGenesis)
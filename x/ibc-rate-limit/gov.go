@@ -0,0 +1,113 @@
+package ibc_rate_limit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
+)
+
+// HandleAddRateLimitProposal sets the quotas of the path, with empty flows for periods starting now.
+// It replaces the existing rate limits of the path.
+func (i *ICS4Wrapper) HandleAddRateLimitProposal(ctx sdk.Context, p *types.AddRateLimitProposal) error {
+	rateLimits := make([]types.RateLimit, len(p.Quotas))
+	for j, quota := range p.Quotas {
+		rateLimits[j] = types.NewRateLimit(quota, ctx.BlockTime())
+	}
+	i.setRateLimits(ctx, p.ChannelId, p.Denom, rateLimits)
+	return nil
+}
+
+func (i *ICS4Wrapper) HandleRemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if _, err := i.getExistingRateLimits(ctx, p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	i.setRateLimits(ctx, p.ChannelId, p.Denom, nil)
+	return nil
+}
+
+func (i *ICS4Wrapper) HandleResetRateLimitProposal(ctx sdk.Context, p *types.ResetRateLimitProposal) error {
+	rateLimits, err := i.getExistingRateLimits(ctx, p.ChannelId, p.Denom)
+	if err != nil {
+		return err
+	}
+
+	j, err := findQuota(rateLimits, p.QuotaName)
+	if err != nil {
+		return err
+	}
+	rateLimits[j].ResetFlow(ctx.BlockTime())
+	i.setRateLimits(ctx, p.ChannelId, p.Denom, rateLimits)
+	return nil
+}
+
+// HandleEditRateLimitProposal replaces the quota of the same name, keeping the flow
+// and the channel value of its current period.
+func (i *ICS4Wrapper) HandleEditRateLimitProposal(ctx sdk.Context, p *types.EditRateLimitProposal) error {
+	rateLimits, err := i.getExistingRateLimits(ctx, p.ChannelId, p.Denom)
+	if err != nil {
+		return err
+	}
+
+	j, err := findQuota(rateLimits, p.Quota.Name)
+	if err != nil {
+		return err
+	}
+	rateLimits[j].Quota = p.Quota
+	i.setRateLimits(ctx, p.ChannelId, p.Denom, rateLimits)
+	return nil
+}
+
+func (i *ICS4Wrapper) HandleSetDenomRestrictionsProposal(ctx sdk.Context, p *types.SetDenomRestrictionsProposal) error {
+	i.setDenomRestrictions(ctx, p.Denom, p.AllowedChannels)
+	return nil
+}
+
+func (i *ICS4Wrapper) HandleUnsetDenomRestrictionsProposal(ctx sdk.Context, p *types.UnsetDenomRestrictionsProposal) error {
+	i.setDenomRestrictions(ctx, p.Denom, nil)
+	return nil
+}
+
+func (i *ICS4Wrapper) getExistingRateLimits(ctx sdk.Context, channelId, denom string) ([]types.RateLimit, error) {
+	rateLimits, err := i.GetRateLimits(ctx, channelId, denom)
+	if err != nil {
+		return nil, err
+	}
+	if len(rateLimits) == 0 {
+		return nil, types.ErrRateLimitNotFound.Wrapf("channel %s, denom %s", channelId, denom)
+	}
+	return rateLimits, nil
+}
+
+func findQuota(rateLimits []types.RateLimit, name string) (int, error) {
+	for j, rateLimit := range rateLimits {
+		if rateLimit.Quota.Name == name {
+			return j, nil
+		}
+	}
+	return 0, types.ErrRateLimitNotFound.Wrapf("quota %s", name)
+}
+
+func NewRateLimitProposalHandler(i *ICS4Wrapper) govtypesv1.Handler {
+	return func(ctx sdk.Context, content govtypesv1.Content) error {
+		switch c := content.(type) {
+		case *types.AddRateLimitProposal:
+			return i.HandleAddRateLimitProposal(ctx, c)
+		case *types.RemoveRateLimitProposal:
+			return i.HandleRemoveRateLimitProposal(ctx, c)
+		case *types.ResetRateLimitProposal:
+			return i.HandleResetRateLimitProposal(ctx, c)
+		case *types.EditRateLimitProposal:
+			return i.HandleEditRateLimitProposal(ctx, c)
+		case *types.SetDenomRestrictionsProposal:
+			return i.HandleSetDenomRestrictionsProposal(ctx, c)
+		case *types.UnsetDenomRestrictionsProposal:
+			return i.HandleUnsetDenomRestrictionsProposal(ctx, c)
+
+		default:
+			return fmt.Errorf("unrecognized ibc rate limit proposal content type: %T", c)
+		}
+	}
+}
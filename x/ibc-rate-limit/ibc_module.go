@@ -2,6 +2,7 @@ package ibc_rate_limit
 
 import (
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -137,7 +138,14 @@ func (im *IBCModule) OnRecvPacket(
 
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
-		// The contract has not been configured. Continue as usual
+		// The contract has not been configured. Use the rate limits of the module
+		err := im.ics4Middleware.CheckAndUpdateNativeRateLimits(ctx, types.FlowIn, packet)
+		if errors.Is(err, types.ErrRateLimitExceeded) {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrRateLimitExceeded)
+		}
+		if err != nil {
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, err)
+		}
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
@@ -208,15 +216,15 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket Notifies the contract, or the rate limits of the module, that a sent packet wasn't properly received
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
 	contract := im.ics4Middleware.GetContractAddress(ctx)
 	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return nil
+		// The contract has not been configured. Revert the flow in the rate limits of the module
+		return im.ics4Middleware.UndoNativeSendRateLimit(ctx, packet)
 	}
 
 	if err := UndoSendRateLimit(
@@ -0,0 +1,226 @@
+package ibc_rate_limit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
+)
+
+// GetRateLimits returns the rate limits of the transfers of the denom through the channel.
+func (i *ICS4Wrapper) GetRateLimits(ctx sdk.Context, channelId, denom string) ([]types.RateLimit, error) {
+	pathRateLimits := types.PathRateLimits{}
+	found, err := osmoutils.Get(ctx.KVStore(i.storeKey), types.GetPathRateLimitsKey(channelId, denom), &pathRateLimits)
+	if err != nil || !found {
+		return nil, err
+	}
+	return pathRateLimits.RateLimits, nil
+}
+
+// setRateLimits sets the rate limits of the transfers of the denom through the channel,
+// removing the path if there are none.
+func (i *ICS4Wrapper) setRateLimits(ctx sdk.Context, channelId, denom string, rateLimits []types.RateLimit) {
+	store := ctx.KVStore(i.storeKey)
+	key := types.GetPathRateLimitsKey(channelId, denom)
+	if len(rateLimits) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	osmoutils.MustSet(store, key, &types.PathRateLimits{
+		ChannelId:  channelId,
+		Denom:      denom,
+		RateLimits: rateLimits,
+	})
+}
+
+// GetAllPathRateLimits returns the rate limits of every path.
+func (i *ICS4Wrapper) GetAllPathRateLimits(ctx sdk.Context) ([]types.PathRateLimits, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(i.storeKey), types.PathRateLimitsPrefix, parsePathRateLimits)
+}
+
+func parsePathRateLimits(bz []byte) (types.PathRateLimits, error) {
+	pathRateLimits := types.PathRateLimits{}
+	err := pathRateLimits.Unmarshal(bz)
+	return pathRateLimits, err
+}
+
+// GetDenomRestrictions returns the channels allowed to send the denom, which can be sent through
+// every channel if there are none.
+func (i *ICS4Wrapper) GetDenomRestrictions(ctx sdk.Context, denom string) ([]string, error) {
+	restriction := types.DenomRestriction{}
+	found, err := osmoutils.Get(ctx.KVStore(i.storeKey), types.GetDenomRestrictionKey(denom), &restriction)
+	if err != nil || !found {
+		return nil, err
+	}
+	return restriction.AllowedChannels, nil
+}
+
+// setDenomRestrictions only allows the sends of the denom through the allowed channels,
+// removing the restriction if there are none.
+func (i *ICS4Wrapper) setDenomRestrictions(ctx sdk.Context, denom string, allowedChannels []string) {
+	store := ctx.KVStore(i.storeKey)
+	key := types.GetDenomRestrictionKey(denom)
+	if len(allowedChannels) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	osmoutils.MustSet(store, key, &types.DenomRestriction{
+		Denom:           denom,
+		AllowedChannels: allowedChannels,
+	})
+}
+
+// GetAllDenomRestrictions returns the channel restrictions of every denom.
+func (i *ICS4Wrapper) GetAllDenomRestrictions(ctx sdk.Context) ([]types.DenomRestriction, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(i.storeKey), types.DenomRestrictionPrefix, parseDenomRestriction)
+}
+
+func parseDenomRestriction(bz []byte) (types.DenomRestriction, error) {
+	restriction := types.DenomRestriction{}
+	err := restriction.Unmarshal(bz)
+	return restriction, err
+}
+
+// CheckAndUpdateNativeRateLimits applies the transfer of the packet to the flows of the rate limits of its
+// channel and of the "any" channel, for the denom of the packet on this chain. It returns an error if a rate
+// limit is exceeded, or if the packet sends a restricted denom through a channel that is not allowed.
+// Transfers of paths without rate limits are always allowed.
+func (i *ICS4Wrapper) CheckAndUpdateNativeRateLimits(ctx sdk.Context, direction types.FlowDirection, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return types.ErrBadMessage.Wrap(err.Error())
+	}
+	amount, ok := osmomath.NewIntFromString(packetData.Amount)
+	if !ok {
+		return types.ErrBadMessage.Wrapf("invalid packet amount %s", packetData.Amount)
+	}
+
+	if direction == types.FlowOut {
+		if err := i.checkDenomRestrictions(ctx, packetData.Denom, packet.GetSourceChannel()); err != nil {
+			return err
+		}
+	}
+
+	channelId, denom := pathData(direction, packet, packetData.Denom)
+	rateLimits, err := i.GetRateLimits(ctx, channelId, denom)
+	if err != nil {
+		return err
+	}
+	anyRateLimits, err := i.GetRateLimits(ctx, types.AnyChannel, denom)
+	if err != nil {
+		return err
+	}
+	if len(rateLimits) == 0 && len(anyRateLimits) == 0 {
+		// No quota configured for the path. Allowing all transfers.
+		return nil
+	}
+
+	// The transfer module burns the vouchers of the non-native tokens before the send reaches the middleware,
+	// add them back so the channel value matches the supply at the start of the transfer.
+	channelValue := i.bankKeeper.GetSupplyWithOffset(ctx, denom).Amount
+	if direction == types.FlowOut && strings.HasPrefix(denom, "ibc") {
+		channelValue = channelValue.Add(amount)
+	}
+
+	for _, limits := range [][]types.RateLimit{rateLimits, anyRateLimits} {
+		for j := range limits {
+			if err := limits[j].AllowTransfer(direction, amount, channelValue, ctx.BlockTime()); err != nil {
+				return errorsmod.Wrapf(err, "channel %s, denom %s", channelId, denom)
+			}
+		}
+	}
+
+	i.setRateLimits(ctx, channelId, denom, rateLimits)
+	i.setRateLimits(ctx, types.AnyChannel, denom, anyRateLimits)
+	return nil
+}
+
+// UndoNativeSendRateLimit removes the amount of a sent packet that failed or timed out
+// from the outflows of the rate limits it was applied to.
+func (i *ICS4Wrapper) UndoNativeSendRateLimit(ctx sdk.Context, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return types.ErrBadMessage.Wrap(err.Error())
+	}
+	amount, ok := osmomath.NewIntFromString(packetData.Amount)
+	if !ok {
+		return types.ErrBadMessage.Wrapf("invalid packet amount %s", packetData.Amount)
+	}
+
+	sourceChannel, denom := pathData(types.FlowOut, packet, packetData.Denom)
+	for _, channelId := range []string{sourceChannel, types.AnyChannel} {
+		rateLimits, err := i.GetRateLimits(ctx, channelId, denom)
+		if err != nil {
+			return err
+		}
+		for j := range rateLimits {
+			rateLimits[j].Flow.UndoFlow(types.FlowOut, amount)
+		}
+		i.setRateLimits(ctx, channelId, denom, rateLimits)
+	}
+	return nil
+}
+
+// checkDenomRestrictions returns an error if the packet denom is restricted to channels
+// other than the source channel of the packet.
+func (i *ICS4Wrapper) checkDenomRestrictions(ctx sdk.Context, packetDenom, sourceChannel string) error {
+	allowedChannels, err := i.GetDenomRestrictions(ctx, packetDenom)
+	if err != nil {
+		return err
+	}
+	if len(allowedChannels) == 0 {
+		return nil
+	}
+
+	for _, channel := range allowedChannels {
+		if channel == sourceChannel {
+			return nil
+		}
+	}
+	return types.ErrChannelBlocked.Wrapf("denom %s cannot be sent through channel %s", packetDenom, sourceChannel)
+}
+
+// pathData returns the channel on this chain the packet goes through, and the denom of the
+// packet tokens on this chain.
+func pathData(direction types.FlowDirection, packet exported.PacketI, packetDenom string) (channelId, denom string) {
+	if direction == types.FlowOut {
+		// The non-native tokens are sent with their full trace
+		if !strings.HasPrefix(packetDenom, "transfer/") {
+			return packet.GetSourceChannel(), packetDenom
+		}
+		return packet.GetSourceChannel(), hashDenom(packetDenom)
+	}
+
+	sourcePrefix := fmt.Sprintf("transfer/%s/", packet.GetSourceChannel())
+	if !strings.HasPrefix(packetDenom, sourcePrefix) {
+		// Tokens that come directly from the counterparty, prefixed on this chain
+		return packet.GetDestChannel(), hashDenom(fmt.Sprintf("transfer/%s/%s", packet.GetDestChannel(), packetDenom))
+	}
+
+	// Tokens that were sent to the counterparty and are returning
+	unprefixed := strings.TrimPrefix(packetDenom, sourcePrefix)
+	firstSegment := strings.Split(unprefixed, "/")[0]
+	if firstSegment == unprefixed || firstSegment == "factory" {
+		// Native and tokenfactory tokens
+		return packet.GetDestChannel(), unprefixed
+	}
+	return packet.GetDestChannel(), hashDenom(unprefixed)
+}
+
+// hashDenom returns the ibc denom of the full denom trace path.
+func hashDenom(fullDenomPath string) string {
+	hash := sha256.Sum256([]byte(fullDenomPath))
+	return "ibc/" + strings.ToUpper(hex.EncodeToString(hash[:]))
+}
@@ -0,0 +1,336 @@
+package ibc_rate_limit_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/ibc-rate-limit/types"
+)
+
+const weeklyDuration = 7 * 24 * time.Hour
+
+func (suite *MiddlewareTestSuite) AddNativeRateLimit(channel, denom string, sendPercentage, recvPercentage uint32) {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	err := osmosisApp.RateLimitingICS4Wrapper.HandleAddRateLimitProposal(suite.chainA.GetContext(), &types.AddRateLimitProposal{
+		Title:       "add rate limit",
+		Description: "add rate limit",
+		ChannelId:   channel,
+		Denom:       denom,
+		Quotas: []types.Quota{{
+			Name:              "weekly",
+			MaxPercentageSend: sendPercentage,
+			MaxPercentageRecv: recvPercentage,
+			Duration:          weeklyDuration,
+		}},
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) SetNativeDenomRestrictions(denom string, allowedChannels ...string) {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	err := osmosisApp.RateLimitingICS4Wrapper.HandleSetDenomRestrictionsProposal(suite.chainA.GetContext(), &types.SetDenomRestrictionsProposal{
+		Title:           "set denom restrictions",
+		Description:     "set denom restrictions",
+		Denom:           denom,
+		AllowedChannels: allowedChannels,
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) GetNativeRateLimit(channel, denom string) types.RateLimit {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	rateLimits, err := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), channel, denom)
+	suite.Require().NoError(err)
+	suite.Require().Len(rateLimits, 1)
+	return rateLimits[0]
+}
+
+// Test rate limiting on sends with the rate limits of the module
+func (suite *MiddlewareTestSuite) fullNativeSendTest(native bool) types.RateLimit {
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	restrictedDenom := denom
+	channel := "channel-0"
+	if !native {
+		denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", denom))
+		restrictedDenom = denomTrace.GetFullDenomPath()
+		denom = denomTrace.IBCDenom()
+	}
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), denom, osmosisApp.BankKeeper)
+
+	// The amount to be sent is 2.5% (quota is 5%)
+	quota := channelValue.QuoRaw(20)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.AddNativeRateLimit(channel, denom, 5, 5)
+	suite.SetNativeDenomRestrictions(restrictedDenom, channel)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	rateLimit := suite.GetNativeRateLimit(channel, denom)
+	suite.Require().Equal(sendAmount.MulRaw(2), rateLimit.Flow.Outflow)
+	suite.Require().Equal(channelValue, rateLimit.ChannelValue)
+
+	// Sending above the quota should fail. We use 2 instead of 1 here to avoid rounding issues
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, osmomath.NewInt(2)))
+	suite.Require().Error(err)
+
+	// The failed send is not counted
+	suite.Require().Equal(rateLimit, suite.GetNativeRateLimit(channel, denom))
+	return rateLimit
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNative() {
+	suite.fullNativeSendTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRateLimitingNonNative() {
+	suite.fullNativeSendTest(false)
+}
+
+// Test the rate limits of the module are reset when their period has passed
+func (suite *MiddlewareTestSuite) TestNativeSendTransferReset() {
+	rateLimit := suite.fullNativeSendTest(true)
+
+	// Move chainA forward one block
+	suite.chainA.NextBlock()
+
+	// Period end + one second
+	oneSecAfterReset := rateLimit.Flow.PeriodEnd.Add(time.Second)
+	suite.coordinator.IncrementTimeBy(oneSecAfterReset.Sub(suite.coordinator.CurrentTime))
+
+	// Sending should succeed again
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+
+	rateLimit = suite.GetNativeRateLimit("channel-0", sdk.DefaultBondDenom)
+	suite.Require().Equal(osmomath.NewInt(1), rateLimit.Flow.Outflow)
+}
+
+// Test that the rate limits of the "any" channel apply to every channel
+func (suite *MiddlewareTestSuite) TestNativeSendTransferAnyChannel() {
+	suite.initializeEscrow()
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), sdk.DefaultBondDenom, osmosisApp.BankKeeper)
+	sendAmount := channelValue.QuoRaw(100)
+
+	suite.AddNativeRateLimit(types.AnyChannel, sdk.DefaultBondDenom, 1, 1)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	_, _, err = suite.FullSendAToC(suite.MessageFromAToC(sdk.DefaultBondDenom, osmomath.NewInt(2)))
+	suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+}
+
+// Test that the denom restrictions of the module block the sends through the other channels
+func (suite *MiddlewareTestSuite) TestNativeSendTransferWithRestrictedChannel() {
+	suite.SetNativeDenomRestrictions(sdk.DefaultBondDenom, "channel-1")
+
+	_, _, err := suite.FullSendAToB(suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().ErrorContains(err, types.ErrChannelBlocked.Error())
+
+	// Receiving is not restricted
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+
+	// Sends are allowed again once the restriction is removed
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	err = osmosisApp.RateLimitingICS4Wrapper.HandleUnsetDenomRestrictionsProposal(suite.chainA.GetContext(), &types.UnsetDenomRestrictionsProposal{
+		Title:       "unset denom restrictions",
+		Description: "unset denom restrictions",
+		Denom:       sdk.DefaultBondDenom,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+}
+
+// Test rate limiting on receives with the rate limits of the module
+func (suite *MiddlewareTestSuite) fullNativeRecvTest(native bool) {
+	suite.initializeEscrow()
+	sendDenom := sdk.DefaultBondDenom
+	localDenom := sdk.DefaultBondDenom
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", sdk.DefaultBondDenom))
+	if native {
+		localDenom = denomTrace.IBCDenom()
+	} else {
+		sendDenom = denomTrace.IBCDenom()
+	}
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), localDenom, osmosisApp.BankKeeper)
+
+	// The amount to be received is 2% (quota is 4%)
+	quota := channelValue.QuoRaw(25)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.AddNativeRateLimit("channel-0", localDenom, 4, 4)
+
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	rateLimit := suite.GetNativeRateLimit("channel-0", localDenom)
+	suite.Require().Equal(sendAmount.MulRaw(2), rateLimit.Flow.Inflow)
+
+	// Receiving above the quota should fail. We send 2 instead of 1 to account for rounding errors
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sendDenom, osmomath.NewInt(2)))
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvTransferWithRateLimitingNative() {
+	suite.fullNativeRecvTest(true)
+}
+
+func (suite *MiddlewareTestSuite) TestNativeRecvTransferWithRateLimitingNonNative() {
+	suite.fullNativeRecvTest(false)
+}
+
+// Test the rate limits of the module are reverted if a "send" fails
+func (suite *MiddlewareTestSuite) TestNativeFailedSendTransfer() {
+	suite.initializeEscrow()
+	suite.AddNativeRateLimit("channel-0", sdk.DefaultBondDenom, 1, 1)
+
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	escrowed := osmosisApp.BankKeeper.GetSupplyWithOffset(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	quota := escrowed.Amount.QuoRaw(100) // 1% of the escrowed amount
+
+	// Use the whole quota with a send that fails on chain B
+	coins := sdk.NewCoin(sdk.DefaultBondDenom, quota)
+	port := suite.path.EndpointA.ChannelConfig.PortID
+	channel := suite.path.EndpointA.ChannelID
+	accountFrom := suite.chainA.SenderAccount.GetAddress().String()
+	timeoutHeight := clienttypes.NewHeight(10, 100)
+	msg := transfertypes.NewMsgTransfer(port, channel, coins, accountFrom, "INVALID", timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgsNoCheck(msg)
+	suite.Require().NoError(err)
+
+	// Sending again fails as the quota is filled
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(sdk.DefaultBondDenom, quota))
+	suite.Require().Error(err)
+
+	suite.chainA.NextBlock()
+	suite.chainA.Coordinator.IncrementTime()
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	// Relay the failed receive back to chain A
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	newRes, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(newRes.GetEvents())
+	suite.Require().NoError(err)
+	err = suite.path.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.GetNativeRateLimit("channel-0", sdk.DefaultBondDenom).Flow.Outflow.IsZero())
+
+	// We should be able to send again because the failed packet has been reverted
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+}
+
+// Test the rate limit proposals of the module
+func (suite *MiddlewareTestSuite) TestNativeRateLimitProposals() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	handler := osmosisApp.RateLimitingICS4Wrapper
+	ctx := suite.chainA.GetContext()
+	denom := sdk.DefaultBondDenom
+
+	// Removing, resetting or editing a missing path fails
+	err := handler.HandleRemoveRateLimitProposal(ctx, &types.RemoveRateLimitProposal{ChannelId: "channel-0", Denom: denom})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	err = handler.HandleResetRateLimitProposal(ctx, &types.ResetRateLimitProposal{ChannelId: "channel-0", Denom: denom, QuotaName: "weekly"})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	err = handler.HandleEditRateLimitProposal(ctx, &types.EditRateLimitProposal{ChannelId: "channel-0", Denom: denom, Quota: types.Quota{Name: "weekly"}})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	suite.AddNativeRateLimit("channel-0", denom, 1, 1)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, osmomath.NewInt(1)))
+	suite.Require().NoError(err)
+	ctx = suite.chainA.GetContext()
+
+	// Editing keeps the flow of the current period
+	editedQuota := types.Quota{Name: "weekly", MaxPercentageSend: 2, MaxPercentageRecv: 2, Duration: weeklyDuration}
+	err = handler.HandleEditRateLimitProposal(ctx, &types.EditRateLimitProposal{ChannelId: "channel-0", Denom: denom, Quota: editedQuota})
+	suite.Require().NoError(err)
+	rateLimit := suite.GetNativeRateLimit("channel-0", denom)
+	suite.Require().Equal(editedQuota, rateLimit.Quota)
+	suite.Require().Equal(osmomath.NewInt(1), rateLimit.Flow.Outflow)
+
+	// Editing an unknown quota fails
+	err = handler.HandleEditRateLimitProposal(ctx, &types.EditRateLimitProposal{ChannelId: "channel-0", Denom: denom, Quota: types.Quota{Name: "daily"}})
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	// Resetting empties the flow
+	err = handler.HandleResetRateLimitProposal(ctx, &types.ResetRateLimitProposal{ChannelId: "channel-0", Denom: denom, QuotaName: "weekly"})
+	suite.Require().NoError(err)
+	rateLimit = suite.GetNativeRateLimit("channel-0", denom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Equal(ctx.BlockTime().Add(weeklyDuration), rateLimit.Flow.PeriodEnd)
+
+	// Removing deletes the path
+	err = handler.HandleRemoveRateLimitProposal(ctx, &types.RemoveRateLimitProposal{ChannelId: "channel-0", Denom: denom})
+	suite.Require().NoError(err)
+	rateLimits, err := handler.GetRateLimits(ctx, "channel-0", denom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rateLimits)
+}
+
+// Test that the state of the rate limiting contract is imported into the module
+func (suite *MiddlewareTestSuite) TestImportContractState() {
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	channel := "channel-0"
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), denom, osmosisApp.BankKeeper)
+	quota := channelValue.QuoRaw(20)
+	sendAmount := quota.QuoRaw(2)
+
+	// Setup contract
+	suite.chainA.StoreContractCode(&suite.Suite, "./bytecode/rate_limiter.wasm")
+	quotas := suite.BuildChannelQuota("weekly", channel, denom, 604800, 5, 5)
+	addr := suite.chainA.InstantiateRLContract(&suite.Suite, quotas)
+	suite.chainA.RegisterRateLimitingContract(addr)
+	suite.SetDenomRestrictions(addr, denom, channel)
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	err = osmosisApp.RateLimitingICS4Wrapper.ImportContractState(suite.chainA.GetContext(), osmosisApp.WasmKeeper)
+	suite.Require().NoError(err)
+	suite.Require().Empty(osmosisApp.RateLimitingICS4Wrapper.GetContractAddress(suite.chainA.GetContext()))
+
+	rateLimit := suite.GetNativeRateLimit(channel, denom)
+	suite.Require().Equal(types.Quota{Name: "weekly", MaxPercentageSend: 5, MaxPercentageRecv: 5, Duration: weeklyDuration}, rateLimit.Quota)
+	suite.Require().Equal(sendAmount, rateLimit.Flow.Outflow)
+	suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+	suite.Require().Equal(channelValue, rateLimit.ChannelValue)
+	suite.Require().True(rateLimit.Flow.PeriodEnd.After(suite.chainA.GetContext().BlockTime()))
+
+	allowedChannels, err := osmosisApp.RateLimitingICS4Wrapper.GetDenomRestrictions(suite.chainA.GetContext(), denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{channel}, allowedChannels)
+
+	// The imported flow keeps counting against the quota
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, osmomath.NewInt(2)))
+	suite.Require().Error(err)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddRateLimitProposal{}, "osmosis/ibc-rate-limit/add-rate-limit-proposal", nil)
+	cdc.RegisterConcrete(&RemoveRateLimitProposal{}, "osmosis/ibc-rate-limit/remove-rate-limit-proposal", nil)
+	cdc.RegisterConcrete(&ResetRateLimitProposal{}, "osmosis/ibc-rate-limit/reset-rate-limit-proposal", nil)
+	cdc.RegisterConcrete(&EditRateLimitProposal{}, "osmosis/ibc-rate-limit/edit-rate-limit-proposal", nil)
+	cdc.RegisterConcrete(&SetDenomRestrictionsProposal{}, "osmosis/ibc-rate-limit/set-denom-restrictions-proposal", nil)
+	cdc.RegisterConcrete(&UnsetDenomRestrictionsProposal{}, "osmosis/ibc-rate-limit/unset-denom-restrictions-proposal", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypesv1.Content)(nil),
+		&AddRateLimitProposal{},
+		&RemoveRateLimitProposal{},
+		&ResetRateLimitProposal{},
+		&EditRateLimitProposal{},
+		&SetDenomRestrictionsProposal{},
+		&UnsetDenomRestrictionsProposal{},
+	)
+}
@@ -8,4 +8,7 @@ var (
 	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = errorsmod.Register(ModuleName, 3, "bad message")
 	ErrContractError     = errorsmod.Register(ModuleName, 4, "contract error")
+	ErrChannelBlocked    = errorsmod.Register(ModuleName, 5, "channel blocked for denom")
+	ErrInvalidQuota      = errorsmod.Register(ModuleName, 6, "invalid quota")
+	ErrRateLimitNotFound = errorsmod.Register(ModuleName, 7, "rate limit not found")
 )
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -13,5 +20,57 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	paths := make(map[string]bool, len(gs.PathRateLimits))
+	for _, pathRateLimits := range gs.PathRateLimits {
+		if err := pathRateLimits.Validate(); err != nil {
+			return err
+		}
+		key := string(GetPathRateLimitsKey(pathRateLimits.ChannelId, pathRateLimits.Denom))
+		if paths[key] {
+			return fmt.Errorf("duplicate rate limits for channel %s and denom %s", pathRateLimits.ChannelId, pathRateLimits.Denom)
+		}
+		paths[key] = true
+	}
+
+	denoms := make(map[string]bool, len(gs.DenomRestrictions))
+	for _, restriction := range gs.DenomRestrictions {
+		if err := restriction.Validate(); err != nil {
+			return err
+		}
+		if denoms[restriction.Denom] {
+			return fmt.Errorf("duplicate restrictions for denom %s", restriction.Denom)
+		}
+		denoms[restriction.Denom] = true
+	}
+	return nil
+}
+
+// Validate returns an error if the path or one of its quotas is invalid.
+func (p PathRateLimits) Validate() error {
+	if err := ValidatePath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+
+	quotas := make([]Quota, len(p.RateLimits))
+	for i, rateLimit := range p.RateLimits {
+		quotas[i] = rateLimit.Quota
+	}
+	return ValidateQuotas(quotas)
+}
+
+// Validate returns an error if the denom or one of the allowed channels is invalid.
+func (r DenomRestriction) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if len(r.AllowedChannels) == 0 {
+		return fmt.Errorf("no allowed channels for denom %s", r.Denom)
+	}
+	for _, channelId := range r.AllowedChannels {
+		if err := host.ChannelIdentifierValidator(channelId); err != nil {
+			return err
+		}
+	}
 	return nil
 }
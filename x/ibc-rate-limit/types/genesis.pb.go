@@ -29,6 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// path_rate_limits are the rate limits enforced by the module when the
+	// contract address param is not set
+	PathRateLimits []PathRateLimits `protobuf:"bytes,2,rep,name=path_rate_limits,json=pathRateLimits,proto3" json:"path_rate_limits" yaml:"path_rate_limits"`
+	// denom_restrictions are the channel restrictions enforced by the module when
+	// the contract address param is not set
+	DenomRestrictions []DenomRestriction `protobuf:"bytes,3,rep,name=denom_restrictions,json=denomRestrictions,proto3" json:"denom_restrictions" yaml:"denom_restrictions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +77,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPathRateLimits() []PathRateLimits {
+	if m != nil {
+		return m.PathRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomRestrictions() []DenomRestriction {
+	if m != nil {
+		return m.DenomRestrictions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.ibcratelimit.v1beta1.GenesisState")
 }
@@ -80,23 +100,30 @@ func init() {
 }
 
 var fileDescriptor_37b7c83ed1422177 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x49, 0x26,
-	0x83, 0x35, 0xc5, 0x43, 0x24, 0x20, 0x1c, 0x98, 0x54, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e,
-	0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x95, 0xd2, 0xc4, 0xeb, 0xaa, 0x82, 0xc4,
-	0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x4a, 0x41, 0x5c, 0x3c, 0xee, 0x10, 0x57, 0x06, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x54, 0xf4,
-	0xf0, 0xb9, 0x5a, 0x2f, 0x00, 0xac, 0xd6, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x4e,
-	0xa7, 0x90, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4a, 0xcf, 0x2c,
-	0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x9a, 0xab, 0x9b, 0x93, 0x98, 0x54, 0x0c,
-	0xe3, 0xe8, 0x97, 0x19, 0x1b, 0xea, 0x57, 0x80, 0x9c, 0xad, 0x0b, 0xb2, 0x4b, 0x17, 0xe2, 0xf0,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x83, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xb7, 0x08, 0xdf, 0x26, 0x73, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x18, 0x86, 0x5b, 0x30, 0x0c, 0xc5, 0x18, 0x6d, 0x4c, 0x04, 0x62, 0x0a, 0x36, 0x0e, 0x68, 0x6c,
+	0x2f, 0xc0, 0xc6, 0xd8, 0x98, 0xb8, 0x38, 0x98, 0xea, 0xe4, 0xd2, 0x5c, 0xcb, 0x59, 0x2e, 0x69,
+	0x7b, 0x4d, 0xef, 0x03, 0x65, 0xf3, 0x27, 0xf8, 0xb3, 0x98, 0x0c, 0xa3, 0x13, 0x31, 0xf0, 0x0f,
+	0xfc, 0x05, 0xa6, 0x77, 0x25, 0x28, 0x26, 0x75, 0xeb, 0xd7, 0xef, 0x7d, 0xde, 0xf7, 0xbd, 0x7c,
+	0xda, 0x25, 0xe3, 0x31, 0xe3, 0x94, 0x23, 0xea, 0x07, 0x19, 0x06, 0x12, 0xd1, 0x98, 0x02, 0x9a,
+	0xf6, 0x7c, 0x02, 0xb8, 0x87, 0x42, 0x92, 0x10, 0x4e, 0xb9, 0x9d, 0x66, 0x0c, 0x98, 0x7e, 0x5a,
+	0x68, 0xed, 0x9f, 0x5a, 0xbb, 0xd0, 0xb6, 0x8e, 0x43, 0x16, 0x32, 0x21, 0x44, 0xf9, 0x97, 0x64,
+	0x5a, 0xcd, 0x40, 0x40, 0x9e, 0x5c, 0xc8, 0x61, 0xb3, 0x0a, 0x19, 0x0b, 0x23, 0x82, 0xc4, 0xe4,
+	0x4f, 0x9e, 0x10, 0x4e, 0x66, 0xc5, 0xea, 0xa2, 0xb4, 0x55, 0x8a, 0x33, 0x1c, 0x6f, 0x5c, 0xac,
+	0x52, 0x69, 0xfe, 0xc7, 0x93, 0x3d, 0x85, 0xdc, 0x7c, 0xaf, 0x68, 0xfb, 0x37, 0xf2, 0x55, 0xf7,
+	0x80, 0x81, 0xe8, 0x8e, 0x56, 0x93, 0x7e, 0x0d, 0xb5, 0xa3, 0x76, 0xeb, 0xfd, 0x73, 0xbb, 0xec,
+	0x95, 0xf6, 0x9d, 0xd0, 0x3a, 0x7b, 0xf3, 0x65, 0x5b, 0x71, 0x0b, 0x52, 0x7f, 0xd6, 0x0e, 0x53,
+	0x0c, 0x63, 0x6f, 0x9b, 0xc6, 0x1b, 0x95, 0x4e, 0xb5, 0x5b, 0xef, 0x5f, 0xfd, 0xe7, 0x06, 0x63,
+	0x17, 0x03, 0xb9, 0x15, 0x8c, 0xd3, 0xce, 0x5d, 0xbf, 0x96, 0xed, 0x93, 0x19, 0x8e, 0xa3, 0xa1,
+	0xb9, 0xeb, 0x69, 0xba, 0x07, 0xe9, 0x2f, 0x40, 0x7f, 0x55, 0x35, 0x7d, 0x44, 0x12, 0x16, 0x7b,
+	0x19, 0xe1, 0x90, 0xd1, 0x00, 0x28, 0x4b, 0x78, 0xa3, 0x2a, 0xb2, 0xed, 0xf2, 0xec, 0xeb, 0x9c,
+	0x73, 0xb7, 0x98, 0x73, 0x56, 0xa4, 0x37, 0x65, 0xfa, 0x5f, 0x5f, 0xd3, 0x3d, 0x1a, 0xed, 0x40,
+	0xdc, 0x79, 0x98, 0xaf, 0x0c, 0x75, 0xb1, 0x32, 0xd4, 0xcf, 0x95, 0xa1, 0xbe, 0xad, 0x0d, 0x65,
+	0xb1, 0x36, 0x94, 0x8f, 0xb5, 0xa1, 0x3c, 0x0e, 0x43, 0x0a, 0xe3, 0x89, 0x6f, 0x07, 0x2c, 0x46,
+	0x45, 0x13, 0x2b, 0xc2, 0x3e, 0xdf, 0x0c, 0x68, 0x3a, 0xe8, 0xa1, 0x97, 0xfc, 0x6e, 0x56, 0xde,
+	0xce, 0x92, 0x97, 0x83, 0x59, 0x4a, 0xb8, 0x5f, 0x13, 0xd7, 0x1a, 0x7c, 0x0f, 0x00, 0xc1, 0xf4,
+	0x09, 0x79, 0x9f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +146,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomRestrictions) > 0 {
+		for iNdEx := len(m.DenomRestrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRestrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PathRateLimits) > 0 {
+		for iNdEx := len(m.PathRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -151,6 +206,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PathRateLimits) > 0 {
+		for _, e := range m.PathRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomRestrictions) > 0 {
+		for _, e := range m.DenomRestrictions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -222,6 +289,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathRateLimits = append(m.PathRateLimits, PathRateLimits{})
+			if err := m.PathRateLimits[len(m.PathRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRestrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRestrictions = append(m.DenomRestrictions, DenomRestriction{})
+			if err := m.DenomRestrictions[len(m.DenomRestrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,333 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	ProposalTypeAddRateLimit           = "AddRateLimit"
+	ProposalTypeRemoveRateLimit        = "RemoveRateLimit"
+	ProposalTypeResetRateLimit         = "ResetRateLimit"
+	ProposalTypeEditRateLimit          = "EditRateLimit"
+	ProposalTypeSetDenomRestrictions   = "SetDenomRestrictions"
+	ProposalTypeUnsetDenomRestrictions = "UnsetDenomRestrictions"
+)
+
+func init() {
+	govtypesv1.RegisterProposalType(ProposalTypeAddRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeResetRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeEditRateLimit)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDenomRestrictions)
+	govtypesv1.RegisterProposalType(ProposalTypeUnsetDenomRestrictions)
+}
+
+var (
+	_ govtypesv1.Content = &AddRateLimitProposal{}
+	_ govtypesv1.Content = &RemoveRateLimitProposal{}
+	_ govtypesv1.Content = &ResetRateLimitProposal{}
+	_ govtypesv1.Content = &EditRateLimitProposal{}
+	_ govtypesv1.Content = &SetDenomRestrictionsProposal{}
+	_ govtypesv1.Content = &UnsetDenomRestrictionsProposal{}
+)
+
+// ValidatePath returns an error if the channel id is neither a valid channel identifier
+// nor the "any" channel, or if the denom is invalid.
+func ValidatePath(channelId, denom string) error {
+	if channelId != AnyChannel {
+		if err := host.ChannelIdentifierValidator(channelId); err != nil {
+			return err
+		}
+	}
+	return sdk.ValidateDenom(denom)
+}
+
+// NewAddRateLimitProposal returns a new instance of an add rate limit proposal struct.
+func NewAddRateLimitProposal(title, description, channelId, denom string, quotas []Quota) govtypesv1.Content {
+	return &AddRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+		Quotas:      quotas,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *AddRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *AddRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *AddRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *AddRateLimitProposal) ProposalType() string { return ProposalTypeAddRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *AddRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := ValidatePath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	return ValidateQuotas(p.Quotas)
+}
+
+// String returns a string containing the add rate limit proposal.
+func (p AddRateLimitProposal) String() string {
+	quotasStr := ""
+	for _, quota := range p.Quotas {
+		quotasStr = quotasStr + quotaString(quota)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel ID:  %s
+Denom:       %s
+Quotas:      %s
+`, p.Title, p.Description, p.ChannelId, p.Denom, quotasStr))
+	return b.String()
+}
+
+// NewRemoveRateLimitProposal returns a new instance of a remove rate limit proposal struct.
+func NewRemoveRateLimitProposal(title, description, channelId, denom string) govtypesv1.Content {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *RemoveRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *RemoveRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *RemoveRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return ValidatePath(p.ChannelId, p.Denom)
+}
+
+// String returns a string containing the remove rate limit proposal.
+func (p RemoveRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel ID:  %s
+Denom:       %s
+`, p.Title, p.Description, p.ChannelId, p.Denom))
+	return b.String()
+}
+
+// NewResetRateLimitProposal returns a new instance of a reset rate limit proposal struct.
+func NewResetRateLimitProposal(title, description, channelId, denom, quotaName string) govtypesv1.Content {
+	return &ResetRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+		QuotaName:   quotaName,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *ResetRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *ResetRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *ResetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *ResetRateLimitProposal) ProposalType() string { return ProposalTypeResetRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *ResetRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.QuotaName == "" {
+		return ErrInvalidQuota.Wrap("quota name cannot be empty")
+	}
+	return ValidatePath(p.ChannelId, p.Denom)
+}
+
+// String returns a string containing the reset rate limit proposal.
+func (p ResetRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Reset Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel ID:  %s
+Denom:       %s
+Quota Name:  %s
+`, p.Title, p.Description, p.ChannelId, p.Denom, p.QuotaName))
+	return b.String()
+}
+
+// NewEditRateLimitProposal returns a new instance of an edit rate limit proposal struct.
+func NewEditRateLimitProposal(title, description, channelId, denom string, quota Quota) govtypesv1.Content {
+	return &EditRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelId,
+		Denom:       denom,
+		Quota:       quota,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *EditRateLimitProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *EditRateLimitProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *EditRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *EditRateLimitProposal) ProposalType() string { return ProposalTypeEditRateLimit }
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *EditRateLimitProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if err := ValidatePath(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	return p.Quota.Validate()
+}
+
+// String returns a string containing the edit rate limit proposal.
+func (p EditRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Edit Rate Limit Proposal:
+Title:       %s
+Description: %s
+Channel ID:  %s
+Denom:       %s
+Quota:       %s
+`, p.Title, p.Description, p.ChannelId, p.Denom, quotaString(p.Quota)))
+	return b.String()
+}
+
+// NewSetDenomRestrictionsProposal returns a new instance of a set denom restrictions proposal struct.
+func NewSetDenomRestrictionsProposal(title, description, denom string, allowedChannels []string) govtypesv1.Content {
+	return &SetDenomRestrictionsProposal{
+		Title:           title,
+		Description:     description,
+		Denom:           denom,
+		AllowedChannels: allowedChannels,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDenomRestrictionsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDenomRestrictionsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDenomRestrictionsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDenomRestrictionsProposal) ProposalType() string {
+	return ProposalTypeSetDenomRestrictions
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *SetDenomRestrictionsProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return DenomRestriction{Denom: p.Denom, AllowedChannels: p.AllowedChannels}.Validate()
+}
+
+// String returns a string containing the set denom restrictions proposal.
+func (p SetDenomRestrictionsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Restrictions Proposal:
+Title:            %s
+Description:      %s
+Denom:            %s
+Allowed Channels: %s
+`, p.Title, p.Description, p.Denom, strings.Join(p.AllowedChannels, ", ")))
+	return b.String()
+}
+
+// NewUnsetDenomRestrictionsProposal returns a new instance of an unset denom restrictions proposal struct.
+func NewUnsetDenomRestrictionsProposal(title, description, denom string) govtypesv1.Content {
+	return &UnsetDenomRestrictionsProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *UnsetDenomRestrictionsProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *UnsetDenomRestrictionsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *UnsetDenomRestrictionsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *UnsetDenomRestrictionsProposal) ProposalType() string {
+	return ProposalTypeUnsetDenomRestrictions
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *UnsetDenomRestrictionsProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(p.Denom)
+}
+
+// String returns a string containing the unset denom restrictions proposal.
+func (p UnsetDenomRestrictionsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Unset Denom Restrictions Proposal:
+Title:       %s
+Description: %s
+Denom:       %s
+`, p.Title, p.Description, p.Denom))
+	return b.String()
+}
+
+func quotaString(quota Quota) string {
+	return fmt.Sprintf("(Name: %s, MaxPercentageSend: %d, MaxPercentageRecv: %d, Duration: %s) ",
+		quota.Name, quota.MaxPercentageSend, quota.MaxPercentageRecv, quota.Duration)
+}
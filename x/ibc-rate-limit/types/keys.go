@@ -5,8 +5,30 @@ import "strings"
 const (
 	ModuleName = "rate-limited-ibc" // IBC at the end to avoid conflicts with the ibc prefix
 
+	// StoreKey is the store of the rate limits enforced by the module when the contract is not configured.
+	StoreKey = ModuleName
+
+	// AnyChannel is the channel id of the rate limits applying to the transfers through every channel.
+	AnyChannel = "any"
+
+	KeySeparator = "|"
+)
+
+var (
+	// RouterKey is the message route. Can only contain
+	// alphanumeric characters.
+	RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+	PathRateLimitsPrefix   = []byte{0x01}
+	DenomRestrictionPrefix = []byte{0x02}
 )
 
-// RouterKey is the message route. Can only contain
-// alphanumeric characters.
-var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+// GetPathRateLimitsKey returns the key of the rate limits of the transfers of the denom through the channel.
+func GetPathRateLimitsKey(channelId, denom string) []byte {
+	return append(PathRateLimitsPrefix, []byte(channelId+KeySeparator+denom)...)
+}
+
+// GetDenomRestrictionKey returns the key of the channels allowed to send the denom.
+func GetDenomRestrictionKey(denom string) []byte {
+	return append(DenomRestrictionPrefix, []byte(denom)...)
+}
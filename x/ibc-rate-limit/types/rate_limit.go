@@ -0,0 +1,143 @@
+package types
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// FlowDirection is the direction of a transfer relative to the chain.
+type FlowDirection int
+
+const (
+	FlowIn FlowDirection = iota
+	FlowOut
+)
+
+func (d FlowDirection) String() string {
+	if d == FlowIn {
+		return "in"
+	}
+	return "out"
+}
+
+// Validate returns an error if the quota has no name, a percentage above 100 or no duration.
+func (q Quota) Validate() error {
+	if q.Name == "" {
+		return ErrInvalidQuota.Wrap("quota name cannot be empty")
+	}
+	if q.MaxPercentageSend > 100 || q.MaxPercentageRecv > 100 {
+		return ErrInvalidQuota.Wrapf("quota %s percentages cannot be above 100", q.Name)
+	}
+	if q.Duration <= 0 {
+		return ErrInvalidQuota.Wrapf("quota %s duration must be positive", q.Name)
+	}
+	return nil
+}
+
+// ValidateQuotas returns an error if a quota is invalid or if two quotas have the same name.
+func ValidateQuotas(quotas []Quota) error {
+	if len(quotas) == 0 {
+		return ErrInvalidQuota.Wrap("no quotas")
+	}
+
+	names := make(map[string]bool, len(quotas))
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if names[quota.Name] {
+			return ErrInvalidQuota.Wrapf("duplicate quota name %s", quota.Name)
+		}
+		names[quota.Name] = true
+	}
+	return nil
+}
+
+// NewFlow returns an empty flow for the period ending at periodEnd.
+func NewFlow(periodEnd time.Time) Flow {
+	return Flow{
+		Inflow:    osmomath.ZeroInt(),
+		Outflow:   osmomath.ZeroInt(),
+		PeriodEnd: periodEnd,
+	}
+}
+
+// Balance returns the net amount transferred in the direction in the current period, or zero
+// if more was transferred in the opposite direction.
+func (f Flow) Balance(direction FlowDirection) osmomath.Int {
+	if direction == FlowIn {
+		return osmomath.MaxInt(f.Inflow.Sub(f.Outflow), osmomath.ZeroInt())
+	}
+	return osmomath.MaxInt(f.Outflow.Sub(f.Inflow), osmomath.ZeroInt())
+}
+
+// IsExpired returns true if the period of the flow ended before now.
+func (f Flow) IsExpired(now time.Time) bool {
+	return f.PeriodEnd.Before(now)
+}
+
+// AddFlow adds the amount to the flow of the direction.
+func (f *Flow) AddFlow(direction FlowDirection, amount osmomath.Int) {
+	if direction == FlowIn {
+		f.Inflow = f.Inflow.Add(amount)
+	} else {
+		f.Outflow = f.Outflow.Add(amount)
+	}
+}
+
+// UndoFlow removes the amount from the flow of the direction, without going below zero.
+func (f *Flow) UndoFlow(direction FlowDirection, amount osmomath.Int) {
+	if direction == FlowIn {
+		f.Inflow = osmomath.MaxInt(f.Inflow.Sub(amount), osmomath.ZeroInt())
+	} else {
+		f.Outflow = osmomath.MaxInt(f.Outflow.Sub(amount), osmomath.ZeroInt())
+	}
+}
+
+// NewRateLimit returns the rate limit of the quota with an empty flow for the period starting now.
+func NewRateLimit(quota Quota, now time.Time) RateLimit {
+	return RateLimit{
+		Quota:        quota,
+		Flow:         NewFlow(now.Add(quota.Duration)),
+		ChannelValue: osmomath.ZeroInt(),
+	}
+}
+
+// Capacity returns the maximum net amount that can be transferred in the direction in a period.
+func (r RateLimit) Capacity(direction FlowDirection) osmomath.Int {
+	percentage := r.Quota.MaxPercentageSend
+	if direction == FlowIn {
+		percentage = r.Quota.MaxPercentageRecv
+	}
+	return r.ChannelValue.MulRaw(int64(percentage)).QuoRaw(100)
+}
+
+// ResetFlow empties the flow of the rate limit and starts a new period now.
+func (r *RateLimit) ResetFlow(now time.Time) {
+	r.Flow = NewFlow(now.Add(r.Quota.Duration))
+}
+
+// AllowTransfer applies the transfer to the flow of the rate limit, starting a new period with
+// the given channel value if the current one has ended. It returns an error if the net amount
+// transferred in the direction exceeds the capacity of the quota.
+func (r *RateLimit) AllowTransfer(direction FlowDirection, amount, channelValue osmomath.Int, now time.Time) error {
+	expired := r.Flow.IsExpired(now)
+	if expired {
+		r.Flow = NewFlow(now.Add(r.Quota.Duration))
+	}
+	used := r.Flow.Balance(direction)
+	r.Flow.AddFlow(direction, amount)
+
+	// The channel value is cached for the whole period
+	if r.ChannelValue.IsZero() || expired {
+		r.ChannelValue = channelValue
+	}
+
+	capacity := r.Capacity(direction)
+	if r.Flow.Balance(direction).GT(capacity) {
+		return ErrRateLimitExceeded.Wrapf("quota %s, direction %s, amount %s, used %s, max %s, resets at %s",
+			r.Quota.Name, direction, amount, used, capacity, r.Flow.PeriodEnd)
+	}
+	return nil
+}
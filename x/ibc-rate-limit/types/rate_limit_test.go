@@ -0,0 +1,117 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestAllowTransfer(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	quota := Quota{Name: "daily", MaxPercentageSend: 10, MaxPercentageRecv: 5, Duration: 24 * time.Hour}
+
+	testCases := map[string]struct {
+		flow         Flow
+		channelValue osmomath.Int
+		direction    FlowDirection
+		amount       osmomath.Int
+		time         time.Time
+
+		expectedFlow         Flow
+		expectedChannelValue osmomath.Int
+		expectErr            bool
+	}{
+		"send within quota": {
+			flow:         NewFlow(now.Add(time.Hour)),
+			channelValue: osmomath.NewInt(1000),
+			direction:    FlowOut,
+			amount:       osmomath.NewInt(100),
+			time:         now,
+
+			expectedFlow:         Flow{Inflow: osmomath.ZeroInt(), Outflow: osmomath.NewInt(100), PeriodEnd: now.Add(time.Hour)},
+			expectedChannelValue: osmomath.NewInt(1000),
+		},
+		"send above quota": {
+			flow:         NewFlow(now.Add(time.Hour)),
+			channelValue: osmomath.NewInt(1000),
+			direction:    FlowOut,
+			amount:       osmomath.NewInt(101),
+			time:         now,
+			expectErr:    true,
+		},
+		"recv uses the recv percentage": {
+			flow:         NewFlow(now.Add(time.Hour)),
+			channelValue: osmomath.NewInt(1000),
+			direction:    FlowIn,
+			amount:       osmomath.NewInt(51),
+			time:         now,
+			expectErr:    true,
+		},
+		"inflow offsets outflow": {
+			flow:         Flow{Inflow: osmomath.NewInt(50), Outflow: osmomath.NewInt(50), PeriodEnd: now.Add(time.Hour)},
+			channelValue: osmomath.NewInt(1000),
+			direction:    FlowOut,
+			amount:       osmomath.NewInt(100),
+			time:         now,
+
+			expectedFlow:         Flow{Inflow: osmomath.NewInt(50), Outflow: osmomath.NewInt(150), PeriodEnd: now.Add(time.Hour)},
+			expectedChannelValue: osmomath.NewInt(1000),
+		},
+		"expired flow starts a new period": {
+			flow:         Flow{Inflow: osmomath.ZeroInt(), Outflow: osmomath.NewInt(100), PeriodEnd: now.Add(-time.Second)},
+			channelValue: osmomath.NewInt(2000),
+			direction:    FlowOut,
+			amount:       osmomath.NewInt(200),
+			time:         now,
+
+			expectedFlow:         Flow{Inflow: osmomath.ZeroInt(), Outflow: osmomath.NewInt(200), PeriodEnd: now.Add(24 * time.Hour)},
+			expectedChannelValue: osmomath.NewInt(2000),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rateLimit := RateLimit{Quota: quota, Flow: tc.flow, ChannelValue: osmomath.ZeroInt()}
+
+			err := rateLimit.AllowTransfer(tc.direction, tc.amount, tc.channelValue, tc.time)
+
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrRateLimitExceeded)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFlow, rateLimit.Flow)
+			require.Equal(t, tc.expectedChannelValue, rateLimit.ChannelValue)
+		})
+	}
+}
+
+func TestValidateQuotas(t *testing.T) {
+	valid := Quota{Name: "weekly", MaxPercentageSend: 10, MaxPercentageRecv: 10, Duration: 7 * 24 * time.Hour}
+
+	testCases := map[string]struct {
+		quotas    []Quota
+		expectErr bool
+	}{
+		"valid":                {quotas: []Quota{valid}},
+		"no quotas":            {quotas: []Quota{}, expectErr: true},
+		"duplicate name":       {quotas: []Quota{valid, valid}, expectErr: true},
+		"empty name":           {quotas: []Quota{{MaxPercentageSend: 1, Duration: time.Hour}}, expectErr: true},
+		"percentage above 100": {quotas: []Quota{{Name: "a", MaxPercentageSend: 101, Duration: time.Hour}}, expectErr: true},
+		"no duration":          {quotas: []Quota{{Name: "a", MaxPercentageSend: 1}}, expectErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateQuotas(tc.quotas)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidQuota)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
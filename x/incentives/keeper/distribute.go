@@ -26,6 +26,10 @@ var (
 	zeroInt              = osmomath.ZeroInt()
 )
 
+// distributionBatchSize is the number of gauges distributed to at once when the distribution
+// of an epoch is split across blocks.
+const distributionBatchSize = 100
+
 // DistributionValueCache is a cache for when we calculate the minimum value
 // an underlying token must be to be distributed.
 type DistributionValueCache struct {
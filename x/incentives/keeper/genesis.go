@@ -33,6 +33,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	for _, group := range genState.Groups {
 		k.SetGroup(ctx, group)
 	}
+
+	if genState.PendingDistribution != nil {
+		k.setPendingDistribution(ctx, *genState.PendingDistribution)
+	}
 }
 
 // ExportGenesis returns the x/incentives module's exported genesis.
@@ -47,7 +51,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return &types.GenesisState{
+	genesis := &types.GenesisState{
 		Params:            k.GetParams(ctx),
 		LockableDurations: k.GetLockableDurations(ctx),
 		Gauges:            k.GetNotFinishedGauges(ctx),
@@ -55,4 +59,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		GroupGauges:       groupGauges,
 		Groups:            groups,
 	}
+
+	pendingDistribution, found, err := k.getPendingDistribution(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.PendingDistribution = &pendingDistribution
+	}
+
+	return genesis
 }
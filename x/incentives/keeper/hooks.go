@@ -16,7 +16,19 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 }
 
 // AfterEpochEnd is the epoch end hook.
+// It distributes to all gauges due for the epoch within the block.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if err := k.startEpochDistribution(ctx, epochIdentifier, epochNumber); err != nil {
+		return err
+	}
+	_, err := k.continueEpochDistribution(ctx, epochIdentifier, epochNumber, epochstypes.UnlimitedEpochWorkBudget())
+	return err
+}
+
+// startEpochDistribution allocates group incentives, activates upcoming gauges whose start time passed,
+// and records the active gauges to distribute to for the ended epoch. The distribution itself is done
+// by continueEpochDistribution.
+func (k Keeper) startEpochDistribution(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	params := k.GetParams(ctx)
 
 	if epochIdentifier == params.DistrEpochIdentifier {
@@ -52,22 +64,57 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		// only distribute to active gauges that are for native denoms
 		// or non-perpetual and for synthetic denoms.
 		// We distribute to perpetual synthetic denoms elsewhere in superfluid.
-		distrGauges := []types.Gauge{}
+		distrGaugeIds := []uint64{}
 		for _, gauge := range gauges {
 			isSynthetic := lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom)
 			if !(isSynthetic && gauge.IsPerpetual) {
-				distrGauges = append(distrGauges, gauge)
+				distrGaugeIds = append(distrGaugeIds, gauge.Id)
 			}
 		}
 
-		ctx.Logger().Info("x/incentives AfterEpochEnd: distributing to gauges", "module", types.ModuleName, "numGauges", len(distrGauges), "height", ctx.BlockHeight())
-		_, err = k.Distribute(ctx, distrGauges)
+		ctx.Logger().Info("x/incentives AfterEpochEnd: distributing to gauges", "module", types.ModuleName, "numGauges", len(distrGaugeIds), "height", ctx.BlockHeight())
+		k.setPendingDistribution(ctx, types.PendingDistribution{
+			EpochIdentifier: epochIdentifier,
+			EpochNumber:     epochNumber,
+			GaugeIds:        distrGaugeIds,
+		})
+	}
+	return nil
+}
+
+// continueEpochDistribution distributes to the gauges recorded by startEpochDistribution for the given epoch,
+// in batches of distributionBatchSize gauges, until the budget is exhausted.
+// Returns true once all gauges were distributed to, or if there is no distribution for the epoch.
+func (k Keeper) continueEpochDistribution(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochstypes.EpochWorkBudget) (bool, error) {
+	pendingDistribution, found, err := k.getPendingDistribution(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !found || pendingDistribution.EpochIdentifier != epochIdentifier || pendingDistribution.EpochNumber != epochNumber {
+		return true, nil
+	}
+
+	for len(pendingDistribution.GaugeIds) > 0 {
+		batchSize := min(distributionBatchSize, len(pendingDistribution.GaugeIds))
+		gauges, err := k.GetGaugeFromIDs(ctx, pendingDistribution.GaugeIds[:batchSize])
 		if err != nil {
-			return err
+			return false, err
+		}
+		if _, err := k.Distribute(ctx, gauges); err != nil {
+			return false, err
+		}
+		pendingDistribution.GaugeIds = pendingDistribution.GaugeIds[batchSize:]
+
+		if len(pendingDistribution.GaugeIds) > 0 && budget.Exhausted(ctx) {
+			k.setPendingDistribution(ctx, pendingDistribution)
+			ctx.Logger().Info("x/incentives distribution continues in the next block", "module", types.ModuleName, "numGauges", len(pendingDistribution.GaugeIds), "height", ctx.BlockHeight())
+			return false, nil
 		}
-		ctx.Logger().Info("x/incentives AfterEpochEnd finished distribution")
 	}
-	return nil
+
+	k.deletePendingDistribution(ctx)
+	ctx.Logger().Info("x/incentives AfterEpochEnd finished distribution")
+	return true, nil
 }
 
 // ___________________________________________________________________________________________________
@@ -77,7 +124,7 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var _ epochstypes.EpochWorkHooks = Hooks{}
 
 // Hooks returns the hook wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -95,6 +142,12 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 }
 
 // AfterEpochEnd is the epoch end hook.
+// The distribution to gauges is done by ContinueEpochWork, which may split it across blocks.
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
-	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	return h.k.startEpochDistribution(ctx, epochIdentifier, epochNumber)
+}
+
+// ContinueEpochWork implements epochstypes.EpochWorkHooks.
+func (h Hooks) ContinueEpochWork(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochstypes.EpochWorkBudget) (bool, error) {
+	return h.k.continueEpochDistribution(ctx, epochIdentifier, epochNumber, budget)
 }
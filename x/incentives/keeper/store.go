@@ -196,3 +196,20 @@ func (k Keeper) GetGroupByGaugeID(ctx sdk.Context, gaugeID uint64) (types.Group,
 
 	return group, nil
 }
+
+// getPendingDistribution returns the gauges left to distribute to for an ended epoch, if any.
+func (k Keeper) getPendingDistribution(ctx sdk.Context) (types.PendingDistribution, bool, error) {
+	pendingDistribution := types.PendingDistribution{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPendingDistribution, &pendingDistribution)
+	return pendingDistribution, found, err
+}
+
+// setPendingDistribution sets the gauges left to distribute to for an ended epoch.
+func (k Keeper) setPendingDistribution(ctx sdk.Context, pendingDistribution types.PendingDistribution) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPendingDistribution, &pendingDistribution)
+}
+
+// deletePendingDistribution deletes the gauges left to distribute to for an ended epoch.
+func (k Keeper) deletePendingDistribution(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPendingDistribution)
+}
@@ -195,50 +195,120 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 	return nil
 }
 
+// PendingDistribution holds the gauges left to distribute to for an ended
+// distribution epoch, whose distribution is split across blocks.
+type PendingDistribution struct {
+	// epoch_identifier is the identifier of the ended epoch.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the ended epoch.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// gauge_ids are the ids of the gauges left to distribute to, in order.
+	GaugeIds []uint64 `protobuf:"varint,3,rep,packed,name=gauge_ids,json=gaugeIds,proto3" json:"gauge_ids,omitempty"`
+}
+
+func (m *PendingDistribution) Reset()         { *m = PendingDistribution{} }
+func (m *PendingDistribution) String() string { return proto.CompactTextString(m) }
+func (*PendingDistribution) ProtoMessage()    {}
+func (*PendingDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{2}
+}
+func (m *PendingDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDistribution.Merge(m, src)
+}
+func (m *PendingDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDistribution proto.InternalMessageInfo
+
+func (m *PendingDistribution) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PendingDistribution) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PendingDistribution) GetGaugeIds() []uint64 {
+	if m != nil {
+		return m.GaugeIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
+	proto.RegisterType((*PendingDistribution)(nil), "osmosis.incentives.PendingDistribution")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0xa6, 0x9b, 0xc4, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xc8, 0xe3, 0x8a, 0x8d, 0xa8, 0x0f, 0xa2, 0x6a, 0xd0, 0xa9, 0x56, 0x8d, 0xc0, 0x9f, 0xe7,
-	0xa9, 0x3e, 0x8c, 0x34, 0xf8, 0x5e, 0x47, 0x8d, 0x0f, 0xc5, 0xd6, 0xf8, 0x09, 0xda, 0x13, 0x81,
-	0x6d, 0xf5, 0xad, 0x61, 0x7d, 0xba, 0x27, 0x02, 0xfc, 0x02, 0xb5, 0x84, 0x74, 0x53, 0x9e, 0xa5,
-	0x5c, 0xe5, 0x2c, 0xb2, 0xf7, 0xfa, 0xd6, 0xf0, 0x70, 0xda, 0x14, 0x72, 0x52, 0x85, 0xf0, 0x05,
-	0x3a, 0x0a, 0x84, 0x54, 0x99, 0xf0, 0x72, 0xc5, 0x5d, 0x05, 0xf6, 0x7e, 0xdf, 0x1a, 0x36, 0x4f,
-	0x1d, 0x52, 0x59, 0x37, 0xf3, 0xc8, 0xe7, 0x9c, 0x67, 0xcb, 0x73, 0x48, 0x02, 0x51, 0xb8, 0x1a,
-	0xd7, 0x6f, 0xef, 0x7b, 0xb5, 0x69, 0x6b, 0x53, 0x7a, 0x09, 0x98, 0xa1, 0x46, 0xb1, 0xb0, 0xb4,
-	0xeb, 0xfd, 0xfd, 0x61, 0xf3, 0xb4, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0xbf, 0x2e, 0xaa, 0x7f, 0x3e, 0xf4, 0x86, 0xa1, 0x50, 0x57, 0xb9, 0x47, 0x7c, 0x88,
-	0x69, 0xe9, 0xdf, 0x1c, 0x27, 0x32, 0x98, 0x53, 0xb5, 0x4c, 0xb9, 0xd4, 0x05, 0x72, 0x6a, 0x3a,
-	0xe3, 0x2f, 0x08, 0x49, 0xc5, 0x32, 0xe5, 0x16, 0xf8, 0xec, 0x86, 0x5e, 0xb5, 0x4b, 0x0c, 0x5b,
-	0x52, 0xb1, 0x25, 0x97, 0x15, 0xdb, 0xf1, 0xf3, 0x62, 0xd0, 0x9f, 0xfb, 0x5e, 0x7b, 0xc9, 0xe2,
-	0xe8, 0xed, 0x60, 0x53, 0x3b, 0xb8, 0x79, 0xe8, 0x59, 0xd3, 0xc7, 0x3a, 0x50, 0xa4, 0x63, 0x8a,
-	0x8e, 0x93, 0x3c, 0x76, 0x79, 0x0a, 0xfe, 0x95, 0x74, 0x53, 0x26, 0x02, 0x17, 0x16, 0x3c, 0xb3,
-	0x0f, 0x34, 0xcc, 0x76, 0x92, 0xc7, 0xef, 0xb5, 0x34, 0x61, 0x22, 0xf8, 0xb4, 0xe0, 0x19, 0x7e,
-	0x89, 0x8e, 0x66, 0x22, 0x8a, 0x78, 0x50, 0xd6, 0xd8, 0x8f, 0x74, 0x66, 0xcb, 0x04, 0x4d, 0x32,
-	0xbe, 0x46, 0xed, 0x0d, 0xa2, 0xc0, 0x35, 0x78, 0x0e, 0xff, 0x3f, 0x9e, 0xa7, 0x5b, 0x53, 0x74,
-	0x64, 0xf0, 0xcd, 0x42, 0xcf, 0x3e, 0x82, 0x3f, 0x67, 0x5e, 0xc4, 0xdf, 0x95, 0x6f, 0x51, 0x5e,
-	0x24, 0x33, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0xed, 0xb2,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x1d, 0x83, 0xf2, 0xdf, 0x16, 0x83, 0x1f, 0x05, 0xd2, 0x76,
-	0xb4, 0x3b, 0x74, 0x3c, 0xb9, 0x5d, 0x39, 0xd6, 0xdd, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0x66,
-	0xed, 0xd4, 0xee, 0xd6, 0x4e, 0xed, 0xd7, 0xda, 0xa9, 0x7d, 0x7d, 0xb3, 0x65, 0xb0, 0x7c, 0x6f,
-	0x27, 0x11, 0xf3, 0x64, 0x75, 0xa1, 0x8b, 0xb3, 0x11, 0xbd, 0xde, 0xfe, 0x3b, 0xb5, 0x69, 0xef,
-	0x40, 0xaf, 0x77, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x7e, 0xd4, 0x7a, 0xc0, 0x03, 0x00,
-	0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0xd4, 0x40,
+	0x1c, 0xc7, 0xb7, 0xec, 0x82, 0x30, 0x0b, 0xca, 0x8e, 0x98, 0x14, 0x8c, 0xdd, 0x75, 0x8d, 0x49,
+	0x3d, 0xd0, 0x11, 0x48, 0x3c, 0x78, 0x5c, 0x30, 0x66, 0x13, 0xa3, 0x6b, 0xc3, 0xc1, 0x78, 0x69,
+	0xa6, 0x9d, 0xd9, 0x32, 0xa1, 0x9d, 0x69, 0x3a, 0xd3, 0x0d, 0xdc, 0x3c, 0x7a, 0x24, 0x9e, 0x7c,
+	0x06, 0x9f, 0x84, 0x23, 0x47, 0x4f, 0x60, 0xe0, 0x0d, 0x7c, 0x02, 0x33, 0x33, 0xad, 0x4b, 0xf0,
+	0xea, 0xa9, 0xdb, 0xef, 0xf7, 0xf7, 0xf7, 0x93, 0x5f, 0x17, 0x78, 0x42, 0xe6, 0x42, 0x32, 0x89,
+	0x18, 0x4f, 0x28, 0x57, 0x6c, 0x46, 0x25, 0x4a, 0x71, 0x95, 0xd2, 0xa0, 0x28, 0x85, 0x12, 0x10,
+	0xd6, 0x7e, 0x30, 0xf7, 0xb7, 0x36, 0x52, 0x91, 0x0a, 0x63, 0x23, 0xfd, 0xcb, 0x46, 0x6e, 0x79,
+	0xa9, 0x10, 0x69, 0x46, 0x91, 0x79, 0x8b, 0xab, 0x29, 0x22, 0x55, 0x89, 0x15, 0x13, 0xbc, 0xf6,
+	0xfb, 0x77, 0x7d, 0xc5, 0x72, 0x2a, 0x15, 0xce, 0x8b, 0xa6, 0x40, 0x62, 0x7a, 0xa1, 0x18, 0x4b,
+	0x8a, 0x66, 0x3b, 0x31, 0x55, 0x78, 0x07, 0x25, 0x82, 0x35, 0x05, 0x36, 0x9b, 0x51, 0x33, 0x91,
+	0x1c, 0x57, 0x85, 0x79, 0x58, 0x6b, 0xf8, 0xad, 0x03, 0x16, 0xdf, 0xea, 0xa9, 0xe1, 0x7d, 0xb0,
+	0xc0, 0x88, 0xeb, 0x0c, 0x1c, 0xbf, 0x13, 0x2e, 0x30, 0x02, 0x9f, 0x82, 0x55, 0x26, 0xa3, 0x82,
+	0x96, 0x05, 0x55, 0x15, 0xce, 0xdc, 0x85, 0x81, 0xe3, 0x2f, 0x87, 0x5d, 0x26, 0x27, 0x8d, 0x04,
+	0xc7, 0x60, 0x8d, 0x30, 0xa9, 0x4a, 0x16, 0x57, 0x8a, 0x46, 0x4a, 0xb8, 0xed, 0x81, 0xe3, 0x77,
+	0x77, 0xbd, 0xa0, 0x59, 0xdd, 0xf6, 0x0b, 0x3e, 0x56, 0xb4, 0x3c, 0xdd, 0x17, 0x9c, 0x30, 0xbd,
+	0xd5, 0xa8, 0x73, 0x7e, 0xd9, 0x6f, 0x85, 0xab, 0xf3, 0xd4, 0x43, 0x01, 0x31, 0x58, 0xd4, 0x03,
+	0x4b, 0xb7, 0x33, 0x68, 0xfb, 0xdd, 0xdd, 0xcd, 0xc0, 0xae, 0x14, 0xe8, 0x95, 0x82, 0x7a, 0xa5,
+	0x60, 0x5f, 0x30, 0x3e, 0x7a, 0xa9, 0xb3, 0x7f, 0x5c, 0xf5, 0xfd, 0x94, 0xa9, 0xa3, 0x2a, 0x0e,
+	0x12, 0x91, 0xa3, 0x7a, 0x7f, 0xfb, 0xd8, 0x96, 0xe4, 0x18, 0xa9, 0xd3, 0x82, 0x4a, 0x93, 0x20,
+	0x43, 0x5b, 0x19, 0x7e, 0x02, 0x40, 0x2a, 0x5c, 0xaa, 0x48, 0xe3, 0x73, 0x17, 0xcd, 0xa8, 0x5b,
+	0x81, 0x65, 0x1b, 0x34, 0x6c, 0x83, 0xc3, 0x86, 0xed, 0xe8, 0x89, 0x6e, 0xf4, 0xfb, 0xb2, 0xdf,
+	0x3b, 0xc5, 0x79, 0xf6, 0x7a, 0x38, 0xcf, 0x1d, 0x9e, 0x5d, 0xf5, 0x9d, 0x70, 0xc5, 0x08, 0x3a,
+	0x1c, 0x22, 0xb0, 0xc1, 0xab, 0x3c, 0xa2, 0x85, 0x48, 0x8e, 0x64, 0x54, 0x60, 0x46, 0x22, 0x31,
+	0xa3, 0xa5, 0xbb, 0x64, 0x60, 0xf6, 0x78, 0x95, 0xbf, 0x31, 0xd6, 0x04, 0x33, 0xf2, 0x61, 0x46,
+	0x4b, 0xf8, 0x0c, 0xac, 0x4d, 0x59, 0x96, 0x51, 0x52, 0xe7, 0xb8, 0xf7, 0x4c, 0xe4, 0xaa, 0x15,
+	0x6d, 0x30, 0x3c, 0x01, 0xbd, 0x39, 0x22, 0x12, 0x59, 0x3c, 0xcb, 0xff, 0x1f, 0xcf, 0xfa, 0xad,
+	0x2e, 0x46, 0x19, 0x7e, 0x75, 0xc0, 0xa3, 0x77, 0x22, 0x39, 0xc6, 0x71, 0x46, 0x0f, 0xea, 0x5b,
+	0x94, 0x63, 0x3e, 0x15, 0x50, 0x00, 0x98, 0xd5, 0x46, 0xd4, 0x5c, 0xa9, 0x74, 0x9d, 0x7a, 0xa8,
+	0xbb, 0x2c, 0x9b, 0xdc, 0xd1, 0xf3, 0x1a, 0xe5, 0xa6, 0x45, 0xf9, 0x6f, 0x89, 0xe1, 0x77, 0x8d,
+	0xb4, 0x97, 0xdd, 0x6d, 0x3a, 0xfc, 0xe2, 0x80, 0x87, 0x13, 0xca, 0x09, 0xe3, 0xe9, 0x41, 0x33,
+	0x26, 0x13, 0x1c, 0xbe, 0x00, 0xeb, 0x06, 0x5d, 0xc4, 0x88, 0xfe, 0xb8, 0xa6, 0x8c, 0x96, 0xe6,
+	0x76, 0x57, 0xc2, 0x07, 0x46, 0x1f, 0xff, 0x95, 0xf5, 0x21, 0xdb, 0x50, 0x5e, 0xe5, 0x31, 0x2d,
+	0xcd, 0x21, 0xb7, 0xc3, 0xae, 0xd1, 0xde, 0x1b, 0x09, 0x3e, 0x06, 0x2b, 0xe6, 0xd3, 0x8d, 0x18,
+	0x91, 0x6e, 0x7b, 0xd0, 0xf6, 0x3b, 0xe1, 0xb2, 0x11, 0xc6, 0x44, 0x8e, 0x26, 0xe7, 0xd7, 0x9e,
+	0x73, 0x71, 0xed, 0x39, 0xbf, 0xae, 0x3d, 0xe7, 0xec, 0xc6, 0x6b, 0x5d, 0xdc, 0x78, 0xad, 0x9f,
+	0x37, 0x5e, 0xeb, 0xf3, 0xab, 0x5b, 0x8c, 0xeb, 0x93, 0xdf, 0xce, 0x70, 0x2c, 0x9b, 0x17, 0x34,
+	0xdb, 0xdb, 0x41, 0x27, 0xb7, 0xff, 0x20, 0x0c, 0xf7, 0x78, 0xc9, 0x10, 0xda, 0xfb, 0x13, 0x00,
+	0x00, 0xff, 0xff, 0x84, 0x2f, 0xb5, 0x1e, 0x43, 0x04, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -370,6 +440,59 @@ func (m *LockableDurationsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GaugeIds) > 0 {
+		dAtA4 := make([]byte, len(m.GaugeIds)*10)
+		var j3 int
+		for _, num := range m.GaugeIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGauge(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGauge(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGauge(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGauge(v)
 	base := offset
@@ -433,6 +556,29 @@ func (m *LockableDurationsInfo) Size() (n int) {
 	return n
 }
 
+func (m *PendingDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGauge(uint64(m.EpochNumber))
+	}
+	if len(m.GaugeIds) > 0 {
+		l = 0
+		for _, e := range m.GaugeIds {
+			l += sovGauge(uint64(e))
+		}
+		n += 1 + sovGauge(uint64(l)) + l
+	}
+	return n
+}
+
 func sovGauge(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +930,183 @@ func (m *LockableDurationsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGauge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GaugeIds = append(m.GaugeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGauge
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGauge
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGauge
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GaugeIds) == 0 {
+					m.GaugeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGauge
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GaugeIds = append(m.GaugeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGauge(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,6 +45,9 @@ type GenesisState struct {
 	GroupGauges []Gauge `protobuf:"bytes,5,rep,name=group_gauges,json=groupGauges,proto3" json:"group_gauges"`
 	// groups are all the groups that should exist at genesis
 	Groups []Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups"`
+	// pending_distribution holds the gauges left to distribute to for an ended
+	// epoch, if its distribution is not finished
+	PendingDistribution *PendingDistribution `protobuf:"bytes,7,opt,name=pending_distribution,json=pendingDistribution,proto3" json:"pending_distribution,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -122,6 +125,13 @@ func (m *GenesisState) GetGroups() []Group {
 	return nil
 }
 
+func (m *GenesisState) GetPendingDistribution() *PendingDistribution {
+	if m != nil {
+		return m.PendingDistribution
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -129,31 +139,33 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4e, 0xab, 0x40,
-	0x14, 0x86, 0xe1, 0xb6, 0x97, 0x05, 0xf4, 0x2e, 0xee, 0xc4, 0x05, 0xed, 0x02, 0x08, 0x89, 0x49,
-	0x37, 0x32, 0xb1, 0x4d, 0xd4, 0xb8, 0x24, 0x26, 0x8d, 0xbb, 0xa6, 0xee, 0xdc, 0x90, 0xa1, 0x1d,
-	0x91, 0x08, 0x0c, 0xe1, 0x0c, 0x8d, 0x7d, 0x0b, 0x13, 0x37, 0x3e, 0x52, 0x97, 0x5d, 0xba, 0xaa,
-	0xa6, 0x7d, 0x03, 0x9f, 0xc0, 0x30, 0x30, 0x6a, 0xd2, 0xa6, 0x71, 0xc7, 0xe1, 0x7c, 0xe7, 0x3f,
-	0xe7, 0xff, 0x47, 0x77, 0x18, 0xa4, 0x0c, 0x62, 0xc0, 0x71, 0x36, 0xa5, 0x19, 0x8f, 0xe7, 0x14,
-	0x70, 0x44, 0x33, 0x0a, 0x31, 0x78, 0x79, 0xc1, 0x38, 0x43, 0xa8, 0x21, 0xbc, 0x6f, 0xa2, 0x77,
-	0x14, 0xb1, 0x88, 0x89, 0x36, 0xae, 0xbe, 0x6a, 0xb2, 0x67, 0x45, 0x8c, 0x45, 0x09, 0xc5, 0xa2,
-	0x0a, 0xcb, 0x3b, 0x3c, 0x2b, 0x0b, 0xc2, 0x63, 0x96, 0x35, 0x7d, 0x7b, 0xcf, 0xae, 0x9c, 0x14,
-	0x24, 0x05, 0x29, 0xb0, 0xef, 0x18, 0x52, 0x46, 0xf4, 0x50, 0xbf, 0x60, 0x65, 0x5e, 0xf7, 0xdd,
-	0xe7, 0x96, 0xde, 0x19, 0xd5, 0xc7, 0xdf, 0x70, 0xc2, 0x29, 0xba, 0xd0, 0xb5, 0x7a, 0x81, 0xa9,
-	0x3a, 0x6a, 0xdf, 0x18, 0xf4, 0xbc, 0x5d, 0x33, 0xde, 0x58, 0x10, 0x7e, 0x7b, 0xb9, 0xb6, 0x95,
-	0x49, 0xc3, 0xa3, 0x73, 0x5d, 0x13, 0x9b, 0xc1, 0xfc, 0xe3, 0xb4, 0xfa, 0xc6, 0xa0, 0xbb, 0x6f,
-	0x72, 0x54, 0x11, 0x72, 0xb0, 0xc6, 0x11, 0xd3, 0x51, 0xc2, 0xa6, 0x0f, 0x24, 0x4c, 0x68, 0x20,
-	0xfd, 0x83, 0xd9, 0x6a, 0x44, 0xea, 0x84, 0x3c, 0x99, 0x90, 0x77, 0xd5, 0x10, 0xfe, 0x71, 0x25,
-	0xf2, 0xb1, 0xb6, 0xbb, 0x0b, 0x92, 0x26, 0x97, 0xee, 0xae, 0x84, 0xfb, 0xf2, 0x66, 0xab, 0x93,
-	0xff, 0xb2, 0x21, 0x07, 0x01, 0xb9, 0xfa, 0xbf, 0x84, 0x00, 0x0f, 0xc4, 0xfe, 0x20, 0x9e, 0x99,
-	0x6d, 0x47, 0xed, 0xb7, 0x27, 0x46, 0xf5, 0x53, 0x1c, 0x78, 0x3d, 0x43, 0xbe, 0xde, 0x11, 0x39,
-	0x05, 0x8d, 0xa7, 0xbf, 0xbf, 0xf3, 0x64, 0x88, 0xa1, 0x51, 0x6d, 0xac, 0x4a, 0xa4, 0x2a, 0xc1,
-	0xd4, 0x0e, 0x4c, 0x57, 0xc4, 0x57, 0x22, 0x02, 0xf7, 0xc7, 0xcb, 0x8d, 0xa5, 0xae, 0x36, 0x96,
-	0xfa, 0xbe, 0xb1, 0xd4, 0xa7, 0xad, 0xa5, 0xac, 0xb6, 0x96, 0xf2, 0xba, 0xb5, 0x94, 0xdb, 0xb3,
-	0x28, 0xe6, 0xf7, 0x65, 0xe8, 0x4d, 0x59, 0x8a, 0x1b, 0xb1, 0x93, 0x84, 0x84, 0x20, 0x0b, 0x3c,
-	0x1f, 0x9e, 0xe2, 0xc7, 0x9f, 0xaf, 0xcd, 0x17, 0x39, 0x85, 0x50, 0x13, 0xf9, 0x0d, 0x3f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x66, 0x53, 0xe4, 0xa0, 0xbd, 0x02, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x13, 0x5b, 0x23, 0x4c, 0xd6, 0x83, 0xe3, 0x1e, 0xb2, 0x3d, 0x24, 0x21, 0x20, 0xf6,
+	0x62, 0x06, 0x77, 0x41, 0xc5, 0x63, 0x58, 0x28, 0xde, 0x96, 0x78, 0xdb, 0x4b, 0x98, 0x34, 0xe3,
+	0x38, 0x98, 0x64, 0x42, 0x66, 0x52, 0xec, 0xb7, 0xf0, 0xe8, 0x47, 0xea, 0xb1, 0x47, 0x4f, 0x55,
+	0xda, 0x0f, 0x20, 0xf8, 0x09, 0x64, 0x66, 0x12, 0x2d, 0x34, 0x94, 0xbd, 0xe5, 0x9d, 0xf7, 0xf7,
+	0xfe, 0x79, 0x9e, 0x37, 0x20, 0xe4, 0xa2, 0xe2, 0x82, 0x09, 0xc4, 0xea, 0x25, 0xa9, 0x25, 0x5b,
+	0x11, 0x81, 0x28, 0xa9, 0x89, 0x60, 0x22, 0x6e, 0x5a, 0x2e, 0x39, 0x84, 0x3d, 0x11, 0xff, 0x27,
+	0x66, 0x97, 0x94, 0x53, 0xae, 0xd3, 0x48, 0x7d, 0x19, 0x72, 0xe6, 0x53, 0xce, 0x69, 0x49, 0x90,
+	0x8e, 0xf2, 0xee, 0x13, 0x2a, 0xba, 0x16, 0x4b, 0xc6, 0xeb, 0x3e, 0x1f, 0x8c, 0xcc, 0x6a, 0x70,
+	0x8b, 0x2b, 0x31, 0x34, 0x18, 0x5b, 0x06, 0x77, 0x94, 0x9c, 0xcb, 0xb7, 0xbc, 0x6b, 0x4c, 0x3e,
+	0xfa, 0x3d, 0x01, 0x17, 0x0b, 0xb3, 0xfc, 0x47, 0x89, 0x25, 0x81, 0xef, 0x80, 0x63, 0x06, 0x78,
+	0x76, 0x68, 0xcf, 0xdd, 0xeb, 0x59, 0x7c, 0x2a, 0x26, 0xbe, 0xd3, 0x44, 0x32, 0xdd, 0xec, 0x02,
+	0x2b, 0xed, 0x79, 0xf8, 0x16, 0x38, 0x7a, 0xb2, 0xf0, 0x1e, 0x85, 0x93, 0xb9, 0x7b, 0x7d, 0x35,
+	0x56, 0xb9, 0x50, 0xc4, 0x50, 0x68, 0x70, 0xc8, 0x01, 0x2c, 0xf9, 0xf2, 0x0b, 0xce, 0x4b, 0x92,
+	0x0d, 0xfa, 0x85, 0x37, 0xe9, 0x9b, 0x18, 0x87, 0xe2, 0xc1, 0xa1, 0xf8, 0xb6, 0x27, 0x92, 0x17,
+	0xaa, 0xc9, 0x9f, 0x5d, 0x70, 0xb5, 0xc6, 0x55, 0xf9, 0x3e, 0x3a, 0x6d, 0x11, 0x7d, 0xff, 0x19,
+	0xd8, 0xe9, 0xb3, 0x21, 0x31, 0x14, 0x0a, 0x18, 0x81, 0xa7, 0x25, 0x16, 0x32, 0xd3, 0xf3, 0x33,
+	0x56, 0x78, 0xd3, 0xd0, 0x9e, 0x4f, 0x53, 0x57, 0x3d, 0xea, 0x05, 0x3f, 0x14, 0x30, 0x01, 0x17,
+	0xda, 0xa7, 0xac, 0xd7, 0xf4, 0xf8, 0x61, 0x9a, 0x5c, 0x5d, 0xb4, 0x30, 0xc2, 0x94, 0x23, 0x2a,
+	0x14, 0x9e, 0x73, 0xa6, 0x5a, 0x11, 0xff, 0x1c, 0xd1, 0x38, 0xbc, 0x07, 0x97, 0x0d, 0xa9, 0x0b,
+	0x56, 0xd3, 0xac, 0x60, 0x42, 0xb6, 0x2c, 0xef, 0xd4, 0xe6, 0xde, 0x13, 0x7d, 0x92, 0x97, 0xa3,
+	0x27, 0x31, 0xfc, 0xed, 0x11, 0x9e, 0x3e, 0x6f, 0x4e, 0x1f, 0x93, 0xbb, 0xcd, 0xde, 0xb7, 0xb7,
+	0x7b, 0xdf, 0xfe, 0xb5, 0xf7, 0xed, 0x6f, 0x07, 0xdf, 0xda, 0x1e, 0x7c, 0xeb, 0xc7, 0xc1, 0xb7,
+	0xee, 0xdf, 0x50, 0x26, 0x3f, 0x77, 0x79, 0xbc, 0xe4, 0x15, 0xea, 0x27, 0xbc, 0x2a, 0x71, 0x2e,
+	0x86, 0x00, 0xad, 0x6e, 0x5e, 0xa3, 0xaf, 0xc7, 0x7f, 0x92, 0x5c, 0x37, 0x44, 0xe4, 0x8e, 0xbe,
+	0xcd, 0xcd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x72, 0x58, 0xbd, 0x19, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +188,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingDistribution != nil {
+		{
+			size, err := m.PendingDistribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Groups) > 0 {
 		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -294,6 +318,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PendingDistribution != nil {
+		l = m.PendingDistribution.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -520,6 +548,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingDistribution == nil {
+				m.PendingDistribution = &PendingDistribution{}
+			}
+			if err := m.PendingDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
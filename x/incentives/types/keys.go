@@ -48,6 +48,9 @@ var (
 	// KeyPrefixGroup defines prefix key for storing groups.
 	KeyPrefixGroup = []byte{0x08}
 
+	// KeyPendingDistribution defines key for storing the gauges left to distribute to for an ended epoch.
+	KeyPendingDistribution = []byte{0x09}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 
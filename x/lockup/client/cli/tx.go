@@ -16,6 +16,7 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(cmd, NewBeginUnlockByIDCmd)
 	osmocli.AddTxCmd(cmd, NewForceUnlockByIdCmd)
 	osmocli.AddTxCmd(cmd, NewSetRewardReceiverAddress)
+	osmocli.AddTxCmd(cmd, NewTransferLockCmd)
 
 	return cmd
 }
@@ -72,3 +73,13 @@ func NewSetRewardReceiverAddress() (*osmocli.TxCliDesc, *types.MsgSetRewardRecei
 		Long:  "sets reward receiver address for the designated lock id",
 	}, &types.MsgSetRewardReceiverAddress{}
 }
+
+// NewTransferLockCmd transfers the ownership of a lock.
+func NewTransferLockCmd() (*osmocli.TxCliDesc, *types.MsgTransferLock) {
+	return &osmocli.TxCliDesc{
+		Use:     "transfer-lock",
+		Short:   "transfers the ownership of the designated lock id to a new owner",
+		Long:    "transfers the ownership of the designated lock id to a new owner. locks that are superfluid staked or unbonding cannot be transferred",
+		Example: "osmosisd tx lockup transfer-lock 1 osmo1... --from=val --chain-id=osmosis-1",
+	}, &types.MsgTransferLock{}
+}
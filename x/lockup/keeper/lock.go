@@ -541,6 +541,58 @@ func (k Keeper) SetLockRewardReceiverAddress(ctx sdk.Context, lockID uint64, own
 	return nil
 }
 
+// TransferLock transfers the ownership of a bonded or unbonding lock to the new owner.
+// The lock refs of the owner are moved to the new owner, while the accumulation stores
+// are kept as is since they are indexed by denom and duration only.
+// The reward receiver is reset to the new owner, so that the rewards of the lock
+// are not sent to a receiver set by the previous owner.
+// Transferring a lock fails on either of the following conditions.
+// 1. Only lock owner is able to transfer the lock.
+// 2. Locks that have synthetic lockup (superfluid staked or unbonding) are not allowed to transfer.
+// 3. Locks of concentrated liquidity positions are not allowed to transfer, since the position is owned by the lock owner.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, owner, newOwner sdk.AccAddress) error {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+
+	if lock.GetOwner() != owner.String() {
+		return types.ErrNotLockOwner
+	}
+
+	if owner.Equals(newOwner) {
+		return types.ErrNewOwnerIsSame
+	}
+
+	// check synthetic lockup exists
+	if k.HasAnySyntheticLockups(ctx, lock.ID) {
+		return errorsmod.Wrapf(types.ErrTransferSyntheticLock, "lock %d", lock.ID)
+	}
+
+	for _, coin := range lock.Coins {
+		if strings.HasPrefix(coin.Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
+			return errorsmod.Wrapf(types.ErrTransferConcentratedLock, "lock %d", lock.ID)
+		}
+	}
+
+	// completely delete existing lock refs of the owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	lock.Owner = newOwner.String()
+	lock.RewardReceiverAddress = types.DefaultOwnerReceiverPlaceholder
+
+	// add lock refs of the new owner
+	err = k.addLockRefs(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
+	return k.setLock(ctx, *lock)
+}
+
 // ExtendLockup changes the existing lock duration to the given lock duration.
 // Updating lock duration would fail on either of the following conditions.
 // 1. Only lock owner is able to change the duration of the lock.
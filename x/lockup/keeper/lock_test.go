@@ -1457,6 +1457,134 @@ func (s *KeeperTestSuite) TestEditLockup() {
 	s.Require().Equal(int64(0), acc.Int64())
 }
 
+func (s *KeeperTestSuite) TestTransferLock() {
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	tests := []struct {
+		name               string
+		sender             sdk.AccAddress
+		newOwner           sdk.AccAddress
+		coins              sdk.Coins
+		isUnlocking        bool
+		hasSyntheticLockup bool
+		expectedErr        error
+	}{
+		{
+			name:     "transfer bonded lock",
+			sender:   addr1,
+			newOwner: addr2,
+			coins:    coins,
+		},
+		{
+			name:        "transfer unbonding lock",
+			sender:      addr1,
+			newOwner:    addr2,
+			coins:       coins,
+			isUnlocking: true,
+		},
+		{
+			name:        "error: sender is not the owner of the lock",
+			sender:      addr2,
+			newOwner:    addr3,
+			coins:       coins,
+			expectedErr: types.ErrNotLockOwner,
+		},
+		{
+			name:        "error: new owner is the owner of the lock",
+			sender:      addr1,
+			newOwner:    addr1,
+			coins:       coins,
+			expectedErr: types.ErrNewOwnerIsSame,
+		},
+		{
+			name:               "error: lock has synthetic lockup",
+			sender:             addr1,
+			newOwner:           addr2,
+			coins:              coins,
+			hasSyntheticLockup: true,
+			expectedErr:        types.ErrTransferSyntheticLock,
+		},
+		{
+			name:        "error: lock of concentrated liquidity position",
+			sender:      addr1,
+			newOwner:    addr2,
+			coins:       sdk.Coins{sdk.NewInt64Coin("cl/pool/1/1", 10)},
+			expectedErr: types.ErrTransferConcentratedLock,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.FundAcc(addr1, tc.coins)
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, addr1, tc.coins, time.Second)
+			s.Require().NoError(err)
+			denom := tc.coins[0].Denom
+
+			// set a reward receiver, which is reset by the transfer
+			err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, lock.ID, addr1, addr3.String())
+			s.Require().NoError(err)
+
+			if tc.isUnlocking {
+				_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lock.ID, nil)
+				s.Require().NoError(err)
+			}
+			if tc.hasSyntheticLockup {
+				err := s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthetic", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			// System under test
+			err = s.App.LockupKeeper.TransferLock(s.Ctx, lock.ID, tc.sender, tc.newOwner)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+				s.Require().NoError(err)
+				s.Require().Equal(addr1.String(), lock.Owner)
+				s.Require().Len(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addr1), 1)
+				return
+			}
+			s.Require().NoError(err)
+
+			newLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.newOwner.String(), newLock.Owner)
+			s.Require().Equal(types.DefaultOwnerReceiverPlaceholder, newLock.RewardReceiverAddress)
+			s.Require().Equal(tc.isUnlocking, newLock.IsUnlocking())
+			s.Require().Equal(tc.coins, newLock.Coins)
+
+			// the lock refs are moved to the new owner
+			s.Require().Empty(s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, addr1))
+			s.Require().Empty(s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, addr1))
+			s.Require().Empty(s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, addr1))
+			newOwnerLocks := s.App.LockupKeeper.GetAccountPeriodLocks(s.Ctx, tc.newOwner)
+			s.Require().Len(newOwnerLocks, 1)
+			s.Require().Equal(lock.ID, newOwnerLocks[0].ID)
+			if tc.isUnlocking {
+				s.Require().Equal(tc.coins, s.App.LockupKeeper.GetAccountUnlockingCoins(s.Ctx, tc.newOwner))
+			} else {
+				s.Require().Equal(tc.coins, s.App.LockupKeeper.GetAccountLockedCoins(s.Ctx, tc.newOwner))
+				s.Require().Len(s.App.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(s.Ctx, tc.newOwner, denom, time.Second), 1)
+			}
+
+			// the lock refs that are not indexed by owner are unchanged
+			s.Require().Len(s.App.LockupKeeper.GetLocksDenom(s.Ctx, denom), 1)
+
+			// the accumulation store is unchanged
+			acc := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+				Denom:    denom,
+				Duration: time.Second,
+			})
+			s.Require().Equal(tc.coins[0].Amount, acc)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestForceUnlock() {
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
 
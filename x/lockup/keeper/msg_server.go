@@ -250,3 +250,35 @@ func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRewardReceiverAddressResponse{Success: true}, nil
 }
+
+// TransferLock transfers the ownership of the lock to the new owner.
+// TransferLock would fail if the lock has a synthetic lock or if it is a lock of a concentrated liquidity position.
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.TransferLock(ctx, msg.LockID, owner, newOwner)
+	if err != nil {
+		return &types.MsgTransferLockResponse{Success: false}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(msg.LockID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockNewOwner, newOwner.String()),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{Success: true}, nil
+}
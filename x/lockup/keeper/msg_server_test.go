@@ -552,3 +552,72 @@ func (s *KeeperTestSuite) TestSetRewardReceiverAddress() {
 
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgTransferLock() {
+	tests := []struct {
+		name             string
+		isOwner          bool
+		hasSyntheticLock bool
+		expectPass       bool
+	}{
+		{
+			name:       "happy path: transfer lock to another address",
+			isOwner:    true,
+			expectPass: true,
+		},
+		{
+			name:       "error: sender is not the owner of the lock",
+			isOwner:    false,
+			expectPass: false,
+		},
+		{
+			name:             "error: lock has synthetic lockup",
+			isOwner:          true,
+			hasSyntheticLock: true,
+			expectPass:       false,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+
+			defaultAmountInLock := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+			s.FundAcc(s.TestAccs[0], defaultAmountInLock)
+
+			lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, s.TestAccs[0], defaultAmountInLock, time.Minute)
+			s.Require().NoError(err)
+
+			if test.hasSyntheticLock {
+				err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lock.ID, "synthetic", time.Second, false)
+				s.Require().NoError(err)
+			}
+
+			owner := s.TestAccs[0]
+			if !test.isOwner {
+				owner = s.TestAccs[1]
+			}
+
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test
+			msg := types.NewMsgTransferLock(owner, s.TestAccs[2], lock.ID)
+			resp, err := msgServer.TransferLock(s.Ctx, msg)
+			if !test.expectPass {
+				s.Require().Error(err)
+				s.Require().False(resp.Success)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtTransferLock, 0)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(resp.Success)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTransferLock, 1)
+
+			newLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(s.TestAccs[2].String(), newLock.Owner)
+		})
+	}
+}
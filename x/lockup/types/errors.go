@@ -13,4 +13,7 @@ var (
 	ErrSyntheticDurationLongerThanNative = errorsmod.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = errorsmod.Register(ModuleName, 4, "lockup not found")
 	ErrRewardReceiverIsSame              = errorsmod.Register(ModuleName, 5, "reward receiver is the same")
+	ErrNewOwnerIsSame                    = errorsmod.Register(ModuleName, 6, "new owner is the same as the lock owner")
+	ErrTransferSyntheticLock             = errorsmod.Register(ModuleName, 7, "cannot transfer lock with synthetic lockup")
+	ErrTransferConcentratedLock          = errorsmod.Register(ModuleName, 8, "cannot transfer lock of concentrated liquidity position")
 )
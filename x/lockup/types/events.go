@@ -6,6 +6,7 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtTransferLock    = "transfer_lock"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -14,4 +15,5 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePeriodLockNewOwner   = "new_owner"
 )
@@ -17,6 +17,7 @@ const (
 	TypeMsgExtendLockup             = "edit_lockup"
 	TypeForceUnlock                 = "force_unlock"
 	TypeMsgSetRewardReceiverAddress = "set_reward_receiver_address"
+	TypeMsgTransferLock             = "transfer_lock"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -218,3 +219,39 @@ func (m MsgSetRewardReceiverAddress) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+// NewMsgTransferLock creates a message for transferring the ownership of a lock
+func NewMsgTransferLock(owner, newOwner sdk.AccAddress, lockId uint64) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		NewOwner: newOwner.String(),
+		LockID:   lockId,
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.NewOwner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new owner address (%s)", err)
+	}
+
+	if m.Owner == m.NewOwner {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "new owner should be different from the owner (%s)", m.Owner)
+	}
+
+	if m.LockID <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock id should be larger than zero, was (%d)", m.LockID)
+	}
+	return nil
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -264,6 +264,75 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgTransferLock(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+	addr2, _ := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgTransferLock
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				LockID:   1,
+				NewOwner: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgTransferLock{
+				Owner:    invalidAddr,
+				LockID:   1,
+				NewOwner: addr2,
+			},
+		},
+		{
+			name: "invalid new owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				LockID:   1,
+				NewOwner: invalidAddr,
+			},
+		},
+		{
+			name: "new owner is the owner",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				LockID:   1,
+				NewOwner: addr1,
+			},
+		},
+		{
+			name: "invalid lockup ID",
+			msg: types.MsgTransferLock{
+				Owner:    addr1,
+				LockID:   0,
+				NewOwner: addr2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "transfer_lock")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -296,6 +365,14 @@ func TestAuthzMsg(t *testing.T) {
 				Owner: addr1,
 			},
 		},
+		{
+			name: "MsgTransferLock",
+			msg: &types.MsgTransferLock{
+				Owner:    addr1,
+				LockID:   1,
+				NewOwner: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -660,6 +660,112 @@ func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgTransferLock transfers the ownership of a bonded or unbonding lock to a
+// new owner. Locks with synthetic lockups cannot be transferred.
+type MsgTransferLock struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LockID   uint64 `protobuf:"varint,2,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferLock) Reset()         { *m = MsgTransferLock{} }
+func (m *MsgTransferLock) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLock) ProtoMessage()    {}
+func (*MsgTransferLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgTransferLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLock.Merge(m, src)
+}
+func (m *MsgTransferLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLock proto.InternalMessageInfo
+
+func (m *MsgTransferLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTransferLock) GetLockID() uint64 {
+	if m != nil {
+		return m.LockID
+	}
+	return 0
+}
+
+func (m *MsgTransferLock) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type MsgTransferLockResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *MsgTransferLockResponse) Reset()         { *m = MsgTransferLockResponse{} }
+func (m *MsgTransferLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockResponse) ProtoMessage()    {}
+func (*MsgTransferLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgTransferLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockResponse.Merge(m, src)
+}
+func (m *MsgTransferLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLockResponse proto.InternalMessageInfo
+
+func (m *MsgTransferLockResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
 // DEPRECATED
 // Following messages are deprecated but kept to support indexing.
 type MsgUnlockPeriodLock struct {
@@ -671,7 +777,7 @@ func (m *MsgUnlockPeriodLock) Reset()         { *m = MsgUnlockPeriodLock{} }
 func (m *MsgUnlockPeriodLock) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockPeriodLock) ProtoMessage()    {}
 func (*MsgUnlockPeriodLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcdad5af0d24735f, []int{12}
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
 }
 func (m *MsgUnlockPeriodLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +828,7 @@ func (m *MsgUnlockTokens) Reset()         { *m = MsgUnlockTokens{} }
 func (m *MsgUnlockTokens) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockTokens) ProtoMessage()    {}
 func (*MsgUnlockTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bcdad5af0d24735f, []int{13}
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
 }
 func (m *MsgUnlockTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,6 +877,8 @@ func init() {
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
 	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
 	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+	proto.RegisterType((*MsgTransferLock)(nil), "osmosis.lockup.MsgTransferLock")
+	proto.RegisterType((*MsgTransferLockResponse)(nil), "osmosis.lockup.MsgTransferLockResponse")
 	proto.RegisterType((*MsgUnlockPeriodLock)(nil), "osmosis.lockup.MsgUnlockPeriodLock")
 	proto.RegisterType((*MsgUnlockTokens)(nil), "osmosis.lockup.MsgUnlockTokens")
 }
@@ -778,62 +886,66 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x64, 0x5f, 0x67, 0x97, 0x84, 0x9a, 0xa5, 0x4d, 0xcd, 0x62, 0x17, 0x03, 0xdb,
-	0x6c, 0x77, 0x6d, 0x93, 0x04, 0x21, 0x35, 0x17, 0xd4, 0xb4, 0x20, 0x55, 0xaa, 0x25, 0x64, 0x5a,
-	0x09, 0x71, 0xa0, 0x72, 0x9c, 0xe9, 0xd4, 0x4a, 0xe2, 0x09, 0x1e, 0x3b, 0x6d, 0x25, 0x4e, 0x1c,
-	0x39, 0x71, 0xe4, 0x33, 0x70, 0xa1, 0x1f, 0xa3, 0xc7, 0x72, 0xe3, 0x42, 0x5a, 0xb5, 0x12, 0x95,
-	0x38, 0xf6, 0x13, 0x20, 0xcf, 0xd8, 0xc6, 0x76, 0xd2, 0x24, 0xad, 0x04, 0xda, 0x4b, 0xed, 0xf1,
-	0xf3, 0x3c, 0xff, 0x79, 0xfe, 0xbf, 0xce, 0x4b, 0xc0, 0x02, 0x26, 0x3d, 0x4c, 0x6c, 0xa2, 0x75,
-	0xb1, 0xd5, 0xf1, 0xfb, 0x9a, 0x77, 0xa8, 0xf6, 0x5d, 0xec, 0x61, 0xbe, 0x18, 0x06, 0x54, 0x16,
-	0x10, 0x9e, 0x21, 0x8c, 0x30, 0x0d, 0x69, 0xc1, 0x1b, 0xcb, 0x12, 0xe6, 0xcc, 0x9e, 0xed, 0x60,
-	0x8d, 0xfe, 0x0d, 0x3f, 0x89, 0x08, 0x63, 0xd4, 0x85, 0x1a, 0x1d, 0xb5, 0xfc, 0x3d, 0xad, 0xed,
-	0xbb, 0xa6, 0x67, 0x63, 0x27, 0x8a, 0x5b, 0x54, 0x59, 0x6b, 0x99, 0x04, 0x6a, 0x83, 0x6a, 0x0b,
-	0x7a, 0x66, 0x55, 0xb3, 0xb0, 0x1d, 0xc5, 0x17, 0x33, 0x1d, 0x05, 0x8f, 0x30, 0xb4, 0x10, 0x96,
-	0xf6, 0x08, 0xd2, 0x06, 0xd5, 0xe0, 0xc1, 0x02, 0xf2, 0x6f, 0x79, 0xf0, 0x96, 0x4e, 0xd0, 0x16,
-	0xb6, 0x3a, 0xdb, 0xb8, 0x03, 0x1d, 0xc2, 0xbf, 0x00, 0xf7, 0xf1, 0x81, 0x03, 0xdd, 0x32, 0xb7,
-	0xc4, 0x55, 0x1e, 0x37, 0xdf, 0xbe, 0x1e, 0x4a, 0x4f, 0x8f, 0xcc, 0x5e, 0xb7, 0x21, 0xd3, 0xcf,
-	0xb2, 0xc1, 0xc2, 0xfc, 0x3e, 0x78, 0x14, 0xf5, 0x57, 0xce, 0x2f, 0x71, 0x95, 0x27, 0xb5, 0x45,
-	0x95, 0x19, 0x50, 0x23, 0x03, 0xea, 0x46, 0x98, 0xd0, 0xac, 0x9e, 0x0c, 0xa5, 0xdc, 0xdf, 0x43,
-	0x89, 0x8f, 0x4a, 0x5e, 0xe3, 0x9e, 0xed, 0xc1, 0x5e, 0xdf, 0x3b, 0xba, 0x1e, 0x4a, 0x25, 0xa6,
-	0x1f, 0xc5, 0xe4, 0x5f, 0xce, 0x24, 0xce, 0x88, 0xd5, 0x79, 0x13, 0xdc, 0x0f, 0x5c, 0x92, 0x72,
-	0x61, 0xa9, 0x40, 0xa7, 0x61, 0x66, 0xd4, 0x80, 0x83, 0x1a, 0x72, 0x50, 0xd7, 0xb1, 0xed, 0x34,
-	0x3f, 0x09, 0xa6, 0xf9, 0xf5, 0x4c, 0xaa, 0x20, 0xdb, 0xdb, 0xf7, 0x5b, 0xaa, 0x85, 0x7b, 0x5a,
-	0xe8, 0x9c, 0x3d, 0x14, 0xd2, 0xee, 0x68, 0xde, 0x51, 0x1f, 0x12, 0x5a, 0x40, 0x0c, 0xa6, 0xdc,
-	0x78, 0xf9, 0xe3, 0xd5, 0xf1, 0x0a, 0x33, 0xf6, 0xd3, 0xd5, 0xf1, 0x8a, 0x30, 0x86, 0xa4, 0xe2,
-	0x51, 0x3e, 0xf2, 0x32, 0x78, 0x37, 0x05, 0xcc, 0x80, 0xa4, 0x8f, 0x1d, 0x02, 0xf9, 0x22, 0xc8,
-	0x6f, 0x6e, 0x50, 0x6a, 0xf7, 0x8c, 0xfc, 0xe6, 0x86, 0xfc, 0x3d, 0x78, 0xa6, 0x13, 0xd4, 0x84,
-	0xc8, 0x76, 0x76, 0x9c, 0x40, 0xc1, 0x76, 0xd0, 0x5a, 0xb7, 0x3b, 0x2b, 0xe0, 0x46, 0x35, 0xdd,
-	0x93, 0x9c, 0xe9, 0xa9, 0x15, 0x08, 0x2b, 0xbe, 0x93, 0xec, 0x6d, 0x1b, 0x3c, 0x1f, 0x37, 0x65,
-	0xdc, 0xe2, 0xa7, 0xe0, 0x21, 0x2b, 0x20, 0x65, 0x8e, 0xb2, 0x14, 0xd4, 0xf4, 0x62, 0x55, 0xbf,
-	0x82, 0xae, 0x8d, 0xdb, 0x81, 0x3b, 0x23, 0x4a, 0x95, 0xff, 0xe2, 0xc0, 0xdc, 0x88, 0xec, 0xcc,
-	0xeb, 0x84, 0x61, 0xc9, 0x47, 0x58, 0xfe, 0x8f, 0xff, 0xe6, 0x67, 0x69, 0x72, 0xcb, 0x93, 0xc8,
-	0xf5, 0xa9, 0x61, 0x25, 0x78, 0x97, 0x77, 0xc1, 0xe2, 0x88, 0xcf, 0x98, 0x5d, 0x19, 0x3c, 0x24,
-	0xbe, 0x65, 0x41, 0x42, 0xa8, 0xe3, 0x47, 0x46, 0x34, 0xe4, 0x2b, 0xa0, 0xe4, 0x47, 0xe9, 0x01,
-	0xb9, 0xd8, 0x6e, 0xf6, 0xb3, 0x7c, 0xce, 0x81, 0x92, 0x4e, 0xd0, 0x17, 0x87, 0x1e, 0x74, 0x28,
-	0x64, 0xbf, 0x7f, 0x67, 0x8e, 0xc9, 0xfd, 0x57, 0xf8, 0x2f, 0xf7, 0x5f, 0xe3, 0x55, 0x1a, 0xe7,
-	0xf3, 0x0c, 0x4e, 0x48, 0xdd, 0x28, 0x6c, 0x24, 0xd7, 0xc1, 0x42, 0xc6, 0xe1, 0x74, 0x82, 0xf2,
-	0x90, 0x03, 0x45, 0x9d, 0xa0, 0x2f, 0xb1, 0x6b, 0x41, 0x46, 0xfe, 0x4d, 0x5e, 0x5e, 0x53, 0x36,
-	0xe6, 0x5e, 0xe0, 0x22, 0xb3, 0x31, 0x6b, 0x60, 0x3e, 0xed, 0x6f, 0x06, 0x28, 0x7f, 0x72, 0xe0,
-	0x3d, 0x9d, 0xa0, 0xaf, 0xa1, 0x67, 0xc0, 0x03, 0xd3, 0x6d, 0x1b, 0xd0, 0x82, 0xf6, 0x00, 0xba,
-	0x6b, 0xed, 0xb6, 0x1b, 0x2c, 0xbb, 0x59, 0x09, 0xcd, 0x83, 0x07, 0xdd, 0xe4, 0xaa, 0x0c, 0x47,
-	0xfc, 0x3a, 0x28, 0xb9, 0x54, 0x78, 0xd7, 0x0d, 0x95, 0xe9, 0x3a, 0x7a, 0xdc, 0x14, 0xae, 0x87,
-	0xd2, 0x3c, 0x53, 0xca, 0x24, 0xc8, 0x46, 0xd1, 0x4d, 0xf5, 0xd2, 0x58, 0x4d, 0xb3, 0x58, 0xc9,
-	0xb0, 0x20, 0xd0, 0x53, 0x58, 0x85, 0x12, 0x69, 0x28, 0x26, 0xeb, 0x5f, 0xfe, 0x1c, 0x7c, 0x38,
-	0xc1, 0xde, 0x0c, 0x80, 0x74, 0xf0, 0x8e, 0x4e, 0x10, 0xe3, 0xf9, 0xef, 0xb9, 0x75, 0xd7, 0x95,
-	0x23, 0xaf, 0xd2, 0xbd, 0xc9, 0xe4, 0x6e, 0x77, 0x17, 0xd6, 0x7e, 0xbf, 0x07, 0x0a, 0x3a, 0x41,
-	0xbc, 0x01, 0x40, 0xe2, 0x26, 0x7d, 0x3f, 0x7b, 0xb8, 0xa6, 0xee, 0x0d, 0xe1, 0xe3, 0x89, 0xe1,
-	0xd8, 0x3f, 0x02, 0x73, 0xa3, 0x77, 0xc8, 0x47, 0x63, 0x6a, 0x47, 0xb2, 0x84, 0xd7, 0xb3, 0x64,
-	0xc5, 0x13, 0x7d, 0x07, 0x8a, 0x99, 0x23, 0xfe, 0x83, 0xa9, 0xf5, 0xc2, 0xcb, 0xa9, 0x29, 0xb1,
-	0xfe, 0x37, 0xe0, 0x69, 0xea, 0xe0, 0x93, 0xc6, 0x94, 0x26, 0x13, 0x84, 0xe5, 0x29, 0x09, 0xb1,
-	0xf2, 0x0e, 0x78, 0x92, 0x3c, 0x3a, 0xc4, 0x31, 0x75, 0x89, 0xb8, 0xf0, 0x62, 0x72, 0x3c, 0x96,
-	0xfd, 0x01, 0x94, 0x6f, 0xdc, 0x7c, 0xaf, 0xc6, 0x68, 0xdc, 0x94, 0x2c, 0xd4, 0x6f, 0x91, 0x1c,
-	0xcd, 0xde, 0xdc, 0x3a, 0xb9, 0x10, 0xb9, 0xd3, 0x0b, 0x91, 0x3b, 0xbf, 0x10, 0xb9, 0x9f, 0x2f,
-	0xc5, 0xdc, 0xe9, 0xa5, 0x98, 0xfb, 0xe3, 0x52, 0xcc, 0x7d, 0x5b, 0x4b, 0x1c, 0x58, 0xa1, 0xb0,
-	0xd2, 0x35, 0x5b, 0x24, 0x1a, 0x68, 0x83, 0x7a, 0x55, 0x3b, 0x8c, 0x7f, 0x97, 0x06, 0x07, 0x58,
-	0xeb, 0x01, 0xbd, 0x13, 0xea, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x80, 0xc2, 0x8c, 0x70, 0xb6,
-	0x0a, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0xa4, 0x4d, 0x5e, 0xcb, 0x6e, 0x63, 0x42, 0xb2, 0x31, 0xc5, 0x0e, 0x03, 0x34,
+	0x69, 0x5a, 0xdb, 0x6c, 0x82, 0x90, 0xba, 0x17, 0xd4, 0x6d, 0x40, 0xaa, 0x14, 0x0b, 0x64, 0x52,
+	0x09, 0x71, 0x20, 0xf2, 0x7a, 0x27, 0x53, 0x2b, 0xbb, 0x9e, 0xc5, 0x63, 0xe7, 0x87, 0xc4, 0x89,
+	0x23, 0x27, 0x8e, 0xfc, 0x0d, 0x70, 0xa0, 0xfc, 0x17, 0x3d, 0xf6, 0xc8, 0x85, 0x6d, 0x95, 0x48,
+	0x54, 0xe2, 0x98, 0xbf, 0x00, 0x79, 0xc6, 0x36, 0xb6, 0xb3, 0xd9, 0xdd, 0x54, 0x02, 0xf5, 0x12,
+	0x7b, 0xfc, 0xbe, 0xf7, 0xcd, 0xfb, 0xbe, 0xbc, 0x37, 0xb3, 0xb0, 0x44, 0x59, 0x8f, 0x32, 0x8f,
+	0x99, 0x5d, 0xea, 0xee, 0x47, 0x7d, 0x33, 0x3c, 0x32, 0xfa, 0x01, 0x0d, 0xa9, 0x5c, 0x4d, 0x02,
+	0x86, 0x08, 0x28, 0x0b, 0x84, 0x12, 0xca, 0x43, 0x66, 0xfc, 0x26, 0x50, 0xca, 0xbc, 0xd3, 0xf3,
+	0x7c, 0x6a, 0xf2, 0xbf, 0xc9, 0x27, 0x95, 0x50, 0x4a, 0xba, 0xd8, 0xe4, 0xab, 0x76, 0xb4, 0x67,
+	0x76, 0xa2, 0xc0, 0x09, 0x3d, 0xea, 0xa7, 0x71, 0x97, 0x33, 0x9b, 0x6d, 0x87, 0x61, 0xf3, 0xa0,
+	0xd1, 0xc6, 0xa1, 0xd3, 0x30, 0x5d, 0xea, 0xa5, 0xf1, 0xe5, 0x52, 0x45, 0xf1, 0x23, 0x09, 0x2d,
+	0x25, 0xa9, 0x3d, 0x46, 0xcc, 0x83, 0x46, 0xfc, 0x10, 0x01, 0xf4, 0x5b, 0x05, 0xde, 0xb4, 0x18,
+	0xd9, 0xa6, 0xee, 0xfe, 0x0e, 0xdd, 0xc7, 0x3e, 0x93, 0x6f, 0xc1, 0x0c, 0x3d, 0xf4, 0x71, 0x50,
+	0x97, 0x56, 0xa4, 0xb5, 0xb9, 0xd6, 0x8d, 0xb3, 0x81, 0x76, 0xfd, 0xd8, 0xe9, 0x75, 0x9b, 0x88,
+	0x7f, 0x46, 0xb6, 0x08, 0xcb, 0x8f, 0x61, 0x36, 0xad, 0xaf, 0x5e, 0x59, 0x91, 0xd6, 0xae, 0x6d,
+	0x2c, 0x1b, 0x42, 0x80, 0x91, 0x0a, 0x30, 0xb6, 0x12, 0x40, 0xab, 0xf1, 0x74, 0xa0, 0x4d, 0xfd,
+	0x3d, 0xd0, 0xe4, 0x34, 0xe5, 0x2e, 0xed, 0x79, 0x21, 0xee, 0xf5, 0xc3, 0xe3, 0xb3, 0x81, 0x56,
+	0x13, 0xfc, 0x69, 0x0c, 0xfd, 0xfc, 0x5c, 0x93, 0xec, 0x8c, 0x5d, 0x76, 0x60, 0x26, 0x56, 0xc9,
+	0xea, 0xd3, 0x2b, 0xd3, 0x7c, 0x1b, 0x21, 0xc6, 0x88, 0x7d, 0x30, 0x12, 0x1f, 0x8c, 0x07, 0xd4,
+	0xf3, 0x5b, 0x1f, 0xc5, 0xdb, 0xfc, 0xf2, 0x5c, 0x5b, 0x23, 0x5e, 0xf8, 0x38, 0x6a, 0x1b, 0x2e,
+	0xed, 0x99, 0x89, 0x72, 0xf1, 0xd0, 0x59, 0x67, 0xdf, 0x0c, 0x8f, 0xfb, 0x98, 0xf1, 0x04, 0x66,
+	0x0b, 0xe6, 0xe6, 0xed, 0x1f, 0x5e, 0x3e, 0x59, 0x17, 0xc2, 0x7e, 0x7c, 0xf9, 0x64, 0x5d, 0x19,
+	0xe2, 0xa4, 0x1e, 0x72, 0x7f, 0xd0, 0x2a, 0xbc, 0x5d, 0x30, 0xcc, 0xc6, 0xac, 0x4f, 0x7d, 0x86,
+	0xe5, 0x2a, 0x54, 0x1e, 0x6e, 0x71, 0xd7, 0xde, 0xb0, 0x2b, 0x0f, 0xb7, 0xd0, 0x77, 0xb0, 0x60,
+	0x31, 0xd2, 0xc2, 0xc4, 0xf3, 0x1f, 0xf9, 0x31, 0x83, 0xe7, 0x93, 0xfb, 0xdd, 0xee, 0xa4, 0x06,
+	0x37, 0x1b, 0xc5, 0x9a, 0x50, 0xa9, 0xa6, 0x76, 0x4c, 0xac, 0x47, 0x7e, 0xbe, 0xb6, 0x1d, 0xb8,
+	0x39, 0x6c, 0xcb, 0xac, 0xc4, 0x8f, 0xe1, 0xaa, 0x48, 0x60, 0x75, 0x89, 0x7b, 0xa9, 0x18, 0xc5,
+	0x66, 0x35, 0xbe, 0xc4, 0x81, 0x47, 0x3b, 0xb1, 0x3a, 0x3b, 0x85, 0xa2, 0xbf, 0x24, 0x98, 0x3f,
+	0x47, 0x3b, 0x71, 0x9f, 0x08, 0x5b, 0x2a, 0xa9, 0x2d, 0xff, 0xc7, 0x7f, 0xf3, 0x93, 0xa2, 0x73,
+	0xab, 0xa3, 0x9c, 0xeb, 0x73, 0xc1, 0x7a, 0xfc, 0x8e, 0x76, 0x61, 0xf9, 0x9c, 0xce, 0xcc, 0xbb,
+	0x3a, 0x5c, 0x65, 0x91, 0xeb, 0x62, 0xc6, 0xb8, 0xe2, 0x59, 0x3b, 0x5d, 0xca, 0x6b, 0x50, 0x8b,
+	0x52, 0x78, 0xec, 0x5c, 0x26, 0xb7, 0xfc, 0x19, 0xbd, 0x90, 0xa0, 0x66, 0x31, 0xf2, 0xd9, 0x51,
+	0x88, 0x7d, 0x6e, 0x72, 0xd4, 0x7f, 0x65, 0x1f, 0xf3, 0xf3, 0x37, 0xfd, 0x5f, 0xce, 0x5f, 0xf3,
+	0x4e, 0xd1, 0xce, 0x9b, 0x25, 0x3b, 0x31, 0x57, 0xa3, 0x8b, 0x15, 0xda, 0x84, 0xa5, 0x92, 0xc2,
+	0xf1, 0x0e, 0xa2, 0x81, 0x04, 0x55, 0x8b, 0x91, 0xcf, 0x69, 0xe0, 0x62, 0xe1, 0xfc, 0xeb, 0xdc,
+	0x5e, 0x63, 0x06, 0x73, 0x2f, 0x56, 0x51, 0x1a, 0xcc, 0x0d, 0x58, 0x2c, 0xea, 0x9b, 0xc0, 0x94,
+	0x3f, 0x25, 0x78, 0xc7, 0x62, 0xe4, 0x2b, 0x1c, 0xda, 0xf8, 0xd0, 0x09, 0x3a, 0x36, 0x76, 0xb1,
+	0x77, 0x80, 0x83, 0xfb, 0x9d, 0x4e, 0x10, 0xb7, 0xdd, 0xa4, 0x0e, 0x2d, 0xc2, 0x95, 0x6e, 0xbe,
+	0x2b, 0x93, 0x95, 0xfc, 0x00, 0x6a, 0x01, 0x27, 0xde, 0x0d, 0x12, 0x66, 0xde, 0x47, 0x73, 0x2d,
+	0xe5, 0x6c, 0xa0, 0x2d, 0x0a, 0xa6, 0x12, 0x00, 0xd9, 0xd5, 0xa0, 0x50, 0x4b, 0xf3, 0x5e, 0xd1,
+	0x8b, 0xf5, 0x92, 0x17, 0x0c, 0x87, 0xba, 0xc8, 0xd0, 0x53, 0x0e, 0xdd, 0x11, 0xf5, 0xa3, 0x4f,
+	0xe1, 0xfd, 0x11, 0xf2, 0x26, 0x30, 0xe8, 0x77, 0x31, 0x4d, 0x3b, 0x81, 0xe3, 0xb3, 0x3d, 0x1c,
+	0x6c, 0x5f, 0xa6, 0x6d, 0x2e, 0x32, 0xa5, 0x01, 0x73, 0x3e, 0x3e, 0xdc, 0x15, 0x1c, 0xc2, 0x8e,
+	0x85, 0xb3, 0x81, 0x76, 0x43, 0x70, 0x64, 0x21, 0x64, 0xcf, 0xfa, 0xf8, 0xf0, 0x8b, 0xf8, 0x75,
+	0xdc, 0x78, 0x84, 0x49, 0x79, 0xe2, 0x88, 0x11, 0xe3, 0x91, 0x2f, 0x79, 0x02, 0xa1, 0x16, 0xbc,
+	0x65, 0x31, 0x22, 0x1a, 0xe7, 0xdf, 0x03, 0xfa, 0x55, 0x47, 0x04, 0xdd, 0x83, 0x5a, 0x46, 0x77,
+	0xb9, 0x4b, 0x7f, 0xe3, 0xd7, 0x19, 0x98, 0xb6, 0x18, 0x91, 0x6d, 0x80, 0xdc, 0x4f, 0x86, 0x77,
+	0xcb, 0xb7, 0x48, 0xe1, 0x82, 0x54, 0x3e, 0x1c, 0x19, 0xce, 0xf4, 0x13, 0x98, 0x3f, 0x7f, 0x59,
+	0x7e, 0x30, 0x24, 0xf7, 0x1c, 0x4a, 0xb9, 0x3b, 0x09, 0x2a, 0xdb, 0xe8, 0x5b, 0xa8, 0x16, 0x83,
+	0xf2, 0x7b, 0x63, 0xf3, 0x95, 0xdb, 0x63, 0x21, 0x19, 0xff, 0xd7, 0x70, 0xbd, 0x70, 0xc2, 0x6b,
+	0x43, 0x52, 0xf3, 0x00, 0x65, 0x75, 0x0c, 0x20, 0x63, 0x7e, 0x04, 0xd7, 0xf2, 0x67, 0xa4, 0x3a,
+	0x24, 0x2f, 0x17, 0x57, 0x6e, 0x8d, 0x8e, 0x67, 0xb4, 0xdf, 0x43, 0xfd, 0xc2, 0x53, 0xe6, 0xce,
+	0x10, 0x8e, 0x8b, 0xc0, 0xca, 0xe6, 0x25, 0xc0, 0x79, 0xbb, 0x0a, 0x23, 0x3c, 0xcc, 0xae, 0x3c,
+	0x40, 0x59, 0x1d, 0x03, 0x48, 0x99, 0x5b, 0xdb, 0x4f, 0x4f, 0x54, 0xe9, 0xd9, 0x89, 0x2a, 0xbd,
+	0x38, 0x51, 0xa5, 0x9f, 0x4e, 0xd5, 0xa9, 0x67, 0xa7, 0xea, 0xd4, 0x1f, 0xa7, 0xea, 0xd4, 0x37,
+	0x1b, 0xb9, 0x33, 0x3f, 0x21, 0xd3, 0xbb, 0x4e, 0x9b, 0xa5, 0x0b, 0xf3, 0x60, 0xb3, 0x61, 0x1e,
+	0x65, 0x23, 0x1c, 0xdf, 0x01, 0xed, 0x2b, 0xfc, 0x5a, 0xdd, 0xfc, 0x67, 0x00, 0x6f, 0x9d, 0x75,
+	0x8e, 0xf9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -859,6 +971,8 @@ type MsgClient interface {
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+	// TransferLock transfers the ownership of the given lock ID to a new owner
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
 }
 
 type msgClient struct {
@@ -923,6 +1037,15 @@ func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewa
 	return out, nil
 }
 
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/TransferLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -936,6 +1059,8 @@ type MsgServer interface {
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+	// TransferLock transfers the ownership of the given lock ID to a new owner
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -960,6 +1085,9 @@ func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnl
 func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
 }
+func (*UnimplementedMsgServer) TransferLock(ctx context.Context, req *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1073,6 +1201,24 @@ func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/TransferLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1101,6 +1247,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRewardReceiverAddress",
 			Handler:    _Msg_SetRewardReceiverAddress_Handler,
 		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1573,6 +1723,81 @@ func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTransferLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUnlockPeriodLock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1843,6 +2068,38 @@ func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTransferLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockID != 0 {
+		n += 1 + sovTx(uint64(m.LockID))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
 func (m *MsgUnlockPeriodLock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3098,6 +3355,209 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTransferLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+			}
+			m.LockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUnlockPeriodLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSwapExactAmountOut)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountIn)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSinglePoolSwapExactAmountOut)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateSmartRouteSwapExactAmountIn)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSwapIntents)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdSpotPrice)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalPoolLiquidity)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
@@ -170,6 +172,29 @@ func GetCmdEstimateSinglePoolSwapExactAmountIn() (*osmocli.QueryDescriptor, *que
 	}, &queryproto.EstimateSinglePoolSwapExactAmountInRequest{}
 }
 
+// GetCmdEstimateSmartRouteSwapExactAmountIn returns estimation of output coin and the routes found
+// by the on-chain smart order router when swapping an amount of x token input.
+func GetCmdEstimateSmartRouteSwapExactAmountIn() (*osmocli.QueryDescriptor, *queryproto.EstimateSmartRouteSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "estimate-smart-route-swap-exact-amount-in",
+		Short: "Query estimate-smart-route-swap-exact-amount-in",
+		Long: `Query estimate-smart-route-swap-exact-amount-in.{{.ExampleHeader}}
+{{.CommandPrefix}} estimate-smart-route-swap-exact-amount-in 1000stake uosmo`,
+		QueryFnName: "EstimateSmartRouteSwapExactAmountIn",
+	}, &queryproto.EstimateSmartRouteSwapExactAmountInRequest{}
+}
+
+// GetCmdSwapIntents returns the swap intents included in the given block.
+func GetCmdSwapIntents() (*osmocli.QueryDescriptor, *queryproto.SwapIntentsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "swap-intents",
+		Short: "Query the swap intents included in a block",
+		Long: `Query the swap intents included in a block.{{.ExampleHeader}}
+{{.CommandPrefix}} swap-intents 100`,
+		QueryFnName: "SwapIntents",
+	}, &queryproto.SwapIntentsRequest{}
+}
+
 // GetCmdEstimateSinglePoolSwapExactAmountOut returns estimation of input coin to get exact amount of x token output.
 func GetCmdEstimateSinglePoolSwapExactAmountOut() (*osmocli.QueryDescriptor, *queryproto.EstimateSinglePoolSwapExactAmountOutRequest) {
 	return &osmocli.QueryDescriptor{
@@ -37,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewSmartRouteSwapExactAmountInCmd)
+	osmocli.AddTxCmd(txCmd, NewSubmitSwapIntentCmd)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
 
 	txCmd.AddCommand(
@@ -116,6 +118,23 @@ func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteS
 	}, &types.MsgSplitRouteSwapExactAmountIn{}
 }
 
+func NewSmartRouteSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSmartRouteSwapExactAmountIn) {
+	return &osmocli.TxCliDesc{
+		Use:     "smart-route-swap-exact-amount-in",
+		Short:   "swap exact amount in through the routes found by the on-chain smart order router",
+		Example: "osmosisd tx poolmanager smart-route-swap-exact-amount-in 2000000uosmo uion 1 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgSmartRouteSwapExactAmountIn{}
+}
+
+func NewSubmitSwapIntentCmd() (*osmocli.TxCliDesc, *types.MsgSubmitSwapIntent) {
+	return &osmocli.TxCliDesc{
+		Use:     "submit-swap-intent",
+		Short:   "submit a swap intent to be settled at the end of the block at a uniform clearing price",
+		Long:    "submit a swap intent to be settled at the end of the block at a uniform clearing price. The deadline is either a unix timestamp or a time in the sortable time format. If refund-if-unsettled is true, the intent is refunded rather than kept pending if it is not settled in the block it is included in.",
+		Example: "osmosisd tx poolmanager submit-swap-intent 2000000uosmo uion 1000000 1700000000 true --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+	}, &types.MsgSubmitSwapIntent{}
+}
+
 func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountOut) {
 	return &osmocli.TxCliDesc{
 		Use:   "split-route-swap-exact-amount-out",
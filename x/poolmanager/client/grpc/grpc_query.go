@@ -81,6 +81,16 @@ func (q Querier) TakerFeeShareAgreementFromDenom(grpcCtx context.Context,
 	return q.Q.TakerFeeShareAgreementFromDenom(ctx, *req)
 }
 
+func (q Querier) SwapIntents(grpcCtx context.Context,
+	req *queryproto.SwapIntentsRequest,
+) (*queryproto.SwapIntentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.SwapIntents(ctx, *req)
+}
+
 func (q Querier) SpotPrice(grpcCtx context.Context,
 	req *queryproto.SpotPriceRequest,
 ) (*queryproto.SpotPriceResponse, error) {
@@ -201,6 +211,16 @@ func (q Querier) EstimateSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) EstimateSmartRouteSwapExactAmountIn(grpcCtx context.Context,
+	req *queryproto.EstimateSmartRouteSwapExactAmountInRequest,
+) (*queryproto.EstimateSmartRouteSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.EstimateSmartRouteSwapExactAmountIn(ctx, *req)
+}
+
 func (q Querier) EstimateSinglePoolSwapExactAmountOut(grpcCtx context.Context,
 	req *queryproto.EstimateSinglePoolSwapExactAmountOutRequest,
 ) (*queryproto.EstimateSwapExactAmountOutResponse, error) {
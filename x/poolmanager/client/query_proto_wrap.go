@@ -62,6 +62,33 @@ func (q Querier) EstimateSwapExactAmountIn(ctx sdk.Context, req queryproto.Estim
 	}, nil
 }
 
+// EstimateSmartRouteSwapExactAmountIn estimates the amount out of swapping the given token in
+// through the routes found by the smart order router, and returns those routes.
+func (q Querier) EstimateSmartRouteSwapExactAmountIn(ctx sdk.Context, req queryproto.EstimateSmartRouteSwapExactAmountInRequest) (*queryproto.EstimateSmartRouteSwapExactAmountInResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	routes, tokenOutAmount, err := q.K.FindSmartRoute(ctx, tokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.EstimateSmartRouteSwapExactAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+		Routes:         routes,
+	}, nil
+}
+
 // EstimateSwapExactAmountInWithPrimitiveTypes runs same logic with EstimateSwapExactAmountIn
 // but instead takes array of primitive types in the request to support query through grpc-gateway.
 func (q Querier) EstimateSwapExactAmountInWithPrimitiveTypes(ctx sdk.Context, req queryproto.EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*queryproto.EstimateSwapExactAmountInResponse, error) {
@@ -479,3 +506,18 @@ func (q Querier) AllRegisteredAlloyedPools(ctx sdk.Context, req queryproto.AllRe
 		ContractStates: contractStates,
 	}, nil
 }
+
+func (q Querier) SwapIntents(ctx sdk.Context, req queryproto.SwapIntentsRequest) (*queryproto.SwapIntentsResponse, error) {
+	if req.BlockHeight <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "block height must be positive")
+	}
+
+	intents, err := q.K.GetSwapIntents(ctx, req.BlockHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.SwapIntentsResponse{
+		Intents: intents,
+	}, nil
+}
@@ -364,6 +364,111 @@ func (m *EstimateSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EstimateSwapExactAmountInResponse proto.InternalMessageInfo
 
+type EstimateSmartRouteSwapExactAmountInRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInRequest) Reset() {
+	*m = EstimateSmartRouteSwapExactAmountInRequest{}
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EstimateSmartRouteSwapExactAmountInRequest) ProtoMessage() {}
+func (*EstimateSmartRouteSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{6}
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSmartRouteSwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSmartRouteSwapExactAmountInRequest.Merge(m, src)
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateSmartRouteSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSmartRouteSwapExactAmountInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateSmartRouteSwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *EstimateSmartRouteSwapExactAmountInRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type EstimateSmartRouteSwapExactAmountInResponse struct {
+	TokenOutAmount cosmossdk_io_math.Int          `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	Routes         []types.SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) Reset() {
+	*m = EstimateSmartRouteSwapExactAmountInResponse{}
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EstimateSmartRouteSwapExactAmountInResponse) ProtoMessage() {}
+func (*EstimateSmartRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{7}
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSmartRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSmartRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateSmartRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSmartRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateSmartRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) GetRoutes() []types.SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 // =============================== EstimateSwapExactAmountOut
 type EstimateSwapExactAmountOutRequest struct {
 	// DEPRECATED
@@ -377,7 +482,7 @@ func (m *EstimateSwapExactAmountOutRequest) Reset()         { *m = EstimateSwapE
 func (m *EstimateSwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateSwapExactAmountOutRequest) ProtoMessage()    {}
 func (*EstimateSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{6}
+	return fileDescriptor_6256a4106f701b7d, []int{8}
 }
 func (m *EstimateSwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +556,7 @@ func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) String() string {
 }
 func (*EstimateSwapExactAmountOutWithPrimitiveTypesRequest) ProtoMessage() {}
 func (*EstimateSwapExactAmountOutWithPrimitiveTypesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{7}
+	return fileDescriptor_6256a4106f701b7d, []int{9}
 }
 func (m *EstimateSwapExactAmountOutWithPrimitiveTypesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +628,7 @@ func (m *EstimateSinglePoolSwapExactAmountOutRequest) String() string {
 }
 func (*EstimateSinglePoolSwapExactAmountOutRequest) ProtoMessage() {}
 func (*EstimateSinglePoolSwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{8}
+	return fileDescriptor_6256a4106f701b7d, []int{10}
 }
 func (m *EstimateSinglePoolSwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +686,7 @@ func (m *EstimateSwapExactAmountOutResponse) Reset()         { *m = EstimateSwap
 func (m *EstimateSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*EstimateSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{9}
+	return fileDescriptor_6256a4106f701b7d, []int{11}
 }
 func (m *EstimateSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +723,7 @@ func (m *NumPoolsRequest) Reset()         { *m = NumPoolsRequest{} }
 func (m *NumPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*NumPoolsRequest) ProtoMessage()    {}
 func (*NumPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{10}
+	return fileDescriptor_6256a4106f701b7d, []int{12}
 }
 func (m *NumPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +760,7 @@ func (m *NumPoolsResponse) Reset()         { *m = NumPoolsResponse{} }
 func (m *NumPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*NumPoolsResponse) ProtoMessage()    {}
 func (*NumPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{11}
+	return fileDescriptor_6256a4106f701b7d, []int{13}
 }
 func (m *NumPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +805,7 @@ func (m *PoolRequest) Reset()         { *m = PoolRequest{} }
 func (m *PoolRequest) String() string { return proto.CompactTextString(m) }
 func (*PoolRequest) ProtoMessage()    {}
 func (*PoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{12}
+	return fileDescriptor_6256a4106f701b7d, []int{14}
 }
 func (m *PoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +849,7 @@ func (m *PoolResponse) Reset()         { *m = PoolResponse{} }
 func (m *PoolResponse) String() string { return proto.CompactTextString(m) }
 func (*PoolResponse) ProtoMessage()    {}
 func (*PoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{13}
+	return fileDescriptor_6256a4106f701b7d, []int{15}
 }
 func (m *PoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +893,7 @@ func (m *AllPoolsRequest) Reset()         { *m = AllPoolsRequest{} }
 func (m *AllPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllPoolsRequest) ProtoMessage()    {}
 func (*AllPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{14}
+	return fileDescriptor_6256a4106f701b7d, []int{16}
 }
 func (m *AllPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +930,7 @@ func (m *AllPoolsResponse) Reset()         { *m = AllPoolsResponse{} }
 func (m *AllPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllPoolsResponse) ProtoMessage()    {}
 func (*AllPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{15}
+	return fileDescriptor_6256a4106f701b7d, []int{17}
 }
 func (m *AllPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +976,7 @@ func (m *ListPoolsByDenomRequest) Reset()         { *m = ListPoolsByDenomRequest
 func (m *ListPoolsByDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPoolsByDenomRequest) ProtoMessage()    {}
 func (*ListPoolsByDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{16}
+	return fileDescriptor_6256a4106f701b7d, []int{18}
 }
 func (m *ListPoolsByDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +1020,7 @@ func (m *ListPoolsByDenomResponse) Reset()         { *m = ListPoolsByDenomRespon
 func (m *ListPoolsByDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPoolsByDenomResponse) ProtoMessage()    {}
 func (*ListPoolsByDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{17}
+	return fileDescriptor_6256a4106f701b7d, []int{19}
 }
 func (m *ListPoolsByDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,7 +1069,7 @@ func (m *SpotPriceRequest) Reset()         { *m = SpotPriceRequest{} }
 func (m *SpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*SpotPriceRequest) ProtoMessage()    {}
 func (*SpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{18}
+	return fileDescriptor_6256a4106f701b7d, []int{20}
 }
 func (m *SpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1025,7 +1130,7 @@ func (m *SpotPriceResponse) Reset()         { *m = SpotPriceResponse{} }
 func (m *SpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*SpotPriceResponse) ProtoMessage()    {}
 func (*SpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{19}
+	return fileDescriptor_6256a4106f701b7d, []int{21}
 }
 func (m *SpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,7 +1175,7 @@ func (m *TotalPoolLiquidityRequest) Reset()         { *m = TotalPoolLiquidityReq
 func (m *TotalPoolLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityRequest) ProtoMessage()    {}
 func (*TotalPoolLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{20}
+	return fileDescriptor_6256a4106f701b7d, []int{22}
 }
 func (m *TotalPoolLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1219,7 @@ func (m *TotalPoolLiquidityResponse) Reset()         { *m = TotalPoolLiquidityRe
 func (m *TotalPoolLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalPoolLiquidityResponse) ProtoMessage()    {}
 func (*TotalPoolLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{21}
+	return fileDescriptor_6256a4106f701b7d, []int{23}
 }
 func (m *TotalPoolLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1263,7 @@ func (m *TotalLiquidityRequest) Reset()         { *m = TotalLiquidityRequest{} }
 func (m *TotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityRequest) ProtoMessage()    {}
 func (*TotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{22}
+	return fileDescriptor_6256a4106f701b7d, []int{24}
 }
 func (m *TotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1300,7 @@ func (m *TotalLiquidityResponse) Reset()         { *m = TotalLiquidityResponse{}
 func (m *TotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalLiquidityResponse) ProtoMessage()    {}
 func (*TotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{23}
+	return fileDescriptor_6256a4106f701b7d, []int{25}
 }
 func (m *TotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,7 +1345,7 @@ func (m *TotalVolumeForPoolRequest) Reset()         { *m = TotalVolumeForPoolReq
 func (m *TotalVolumeForPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolRequest) ProtoMessage()    {}
 func (*TotalVolumeForPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{24}
+	return fileDescriptor_6256a4106f701b7d, []int{26}
 }
 func (m *TotalVolumeForPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1284,7 +1389,7 @@ func (m *TotalVolumeForPoolResponse) Reset()         { *m = TotalVolumeForPoolRe
 func (m *TotalVolumeForPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalVolumeForPoolResponse) ProtoMessage()    {}
 func (*TotalVolumeForPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{25}
+	return fileDescriptor_6256a4106f701b7d, []int{27}
 }
 func (m *TotalVolumeForPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1330,7 +1435,7 @@ func (m *TradingPairTakerFeeRequest) Reset()         { *m = TradingPairTakerFeeR
 func (m *TradingPairTakerFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeRequest) ProtoMessage()    {}
 func (*TradingPairTakerFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{26}
+	return fileDescriptor_6256a4106f701b7d, []int{28}
 }
 func (m *TradingPairTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1381,7 +1486,7 @@ func (m *TradingPairTakerFeeResponse) Reset()         { *m = TradingPairTakerFee
 func (m *TradingPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeResponse) ProtoMessage()    {}
 func (*TradingPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{27}
+	return fileDescriptor_6256a4106f701b7d, []int{29}
 }
 func (m *TradingPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1437,7 +1542,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{28}
+	return fileDescriptor_6256a4106f701b7d, []int{30}
 }
 func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1611,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{29}
+	return fileDescriptor_6256a4106f701b7d, []int{31}
 }
 func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1661,7 @@ func (m *AllTakerFeeShareAgreementsRequest) Reset()         { *m = AllTakerFeeSh
 func (m *AllTakerFeeShareAgreementsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{30}
+	return fileDescriptor_6256a4106f701b7d, []int{32}
 }
 func (m *AllTakerFeeShareAgreementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1698,7 @@ func (m *AllTakerFeeShareAgreementsResponse) Reset()         { *m = AllTakerFeeS
 func (m *AllTakerFeeShareAgreementsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAgreementsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAgreementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{31}
+	return fileDescriptor_6256a4106f701b7d, []int{33}
 }
 func (m *AllTakerFeeShareAgreementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1639,7 +1744,7 @@ func (m *TakerFeeShareAgreementFromDenomRequest) Reset() {
 func (m *TakerFeeShareAgreementFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomRequest) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{32}
+	return fileDescriptor_6256a4106f701b7d, []int{34}
 }
 func (m *TakerFeeShareAgreementFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1685,7 +1790,7 @@ func (m *TakerFeeShareAgreementFromDenomResponse) Reset() {
 func (m *TakerFeeShareAgreementFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareAgreementFromDenomResponse) ProtoMessage()    {}
 func (*TakerFeeShareAgreementFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{33}
+	return fileDescriptor_6256a4106f701b7d, []int{35}
 }
 func (m *TakerFeeShareAgreementFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,7 +1837,7 @@ func (m *TakerFeeShareDenomsToAccruedValueRequest) Reset() {
 func (m *TakerFeeShareDenomsToAccruedValueRequest) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeShareDenomsToAccruedValueRequest) ProtoMessage()    {}
 func (*TakerFeeShareDenomsToAccruedValueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{34}
+	return fileDescriptor_6256a4106f701b7d, []int{36}
 }
 func (m *TakerFeeShareDenomsToAccruedValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1787,7 +1892,7 @@ func (m *TakerFeeShareDenomsToAccruedValueResponse) String() string {
 }
 func (*TakerFeeShareDenomsToAccruedValueResponse) ProtoMessage() {}
 func (*TakerFeeShareDenomsToAccruedValueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{35}
+	return fileDescriptor_6256a4106f701b7d, []int{37}
 }
 func (m *TakerFeeShareDenomsToAccruedValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +1928,7 @@ func (m *AllTakerFeeShareAccumulatorsRequest) Reset()         { *m = AllTakerFee
 func (m *AllTakerFeeShareAccumulatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsRequest) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{36}
+	return fileDescriptor_6256a4106f701b7d, []int{38}
 }
 func (m *AllTakerFeeShareAccumulatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,7 +1965,7 @@ func (m *AllTakerFeeShareAccumulatorsResponse) Reset()         { *m = AllTakerFe
 func (m *AllTakerFeeShareAccumulatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllTakerFeeShareAccumulatorsResponse) ProtoMessage()    {}
 func (*AllTakerFeeShareAccumulatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{37}
+	return fileDescriptor_6256a4106f701b7d, []int{39}
 }
 func (m *AllTakerFeeShareAccumulatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2009,7 @@ func (m *RegisteredAlloyedPoolFromDenomRequest) Reset()         { *m = Registere
 func (m *RegisteredAlloyedPoolFromDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{38}
+	return fileDescriptor_6256a4106f701b7d, []int{40}
 }
 func (m *RegisteredAlloyedPoolFromDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,7 +2055,7 @@ func (m *RegisteredAlloyedPoolFromDenomResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromDenomResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{39}
+	return fileDescriptor_6256a4106f701b7d, []int{41}
 }
 func (m *RegisteredAlloyedPoolFromDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1996,7 +2101,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdRequest) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdRequest) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{40}
+	return fileDescriptor_6256a4106f701b7d, []int{42}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2042,7 +2147,7 @@ func (m *RegisteredAlloyedPoolFromPoolIdResponse) Reset() {
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisteredAlloyedPoolFromPoolIdResponse) ProtoMessage()    {}
 func (*RegisteredAlloyedPoolFromPoolIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{41}
+	return fileDescriptor_6256a4106f701b7d, []int{43}
 }
 func (m *RegisteredAlloyedPoolFromPoolIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,7 +2190,7 @@ func (m *AllRegisteredAlloyedPoolsRequest) Reset()         { *m = AllRegisteredA
 func (m *AllRegisteredAlloyedPoolsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsRequest) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{42}
+	return fileDescriptor_6256a4106f701b7d, []int{44}
 }
 func (m *AllRegisteredAlloyedPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2122,7 +2227,7 @@ func (m *AllRegisteredAlloyedPoolsResponse) Reset()         { *m = AllRegistered
 func (m *AllRegisteredAlloyedPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllRegisteredAlloyedPoolsResponse) ProtoMessage()    {}
 func (*AllRegisteredAlloyedPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{43}
+	return fileDescriptor_6256a4106f701b7d, []int{45}
 }
 func (m *AllRegisteredAlloyedPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2158,6 +2263,94 @@ func (m *AllRegisteredAlloyedPoolsResponse) GetContractStates() []types.AlloyCon
 	return nil
 }
 
+type SwapIntentsRequest struct {
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+}
+
+func (m *SwapIntentsRequest) Reset()         { *m = SwapIntentsRequest{} }
+func (m *SwapIntentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SwapIntentsRequest) ProtoMessage()    {}
+func (*SwapIntentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{46}
+}
+func (m *SwapIntentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapIntentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapIntentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapIntentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapIntentsRequest.Merge(m, src)
+}
+func (m *SwapIntentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapIntentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapIntentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapIntentsRequest proto.InternalMessageInfo
+
+func (m *SwapIntentsRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type SwapIntentsResponse struct {
+	Intents []types.SwapIntent `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents" yaml:"intents"`
+}
+
+func (m *SwapIntentsResponse) Reset()         { *m = SwapIntentsResponse{} }
+func (m *SwapIntentsResponse) String() string { return proto.CompactTextString(m) }
+func (*SwapIntentsResponse) ProtoMessage()    {}
+func (*SwapIntentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{47}
+}
+func (m *SwapIntentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapIntentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapIntentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapIntentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapIntentsResponse.Merge(m, src)
+}
+func (m *SwapIntentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapIntentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapIntentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapIntentsResponse proto.InternalMessageInfo
+
+func (m *SwapIntentsResponse) GetIntents() []types.SwapIntent {
+	if m != nil {
+		return m.Intents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
@@ -2165,6 +2358,8 @@ func init() {
 	proto.RegisterType((*EstimateSwapExactAmountInWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInWithPrimitiveTypesRequest")
 	proto.RegisterType((*EstimateSinglePoolSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountInRequest")
 	proto.RegisterType((*EstimateSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountInResponse")
+	proto.RegisterType((*EstimateSmartRouteSwapExactAmountInRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSmartRouteSwapExactAmountInRequest")
+	proto.RegisterType((*EstimateSmartRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateSmartRouteSwapExactAmountInResponse")
 	proto.RegisterType((*EstimateSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutRequest")
 	proto.RegisterType((*EstimateSwapExactAmountOutWithPrimitiveTypesRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSwapExactAmountOutWithPrimitiveTypesRequest")
 	proto.RegisterType((*EstimateSinglePoolSwapExactAmountOutRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateSinglePoolSwapExactAmountOutRequest")
@@ -2203,6 +2398,8 @@ func init() {
 	proto.RegisterType((*RegisteredAlloyedPoolFromPoolIdResponse)(nil), "osmosis.poolmanager.v1beta1.RegisteredAlloyedPoolFromPoolIdResponse")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsRequest)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsRequest")
 	proto.RegisterType((*AllRegisteredAlloyedPoolsResponse)(nil), "osmosis.poolmanager.v1beta1.AllRegisteredAlloyedPoolsResponse")
+	proto.RegisterType((*SwapIntentsRequest)(nil), "osmosis.poolmanager.v1beta1.SwapIntentsRequest")
+	proto.RegisterType((*SwapIntentsResponse)(nil), "osmosis.poolmanager.v1beta1.SwapIntentsResponse")
 }
 
 func init() {
@@ -2210,173 +2407,186 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4e, 0x8f, 0x1d, 0xaf, 0xfd, 0x12, 0xff, 0x49, 0x6d, 0x1c, 0xdb, 0x93, 0xfc, 0x3c, 0x4e,
-	0x39, 0x71, 0x9c, 0x4d, 0x3c, 0xb3, 0xb6, 0xb3, 0xbf, 0x0d, 0xd9, 0x75, 0x92, 0x19, 0xff, 0x49,
-	0xcc, 0x26, 0xc4, 0x19, 0x9b, 0x2c, 0x2c, 0x9b, 0x6d, 0xb5, 0x67, 0x2a, 0xe3, 0x96, 0xa7, 0xbb,
-	0x27, 0xdd, 0xd5, 0x8e, 0x47, 0x28, 0x07, 0x90, 0x10, 0x9c, 0x50, 0x60, 0x91, 0x16, 0x09, 0xa4,
-	0xd5, 0x1e, 0xb8, 0xc0, 0x01, 0x21, 0x21, 0x24, 0x2e, 0x70, 0xe1, 0x10, 0x21, 0x81, 0x22, 0x71,
-	0x00, 0x21, 0x31, 0xa0, 0x84, 0x03, 0x02, 0x4e, 0x73, 0xe4, 0x02, 0xea, 0xaa, 0xea, 0x9e, 0x9e,
-	0xf1, 0x4c, 0x77, 0xcf, 0x4c, 0x40, 0x7b, 0x8a, 0x5d, 0xf5, 0xde, 0xab, 0xf7, 0x7d, 0xf5, 0x5e,
-	0x55, 0xf5, 0x17, 0xc3, 0x39, 0xc3, 0xd2, 0x0c, 0x4b, 0xb5, 0x52, 0x25, 0xc3, 0x28, 0x6a, 0x8a,
-	0xae, 0x14, 0x88, 0x99, 0xda, 0x9b, 0xdf, 0x26, 0x54, 0x99, 0x4f, 0x3d, 0xb4, 0x89, 0x59, 0x4e,
-	0x96, 0x4c, 0x83, 0x1a, 0xe8, 0xa4, 0x30, 0x4c, 0xfa, 0x0c, 0x93, 0xc2, 0x30, 0x7e, 0xbc, 0x60,
-	0x14, 0x0c, 0x66, 0x97, 0x72, 0x7e, 0xe2, 0x2e, 0xf1, 0xf3, 0x41, 0xb1, 0x0b, 0x44, 0x27, 0x2c,
-	0x1c, 0x33, 0x3d, 0x13, 0x64, 0x4a, 0xf7, 0x85, 0xd5, 0xc5, 0x20, 0x2b, 0xeb, 0x91, 0x52, 0x92,
-	0x4d, 0xc3, 0xa6, 0x44, 0x58, 0xcf, 0x07, 0xc6, 0x54, 0x76, 0x89, 0x29, 0x3f, 0x20, 0x44, 0xb6,
-	0x76, 0x14, 0xd3, 0x75, 0x99, 0xcc, 0x31, 0x9f, 0xd4, 0xb6, 0x62, 0x11, 0xcf, 0x34, 0x67, 0xa8,
-	0xba, 0x98, 0x7f, 0xcd, 0x3f, 0xcf, 0xd8, 0xf1, 0xac, 0x4a, 0x4a, 0x41, 0xd5, 0x15, 0xaa, 0x1a,
-	0xae, 0xed, 0xa9, 0x82, 0x61, 0x14, 0x8a, 0x24, 0xa5, 0x94, 0xd4, 0x94, 0xa2, 0xeb, 0x06, 0x65,
-	0x93, 0x2e, 0xe0, 0x09, 0x31, 0xcb, 0x7e, 0xdb, 0xb6, 0x1f, 0xa4, 0x14, 0xbd, 0xec, 0x4e, 0xf1,
-	0x45, 0x64, 0xce, 0x27, 0xff, 0x45, 0x4c, 0x25, 0x1a, 0xbd, 0xa8, 0xaa, 0x11, 0x8b, 0x2a, 0x5a,
-	0x89, 0x1b, 0xe0, 0x61, 0x18, 0xdc, 0x50, 0x4c, 0x45, 0xb3, 0xb2, 0xe4, 0xa1, 0x4d, 0x2c, 0x8a,
-	0x37, 0x61, 0xc8, 0x1d, 0xb0, 0x4a, 0x86, 0x6e, 0x11, 0x94, 0x86, 0xbe, 0x12, 0x1b, 0x19, 0x97,
-	0xa6, 0xa4, 0xd9, 0x23, 0x0b, 0xd3, 0xc9, 0x80, 0x9d, 0x4d, 0x72, 0xe7, 0x4c, 0xef, 0xd3, 0x4a,
-	0xe2, 0x50, 0x56, 0x38, 0xe2, 0x9f, 0xc4, 0x60, 0x6a, 0xd5, 0xa2, 0xaa, 0xa6, 0x50, 0xb2, 0xf9,
-	0x48, 0x29, 0xad, 0xee, 0x2b, 0x39, 0x9a, 0xd6, 0x0c, 0x5b, 0xa7, 0xeb, 0xba, 0x58, 0x19, 0x2d,
-	0x41, 0x9f, 0x45, 0xf4, 0x3c, 0x31, 0xd9, 0x3a, 0x03, 0x99, 0xb3, 0xd5, 0x4a, 0x22, 0x51, 0x56,
-	0xb4, 0xe2, 0x15, 0xcc, 0xc7, 0xf1, 0xc5, 0x3c, 0x29, 0x99, 0x24, 0xa7, 0x50, 0x92, 0xbf, 0x82,
-	0xa9, 0x69, 0x13, 0x3c, 0x2e, 0x65, 0x85, 0x13, 0xba, 0x06, 0xaf, 0x38, 0xf9, 0xc8, 0x6a, 0x7e,
-	0x3c, 0x36, 0x25, 0xcd, 0xf6, 0x66, 0x66, 0xaa, 0x95, 0xc4, 0x14, 0xf7, 0x17, 0x13, 0x2d, 0x02,
-	0x38, 0xb3, 0xeb, 0x79, 0x94, 0x84, 0x7e, 0x6a, 0xec, 0x12, 0x5d, 0x56, 0xf5, 0xf1, 0x1e, 0x96,
-	0xc1, 0xab, 0xd5, 0x4a, 0x62, 0x98, 0x47, 0x70, 0x67, 0x70, 0xf6, 0x15, 0xf6, 0xe3, 0xba, 0x8e,
-	0xee, 0x43, 0x1f, 0xab, 0x1e, 0x6b, 0xbc, 0x77, 0xaa, 0x67, 0xf6, 0xc8, 0x42, 0x32, 0x90, 0x17,
-	0x07, 0xb6, 0x87, 0xd8, 0x71, 0xcb, 0x8c, 0x3a, 0x14, 0x55, 0x2b, 0x89, 0x41, 0xbe, 0x02, 0x8f,
-	0x85, 0xb3, 0x22, 0x28, 0xfe, 0x45, 0x0c, 0x16, 0x5a, 0x72, 0xf6, 0xae, 0x4a, 0x77, 0x36, 0x4c,
-	0x55, 0x53, 0xa9, 0xba, 0x47, 0xb6, 0xca, 0x25, 0xe2, 0xee, 0x9f, 0x9f, 0x06, 0xa9, 0x6b, 0x1a,
-	0x62, 0x11, 0x68, 0xb8, 0x06, 0x43, 0x3c, 0x63, 0xd9, 0x5d, 0xb7, 0x67, 0xaa, 0x67, 0xb6, 0x37,
-	0x33, 0x51, 0xad, 0x24, 0x46, 0xfd, 0xd0, 0xdc, 0x79, 0x9c, 0x3d, 0xca, 0x07, 0x36, 0xf8, 0x82,
-	0xf7, 0xe0, 0x84, 0x30, 0xe0, 0xd1, 0x0d, 0x9b, 0xca, 0x79, 0xa2, 0x1b, 0x1a, 0xe3, 0x75, 0x20,
-	0x73, 0xba, 0x5a, 0x49, 0xfc, 0x5f, 0x5d, 0xa0, 0x06, 0x3b, 0x9c, 0x7d, 0x95, 0x4f, 0x6c, 0x39,
-	0xe3, 0x77, 0x6c, 0xba, 0xc2, 0x46, 0x7f, 0x23, 0xc1, 0x6b, 0x1e, 0x81, 0xaa, 0x5e, 0x28, 0x12,
-	0x67, 0xc1, 0x96, 0xe5, 0x77, 0xa1, 0x91, 0x38, 0x54, 0xad, 0x24, 0x86, 0xea, 0x89, 0xeb, 0x98,
-	0xa4, 0x0c, 0x0c, 0x37, 0x82, 0xe3, 0x25, 0x16, 0xaf, 0x56, 0x12, 0x27, 0xfc, 0x6e, 0x3e, 0x54,
-	0x83, 0xb4, 0x0e, 0xcf, 0xd7, 0x25, 0x38, 0x1d, 0xd0, 0x44, 0xa2, 0x5b, 0xb7, 0x61, 0xa4, 0x16,
-	0x48, 0x61, 0xb3, 0xa2, 0x9f, 0x2e, 0x3b, 0xf5, 0xf6, 0xc7, 0x4a, 0x62, 0x94, 0x9f, 0x10, 0x56,
-	0x7e, 0x37, 0xa9, 0x1a, 0x29, 0x4d, 0xa1, 0x3b, 0xc9, 0x75, 0x9d, 0x56, 0x2b, 0x89, 0xb1, 0xc6,
-	0x3c, 0xb8, 0x3b, 0xce, 0x0e, 0xb9, 0x89, 0xf0, 0xd5, 0xf0, 0xcf, 0x62, 0x2d, 0x33, 0xb9, 0x63,
-	0xd3, 0x4f, 0x4b, 0x3f, 0x7f, 0xe0, 0xf5, 0x67, 0x0f, 0xeb, 0xcf, 0x54, 0xc4, 0xfe, 0x74, 0x20,
-	0x44, 0x68, 0x50, 0x34, 0x0f, 0x03, 0x1e, 0x55, 0xe3, 0xbd, 0x0c, 0xe2, 0xf1, 0x6a, 0x25, 0x31,
-	0xd2, 0xc0, 0x22, 0xce, 0xf6, 0xbb, 0xf4, 0xe1, 0x5f, 0xc6, 0x60, 0xb1, 0x35, 0x71, 0xff, 0xc5,
-	0xa6, 0x3e, 0xd8, 0xa4, 0xb1, 0xf6, 0x9a, 0x74, 0x13, 0x46, 0xeb, 0x9a, 0x4f, 0xd5, 0xbd, 0x32,
-	0x76, 0x7a, 0x74, 0xaa, 0x5a, 0x49, 0x9c, 0x6a, 0xd2, 0xa3, 0xae, 0x19, 0xce, 0x22, 0x5f, 0x8b,
-	0xae, 0xeb, 0xac, 0xa2, 0x3b, 0x61, 0xf0, 0xb7, 0x12, 0x5c, 0x08, 0x6d, 0x6a, 0x5f, 0x11, 0xb6,
-	0xd5, 0xd5, 0xd7, 0x60, 0xa8, 0x01, 0x1d, 0xef, 0x6d, 0x1f, 0x4b, 0x8d, 0xb0, 0x8e, 0xd2, 0x96,
-	0x80, 0x7a, 0x22, 0x01, 0xfa, 0x9a, 0x04, 0x38, 0xa8, 0x97, 0x44, 0x5b, 0xcb, 0xee, 0x01, 0xa2,
-	0xea, 0xf5, 0x5d, 0xfd, 0x66, 0x58, 0x57, 0x9f, 0x68, 0x48, 0xdc, 0x6d, 0xea, 0x41, 0x91, 0xb9,
-	0xe8, 0xe9, 0x63, 0x30, 0xfc, 0x39, 0x5b, 0x73, 0xc8, 0xf4, 0x9e, 0x02, 0xab, 0x30, 0x52, 0x1b,
-	0x12, 0x79, 0xcc, 0xc3, 0x80, 0x6e, 0x6b, 0xac, 0x4a, 0x2c, 0xc1, 0xa8, 0x0f, 0xa1, 0x37, 0x85,
-	0xb3, 0xfd, 0xba, 0x70, 0xc5, 0x57, 0xe0, 0x88, 0xf3, 0x43, 0x27, 0x3b, 0x82, 0x97, 0xe1, 0x28,
-	0xf7, 0x15, 0xcb, 0x2f, 0x42, 0xaf, 0x33, 0x23, 0x5e, 0x22, 0xc7, 0x93, 0xfc, 0x79, 0x93, 0x74,
-	0x9f, 0x37, 0xc9, 0xb4, 0x5e, 0xce, 0x0c, 0xfc, 0xfa, 0xa7, 0x73, 0x87, 0x59, 0xd9, 0x66, 0x99,
-	0xb1, 0x03, 0x2d, 0x5d, 0x2c, 0xd6, 0x41, 0x5b, 0x87, 0x91, 0xda, 0x90, 0x88, 0xfd, 0x06, 0x1c,
-	0x76, 0x61, 0xf5, 0x44, 0x09, 0xce, 0xad, 0x71, 0x1a, 0xc6, 0x6e, 0xa9, 0x16, 0x65, 0xb1, 0x32,
-	0x65, 0x56, 0x07, 0x2e, 0xd4, 0x19, 0x38, 0xcc, 0xcb, 0x88, 0x6f, 0xd5, 0x48, 0xb5, 0x92, 0x38,
-	0xca, 0x81, 0x8a, 0xea, 0xe1, 0xd3, 0xf8, 0x2e, 0x8c, 0x1f, 0x0c, 0xd1, 0x5d, 0x56, 0xcf, 0x24,
-	0x18, 0xd9, 0x2c, 0x19, 0x74, 0xc3, 0x54, 0x73, 0xa4, 0xa3, 0x66, 0x58, 0x85, 0x11, 0xe7, 0xd5,
-	0x2a, 0x2b, 0x96, 0x45, 0x68, 0x5d, 0x3b, 0x9c, 0xac, 0xdd, 0x15, 0x8d, 0x16, 0x38, 0x3b, 0xe4,
-	0x0c, 0xa5, 0x9d, 0x11, 0xde, 0x12, 0x37, 0xe1, 0xd8, 0x43, 0xdb, 0xa0, 0xf5, 0x71, 0x78, 0x6b,
-	0x9c, 0xaa, 0x56, 0x12, 0xe3, 0x3c, 0xce, 0x01, 0x13, 0x9c, 0x1d, 0x66, 0x63, 0xb5, 0x48, 0x78,
-	0x1d, 0x8e, 0xf9, 0x10, 0x09, 0x7a, 0x2e, 0x01, 0x58, 0x25, 0x83, 0xca, 0x25, 0x67, 0x54, 0xf0,
-	0x3c, 0x5a, 0xad, 0x24, 0x8e, 0x89, 0x8b, 0xc6, 0x9b, 0xc3, 0xd9, 0x01, 0xcb, 0xf5, 0xc6, 0x37,
-	0x61, 0x62, 0xcb, 0xa0, 0x0a, 0x2b, 0x80, 0x5b, 0xea, 0x43, 0x5b, 0xcd, 0xab, 0xb4, 0xdc, 0x51,
-	0x81, 0x7e, 0x4f, 0x82, 0x78, 0xb3, 0x50, 0x22, 0xbd, 0xc7, 0x30, 0x50, 0x74, 0x07, 0xc5, 0x0e,
-	0x4e, 0x24, 0xc5, 0x0b, 0xdd, 0x21, 0xca, 0xbb, 0x7e, 0x96, 0x0d, 0x55, 0xcf, 0xac, 0x88, 0x0b,
-	0x47, 0x74, 0x93, 0xe7, 0x89, 0x7f, 0xf8, 0xe7, 0xc4, 0x6c, 0x41, 0xa5, 0x3b, 0xf6, 0x76, 0x32,
-	0x67, 0x68, 0xe2, 0x89, 0x2f, 0xfe, 0x99, 0xb3, 0xf2, 0xbb, 0x29, 0xea, 0xdc, 0x16, 0x2c, 0x88,
-	0x95, 0xad, 0xad, 0x88, 0xc7, 0x60, 0x94, 0x25, 0xd7, 0x88, 0x11, 0x7f, 0x24, 0xc1, 0x89, 0xc6,
-	0x99, 0x4f, 0x47, 0xca, 0xee, 0xd6, 0xdc, 0x33, 0x8a, 0xb6, 0x46, 0xd6, 0x0c, 0xb3, 0xe3, 0xb3,
-	0xe3, 0xdb, 0xee, 0xd6, 0x34, 0x84, 0x12, 0x38, 0x29, 0xf4, 0xed, 0xb1, 0x89, 0x70, 0x90, 0xe9,
-	0xfa, 0x87, 0x00, 0x77, 0x6b, 0x0f, 0xa1, 0x58, 0x0b, 0xef, 0x41, 0x7c, 0xcb, 0x54, 0xf2, 0xaa,
-	0x5e, 0xd8, 0x50, 0x54, 0x73, 0xcb, 0xf9, 0xa8, 0x5c, 0x23, 0xfe, 0x06, 0x65, 0xd5, 0x2f, 0xbf,
-	0x2e, 0x4a, 0xd9, 0x87, 0x4f, 0x4c, 0xe0, 0x6c, 0x1f, 0xfb, 0xe9, 0xf5, 0x9a, 0xf1, 0xbc, 0xe8,
-	0xcb, 0x03, 0xc6, 0xf3, 0xae, 0xf1, 0x3c, 0x96, 0xe1, 0x64, 0xd3, 0x75, 0x05, 0x19, 0xd7, 0x61,
-	0xc0, 0xfb, 0xc0, 0x15, 0x4b, 0x4f, 0x8b, 0x8b, 0xe5, 0xe4, 0xc1, 0x8b, 0xe5, 0x16, 0x29, 0x28,
-	0xb9, 0xf2, 0x0a, 0xc9, 0x65, 0xfb, 0xa9, 0x88, 0xe4, 0x7c, 0xae, 0xcc, 0xb8, 0xf7, 0x98, 0xb3,
-	0x12, 0xc9, 0x28, 0x16, 0xc9, 0xdf, 0xd1, 0x59, 0xc3, 0xad, 0x6b, 0x25, 0x25, 0xe7, 0xdd, 0xc9,
-	0x6f, 0xc3, 0xc0, 0x03, 0xd3, 0xd0, 0x64, 0xe7, 0x3b, 0x59, 0x9c, 0xe4, 0x01, 0xe4, 0xf3, 0x2f,
-	0xc9, 0x7e, 0xc7, 0xc3, 0xf9, 0x1d, 0x61, 0x18, 0xa4, 0x06, 0xf3, 0xf5, 0x1f, 0x4a, 0xd9, 0x23,
-	0xd4, 0x70, 0xa6, 0xf9, 0xa1, 0x33, 0x56, 0xab, 0x13, 0xe7, 0xa8, 0xe9, 0xf5, 0x0e, 0xb5, 0xdb,
-	0x30, 0xa2, 0x29, 0xfb, 0xfc, 0x44, 0x90, 0x55, 0x96, 0x95, 0x78, 0x78, 0x44, 0x82, 0x3b, 0xa4,
-	0x29, 0xfb, 0x3e, 0x40, 0xe8, 0xb3, 0x30, 0x44, 0xf6, 0x29, 0x31, 0x75, 0xa5, 0x28, 0x4e, 0xa0,
-	0xc3, 0xd1, 0x83, 0x0d, 0xba, 0xae, 0xfc, 0x4c, 0xfa, 0x91, 0x04, 0xe7, 0x42, 0x09, 0x14, 0xdb,
-	0x75, 0x15, 0x40, 0xd5, 0x4b, 0x36, 0x6d, 0x8b, 0xc2, 0x01, 0xe6, 0xc2, 0x38, 0xbc, 0x0e, 0x47,
-	0x0c, 0x9b, 0x7a, 0x01, 0x62, 0xd1, 0x02, 0x00, 0xf7, 0x71, 0x46, 0xf0, 0x34, 0x9c, 0x4e, 0x17,
-	0x8b, 0x6e, 0x1d, 0x6d, 0xee, 0x28, 0x26, 0x49, 0x17, 0x4c, 0x42, 0x34, 0xa2, 0x53, 0xef, 0x96,
-	0xfd, 0xbe, 0x04, 0x38, 0xc8, 0x4a, 0xa0, 0xd9, 0x83, 0x78, 0x83, 0xba, 0x22, 0x2b, 0x9e, 0x95,
-	0xe8, 0xce, 0xc5, 0xc0, 0xc7, 0x7b, 0xf3, 0x15, 0x44, 0xda, 0x63, 0xb4, 0xf9, 0xfa, 0xf8, 0x2a,
-	0xcc, 0x34, 0x77, 0x5c, 0x33, 0x0d, 0xad, 0xee, 0x22, 0x3f, 0x5e, 0x77, 0x91, 0xbb, 0xd7, 0xf6,
-	0xc7, 0x12, 0x9c, 0x0b, 0x0d, 0xe0, 0x9d, 0x36, 0x13, 0x2d, 0x31, 0x8a, 0x0d, 0xec, 0x02, 0xe2,
-	0x89, 0xe6, 0x10, 0xf1, 0x03, 0x98, 0xad, 0xf3, 0x63, 0x39, 0x59, 0x5b, 0x46, 0x3a, 0x97, 0x33,
-	0x6d, 0x92, 0xbf, 0xa7, 0x14, 0x6d, 0x12, 0x88, 0x11, 0x9d, 0x81, 0x41, 0x37, 0xf6, 0x8a, 0xaf,
-	0xdb, 0xea, 0x07, 0xb1, 0x05, 0xe7, 0x23, 0xac, 0x23, 0xa8, 0x58, 0x83, 0xbe, 0xba, 0x17, 0x6c,
-	0x32, 0xec, 0x05, 0x2b, 0x8e, 0x5d, 0xf7, 0xe1, 0x2a, 0xbc, 0xf1, 0x59, 0x98, 0x3e, 0x50, 0x5c,
-	0xb9, 0x9c, 0xad, 0xd9, 0x45, 0x85, 0x1a, 0xa6, 0x57, 0x84, 0x9f, 0x48, 0x70, 0x26, 0xd8, 0x4e,
-	0xe4, 0x55, 0x86, 0x93, 0xbe, 0x2d, 0xda, 0x55, 0x35, 0x59, 0xf1, 0x99, 0x89, 0x3a, 0xbc, 0x14,
-	0x6d, 0x93, 0x76, 0x55, 0xcd, 0xb7, 0x86, 0xd8, 0xa5, 0x71, 0xda, 0x7c, 0xda, 0xc2, 0x4b, 0x70,
-	0x36, 0x4b, 0x0a, 0xaa, 0x45, 0x89, 0x49, 0xf2, 0xe9, 0x62, 0xd1, 0x28, 0x93, 0xbc, 0x73, 0x59,
-	0x45, 0x2c, 0xc4, 0x0f, 0x25, 0x98, 0x09, 0xf3, 0x17, 0x20, 0x55, 0x18, 0xca, 0x19, 0x3a, 0x35,
-	0x95, 0x1c, 0x95, 0x2d, 0xaa, 0x50, 0x22, 0x8a, 0xef, 0xed, 0x40, 0x5c, 0x2c, 0xe4, 0xb2, 0xf0,
-	0xab, 0x63, 0x72, 0xd3, 0x89, 0x21, 0xf0, 0x0d, 0xba, 0x91, 0xd9, 0x20, 0x4e, 0x07, 0x24, 0xc5,
-	0xbf, 0x2a, 0x5d, 0x54, 0x63, 0x0d, 0xd7, 0xba, 0x77, 0x85, 0x7f, 0x47, 0x82, 0x73, 0xa1, 0x31,
-	0xfe, 0xf7, 0xc8, 0x30, 0x4c, 0xa5, 0x8b, 0xc5, 0xa6, 0x89, 0x79, 0x65, 0xf7, 0x44, 0x62, 0x27,
-	0x64, 0x2b, 0x23, 0x91, 0xf4, 0x2e, 0x0c, 0xd7, 0x27, 0xed, 0xd6, 0xd9, 0xcb, 0xc8, 0x7a, 0xa8,
-	0x2e, 0x6b, 0x6b, 0xe1, 0xf7, 0xe7, 0xe1, 0xf0, 0x5d, 0x9b, 0x98, 0x65, 0xf4, 0x4d, 0x09, 0xfa,
-	0xb8, 0x50, 0x8b, 0x5e, 0x8b, 0xa0, 0xe6, 0x0a, 0x4c, 0xf1, 0x0b, 0x91, 0x6c, 0x39, 0x34, 0x7c,
-	0xe1, 0xab, 0xbf, 0xfb, 0xeb, 0x87, 0xb1, 0xb3, 0x68, 0x3a, 0x15, 0x24, 0xab, 0x8b, 0x2c, 0xfe,
-	0x26, 0xc1, 0x44, 0x4b, 0x6d, 0x0b, 0x2d, 0x05, 0xae, 0x1b, 0x26, 0x2c, 0xc7, 0xaf, 0x76, 0xea,
-	0x2e, 0x90, 0xdc, 0x62, 0x48, 0xd6, 0xd0, 0x4a, 0x20, 0x92, 0x2f, 0x8b, 0x12, 0x7e, 0x9c, 0x22,
-	0x22, 0x22, 0xff, 0x1f, 0x06, 0xe2, 0xc4, 0x14, 0x5f, 0xdd, 0xb2, 0xaa, 0xa3, 0x4f, 0x62, 0x3e,
-	0x05, 0x23, 0x5c, 0xd7, 0x45, 0x77, 0x3a, 0xcb, 0xbe, 0xa5, 0x98, 0xd4, 0x35, 0x1d, 0x0a, 0xa3,
-	0xe3, 0x4b, 0xe8, 0x8b, 0x2f, 0x83, 0x0e, 0xf9, 0x91, 0x4a, 0x77, 0x9c, 0xb7, 0x13, 0x4f, 0x54,
-	0x66, 0x6f, 0x66, 0xf4, 0x8d, 0x18, 0x4c, 0x47, 0x90, 0x6e, 0xd1, 0x8d, 0x68, 0x50, 0x42, 0xc5,
-	0xdf, 0xae, 0x39, 0xf9, 0x02, 0xe3, 0x24, 0x8b, 0x36, 0xda, 0xe6, 0x84, 0xe5, 0xc6, 0x55, 0xb7,
-	0xa6, 0xe5, 0xf2, 0x4f, 0x09, 0xe2, 0xad, 0xf5, 0x21, 0xd4, 0x51, 0xe2, 0x35, 0x7d, 0x2c, 0x7e,
-	0xad, 0x63, 0x7f, 0x81, 0xfc, 0x36, 0x43, 0x7e, 0x03, 0xad, 0x76, 0x5f, 0x0d, 0x86, 0x4d, 0xd1,
-	0x0f, 0x62, 0x70, 0xb1, 0x1d, 0x85, 0x14, 0x6d, 0x74, 0x08, 0xa0, 0x75, 0x7f, 0x74, 0x4d, 0xc9,
-	0x36, 0xa3, 0xe4, 0x7d, 0xf4, 0xde, 0x4b, 0xa1, 0xa4, 0x79, 0x87, 0x3c, 0x89, 0xc1, 0x99, 0x28,
-	0x3a, 0x28, 0xba, 0xd9, 0x5d, 0x8b, 0xbc, 0xcc, 0x52, 0xb9, 0xcf, 0x78, 0x79, 0x17, 0x7d, 0xbe,
-	0x4d, 0x5e, 0x1c, 0x16, 0x42, 0x1a, 0xc5, 0x29, 0x9d, 0x8f, 0x24, 0xe8, 0x77, 0xf5, 0x4a, 0x74,
-	0x31, 0x30, 0xd9, 0x06, 0xa5, 0x33, 0x3e, 0x17, 0xd1, 0x5a, 0x00, 0x49, 0x32, 0x20, 0xb3, 0x68,
-	0x26, 0x10, 0x88, 0x27, 0x86, 0xa2, 0x6f, 0x49, 0xd0, 0xeb, 0x44, 0x40, 0xb3, 0xc1, 0x17, 0x68,
-	0x4d, 0xe9, 0x88, 0x9f, 0x8f, 0x60, 0x29, 0xb2, 0xb9, 0xc4, 0xb2, 0x49, 0xa2, 0x8b, 0x81, 0xd9,
-	0xb0, 0x4c, 0x6a, 0xe4, 0x32, 0xb6, 0x5c, 0x09, 0x34, 0x84, 0xad, 0x06, 0xf1, 0x34, 0x84, 0xad,
-	0x46, 0x5d, 0x35, 0x22, 0x5b, 0x4a, 0xb1, 0x38, 0xc7, 0xd9, 0xfa, 0xb9, 0x04, 0x23, 0x8d, 0x72,
-	0x28, 0x0a, 0x7e, 0x77, 0xb7, 0x10, 0x60, 0xe3, 0x6f, 0xb4, 0xe9, 0x25, 0x32, 0xbe, 0xcc, 0x32,
-	0x5e, 0x40, 0xaf, 0x07, 0x66, 0x5c, 0x54, 0x2d, 0xca, 0x53, 0x9e, 0xdb, 0x2e, 0xcf, 0xf1, 0xcf,
-	0xa5, 0x8f, 0x25, 0x18, 0xf0, 0x44, 0x4a, 0x14, 0x4c, 0x54, 0xa3, 0x3c, 0x1b, 0x4f, 0x46, 0x35,
-	0x17, 0x69, 0x2e, 0xb2, 0x34, 0xe7, 0xd0, 0x85, 0xa6, 0x69, 0x36, 0x6c, 0x78, 0x8a, 0xe9, 0x13,
-	0x16, 0x7a, 0x26, 0x01, 0x3a, 0x28, 0x58, 0xa2, 0xff, 0x0f, 0xfe, 0xae, 0x69, 0x25, 0x96, 0xc6,
-	0xdf, 0x6c, 0xdb, 0x4f, 0x24, 0xbf, 0xce, 0x92, 0x5f, 0x46, 0xe9, 0x76, 0xaa, 0x36, 0x45, 0x9d,
-	0x80, 0xfc, 0x10, 0xf0, 0x24, 0x43, 0xf4, 0x63, 0x09, 0x86, 0xea, 0xc5, 0x4c, 0xb4, 0x10, 0x9e,
-	0xd6, 0x01, 0x28, 0x8b, 0x6d, 0xf9, 0xb4, 0xd5, 0x7c, 0x3c, 0xed, 0x5a, 0xc6, 0x4f, 0xdd, 0x4d,
-	0xa8, 0x93, 0x26, 0xa3, 0x6c, 0x42, 0x33, 0x59, 0x34, 0xca, 0x26, 0x34, 0xd5, 0x40, 0x71, 0x9a,
-	0x65, 0xff, 0x16, 0xfa, 0x4c, 0x07, 0x9b, 0xc0, 0x05, 0x4d, 0xf4, 0x2b, 0x09, 0x5e, 0x6d, 0xa2,
-	0x2c, 0xa2, 0x90, 0x9c, 0x5a, 0x6a, 0xa0, 0xf1, 0xcb, 0xed, 0x3b, 0x0a, 0x34, 0x57, 0x18, 0x9a,
-	0x4b, 0x68, 0x21, 0x78, 0x2f, 0x78, 0x04, 0xb9, 0xa4, 0xa8, 0xa6, 0xcc, 0xbe, 0xc8, 0x1f, 0x10,
-	0x82, 0xfe, 0x21, 0x41, 0x22, 0x44, 0x7d, 0x43, 0xcb, 0x91, 0x2e, 0xc0, 0x60, 0xf1, 0x33, 0xbe,
-	0xd2, 0x5d, 0x10, 0x01, 0x75, 0x89, 0x41, 0x7d, 0x13, 0xbd, 0xd1, 0xee, 0x55, 0xea, 0xa0, 0x27,
-	0xe8, 0xb9, 0x04, 0xf1, 0xd6, 0xc2, 0x5c, 0xc8, 0xa3, 0x32, 0x54, 0xf7, 0x0b, 0x79, 0x29, 0x84,
-	0x2b, 0x82, 0x78, 0x99, 0xc1, 0x5b, 0x42, 0x6f, 0x85, 0x5d, 0x19, 0x72, 0x6b, 0xe1, 0x10, 0xfd,
-	0x5b, 0x82, 0x44, 0x88, 0x3c, 0x17, 0xb2, 0xa5, 0xd1, 0xd4, 0xc1, 0x90, 0x2d, 0x8d, 0xa8, 0x10,
-	0xe2, 0xbb, 0x0c, 0xf3, 0x3b, 0x68, 0x3d, 0x78, 0x4b, 0xd9, 0x3d, 0xf3, 0x38, 0xd5, 0x12, 0xb7,
-	0xcc, 0xa4, 0x75, 0x7e, 0x1b, 0x7d, 0x37, 0x06, 0xa7, 0x43, 0x75, 0x39, 0xb4, 0x1a, 0x3d, 0xfd,
-	0x00, 0xfd, 0x30, 0xbe, 0xd6, 0x6d, 0x18, 0xc1, 0x43, 0x9e, 0xf1, 0xf0, 0x01, 0x7a, 0x3f, 0x98,
-	0x87, 0x3a, 0x01, 0xf2, 0x71, 0x4b, 0x5e, 0xd8, 0xb0, 0x25, 0x53, 0x43, 0x56, 0xf8, 0x62, 0xf2,
-	0x1e, 0x03, 0xfd, 0x77, 0x09, 0x4e, 0x05, 0xa9, 0x82, 0xe8, 0x7a, 0x7b, 0x35, 0x7c, 0x50, 0x78,
-	0x8c, 0xa7, 0xbb, 0x88, 0x20, 0xb8, 0x58, 0x65, 0x5c, 0x5c, 0x43, 0x4b, 0xed, 0xf7, 0x81, 0x1f,
-	0xcb, 0xbf, 0x24, 0x98, 0x0c, 0xd6, 0x07, 0x51, 0x26, 0x30, 0xd9, 0x48, 0xe2, 0x64, 0x7c, 0xb9,
-	0xab, 0x18, 0x02, 0xf2, 0x1d, 0x06, 0x79, 0x1d, 0xdd, 0x88, 0xd4, 0x06, 0xa6, 0x17, 0x54, 0x56,
-	0x78, 0x54, 0xfe, 0x38, 0xf0, 0x35, 0xc1, 0x57, 0x62, 0x90, 0x08, 0xd1, 0x10, 0x51, 0x87, 0x99,
-	0xd7, 0xa9, 0x98, 0x21, 0xc7, 0x40, 0x44, 0x19, 0x13, 0x6f, 0x32, 0xfc, 0xb7, 0xd1, 0x3b, 0x11,
-	0x4f, 0xf6, 0x40, 0x06, 0x84, 0x15, 0xfa, 0x93, 0x04, 0x13, 0x2d, 0xc5, 0xc8, 0x10, 0x79, 0x2d,
-	0x4c, 0xe9, 0x8c, 0x5f, 0xed, 0xd4, 0xbd, 0xad, 0x47, 0x88, 0x53, 0xe4, 0x2d, 0xb0, 0x5a, 0x99,
-	0xfb, 0x4f, 0x9f, 0x4f, 0x4a, 0xcf, 0x9e, 0x4f, 0x4a, 0x7f, 0x79, 0x3e, 0x29, 0x3d, 0x79, 0x31,
-	0x79, 0xe8, 0xd9, 0x8b, 0xc9, 0x43, 0x7f, 0x78, 0x31, 0x79, 0xe8, 0xbd, 0x65, 0xdf, 0xff, 0xd0,
-	0x8a, 0xf0, 0x73, 0x45, 0x65, 0xdb, 0xf2, 0xd6, 0xda, 0x5b, 0x9c, 0x4f, 0xed, 0xd7, 0xad, 0x98,
-	0x2b, 0xaa, 0x44, 0xa7, 0xfc, 0xaf, 0x75, 0xf9, 0x5f, 0x59, 0xf4, 0xb1, 0x7f, 0x16, 0xff, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0xaf, 0xc6, 0xd7, 0x0f, 0xfc, 0x2c, 0x00, 0x00,
+	// 2854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xcf, 0xac, 0x1d, 0x37, 0x3e, 0x8e, 0x1d, 0xe7, 0xe6, 0xc3, 0xf6, 0x24, 0x7f, 0xaf, 0x73,
+	0xf3, 0xe5, 0x36, 0xf1, 0x6e, 0x6c, 0xa7, 0xff, 0x84, 0xb4, 0xf9, 0xd8, 0xf5, 0x47, 0xb3, 0x34,
+	0x6d, 0xdc, 0x75, 0x68, 0x69, 0x69, 0x3b, 0x1a, 0xef, 0xde, 0xac, 0x47, 0xde, 0x99, 0xd9, 0xcc,
+	0xdc, 0x75, 0x6d, 0x55, 0x79, 0x00, 0x09, 0xc1, 0x13, 0x2a, 0x14, 0xa9, 0x48, 0x20, 0x95, 0x3e,
+	0xf0, 0x02, 0x0f, 0x08, 0x84, 0x90, 0x78, 0x81, 0x17, 0x1e, 0x2a, 0x24, 0x50, 0x24, 0x5e, 0x10,
+	0x12, 0x0b, 0x4a, 0x79, 0x40, 0xc0, 0xd3, 0x4a, 0xbc, 0xf0, 0x02, 0x9a, 0x7b, 0xef, 0xcc, 0xce,
+	0xac, 0x77, 0x3e, 0x76, 0x37, 0x54, 0x7d, 0xb2, 0x7d, 0xef, 0x39, 0xe7, 0x9e, 0xdf, 0xef, 0x9e,
+	0x7b, 0xee, 0xcc, 0x6f, 0x0c, 0xe7, 0x4d, 0x5b, 0x37, 0x6d, 0xcd, 0xce, 0xd6, 0x4c, 0xb3, 0xaa,
+	0xab, 0x86, 0x5a, 0x21, 0x56, 0x76, 0x7b, 0x7e, 0x83, 0x50, 0x75, 0x3e, 0xfb, 0xa0, 0x4e, 0xac,
+	0xdd, 0x4c, 0xcd, 0x32, 0xa9, 0x89, 0x4e, 0x08, 0xc3, 0x8c, 0xcf, 0x30, 0x23, 0x0c, 0xe5, 0xa3,
+	0x15, 0xb3, 0x62, 0x32, 0xbb, 0xac, 0xf3, 0x1b, 0x77, 0x91, 0x9f, 0x8e, 0x8a, 0x5d, 0x21, 0x06,
+	0x61, 0xe1, 0x98, 0xe9, 0x99, 0x28, 0x53, 0xba, 0x23, 0xac, 0x2e, 0x46, 0x59, 0xd9, 0xef, 0xa8,
+	0x35, 0xc5, 0x32, 0xeb, 0x94, 0x08, 0xeb, 0xf9, 0xc8, 0x98, 0xea, 0x16, 0xb1, 0x94, 0xfb, 0x84,
+	0x28, 0xf6, 0xa6, 0x6a, 0xb9, 0x2e, 0x73, 0xb1, 0x0b, 0x68, 0x06, 0x25, 0x06, 0x15, 0xe6, 0xd3,
+	0x25, 0x66, 0x9f, 0xdd, 0x50, 0x6d, 0xe2, 0x99, 0x95, 0x4c, 0xcd, 0x10, 0xf3, 0xcf, 0xf8, 0xe7,
+	0x19, 0x99, 0x9e, 0x55, 0x4d, 0xad, 0x68, 0x86, 0x4a, 0x35, 0xd3, 0xb5, 0x3d, 0x59, 0x31, 0xcd,
+	0x4a, 0x95, 0x64, 0xd5, 0x9a, 0x96, 0x55, 0x0d, 0xc3, 0xa4, 0x6c, 0xd2, 0xe5, 0x67, 0x4a, 0xcc,
+	0xb2, 0xbf, 0x36, 0xea, 0xf7, 0xb3, 0xaa, 0xb1, 0xeb, 0x4e, 0xf1, 0x45, 0x14, 0x4e, 0x3f, 0xff,
+	0x43, 0x4c, 0xa5, 0xdb, 0xbd, 0xa8, 0xa6, 0x13, 0x9b, 0xaa, 0x7a, 0x8d, 0x1b, 0xe0, 0x43, 0x30,
+	0xba, 0xa6, 0x5a, 0xaa, 0x6e, 0x17, 0xc9, 0x83, 0x3a, 0xb1, 0x29, 0x5e, 0x87, 0x31, 0x77, 0xc0,
+	0xae, 0x99, 0x86, 0x4d, 0x50, 0x0e, 0x86, 0x6a, 0x6c, 0x64, 0x52, 0x9a, 0x91, 0x66, 0x47, 0x16,
+	0x4e, 0x67, 0x22, 0x0a, 0x21, 0xc3, 0x9d, 0xf3, 0x83, 0x1f, 0x37, 0xd2, 0xfb, 0x8a, 0xc2, 0x11,
+	0xff, 0x24, 0x05, 0x33, 0x2b, 0x36, 0xd5, 0x74, 0x95, 0x92, 0xf5, 0x77, 0xd4, 0xda, 0xca, 0x8e,
+	0x5a, 0xa2, 0x39, 0xdd, 0xac, 0x1b, 0xb4, 0x60, 0x88, 0x95, 0xd1, 0x75, 0x18, 0xb2, 0x89, 0x51,
+	0x26, 0x16, 0x5b, 0x67, 0x38, 0x7f, 0xb6, 0xd9, 0x48, 0xa7, 0x77, 0x55, 0xbd, 0x7a, 0x0d, 0xf3,
+	0x71, 0x7c, 0xb1, 0x4c, 0x6a, 0x16, 0x29, 0xa9, 0x94, 0x94, 0xaf, 0x61, 0x6a, 0xd5, 0x09, 0x9e,
+	0x94, 0x8a, 0xc2, 0x09, 0xdd, 0x84, 0xa7, 0x9c, 0x7c, 0x14, 0xad, 0x3c, 0x99, 0x9a, 0x91, 0x66,
+	0x07, 0xf3, 0xe7, 0x9a, 0x8d, 0xf4, 0x0c, 0xf7, 0x17, 0x13, 0x21, 0x01, 0x9c, 0xd9, 0x42, 0x19,
+	0x65, 0xe0, 0x00, 0x35, 0xb7, 0x88, 0xa1, 0x68, 0xc6, 0xe4, 0x00, 0xcb, 0xe0, 0x48, 0xb3, 0x91,
+	0x3e, 0xc4, 0x23, 0xb8, 0x33, 0xb8, 0xf8, 0x14, 0xfb, 0xb5, 0x60, 0xa0, 0xb7, 0x60, 0x88, 0x15,
+	0x9b, 0x3d, 0x39, 0x38, 0x33, 0x30, 0x3b, 0xb2, 0x90, 0x89, 0xe4, 0xc5, 0x81, 0xed, 0x21, 0x76,
+	0xdc, 0xf2, 0xc7, 0x1c, 0x8a, 0x9a, 0x8d, 0xf4, 0x28, 0x5f, 0x81, 0xc7, 0xc2, 0x45, 0x11, 0x14,
+	0xff, 0x32, 0x05, 0x0b, 0xa1, 0x9c, 0xbd, 0xa6, 0xd1, 0xcd, 0x35, 0x4b, 0xd3, 0x35, 0xaa, 0x6d,
+	0x93, 0x7b, 0xbb, 0x35, 0xe2, 0xee, 0x9f, 0x9f, 0x06, 0xa9, 0x6f, 0x1a, 0x52, 0x09, 0x68, 0xb8,
+	0x09, 0x63, 0x3c, 0x63, 0xc5, 0x5d, 0x77, 0x60, 0x66, 0x60, 0x76, 0x30, 0x3f, 0xd5, 0x6c, 0xa4,
+	0x8f, 0xf9, 0xa1, 0xb9, 0xf3, 0xb8, 0x78, 0x90, 0x0f, 0xac, 0xf1, 0x05, 0x5f, 0x85, 0xe3, 0xc2,
+	0x80, 0x47, 0x37, 0xeb, 0x54, 0x29, 0x13, 0xc3, 0xd4, 0x19, 0xaf, 0xc3, 0xf9, 0x53, 0xcd, 0x46,
+	0xfa, 0xff, 0x02, 0x81, 0xda, 0xec, 0x70, 0xf1, 0x08, 0x9f, 0xb8, 0xe7, 0x8c, 0xdf, 0xad, 0xd3,
+	0x65, 0x36, 0xfa, 0x5b, 0x09, 0x9e, 0xf1, 0x08, 0xd4, 0x8c, 0x4a, 0x95, 0x38, 0x0b, 0x86, 0x96,
+	0xdf, 0x85, 0x76, 0xe2, 0x50, 0xb3, 0x91, 0x1e, 0x0b, 0x12, 0xd7, 0x33, 0x49, 0x79, 0x38, 0xd4,
+	0x0e, 0x8e, 0x97, 0x98, 0xdc, 0x6c, 0xa4, 0x8f, 0xfb, 0xdd, 0x7c, 0xa8, 0x46, 0x69, 0x00, 0xcf,
+	0xd7, 0x24, 0x38, 0x15, 0x71, 0x88, 0xc4, 0x69, 0xdd, 0x80, 0xf1, 0x56, 0x20, 0x95, 0xcd, 0x8a,
+	0xf3, 0x74, 0xd5, 0xa9, 0xb7, 0x3f, 0x36, 0xd2, 0xc7, 0x78, 0x87, 0xb0, 0xcb, 0x5b, 0x19, 0xcd,
+	0xcc, 0xea, 0x2a, 0xdd, 0xcc, 0x14, 0x0c, 0xda, 0x6c, 0xa4, 0x27, 0xda, 0xf3, 0xe0, 0xee, 0xb8,
+	0x38, 0xe6, 0x26, 0xc2, 0x57, 0xc3, 0xdf, 0xf7, 0x33, 0xab, 0xab, 0x16, 0x65, 0x05, 0x1d, 0xca,
+	0xac, 0x9f, 0x2c, 0xa9, 0x37, 0xb2, 0x52, 0xdd, 0x92, 0xf5, 0x2f, 0x09, 0x2e, 0x24, 0x4a, 0xf1,
+	0xd3, 0xa3, 0x0d, 0x6d, 0x78, 0x0d, 0x23, 0xc5, 0x1a, 0xc6, 0x62, 0xe2, 0x86, 0xb1, 0x5e, 0xab,
+	0x6a, 0x34, 0x51, 0xd7, 0xf8, 0x79, 0x2a, 0xb4, 0x48, 0xee, 0xd6, 0xe9, 0x67, 0xa5, 0xd5, 0xbe,
+	0xed, 0x31, 0x31, 0xc0, 0x98, 0xc8, 0x26, 0x64, 0xc2, 0x81, 0x90, 0x80, 0x05, 0x34, 0x0f, 0xc3,
+	0xde, 0x76, 0x4c, 0x0e, 0x32, 0x88, 0x47, 0x9b, 0x8d, 0xf4, 0x78, 0xdb, 0x4e, 0xe1, 0xe2, 0x01,
+	0x77, 0x8b, 0xf0, 0xaf, 0x52, 0xb0, 0x18, 0x4e, 0xdc, 0xff, 0xb0, 0xdf, 0xee, 0xed, 0x9f, 0xa9,
+	0xee, 0xfa, 0xe7, 0x3a, 0x1c, 0x0b, 0xf4, 0x45, 0xcd, 0xf0, 0x3a, 0x8c, 0xd3, 0x3e, 0x67, 0x9a,
+	0x8d, 0xf4, 0xc9, 0x0e, 0xed, 0xd3, 0x35, 0xc3, 0x45, 0xe4, 0xeb, 0x9e, 0x05, 0x83, 0x9d, 0x9f,
+	0x5e, 0x18, 0xfc, 0x9d, 0xff, 0xc8, 0x85, 0xf5, 0x5b, 0x5f, 0x11, 0x76, 0xd5, 0x70, 0x6f, 0xc2,
+	0x58, 0x1b, 0x3a, 0xde, 0x12, 0x7c, 0x2c, 0xb5, 0xc3, 0x3a, 0x48, 0x43, 0x01, 0x0d, 0x24, 0x02,
+	0xf4, 0x55, 0x09, 0x70, 0xd4, 0x59, 0x12, 0xad, 0x43, 0x71, 0xdb, 0x95, 0x66, 0x04, 0x3b, 0xc7,
+	0x95, 0xb8, 0xce, 0x71, 0xbc, 0x2d, 0x71, 0xb7, 0x71, 0x8c, 0x8a, 0xcc, 0x45, 0xbb, 0x3d, 0x0c,
+	0x87, 0x5e, 0xae, 0xeb, 0x0e, 0x99, 0xde, 0x53, 0xda, 0x0a, 0x8c, 0xb7, 0x86, 0x44, 0x1e, 0xf3,
+	0x30, 0x6c, 0xd4, 0x75, 0x56, 0x25, 0xb6, 0x60, 0xd4, 0x87, 0xd0, 0x9b, 0xc2, 0xc5, 0x03, 0x86,
+	0x70, 0xc5, 0xd7, 0x60, 0xc4, 0xf9, 0xa5, 0x97, 0x1d, 0xc1, 0x4b, 0x70, 0x90, 0xfb, 0x8a, 0xe5,
+	0x17, 0x61, 0xd0, 0x99, 0x11, 0x0f, 0x89, 0x47, 0x33, 0xfc, 0xc9, 0x33, 0xe3, 0x3e, 0x79, 0x66,
+	0x72, 0xc6, 0x6e, 0x7e, 0xf8, 0x37, 0x3f, 0x9b, 0xdb, 0xcf, 0xca, 0xb6, 0xc8, 0x8c, 0x1d, 0x68,
+	0xb9, 0x6a, 0x35, 0x00, 0xad, 0x00, 0xe3, 0xad, 0x21, 0x11, 0xfb, 0x59, 0xd8, 0xef, 0xc2, 0x1a,
+	0x48, 0x12, 0x9c, 0x5b, 0xe3, 0x1c, 0x4c, 0xdc, 0xd1, 0x6c, 0xca, 0x62, 0xe5, 0x77, 0x59, 0x1d,
+	0xb8, 0x50, 0xcf, 0xc1, 0x7e, 0x5e, 0x46, 0x7c, 0xab, 0xc6, 0x9b, 0x8d, 0xf4, 0x41, 0x0e, 0x54,
+	0x54, 0x0f, 0x9f, 0xc6, 0xaf, 0xc0, 0xe4, 0xde, 0x10, 0xfd, 0x65, 0xf5, 0x48, 0x82, 0xf1, 0xf5,
+	0x9a, 0x49, 0xd7, 0x2c, 0xad, 0x44, 0x7a, 0x3a, 0x0c, 0x2b, 0x30, 0xee, 0xbc, 0x50, 0x28, 0xaa,
+	0x6d, 0x93, 0xe0, 0x0d, 0x79, 0xa2, 0x75, 0x1f, 0xb5, 0x5b, 0xe0, 0xe2, 0x98, 0x33, 0x94, 0x73,
+	0x46, 0xf8, 0x91, 0xb8, 0x0d, 0x87, 0x1f, 0xd4, 0x4d, 0x1a, 0x8c, 0xc3, 0x8f, 0xc6, 0xc9, 0x66,
+	0x23, 0x3d, 0xc9, 0xe3, 0xec, 0x31, 0xc1, 0xc5, 0x43, 0x6c, 0xac, 0x15, 0x09, 0x17, 0xe0, 0xb0,
+	0x0f, 0x91, 0xa0, 0xe7, 0x32, 0x80, 0x5d, 0x33, 0xa9, 0x52, 0x73, 0x46, 0x05, 0xcf, 0xc7, 0x9a,
+	0x8d, 0xf4, 0x61, 0x1e, 0xb7, 0x35, 0x87, 0x8b, 0xc3, 0xb6, 0xeb, 0x8d, 0x6f, 0xc3, 0xd4, 0x3d,
+	0x93, 0xaa, 0xac, 0x00, 0xee, 0x68, 0x0f, 0xea, 0x5a, 0x59, 0xa3, 0xbb, 0x3d, 0x15, 0xe8, 0x77,
+	0x25, 0x90, 0x3b, 0x85, 0x12, 0xe9, 0x3d, 0x84, 0xe1, 0xaa, 0x3b, 0x28, 0x76, 0x70, 0x2a, 0x23,
+	0x5e, 0x9e, 0x1c, 0xa2, 0xbc, 0xeb, 0x67, 0xc9, 0xd4, 0x8c, 0xfc, 0xb2, 0xb8, 0x70, 0xc4, 0x69,
+	0xf2, 0x3c, 0xf1, 0x0f, 0xff, 0x9c, 0x9e, 0xad, 0x68, 0x74, 0xb3, 0xbe, 0x91, 0x29, 0x99, 0xba,
+	0x78, 0xfb, 0x12, 0x3f, 0xe6, 0xec, 0xf2, 0x56, 0x96, 0x3a, 0xb7, 0x05, 0x0b, 0x62, 0x17, 0x5b,
+	0x2b, 0xe2, 0x09, 0x38, 0xc6, 0x92, 0x6b, 0xc7, 0x88, 0x3f, 0x90, 0xe0, 0x78, 0xfb, 0xcc, 0x67,
+	0x23, 0x65, 0x77, 0x6b, 0x5e, 0x35, 0xab, 0x75, 0x9d, 0xac, 0x9a, 0x56, 0xcf, 0xbd, 0xe3, 0x5b,
+	0xee, 0xd6, 0xb4, 0x85, 0x12, 0x38, 0x29, 0x0c, 0x6d, 0xb3, 0x89, 0x78, 0x90, 0xb9, 0xe0, 0x83,
+	0x00, 0x77, 0xeb, 0x0e, 0xa1, 0x58, 0x0b, 0x6f, 0x83, 0x7c, 0xcf, 0x52, 0xcb, 0x9a, 0x51, 0x59,
+	0x53, 0x35, 0xeb, 0x9e, 0x23, 0x0f, 0xac, 0x12, 0xff, 0x01, 0x65, 0xd5, 0xaf, 0x5c, 0x12, 0xa5,
+	0xec, 0xc3, 0x27, 0x26, 0x70, 0x71, 0x88, 0xfd, 0x76, 0xa9, 0x65, 0x3c, 0x3f, 0x99, 0xea, 0x6c,
+	0x3c, 0xef, 0x1a, 0xcf, 0x63, 0x05, 0x4e, 0x74, 0x5c, 0x57, 0x90, 0x71, 0x0b, 0x86, 0x3d, 0xa9,
+	0x42, 0x2c, 0x7d, 0x5a, 0x5c, 0x2c, 0x27, 0xf6, 0x5e, 0x2c, 0x77, 0x48, 0x45, 0x2d, 0xed, 0x2e,
+	0x93, 0x52, 0xf1, 0x00, 0x15, 0x91, 0x9c, 0x37, 0xc9, 0x73, 0xee, 0x3d, 0xe6, 0xac, 0x44, 0xf2,
+	0xaa, 0x4d, 0xca, 0x77, 0x0d, 0x76, 0xe0, 0x0a, 0x7a, 0x4d, 0x2d, 0x79, 0x77, 0xf2, 0xf3, 0x30,
+	0x7c, 0xdf, 0x32, 0x75, 0xc5, 0x91, 0x30, 0x44, 0x27, 0x8f, 0x20, 0x9f, 0xbf, 0xe4, 0x1f, 0x70,
+	0x3c, 0x9c, 0xbf, 0x11, 0x86, 0x51, 0x6a, 0x32, 0x5f, 0x7f, 0x53, 0x2a, 0x8e, 0x50, 0xd3, 0x99,
+	0xe6, 0x4d, 0x67, 0xa2, 0x55, 0x27, 0x4e, 0xab, 0x19, 0xf4, 0x9a, 0xda, 0x4b, 0x30, 0xae, 0xab,
+	0x3b, 0xbc, 0x23, 0x28, 0x1a, 0xcb, 0x6a, 0x72, 0x30, 0x39, 0xdc, 0x31, 0x5d, 0xdd, 0xf1, 0x01,
+	0x42, 0x9f, 0x87, 0x31, 0xb2, 0x43, 0x89, 0x65, 0xa8, 0x55, 0xd1, 0x81, 0xf6, 0x27, 0x0f, 0x36,
+	0xea, 0xba, 0xf2, 0x9e, 0xf4, 0x23, 0x09, 0xce, 0xc7, 0x12, 0x28, 0xb6, 0xeb, 0x06, 0x80, 0x66,
+	0xd4, 0xea, 0xb4, 0x2b, 0x0a, 0x87, 0x99, 0x0b, 0xe3, 0xf0, 0x16, 0x8c, 0x98, 0x75, 0xea, 0x05,
+	0x48, 0x25, 0x0b, 0x00, 0xdc, 0xc7, 0x19, 0xc1, 0xa7, 0xe1, 0x54, 0xae, 0x5a, 0x75, 0xeb, 0x68,
+	0xdd, 0x11, 0xb7, 0x72, 0x15, 0x8b, 0x10, 0x9d, 0x18, 0xd4, 0xbb, 0x65, 0xbf, 0x27, 0x01, 0x8e,
+	0xb2, 0x12, 0x68, 0xb6, 0x41, 0x6e, 0xd3, 0xc9, 0x14, 0xd5, 0xb3, 0x9a, 0x94, 0x12, 0xbc, 0xc6,
+	0x74, 0x5e, 0x41, 0xa4, 0x3d, 0x41, 0x3b, 0xaf, 0x8f, 0x6f, 0xc0, 0xb9, 0xce, 0x8e, 0xab, 0x96,
+	0xa9, 0x07, 0x2e, 0xf2, 0xa3, 0x81, 0x8b, 0xdc, 0xbd, 0xb6, 0x3f, 0x94, 0xe0, 0x7c, 0x6c, 0x00,
+	0xaf, 0xdb, 0x4c, 0x85, 0x62, 0x14, 0x1b, 0xd8, 0x07, 0xc4, 0xe3, 0x9d, 0x21, 0xe2, 0xfb, 0x30,
+	0x1b, 0xf0, 0x63, 0x39, 0xd9, 0xf7, 0xcc, 0x5c, 0xa9, 0x64, 0xd5, 0x49, 0xf9, 0x55, 0xb5, 0x5a,
+	0x27, 0x91, 0x18, 0xd1, 0x19, 0x18, 0x75, 0x63, 0x2f, 0xfb, 0x4e, 0x5b, 0x70, 0x10, 0xdb, 0xf0,
+	0x74, 0x82, 0x75, 0x04, 0x15, 0xab, 0x30, 0x14, 0x78, 0x82, 0xcd, 0xc4, 0x3d, 0xc1, 0x8a, 0xb6,
+	0xeb, 0x3e, 0xb8, 0x0a, 0x6f, 0x7c, 0x16, 0x4e, 0xef, 0x29, 0xae, 0x52, 0xa9, 0xae, 0xd7, 0xab,
+	0x2a, 0x35, 0x2d, 0xaf, 0x08, 0x3f, 0x92, 0xe0, 0x4c, 0xb4, 0x9d, 0xc8, 0x6b, 0x17, 0x4e, 0xf8,
+	0xb6, 0x68, 0x4b, 0xd3, 0x15, 0xd5, 0x67, 0x26, 0xea, 0xf0, 0x72, 0xb2, 0x4d, 0xda, 0xd2, 0x74,
+	0xdf, 0x1a, 0x62, 0x97, 0x26, 0x69, 0xe7, 0x69, 0x1b, 0x5f, 0x87, 0xb3, 0x45, 0x52, 0xd1, 0x6c,
+	0x4a, 0x2c, 0x52, 0xce, 0x55, 0xab, 0xe6, 0x2e, 0x29, 0x3b, 0x97, 0x55, 0xc2, 0x42, 0x7c, 0x5f,
+	0x82, 0x73, 0x71, 0xfe, 0x02, 0xa4, 0x06, 0x63, 0x25, 0xd3, 0xa0, 0x96, 0x5a, 0xa2, 0x8a, 0x4d,
+	0x55, 0x4a, 0x44, 0xf1, 0x3d, 0x1f, 0x89, 0x8b, 0x85, 0x5c, 0x12, 0x7e, 0x01, 0x26, 0xd7, 0x9d,
+	0x18, 0x02, 0xdf, 0xa8, 0x1b, 0x99, 0x0d, 0xe2, 0x5c, 0x44, 0x52, 0xfc, 0xad, 0xd2, 0x45, 0x35,
+	0xd1, 0x76, 0xad, 0x7b, 0x57, 0xf8, 0xb7, 0x25, 0x38, 0x1f, 0x1b, 0xe3, 0xd3, 0x47, 0x86, 0x61,
+	0x26, 0x57, 0xad, 0x76, 0x4c, 0xcc, 0x2b, 0xbb, 0xf7, 0x24, 0x38, 0x15, 0x61, 0x24, 0x92, 0xde,
+	0x82, 0x43, 0xc1, 0xa4, 0xdd, 0x3a, 0x7b, 0x12, 0x59, 0x8f, 0x05, 0xb2, 0xb6, 0xf1, 0x1a, 0x20,
+	0xe7, 0x0d, 0xb3, 0xc0, 0xbe, 0x2d, 0x78, 0xda, 0xc2, 0x35, 0x38, 0xb8, 0x51, 0x35, 0x4b, 0x5b,
+	0xca, 0x26, 0xd1, 0x2a, 0x9b, 0xfc, 0x50, 0x0e, 0xe4, 0x27, 0x9a, 0x8d, 0xf4, 0x11, 0xf1, 0x8c,
+	0xef, 0x9b, 0xc5, 0xc5, 0x11, 0xf6, 0xe7, 0x6d, 0xfe, 0x57, 0x0d, 0x8e, 0x04, 0x22, 0x0a, 0x54,
+	0xaf, 0xc3, 0x53, 0xfc, 0x03, 0x86, 0x8b, 0xe6, 0x7c, 0xac, 0xf4, 0xc2, 0x43, 0xe4, 0x8f, 0x8b,
+	0x27, 0x2d, 0xf1, 0x18, 0x23, 0xa2, 0xe0, 0xa2, 0x1b, 0x6f, 0xe1, 0x83, 0x39, 0xd8, 0xff, 0x8a,
+	0xf3, 0x89, 0x03, 0x7d, 0x43, 0x82, 0x21, 0xfe, 0x1d, 0x00, 0x3d, 0x93, 0xe0, 0x63, 0x81, 0x80,
+	0x2b, 0x5f, 0x48, 0x64, 0xcb, 0x81, 0xe0, 0x0b, 0x5f, 0xf9, 0xfd, 0x5f, 0xdf, 0x4f, 0x9d, 0x45,
+	0xa7, 0xb3, 0x51, 0x5f, 0x6c, 0x44, 0x16, 0x7f, 0x93, 0x60, 0x2a, 0x54, 0x3a, 0x45, 0xd7, 0x23,
+	0xd7, 0x8d, 0xfb, 0x6e, 0x21, 0xdf, 0xe8, 0xd5, 0x5d, 0x20, 0xb9, 0xc3, 0x90, 0xac, 0xa2, 0xe5,
+	0x48, 0x24, 0xef, 0x8a, 0x63, 0xf8, 0x30, 0x4b, 0x44, 0x44, 0xfe, 0x39, 0x8a, 0x38, 0x31, 0x85,
+	0x72, 0xa0, 0x68, 0x06, 0xfa, 0x28, 0x05, 0x17, 0x42, 0xd7, 0xdc, 0x2b, 0x63, 0xa1, 0xbb, 0xbd,
+	0x65, 0x1f, 0x2a, 0x88, 0xf5, 0x4d, 0x87, 0xca, 0xe8, 0xf8, 0x12, 0x7a, 0xfd, 0x49, 0xd0, 0xa1,
+	0xbc, 0xa3, 0xd1, 0x4d, 0xa5, 0xe6, 0x26, 0xaa, 0xb0, 0xe7, 0x7e, 0xf4, 0xf5, 0x14, 0x9c, 0x4e,
+	0x20, 0x0e, 0xa3, 0x17, 0x92, 0x41, 0x89, 0x55, 0xc0, 0xe5, 0xdb, 0xfd, 0x07, 0x12, 0xec, 0xbc,
+	0xcc, 0xd8, 0xb9, 0x8d, 0x56, 0x23, 0xd9, 0x69, 0x71, 0xe2, 0x84, 0xe4, 0xdf, 0x44, 0x95, 0x8e,
+	0xe5, 0x12, 0xa0, 0x22, 0xfc, 0x23, 0x49, 0x52, 0x2a, 0x62, 0x3f, 0xb3, 0xf4, 0x5d, 0x1e, 0x5f,
+	0x64, 0x04, 0x14, 0xd1, 0x5a, 0xd7, 0xe5, 0xc1, 0x72, 0xe3, 0x22, 0x6a, 0x47, 0x2a, 0xfe, 0x29,
+	0x81, 0x1c, 0x2e, 0xf7, 0xa1, 0x9e, 0x12, 0x6f, 0xc9, 0x9d, 0xf2, 0xcd, 0x9e, 0xfd, 0x05, 0xf2,
+	0x97, 0x18, 0xf2, 0x17, 0xd0, 0x4a, 0xff, 0x07, 0xc3, 0xac, 0x53, 0xf4, 0x83, 0x14, 0x5c, 0xec,
+	0x46, 0xf0, 0x46, 0x6b, 0x3d, 0x02, 0x08, 0x6f, 0x15, 0x7d, 0x53, 0xb2, 0xc1, 0x28, 0x79, 0x13,
+	0xbd, 0xf1, 0x44, 0x28, 0xe9, 0xdc, 0x2c, 0xde, 0x4b, 0xc1, 0x99, 0x24, 0xb2, 0x36, 0xba, 0xdd,
+	0xdf, 0x11, 0x79, 0x92, 0xa5, 0xf2, 0x16, 0xe3, 0xe5, 0x35, 0xf4, 0x85, 0x2e, 0x79, 0x71, 0x58,
+	0x88, 0x39, 0x28, 0x4e, 0xe9, 0x7c, 0x20, 0xc1, 0x01, 0x57, 0x7e, 0x46, 0x17, 0x23, 0x93, 0x6d,
+	0x13, 0xae, 0xe5, 0xb9, 0x84, 0xd6, 0x02, 0x48, 0x86, 0x01, 0x99, 0x45, 0xe7, 0x22, 0x81, 0x78,
+	0xda, 0x36, 0xfa, 0xa6, 0x04, 0x83, 0x4e, 0x04, 0x34, 0x1b, 0xfd, 0x2c, 0xd1, 0x12, 0xae, 0xe4,
+	0xa7, 0x13, 0x58, 0x8a, 0x6c, 0x2e, 0xb3, 0x6c, 0x32, 0xe8, 0x62, 0x64, 0x36, 0x2c, 0x93, 0x16,
+	0xb9, 0x8c, 0x2d, 0x57, 0xd1, 0x8e, 0x61, 0xab, 0x4d, 0x0b, 0x97, 0xe7, 0x12, 0x5a, 0x77, 0xc5,
+	0x96, 0x5a, 0xad, 0xce, 0x71, 0xb6, 0x7e, 0x21, 0xc1, 0x78, 0xbb, 0xba, 0x8d, 0xa2, 0x5f, 0xa3,
+	0x42, 0xf4, 0x74, 0xf9, 0xd9, 0x2e, 0xbd, 0x44, 0xc6, 0x57, 0x59, 0xc6, 0x0b, 0xe8, 0x52, 0x64,
+	0xc6, 0x55, 0xcd, 0xa6, 0x3c, 0xe5, 0xb9, 0x8d, 0xdd, 0x39, 0xfe, 0xf6, 0xfb, 0xa1, 0x04, 0xc3,
+	0x9e, 0xe6, 0x8c, 0xa2, 0x89, 0x6a, 0x57, 0xdb, 0xe5, 0x4c, 0x52, 0x73, 0x91, 0xe6, 0x22, 0x4b,
+	0x73, 0x0e, 0x5d, 0xe8, 0x98, 0x66, 0xdb, 0x86, 0x67, 0x99, 0xdc, 0x64, 0xa3, 0x47, 0x12, 0xa0,
+	0xbd, 0xfa, 0x33, 0xfa, 0xff, 0xe8, 0xd7, 0xd4, 0x30, 0xed, 0x5b, 0xbe, 0xd2, 0xb5, 0x9f, 0x48,
+	0xbe, 0xc0, 0x92, 0x5f, 0x42, 0xb9, 0x6e, 0xaa, 0x36, 0x4b, 0x9d, 0x80, 0xbc, 0x09, 0x78, 0x0a,
+	0x30, 0xfa, 0xb1, 0x04, 0x63, 0x41, 0x6d, 0x1a, 0x2d, 0xc4, 0xa7, 0xb5, 0x07, 0xca, 0x62, 0x57,
+	0x3e, 0x5d, 0x1d, 0x3e, 0x9e, 0x76, 0x2b, 0xe3, 0x8f, 0xdd, 0x4d, 0x08, 0x28, 0xcd, 0x49, 0x36,
+	0xa1, 0x93, 0xca, 0x2d, 0x5f, 0xe9, 0xda, 0x4f, 0x64, 0x9f, 0x63, 0xd9, 0x3f, 0x87, 0x3e, 0xd7,
+	0xc3, 0x26, 0x70, 0x7d, 0x1a, 0xfd, 0x5a, 0x82, 0x23, 0x1d, 0x84, 0x62, 0x14, 0x93, 0x53, 0xa8,
+	0xa4, 0x2d, 0x5f, 0xed, 0xde, 0x51, 0xa0, 0xb9, 0xc6, 0xd0, 0x5c, 0x46, 0x0b, 0xd1, 0x7b, 0xc1,
+	0x23, 0x28, 0x35, 0x55, 0xb3, 0x14, 0x26, 0xb0, 0xdc, 0x27, 0x04, 0xfd, 0x43, 0x82, 0x74, 0x8c,
+	0x98, 0x8a, 0x96, 0x12, 0x5d, 0x80, 0xd1, 0x5a, 0xb6, 0xbc, 0xdc, 0x5f, 0x10, 0x01, 0xf5, 0x3a,
+	0x83, 0x7a, 0x05, 0x3d, 0xdb, 0xed, 0x55, 0xea, 0xa0, 0x27, 0xe8, 0xb1, 0x04, 0x72, 0xb8, 0xce,
+	0x1a, 0xf3, 0x50, 0x19, 0x2b, 0xe3, 0xca, 0x37, 0x7b, 0xf6, 0x17, 0xf0, 0x96, 0x18, 0xbc, 0xeb,
+	0xe8, 0xb9, 0xb8, 0x2b, 0x43, 0x09, 0xd7, 0x81, 0xd1, 0x7f, 0x24, 0x48, 0xc7, 0xa8, 0xad, 0x31,
+	0x5b, 0x9a, 0x4c, 0xec, 0x95, 0x97, 0xfb, 0x0b, 0x22, 0x30, 0xbf, 0xc2, 0x30, 0xbf, 0x88, 0x0a,
+	0xd1, 0x5b, 0xca, 0xee, 0x99, 0x87, 0xd9, 0x50, 0xdc, 0x0a, 0xfb, 0x52, 0xc2, 0x6f, 0xa3, 0xef,
+	0xa4, 0xe0, 0x54, 0xac, 0xcc, 0x8a, 0x56, 0x92, 0xa7, 0x1f, 0x21, 0x07, 0xcb, 0xab, 0xfd, 0x86,
+	0x11, 0x3c, 0x94, 0x19, 0x0f, 0x6f, 0xa3, 0x37, 0xa3, 0x79, 0x08, 0xe8, 0xc9, 0x0f, 0x43, 0x79,
+	0x61, 0xc3, 0xb6, 0x42, 0x4d, 0x45, 0xe5, 0x8b, 0x29, 0xdb, 0x0c, 0xf4, 0xdf, 0x25, 0x38, 0x19,
+	0x25, 0xf2, 0xa2, 0x5b, 0xdd, 0xd5, 0xf0, 0x5e, 0x1d, 0x59, 0xce, 0xf5, 0x11, 0x41, 0x70, 0xb1,
+	0xc2, 0xb8, 0xb8, 0x89, 0xae, 0x77, 0x7f, 0x0e, 0xfc, 0x58, 0xfe, 0x2d, 0xc1, 0x74, 0xb4, 0xdc,
+	0x8b, 0xf2, 0x91, 0xc9, 0x26, 0xd2, 0x9a, 0xe5, 0xa5, 0xbe, 0x62, 0x08, 0xc8, 0x77, 0x19, 0xe4,
+	0x02, 0x7a, 0x21, 0xd1, 0x31, 0xb0, 0xbc, 0xa0, 0x8a, 0xca, 0xa3, 0xf2, 0x87, 0x03, 0xdf, 0x21,
+	0xf8, 0x72, 0x0a, 0xd2, 0x31, 0x92, 0x30, 0xea, 0x31, 0xf3, 0x80, 0x28, 0x2d, 0x2f, 0xf7, 0x17,
+	0x44, 0xe0, 0x5f, 0x67, 0xf8, 0x5f, 0x42, 0x2f, 0x26, 0xec, 0xec, 0x91, 0x0c, 0x08, 0x2b, 0xf4,
+	0x27, 0x09, 0xa6, 0x42, 0xb5, 0xe5, 0x18, 0xa5, 0x31, 0x4e, 0xb8, 0x96, 0x6f, 0xf4, 0xea, 0xde,
+	0xd5, 0x43, 0x88, 0x53, 0xe4, 0x21, 0x58, 0x6d, 0xf4, 0x53, 0x09, 0x46, 0x7c, 0xba, 0x32, 0xca,
+	0x26, 0x94, 0x8f, 0x3d, 0x0c, 0x97, 0x92, 0x3b, 0x88, 0xac, 0x6f, 0xb1, 0xac, 0xaf, 0xa1, 0xab,
+	0xd9, 0x84, 0xff, 0x9b, 0x6f, 0x67, 0xdf, 0xf5, 0x0b, 0xe3, 0x0f, 0xf3, 0x6f, 0x7d, 0xfc, 0x78,
+	0x5a, 0x7a, 0xf4, 0x78, 0x5a, 0xfa, 0xcb, 0xe3, 0x69, 0xe9, 0xbd, 0x4f, 0xa6, 0xf7, 0x3d, 0xfa,
+	0x64, 0x7a, 0xdf, 0x1f, 0x3e, 0x99, 0xde, 0xf7, 0xc6, 0x92, 0xef, 0xbf, 0x04, 0x44, 0xf4, 0xb9,
+	0xaa, 0xba, 0x61, 0x7b, 0x4b, 0x6d, 0x2f, 0xce, 0x67, 0x77, 0x02, 0x0b, 0x96, 0xaa, 0x1a, 0x31,
+	0x28, 0xff, 0x67, 0x7e, 0xfe, 0x9f, 0x3e, 0x43, 0xec, 0xc7, 0xe2, 0x7f, 0x07, 0x00, 0x95, 0xec,
+	0x85, 0xa7, 0x4a, 0x31, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2405,6 +2615,9 @@ type QueryClient interface {
 	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
 	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
 	EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, in *EstimateSwapExactAmountInWithPrimitiveTypesRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSmartRouteSwapExactAmountIn returns the routes and amount out that
+	// MsgSmartRouteSwapExactAmountIn would use for the given token in.
+	EstimateSmartRouteSwapExactAmountIn(ctx context.Context, in *EstimateSmartRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSmartRouteSwapExactAmountInResponse, error)
 	EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error)
 	// Estimates swap amount in given out.
 	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
@@ -2470,6 +2683,11 @@ type QueryClient interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(ctx context.Context, in *AllRegisteredAlloyedPoolsRequest, opts ...grpc.CallOption) (*AllRegisteredAlloyedPoolsResponse, error)
+	// SwapIntents returns the swap intents included in the given block. Intents
+	// of the current block are pending until the end of the block, intents of
+	// past blocks are either settled or refunded. Records are kept for a limited
+	// number of blocks.
+	SwapIntents(ctx context.Context, in *SwapIntentsRequest, opts ...grpc.CallOption) (*SwapIntentsResponse, error)
 }
 
 type queryClient struct {
@@ -2507,6 +2725,15 @@ func (c *queryClient) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Co
 	return out, nil
 }
 
+func (c *queryClient) EstimateSmartRouteSwapExactAmountIn(ctx context.Context, in *EstimateSmartRouteSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSmartRouteSwapExactAmountInResponse, error) {
+	out := new(EstimateSmartRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSmartRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, in *EstimateSinglePoolSwapExactAmountInRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountInResponse, error) {
 	out := new(EstimateSwapExactAmountInResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/EstimateSinglePoolSwapExactAmountIn", in, out, opts...)
@@ -2696,6 +2923,15 @@ func (c *queryClient) AllRegisteredAlloyedPools(ctx context.Context, in *AllRegi
 	return out, nil
 }
 
+func (c *queryClient) SwapIntents(ctx context.Context, in *SwapIntentsRequest, opts ...grpc.CallOption) (*SwapIntentsResponse, error) {
+	out := new(SwapIntentsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/SwapIntents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -2712,6 +2948,9 @@ type QueryServer interface {
 	// swap_exact_amount_in_with_primitive_types?token_in=100000stake&routes_token_out_denom=uatom
 	// &routes_token_out_denom=uion&routes_pool_id=1&routes_pool_id=2
 	EstimateSwapExactAmountInWithPrimitiveTypes(context.Context, *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error)
+	// EstimateSmartRouteSwapExactAmountIn returns the routes and amount out that
+	// MsgSmartRouteSwapExactAmountIn would use for the given token in.
+	EstimateSmartRouteSwapExactAmountIn(context.Context, *EstimateSmartRouteSwapExactAmountInRequest) (*EstimateSmartRouteSwapExactAmountInResponse, error)
 	EstimateSinglePoolSwapExactAmountIn(context.Context, *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error)
 	// Estimates swap amount in given out.
 	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
@@ -2777,6 +3016,11 @@ type QueryServer interface {
 	// the current distribution composition of taker fee share denoms within the
 	// alloyed pool.
 	AllRegisteredAlloyedPools(context.Context, *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error)
+	// SwapIntents returns the swap intents included in the given block. Intents
+	// of the current block are pending until the end of the block, intents of
+	// past blocks are either settled or refunded. Records are kept for a limited
+	// number of blocks.
+	SwapIntents(context.Context, *SwapIntentsRequest) (*SwapIntentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2792,6 +3036,9 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateSwapExactAmountInWithPrimitiveTypes(ctx context.Context, req *EstimateSwapExactAmountInWithPrimitiveTypesRequest) (*EstimateSwapExactAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountInWithPrimitiveTypes not implemented")
 }
+func (*UnimplementedQueryServer) EstimateSmartRouteSwapExactAmountIn(ctx context.Context, req *EstimateSmartRouteSwapExactAmountInRequest) (*EstimateSmartRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSmartRouteSwapExactAmountIn not implemented")
+}
 func (*UnimplementedQueryServer) EstimateSinglePoolSwapExactAmountIn(ctx context.Context, req *EstimateSinglePoolSwapExactAmountInRequest) (*EstimateSwapExactAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSinglePoolSwapExactAmountIn not implemented")
 }
@@ -2855,6 +3102,9 @@ func (*UnimplementedQueryServer) RegisteredAlloyedPoolFromPoolId(ctx context.Con
 func (*UnimplementedQueryServer) AllRegisteredAlloyedPools(ctx context.Context, req *AllRegisteredAlloyedPoolsRequest) (*AllRegisteredAlloyedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllRegisteredAlloyedPools not implemented")
 }
+func (*UnimplementedQueryServer) SwapIntents(ctx context.Context, req *SwapIntentsRequest) (*SwapIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapIntents not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2914,6 +3164,24 @@ func _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateSmartRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSmartRouteSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSmartRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/EstimateSmartRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSmartRouteSwapExactAmountIn(ctx, req.(*EstimateSmartRouteSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateSinglePoolSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EstimateSinglePoolSwapExactAmountInRequest)
 	if err := dec(in); err != nil {
@@ -3292,6 +3560,24 @@ func _Query_AllRegisteredAlloyedPools_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SwapIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapIntentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/SwapIntents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapIntents(ctx, req.(*SwapIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3308,6 +3594,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountInWithPrimitiveTypes",
 			Handler:    _Query_EstimateSwapExactAmountInWithPrimitiveTypes_Handler,
 		},
+		{
+			MethodName: "EstimateSmartRouteSwapExactAmountIn",
+			Handler:    _Query_EstimateSmartRouteSwapExactAmountIn_Handler,
+		},
 		{
 			MethodName: "EstimateSinglePoolSwapExactAmountIn",
 			Handler:    _Query_EstimateSinglePoolSwapExactAmountIn_Handler,
@@ -3392,6 +3682,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllRegisteredAlloyedPools",
 			Handler:    _Query_AllRegisteredAlloyedPools_Handler,
 		},
+		{
+			MethodName: "SwapIntents",
+			Handler:    _Query_SwapIntents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
@@ -3646,7 +3940,7 @@ func (m *EstimateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+func (m *EstimateSmartRouteSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3656,28 +3950,112 @@ func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateSmartRouteSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateSmartRouteSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSwapExactAmountOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSwapExactAmountOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSwapExactAmountOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
 				i -= size
@@ -4957,6 +5335,71 @@ func (m *AllRegisteredAlloyedPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *SwapIntentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapIntentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapIntentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapIntentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -5074,6 +5517,40 @@ func (m *EstimateSwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
+func (m *EstimateSmartRouteSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSmartRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EstimateSwapExactAmountOutRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5603,6 +6080,33 @@ func (m *AllRegisteredAlloyedPoolsResponse) Size() (n int) {
 	return n
 }
 
+func (m *SwapIntentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *SwapIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6065,9 +6569,224 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field RoutesPoolId", wireType)
 			}
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoutesTokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6095,7 +6814,9 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoutesTokenOutDenom = append(m.RoutesTokenOutDenom, string(dAtA[iNdEx:postIndex]))
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6118,7 +6839,7 @@ func (m *EstimateSwapExactAmountInWithPrimitiveTypesRequest) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSmartRouteSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6141,32 +6862,13 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSmartRouteSwapExactAmountInRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSinglePoolSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSmartRouteSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
@@ -6198,7 +6900,7 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 			}
 			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
@@ -6251,7 +6953,7 @@ func (m *EstimateSinglePoolSwapExactAmountInRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSmartRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6274,10 +6976,10 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSmartRouteSwapExactAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSmartRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6314,6 +7016,40 @@ func (m *EstimateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9755,6 +10491,159 @@ func (m *AllRegisteredAlloyedPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapIntentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapIntentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapIntentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapIntentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapIntentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intents = append(m.Intents, types.SwapIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -124,6 +124,11 @@ func (k Keeper) createPoolZeroLiquidityNoCreationFee(ctx sdk.Context, msg types.
 		return nil, err
 	}
 
+	// Index the pool by its denoms for the smart router.
+	if err := k.setPoolDenomIndex(ctx, poolId); err != nil {
+		return nil, err
+	}
+
 	// Create and save the pool's module account to the account keeper.
 	// This utilizes the pool address already created and validated in the previous steps.
 	if err := osmoutils.CreateModuleAccount(ctx, k.accountKeeper, pool.GetAddress()); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/x/gamm/types"
 )
 
@@ -29,6 +30,17 @@ func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output
 	)
 }
 
+// EmitSwapEventWithSpreadFactor emits a swap event that additionally carries the spread factor
+// that was charged on the swap. It is used by pool modules whose spread factor can differ
+// from the pool's static spread factor.
+func EmitSwapEventWithSpreadFactor(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, spreadFactor osmomath.Dec) {
+	swapEvent := newSwapEvent(sender, poolId, input, output)
+	swapEvent = swapEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeySpreadFactor, spreadFactor.String()))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		swapEvent,
+	})
+}
+
 func EmitAddLiquidityEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		newAddLiquidityEvent(sender, poolId, liquidity),
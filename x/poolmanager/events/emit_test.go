@@ -84,6 +84,57 @@ func (suite *PoolManagerEventsTestSuite) TestEmitSwapEvent() {
 	}
 }
 
+func (suite *PoolManagerEventsTestSuite) TestEmitSwapEventWithSpreadFactor() {
+	testcases := map[string]struct {
+		ctx             sdk.Context
+		testAccountAddr sdk.AccAddress
+		poolId          uint64
+		tokensIn        sdk.Coins
+		tokensOut       sdk.Coins
+		spreadFactor    osmomath.Dec
+	}{
+		"basic valid": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolId:          1,
+			tokensIn:        sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(1234))),
+			tokensOut:       sdk.NewCoins(sdk.NewCoin(testDenomB, osmomath.NewInt(5678))),
+			spreadFactor:    osmomath.NewDecWithPrec(3, 3),
+		},
+		"zero spread factor": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolId:          200,
+			tokensIn:        sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(12))),
+			tokensOut:       sdk.NewCoins(sdk.NewCoin(testDenomC, osmomath.NewInt(88))),
+			spreadFactor:    osmomath.ZeroDec(),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtTokenSwapped,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
+					sdk.NewAttribute(types.AttributeKeyTokensIn, tc.tokensIn.String()),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.tokensOut.String()),
+					sdk.NewAttribute(types.AttributeKeySpreadFactor, tc.spreadFactor.String()),
+				),
+			}
+
+			// System under test.
+			events.EmitSwapEventWithSpreadFactor(tc.ctx, tc.testAccountAddr, tc.poolId, tc.tokensIn, tc.tokensOut, tc.spreadFactor)
+
+			// Assertions
+			actualEvents := tc.ctx.EventManager().Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *PoolManagerEventsTestSuite) TestEmitAddLiquidityEvent() {
 	testcases := map[string]struct {
 		ctx             sdk.Context
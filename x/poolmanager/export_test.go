@@ -154,3 +154,12 @@ func (k *Keeper) SetCacheTrackers(takerFeeShareAgreement map[string]types.TakerF
 func (k Keeper) FundCommunityPoolIfNotWhitelisted(ctx sdk.Context, sender sdk.AccAddress) error {
 	return k.fundCommunityPoolIfNotWhitelisted(ctx, sender)
 }
+
+func (k Keeper) GetPoolIdsByDenom(ctx sdk.Context, denom string) []uint64 {
+	poolIds := []uint64{}
+	k.iteratePoolIdsByDenom(ctx, denom, func(poolId uint64) bool {
+		poolIds = append(poolIds, poolId)
+		return false
+	})
+	return poolIds
+}
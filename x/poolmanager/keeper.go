@@ -128,6 +128,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 	k.SetParams(ctx, genState.Params)
 
+	// Create the swap intent escrow module account if it does not exist yet.
+	k.accountKeeper.GetModuleAccount(ctx, types.SwapIntentEscrowName)
+
 	for _, poolRoute := range genState.PoolRoutes {
 		k.SetPoolRoute(ctx, poolRoute.PoolId, poolRoute.PoolType)
 	}
@@ -258,6 +261,8 @@ var AlloyedAssetCompositionUpdateRate = int64(700)
 // EndBlock updates the taker fee share alloy composition for all registered alloyed pools
 // if the current block height is a multiple of the alloyedAssetCompositionUpdateRate.
 func (k *Keeper) EndBlock(ctx sdk.Context) {
+	k.SettleSwapIntents(ctx)
+
 	if ctx.BlockHeight()%AlloyedAssetCompositionUpdateRate == 0 {
 		registeredAlloyPoolIds, err := k.getAllRegisteredAlloyedPoolsIdArray(ctx)
 		if err != nil {
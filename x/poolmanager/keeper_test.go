@@ -40,6 +40,8 @@ var (
 	testAdminAddresses                                 = []string{"osmo106x8q2nv7xsg7qrec2zgdf3vvq0t3gn49zvaha", "osmo105l5r3rjtynn7lg362r2m9hkpfvmgmjtkglsn9"}
 	testCommunityPoolDenomToSwapNonWhitelistedAssetsTo = "uusdc"
 	testAuthorizedQuoteDenoms                          = []string{appparams.BaseCoinUnit, "uion", "uatom"}
+	testSmartRouterParams                              = types.SmartRouterParams{MaxHops: 2, MaxPools: 10, MaxSplitRoutes: 2, MaxGas: 1_000_000}
+	testSwapIntentParams                               = types.SwapIntentParams{MaxIntentsPerBlock: 100, MaxPairsPerBlock: 10, MaxRoundsPerPair: 2, MaxGas: 100_000_000}
 
 	testPoolRoute = []types.ModuleRoute{
 		{
@@ -140,6 +142,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 				DailyStakingRewardsSmoothingFactor:             1,
 			},
 			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			SmartRouterParams:     testSmartRouterParams,
+			SwapIntentParams:      testSwapIntentParams,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -157,6 +161,8 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	s.Require().Equal(testAdminAddresses, params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testSmartRouterParams, params.SmartRouterParams)
+	s.Require().Equal(testSwapIntentParams, params.SwapIntentParams)
 	s.Require().Equal(testPoolRoute, s.App.PoolManagerKeeper.GetAllPoolRoutes(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, s.App.PoolManagerKeeper.GetTakerFeeTrackerForStakers(s.Ctx))
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, s.App.PoolManagerKeeper.GetTakerFeeTrackerForCommunityPool(s.Ctx))
@@ -189,6 +195,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 				DailyStakingRewardsSmoothingFactor:             1,
 			},
 			AuthorizedQuoteDenoms: testAuthorizedQuoteDenoms,
+			SmartRouterParams:     testSmartRouterParams,
+			SwapIntentParams:      testSwapIntentParams,
 		},
 		NextPoolId:             testExpectedPoolId,
 		PoolRoutes:             testPoolRoute,
@@ -206,6 +214,8 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testAdminAddresses, genesis.Params.TakerFeeParams.AdminAddresses)
 	s.Require().Equal(testCommunityPoolDenomToSwapNonWhitelistedAssetsTo, genesis.Params.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
 	s.Require().Equal(testAuthorizedQuoteDenoms, genesis.Params.AuthorizedQuoteDenoms)
+	s.Require().Equal(testSmartRouterParams, genesis.Params.SmartRouterParams)
+	s.Require().Equal(testSwapIntentParams, genesis.Params.SwapIntentParams)
 	s.Require().Equal(testPoolRoute, genesis.PoolRoutes)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToStakers, genesis.TakerFeesTracker.TakerFeesToStakers)
 	s.Require().Equal(testTakerFeesTracker.TakerFeesToCommunityPool, genesis.TakerFeesTracker.TakerFeesToCommunityPool)
@@ -139,7 +139,7 @@ func (am AppModule) BeginBlock(context context.Context) error {
 	return nil
 }
 
-// EndBlock performs alloy pool state updates for the poolmanager module.
+// EndBlock settles the block's swap intents and performs alloy pool state updates for the poolmanager module.
 func (am AppModule) EndBlock(context context.Context) error {
 	ctx := sdk.UnwrapSDKContext(context)
 	am.k.EndBlock(ctx)
@@ -76,6 +76,40 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
 
+func (server msgServer) SmartRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSmartRouteSwapExactAmountIn) (*types.MsgSmartRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, routes, err := server.keeper.SmartRouteExactAmountIn(ctx, sender, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+
+	return &types.MsgSmartRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount, Routes: routes}, nil
+}
+
+func (server msgServer) SubmitSwapIntent(goCtx context.Context, msg *types.MsgSubmitSwapIntent) (*types.MsgSubmitSwapIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	intentId, err := server.keeper.SubmitSwapIntent(ctx, sender, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount, msg.Deadline, msg.RefundIfUnsettled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitSwapIntentResponse{IntentId: intentId}, nil
+}
+
 func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountOut) (*types.MsgSplitRouteSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -0,0 +1,342 @@
+package poolmanager
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// smartRouterSplitSteps is the number of chunks the input amount is divided into
+// when distributing it across the candidate routes.
+const smartRouterSplitSteps = 10
+
+// smartRouteCandidate is a route found by the smart router along with its quote
+// for the full input amount.
+type smartRouteCandidate struct {
+	route    []types.SwapAmountInRoute
+	poolIds  map[uint64]struct{}
+	quoteOut osmomath.Int
+}
+
+// smartRouteSearch holds the state of a single smart router route search.
+type smartRouteSearch struct {
+	k             Keeper
+	ctx           sdk.Context
+	params        types.SmartRouterParams
+	startGas      uint64
+	tokenOutDenom string
+	poolsByDenom  map[string][]types.PoolI
+	seenPools     map[uint64]struct{}
+	candidates    [][]types.SwapAmountInRoute
+}
+
+// outOfGasBudget returns true if the search consumed more gas than allowed by the smart router params.
+func (s *smartRouteSearch) outOfGasBudget() bool {
+	return s.ctx.GasMeter().GasConsumed()-s.startGas > s.params.MaxGas
+}
+
+// listPoolsByDenom returns the pools containing the given denom, caching the result for the
+// duration of the search. Pools are looked up in the pool denom index, and the lookup stops
+// early once the gas budget is exhausted.
+func (s *smartRouteSearch) listPoolsByDenom(denom string) ([]types.PoolI, error) {
+	if pools, ok := s.poolsByDenom[denom]; ok {
+		return pools, nil
+	}
+
+	pools := []types.PoolI{}
+	var err error
+	s.k.iteratePoolIdsByDenom(s.ctx, denom, func(poolId uint64) bool {
+		if s.outOfGasBudget() {
+			return true
+		}
+		var pool types.PoolI
+		pool, err = s.k.GetPool(s.ctx, poolId)
+		if err != nil {
+			return true
+		}
+		pools = append(pools, pool)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.poolsByDenom[denom] = pools
+	return pools, nil
+}
+
+// findCandidateRoutes performs a depth first search from the given denom, appending every route
+// that ends in the token out denom to the candidates. Routes never revisit a denom nor reuse a pool.
+// The search stops exploring new pools once MaxPools distinct pools were visited, and stops
+// altogether once the gas budget is exhausted.
+func (s *smartRouteSearch) findCandidateRoutes(denom string, route []types.SwapAmountInRoute, visitedDenoms map[string]struct{}) error {
+	if s.outOfGasBudget() {
+		return nil
+	}
+	pools, err := s.listPoolsByDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		if s.outOfGasBudget() {
+			return nil
+		}
+
+		poolId := pool.GetId()
+		if routeContainsPool(route, poolId) || !pool.IsActive(s.ctx) {
+			continue
+		}
+		if _, seen := s.seenPools[poolId]; !seen {
+			if uint64(len(s.seenPools)) >= s.params.MaxPools {
+				continue
+			}
+			s.seenPools[poolId] = struct{}{}
+		}
+
+		poolDenoms := pool.GetPoolDenoms(s.ctx)
+		sort.Strings(poolDenoms)
+		for _, outDenom := range poolDenoms {
+			if _, visited := visitedDenoms[outDenom]; visited {
+				continue
+			}
+
+			nextRoute := make([]types.SwapAmountInRoute, len(route), len(route)+1)
+			copy(nextRoute, route)
+			nextRoute = append(nextRoute, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: outDenom})
+
+			if outDenom == s.tokenOutDenom {
+				s.candidates = append(s.candidates, nextRoute)
+				continue
+			}
+
+			if uint64(len(nextRoute)) >= s.params.MaxHops {
+				continue
+			}
+
+			visitedDenoms[outDenom] = struct{}{}
+			err := s.findCandidateRoutes(outDenom, nextRoute, visitedDenoms)
+			delete(visitedDenoms, outDenom)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// quote estimates the amount out of the given route for the given amount in, including taker fees.
+// Routes that fail to estimate are quoted as zero so that they are never selected.
+// If the estimate ran out of gas, the out of gas panic is rethrown rather than treating the route as failed,
+// since the gas limit of the transaction is exhausted.
+func (s *smartRouteSearch) quote(route []types.SwapAmountInRoute, tokenIn sdk.Coin) osmomath.Int {
+	if !tokenIn.Amount.IsPositive() {
+		return osmomath.ZeroInt()
+	}
+	tokenOutAmount, err := s.k.multihopEstimateOutGivenExactAmountInInternal(s.ctx, route, tokenIn, true)
+	if err != nil {
+		if s.ctx.GasMeter().IsOutOfGas() {
+			panic(storetypes.ErrorOutOfGas{Descriptor: "smart router quote"})
+		}
+		return osmomath.ZeroInt()
+	}
+	return tokenOutAmount
+}
+
+// FindSmartRoute searches for the best split of tokenIn into tokenOutDenom across the pools of all
+// pool modules, bounded by the smart router params. Returns the split routes and the estimated
+// total amount out.
+//
+// Candidate routes are discovered via the pool denom index and quoted for the full amount in. The best
+// pool-disjoint candidates, up to MaxSplitRoutes, are kept and the amount in is then distributed
+// among them in smartRouterSplitSteps chunks, each chunk going to the route with the highest
+// marginal amount out. Once the gas budget is exhausted, the search stops and the remainder of the
+// amount in is allocated to the best route found so far.
+func (k Keeper) FindSmartRoute(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("token in and token out denoms must be different, both were (%s)", tokenOutDenom)
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return nil, osmomath.Int{}, types.FinalAmountIsNotPositiveError{IsAmountOut: false, Amount: tokenIn.Amount}
+	}
+
+	search := &smartRouteSearch{
+		k:             k,
+		ctx:           ctx,
+		params:        k.GetParams(ctx).SmartRouterParams,
+		startGas:      ctx.GasMeter().GasConsumed(),
+		tokenOutDenom: tokenOutDenom,
+		poolsByDenom:  make(map[string][]types.PoolI),
+		seenPools:     make(map[uint64]struct{}),
+	}
+	if err := search.findCandidateRoutes(tokenIn.Denom, nil, map[string]struct{}{tokenIn.Denom: {}}); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	candidates := make([]smartRouteCandidate, 0, len(search.candidates))
+	for _, route := range search.candidates {
+		if search.outOfGasBudget() {
+			break
+		}
+		quoteOut := search.quote(route, tokenIn)
+		if !quoteOut.IsPositive() {
+			continue
+		}
+		poolIds := make(map[uint64]struct{}, len(route))
+		for _, step := range route {
+			poolIds[step.PoolId] = struct{}{}
+		}
+		candidates = append(candidates, smartRouteCandidate{route: route, poolIds: poolIds, quoteOut: quoteOut})
+	}
+	if len(candidates) == 0 {
+		return nil, osmomath.Int{}, types.NoSmartRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	// Keep the best quoted routes that do not share pools with each other, so that
+	// the quote of one route is not affected by swapping through another.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quoteOut.GT(candidates[j].quoteOut)
+	})
+	selected := make([]smartRouteCandidate, 0, search.params.MaxSplitRoutes)
+	for _, candidate := range candidates {
+		if uint64(len(selected)) >= search.params.MaxSplitRoutes {
+			break
+		}
+		if sharesPool(candidate, selected) {
+			continue
+		}
+		selected = append(selected, candidate)
+	}
+
+	allocations, amountsOut := search.splitAmountIn(selected, tokenIn)
+
+	splitRoutes := make([]types.SwapAmountInSplitRoute, 0, len(selected))
+	totalOut := osmomath.ZeroInt()
+	for i, candidate := range selected {
+		if !allocations[i].IsPositive() {
+			continue
+		}
+		splitRoutes = append(splitRoutes, types.SwapAmountInSplitRoute{
+			Pools:         candidate.route,
+			TokenInAmount: allocations[i],
+		})
+		totalOut = totalOut.Add(amountsOut[i])
+	}
+	if !totalOut.IsPositive() {
+		return nil, osmomath.Int{}, types.NoSmartRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	return splitRoutes, totalOut, nil
+}
+
+// splitAmountIn distributes tokenIn across the selected routes, returning the amount in allocated to
+// each route along with its estimated amount out. The selected routes must be sorted by descending
+// full amount quote.
+func (s *smartRouteSearch) splitAmountIn(selected []smartRouteCandidate, tokenIn sdk.Coin) ([]osmomath.Int, []osmomath.Int) {
+	allocations := make([]osmomath.Int, len(selected))
+	amountsOut := make([]osmomath.Int, len(selected))
+	for i := range selected {
+		allocations[i] = osmomath.ZeroInt()
+		amountsOut[i] = osmomath.ZeroInt()
+	}
+
+	// A single route takes the whole amount, for which it was already quoted.
+	if len(selected) == 1 {
+		allocations[0] = tokenIn.Amount
+		amountsOut[0] = selected[0].quoteOut
+		return allocations, amountsOut
+	}
+
+	steps := osmomath.NewInt(smartRouterSplitSteps)
+	if tokenIn.Amount.LT(steps) {
+		steps = tokenIn.Amount
+	}
+	chunk := tokenIn.Amount.Quo(steps)
+	remaining := tokenIn.Amount
+
+	for step := int64(0); step < steps.Int64(); step++ {
+		if s.outOfGasBudget() {
+			break
+		}
+
+		stepAmount := chunk
+		if step == steps.Int64()-1 {
+			stepAmount = remaining
+		}
+
+		bestIndex := -1
+		bestGain := osmomath.ZeroInt()
+		bestOut := osmomath.ZeroInt()
+		for i, candidate := range selected {
+			out := s.quote(candidate.route, sdk.NewCoin(tokenIn.Denom, allocations[i].Add(stepAmount)))
+			gain := out.Sub(amountsOut[i])
+			if gain.GT(bestGain) {
+				bestIndex, bestGain, bestOut = i, gain, out
+			}
+		}
+		if bestIndex == -1 {
+			break
+		}
+
+		allocations[bestIndex] = allocations[bestIndex].Add(stepAmount)
+		amountsOut[bestIndex] = bestOut
+		remaining = remaining.Sub(stepAmount)
+	}
+
+	// Any amount left over, either because the gas budget ran out or because no route yielded
+	// additional output, is allocated to the best quoted route.
+	if remaining.IsPositive() {
+		allocations[0] = allocations[0].Add(remaining)
+		amountsOut[0] = s.quote(selected[0].route, sdk.NewCoin(tokenIn.Denom, allocations[0]))
+	}
+
+	return allocations, amountsOut
+}
+
+// SmartRouteExactAmountIn finds the best split of tokenIn into tokenOutDenom via FindSmartRoute and
+// swaps through it. Errors if the amount out is less than tokenOutMinAmount.
+// Returns the amount out and the split routes that were executed.
+func (k Keeper) SmartRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+) (osmomath.Int, []types.SwapAmountInSplitRoute, error) {
+	routes, _, err := k.FindSmartRoute(ctx, tokenIn, tokenOutDenom)
+	if err != nil {
+		return osmomath.Int{}, nil, err
+	}
+
+	tokenOutAmount, err := k.SplitRouteExactAmountIn(ctx, sender, routes, tokenIn.Denom, tokenOutMinAmount)
+	if err != nil {
+		return osmomath.Int{}, nil, err
+	}
+
+	return tokenOutAmount, routes, nil
+}
+
+func routeContainsPool(route []types.SwapAmountInRoute, poolId uint64) bool {
+	for _, step := range route {
+		if step.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+func sharesPool(candidate smartRouteCandidate, selected []smartRouteCandidate) bool {
+	for _, other := range selected {
+		for poolId := range candidate.poolIds {
+			if _, ok := other.poolIds[poolId]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
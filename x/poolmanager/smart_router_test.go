@@ -0,0 +1,240 @@
+package poolmanager_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+var defaultSmartRouterPoolAmount = osmomath.NewInt(1_000_000)
+
+func (s *KeeperTestSuite) TestFindSmartRoute() {
+	var (
+		fooBarPool = sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+		fooBazPool = sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAZ, defaultSmartRouterPoolAmount))
+		bazBarPool = sdk.NewCoins(sdk.NewCoin(BAZ, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	)
+
+	tests := map[string]struct {
+		poolCoins         []sdk.Coins
+		smartRouterParams *types.SmartRouterParams
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+
+		expectedRoutes []types.SwapAmountInSplitRoute
+		expectedError  error
+	}{
+		"single pool": {
+			poolCoins:     []sdk.Coins{fooBarPool},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			tokenOutDenom: BAR,
+
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(1000)},
+			},
+		},
+		"two hop route": {
+			poolCoins:     []sdk.Coins{fooBazPool, bazBarPool},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			tokenOutDenom: BAR,
+
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAZ}, {PoolId: 2, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(1000)},
+			},
+		},
+		"split across identical pools": {
+			poolCoins:     []sdk.Coins{fooBarPool, fooBarPool},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(500_000)),
+			tokenOutDenom: BAR,
+
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(250_000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(250_000)},
+			},
+		},
+		"max split routes of one": {
+			poolCoins:         []sdk.Coins{fooBarPool, fooBarPool},
+			smartRouterParams: &types.SmartRouterParams{MaxHops: 3, MaxPools: 50, MaxSplitRoutes: 1, MaxGas: 5_000_000},
+			tokenIn:           sdk.NewCoin(FOO, osmomath.NewInt(500_000)),
+			tokenOutDenom:     BAR,
+
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(500_000)},
+			},
+		},
+		"max pools of one ignores the second pool": {
+			poolCoins:         []sdk.Coins{fooBarPool, fooBarPool},
+			smartRouterParams: &types.SmartRouterParams{MaxHops: 3, MaxPools: 1, MaxSplitRoutes: 3, MaxGas: 5_000_000},
+			tokenIn:           sdk.NewCoin(FOO, osmomath.NewInt(500_000)),
+			tokenOutDenom:     BAR,
+
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, TokenInAmount: osmomath.NewInt(500_000)},
+			},
+		},
+		"error: route exceeds max hops": {
+			poolCoins:         []sdk.Coins{fooBazPool, bazBarPool},
+			smartRouterParams: &types.SmartRouterParams{MaxHops: 1, MaxPools: 50, MaxSplitRoutes: 3, MaxGas: 5_000_000},
+			tokenIn:           sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			tokenOutDenom:     BAR,
+
+			expectedError: types.NoSmartRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAR},
+		},
+		"error: gas budget exhausted": {
+			poolCoins:         []sdk.Coins{fooBarPool},
+			smartRouterParams: &types.SmartRouterParams{MaxHops: 3, MaxPools: 50, MaxSplitRoutes: 3, MaxGas: 1},
+			tokenIn:           sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			tokenOutDenom:     BAR,
+
+			expectedError: types.NoSmartRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAR},
+		},
+		"error: no pool with token out": {
+			poolCoins:     []sdk.Coins{fooBazPool},
+			tokenIn:       sdk.NewCoin(FOO, osmomath.NewInt(1000)),
+			tokenOutDenom: BAR,
+
+			expectedError: types.NoSmartRouteFoundError{TokenInDenom: FOO, TokenOutDenom: BAR},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+
+			for _, poolCoins := range tc.poolCoins {
+				s.PrepareBalancerPoolWithCoins(poolCoins...)
+			}
+			if tc.smartRouterParams != nil {
+				poolmanagerKeeper.SetParam(s.Ctx, types.KeySmartRouterParams, *tc.smartRouterParams)
+			}
+
+			routes, tokenOutAmount, err := poolmanagerKeeper.FindSmartRoute(s.Ctx, tc.tokenIn, tc.tokenOutDenom)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRoutes, routes)
+
+			// The estimated amount out is the sum of the estimates of each route.
+			expectedTokenOutAmount := osmomath.ZeroInt()
+			for _, route := range routes {
+				routeOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route.Pools, sdk.NewCoin(tc.tokenIn.Denom, route.TokenInAmount))
+				s.Require().NoError(err)
+				expectedTokenOutAmount = expectedTokenOutAmount.Add(routeOut)
+			}
+			s.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+		})
+	}
+}
+
+// TestFindSmartRoute_SplitBeatsSingleRoute checks that splitting a large trade across parallel pools
+// yields more than swapping it through the best single route.
+func (s *KeeperTestSuite) TestFindSmartRoute_SplitBeatsSingleRoute() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount.QuoRaw(2)), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount.QuoRaw(2)))
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(500_000))
+
+	singleRouteOut, err := poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: BAR}}, tokenIn)
+	s.Require().NoError(err)
+
+	routes, tokenOutAmount, err := poolmanagerKeeper.FindSmartRoute(s.Ctx, tokenIn, BAR)
+	s.Require().NoError(err)
+	s.Require().Len(routes, 2)
+	s.Require().True(tokenOutAmount.GT(singleRouteOut))
+
+	// The deeper pool receives the larger share of the input.
+	s.Require().Equal(uint64(1), routes[0].Pools[0].PoolId)
+	s.Require().True(routes[0].TokenInAmount.GT(routes[1].TokenInAmount))
+	s.Require().Equal(tokenIn.Amount, routes[0].TokenInAmount.Add(routes[1].TokenInAmount))
+}
+
+// TestFindSmartRoute_OutOfGas checks that running out of the transaction's gas while quoting a route
+// panics instead of quoting the route as failed.
+func (s *KeeperTestSuite) TestFindSmartRoute_OutOfGas() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(1000))
+
+	// With a single pool, quoting the only candidate route is the last thing consuming gas.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, _, err := poolmanagerKeeper.FindSmartRoute(cacheCtx, tokenIn, BAR)
+	s.Require().NoError(err)
+	gasUsed := cacheCtx.GasMeter().GasConsumed()
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(gasUsed - 1))
+	s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "smart router quote"}, func() {
+		_, _, _ = poolmanagerKeeper.FindSmartRoute(ctx, tokenIn, BAR)
+	})
+}
+
+// TestPoolDenomIndex checks that pools are indexed by their denoms when created.
+func (s *KeeperTestSuite) TestPoolDenomIndex() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	fooBarPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	fooBazPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAZ, defaultSmartRouterPoolAmount))
+
+	s.Require().Equal([]uint64{fooBarPoolId, fooBazPoolId}, poolmanagerKeeper.GetPoolIdsByDenom(s.Ctx, FOO))
+	s.Require().Equal([]uint64{fooBarPoolId}, poolmanagerKeeper.GetPoolIdsByDenom(s.Ctx, BAR))
+	s.Require().Equal([]uint64{fooBazPoolId}, poolmanagerKeeper.GetPoolIdsByDenom(s.Ctx, BAZ))
+	s.Require().Empty(poolmanagerKeeper.GetPoolIdsByDenom(s.Ctx, UOSMO))
+
+	// Indexing all pools again does not change the index.
+	s.Require().NoError(poolmanagerKeeper.SetAllPoolDenomIndexes(s.Ctx))
+	s.Require().Equal([]uint64{fooBarPoolId, fooBazPoolId}, poolmanagerKeeper.GetPoolIdsByDenom(s.Ctx, FOO))
+}
+
+func (s *KeeperTestSuite) TestSmartRouteExactAmountIn() {
+	tests := map[string]struct {
+		tokenOutMinAmount osmomath.Int
+		expectedError     bool
+	}{
+		"valid swap": {
+			tokenOutMinAmount: osmomath.OneInt(),
+		},
+		"error: min amount out not met": {
+			tokenOutMinAmount: defaultSmartRouterPoolAmount,
+			expectedError:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+
+			sender := s.TestAccs[1]
+			tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(100_000))
+			s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+			expectedRoutes, expectedTokenOutAmount, err := poolmanagerKeeper.FindSmartRoute(s.Ctx, tokenIn, BAR)
+			s.Require().NoError(err)
+
+			tokenOutAmount, routes, err := poolmanagerKeeper.SmartRouteExactAmountIn(s.Ctx, sender, tokenIn, BAR, tc.tokenOutMinAmount)
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(expectedRoutes, routes)
+			s.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			s.Require().Equal(osmomath.ZeroInt(), s.App.BankKeeper.GetBalance(s.Ctx, sender, FOO).Amount)
+			s.Require().Equal(tokenOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, sender, BAR).Amount)
+		})
+	}
+}
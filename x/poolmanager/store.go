@@ -638,3 +638,54 @@ func (k Keeper) getAlloyedDenomFromPoolId(ctx sdk.Context, poolId uint64) (strin
 	}
 	return "", types.NoRegisteredAlloyedPoolError{PoolId: poolId}
 }
+
+//
+// Pool Denom Index
+//
+
+// setPoolDenomIndex indexes the given pool by each of its denoms, so that the pools containing
+// a denom can be found without iterating over all pools.
+func (k Keeper) setPoolDenomIndex(ctx sdk.Context, poolId uint64) error {
+	denoms, err := k.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Set(types.FormatPoolIdByDenomKey(denom, poolId), []byte{})
+	}
+	return nil
+}
+
+// SetAllPoolDenomIndexes indexes all pools by their denoms.
+// Pools are indexed when created, so this is only needed for pools set at genesis or created before the index existed.
+func (k Keeper) SetAllPoolDenomIndexes(ctx sdk.Context) error {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		if err := k.setPoolDenomIndex(ctx, pool.GetId()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iteratePoolIdsByDenom calls cb with the ids of the pools indexed as containing the given denom,
+// in ascending order, until cb returns true.
+// Note that the denoms of a pool are indexed when it is created, and pools whose denoms change afterwards
+// may be missing from the index or be indexed by a denom they no longer contain.
+func (k Keeper) iteratePoolIdsByDenom(ctx sdk.Context, denom string, cb func(poolId uint64) (stop bool)) {
+	prefix := types.FormatPoolIdsByDenomPrefix(denom)
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.BigEndianToUint64(iterator.Key()[len(prefix):])) {
+			return
+		}
+	}
+}
@@ -0,0 +1,621 @@
+package poolmanager
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+// swapIntentPair holds the pending intents of a block trading between two denoms.
+// denom0 is the lexicographically smaller denom. sell0 are the intents selling denom0
+// for denom1 and sell1 the intents selling denom1 for denom0.
+type swapIntentPair struct {
+	denom0 string
+	denom1 string
+	sell0  []types.SwapIntent
+	sell1  []types.SwapIntent
+}
+
+// swapIntentClearing is the outcome of clearing the intents of a pair, computed from estimates.
+// The excess side is the one whose total amount in is worth more than the other side's at
+// market price. Its imbalance is routed through the pools.
+type swapIntentClearing struct {
+	// outs are the amounts of token out received by each intent, in the same order as the intents.
+	outs0 []osmomath.Int
+	outs1 []osmomath.Int
+	// refunds are the amounts of token in returned to each intent.
+	refunds0 []osmomath.Int
+	refunds1 []osmomath.Int
+	// price0 is the amount of denom1 per denom0 sold, and price1 the amount of denom0 per denom1 sold.
+	price0 osmomath.Dec
+	price1 osmomath.Dec
+	// routes, routedIn and routedOut describe the swap of the imbalance through the pools.
+	routes        []types.SwapAmountInSplitRoute
+	routedInDenom string
+	routedIn      osmomath.Int
+	routedOut     osmomath.Int
+	// matched is the amount of the routed in denom matched against the other side.
+	matched osmomath.Int
+	// unrouted0 and unrouted1 are set if the intents selling denom0, respectively denom1, cannot be
+	// priced against the pools or their excess cannot be routed. Such a side is refunded rather than
+	// matched at the ratio of the amounts in, which nothing but the min amounts out would bound.
+	unrouted0 bool
+	unrouted1 bool
+}
+
+// SubmitSwapIntent escrows tokenIn and records a pending swap intent for the current block.
+// The intent is settled at the end of the block by SettleSwapIntents, or in a following block
+// if the settlement limits of the block are reached. If refundIfUnsettled is set, the intent is
+// refunded instead of being kept pending for the following blocks.
+// Returns error if the deadline is before the current block time or if the maximum number of
+// intents per block has been submitted already.
+func (k Keeper) SubmitSwapIntent(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+	deadline time.Time,
+	refundIfUnsettled bool,
+) (uint64, error) {
+	if ctx.BlockTime().After(deadline) {
+		return 0, types.SwapIntentDeadlinePassedError{Deadline: deadline, BlockTime: ctx.BlockTime()}
+	}
+
+	var params types.SwapIntentParams
+	k.GetParam(ctx, types.KeySwapIntentParams, &params)
+	submitted := k.getSwapIntentCount(ctx, ctx.BlockHeight())
+	if submitted >= params.MaxIntentsPerBlock {
+		return 0, types.SwapIntentBlockLimitReachedError{MaxIntentsPerBlock: params.MaxIntentsPerBlock}
+	}
+	k.setSwapIntentCount(ctx, ctx.BlockHeight(), submitted+1)
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.SwapIntentEscrowName, sdk.NewCoins(tokenIn)); err != nil {
+		return 0, err
+	}
+
+	intentId := k.getNextSwapIntentIdAndIncrement(ctx)
+	k.setSwapIntent(ctx, types.SwapIntent{
+		IntentId:          intentId,
+		Sender:            sender.String(),
+		TokenIn:           tokenIn,
+		TokenOutDenom:     tokenOutDenom,
+		TokenOutMinAmount: tokenOutMinAmount,
+		Deadline:          deadline,
+		BlockHeight:       ctx.BlockHeight(),
+		Status:            types.SwapIntentPending,
+		TokenOut:          sdk.NewCoin(tokenOutDenom, osmomath.ZeroInt()),
+		TokenInRefund:     sdk.NewCoin(tokenIn.Denom, osmomath.ZeroInt()),
+		ClearingPrice:     osmomath.ZeroDec(),
+		RefundIfUnsettled: refundIfUnsettled,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapIntentSubmitted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyIntentId, strconv.FormatUint(intentId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+	))
+
+	return intentId, nil
+}
+
+// GetSwapIntents returns the swap intents included at the given block height, ordered by ID.
+func (k Keeper) GetSwapIntents(ctx sdk.Context, blockHeight int64) ([]types.SwapIntent, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.FormatSwapIntentHeightPrefix(blockHeight), parseSwapIntentFromBz)
+}
+
+// SettleSwapIntents settles pending swap intents, one denom pair at a time, and prunes the records
+// of intents past the retention window.
+//
+// The work is bounded by the swap intent params. At most MaxIntentsPerBlock pending intents are
+// read, oldest first, and at most MaxPairsPerBlock of their pairs are cleared, in the order of their
+// oldest intent. The settlement runs with a gas limit of MaxGas, and once it is reached the pairs
+// not cleared yet are left as they are. The intents left over stay pending and are settled in the
+// following blocks, unless their deadline passes first or they opted into being refunded if not
+// settled in the block they were included in, in which case they are refunded.
+//
+// The intents of a pair are cleared at one uniform price. Opposite intents are matched against each
+// other and only the imbalance is routed through the pools with the smart router. Intents whose
+// min amount out cannot be met at the clearing price are refunded and the pair is cleared again
+// without them. If executing the settlement of a pair fails, all of its intents are refunded.
+func (k Keeper) SettleSwapIntents(ctx sdk.Context) {
+	var params types.SwapIntentParams
+	k.GetParam(ctx, types.KeySwapIntentParams, &params)
+
+	intents, err := k.getPendingSwapIntents(ctx, params.MaxIntentsPerBlock)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to get pending swap intents: %w", err).Error())
+		return
+	}
+
+	liveIntents := make([]types.SwapIntent, 0, len(intents))
+	for _, intent := range intents {
+		if ctx.BlockTime().After(intent.Deadline) {
+			k.refundSwapIntent(ctx, intent)
+			continue
+		}
+		liveIntents = append(liveIntents, intent)
+	}
+
+	pairs := groupSwapIntentsByPair(liveIntents)
+	if uint64(len(pairs)) > params.MaxPairsPerBlock {
+		pairs = pairs[:params.MaxPairsPerBlock]
+	}
+	// EndBlock does not meter gas, so the route searches of the settlement are bounded
+	// by a gas limited child context instead.
+	settleCtx := ctx.WithGasMeter(storetypes.NewGasMeter(params.MaxGas))
+	for i, pair := range pairs {
+		if !k.settleSwapIntentPairWithinGas(settleCtx, pair, params.MaxRoundsPerPair) {
+			ctx.Logger().Info(fmt.Sprintf("swap intent settlement ran out of gas, %d pairs are left pending", len(pairs)-i))
+			break
+		}
+	}
+
+	k.refundUnsettledSwapIntents(ctx)
+
+	ctx.KVStore(k.storeKey).Delete(types.FormatSwapIntentCountKey(ctx.BlockHeight()))
+	if pruneHeight := ctx.BlockHeight() - types.SwapIntentRetentionBlocks; pruneHeight > 0 {
+		k.pruneSwapIntents(ctx, pruneHeight)
+	}
+}
+
+// settleSwapIntentPairWithinGas settles the intents of a single pair within the gas limit of ctx.
+// If the gas limit is reached first, the changes made settling the pair are discarded, its intents
+// stay pending and false is returned.
+func (k Keeper) settleSwapIntentPairWithinGas(ctx sdk.Context, pair swapIntentPair, maxRounds uint64) (settled bool) {
+	if ctx.GasMeter().IsOutOfGas() {
+		return false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			settled = false
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	k.settleSwapIntentPair(cacheCtx, pair, maxRounds)
+	write()
+	return true
+}
+
+// settleSwapIntentPair clears and settles the intents of a single pair.
+// If the intents whose min amount out is not met are still being left out after maxRounds clearings,
+// all the remaining intents of the pair are refunded.
+func (k Keeper) settleSwapIntentPair(ctx sdk.Context, pair swapIntentPair, maxRounds uint64) {
+	var clearing swapIntentClearing
+	for round := uint64(1); ; round++ {
+		if len(pair.sell0) == 0 && len(pair.sell1) == 0 {
+			return
+		}
+
+		clearing = k.computeSwapIntentClearing(ctx, pair)
+
+		var unfilled0, unfilled1 []types.SwapIntent
+		if clearing.unrouted0 || clearing.unrouted1 {
+			if clearing.unrouted0 {
+				unfilled0, pair.sell0 = pair.sell0, nil
+			}
+			if clearing.unrouted1 {
+				unfilled1, pair.sell1 = pair.sell1, nil
+			}
+		} else {
+			unfilled0, pair.sell0 = splitUnfilledSwapIntents(pair.sell0, clearing.outs0)
+			unfilled1, pair.sell1 = splitUnfilledSwapIntents(pair.sell1, clearing.outs1)
+		}
+		if len(unfilled0) == 0 && len(unfilled1) == 0 {
+			break
+		}
+		for _, intent := range append(unfilled0, unfilled1...) {
+			k.refundSwapIntent(ctx, intent)
+		}
+		if round >= maxRounds {
+			ctx.Logger().Info(fmt.Sprintf("swap intents of pair %s/%s did not clear within %d rounds, refunding them", pair.denom0, pair.denom1, maxRounds))
+			for _, intent := range append(pair.sell0, pair.sell1...) {
+				k.refundSwapIntent(ctx, intent)
+			}
+			return
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.executeSwapIntentClearing(cacheCtx, pair, clearing); err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to settle swap intents of pair %s/%s: %w", pair.denom0, pair.denom1, err).Error())
+		for _, intent := range append(pair.sell0, pair.sell1...) {
+			k.refundSwapIntent(ctx, intent)
+		}
+		return
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapIntentPairClear,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyDenom0, pair.denom0),
+		sdk.NewAttribute(types.AttributeKeyDenom1, pair.denom1),
+		sdk.NewAttribute(types.AttributeKeyClearingPrice, clearing.price0.String()),
+		sdk.NewAttribute(types.AttributeKeyMatchedAmount, sdk.NewCoin(clearing.routedInDenom, clearing.matched).String()),
+		sdk.NewAttribute(types.AttributeKeyRoutedAmount, sdk.NewCoin(clearing.routedInDenom, clearing.routedIn).String()),
+	))
+}
+
+// computeSwapIntentClearing computes how the intents of a pair clear, using estimates of the smart router.
+//
+// Let X be the excess side, selling inX of denom x, and Y the other side, selling inY of denom y.
+// X is the excess side if routing all of inX yields more than inY. Then the amount of x worth inY at
+// the average price of routing inX, M = inY * inX / quote(inX), is matched against Y, and the rest,
+// inX - M, is routed for routedOut of y. X receives T = inY + routedOut of y in total.
+// Since routing less than inX yields a better average price, this leaves some x unneeded to pay Y at
+// the uniform price. That remainder, s = (M * T - inY * inX) / routedOut, is refunded to X so that
+// X sells inX - s for T, and Y sells inY for M - s, at exactly reciprocal prices.
+//
+// If neither side is in excess, the intents are fully matched against each other at the ratio of
+// their amounts in, which is at least as good for both sides as routing.
+//
+// A side with no route through the pools cannot be priced, and if the imbalance of the excess side
+// cannot be routed, the excess side cannot be cleared at the market price. In both cases the side
+// is marked as unrouted rather than matched at the ratio of the amounts in.
+func (k Keeper) computeSwapIntentClearing(ctx sdk.Context, pair swapIntentPair) swapIntentClearing {
+	in0, in1 := sumSwapIntentsIn(pair.sell0), sumSwapIntentsIn(pair.sell1)
+
+	quote0 := k.estimateSwapIntentRoute(ctx, pair.denom0, in0, pair.denom1)
+	quote1 := k.estimateSwapIntentRoute(ctx, pair.denom1, in1, pair.denom0)
+	unpriced0 := in0.IsPositive() && !quote0.IsPositive()
+	unpriced1 := in1.IsPositive() && !quote1.IsPositive()
+	if unpriced0 || unpriced1 {
+		return swapIntentClearing{unrouted0: unpriced0, unrouted1: unpriced1}
+	}
+
+	excess0, inX, inY, denomX, denomY, quoteX := false, osmomath.ZeroInt(), osmomath.ZeroInt(), "", "", osmomath.ZeroInt()
+	if quote0.GT(in1) {
+		excess0, inX, inY, denomX, denomY, quoteX = true, in0, in1, pair.denom0, pair.denom1, quote0
+	} else if quote1.GT(in0) {
+		inX, inY, denomX, denomY, quoteX = in1, in0, pair.denom1, pair.denom0, quote1
+	}
+
+	var (
+		routes    []types.SwapAmountInSplitRoute
+		routedOut osmomath.Int
+		err       error
+	)
+	matched := osmomath.ZeroInt()
+	routedIn := osmomath.ZeroInt()
+	if denomX != "" {
+		matched = inY.Mul(inX).Quo(quoteX)
+		routedIn = inX.Sub(matched)
+		if routedIn.IsPositive() {
+			routes, routedOut, err = k.FindSmartRoute(ctx, sdk.NewCoin(denomX, routedIn), denomY)
+		}
+	}
+
+	if err != nil {
+		return swapIntentClearing{unrouted0: excess0, unrouted1: !excess0}
+	}
+
+	// Neither side is in excess: match the intents against each other.
+	if denomX == "" || !routedIn.IsPositive() {
+		return swapIntentClearing{
+			outs0:         distributeProRata(in1, pair.sell0, in0),
+			outs1:         distributeProRata(in0, pair.sell1, in1),
+			refunds0:      distributeProRata(osmomath.ZeroInt(), pair.sell0, in0),
+			refunds1:      distributeProRata(osmomath.ZeroInt(), pair.sell1, in1),
+			price0:        priceOrZero(in1, in0),
+			price1:        priceOrZero(in0, in1),
+			routedInDenom: pair.denom0,
+			routedIn:      osmomath.ZeroInt(),
+			routedOut:     osmomath.ZeroInt(),
+			matched:       in0,
+		}
+	}
+
+	total := inY.Add(routedOut)
+	refund := matched.Mul(total).Sub(inY.Mul(inX)).Quo(routedOut)
+	refund = osmomath.MaxInt(osmomath.ZeroInt(), osmomath.MinInt(refund, matched))
+	soldX := inX.Sub(refund)
+	receivedY := matched.Sub(refund)
+
+	clearing := swapIntentClearing{
+		routes:        routes,
+		routedInDenom: denomX,
+		routedIn:      routedIn,
+		routedOut:     routedOut,
+		matched:       matched,
+	}
+	sellX, sellY := pair.sell0, pair.sell1
+	if !excess0 {
+		sellX, sellY = pair.sell1, pair.sell0
+	}
+	outsX := distributeProRata(total, sellX, inX)
+	refundsX := distributeProRata(refund, sellX, inX)
+	outsY := distributeProRata(receivedY, sellY, inY)
+	refundsY := distributeProRata(osmomath.ZeroInt(), sellY, inY)
+	priceX, priceY := priceOrZero(total, soldX), priceOrZero(receivedY, inY)
+
+	if excess0 {
+		clearing.outs0, clearing.refunds0, clearing.price0 = outsX, refundsX, priceX
+		clearing.outs1, clearing.refunds1, clearing.price1 = outsY, refundsY, priceY
+	} else {
+		clearing.outs1, clearing.refunds1, clearing.price1 = outsX, refundsX, priceX
+		clearing.outs0, clearing.refunds0, clearing.price0 = outsY, refundsY, priceY
+	}
+	return clearing
+}
+
+// executeSwapIntentClearing routes the imbalance of the pair and pays out every intent as computed
+// by the clearing.
+func (k Keeper) executeSwapIntentClearing(ctx sdk.Context, pair swapIntentPair, clearing swapIntentClearing) error {
+	escrowAddress := k.accountKeeper.GetModuleAccount(ctx, types.SwapIntentEscrowName).GetAddress()
+
+	if clearing.routedIn.IsPositive() {
+		// The clearing was computed from the estimate of this exact split,
+		// so anything less than the estimate means the state diverged.
+		_, err := k.SplitRouteExactAmountIn(ctx, escrowAddress, clearing.routes, clearing.routedInDenom, clearing.routedOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, intent := range pair.sell0 {
+		if err := k.settleSwapIntent(ctx, intent, clearing.outs0[i], clearing.refunds0[i], clearing.price0); err != nil {
+			return err
+		}
+	}
+	for i, intent := range pair.sell1 {
+		if err := k.settleSwapIntent(ctx, intent, clearing.outs1[i], clearing.refunds1[i], clearing.price1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settleSwapIntent pays out a settled intent from the escrow and records its outcome.
+func (k Keeper) settleSwapIntent(ctx sdk.Context, intent types.SwapIntent, tokenOutAmount, refundAmount osmomath.Int, clearingPrice osmomath.Dec) error {
+	sender, err := sdk.AccAddressFromBech32(intent.Sender)
+	if err != nil {
+		return err
+	}
+
+	tokenOut := sdk.NewCoin(intent.TokenOutDenom, tokenOutAmount)
+	refund := sdk.NewCoin(intent.TokenIn.Denom, refundAmount)
+	payout := sdk.NewCoins(tokenOut, refund)
+	if !payout.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.SwapIntentEscrowName, sender, payout); err != nil {
+			return err
+		}
+	}
+
+	intent.Status = types.SwapIntentSettled
+	intent.TokenOut = tokenOut
+	intent.TokenInRefund = refund
+	intent.ClearingPrice = clearingPrice
+	k.setSwapIntent(ctx, intent)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapIntentSettled,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, intent.Sender),
+		sdk.NewAttribute(types.AttributeKeyIntentId, strconv.FormatUint(intent.IntentId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+		sdk.NewAttribute(types.AttributeKeyClearingPrice, clearingPrice.String()),
+	))
+	return nil
+}
+
+// refundUnsettledSwapIntents refunds the intents included in the current block that are still pending
+// and opted into being refunded rather than kept pending for the following blocks.
+func (k Keeper) refundUnsettledSwapIntents(ctx sdk.Context) {
+	intents, err := k.GetSwapIntents(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to get swap intents: %w", err).Error())
+		return
+	}
+	for _, intent := range intents {
+		if intent.Status == types.SwapIntentPending && intent.RefundIfUnsettled {
+			k.refundSwapIntent(ctx, intent)
+		}
+	}
+}
+
+// refundSwapIntent returns the escrowed token in of an intent to its sender and records it as refunded.
+func (k Keeper) refundSwapIntent(ctx sdk.Context, intent types.SwapIntent) {
+	sender, err := sdk.AccAddressFromBech32(intent.Sender)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to refund swap intent %d: %w", intent.IntentId, err).Error())
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.SwapIntentEscrowName, sender, sdk.NewCoins(intent.TokenIn)); err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to refund swap intent %d: %w", intent.IntentId, err).Error())
+		return
+	}
+
+	intent.Status = types.SwapIntentRefunded
+	intent.TokenInRefund = intent.TokenIn
+	k.setSwapIntent(ctx, intent)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapIntentRefunded,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, intent.Sender),
+		sdk.NewAttribute(types.AttributeKeyIntentId, strconv.FormatUint(intent.IntentId, 10)),
+	))
+}
+
+// estimateSwapIntentRoute returns the estimated amount out of routing amountIn through the smart router,
+// or zero if there is nothing to route or no route.
+func (k Keeper) estimateSwapIntentRoute(ctx sdk.Context, denomIn string, amountIn osmomath.Int, denomOut string) osmomath.Int {
+	if !amountIn.IsPositive() {
+		return osmomath.ZeroInt()
+	}
+	_, amountOut, err := k.FindSmartRoute(ctx, sdk.NewCoin(denomIn, amountIn), denomOut)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+	return amountOut
+}
+
+func (k Keeper) getNextSwapIntentIdAndIncrement(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextIntentId := gogotypes.UInt64Value{Value: 1}
+	if _, err := osmoutils.Get(store, types.KeyNextSwapIntentId, &nextIntentId); err != nil {
+		panic(err)
+	}
+	osmoutils.MustSet(store, types.KeyNextSwapIntentId, &gogotypes.UInt64Value{Value: nextIntentId.Value + 1})
+	return nextIntentId.Value
+}
+
+// setSwapIntent stores the intent and keeps the index of pending intents up to date with its status.
+func (k Keeper) setSwapIntent(ctx sdk.Context, intent types.SwapIntent) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatSwapIntentKey(intent.BlockHeight, intent.IntentId), &intent)
+	if intent.Status == types.SwapIntentPending {
+		osmoutils.MustSet(store, types.FormatPendingSwapIntentKey(intent.IntentId), &gogotypes.Int64Value{Value: intent.BlockHeight})
+	} else {
+		store.Delete(types.FormatPendingSwapIntentKey(intent.IntentId))
+	}
+}
+
+// getPendingSwapIntents returns up to limit pending swap intents, oldest first.
+func (k Keeper) getPendingSwapIntents(ctx sdk.Context, limit uint64) ([]types.SwapIntent, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingSwapIntentPrefix)
+	defer iterator.Close()
+
+	intents := []types.SwapIntent{}
+	for ; iterator.Valid() && uint64(len(intents)) < limit; iterator.Next() {
+		var blockHeight gogotypes.Int64Value
+		if err := blockHeight.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		intentId := sdk.BigEndianToUint64(iterator.Key()[len(types.PendingSwapIntentPrefix):])
+
+		var intent types.SwapIntent
+		found, err := osmoutils.Get(store, types.FormatSwapIntentKey(blockHeight.Value, intentId), &intent)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("pending swap intent %d not found at height %d", intentId, blockHeight.Value)
+		}
+		intents = append(intents, intent)
+	}
+	return intents, nil
+}
+
+// pruneSwapIntents deletes the records of the intents included at the given block height,
+// except for the ones still pending.
+func (k Keeper) pruneSwapIntents(ctx sdk.Context, blockHeight int64) {
+	intents, err := k.GetSwapIntents(ctx, blockHeight)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("unable to prune swap intents: %w", err).Error())
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, intent := range intents {
+		if intent.Status != types.SwapIntentPending {
+			store.Delete(types.FormatSwapIntentKey(intent.BlockHeight, intent.IntentId))
+		}
+	}
+}
+
+func (k Keeper) getSwapIntentCount(ctx sdk.Context, blockHeight int64) uint64 {
+	count := gogotypes.UInt64Value{}
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatSwapIntentCountKey(blockHeight), &count); err != nil {
+		panic(err)
+	}
+	return count.Value
+}
+
+func (k Keeper) setSwapIntentCount(ctx sdk.Context, blockHeight int64, count uint64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatSwapIntentCountKey(blockHeight), &gogotypes.UInt64Value{Value: count})
+}
+
+func parseSwapIntentFromBz(bz []byte) (types.SwapIntent, error) {
+	var intent types.SwapIntent
+	err := intent.Unmarshal(bz)
+	return intent, err
+}
+
+// groupSwapIntentsByPair groups the pending intents by denom pair, in the order the pairs first appear
+// in intents.
+func groupSwapIntentsByPair(intents []types.SwapIntent) []swapIntentPair {
+	pairs := make(map[[2]string]int)
+	groupedPairs := []swapIntentPair{}
+	for _, intent := range intents {
+		if intent.Status != types.SwapIntentPending {
+			continue
+		}
+		denom0, denom1 := intent.TokenIn.Denom, intent.TokenOutDenom
+		if denom1 < denom0 {
+			denom0, denom1 = denom1, denom0
+		}
+		i, ok := pairs[[2]string{denom0, denom1}]
+		if !ok {
+			i = len(groupedPairs)
+			pairs[[2]string{denom0, denom1}] = i
+			groupedPairs = append(groupedPairs, swapIntentPair{denom0: denom0, denom1: denom1})
+		}
+		if intent.TokenIn.Denom == denom0 {
+			groupedPairs[i].sell0 = append(groupedPairs[i].sell0, intent)
+		} else {
+			groupedPairs[i].sell1 = append(groupedPairs[i].sell1, intent)
+		}
+	}
+	return groupedPairs
+}
+
+// splitUnfilledSwapIntents splits intents into the ones whose amount out does not meet their
+// min amount out and the ones that do.
+func splitUnfilledSwapIntents(intents []types.SwapIntent, outs []osmomath.Int) (unfilled, filled []types.SwapIntent) {
+	for i, intent := range intents {
+		if outs[i].LT(intent.TokenOutMinAmount) {
+			unfilled = append(unfilled, intent)
+		} else {
+			filled = append(filled, intent)
+		}
+	}
+	return unfilled, filled
+}
+
+func sumSwapIntentsIn(intents []types.SwapIntent) osmomath.Int {
+	total := osmomath.ZeroInt()
+	for _, intent := range intents {
+		total = total.Add(intent.TokenIn.Amount)
+	}
+	return total
+}
+
+// distributeProRata splits total across the intents in proportion to their amount in, rounding down.
+// The rounding remainder goes to the last intent so that exactly total is distributed.
+func distributeProRata(total osmomath.Int, intents []types.SwapIntent, totalIn osmomath.Int) []osmomath.Int {
+	shares := make([]osmomath.Int, len(intents))
+	distributed := osmomath.ZeroInt()
+	for i, intent := range intents {
+		if i == len(intents)-1 {
+			shares[i] = total.Sub(distributed)
+			break
+		}
+		shares[i] = total.Mul(intent.TokenIn.Amount).Quo(totalIn)
+		distributed = distributed.Add(shares[i])
+	}
+	return shares
+}
+
+func priceOrZero(amountOut, amountIn osmomath.Int) osmomath.Dec {
+	if !amountIn.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+	return amountOut.ToLegacyDec().Quo(amountIn.ToLegacyDec())
+}
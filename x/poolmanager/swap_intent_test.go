@@ -0,0 +1,388 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+)
+
+type swapIntentInput struct {
+	tokenIn           sdk.Coin
+	tokenOutDenom     string
+	tokenOutMinAmount osmomath.Int
+	refundIfUnsettled bool
+}
+
+// submitSwapIntents funds a new random account for each intent and submits it, returning the senders.
+func (s *KeeperTestSuite) submitSwapIntents(intents []swapIntentInput) []sdk.AccAddress {
+	senders := apptesting.CreateRandomAccounts(len(intents))
+	for i, intent := range intents {
+		s.FundAcc(senders[i], sdk.NewCoins(intent.tokenIn))
+		_, err := s.App.PoolManagerKeeper.SubmitSwapIntent(s.Ctx, senders[i], intent.tokenIn, intent.tokenOutDenom, intent.tokenOutMinAmount, s.Ctx.BlockTime().Add(time.Minute), intent.refundIfUnsettled)
+		s.Require().NoError(err)
+	}
+	return senders
+}
+
+func (s *KeeperTestSuite) TestSubmitSwapIntent() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	sender := apptesting.CreateRandomAccounts(1)[0]
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(1000))
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	// Deadline before the block time.
+	_, err := poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime().Add(-time.Second), false)
+	s.Require().ErrorIs(err, types.SwapIntentDeadlinePassedError{Deadline: s.Ctx.BlockTime().Add(-time.Second), BlockTime: s.Ctx.BlockTime()})
+
+	intentId, err := poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime(), false)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), intentId)
+
+	// The token in is escrowed.
+	escrowAddress := s.App.AccountKeeper.GetModuleAddress(types.SwapIntentEscrowName)
+	s.Require().Equal(tokenIn, s.App.BankKeeper.GetBalance(s.Ctx, escrowAddress, FOO))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sender, FOO).IsZero())
+
+	intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, s.Ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().Equal(types.SwapIntentPending, intents[0].Status)
+	s.Require().Equal(tokenIn, intents[0].TokenIn)
+	s.Require().Equal(sender.String(), intents[0].Sender)
+
+	// IDs keep increasing.
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+	intentId, err = poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime(), false)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), intentId)
+}
+
+func (s *KeeperTestSuite) TestSettleSwapIntents() {
+	tests := map[string]struct {
+		poolCoins []sdk.Coins
+		intents   []swapIntentInput
+
+		// expectedTokenOut is nil when the amounts out depend on the pool estimates,
+		// in which case they are checked against routing each intent alone.
+		expectedStatuses []types.SwapIntentStatus
+		expectedTokenOut []osmomath.Int
+	}{
+		"opposite intents are matched at the ratio of their amounts in": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount.MulRaw(2)))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(2000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled},
+			expectedTokenOut: []osmomath.Int{osmomath.NewInt(2000), osmomath.NewInt(1000)},
+		},
+		"intents on the same side share the amount out pro rata": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount.MulRaw(2)))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(3000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(8000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+			expectedTokenOut: []osmomath.Int{osmomath.NewInt(2000), osmomath.NewInt(6000), osmomath.NewInt(4000)},
+		},
+		"opposite intents without a route are refunded rather than matched at the ratio of their amounts in": {
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentRefunded, types.SwapIntentRefunded},
+			expectedTokenOut: []osmomath.Int{osmomath.ZeroInt(), osmomath.ZeroInt()},
+		},
+		"one sided intent without a route is refunded": {
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentRefunded},
+			expectedTokenOut: []osmomath.Int{osmomath.ZeroInt()},
+		},
+		"intent below its min amount out is refunded, the others are matched": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.NewInt(2000)},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentRefunded, types.SwapIntentSettled},
+			expectedTokenOut: []osmomath.Int{osmomath.NewInt(1000), osmomath.ZeroInt(), osmomath.NewInt(1000)},
+		},
+		"one sided intent is routed through the pools": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(10_000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentSettled},
+		},
+		"one sided intent below the routed amount out is refunded": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(10_000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.NewInt(10_000)},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentRefunded},
+			expectedTokenOut: []osmomath.Int{osmomath.ZeroInt()},
+		},
+		"excess side is partially routed": {
+			poolCoins: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))},
+			intents: []swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(100_000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(10_000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+			},
+			expectedStatuses: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+
+			for _, poolCoins := range tc.poolCoins {
+				s.PrepareBalancerPoolWithCoins(poolCoins...)
+			}
+
+			// Quote each intent as if it was routed alone, before any intent is settled.
+			routedQuotes := make([]osmomath.Int, len(tc.intents))
+			for i, intent := range tc.intents {
+				routedQuotes[i] = osmomath.ZeroInt()
+				if _, quote, err := poolmanagerKeeper.FindSmartRoute(s.Ctx, intent.tokenIn, intent.tokenOutDenom); err == nil {
+					routedQuotes[i] = quote
+				}
+			}
+
+			senders := s.submitSwapIntents(tc.intents)
+
+			poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+
+			intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, s.Ctx.BlockHeight())
+			s.Require().NoError(err)
+			s.Require().Len(intents, len(tc.intents))
+
+			for i, intent := range intents {
+				s.Require().Equal(tc.expectedStatuses[i], intent.Status)
+
+				// The sender received exactly what the record says.
+				s.Require().Equal(intent.TokenOut.Amount, s.App.BankKeeper.GetBalance(s.Ctx, senders[i], intent.TokenOutDenom).Amount)
+				s.Require().Equal(intent.TokenInRefund.Amount, s.App.BankKeeper.GetBalance(s.Ctx, senders[i], intent.TokenIn.Denom).Amount)
+
+				if intent.Status == types.SwapIntentRefunded {
+					s.Require().Equal(intent.TokenIn, intent.TokenInRefund)
+					continue
+				}
+				s.Require().True(intent.TokenOut.Amount.GTE(intent.TokenOutMinAmount))
+
+				if tc.expectedTokenOut != nil {
+					s.Require().Equal(tc.expectedTokenOut[i], intent.TokenOut.Amount)
+				} else {
+					// Settling in the batch is at least as good as routing the intent alone.
+					s.Require().True(intent.TokenOut.Amount.GTE(routedQuotes[i]))
+				}
+			}
+
+			// Everything escrowed was paid out.
+			escrowAddress := s.App.AccountKeeper.GetModuleAddress(types.SwapIntentEscrowName)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress).IsZero())
+		})
+	}
+}
+
+// TestSettleSwapIntents_UniformPrice checks that when the excess side is partially routed,
+// both sides of the pair trade at reciprocal prices.
+func (s *KeeperTestSuite) TestSettleSwapIntents_UniformPrice() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	s.submitSwapIntents([]swapIntentInput{
+		{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(100_000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+		{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(10_000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+	})
+
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+
+	intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, s.Ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Len(intents, 2)
+
+	// Part of the excess side is refunded so that the price it gets matches the other side's.
+	s.Require().True(intents[0].TokenInRefund.IsPositive())
+	s.Require().True(intents[1].TokenInRefund.IsZero())
+
+	priceProduct := intents[0].ClearingPrice.Mul(intents[1].ClearingPrice)
+	s.Require().True(priceProduct.Sub(osmomath.OneDec()).Abs().LT(osmomath.MustNewDecFromStr("0.001")), "price product %s", priceProduct)
+}
+
+func (s *KeeperTestSuite) TestSettleSwapIntents_Pruning() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+
+	s.submitSwapIntents([]swapIntentInput{
+		{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+		{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+	})
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+	submittedHeight := s.Ctx.BlockHeight()
+
+	// The records are kept within the retention window.
+	s.Ctx = s.Ctx.WithBlockHeight(submittedHeight + types.SwapIntentRetentionBlocks - 1)
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+	intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, submittedHeight)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 2)
+
+	s.Ctx = s.Ctx.WithBlockHeight(submittedHeight + types.SwapIntentRetentionBlocks)
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+	intents, err = poolmanagerKeeper.GetSwapIntents(s.Ctx, submittedHeight)
+	s.Require().NoError(err)
+	s.Require().Empty(intents)
+}
+
+func (s *KeeperTestSuite) TestSubmitSwapIntent_BlockLimit() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	poolmanagerKeeper.SetParam(s.Ctx, types.KeySwapIntentParams, types.SwapIntentParams{MaxIntentsPerBlock: 2, MaxPairsPerBlock: 1, MaxRoundsPerPair: 1, MaxGas: 100_000_000})
+
+	tokenIn := sdk.NewCoin(FOO, osmomath.NewInt(1000))
+	sender := s.TestAccs[0]
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(FOO, osmomath.NewInt(4000))))
+
+	for i := 0; i < 2; i++ {
+		_, err := poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime().Add(time.Minute), false)
+		s.Require().NoError(err)
+	}
+	_, err := poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime().Add(time.Minute), false)
+	s.Require().ErrorIs(err, types.SwapIntentBlockLimitReachedError{MaxIntentsPerBlock: 2})
+
+	// The limit applies per block.
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	_, err = poolmanagerKeeper.SubmitSwapIntent(s.Ctx, sender, tokenIn, BAR, osmomath.OneInt(), s.Ctx.BlockTime().Add(time.Minute), false)
+	s.Require().NoError(err)
+}
+
+// TestSettleSwapIntents_CarryOver checks that the intents left over by the settlement limits of a block
+// stay pending and are settled in the following block, or refunded once their deadline passes.
+func (s *KeeperTestSuite) TestSettleSwapIntents_CarryOver() {
+	tests := map[string]struct {
+		params            types.SwapIntentParams
+		expectedFirstRun  []types.SwapIntentStatus
+		expectedSecondRun []types.SwapIntentStatus
+		deadlinePassed    bool
+		refundIfUnsettled bool
+	}{
+		"all intents are settled within the limits": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 2, MaxRoundsPerPair: 1, MaxGas: 100_000_000},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+		},
+		"pairs beyond the limit are settled in the next block": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 1, MaxRoundsPerPair: 1, MaxGas: 100_000_000},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentPending, types.SwapIntentPending},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+		},
+		"intents beyond the limit are settled in the next block": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 2, MaxPairsPerBlock: 2, MaxRoundsPerPair: 1, MaxGas: 100_000_000},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentPending, types.SwapIntentPending},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+		},
+		"left over intents past their deadline are refunded": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 1, MaxRoundsPerPair: 1, MaxGas: 100_000_000},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentPending, types.SwapIntentPending},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentRefunded, types.SwapIntentRefunded},
+			deadlinePassed:    true,
+		},
+		"left over intents opted into refunds are refunded in the block they were included in": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 1, MaxRoundsPerPair: 1, MaxGas: 100_000_000},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentRefunded, types.SwapIntentRefunded},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentRefunded, types.SwapIntentRefunded},
+			refundIfUnsettled: true,
+		},
+		"pairs beyond the gas budget are settled in the next block": {
+			params:            types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 2, MaxRoundsPerPair: 1, MaxGas: 1},
+			expectedFirstRun:  []types.SwapIntentStatus{types.SwapIntentPending, types.SwapIntentPending, types.SwapIntentPending, types.SwapIntentPending},
+			expectedSecondRun: []types.SwapIntentStatus{types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled, types.SwapIntentSettled},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolmanagerKeeper := s.App.PoolManagerKeeper
+			poolmanagerKeeper.SetParam(s.Ctx, types.KeySwapIntentParams, types.SwapIntentParams{MaxIntentsPerBlock: 4, MaxPairsPerBlock: 2, MaxRoundsPerPair: 1, MaxGas: 100_000_000})
+
+			// Two pairs of opposite intents, matched against each other at the pool prices.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(BAZ, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+			senders := s.submitSwapIntents([]swapIntentInput{
+				{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+				{tokenIn: sdk.NewCoin(BAZ, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt(), refundIfUnsettled: tc.refundIfUnsettled},
+				{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1000)), tokenOutDenom: BAZ, tokenOutMinAmount: osmomath.OneInt(), refundIfUnsettled: tc.refundIfUnsettled},
+			})
+			submittedHeight := s.Ctx.BlockHeight()
+			poolmanagerKeeper.SetParam(s.Ctx, types.KeySwapIntentParams, tc.params)
+
+			assertStatuses := func(expectedStatuses []types.SwapIntentStatus) {
+				intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, submittedHeight)
+				s.Require().NoError(err)
+				s.Require().Len(intents, len(expectedStatuses))
+				for i, intent := range intents {
+					s.Require().Equal(expectedStatuses[i], intent.Status, "intent %d", i)
+				}
+			}
+
+			poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+			assertStatuses(tc.expectedFirstRun)
+
+			poolmanagerKeeper.SetParam(s.Ctx, types.KeySwapIntentParams, testSwapIntentParams)
+			s.Ctx = s.Ctx.WithBlockHeight(submittedHeight + 1)
+			if tc.deadlinePassed {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+			}
+			poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+			assertStatuses(tc.expectedSecondRun)
+
+			// Every sender got back either the token out or the token in.
+			for _, sender := range senders {
+				s.Require().Equal(osmomath.NewInt(1000), s.App.BankKeeper.GetAllBalances(s.Ctx, sender)[0].Amount)
+			}
+			escrowAddress := s.App.AccountKeeper.GetModuleAddress(types.SwapIntentEscrowName)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSettleSwapIntents_MaxRoundsPerPair() {
+	s.SetupTest()
+	poolmanagerKeeper := s.App.PoolManagerKeeper
+	poolmanagerKeeper.SetParam(s.Ctx, types.KeySwapIntentParams, types.SwapIntentParams{MaxIntentsPerBlock: 10, MaxPairsPerBlock: 1, MaxRoundsPerPair: 1, MaxGas: 100_000_000})
+
+	// Within one round, the intent below its min amount out is refunded,
+	// and the others are refunded rather than cleared again without it.
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, defaultSmartRouterPoolAmount), sdk.NewCoin(BAR, defaultSmartRouterPoolAmount))
+	s.submitSwapIntents([]swapIntentInput{
+		{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.OneInt()},
+		{tokenIn: sdk.NewCoin(FOO, osmomath.NewInt(1000)), tokenOutDenom: BAR, tokenOutMinAmount: osmomath.NewInt(2000)},
+		{tokenIn: sdk.NewCoin(BAR, osmomath.NewInt(1000)), tokenOutDenom: FOO, tokenOutMinAmount: osmomath.OneInt()},
+	})
+	poolmanagerKeeper.SettleSwapIntents(s.Ctx)
+
+	intents, err := poolmanagerKeeper.GetSwapIntents(s.Ctx, s.Ctx.BlockHeight())
+	s.Require().NoError(err)
+	s.Require().Len(intents, 3)
+	for _, intent := range intents {
+		s.Require().Equal(types.SwapIntentRefunded, intent.Status)
+	}
+	escrowAddress := s.App.AccountKeeper.GetModuleAddress(types.SwapIntentEscrowName)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, escrowAddress).IsZero())
+}
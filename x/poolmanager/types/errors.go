@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 )
@@ -204,3 +205,29 @@ type InvalidTakerFeeSharePercentageError struct {
 func (e InvalidTakerFeeSharePercentageError) Error() string {
 	return fmt.Sprintf("invalid taker fee share percentage: %s, must be between 0 and 1", e.Percentage)
 }
+
+type NoSmartRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e NoSmartRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from %s to %s", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type SwapIntentDeadlinePassedError struct {
+	Deadline  time.Time
+	BlockTime time.Time
+}
+
+func (e SwapIntentDeadlinePassedError) Error() string {
+	return fmt.Sprintf("swap intent deadline (%s) is before the current block time (%s)", e.Deadline, e.BlockTime)
+}
+
+type SwapIntentBlockLimitReachedError struct {
+	MaxIntentsPerBlock uint64
+}
+
+func (e SwapIntentBlockLimitReachedError) Error() string {
+	return fmt.Sprintf("the maximum number of swap intents per block (%d) has been reached", e.MaxIntentsPerBlock)
+}
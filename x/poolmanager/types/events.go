@@ -13,4 +13,13 @@ const (
 	AttributeKeyTakerFeeShareDenom       = "taker_fee_share_denom"
 	AttributeKeyTakerFeeShareSkimPercent = "taker_fee_share_skim_percent"
 	AttributeKeyTakerFeeShareSkimAddress = "taker_fee_share_skim_address"
+
+	TypeEvtSwapIntentSubmitted = "swap_intent_submitted"
+	TypeEvtSwapIntentSettled   = "swap_intent_settled"
+	TypeEvtSwapIntentRefunded  = "swap_intent_refunded"
+	TypeEvtSwapIntentPairClear = "swap_intent_pair_cleared"
+	AttributeKeyIntentId       = "intent_id"
+	AttributeKeyClearingPrice  = "clearing_price"
+	AttributeKeyMatchedAmount  = "matched_amount"
+	AttributeKeyRoutedAmount   = "routed_amount"
 )
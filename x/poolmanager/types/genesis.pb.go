@@ -46,6 +46,12 @@ type Params struct {
 	// 819. Any asset can now be used as a quote asset in concentrated liquidity
 	// pools.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms",deprecated:"true"` // Deprecated: Do not use.
+	// smart_router_params bounds the on-chain route search used by
+	// MsgSmartRouteSwapExactAmountIn.
+	SmartRouterParams SmartRouterParams `protobuf:"bytes,4,opt,name=smart_router_params,json=smartRouterParams,proto3" json:"smart_router_params" yaml:"smart_router_params"`
+	// swap_intent_params bounds the work done settling swap intents at the end
+	// of each block.
+	SwapIntentParams SwapIntentParams `protobuf:"bytes,5,opt,name=swap_intent_params,json=swapIntentParams,proto3" json:"swap_intent_params" yaml:"swap_intent_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -103,6 +109,178 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetSmartRouterParams() SmartRouterParams {
+	if m != nil {
+		return m.SmartRouterParams
+	}
+	return SmartRouterParams{}
+}
+
+func (m *Params) GetSwapIntentParams() SwapIntentParams {
+	if m != nil {
+		return m.SwapIntentParams
+	}
+	return SwapIntentParams{}
+}
+
+// SmartRouterParams bounds the work done by the on-chain smart order router
+// when searching for and quoting routes.
+type SmartRouterParams struct {
+	// max_hops is the maximum number of pools in a single route.
+	MaxHops uint64 `protobuf:"varint,1,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_pools is the maximum number of distinct pools considered while
+	// searching for routes.
+	MaxPools uint64 `protobuf:"varint,2,opt,name=max_pools,json=maxPools,proto3" json:"max_pools,omitempty" yaml:"max_pools"`
+	// max_split_routes is the maximum number of routes the swap is split across.
+	MaxSplitRoutes uint64 `protobuf:"varint,3,opt,name=max_split_routes,json=maxSplitRoutes,proto3" json:"max_split_routes,omitempty" yaml:"max_split_routes"`
+	// max_gas is the gas budget for the route search and quoting. Once it is
+	// spent, the best split found so far is used.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *SmartRouterParams) Reset()         { *m = SmartRouterParams{} }
+func (m *SmartRouterParams) String() string { return proto.CompactTextString(m) }
+func (*SmartRouterParams) ProtoMessage()    {}
+func (*SmartRouterParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+}
+func (m *SmartRouterParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartRouterParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SmartRouterParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SmartRouterParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartRouterParams.Merge(m, src)
+}
+func (m *SmartRouterParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartRouterParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartRouterParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartRouterParams proto.InternalMessageInfo
+
+func (m *SmartRouterParams) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *SmartRouterParams) GetMaxPools() uint64 {
+	if m != nil {
+		return m.MaxPools
+	}
+	return 0
+}
+
+func (m *SmartRouterParams) GetMaxSplitRoutes() uint64 {
+	if m != nil {
+		return m.MaxSplitRoutes
+	}
+	return 0
+}
+
+func (m *SmartRouterParams) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// SwapIntentParams bounds the work done settling swap intents in the
+// poolmanager EndBlock. Pending intents left over are settled in the
+// following blocks.
+type SwapIntentParams struct {
+	// max_intents_per_block is the maximum number of intents submitted in a
+	// block, as well as the maximum number of pending intents settled in a
+	// block.
+	MaxIntentsPerBlock uint64 `protobuf:"varint,1,opt,name=max_intents_per_block,json=maxIntentsPerBlock,proto3" json:"max_intents_per_block,omitempty" yaml:"max_intents_per_block"`
+	// max_pairs_per_block is the maximum number of denom pairs cleared in a
+	// block.
+	MaxPairsPerBlock uint64 `protobuf:"varint,2,opt,name=max_pairs_per_block,json=maxPairsPerBlock,proto3" json:"max_pairs_per_block,omitempty" yaml:"max_pairs_per_block"`
+	// max_rounds_per_pair is the maximum number of times the clearing of a pair
+	// is computed. Each round after the first leaves out the intents whose min
+	// amount out was not met in the previous one.
+	MaxRoundsPerPair uint64 `protobuf:"varint,3,opt,name=max_rounds_per_pair,json=maxRoundsPerPair,proto3" json:"max_rounds_per_pair,omitempty" yaml:"max_rounds_per_pair"`
+	// max_gas is the gas budget for settling the intents of a block, including
+	// the route searches of every round of every pair. Once it is spent, the
+	// pairs not cleared yet stay pending.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *SwapIntentParams) Reset()         { *m = SwapIntentParams{} }
+func (m *SwapIntentParams) String() string { return proto.CompactTextString(m) }
+func (*SwapIntentParams) ProtoMessage()    {}
+func (*SwapIntentParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{2}
+}
+func (m *SwapIntentParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapIntentParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapIntentParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapIntentParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapIntentParams.Merge(m, src)
+}
+func (m *SwapIntentParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapIntentParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapIntentParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapIntentParams proto.InternalMessageInfo
+
+func (m *SwapIntentParams) GetMaxIntentsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxIntentsPerBlock
+	}
+	return 0
+}
+
+func (m *SwapIntentParams) GetMaxPairsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPairsPerBlock
+	}
+	return 0
+}
+
+func (m *SwapIntentParams) GetMaxRoundsPerPair() uint64 {
+	if m != nil {
+		return m.MaxRoundsPerPair
+	}
+	return 0
+}
+
+func (m *SwapIntentParams) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -121,7 +299,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{1}
+	return fileDescriptor_aa099d9fbdf68b35, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +424,7 @@ func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
 func (m *TakerFeeParams) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeParams) ProtoMessage()    {}
 func (*TakerFeeParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{2}
+	return fileDescriptor_aa099d9fbdf68b35, []int{4}
 }
 func (m *TakerFeeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +514,7 @@ func (m *TakerFeeDistributionPercentage) Reset()         { *m = TakerFeeDistribu
 func (m *TakerFeeDistributionPercentage) String() string { return proto.CompactTextString(m) }
 func (*TakerFeeDistributionPercentage) ProtoMessage()    {}
 func (*TakerFeeDistributionPercentage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{3}
+	return fileDescriptor_aa099d9fbdf68b35, []int{5}
 }
 func (m *TakerFeeDistributionPercentage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +554,7 @@ func (m *TakerFeesTracker) Reset()         { *m = TakerFeesTracker{} }
 func (m *TakerFeesTracker) String() string { return proto.CompactTextString(m) }
 func (*TakerFeesTracker) ProtoMessage()    {}
 func (*TakerFeesTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{4}
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
 }
 func (m *TakerFeesTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +624,7 @@ func (m *PoolVolume) Reset()         { *m = PoolVolume{} }
 func (m *PoolVolume) String() string { return proto.CompactTextString(m) }
 func (*PoolVolume) ProtoMessage()    {}
 func (*PoolVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aa099d9fbdf68b35, []int{5}
+	return fileDescriptor_aa099d9fbdf68b35, []int{7}
 }
 func (m *PoolVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,6 +669,8 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
+	proto.RegisterType((*SmartRouterParams)(nil), "osmosis.poolmanager.v1beta1.SmartRouterParams")
+	proto.RegisterType((*SwapIntentParams)(nil), "osmosis.poolmanager.v1beta1.SwapIntentParams")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
 	proto.RegisterType((*TakerFeeParams)(nil), "osmosis.poolmanager.v1beta1.TakerFeeParams")
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
@@ -503,83 +683,100 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1f, 0xce, 0xd6, 0x6e, 0xda, 0x4c, 0xfa, 0x8f, 0xdb, 0xf9, 0x93, 0x76, 0x9b, 0x14, 0xaf, 0xb5,
-	0x2d, 0xe0, 0x0a, 0xb2, 0x6e, 0x52, 0xa9, 0x48, 0x85, 0x1e, 0xb2, 0x89, 0x8c, 0x40, 0xa1, 0x4d,
-	0xd7, 0x11, 0x48, 0x70, 0x18, 0x8d, 0x77, 0xc7, 0xf6, 0xca, 0xbb, 0x3b, 0x66, 0x66, 0x36, 0x89,
-	0x39, 0x82, 0xe0, 0x8c, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x38, 0xf0, 0x1d, 0x7a, 0xec, 0x11, 0x71,
-	0xd8, 0xa2, 0xe4, 0x1b, 0xf8, 0x13, 0xa0, 0x9d, 0x59, 0xbf, 0xac, 0x93, 0xb8, 0x06, 0x4e, 0xf6,
-	0xce, 0x3c, 0xcf, 0x33, 0xcf, 0xef, 0x65, 0x5e, 0xc0, 0x7d, 0xca, 0x43, 0xca, 0x7d, 0x5e, 0xeb,
-	0x51, 0x1a, 0x84, 0x38, 0xc2, 0x6d, 0xc2, 0x6a, 0x87, 0x9b, 0x4d, 0x22, 0xf0, 0x66, 0xad, 0x4d,
-	0x22, 0xc2, 0x7d, 0x6e, 0xf5, 0x18, 0x15, 0x14, 0xae, 0x67, 0x50, 0x6b, 0x02, 0x6a, 0x65, 0xd0,
-	0xb5, 0xb7, 0xda, 0xb4, 0x4d, 0x25, 0xae, 0x96, 0xfe, 0x53, 0x94, 0xb5, 0xdb, 0x6d, 0x4a, 0xdb,
-	0x01, 0xa9, 0xc9, 0xaf, 0x66, 0xdc, 0xaa, 0xe1, 0xa8, 0x3f, 0x9c, 0x72, 0xa5, 0x1c, 0x52, 0x1c,
-	0xf5, 0x91, 0x4d, 0x95, 0xa7, 0x59, 0x5e, 0xcc, 0xb0, 0xf0, 0x69, 0x34, 0x9c, 0x57, 0xe8, 0x5a,
-	0x13, 0x73, 0x32, 0xf2, 0xea, 0x52, 0x7f, 0x38, 0x6f, 0xcd, 0x8a, 0x29, 0xa4, 0x5e, 0x1c, 0x10,
-	0xc4, 0x68, 0x2c, 0x48, 0x86, 0xbf, 0x37, 0x0b, 0x2f, 0x8e, 0x15, 0xca, 0xfc, 0xb1, 0x00, 0x16,
-	0xf7, 0x31, 0xc3, 0x21, 0x87, 0x2f, 0x34, 0x70, 0x23, 0xc5, 0x22, 0x97, 0x11, 0x69, 0x0c, 0xb5,
-	0x08, 0xd1, 0xb5, 0x4a, 0xa1, 0xba, 0xbc, 0x75, 0xdb, 0xca, 0x62, 0x49, 0xdd, 0x0d, 0xd3, 0x63,
-	0xed, 0x50, 0x3f, 0xb2, 0xf7, 0x5e, 0x26, 0xc6, 0xc2, 0x20, 0x31, 0xf4, 0x3e, 0x0e, 0x83, 0xc7,
-	0xe6, 0x19, 0x05, 0xf3, 0xd7, 0xd7, 0x46, 0xb5, 0xed, 0x8b, 0x4e, 0xdc, 0xb4, 0x5c, 0x1a, 0x66,
-	0x49, 0xc9, 0x7e, 0x36, 0xb8, 0xd7, 0xad, 0x89, 0x7e, 0x8f, 0x70, 0x29, 0xc6, 0x9d, 0x52, 0xca,
-	0xdf, 0xc9, 0xe8, 0x75, 0x42, 0xe0, 0x21, 0xb8, 0x2e, 0x70, 0x97, 0xb0, 0x54, 0x0a, 0xf5, 0xa4,
-	0x53, 0xfd, 0x52, 0x45, 0xab, 0x2e, 0x6f, 0xbd, 0x6f, 0xcd, 0x28, 0x9d, 0x75, 0x90, 0x92, 0xea,
-	0x84, 0xa8, 0xe0, 0x6c, 0x23, 0x73, 0x79, 0x4b, 0xb9, 0x9c, 0x96, 0x34, 0x9d, 0x15, 0x91, 0x23,
-	0xc0, 0x08, 0xdc, 0xc2, 0xb1, 0xe8, 0x50, 0xe6, 0x7f, 0x4b, 0x3c, 0xf4, 0x4d, 0x4c, 0x05, 0x41,
-	0x1e, 0x89, 0x68, 0xc8, 0xf5, 0x42, 0xa5, 0x50, 0x5d, 0xb2, 0x1f, 0x0d, 0x12, 0xe3, 0x81, 0x52,
-	0xbb, 0x00, 0x68, 0x7e, 0xe0, 0x91, 0x1e, 0x23, 0x2e, 0x16, 0xc4, 0x7b, 0x6c, 0x0a, 0x16, 0x13,
-	0x53, 0xd7, 0x9c, 0xd5, 0x31, 0xfa, 0x79, 0x0a, 0xde, 0x55, 0xd8, 0xd7, 0x05, 0x70, 0xed, 0x13,
-	0xd5, 0x99, 0x0d, 0x81, 0x05, 0x81, 0x15, 0x70, 0x2d, 0x22, 0xc7, 0x02, 0xc9, 0x84, 0xfa, 0x9e,
-	0xae, 0x55, 0xb4, 0x6a, 0xd1, 0x01, 0xe9, 0xd8, 0x3e, 0xa5, 0xc1, 0xa7, 0x1e, 0xdc, 0x06, 0x8b,
-	0xb9, 0x84, 0xdc, 0x9d, 0x99, 0x90, 0x2c, 0x11, 0xc5, 0x34, 0x11, 0x4e, 0x46, 0x84, 0xcf, 0xc0,
-	0xb2, 0xd4, 0x97, 0x8d, 0xa3, 0x22, 0x5b, 0xde, 0xaa, 0xce, 0xd4, 0xf9, 0x5c, 0xb6, 0x9a, 0x93,
-	0x12, 0x32, 0x31, 0x90, 0xc2, 0xe4, 0x00, 0x87, 0x5f, 0x03, 0x38, 0xca, 0x2d, 0x47, 0x82, 0x61,
-	0xb7, 0x4b, 0x98, 0x5e, 0x94, 0xfe, 0x36, 0xe6, 0x2a, 0x18, 0x3f, 0x50, 0x24, 0xe7, 0xba, 0x98,
-	0x1a, 0x81, 0x9f, 0x81, 0x6b, 0xd2, 0xed, 0x21, 0x0d, 0xe2, 0x90, 0x70, 0xfd, 0xb2, 0xb4, 0xfb,
-	0xde, 0xec, 0xb0, 0x29, 0x0d, 0xbe, 0x90, 0x78, 0x47, 0x86, 0xaa, 0xfe, 0x73, 0xd8, 0x03, 0x6b,
-	0xb2, 0x4a, 0xa8, 0x87, 0x7d, 0x86, 0xc6, 0xfd, 0xc0, 0x05, 0x65, 0x44, 0x5f, 0x94, 0xca, 0xd6,
-	0x4c, 0x65, 0x59, 0xb8, 0x7d, 0xec, 0xb3, 0xa1, 0xf3, 0x2c, 0x1d, 0x37, 0xbd, 0xe9, 0x89, 0x46,
-	0xaa, 0x69, 0x7e, 0x7f, 0x15, 0xac, 0xe4, 0xbb, 0x12, 0x36, 0xc1, 0x0d, 0x8f, 0xb4, 0x70, 0x1c,
-	0x88, 0xb1, 0x03, 0x59, 0xe8, 0x25, 0xfb, 0x51, 0xaa, 0xf5, 0x67, 0x62, 0xac, 0xab, 0x8d, 0xc2,
-	0xbd, 0xae, 0xe5, 0xd3, 0x5a, 0x88, 0x45, 0xc7, 0xda, 0x23, 0x6d, 0xec, 0xf6, 0x77, 0x89, 0x7b,
-	0x92, 0x18, 0xa5, 0x5d, 0xc5, 0x1f, 0x0a, 0x3b, 0x25, 0x2f, 0x3f, 0x00, 0x7f, 0xd6, 0x80, 0x3c,
-	0xe3, 0x26, 0x62, 0xf4, 0x7c, 0x2e, 0x98, 0xdf, 0x8c, 0xd3, 0x3d, 0x96, 0xf5, 0xce, 0x47, 0x73,
-	0xd5, 0x66, 0x77, 0x82, 0xb8, 0x4f, 0x98, 0x4b, 0x22, 0x81, 0xdb, 0xc4, 0xae, 0xa4, 0x5e, 0x4f,
-	0x12, 0x43, 0x7f, 0xc6, 0x43, 0x7a, 0x1e, 0xd6, 0xd1, 0xe9, 0x05, 0x33, 0xf0, 0x17, 0x0d, 0x18,
-	0x11, 0x8d, 0xd0, 0x2c, 0x8b, 0x85, 0xff, 0x6e, 0xf1, 0x6e, 0x66, 0x71, 0xfd, 0x29, 0x8d, 0x2e,
-	0x74, 0xb9, 0x1e, 0x5d, 0x3c, 0x09, 0x77, 0x40, 0x09, 0x7b, 0xa1, 0x1f, 0x21, 0xec, 0x79, 0x8c,
-	0x70, 0x4e, 0xb8, 0x5e, 0x94, 0x07, 0xc1, 0xda, 0x20, 0x31, 0x6e, 0x66, 0x07, 0x41, 0x1e, 0x60,
-	0x3a, 0x2b, 0x72, 0x64, 0x7b, 0x38, 0x00, 0x7f, 0xd3, 0xc0, 0x23, 0x97, 0x86, 0x61, 0x1c, 0xf9,
-	0xa2, 0xaf, 0xb6, 0xb6, 0xea, 0x42, 0x41, 0x11, 0x3f, 0xc2, 0x3d, 0x94, 0xa6, 0xe2, 0xa8, 0xe3,
-	0x0b, 0x12, 0xf8, 0x5c, 0x10, 0x0f, 0x61, 0xce, 0x89, 0xe0, 0x48, 0x50, 0xfd, 0xb2, 0x6c, 0x8b,
-	0xed, 0x41, 0x62, 0x3c, 0x51, 0x8b, 0xfd, 0x3b, 0x1d, 0xd3, 0xb1, 0x46, 0xc4, 0x74, 0x6f, 0xc8,
-	0x2e, 0x3e, 0xa0, 0x8d, 0x23, 0xdc, 0x7b, 0x4a, 0xa3, 0x2f, 0xc7, 0x94, 0x6d, 0xc9, 0x38, 0xa0,
-	0xf0, 0x00, 0xac, 0x32, 0xe2, 0xc5, 0x2e, 0xf1, 0x64, 0x65, 0x46, 0xaa, 0x72, 0x93, 0x2c, 0xd9,
-	0x95, 0x41, 0x62, 0xdc, 0x51, 0x8e, 0xce, 0x85, 0x99, 0xce, 0xff, 0xb3, 0xf1, 0x3a, 0x21, 0x23,
-	0x7d, 0x18, 0x81, 0xf2, 0xb9, 0x01, 0x8c, 0xe5, 0xaf, 0x48, 0xf9, 0xfb, 0x83, 0xc4, 0x78, 0x67,
-	0x46, 0xc0, 0x13, 0xeb, 0xac, 0x9f, 0x0d, 0x6c, 0xbc, 0xde, 0x0f, 0x1a, 0x78, 0xd7, 0xc3, 0x7e,
-	0xd0, 0x47, 0x5c, 0xe0, 0xae, 0x1f, 0xb5, 0x11, 0x23, 0x47, 0x98, 0x79, 0x1c, 0xf1, 0x90, 0x52,
-	0xd1, 0x49, 0x47, 0x5a, 0xd8, 0x15, 0x94, 0xe9, 0x57, 0xd3, 0x93, 0xd6, 0xde, 0x1c, 0x24, 0xc6,
-	0x86, 0x5a, 0x78, 0x3e, 0x9e, 0xe9, 0x28, 0x60, 0x43, 0xe1, 0x1c, 0x05, 0x6b, 0x0c, 0x51, 0x75,
-	0x05, 0xfa, 0xfd, 0x12, 0x28, 0xcf, 0xee, 0x55, 0xd8, 0x02, 0xa5, 0xa9, 0xb5, 0xb2, 0x33, 0xe1,
-	0xc9, 0x1c, 0x67, 0xc2, 0xb8, 0x19, 0xa7, 0x34, 0x4c, 0x67, 0x85, 0xe7, 0x9c, 0x41, 0x17, 0xac,
-	0xe4, 0x53, 0x2a, 0xcf, 0x82, 0x25, 0xfb, 0xe3, 0xf9, 0x96, 0x59, 0x3d, 0xaf, 0x2a, 0xa6, 0xf3,
-	0xbf, 0x5c, 0x15, 0x60, 0x1d, 0x14, 0x9b, 0x31, 0x53, 0x7b, 0x78, 0xc9, 0xde, 0x9a, 0x4f, 0x7a,
-	0x59, 0x49, 0xa7, 0x44, 0xd3, 0x91, 0x7c, 0xf3, 0xbb, 0x02, 0xb8, 0x3e, 0x7d, 0x45, 0x40, 0x07,
-	0xac, 0x4e, 0xde, 0x36, 0x54, 0xd6, 0x88, 0x30, 0xfe, 0xe6, 0x57, 0x8b, 0x3a, 0xaa, 0xe1, 0xf8,
-	0x8a, 0xa1, 0x0d, 0x45, 0x85, 0x08, 0xdc, 0xc9, 0x6b, 0x9e, 0xc9, 0xd1, 0x5c, 0xd2, 0xfa, 0x84,
-	0xf4, 0x4e, 0x2e, 0x23, 0x5d, 0xf0, 0x76, 0x87, 0xf8, 0xed, 0x8e, 0x40, 0xd8, 0x75, 0x69, 0x1c,
-	0x89, 0xb4, 0x48, 0x5c, 0x60, 0x26, 0x38, 0x6a, 0x31, 0x1a, 0xca, 0x54, 0x15, 0xec, 0xea, 0x20,
-	0x31, 0xee, 0xa9, 0x3c, 0xcc, 0x84, 0x9b, 0xce, 0x9a, 0x9a, 0xdf, 0x1e, 0x4d, 0x37, 0xe4, 0x6c,
-	0x9d, 0xd1, 0x10, 0xee, 0xe5, 0xef, 0x63, 0x8a, 0x64, 0x31, 0x8a, 0xf3, 0xc5, 0x50, 0x9a, 0x88,
-	0xc1, 0x4e, 0x8b, 0xf0, 0x42, 0x03, 0x60, 0x7c, 0xa1, 0xc2, 0x5b, 0xe0, 0x4a, 0xfe, 0x75, 0xb2,
-	0xd8, 0x53, 0x2f, 0x93, 0x20, 0x7b, 0x56, 0xa8, 0x8b, 0xfa, 0xcd, 0x29, 0x7b, 0x90, 0x2e, 0xf7,
-	0x8f, 0xde, 0x89, 0x60, 0x7c, 0x97, 0xdb, 0xcf, 0x5f, 0x9e, 0x94, 0xb5, 0x57, 0x27, 0x65, 0xed,
-	0xaf, 0x93, 0xb2, 0xf6, 0xd3, 0x69, 0x79, 0xe1, 0xd5, 0x69, 0x79, 0xe1, 0x8f, 0xd3, 0xf2, 0xc2,
-	0x57, 0x1f, 0x4e, 0xe8, 0x65, 0x97, 0xc7, 0x46, 0x80, 0x9b, 0x7c, 0xf8, 0x51, 0x3b, 0x7c, 0xb8,
-	0x59, 0x3b, 0xce, 0xbd, 0x90, 0xe5, 0x22, 0xcd, 0x45, 0xf9, 0x3a, 0x7e, 0xf8, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd2, 0x09, 0xcf, 0xd3, 0x49, 0x0c, 0x00, 0x00,
+	// 1488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
+	0x16, 0xd6, 0x5a, 0xb2, 0x6c, 0x8d, 0x7c, 0xf5, 0x18, 0x59, 0x36, 0x2d, 0xf9, 0x72, 0x75, 0xc7,
+	0xbe, 0xf7, 0xca, 0x70, 0x44, 0x5a, 0x36, 0xe0, 0x00, 0x4e, 0x5c, 0x68, 0xa5, 0xc8, 0x71, 0xe0,
+	0x87, 0x3c, 0x14, 0x12, 0x20, 0x29, 0x16, 0xc3, 0xdd, 0x11, 0xb9, 0x10, 0x77, 0x87, 0x99, 0x99,
+	0x95, 0xa8, 0x14, 0x29, 0x1c, 0xa4, 0x4a, 0x13, 0xc0, 0x6d, 0xea, 0x14, 0xe9, 0x12, 0x20, 0xff,
+	0xc1, 0xa5, 0xcb, 0x20, 0xc5, 0x3a, 0x90, 0xbb, 0x94, 0xfc, 0x05, 0xc1, 0x3c, 0xa8, 0x25, 0x29,
+	0x89, 0xa6, 0x93, 0x8a, 0xe4, 0x99, 0xef, 0xfb, 0xe6, 0xbc, 0xf6, 0x9c, 0x25, 0xb8, 0xc1, 0x44,
+	0xcc, 0x44, 0x24, 0xca, 0x4d, 0xc6, 0x1a, 0x31, 0x49, 0x48, 0x8d, 0xf2, 0xf2, 0xde, 0x6a, 0x95,
+	0x4a, 0xb2, 0x5a, 0xae, 0xd1, 0x84, 0x8a, 0x48, 0x94, 0x9a, 0x9c, 0x49, 0x06, 0x17, 0x2d, 0xb4,
+	0xd4, 0x05, 0x2d, 0x59, 0xe8, 0xc2, 0xc5, 0x1a, 0xab, 0x31, 0x8d, 0x2b, 0xab, 0x6f, 0x86, 0xb2,
+	0x70, 0xa5, 0xc6, 0x58, 0xad, 0x41, 0xcb, 0xfa, 0x57, 0x35, 0xdd, 0x29, 0x93, 0xe4, 0xa0, 0x73,
+	0x14, 0x68, 0x39, 0xdf, 0x70, 0xcc, 0x0f, 0x7b, 0x54, 0xec, 0x67, 0x85, 0x29, 0x27, 0x32, 0x62,
+	0x49, 0xe7, 0xdc, 0xa0, 0xcb, 0x55, 0x22, 0xe8, 0x91, 0xaf, 0x01, 0x8b, 0x3a, 0xe7, 0xa5, 0x41,
+	0x31, 0xc5, 0x2c, 0x4c, 0x1b, 0xd4, 0xe7, 0x2c, 0x95, 0xd4, 0xe2, 0xaf, 0x0f, 0xc2, 0xcb, 0x96,
+	0x41, 0xa1, 0xef, 0xce, 0x82, 0xf1, 0x2d, 0xc2, 0x49, 0x2c, 0xe0, 0x0b, 0x07, 0xcc, 0x2a, 0xac,
+	0x1f, 0x70, 0xaa, 0x1d, 0xf3, 0x77, 0x28, 0x2d, 0x38, 0x4b, 0xa3, 0xcb, 0x93, 0xb7, 0xaf, 0x94,
+	0x6c, 0x2c, 0xca, 0xbb, 0x4e, 0x7a, 0x4a, 0xeb, 0x2c, 0x4a, 0xbc, 0x47, 0x2f, 0x33, 0x77, 0xa4,
+	0x9d, 0xb9, 0x85, 0x03, 0x12, 0x37, 0xee, 0xa1, 0x63, 0x0a, 0xe8, 0xa7, 0xd7, 0xee, 0x72, 0x2d,
+	0x92, 0xf5, 0xb4, 0x5a, 0x0a, 0x58, 0x6c, 0x93, 0x62, 0x3f, 0x56, 0x44, 0xb8, 0x5b, 0x96, 0x07,
+	0x4d, 0x2a, 0xb4, 0x98, 0xc0, 0xd3, 0x8a, 0xbf, 0x6e, 0xe9, 0x9b, 0x94, 0xc2, 0x3d, 0x30, 0x23,
+	0xc9, 0x2e, 0xe5, 0x4a, 0xca, 0x6f, 0x6a, 0x4f, 0x0b, 0x67, 0x96, 0x9c, 0xe5, 0xc9, 0xdb, 0x37,
+	0x4b, 0x03, 0x4a, 0x57, 0xda, 0x56, 0xa4, 0x4d, 0x4a, 0x4d, 0x70, 0x9e, 0x6b, 0xbd, 0xbc, 0x6c,
+	0xbc, 0xec, 0x97, 0x44, 0x78, 0x4a, 0xf6, 0x10, 0x60, 0x02, 0x2e, 0x93, 0x54, 0xd6, 0x19, 0x8f,
+	0xbe, 0xa2, 0xa1, 0xff, 0x65, 0xca, 0x24, 0xf5, 0x43, 0x9a, 0xb0, 0x58, 0x14, 0x46, 0x97, 0x46,
+	0x97, 0x27, 0xbc, 0xbb, 0xed, 0xcc, 0xbd, 0x65, 0xd4, 0x4e, 0x01, 0xa2, 0xf7, 0x42, 0xda, 0xe4,
+	0x34, 0x20, 0x92, 0x86, 0xf7, 0x90, 0xe4, 0x29, 0x45, 0x05, 0x07, 0xcf, 0xe7, 0xe8, 0x67, 0x0a,
+	0xbc, 0xa1, 0xb1, 0xf0, 0xb9, 0x03, 0xe6, 0x44, 0x4c, 0xb8, 0x34, 0x45, 0xe4, 0x9d, 0x58, 0xc7,
+	0x74, 0xac, 0xa5, 0x81, 0xb1, 0x56, 0x14, 0x0f, 0x6b, 0x9a, 0x0d, 0x17, 0xd9, 0x70, 0x17, 0x8c,
+	0x83, 0x27, 0x08, 0x23, 0x3c, 0x2b, 0xfa, 0x69, 0xf0, 0x6b, 0x00, 0xc5, 0x3e, 0x69, 0xfa, 0x51,
+	0x22, 0x69, 0x22, 0x3b, 0x2e, 0x9c, 0xd5, 0x2e, 0xac, 0x0c, 0x76, 0x61, 0x9f, 0x34, 0x1f, 0x6a,
+	0x96, 0xf5, 0xe0, 0x3f, 0xd6, 0x83, 0x2b, 0xd6, 0x83, 0x63, 0xb2, 0x08, 0xcf, 0x88, 0x3e, 0x12,
+	0xfa, 0xd3, 0x01, 0xb3, 0xc7, 0x82, 0x81, 0x25, 0x70, 0x3e, 0x26, 0x2d, 0xbf, 0xce, 0x9a, 0xa2,
+	0xe0, 0x2c, 0x39, 0xcb, 0x63, 0xde, 0x5c, 0x3b, 0x73, 0xa7, 0x8d, 0x70, 0xe7, 0x04, 0xe1, 0x73,
+	0x31, 0x69, 0x7d, 0xcc, 0x9a, 0x02, 0xae, 0x82, 0x09, 0x65, 0x55, 0x6e, 0x9a, 0x5e, 0x19, 0xf3,
+	0x2e, 0xb6, 0x33, 0x77, 0x26, 0x27, 0xe8, 0x23, 0x84, 0x95, 0xec, 0x96, 0xfa, 0x0a, 0x3f, 0x02,
+	0x33, 0xca, 0x2e, 0x9a, 0x8d, 0xc8, 0xe6, 0x49, 0x95, 0x59, 0x31, 0x17, 0xf3, 0xa6, 0xe9, 0x47,
+	0x20, 0x3c, 0x15, 0x93, 0x56, 0x45, 0x59, 0xb4, 0xbf, 0x02, 0xde, 0x04, 0xca, 0x09, 0xbf, 0x46,
+	0x4c, 0xdd, 0xc6, 0x3c, 0xd8, 0xce, 0xdc, 0xa9, 0x9c, 0x5d, 0x23, 0x02, 0xe1, 0xf1, 0x98, 0xb4,
+	0x1e, 0x10, 0x81, 0x7e, 0x39, 0x03, 0x66, 0xfa, 0xd3, 0x06, 0x2b, 0x60, 0x5e, 0x01, 0x4d, 0xa6,
+	0x84, 0xdf, 0xa4, 0xdc, 0xaf, 0x36, 0x58, 0xb0, 0x6b, 0x03, 0x5f, 0x6a, 0x67, 0xee, 0xd5, 0x5c,
+	0xef, 0x18, 0x0c, 0x61, 0x18, 0x93, 0x96, 0x51, 0x14, 0x5b, 0x94, 0x7b, 0xca, 0x08, 0x1f, 0x83,
+	0x39, 0x1d, 0x35, 0x89, 0x78, 0xb7, 0xa4, 0x49, 0x4d, 0x31, 0x6f, 0x93, 0x13, 0x40, 0x08, 0xab,
+	0xc4, 0x6c, 0x29, 0x63, 0xbf, 0x1c, 0x67, 0x69, 0x12, 0x1a, 0xa8, 0x22, 0xd9, 0x7c, 0xf5, 0xc9,
+	0xf5, 0x81, 0x8c, 0x1c, 0xd6, 0xc6, 0x2d, 0x55, 0xe0, 0x88, 0xbf, 0x5b, 0xd2, 0x5e, 0x8f, 0x82,
+	0x0b, 0x0f, 0xcc, 0x00, 0xaf, 0x48, 0x22, 0x29, 0x5c, 0x02, 0x17, 0x12, 0xda, 0x92, 0xba, 0xa4,
+	0x7e, 0x14, 0x9a, 0x3c, 0x61, 0xa0, 0x6c, 0xaa, 0xb4, 0x0f, 0x43, 0xb8, 0x06, 0xc6, 0x7b, 0xe6,
+	0xc6, 0xb5, 0x81, 0x8d, 0x6c, 0xdb, 0x77, 0x4c, 0xb5, 0x2f, 0xb6, 0x44, 0xf8, 0x14, 0x4c, 0x6a,
+	0xfd, 0xa3, 0xce, 0x50, 0x33, 0x71, 0x79, 0xa0, 0xce, 0x63, 0x3d, 0x91, 0x75, 0x5f, 0x58, 0x31,
+	0xa0, 0x60, 0xb6, 0x51, 0xbe, 0x00, 0xf0, 0x68, 0x04, 0x09, 0x5f, 0x72, 0x12, 0xec, 0x52, 0x6e,
+	0x9f, 0xf5, 0x95, 0xa1, 0xe6, 0x9a, 0xd8, 0x36, 0x24, 0x3c, 0x23, 0xfb, 0x2c, 0xf0, 0x13, 0x70,
+	0x41, 0x7b, 0xbb, 0xc7, 0x1a, 0x69, 0x4c, 0xd5, 0xf3, 0xab, 0xdc, 0xfd, 0xff, 0xe0, 0xb0, 0x19,
+	0x6b, 0x7c, 0xaa, 0xf1, 0x58, 0x87, 0x6a, 0xbe, 0x0b, 0xd8, 0x04, 0x0b, 0x7a, 0x98, 0xe9, 0xea,
+	0xf9, 0xf9, 0xd8, 0x14, 0x92, 0x71, 0x5a, 0x18, 0xd7, 0xca, 0x83, 0x87, 0x93, 0x9e, 0x6f, 0xaa,
+	0xd0, 0x1d, 0xcf, 0x6d, 0x3a, 0x2e, 0x85, 0xfd, 0x07, 0x15, 0xa5, 0x89, 0xbe, 0x39, 0x0f, 0xa6,
+	0x7a, 0x87, 0x37, 0xac, 0x82, 0xd9, 0x90, 0xee, 0x90, 0xb4, 0x21, 0x73, 0x0f, 0x74, 0xa1, 0x27,
+	0xbc, 0xbb, 0x4a, 0xeb, 0xf7, 0xcc, 0x5d, 0x34, 0xfb, 0x44, 0x84, 0xbb, 0xa5, 0x88, 0x95, 0x63,
+	0x22, 0xeb, 0xa5, 0x47, 0xb4, 0x46, 0x82, 0x83, 0x0d, 0x1a, 0x1c, 0x66, 0xee, 0xf4, 0x86, 0xe1,
+	0x77, 0x84, 0xf1, 0x74, 0xd8, 0x6b, 0x80, 0x3f, 0x38, 0x40, 0xbf, 0x0a, 0x74, 0xc5, 0x18, 0x46,
+	0x42, 0xf2, 0xa8, 0x9a, 0xaa, 0x55, 0x64, 0x7b, 0xe7, 0x83, 0xa1, 0x6a, 0xb3, 0xd1, 0x45, 0xdc,
+	0xa2, 0x3c, 0xa0, 0x89, 0x24, 0x35, 0xea, 0x2d, 0x29, 0x5f, 0x0f, 0x33, 0xb7, 0xf0, 0x54, 0xc4,
+	0xec, 0x24, 0x2c, 0x2e, 0xb0, 0x53, 0x4e, 0xe0, 0x8f, 0x0e, 0x70, 0x13, 0x96, 0xf8, 0x83, 0x5c,
+	0x1c, 0xfd, 0xe7, 0x2e, 0x5e, 0xb3, 0x2e, 0x2e, 0x3e, 0x61, 0xc9, 0xa9, 0x5e, 0x2e, 0x26, 0xa7,
+	0x1f, 0xc2, 0x75, 0x30, 0x4d, 0xc2, 0x38, 0x4a, 0x7c, 0x12, 0x86, 0x9c, 0x0a, 0x41, 0xd5, 0x53,
+	0xad, 0xf6, 0xe5, 0x42, 0x3b, 0x73, 0x2f, 0xd9, 0x7d, 0xd9, 0x0b, 0x40, 0x78, 0x4a, 0x5b, 0xd6,
+	0x3a, 0x06, 0xf8, 0xb3, 0x03, 0xee, 0x06, 0x2c, 0x8e, 0xd3, 0x24, 0x92, 0x07, 0xe6, 0xd1, 0x36,
+	0x5d, 0x28, 0x99, 0xaf, 0x37, 0x89, 0x4a, 0xc5, 0x7e, 0x3d, 0x92, 0xb4, 0x11, 0x09, 0x49, 0x43,
+	0x9f, 0x08, 0x41, 0xa5, 0xf0, 0x25, 0xd3, 0xcb, 0x6a, 0xc2, 0x5b, 0x6b, 0x67, 0xee, 0x7d, 0x73,
+	0xd9, 0xdf, 0xd3, 0x41, 0xb8, 0x74, 0x44, 0x54, 0xcf, 0x86, 0xee, 0xe2, 0x6d, 0xa6, 0xe6, 0xf5,
+	0x13, 0x96, 0x7c, 0x96, 0x53, 0xd6, 0x34, 0x63, 0x9b, 0xc1, 0x6d, 0x30, 0xcf, 0x69, 0x98, 0x06,
+	0x34, 0xd4, 0x95, 0x39, 0x52, 0xd5, 0x0f, 0xc9, 0x44, 0xf7, 0xe4, 0x3e, 0x11, 0x86, 0xf0, 0x9c,
+	0xb5, 0x6f, 0x52, 0x7a, 0xa4, 0x0f, 0x13, 0x50, 0x3c, 0x31, 0x80, 0x5c, 0xfe, 0x9c, 0x96, 0xbf,
+	0xd1, 0xce, 0xdc, 0xff, 0x0e, 0x08, 0xb8, 0xeb, 0x9e, 0xc5, 0xe3, 0x81, 0xe5, 0xf7, 0x7d, 0xeb,
+	0x80, 0xff, 0x85, 0x24, 0x6a, 0x1c, 0xf8, 0x42, 0x92, 0xdd, 0x28, 0xa9, 0xf9, 0x9c, 0xee, 0x13,
+	0x1e, 0x0a, 0x5f, 0xc4, 0x8c, 0xc9, 0xba, 0xb2, 0xec, 0x90, 0x40, 0x32, 0x5e, 0x38, 0xaf, 0x87,
+	0xf5, 0x6a, 0x3b, 0x73, 0x57, 0xcc, 0xc5, 0xc3, 0xf1, 0x10, 0x36, 0xc0, 0x8a, 0xc1, 0x61, 0x03,
+	0xab, 0x74, 0x50, 0x9b, 0x06, 0xf4, 0xeb, 0x19, 0x50, 0x1c, 0xdc, 0xab, 0x70, 0x07, 0x4c, 0xf7,
+	0xdd, 0x65, 0x67, 0xc2, 0xfd, 0x21, 0x66, 0x42, 0xde, 0x8c, 0x7d, 0x1a, 0x08, 0x4f, 0x89, 0x1e,
+	0xcf, 0x60, 0x00, 0xa6, 0x7a, 0x53, 0xaa, 0x67, 0xc1, 0x84, 0xf7, 0xe1, 0x70, 0xd7, 0xcc, 0x9f,
+	0x54, 0x15, 0x84, 0xff, 0xd5, 0x53, 0x05, 0xb8, 0x09, 0xc6, 0xaa, 0x29, 0x37, 0xcf, 0xf0, 0x84,
+	0x77, 0x7b, 0x38, 0xe9, 0x49, 0x23, 0xad, 0x88, 0x08, 0x6b, 0x3e, 0x7a, 0x3e, 0x0a, 0x66, 0xfa,
+	0x57, 0x04, 0xc4, 0x60, 0xbe, 0x7b, 0xdb, 0x30, 0x5d, 0x23, 0xca, 0xc5, 0xdb, 0x5f, 0xee, 0xcd,
+	0xa8, 0x86, 0xf9, 0x8a, 0x61, 0x15, 0x43, 0x85, 0x3e, 0xb8, 0xda, 0xab, 0x79, 0x2c, 0x47, 0x43,
+	0x49, 0x17, 0xba, 0xa4, 0xd7, 0x7b, 0x32, 0xb2, 0x0b, 0xfe, 0x5d, 0xa7, 0x51, 0xad, 0x2e, 0x7d,
+	0x12, 0x04, 0x2c, 0x4d, 0xa4, 0x2a, 0x92, 0x90, 0x84, 0x4b, 0xe1, 0xef, 0x70, 0x16, 0xeb, 0x54,
+	0x8d, 0x7a, 0xcb, 0xed, 0xcc, 0xbd, 0x6e, 0xf2, 0x30, 0x10, 0x8e, 0xf0, 0x82, 0x39, 0x5f, 0x3b,
+	0x3a, 0xae, 0xe8, 0xd3, 0x4d, 0xce, 0x62, 0xf8, 0xa8, 0x77, 0x1f, 0x33, 0x5f, 0x17, 0x63, 0x6c,
+	0xb8, 0x18, 0xa6, 0xbb, 0x62, 0xf0, 0x54, 0x11, 0x5e, 0x38, 0x00, 0xe4, 0x0b, 0x15, 0x5e, 0x06,
+	0xe7, 0x7a, 0xdf, 0x4e, 0xc6, 0x9b, 0xe6, 0xcd, 0xa4, 0x61, 0x5f, 0x2b, 0xcc, 0xa2, 0x7e, 0x7b,
+	0xca, 0x6e, 0xa9, 0xeb, 0xde, 0xe9, 0xef, 0x14, 0xc8, 0x77, 0xb9, 0xf7, 0xec, 0xe5, 0x61, 0xd1,
+	0x79, 0x75, 0x58, 0x74, 0xfe, 0x38, 0x2c, 0x3a, 0xdf, 0xbf, 0x29, 0x8e, 0xbc, 0x7a, 0x53, 0x1c,
+	0xf9, 0xed, 0x4d, 0x71, 0xe4, 0xf3, 0xf7, 0xbb, 0xf4, 0xec, 0xf2, 0x58, 0x69, 0x90, 0xaa, 0xe8,
+	0xfc, 0x28, 0xef, 0xdd, 0x59, 0x2d, 0xb7, 0x7a, 0xfe, 0x48, 0xea, 0x4b, 0xaa, 0xe3, 0xfa, 0x4f,
+	0xe4, 0x9d, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xce, 0xe6, 0x85, 0x6c, 0x70, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -602,6 +799,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SwapIntentParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SmartRouterParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.AuthorizedQuoteDenoms) > 0 {
 		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
@@ -638,6 +855,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SmartRouterParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartRouterParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartRouterParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSplitRoutes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSplitRoutes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPools != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPools))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapIntentParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapIntentParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapIntentParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxRoundsPerPair != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRoundsPerPair))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPairsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPairsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxIntentsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxIntentsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1018,6 +1321,52 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.SmartRouterParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SwapIntentParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SmartRouterParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxHops))
+	}
+	if m.MaxPools != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPools))
+	}
+	if m.MaxSplitRoutes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSplitRoutes))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *SwapIntentParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxIntentsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxIntentsPerBlock))
+	}
+	if m.MaxPairsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPairsPerBlock))
+	}
+	if m.MaxRoundsPerPair != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRoundsPerPair))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGas))
+	}
 	return n
 }
 
@@ -1294,6 +1643,324 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartRouterParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmartRouterParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapIntentParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapIntentParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SmartRouterParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartRouterParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartRouterParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPools", wireType)
+			}
+			m.MaxPools = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPools |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplitRoutes", wireType)
+			}
+			m.MaxSplitRoutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplitRoutes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapIntentParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapIntentParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapIntentParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIntentsPerBlock", wireType)
+			}
+			m.MaxIntentsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIntentsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPairsPerBlock", wireType)
+			}
+			m.MaxPairsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPairsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoundsPerPair", wireType)
+			}
+			m.MaxRoundsPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoundsPerPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -60,6 +60,32 @@ var (
 
 	// KeyTakerFeeBurnProtoRevArray defines key to store the taker fee for burn tracker coin array.
 	KeyTakerFeeBurnProtoRevArray = []byte{0x0D}
+
+	// KeyNextSwapIntentId defines key to store the next swap intent ID to be used.
+	KeyNextSwapIntentId = []byte{0x0E}
+
+	// SwapIntentPrefix defines prefix to store swap intents by block height and intent ID.
+	SwapIntentPrefix = []byte{0x0F}
+
+	// PoolIdsByDenomPrefix defines prefix to store the ids of the pools containing a denom.
+	PoolIdsByDenomPrefix = []byte{0x10}
+
+	// PendingSwapIntentPrefix defines prefix to store the block heights of pending swap intents by intent ID.
+	PendingSwapIntentPrefix = []byte{0x11}
+
+	// SwapIntentCountPrefix defines prefix to store the number of swap intents submitted at a block height.
+	SwapIntentCountPrefix = []byte{0x12}
+)
+
+const (
+	// SwapIntentEscrowName is the name of the module account escrowing the token in of swap intents until they are settled.
+	SwapIntentEscrowName = "poolmanager_swap_intent_escrow"
+
+	// SwapIntentRetentionBlocks is the number of blocks swap intent records are kept for after settlement.
+	SwapIntentRetentionBlocks = 1000
+
+	// MaxSwapIntentSettlementGas is the upper bound of the gas budget for settling the swap intents of a block.
+	MaxSwapIntentSettlementGas = 300_000_000
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -84,6 +110,19 @@ func FormatDenomTradePairKey(tokenInDenom, tokenOutDenom string) []byte {
 	return buffer.Bytes()
 }
 
+// FormatPoolIdsByDenomPrefix returns the prefix of the keys of the pools containing the given denom.
+func FormatPoolIdsByDenomPrefix(denom string) []byte {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "%s%s%s%s", PoolIdsByDenomPrefix, KeySeparator, denom, KeySeparator)
+	return buffer.Bytes()
+}
+
+// FormatPoolIdByDenomKey returns the key indexing the given pool as containing the given denom.
+// Pool ids are big endian encoded so that the pools of a denom are iterated in ascending order.
+func FormatPoolIdByDenomKey(denom string, poolId uint64) []byte {
+	return append(FormatPoolIdsByDenomPrefix(denom), sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // ParseModuleRouteFromBz parses the raw bytes into ModuleRoute.
 // Returns error if fails to parse or if the bytes are empty.
 func ParseModuleRouteFromBz(bz []byte) (ModuleRoute, error) {
@@ -150,3 +189,25 @@ func FormatRegisteredAlloyPoolKey(poolId uint64, alloyedDenom string) []byte {
 func FormatRegisteredAlloyPoolKeyPoolIdOnly(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d", KeyRegisteredAlloyPool, KeySeparator, poolId))
 }
+
+// FormatSwapIntentHeightPrefix generates the prefix of all swap intents included at the given block height.
+func FormatSwapIntentHeightPrefix(blockHeight int64) []byte {
+	return append(append([]byte{}, SwapIntentPrefix...), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// FormatSwapIntentKey generates the key of the swap intent with the given ID included at the given block height.
+// Intents of a block are ordered by ID.
+func FormatSwapIntentKey(blockHeight int64, intentId uint64) []byte {
+	return append(FormatSwapIntentHeightPrefix(blockHeight), sdk.Uint64ToBigEndian(intentId)...)
+}
+
+// FormatPendingSwapIntentKey generates the key indexing the swap intent with the given ID as pending.
+// Pending intents are ordered by ID, so that the oldest ones are settled first.
+func FormatPendingSwapIntentKey(intentId uint64) []byte {
+	return append(append([]byte{}, PendingSwapIntentPrefix...), sdk.Uint64ToBigEndian(intentId)...)
+}
+
+// FormatSwapIntentCountKey generates the key of the number of swap intents submitted at the given block height.
+func FormatSwapIntentCountKey(blockHeight int64) []byte {
+	return append(append([]byte{}, SwapIntentCountPrefix...), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
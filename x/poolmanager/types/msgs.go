@@ -14,6 +14,8 @@ const (
 	TypeMsgSwapExactAmountOut                    = "swap_exact_amount_out"
 	TypeMsgSplitRouteSwapExactAmountIn           = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut          = "split_route_swap_exact_amount_out"
+	TypeMsgSmartRouteSwapExactAmountIn           = "smart_route_swap_exact_amount_in"
+	TypeMsgSubmitSwapIntent                      = "submit_swap_intent"
 	TypeMsgSetDenomPairTakerFee                  = "set_denom_pair_taker_fee"
 	TypeMsgSetTakerFeeShareAgreementForDenomPair = "set_taker_fee_share_agreement_for_denom_pair"
 	TypeMsgSetRegisteredAlloyedPool              = "set_registered_alloyed_pool"
@@ -122,6 +124,86 @@ func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSmartRouteSwapExactAmountIn{}
+
+func (msg MsgSmartRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSmartRouteSwapExactAmountIn) Type() string  { return TypeMsgSmartRouteSwapExactAmountIn }
+
+func (msg MsgSmartRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return fmt.Errorf("token in and token out denoms must be different, both were (%s)", msg.TokenOutDenom)
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSmartRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSubmitSwapIntent{}
+
+func (msg MsgSubmitSwapIntent) Route() string { return RouterKey }
+func (msg MsgSubmitSwapIntent) Type() string  { return TypeMsgSubmitSwapIntent }
+
+func (msg MsgSubmitSwapIntent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return fmt.Errorf("token in and token out denoms must be different, both were (%s)", msg.TokenOutDenom)
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	if msg.Deadline.IsZero() {
+		return fmt.Errorf("swap intent deadline must be set")
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitSwapIntent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgSplitRouteSwapExactAmountOut{}
 
 func (msg MsgSplitRouteSwapExactAmountOut) Route() string { return RouterKey }
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -457,6 +458,173 @@ func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgSmartRouteSwapExactAmountIn(t *testing.T) {
+	defaultValidMsg := types.MsgSmartRouteSwapExactAmountIn{
+		Sender:            addr1,
+		TokenIn:           sdk.NewCoin("udai", osmomath.NewInt(10)),
+		TokenOutDenom:     "uosmo",
+		TokenOutMinAmount: osmomath.OneInt(),
+	}
+	msg := createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSmartRouteSwapExactAmountIn)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSmartRouteSwapExactAmountIn
+		expectError bool
+	}{
+		"valid": {
+			msg: defaultValidMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.TokenIn.Amount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token in denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.TokenIn.Denom = "1"
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectError: true,
+		},
+		"same token in and out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.TokenOutDenom = msg.TokenIn.Denom
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero min amount": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSmartRouteSwapExactAmountIn) types.MsgSmartRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitSwapIntent(t *testing.T) {
+	defaultValidMsg := types.MsgSubmitSwapIntent{
+		Sender:            addr1,
+		TokenIn:           sdk.NewCoin("udai", osmomath.NewInt(10)),
+		TokenOutDenom:     "uosmo",
+		TokenOutMinAmount: osmomath.OneInt(),
+		Deadline:          time.Unix(1700000000, 0).UTC(),
+	}
+	msg := createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), types.TypeMsgSubmitSwapIntent)
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSubmitSwapIntent
+		expectError bool
+	}{
+		"valid": {
+			msg: defaultValidMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.TokenIn.Amount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectError: true,
+		},
+		"same token in and out denom": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.TokenOutDenom = msg.TokenIn.Denom
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero min amount": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"unset deadline": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSubmitSwapIntent) types.MsgSubmitSwapIntent {
+				msg.Deadline = time.Time{}
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
 	var (
 		validMultihopRouteOne = types.SwapAmountOutSplitRoute{
@@ -23,6 +23,8 @@ var (
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
 	KeyCommunityPoolDenomWhitelist                    = []byte("CommunityPoolDenomWhitelist")
 	KeyDailyStakingRewardsSmoothingFactor             = []byte("DailyStakingRewardsSmoothingFactor")
+	KeySmartRouterParams                              = []byte("SmartRouterParams")
+	KeySwapIntentParams                               = []byte("SwapIntentParams")
 
 	ZeroDec = osmomath.ZeroDec()
 	OneDec  = osmomath.OneDec()
@@ -54,6 +56,28 @@ func NewParams(poolCreationFee sdk.Coins,
 			DailyStakingRewardsSmoothingFactor:             dailyStakingRewardsSmoothingFactor,
 		},
 		AuthorizedQuoteDenoms: authorizedQuoteDenoms,
+		SmartRouterParams:     DefaultSmartRouterParams(),
+		SwapIntentParams:      DefaultSwapIntentParams(),
+	}
+}
+
+// DefaultSmartRouterParams are the default bounds of the on-chain smart order router.
+func DefaultSmartRouterParams() SmartRouterParams {
+	return SmartRouterParams{
+		MaxHops:        3,
+		MaxPools:       50,
+		MaxSplitRoutes: 3,
+		MaxGas:         5_000_000,
+	}
+}
+
+// DefaultSwapIntentParams are the default bounds of the swap intent settlement.
+func DefaultSwapIntentParams() SwapIntentParams {
+	return SwapIntentParams{
+		MaxIntentsPerBlock: 500,
+		MaxPairsPerBlock:   20,
+		MaxRoundsPerPair:   5,
+		MaxGas:             100_000_000,
 	}
 }
 
@@ -85,6 +109,8 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		SmartRouterParams: DefaultSmartRouterParams(),
+		SwapIntentParams:  DefaultSwapIntentParams(),
 	}
 }
 
@@ -120,6 +146,12 @@ func (p Params) Validate() error {
 	if err := validateDailyStakingRewardsSmoothingFactor(p.TakerFeeParams.DailyStakingRewardsSmoothingFactor); err != nil {
 		return err
 	}
+	if err := validateSmartRouterParams(p.SmartRouterParams); err != nil {
+		return err
+	}
+	if err := validateSwapIntentParams(p.SwapIntentParams); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -137,6 +169,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomWhitelist, &p.TakerFeeParams.CommunityPoolDenomWhitelist, validateCommunityPoolDenomWhitelist),
 		paramtypes.NewParamSetPair(KeyDailyStakingRewardsSmoothingFactor, &p.TakerFeeParams.DailyStakingRewardsSmoothingFactor, validateDailyStakingRewardsSmoothingFactor),
+		paramtypes.NewParamSetPair(KeySmartRouterParams, &p.SmartRouterParams, validateSmartRouterParams),
+		paramtypes.NewParamSetPair(KeySwapIntentParams, &p.SwapIntentParams, validateSwapIntentParams),
 	}
 }
 
@@ -315,3 +349,52 @@ func validateDailyStakingRewardsSmoothingFactor(i interface{}) error {
 
 	return nil
 }
+
+func validateSmartRouterParams(i interface{}) error {
+	smartRouterParams, ok := i.(SmartRouterParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if smartRouterParams.MaxHops == 0 {
+		return fmt.Errorf("smart router max hops must be greater than 0")
+	}
+	if smartRouterParams.MaxPools == 0 {
+		return fmt.Errorf("smart router max pools must be greater than 0")
+	}
+	if smartRouterParams.MaxSplitRoutes == 0 {
+		return fmt.Errorf("smart router max split routes must be greater than 0")
+	}
+	if smartRouterParams.MaxGas == 0 {
+		return fmt.Errorf("smart router max gas must be greater than 0")
+	}
+
+	return nil
+}
+
+func validateSwapIntentParams(i interface{}) error {
+	swapIntentParams, ok := i.(SwapIntentParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if swapIntentParams.MaxIntentsPerBlock == 0 {
+		return fmt.Errorf("swap intent max intents per block must be greater than 0")
+	}
+	if swapIntentParams.MaxPairsPerBlock == 0 {
+		return fmt.Errorf("swap intent max pairs per block must be greater than 0")
+	}
+	if swapIntentParams.MaxRoundsPerPair == 0 {
+		return fmt.Errorf("swap intent max rounds per pair must be greater than 0")
+	}
+	// The gas budget bounds the route searches of every round of every pair settled in the block,
+	// since the settlement runs in EndBlock where gas is not otherwise limited.
+	if swapIntentParams.MaxGas == 0 {
+		return fmt.Errorf("swap intent max gas must be greater than 0")
+	}
+	if swapIntentParams.MaxGas > MaxSwapIntentSettlementGas {
+		return fmt.Errorf("swap intent max gas must be at most %d, was %d", MaxSwapIntentSettlementGas, swapIntentParams.MaxGas)
+	}
+
+	return nil
+}
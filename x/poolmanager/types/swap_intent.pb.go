@@ -0,0 +1,906 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/poolmanager/v1beta1/swap_intent.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwapIntentStatus is the lifecycle state of a swap intent.
+type SwapIntentStatus int32
+
+const (
+	// SwapIntentPending is an intent included in the current block that has not
+	// been settled yet.
+	SwapIntentPending SwapIntentStatus = 0
+	// SwapIntentSettled is an intent that was settled at its pair's clearing
+	// price at the end of the block it was included in.
+	SwapIntentSettled SwapIntentStatus = 1
+	// SwapIntentRefunded is an intent whose min amount out could not be met at
+	// its pair's clearing price, and whose token in was returned to the sender.
+	SwapIntentRefunded SwapIntentStatus = 2
+)
+
+var SwapIntentStatus_name = map[int32]string{
+	0: "SwapIntentPending",
+	1: "SwapIntentSettled",
+	2: "SwapIntentRefunded",
+}
+
+var SwapIntentStatus_value = map[string]int32{
+	"SwapIntentPending":  0,
+	"SwapIntentSettled":  1,
+	"SwapIntentRefunded": 2,
+}
+
+func (x SwapIntentStatus) String() string {
+	return proto.EnumName(SwapIntentStatus_name, int32(x))
+}
+
+func (SwapIntentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d935fed8203f826, []int{0}
+}
+
+// SwapIntent is a signed request to swap token_in for at least
+// token_out_min_amount of token_out_denom. Intents included in a block are
+// settled together at the end of that block, at one uniform clearing price per
+// denom pair.
+type SwapIntent struct {
+	IntentId          uint64                `protobuf:"varint,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty" yaml:"intent_id"`
+	Sender            string                `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                `protobuf:"bytes,4,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	Deadline          time.Time             `protobuf:"bytes,6,opt,name=deadline,proto3,stdtime" json:"deadline" yaml:"deadline"`
+	BlockHeight       int64                 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Status            SwapIntentStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=osmosis.poolmanager.v1beta1.SwapIntentStatus" json:"status,omitempty" yaml:"status"`
+	// token_out is the amount received by the sender once settled.
+	TokenOut types.Coin `protobuf:"bytes,9,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	// token_in_refund is the part of token_in returned to the sender, either
+	// because it was not needed to clear the pair or because the intent was
+	// refunded.
+	TokenInRefund types.Coin `protobuf:"bytes,10,opt,name=token_in_refund,json=tokenInRefund,proto3" json:"token_in_refund" yaml:"token_in_refund"`
+	// clearing_price is the amount of token out per token in the pair was
+	// settled at. Zero for pending and refunded intents.
+	ClearingPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=clearing_price,json=clearingPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"clearing_price" yaml:"clearing_price"`
+	// refund_if_unsettled is set if the intent is refunded rather than kept
+	// pending when it is not settled in the block it was included in.
+	RefundIfUnsettled bool `protobuf:"varint,12,opt,name=refund_if_unsettled,json=refundIfUnsettled,proto3" json:"refund_if_unsettled,omitempty" yaml:"refund_if_unsettled"`
+}
+
+func (m *SwapIntent) Reset()         { *m = SwapIntent{} }
+func (m *SwapIntent) String() string { return proto.CompactTextString(m) }
+func (*SwapIntent) ProtoMessage()    {}
+func (*SwapIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d935fed8203f826, []int{0}
+}
+func (m *SwapIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapIntent.Merge(m, src)
+}
+func (m *SwapIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapIntent proto.InternalMessageInfo
+
+func (m *SwapIntent) GetIntentId() uint64 {
+	if m != nil {
+		return m.IntentId
+	}
+	return 0
+}
+
+func (m *SwapIntent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SwapIntent) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *SwapIntent) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *SwapIntent) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func (m *SwapIntent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SwapIntent) GetStatus() SwapIntentStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SwapIntentPending
+}
+
+func (m *SwapIntent) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *SwapIntent) GetTokenInRefund() types.Coin {
+	if m != nil {
+		return m.TokenInRefund
+	}
+	return types.Coin{}
+}
+
+func (m *SwapIntent) GetRefundIfUnsettled() bool {
+	if m != nil {
+		return m.RefundIfUnsettled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.poolmanager.v1beta1.SwapIntentStatus", SwapIntentStatus_name, SwapIntentStatus_value)
+	proto.RegisterType((*SwapIntent)(nil), "osmosis.poolmanager.v1beta1.SwapIntent")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/poolmanager/v1beta1/swap_intent.proto", fileDescriptor_2d935fed8203f826)
+}
+
+var fileDescriptor_2d935fed8203f826 = []byte{
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0x92, 0x26, 0xdb, 0xbf, 0x64, 0xfb, 0x67, 0x12, 0xc9, 0x8e, 0x7c, 0x0a, 0x48,
+	0xb5, 0x95, 0xf6, 0x80, 0x54, 0x71, 0xc1, 0xf4, 0x40, 0x24, 0x0a, 0xc5, 0x01, 0x09, 0x71, 0xc0,
+	0xda, 0xd8, 0x1b, 0x67, 0x55, 0x7b, 0x37, 0x8a, 0xd7, 0x2d, 0x7d, 0x03, 0x8e, 0x7d, 0x07, 0x9e,
+	0x84, 0x5b, 0x8f, 0x3d, 0x22, 0x0e, 0x06, 0xb5, 0x6f, 0xe0, 0x27, 0x40, 0xd9, 0xb5, 0x93, 0xa6,
+	0x20, 0x7a, 0x9b, 0xf9, 0xf6, 0x9b, 0x6f, 0xc6, 0x33, 0x9f, 0x0c, 0xf6, 0x58, 0x1c, 0xb1, 0x98,
+	0xc4, 0xd6, 0x88, 0xb1, 0x30, 0x42, 0x14, 0x05, 0x78, 0x6c, 0x9d, 0x75, 0xfa, 0x98, 0xa3, 0x8e,
+	0x15, 0x9f, 0xa3, 0x91, 0x4b, 0x28, 0xc7, 0x94, 0x9b, 0xa3, 0x31, 0xe3, 0x0c, 0x36, 0x73, 0xba,
+	0x79, 0x87, 0x6e, 0xe6, 0xf4, 0xc6, 0x56, 0xc0, 0x02, 0x26, 0x78, 0xd6, 0x24, 0x92, 0x25, 0x0d,
+	0x3d, 0x60, 0x2c, 0x08, 0xb1, 0x25, 0xb2, 0x7e, 0x32, 0xb0, 0x38, 0x89, 0x70, 0xcc, 0x51, 0x34,
+	0xca, 0x09, 0x9a, 0x27, 0x44, 0xad, 0x3e, 0x8a, 0xf1, 0xb4, 0xb5, 0xc7, 0x08, 0x95, 0xef, 0xc6,
+	0xf7, 0x65, 0x00, 0x7a, 0xe7, 0x68, 0xd4, 0x15, 0x83, 0xc0, 0x0e, 0xa8, 0xca, 0x91, 0x5c, 0xe2,
+	0xab, 0x4a, 0x4b, 0x69, 0x2f, 0xd9, 0x5b, 0x59, 0xaa, 0xd7, 0x2e, 0x50, 0x14, 0x1e, 0x1a, 0xd3,
+	0x27, 0xc3, 0xa9, 0xc8, 0xb8, 0xeb, 0xc3, 0x27, 0xa0, 0x1c, 0x63, 0xea, 0xe3, 0xb1, 0xba, 0xd0,
+	0x52, 0xda, 0x55, 0xbb, 0x9e, 0xa5, 0xfa, 0x9a, 0xe4, 0x4b, 0xdc, 0x70, 0x72, 0x02, 0x3c, 0x06,
+	0x15, 0xce, 0x4e, 0x31, 0x75, 0x09, 0x55, 0x17, 0x5b, 0x4a, 0x7b, 0x65, 0xff, 0xb1, 0x29, 0xe7,
+	0x33, 0x27, 0xf3, 0x15, 0xdf, 0x6a, 0xbe, 0x64, 0x84, 0xda, 0xbb, 0x57, 0xa9, 0x5e, 0xca, 0x52,
+	0x7d, 0x43, 0x6a, 0x15, 0x85, 0x86, 0xb3, 0x2c, 0xc2, 0x2e, 0x85, 0x36, 0xd8, 0x90, 0x28, 0x4b,
+	0xb8, 0xeb, 0x63, 0xca, 0x22, 0x75, 0x49, 0x8c, 0xd0, 0xc8, 0x52, 0x7d, 0xe7, 0x6e, 0xd9, 0x94,
+	0x60, 0x38, 0x6b, 0x02, 0x79, 0x9b, 0xf0, 0xa3, 0x49, 0x0e, 0x23, 0xb0, 0x35, 0xa3, 0x44, 0x84,
+	0xba, 0x28, 0x62, 0x09, 0xe5, 0xea, 0x23, 0x21, 0xf4, 0x7c, 0x32, 0xc3, 0xcf, 0x54, 0xdf, 0x96,
+	0x53, 0xc6, 0xfe, 0xa9, 0x49, 0x98, 0x15, 0x21, 0x3e, 0x34, 0xbb, 0x94, 0x67, 0xa9, 0xde, 0xbc,
+	0xdf, 0x65, 0x26, 0x61, 0x38, 0xf5, 0xa2, 0xd5, 0x31, 0xa1, 0x2f, 0x04, 0x06, 0x7b, 0xa0, 0xe2,
+	0x63, 0xe4, 0x87, 0x84, 0x62, 0xb5, 0x2c, 0x36, 0xd0, 0x30, 0xe5, 0x09, 0xcd, 0xe2, 0x84, 0xe6,
+	0xfb, 0xe2, 0x84, 0x76, 0x73, 0x7e, 0x05, 0x45, 0xa5, 0x71, 0xf9, 0x4b, 0x57, 0x9c, 0xa9, 0x10,
+	0x3c, 0x04, 0xab, 0xfd, 0x90, 0x79, 0xa7, 0xee, 0x10, 0x93, 0x60, 0xc8, 0xd5, 0xe5, 0x96, 0xd2,
+	0x5e, 0xb4, 0x77, 0xb3, 0x54, 0xdf, 0x94, 0x85, 0x77, 0x5f, 0x0d, 0x67, 0x45, 0xa4, 0xaf, 0x44,
+	0x06, 0x3f, 0x82, 0x72, 0xcc, 0x11, 0x4f, 0x62, 0xb5, 0xd2, 0x52, 0xda, 0xeb, 0xfb, 0x7b, 0xe6,
+	0x7f, 0x4c, 0x68, 0xce, 0x9c, 0xd2, 0x13, 0x45, 0x73, 0xc7, 0x16, 0xc8, 0xe4, 0xd8, 0x22, 0x80,
+	0x27, 0xa0, 0x3a, 0x5d, 0x8b, 0x5a, 0x7d, 0xe8, 0xda, 0x6a, 0xfe, 0xa9, 0xb5, 0x7b, 0x0b, 0x35,
+	0x9c, 0x4a, 0xb1, 0x45, 0x88, 0x8a, 0x7b, 0x13, 0xea, 0x8e, 0xf1, 0x20, 0xa1, 0xbe, 0x0a, 0x1e,
+	0xd2, 0xd5, 0x72, 0xdd, 0x9d, 0x79, 0x17, 0xe5, 0xf5, 0x85, 0x1d, 0xba, 0xd4, 0x11, 0x39, 0xf4,
+	0xc0, 0xba, 0x17, 0x62, 0x34, 0x26, 0x34, 0x70, 0x47, 0x63, 0xe2, 0x61, 0x75, 0x65, 0xce, 0x08,
+	0xcd, 0xbf, 0x8d, 0xf0, 0x1a, 0x07, 0xc8, 0xbb, 0x38, 0xc2, 0x5e, 0x96, 0xea, 0xdb, 0xb2, 0xcb,
+	0xbc, 0x84, 0xe1, 0xac, 0x15, 0xc0, 0xc9, 0x24, 0x87, 0x6f, 0xc0, 0xa6, 0x6c, 0xef, 0x92, 0x81,
+	0x9b, 0xd0, 0x18, 0x73, 0x1e, 0x62, 0x5f, 0x5d, 0x6d, 0x29, 0xed, 0x8a, 0xad, 0x65, 0xa9, 0xde,
+	0x90, 0x32, 0xff, 0x20, 0x19, 0x4e, 0x5d, 0xa2, 0xdd, 0xc1, 0x87, 0x02, 0x7b, 0xfa, 0x19, 0xd4,
+	0xee, 0x1f, 0x06, 0x6e, 0x83, 0xfa, 0x0c, 0x3b, 0xc1, 0xd4, 0x27, 0x34, 0xa8, 0x95, 0xe6, 0xe1,
+	0x9e, 0xac, 0xaf, 0x29, 0x70, 0x07, 0xc0, 0x19, 0x2c, 0x57, 0x81, 0xfd, 0xda, 0x42, 0x63, 0xe9,
+	0xeb, 0x37, 0xad, 0x64, 0xbf, 0xbb, 0xba, 0xd1, 0x94, 0xeb, 0x1b, 0x4d, 0xf9, 0x7d, 0xa3, 0x29,
+	0x97, 0xb7, 0x5a, 0xe9, 0xfa, 0x56, 0x2b, 0xfd, 0xb8, 0xd5, 0x4a, 0x9f, 0x9e, 0x05, 0x84, 0x0f,
+	0x93, 0xbe, 0xe9, 0xb1, 0xc8, 0xca, 0x7d, 0xb3, 0x17, 0xa2, 0x7e, 0x5c, 0x24, 0xd6, 0xd9, 0x41,
+	0xc7, 0xfa, 0x32, 0xf7, 0xfb, 0xe3, 0x17, 0x23, 0x1c, 0xf7, 0xcb, 0xc2, 0xed, 0x07, 0x7f, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x23, 0xd0, 0x6a, 0x5f, 0x22, 0x05, 0x00, 0x00,
+}
+
+func (m *SwapIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundIfUnsettled {
+		i--
+		if m.RefundIfUnsettled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.ClearingPrice.Size()
+		i -= size
+		if _, err := m.ClearingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.TokenInRefund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintSwapIntent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintSwapIntent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSwapIntent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwapIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintSwapIntent(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSwapIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSwapIntent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IntentId != 0 {
+		i = encodeVarintSwapIntent(dAtA, i, uint64(m.IntentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwapIntent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwapIntent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntentId != 0 {
+		n += 1 + sovSwapIntent(uint64(m.IntentId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSwapIntent(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovSwapIntent(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovSwapIntent(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovSwapIntent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovSwapIntent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovSwapIntent(uint64(m.BlockHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSwapIntent(uint64(m.Status))
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovSwapIntent(uint64(l))
+	l = m.TokenInRefund.Size()
+	n += 1 + l + sovSwapIntent(uint64(l))
+	l = m.ClearingPrice.Size()
+	n += 1 + l + sovSwapIntent(uint64(l))
+	if m.RefundIfUnsettled {
+		n += 2
+	}
+	return n
+}
+
+func sovSwapIntent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwapIntent(x uint64) (n int) {
+	return sovSwapIntent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwapIntent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentId", wireType)
+			}
+			m.IntentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapIntentStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundIfUnsettled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundIfUnsettled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwapIntent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwapIntent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwapIntent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwapIntent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwapIntent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwapIntent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwapIntent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwapIntent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwapIntent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwapIntent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwapIntent = fmt.Errorf("proto: unexpected end of group")
+)
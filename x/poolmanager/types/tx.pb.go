@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -232,6 +236,252 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
 
+// ===================== MsgSmartRouteSwapExactAmountIn
+// MsgSmartRouteSwapExactAmountIn swaps token_in for at least
+// token_out_min_amount of token_out_denom, along routes found on-chain.
+// The routes are searched for through all pools containing the denoms along
+// the way, bounded by the module's smart router params, and token_in is split
+// across the routes that give the most token out.
+type MsgSmartRouteSwapExactAmountIn struct {
+	Sender            string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin            `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSmartRouteSwapExactAmountIn) Reset()         { *m = MsgSmartRouteSwapExactAmountIn{} }
+func (m *MsgSmartRouteSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSmartRouteSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSmartRouteSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{4}
+}
+func (m *MsgSmartRouteSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSmartRouteSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSmartRouteSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSmartRouteSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSmartRouteSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSmartRouteSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSmartRouteSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSmartRouteSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSmartRouteSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSmartRouteSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSmartRouteSwapExactAmountIn) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSmartRouteSwapExactAmountIn) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type MsgSmartRouteSwapExactAmountInResponse struct {
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// routes are the routes the swap was executed along.
+	Routes []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *MsgSmartRouteSwapExactAmountInResponse) Reset() {
+	*m = MsgSmartRouteSwapExactAmountInResponse{}
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSmartRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSmartRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{5}
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSmartRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSmartRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSmartRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSmartRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
+func (m *MsgSmartRouteSwapExactAmountInResponse) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// ===================== MsgSubmitSwapIntent
+// MsgSubmitSwapIntent escrows token_in and records a swap intent for the
+// current block. All intents of a block are settled at the end of the block at
+// one uniform clearing price per denom pair, matching opposite intents against
+// each other before routing the remainder through the pools. Intents whose
+// token_out_min_amount cannot be met are refunded.
+type MsgSubmitSwapIntent struct {
+	Sender            string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin            `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// deadline is the latest block time at which the intent may be included.
+	Deadline time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline" yaml:"deadline"`
+	// refund_if_unsettled refunds the intent at the end of the block it is
+	// included in if the settlement limits of that block are reached before it
+	// is settled, instead of keeping it pending for the following blocks.
+	RefundIfUnsettled bool `protobuf:"varint,6,opt,name=refund_if_unsettled,json=refundIfUnsettled,proto3" json:"refund_if_unsettled,omitempty" yaml:"refund_if_unsettled"`
+}
+
+func (m *MsgSubmitSwapIntent) Reset()         { *m = MsgSubmitSwapIntent{} }
+func (m *MsgSubmitSwapIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSwapIntent) ProtoMessage()    {}
+func (*MsgSubmitSwapIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{6}
+}
+func (m *MsgSubmitSwapIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSwapIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSwapIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSwapIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSwapIntent.Merge(m, src)
+}
+func (m *MsgSubmitSwapIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSwapIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSwapIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSwapIntent proto.InternalMessageInfo
+
+func (m *MsgSubmitSwapIntent) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitSwapIntent) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgSubmitSwapIntent) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *MsgSubmitSwapIntent) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+func (m *MsgSubmitSwapIntent) GetRefundIfUnsettled() bool {
+	if m != nil {
+		return m.RefundIfUnsettled
+	}
+	return false
+}
+
+type MsgSubmitSwapIntentResponse struct {
+	IntentId uint64 `protobuf:"varint,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty" yaml:"intent_id"`
+}
+
+func (m *MsgSubmitSwapIntentResponse) Reset()         { *m = MsgSubmitSwapIntentResponse{} }
+func (m *MsgSubmitSwapIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSwapIntentResponse) ProtoMessage()    {}
+func (*MsgSubmitSwapIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{7}
+}
+func (m *MsgSubmitSwapIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSwapIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSwapIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitSwapIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSwapIntentResponse.Merge(m, src)
+}
+func (m *MsgSubmitSwapIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSwapIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSwapIntentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitSwapIntentResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitSwapIntentResponse) GetIntentId() uint64 {
+	if m != nil {
+		return m.IntentId
+	}
+	return 0
+}
+
 // ===================== MsgSwapExactAmountOut
 type MsgSwapExactAmountOut struct {
 	Sender           string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -244,7 +494,7 @@ func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
 func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{4}
+	return fileDescriptor_acd130b4825d67dc, []int{8}
 }
 func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +552,7 @@ func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmoun
 func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{5}
+	return fileDescriptor_acd130b4825d67dc, []int{9}
 }
 func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +593,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Reset()         { *m = MsgSplitRouteSw
 func (m *MsgSplitRouteSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSplitRouteSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSplitRouteSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{6}
+	return fileDescriptor_acd130b4825d67dc, []int{10}
 }
 func (m *MsgSplitRouteSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +653,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Reset() {
 func (m *MsgSplitRouteSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSplitRouteSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSplitRouteSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{7}
+	return fileDescriptor_acd130b4825d67dc, []int{11}
 }
 func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +692,7 @@ func (m *MsgSetDenomPairTakerFee) Reset()         { *m = MsgSetDenomPairTakerFee
 func (m *MsgSetDenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFee) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{8}
+	return fileDescriptor_acd130b4825d67dc, []int{12}
 }
 func (m *MsgSetDenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +743,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Reset()         { *m = MsgSetDenomPair
 func (m *MsgSetDenomPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFeeResponse) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{9}
+	return fileDescriptor_acd130b4825d67dc, []int{13}
 }
 func (m *MsgSetDenomPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +800,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenom) Reset()         { *m = MsgSetTake
 func (m *MsgSetTakerFeeShareAgreementForDenom) String() string { return proto.CompactTextString(m) }
 func (*MsgSetTakerFeeShareAgreementForDenom) ProtoMessage()    {}
 func (*MsgSetTakerFeeShareAgreementForDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{10}
+	return fileDescriptor_acd130b4825d67dc, []int{14}
 }
 func (m *MsgSetTakerFeeShareAgreementForDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +861,7 @@ func (m *MsgSetTakerFeeShareAgreementForDenomResponse) String() string {
 }
 func (*MsgSetTakerFeeShareAgreementForDenomResponse) ProtoMessage() {}
 func (*MsgSetTakerFeeShareAgreementForDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{11}
+	return fileDescriptor_acd130b4825d67dc, []int{15}
 }
 func (m *MsgSetTakerFeeShareAgreementForDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +903,7 @@ func (m *MsgSetRegisteredAlloyedPool) Reset()         { *m = MsgSetRegisteredAll
 func (m *MsgSetRegisteredAlloyedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgSetRegisteredAlloyedPool) ProtoMessage()    {}
 func (*MsgSetRegisteredAlloyedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{12}
+	return fileDescriptor_acd130b4825d67dc, []int{16}
 }
 func (m *MsgSetRegisteredAlloyedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +953,7 @@ func (m *MsgSetRegisteredAlloyedPoolResponse) Reset()         { *m = MsgSetRegis
 func (m *MsgSetRegisteredAlloyedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetRegisteredAlloyedPoolResponse) ProtoMessage()    {}
 func (*MsgSetRegisteredAlloyedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{13}
+	return fileDescriptor_acd130b4825d67dc, []int{17}
 }
 func (m *MsgSetRegisteredAlloyedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +997,7 @@ func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
 func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*DenomPairTakerFee) ProtoMessage()    {}
 func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{14}
+	return fileDescriptor_acd130b4825d67dc, []int{18}
 }
 func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,6 +1061,10 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountIn")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSmartRouteSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSmartRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSmartRouteSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSmartRouteSwapExactAmountInResponse")
+	proto.RegisterType((*MsgSubmitSwapIntent)(nil), "osmosis.poolmanager.v1beta1.MsgSubmitSwapIntent")
+	proto.RegisterType((*MsgSubmitSwapIntentResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSubmitSwapIntentResponse")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
@@ -829,89 +1083,105 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0x98, 0x10, 0xd8, 0x24, 0x04, 0x2b, 0x50, 0x1c, 0x93, 0x5a, 0x54, 0x90, 0x94, 0xa4,
-	0x48, 0x8e, 0x81, 0x99, 0x10, 0x43, 0x27, 0xc1, 0xa1, 0x99, 0x61, 0x1a, 0x0f, 0x44, 0xc9, 0xa9,
-	0x33, 0x1d, 0xcf, 0xda, 0xda, 0x18, 0x15, 0x4b, 0xeb, 0x91, 0xd6, 0x04, 0x6e, 0x6d, 0x9a, 0x69,
-	0xa7, 0x4c, 0x0f, 0x3d, 0xf5, 0xda, 0x99, 0x7e, 0x82, 0xe4, 0xd2, 0xcf, 0x90, 0x63, 0x8e, 0x9d,
-	0x1e, 0x3c, 0x2d, 0x1c, 0xd2, 0xb3, 0x3f, 0x41, 0x67, 0xb5, 0x2b, 0xd9, 0x16, 0xb2, 0x6c, 0xc3,
-	0x94, 0x4b, 0x22, 0x69, 0xdf, 0xef, 0xfd, 0xf9, 0xbd, 0xdf, 0xbe, 0x5d, 0x03, 0xe6, 0xb0, 0x63,
-	0x62, 0xc7, 0x70, 0xd2, 0x55, 0x8c, 0x2b, 0x26, 0xb4, 0x60, 0x19, 0xd9, 0xe9, 0xbd, 0x4c, 0x11,
-	0x11, 0x98, 0x49, 0x93, 0x7d, 0xb5, 0x6a, 0x63, 0x82, 0xc5, 0x69, 0x6e, 0xa5, 0xb6, 0x58, 0xa9,
-	0xdc, 0x2a, 0x39, 0x51, 0xc6, 0x65, 0xec, 0xda, 0xa5, 0xe9, 0x13, 0x83, 0x24, 0xe3, 0xd0, 0x34,
-	0x2c, 0x9c, 0x76, 0xff, 0xe5, 0x9f, 0x52, 0x25, 0xd7, 0x4d, 0xba, 0x08, 0x1d, 0xe4, 0xc7, 0x28,
-	0x61, 0xc3, 0xe2, 0xeb, 0x0b, 0x51, 0xb9, 0x38, 0x2f, 0x61, 0xb5, 0x60, 0xe3, 0x1a, 0x41, 0xdc,
-	0x7a, 0x8a, 0x7b, 0x33, 0x9d, 0x72, 0x7a, 0x2f, 0x43, 0xff, 0x63, 0x0b, 0xf2, 0x8f, 0x31, 0x30,
-	0x91, 0x77, 0xca, 0xcf, 0x5e, 0xc2, 0xea, 0x17, 0xfb, 0xb0, 0x44, 0xd6, 0x4d, 0x5c, 0xb3, 0xc8,
-	0xa6, 0x25, 0xde, 0x06, 0xc3, 0x0e, 0xb2, 0x74, 0x64, 0x27, 0x84, 0x19, 0x61, 0x7e, 0x34, 0x17,
-	0x6f, 0xd4, 0xa5, 0x2b, 0x07, 0xd0, 0xac, 0x64, 0x65, 0xf6, 0x5d, 0xd6, 0xb8, 0x81, 0xf8, 0x04,
-	0x0c, 0xbb, 0xb1, 0x9c, 0xc4, 0xe0, 0x4c, 0x6c, 0xfe, 0xd2, 0xa2, 0xaa, 0x46, 0x30, 0xa0, 0xd2,
-	0x50, 0x5e, 0x14, 0x8d, 0xc2, 0x72, 0x43, 0xef, 0xea, 0xd2, 0x80, 0xc6, 0x7d, 0x88, 0x79, 0x30,
-	0x42, 0xf0, 0x2e, 0xb2, 0x0a, 0x86, 0x95, 0x88, 0xcd, 0x08, 0xf3, 0x97, 0x16, 0xaf, 0xab, 0x2c,
-	0x7b, 0x95, 0x72, 0xe1, 0xfb, 0x79, 0x84, 0x0d, 0x2b, 0x37, 0x45, 0xa1, 0x8d, 0xba, 0x74, 0x95,
-	0x65, 0xe6, 0x01, 0x65, 0xed, 0xa2, 0xfb, 0xb8, 0x69, 0x89, 0x26, 0x98, 0x60, 0x5f, 0x71, 0x8d,
-	0x14, 0x4c, 0xc3, 0x2a, 0x40, 0x37, 0x76, 0x62, 0xc8, 0xad, 0x6a, 0x8d, 0xe2, 0xff, 0xaa, 0x4b,
-	0x93, 0x2c, 0x82, 0xa3, 0xef, 0xaa, 0x06, 0x4e, 0x9b, 0x90, 0xec, 0xa8, 0x9b, 0x16, 0x69, 0xd4,
-	0xa5, 0xe9, 0x56, 0xc7, 0xed, 0x2e, 0x64, 0x2d, 0xee, 0x7e, 0xde, 0xaa, 0x91, 0xbc, 0x61, 0xb1,
-	0x92, 0xb2, 0x2b, 0xaf, 0x3e, 0xbc, 0xb9, 0xc3, 0x89, 0x39, 0xfc, 0xf0, 0xe6, 0xce, 0x7c, 0x58,
-	0x9b, 0x68, 0x7b, 0x14, 0x44, 0xe9, 0x56, 0x98, 0x2b, 0xc5, 0xb0, 0xe4, 0x57, 0x02, 0xb8, 0x11,
-	0xd6, 0x09, 0x0d, 0x39, 0x55, 0x6c, 0x39, 0x48, 0x2c, 0x82, 0xf1, 0x66, 0x1a, 0xbc, 0x0a, 0xd6,
-	0x9b, 0x95, 0x6e, 0x55, 0x4c, 0x05, 0xab, 0xf0, 0x2a, 0x18, 0xf3, 0x2a, 0x60, 0xd1, 0xe4, 0xef,
-	0x63, 0x20, 0x45, 0x93, 0xa8, 0x56, 0x0c, 0xe2, 0x36, 0xe7, 0x4c, 0xc2, 0x78, 0x1a, 0x10, 0xc6,
-	0x52, 0xcf, 0xc2, 0x68, 0x26, 0x10, 0x50, 0xc7, 0x03, 0x30, 0xe6, 0x35, 0xb9, 0xa0, 0x23, 0x0b,
-	0x9b, 0xae, 0x46, 0x46, 0x73, 0xd7, 0x1b, 0x75, 0x69, 0xb2, 0x5d, 0x04, 0x6c, 0x5d, 0xd6, 0x2e,
-	0x73, 0x29, 0x6c, 0xd0, 0xd7, 0xf3, 0xd6, 0xc3, 0x52, 0x40, 0x0f, 0xb3, 0xa1, 0x7a, 0xa0, 0xd5,
-	0xb6, 0x48, 0xe1, 0x67, 0x01, 0xdc, 0x8a, 0xee, 0xc2, 0xb9, 0x8a, 0xe2, 0x30, 0x06, 0x26, 0x4f,
-	0x2a, 0x73, 0xab, 0x46, 0xfa, 0xd1, 0x42, 0x3e, 0xa0, 0x85, 0x74, 0x8f, 0x5a, 0xd8, 0xaa, 0x85,
-	0xea, 0xe0, 0x1b, 0x70, 0xcd, 0xef, 0xb3, 0x09, 0xf7, 0xbd, 0xd2, 0x99, 0x18, 0x56, 0xbb, 0x95,
-	0x9e, 0x0c, 0x28, 0xa5, 0xe9, 0x41, 0xd6, 0xc6, 0xb9, 0x5c, 0xf2, 0x70, 0x9f, 0x65, 0x20, 0x6e,
-	0x83, 0x51, 0x9f, 0x24, 0x57, 0x27, 0x91, 0x23, 0x29, 0xc1, 0x47, 0xd2, 0x78, 0x80, 0x5e, 0x59,
-	0x1b, 0xf1, 0x78, 0xcd, 0xde, 0x0f, 0xa8, 0xe2, 0x76, 0x6f, 0x53, 0x82, 0x7a, 0xf9, 0x56, 0x00,
-	0x1f, 0x87, 0x36, 0xc3, 0x97, 0x44, 0x01, 0x5c, 0xf5, 0x0b, 0x6b, 0x53, 0xc4, 0xbd, 0x6e, 0xb4,
-	0x7c, 0x14, 0xa0, 0xc5, 0xa3, 0xe4, 0x0a, 0xa7, 0x84, 0xeb, 0xe1, 0x87, 0x18, 0x90, 0xa2, 0xe4,
-	0xd9, 0xa7, 0x32, 0xb4, 0x80, 0x32, 0x96, 0x7b, 0x57, 0x46, 0xc7, 0x31, 0x91, 0xf3, 0x38, 0xa0,
-	0xba, 0x6e, 0x9d, 0x13, 0xc9, 0x60, 0x99, 0xbe, 0x81, 0x57, 0xe6, 0x56, 0x8d, 0xb0, 0x49, 0xd1,
-	0x41, 0x62, 0x43, 0xff, 0x83, 0xc4, 0xb2, 0xcb, 0x01, 0x41, 0xcc, 0x75, 0x1d, 0x13, 0x54, 0x0b,
-	0x87, 0x02, 0xf8, 0xb4, 0x4b, 0x23, 0xce, 0x4f, 0x15, 0x3f, 0x0d, 0x82, 0x29, 0x9a, 0x0c, 0x62,
-	0xf4, 0x6d, 0x43, 0xc3, 0x7e, 0x0e, 0x77, 0x91, 0xfd, 0x18, 0xa1, 0x7e, 0xd4, 0xf0, 0x5a, 0x00,
-	0x13, 0x6e, 0x3f, 0x0a, 0x55, 0x68, 0xd8, 0x05, 0x42, 0x5d, 0x14, 0x5e, 0x20, 0xd4, 0xd3, 0xdd,
-	0xe2, 0x44, 0xe4, 0xdc, 0x2c, 0xdf, 0x8d, 0x7c, 0x6e, 0x87, 0x79, 0x96, 0xb5, 0xb8, 0x1e, 0xc4,
-	0x65, 0xd7, 0x02, 0x0d, 0x09, 0xbd, 0x6e, 0x39, 0x88, 0x28, 0x2e, 0x54, 0xa1, 0x1e, 0x15, 0xd7,
-	0xa3, 0x42, 0x3d, 0xae, 0xb2, 0x0d, 0x12, 0x42, 0x85, 0xdf, 0x8f, 0x04, 0xb8, 0xe8, 0xd4, 0x4a,
-	0x25, 0xe4, 0x38, 0x2e, 0x27, 0x23, 0x9a, 0xf7, 0x2a, 0xff, 0x33, 0x08, 0xe6, 0x18, 0xda, 0x03,
-	0x3d, 0xdb, 0x81, 0x36, 0x5a, 0x2f, 0xdb, 0x08, 0x99, 0xc8, 0x22, 0x8f, 0xb1, 0xcd, 0x04, 0xda,
-	0x07, 0xab, 0xb7, 0xc0, 0x05, 0xb6, 0x0b, 0x06, 0x5d, 0xcb, 0xf1, 0x46, 0x5d, 0xba, 0xdc, 0xc2,
-	0x88, 0xac, 0xb1, 0x65, 0xf1, 0x6b, 0x70, 0xd9, 0xd9, 0x35, 0xcc, 0x42, 0x15, 0xd9, 0x25, 0xe4,
-	0xcf, 0xd3, 0x2c, 0x97, 0xc8, 0xf4, 0x49, 0x89, 0x3c, 0x41, 0x65, 0x58, 0x3a, 0xd8, 0x40, 0xa5,
-	0x46, 0x5d, 0xba, 0xc6, 0x63, 0xb7, 0x38, 0x90, 0xb5, 0x4b, 0xf4, 0x75, 0x9b, 0xbd, 0x89, 0x59,
-	0xee, 0x1e, 0xea, 0xba, 0x4d, 0x2b, 0x67, 0x7b, 0x69, 0x2a, 0x80, 0xe5, 0xab, 0x1c, 0xbb, 0xce,
-	0xde, 0xb2, 0x5f, 0x06, 0x3a, 0xb2, 0xda, 0xa9, 0x23, 0x7e, 0x1b, 0x14, 0x87, 0xf2, 0xa6, 0x40,
-	0x8f, 0x38, 0xe5, 0x05, 0xb6, 0x59, 0xbf, 0x64, 0x15, 0x2c, 0xf4, 0x42, 0xb1, 0xd7, 0x2d, 0xf9,
-	0x0f, 0x01, 0x4c, 0x33, 0x80, 0x86, 0xca, 0x86, 0x43, 0x90, 0x8d, 0xf4, 0xf5, 0x4a, 0x05, 0x1f,
-	0x20, 0x7d, 0x1b, 0xe3, 0x4a, 0x3f, 0xad, 0xf8, 0x0c, 0x5c, 0xa4, 0x19, 0x17, 0x0c, 0xdd, 0x6d,
-	0xc6, 0x50, 0x4e, 0x6c, 0xd4, 0xa5, 0x31, 0x66, 0xcb, 0x17, 0x64, 0x6d, 0x98, 0x3e, 0x6d, 0xea,
-	0xd9, 0x07, 0x81, 0xa2, 0xd3, 0x9d, 0x8a, 0xb6, 0xfd, 0xb4, 0x14, 0xc8, 0xf2, 0x52, 0xa8, 0x89,
-	0x7c, 0x13, 0xcc, 0x46, 0xe4, 0xed, 0xd7, 0xf7, 0xef, 0x20, 0x88, 0x9f, 0xdc, 0xb6, 0x9f, 0x83,
-	0x61, 0x97, 0xae, 0xbb, 0xbc, 0xaa, 0x9b, 0x8d, 0xba, 0x24, 0xb5, 0xc8, 0xe6, 0xae, 0xbc, 0xa0,
-	0xa3, 0xaa, 0x8d, 0x4a, 0x90, 0x20, 0x3d, 0x2b, 0x13, 0xbb, 0x86, 0xe4, 0x84, 0xa0, 0x71, 0x90,
-	0x0f, 0xcf, 0x70, 0xd5, 0x05, 0xe1, 0x99, 0x28, 0x78, 0x46, 0x7c, 0x0e, 0x46, 0x9b, 0xbb, 0x3f,
-	0xd6, 0x36, 0xab, 0xba, 0x08, 0xd1, 0x3b, 0x7a, 0x9b, 0x3b, 0x7c, 0x84, 0x34, 0x6b, 0x6a, 0xbb,
-	0x0f, 0x72, 0x09, 0xf6, 0x7c, 0x7d, 0x7c, 0x08, 0xda, 0x4f, 0x89, 0xc4, 0x85, 0x3e, 0x8f, 0x95,
-	0xc5, 0xb7, 0x23, 0x20, 0x96, 0x77, 0xca, 0xe2, 0x77, 0x02, 0x88, 0x9f, 0xbc, 0x5d, 0x67, 0x22,
-	0xe7, 0x5b, 0xd8, 0xef, 0x83, 0xe4, 0xfd, 0xbe, 0x21, 0xfe, 0x10, 0x7a, 0x2d, 0x00, 0x31, 0xe4,
-	0xf0, 0x5e, 0xec, 0xd3, 0xe3, 0x56, 0x8d, 0x24, 0xb3, 0xfd, 0x63, 0xfc, 0x34, 0x7e, 0x13, 0xc0,
-	0x74, 0xd4, 0x4f, 0x8e, 0xd5, 0xae, 0xbe, 0x3b, 0x83, 0x93, 0x8f, 0xce, 0x00, 0xf6, 0x33, 0xfc,
-	0x5d, 0x00, 0x37, 0x22, 0xef, 0x3b, 0x6b, 0xa7, 0x8e, 0x42, 0xc9, 0xdb, 0x38, 0x0b, 0xda, 0x4f,
-	0xf2, 0x50, 0x00, 0x13, 0xa1, 0xc7, 0xef, 0x72, 0x57, 0xf7, 0x21, 0xa8, 0xe4, 0xda, 0x69, 0x50,
-	0x7e, 0x32, 0x6f, 0x05, 0xf0, 0x49, 0xf7, 0x23, 0x6c, 0xbd, 0x87, 0x18, 0xd1, 0x2e, 0x92, 0x9b,
-	0x67, 0x76, 0xe1, 0xe7, 0xfc, 0xab, 0x00, 0x12, 0x1d, 0x47, 0xfc, 0x4a, 0x0f, 0x71, 0x42, 0x91,
-	0xc9, 0x87, 0xa7, 0x45, 0x7a, 0x89, 0xe5, 0x9e, 0xbe, 0x3b, 0x4a, 0x09, 0xef, 0x8f, 0x52, 0xc2,
-	0xdf, 0x47, 0x29, 0xe1, 0x97, 0xe3, 0xd4, 0xc0, 0xfb, 0xe3, 0xd4, 0xc0, 0x9f, 0xc7, 0xa9, 0x81,
-	0xaf, 0xee, 0x95, 0x0d, 0xb2, 0x53, 0x2b, 0xaa, 0x25, 0x6c, 0x7a, 0x67, 0x83, 0x52, 0x81, 0x45,
-	0xc7, 0x3f, 0x28, 0xf6, 0x96, 0x32, 0xe9, 0xfd, 0xb6, 0xe3, 0x82, 0x1c, 0x54, 0x91, 0x53, 0x1c,
-	0x76, 0xff, 0xfe, 0xb3, 0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x59, 0x36, 0x1f, 0xd4,
-	0x12, 0x00, 0x00,
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0x4b, 0x73, 0xd3, 0x56,
+	0x1b, 0xc7, 0xa3, 0x38, 0x84, 0xe4, 0x70, 0x8b, 0x95, 0x40, 0x8c, 0xcd, 0x6b, 0xe5, 0x3d, 0x5c,
+	0xde, 0xc0, 0x8b, 0x64, 0x9c, 0x30, 0x2f, 0xc1, 0xc9, 0x3b, 0x10, 0x43, 0x99, 0xf1, 0x94, 0x34,
+	0x41, 0xd0, 0x4d, 0x67, 0x3a, 0x1e, 0xd9, 0x3a, 0x31, 0x6a, 0x2c, 0xc9, 0x23, 0x1d, 0x41, 0xb2,
+	0xe9, 0xb4, 0x94, 0xe9, 0x25, 0xd3, 0x05, 0xab, 0x6e, 0x99, 0xe9, 0x27, 0xa0, 0x9b, 0x7e, 0x06,
+	0x96, 0xcc, 0x74, 0xd3, 0xe9, 0xc2, 0xb4, 0xb0, 0xa0, 0x6b, 0x7f, 0x82, 0xce, 0xb9, 0x48, 0xb6,
+	0x65, 0xf9, 0x46, 0x20, 0xdd, 0x74, 0x93, 0x58, 0xd2, 0xf9, 0x3f, 0xe7, 0xb9, 0xfc, 0x1e, 0x3d,
+	0x92, 0xc0, 0x19, 0xdb, 0x35, 0x6d, 0xd7, 0x70, 0x33, 0x35, 0xdb, 0xae, 0x9a, 0x9a, 0xa5, 0x55,
+	0x90, 0x93, 0x79, 0x90, 0x2d, 0x21, 0xac, 0x65, 0x33, 0x78, 0x5b, 0xa9, 0x39, 0x36, 0xb6, 0xc5,
+	0x14, 0x5f, 0xa5, 0xb4, 0xac, 0x52, 0xf8, 0xaa, 0xe4, 0x4c, 0xc5, 0xae, 0xd8, 0x74, 0x5d, 0x86,
+	0xfc, 0x62, 0x92, 0x64, 0x5c, 0x33, 0x0d, 0xcb, 0xce, 0xd0, 0xbf, 0xfc, 0x54, 0xba, 0x4c, 0xcd,
+	0x64, 0x4a, 0x9a, 0x8b, 0x82, 0x3d, 0xca, 0xb6, 0x61, 0xf1, 0xeb, 0x17, 0x7b, 0xf9, 0xe2, 0x3e,
+	0xd4, 0x6a, 0x45, 0xc7, 0xf6, 0x30, 0xe2, 0xab, 0x67, 0xb9, 0x35, 0xd3, 0xad, 0x64, 0x1e, 0x64,
+	0xc9, 0x3f, 0x7e, 0x41, 0xaa, 0xd8, 0x76, 0xa5, 0x8a, 0x32, 0xf4, 0xa8, 0xe4, 0x6d, 0x66, 0xb0,
+	0x61, 0x22, 0x17, 0x6b, 0x66, 0x8d, 0x2d, 0x80, 0xdf, 0xc4, 0xc0, 0xcc, 0x9a, 0x5b, 0xb9, 0xfb,
+	0x50, 0xab, 0x7d, 0xb0, 0xad, 0x95, 0xf1, 0xaa, 0x69, 0x7b, 0x16, 0x2e, 0x58, 0xe2, 0x79, 0x30,
+	0xee, 0x22, 0x4b, 0x47, 0x4e, 0x42, 0x98, 0x13, 0xe6, 0x27, 0xf3, 0xf1, 0x46, 0x5d, 0x3a, 0xb2,
+	0xa3, 0x99, 0xd5, 0x1c, 0x64, 0xe7, 0xa1, 0xca, 0x17, 0x88, 0xb7, 0xc1, 0x38, 0x75, 0xc6, 0x4d,
+	0x8c, 0xce, 0xc5, 0xe6, 0x0f, 0x2d, 0x28, 0x4a, 0x8f, 0x14, 0x29, 0x64, 0x2b, 0x7f, 0x17, 0x95,
+	0xc8, 0xf2, 0x63, 0xcf, 0xeb, 0xd2, 0x88, 0xca, 0x6d, 0x88, 0x6b, 0x60, 0x02, 0xdb, 0x5b, 0xc8,
+	0x2a, 0x1a, 0x56, 0x22, 0x36, 0x27, 0xcc, 0x1f, 0x5a, 0x38, 0xa9, 0xb0, 0xf0, 0x14, 0x92, 0xac,
+	0xc0, 0xce, 0x0d, 0xdb, 0xb0, 0xf2, 0xb3, 0x44, 0xda, 0xa8, 0x4b, 0xc7, 0x98, 0x67, 0xbe, 0x10,
+	0xaa, 0x07, 0xe9, 0xcf, 0x82, 0x25, 0x9a, 0x60, 0x86, 0x9d, 0xb5, 0x3d, 0x5c, 0x34, 0x0d, 0xab,
+	0xa8, 0xd1, 0xbd, 0x13, 0x63, 0x34, 0xaa, 0x15, 0xa2, 0xff, 0xad, 0x2e, 0x1d, 0x67, 0x3b, 0xb8,
+	0xfa, 0x96, 0x62, 0xd8, 0x19, 0x53, 0xc3, 0xf7, 0x95, 0x82, 0x85, 0x1b, 0x75, 0x29, 0xd5, 0x6a,
+	0xb8, 0xdd, 0x04, 0x54, 0xe3, 0xf4, 0xf4, 0xba, 0x87, 0xd7, 0x0c, 0x8b, 0x85, 0x94, 0x5b, 0x7a,
+	0xf4, 0xe6, 0xd9, 0x05, 0x9e, 0x98, 0xdd, 0x37, 0xcf, 0x2e, 0xcc, 0x47, 0xd5, 0x91, 0xd4, 0x4f,
+	0x46, 0x24, 0xdd, 0x32, 0x33, 0x25, 0x1b, 0x16, 0x7c, 0x24, 0x80, 0x53, 0x51, 0x95, 0x50, 0x91,
+	0x5b, 0xb3, 0x2d, 0x17, 0x89, 0x25, 0x30, 0xd5, 0x74, 0x83, 0x47, 0xc1, 0x6a, 0xb3, 0xd4, 0x2f,
+	0x8a, 0xd9, 0x70, 0x14, 0x7e, 0x04, 0x47, 0xfd, 0x08, 0xd8, 0x6e, 0xf0, 0xab, 0x18, 0x48, 0x13,
+	0x27, 0x6a, 0x55, 0x03, 0xd3, 0xe2, 0xec, 0x09, 0x8c, 0x3b, 0x21, 0x30, 0x16, 0x07, 0x06, 0xa3,
+	0xe9, 0x40, 0x88, 0x8e, 0x6b, 0xe0, 0xa8, 0x5f, 0xe4, 0xa2, 0x8e, 0x2c, 0xdb, 0xa4, 0x8c, 0x4c,
+	0xe6, 0x4f, 0x36, 0xea, 0xd2, 0xf1, 0x76, 0x08, 0xd8, 0x75, 0xa8, 0x1e, 0xe6, 0x28, 0xdc, 0x24,
+	0x87, 0xfb, 0xcd, 0xc3, 0x62, 0x88, 0x87, 0xd3, 0x91, 0x3c, 0x90, 0x68, 0x5b, 0x50, 0xf8, 0x5e,
+	0x00, 0xe7, 0x7a, 0x57, 0x61, 0x5f, 0xa1, 0xf8, 0x96, 0x43, 0x61, 0x6a, 0xce, 0x3b, 0x80, 0xa2,
+	0xb5, 0xbf, 0x47, 0xf7, 0xde, 0xdf, 0x79, 0x70, 0xac, 0x19, 0x41, 0x2b, 0x11, 0xc9, 0x46, 0x5d,
+	0x3a, 0x11, 0x0e, 0x91, 0x23, 0x71, 0xc4, 0x8f, 0xf0, 0x6f, 0x61, 0xe2, 0x6a, 0x88, 0x89, 0xf3,
+	0x91, 0x4c, 0x90, 0x6c, 0xcb, 0x94, 0xfa, 0x16, 0x32, 0x5e, 0x72, 0x32, 0xba, 0x97, 0x62, 0x3f,
+	0xc9, 0x78, 0x0f, 0x0d, 0x0e, 0x9f, 0x8e, 0x81, 0x69, 0x12, 0xa1, 0x57, 0x32, 0x0d, 0x4c, 0x14,
+	0x05, 0x0b, 0x23, 0x0b, 0xff, 0x43, 0xd8, 0x10, 0x84, 0x89, 0x77, 0xc1, 0x84, 0x8e, 0x34, 0xbd,
+	0x6a, 0x58, 0x28, 0x71, 0x80, 0x66, 0x20, 0xa9, 0xb0, 0x27, 0x01, 0xc5, 0x7f, 0x12, 0x50, 0xee,
+	0xf9, 0x4f, 0x02, 0xf9, 0x54, 0x7b, 0x0a, 0x7c, 0x25, 0x7c, 0xf2, 0x52, 0x12, 0xd4, 0xc0, 0x90,
+	0xf8, 0x11, 0x98, 0x76, 0xd0, 0xa6, 0x67, 0xe9, 0x45, 0x63, 0xb3, 0xe8, 0x59, 0x2e, 0xc2, 0xb8,
+	0x8a, 0xf4, 0xc4, 0xf8, 0x9c, 0x30, 0x3f, 0x91, 0x4f, 0x37, 0xea, 0x52, 0x92, 0xe9, 0x23, 0x16,
+	0x41, 0x35, 0xce, 0xce, 0x16, 0x36, 0x3f, 0xf6, 0xcf, 0xe5, 0xfe, 0x17, 0x6a, 0x83, 0x73, 0x91,
+	0x6d, 0x40, 0x39, 0x90, 0xe9, 0xc4, 0x34, 0x28, 0x09, 0x70, 0x03, 0xa4, 0x22, 0x00, 0x09, 0xb8,
+	0xcf, 0x82, 0x49, 0xb6, 0xb0, 0x68, 0xe8, 0x94, 0x95, 0xb1, 0xfc, 0x4c, 0xa3, 0x2e, 0x4d, 0x31,
+	0xe7, 0x82, 0x4b, 0x50, 0x9d, 0x60, 0xbf, 0x0b, 0x3a, 0xdc, 0x8d, 0x81, 0xe3, 0x9d, 0xa3, 0x77,
+	0xdd, 0x1b, 0x92, 0xba, 0xf6, 0x5e, 0xc8, 0x0c, 0xd8, 0x0b, 0xeb, 0x5e, 0xe4, 0xa0, 0xfb, 0x0c,
+	0x4c, 0x07, 0x83, 0xcc, 0xd4, 0xb6, 0x7d, 0x60, 0x18, 0x79, 0xcb, 0xfd, 0x80, 0x49, 0x86, 0x46,
+	0x61, 0xd3, 0x02, 0x54, 0xa7, 0x38, 0xd8, 0x6b, 0xda, 0x36, 0xc7, 0x65, 0x03, 0x4c, 0x06, 0x68,
+	0x51, 0x24, 0x7b, 0x76, 0x4c, 0x82, 0xe3, 0x32, 0x15, 0x82, 0x12, 0xaa, 0x13, 0x3e, 0x89, 0x03,
+	0xde, 0xe2, 0x3a, 0x1e, 0x83, 0x88, 0x95, 0x2f, 0x04, 0xf0, 0xaf, 0xc8, 0x62, 0x04, 0x15, 0x2e,
+	0xfa, 0x0d, 0xd9, 0xec, 0x23, 0x56, 0x9d, 0x2b, 0xfd, 0xd2, 0x72, 0x22, 0x94, 0x16, 0x3f, 0x25,
+	0x47, 0x78, 0x4a, 0xf8, 0xc0, 0xfb, 0x3a, 0x06, 0xa4, 0x5e, 0xf3, 0x77, 0x48, 0x32, 0xd4, 0x10,
+	0x19, 0x97, 0x07, 0x27, 0xa3, 0xeb, 0x73, 0xd0, 0xbb, 0xb8, 0x29, 0x75, 0x41, 0x6c, 0xec, 0x3d,
+	0x20, 0x96, 0xbb, 0x1c, 0x02, 0xe2, 0x4c, 0xdf, 0xe7, 0x20, 0xc2, 0xc2, 0xae, 0x00, 0xfe, 0xd3,
+	0xa7, 0x10, 0xfb, 0x47, 0xc5, 0x77, 0xa3, 0x60, 0x96, 0x38, 0x83, 0x58, 0xfa, 0x36, 0x34, 0xc3,
+	0xb9, 0xa7, 0x6d, 0x21, 0xe7, 0x16, 0x42, 0xc3, 0xd0, 0xf0, 0x58, 0x00, 0x33, 0xb4, 0x1e, 0xc5,
+	0x9a, 0x66, 0x38, 0x45, 0x4c, 0x4c, 0x14, 0x37, 0x11, 0x1a, 0xe8, 0xe5, 0xa9, 0x63, 0xe7, 0xfc,
+	0x69, 0xde, 0x8d, 0x29, 0xff, 0xe6, 0xdd, 0x69, 0x19, 0xaa, 0x71, 0x3d, 0xac, 0xcb, 0xad, 0x84,
+	0x0a, 0x12, 0xf9, 0xc2, 0xe9, 0x22, 0x2c, 0x53, 0xa9, 0x4c, 0x2c, 0xca, 0xd4, 0xa2, 0x4c, 0x2c,
+	0x2e, 0xb3, 0x06, 0x89, 0x48, 0x45, 0x50, 0x8f, 0x04, 0x38, 0xe8, 0x7a, 0xe5, 0x32, 0x72, 0x5d,
+	0x9a, 0x93, 0x09, 0xd5, 0x3f, 0x84, 0x7f, 0x8c, 0x82, 0x33, 0x4c, 0xed, 0x8b, 0xee, 0xde, 0xd7,
+	0x1c, 0xb4, 0x5a, 0x71, 0x10, 0x32, 0x91, 0x85, 0x6f, 0xd9, 0x0e, 0x03, 0x74, 0x88, 0xac, 0x9e,
+	0x03, 0x07, 0x58, 0x17, 0x8c, 0xd2, 0x95, 0x53, 0x8d, 0xba, 0x74, 0xb8, 0x25, 0x23, 0x50, 0x65,
+	0x97, 0xc5, 0x4f, 0xc1, 0x61, 0x77, 0xcb, 0x30, 0x8b, 0x35, 0xe4, 0x94, 0x51, 0x70, 0x3f, 0xcd,
+	0x71, 0x44, 0x52, 0x9d, 0x88, 0xdc, 0x46, 0x15, 0xad, 0xbc, 0x73, 0x13, 0x95, 0x1b, 0x75, 0x69,
+	0x9a, 0xef, 0xdd, 0x62, 0x00, 0xaa, 0x87, 0xc8, 0xe1, 0x06, 0x3b, 0x12, 0x73, 0xdc, 0xbc, 0xa6,
+	0xeb, 0x0e, 0x89, 0x9c, 0xf5, 0xd2, 0x6c, 0x48, 0xcb, 0xaf, 0x72, 0xed, 0x2a, 0x3b, 0xca, 0x7d,
+	0x18, 0xaa, 0xc8, 0x72, 0xb7, 0x8a, 0x04, 0x65, 0x90, 0x5d, 0x92, 0x37, 0x59, 0xf3, 0x13, 0x27,
+	0x6f, 0xda, 0x0e, 0xab, 0x17, 0x54, 0xc0, 0xc5, 0x41, 0x52, 0xec, 0x57, 0x0b, 0xfe, 0x2c, 0xb0,
+	0xa9, 0x8a, 0xb0, 0x8a, 0x2a, 0x86, 0x8b, 0x91, 0x83, 0xf4, 0xd5, 0x6a, 0xd5, 0xde, 0x41, 0xfa,
+	0x86, 0x6d, 0x57, 0x87, 0x29, 0xc5, 0x7f, 0xc1, 0x41, 0xe2, 0x31, 0x19, 0xbf, 0xa3, 0x74, 0xfc,
+	0x8a, 0x8d, 0xba, 0x74, 0x94, 0xad, 0xe5, 0x17, 0xa0, 0x3a, 0x4e, 0x7e, 0x15, 0xf4, 0xdc, 0xb5,
+	0x50, 0xd0, 0x99, 0x6e, 0x41, 0x3b, 0x81, 0x5b, 0xb2, 0xc6, 0xfc, 0x92, 0xc9, 0x12, 0x78, 0x16,
+	0x9c, 0xee, 0xe1, 0x77, 0x10, 0xdf, 0x9f, 0xa3, 0x20, 0xde, 0xd9, 0xb6, 0xff, 0x07, 0xe3, 0x34,
+	0x5d, 0x97, 0x78, 0x54, 0x67, 0x1b, 0x75, 0x49, 0x6a, 0xc1, 0xe6, 0x12, 0xbc, 0xa8, 0xa3, 0x9a,
+	0x83, 0xca, 0x1a, 0x46, 0x7a, 0x0e, 0x62, 0xc7, 0x43, 0x30, 0x21, 0xa8, 0x5c, 0x14, 0xc8, 0xb3,
+	0x9c, 0xba, 0xb0, 0x3c, 0xdb, 0x4b, 0x9e, 0x15, 0xef, 0x81, 0xc9, 0x66, 0xf7, 0xc7, 0xda, 0xee,
+	0x55, 0x7d, 0x40, 0xf4, 0x47, 0x6f, 0xb3, 0xc3, 0x27, 0x70, 0x33, 0xa6, 0xb6, 0x17, 0x5e, 0x8e,
+	0xe0, 0xc0, 0xef, 0xc7, 0xd7, 0x41, 0xfb, 0x94, 0xa0, 0xcf, 0x8f, 0xc3, 0x8c, 0x95, 0x85, 0x5f,
+	0x00, 0x88, 0xad, 0xb9, 0x15, 0xf1, 0x4b, 0x01, 0xc4, 0x3b, 0xdf, 0x14, 0xb3, 0x3d, 0xef, 0x6f,
+	0x51, 0x1f, 0x40, 0x92, 0x57, 0x87, 0x96, 0x04, 0x37, 0xa1, 0xc7, 0x02, 0x10, 0x23, 0x86, 0xf7,
+	0xc2, 0x90, 0x16, 0xd7, 0x3d, 0x9c, 0xcc, 0x0d, 0xaf, 0x09, 0xdc, 0x78, 0x2a, 0x80, 0x54, 0xaf,
+	0x6f, 0x2a, 0xcb, 0x7d, 0x6d, 0x77, 0x17, 0x27, 0x6f, 0xec, 0x41, 0x1c, 0x78, 0xf8, 0xa3, 0x00,
+	0x4e, 0xf5, 0x7c, 0xde, 0x59, 0x79, 0xeb, 0x5d, 0x48, 0xf2, 0x6e, 0xee, 0x45, 0xdd, 0x9e, 0xc6,
+	0x1e, 0x5f, 0x21, 0xfa, 0xa7, 0xb1, 0xbb, 0x78, 0x80, 0x34, 0x0e, 0xf0, 0xd2, 0xfd, 0x39, 0x98,
+	0xea, 0x78, 0x73, 0xbd, 0xd4, 0xd7, 0x70, 0x48, 0x91, 0x5c, 0x1a, 0x56, 0x11, 0xec, 0xbf, 0x2b,
+	0x80, 0x99, 0xc8, 0x07, 0x94, 0xcb, 0x7d, 0x4d, 0x46, 0xa8, 0x92, 0x2b, 0x6f, 0xa3, 0x0a, 0x9c,
+	0xf9, 0x49, 0x00, 0xff, 0xee, 0x3f, 0xe4, 0x57, 0x07, 0xd8, 0xa3, 0xb7, 0x89, 0x64, 0x61, 0xcf,
+	0x26, 0x02, 0x9f, 0x7f, 0x10, 0x40, 0xa2, 0xeb, 0x10, 0x5c, 0x1a, 0x60, 0x9f, 0x48, 0x65, 0xf2,
+	0xfa, 0xdb, 0x2a, 0x7d, 0xc7, 0xf2, 0x77, 0x9e, 0xbf, 0x4a, 0x0b, 0x2f, 0x5e, 0xa5, 0x85, 0xdf,
+	0x5f, 0xa5, 0x85, 0x27, 0xaf, 0xd3, 0x23, 0x2f, 0x5e, 0xa7, 0x47, 0x7e, 0x7d, 0x9d, 0x1e, 0xf9,
+	0xe4, 0x4a, 0xc5, 0xc0, 0xf7, 0xbd, 0x92, 0x52, 0xb6, 0x4d, 0x7f, 0x7a, 0xca, 0x55, 0xad, 0xe4,
+	0x06, 0xa3, 0xf4, 0xc1, 0x62, 0x36, 0xb3, 0xdd, 0x36, 0x50, 0xf1, 0x4e, 0x0d, 0xb9, 0xa5, 0x71,
+	0xfa, 0x2d, 0x60, 0xf1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x67, 0xd9, 0x08, 0xf8, 0x18,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1200,8 @@ type MsgClient interface {
 	SwapExactAmountOut(ctx context.Context, in *MsgSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SmartRouteSwapExactAmountIn(ctx context.Context, in *MsgSmartRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSmartRouteSwapExactAmountInResponse, error)
+	SubmitSwapIntent(ctx context.Context, in *MsgSubmitSwapIntent, opts ...grpc.CallOption) (*MsgSubmitSwapIntentResponse, error)
 	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(ctx context.Context, in *MsgSetTakerFeeShareAgreementForDenom, opts ...grpc.CallOption) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(ctx context.Context, in *MsgSetRegisteredAlloyedPool, opts ...grpc.CallOption) (*MsgSetRegisteredAlloyedPoolResponse, error)
@@ -979,6 +1251,24 @@ func (c *msgClient) SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSpl
 	return out, nil
 }
 
+func (c *msgClient) SmartRouteSwapExactAmountIn(ctx context.Context, in *MsgSmartRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSmartRouteSwapExactAmountInResponse, error) {
+	out := new(MsgSmartRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SmartRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitSwapIntent(ctx context.Context, in *MsgSubmitSwapIntent, opts ...grpc.CallOption) (*MsgSubmitSwapIntentResponse, error) {
+	out := new(MsgSubmitSwapIntentResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SubmitSwapIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error) {
 	out := new(MsgSetDenomPairTakerFeeResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SetDenomPairTakerFee", in, out, opts...)
@@ -1012,6 +1302,8 @@ type MsgServer interface {
 	SwapExactAmountOut(context.Context, *MsgSwapExactAmountOut) (*MsgSwapExactAmountOutResponse, error)
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
+	SmartRouteSwapExactAmountIn(context.Context, *MsgSmartRouteSwapExactAmountIn) (*MsgSmartRouteSwapExactAmountInResponse, error)
+	SubmitSwapIntent(context.Context, *MsgSubmitSwapIntent) (*MsgSubmitSwapIntentResponse, error)
 	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
 	SetTakerFeeShareAgreementForDenom(context.Context, *MsgSetTakerFeeShareAgreementForDenom) (*MsgSetTakerFeeShareAgreementForDenomResponse, error)
 	SetRegisteredAlloyedPool(context.Context, *MsgSetRegisteredAlloyedPool) (*MsgSetRegisteredAlloyedPoolResponse, error)
@@ -1033,6 +1325,12 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context, req *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountOut not implemented")
 }
+func (*UnimplementedMsgServer) SmartRouteSwapExactAmountIn(ctx context.Context, req *MsgSmartRouteSwapExactAmountIn) (*MsgSmartRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SmartRouteSwapExactAmountIn not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSwapIntent(ctx context.Context, req *MsgSubmitSwapIntent) (*MsgSubmitSwapIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSwapIntent not implemented")
+}
 func (*UnimplementedMsgServer) SetDenomPairTakerFee(ctx context.Context, req *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPairTakerFee not implemented")
 }
@@ -1119,6 +1417,42 @@ func _Msg_SplitRouteSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SmartRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSmartRouteSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SmartRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SmartRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SmartRouteSwapExactAmountIn(ctx, req.(*MsgSmartRouteSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitSwapIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSwapIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSwapIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SubmitSwapIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSwapIntent(ctx, req.(*MsgSubmitSwapIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetDenomPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetDenomPairTakerFee)
 	if err := dec(in); err != nil {
@@ -1193,6 +1527,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitRouteSwapExactAmountOut",
 			Handler:    _Msg_SplitRouteSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "SmartRouteSwapExactAmountIn",
+			Handler:    _Msg_SmartRouteSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SubmitSwapIntent",
+			Handler:    _Msg_SubmitSwapIntent_Handler,
+		},
 		{
 			MethodName: "SetDenomPairTakerFee",
 			Handler:    _Msg_SetDenomPairTakerFee_Handler,
@@ -1401,7 +1743,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgSmartRouteSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1411,50 +1753,43 @@ func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSmartRouteSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSmartRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size := m.TokenInMaxAmount.Size()
-		i -= size
-		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1465,7 +1800,7 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSmartRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1475,22 +1810,236 @@ func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSmartRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSmartRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TokenInAmount.Size()
-		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSwapIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSwapIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSwapIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundIfUnsettled {
+		i--
+		if m.RefundIfUnsettled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSwapIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSwapIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSwapIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntentId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -1946,7 +2495,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSwapExactAmountOut) Size() (n int) {
+func (m *MsgSmartRouteSwapExactAmountIn) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1956,31 +2505,35 @@ func (m *MsgSwapExactAmountOut) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.TokenInMaxAmount.Size()
+	l = m.TokenIn.Size()
 	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenOut.Size()
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+func (m *MsgSmartRouteSwapExactAmountInResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+func (m *MsgSubmitSwapIntent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1990,33 +2543,35 @@ func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.TokenOutDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TokenInMaxAmount.Size()
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
 	n += 1 + l + sovTx(uint64(l))
+	if m.RefundIfUnsettled {
+		n += 2
+	}
 	return n
 }
 
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+func (m *MsgSubmitSwapIntentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.TokenInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.IntentId != 0 {
+		n += 1 + sovTx(uint64(m.IntentId))
+	}
 	return n
 }
 
-func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+func (m *MsgSwapExactAmountOut) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2026,16 +2581,86 @@ func (m *MsgSetDenomPairTakerFee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.DenomPairTakerFee) > 0 {
-		for _, e := range m.DenomPairTakerFee {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOut.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenInMaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2670,6 +3295,608 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSmartRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSmartRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSmartRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSmartRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSmartRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSmartRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSwapIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSwapIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSwapIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundIfUnsettled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundIfUnsettled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSwapIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSwapIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSwapIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentId", wireType)
+			}
+			m.IntentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,6 +29,7 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryBaseDenomsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryRouteDiscoveryCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
 
@@ -150,6 +151,14 @@ func NewQueryInfoByPoolTypeCmd() (*osmocli.QueryDescriptor, *types.QueryGetProto
 	}, &types.QueryGetProtoRevInfoByPoolTypeRequest{}
 }
 
+// NewQueryRouteDiscoveryCmd returns the command to query the route discovery configuration of protorev
+func NewQueryRouteDiscoveryCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevRouteDiscoveryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "route-discovery",
+		Short: "Query the configuration of the cyclic route discovery",
+	}, &types.QueryGetProtoRevRouteDiscoveryRequest{}
+}
+
 // NewQueryPoolCmd returns the command to query the pool id for a given denom pair stored via the highest liquidity method in ProtoRev
 func NewQueryPoolCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevPoolRequest) {
 	return &osmocli.QueryDescriptor{
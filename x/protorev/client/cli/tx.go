@@ -26,6 +26,7 @@ func NewCmdTx() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, CmdSetDeveloperAccount)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerTx)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerBlock)
+	osmocli.AddTxCmd(txCmd, CmdSetRouteDiscovery)
 	txCmd.AddCommand(
 		CmdSetDeveloperHotRoutes().BuildCommandCustomFn(),
 		CmdSetInfoByPoolType().BuildCommandCustomFn(),
@@ -140,6 +141,41 @@ func CmdSetMaxPoolPointsPerBlock() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPoin
 	}, &types.MsgSetMaxPoolPointsPerBlock{}
 }
 
+// CmdSetRouteDiscovery implements the command to set the route discovery configuration
+func CmdSetRouteDiscovery() (*osmocli.TxCliDesc, *types.MsgSetRouteDiscovery) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-route-discovery [enabled] [max-route-length] [max-neighbors]",
+		Short:   "set the configuration of the cyclic route discovery",
+		Example: fmt.Sprintf(`$ %s tx protorev set-route-discovery true 3 5 --from mykey`, version.AppName),
+		NumArgs: 3,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			maxRouteLength, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			maxNeighbors, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.MsgSetRouteDiscovery{
+				Admin: clientCtx.GetFromAddress().String(),
+				RouteDiscovery: types.RouteDiscovery{
+					Enabled:        enabled,
+					MaxRouteLength: maxRouteLength,
+					MaxNeighbors:   maxNeighbors,
+				},
+			}, nil
+		},
+	}, &types.MsgSetRouteDiscovery{}
+}
+
 // CmdSetInfoByPoolType implements the command to set the pool information used throughout the module
 func CmdSetInfoByPoolType() *osmocli.TxCliDesc {
 	desc := osmocli.TxCliDesc{
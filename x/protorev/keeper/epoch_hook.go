@@ -101,7 +101,8 @@ func (k Keeper) CurrentBaseDenomProfits(ctx sdk.Context) (sdk.Coins, error) {
 	return protorevBalanceBaseDenoms.Sort(), nil
 }
 
-// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store
+// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store.
+// The denom graph used by the route discovery is rebuilt as well.
 func (k Keeper) UpdatePools(ctx sdk.Context) error {
 	// baseDenomPools maps each base denom to a map of the highest liquidity pools paired with that base denom
 	// ex. {osmo -> {atom : 100, weth : 200}}
@@ -129,7 +130,8 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 		}
 	}
 
-	return nil
+	// Update the denom graph used by the route discovery
+	return k.UpdateDenomGraph(ctx)
 }
 
 // UpdateHighestLiquidityPools updates the baseDenomPools map (passed in by reference) with the
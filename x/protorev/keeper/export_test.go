@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BuildDiscoveredRoutesWithMaxVisitedNodes builds the discovered routes visiting at most maxVisitedNodes denoms.
+func (k Keeper) BuildDiscoveredRoutesWithMaxVisitedNodes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, maxVisitedNodes uint64) ([]RouteMetaData, error) {
+	return k.buildDiscoveredRoutes(ctx, tokenIn, tokenOut, poolId, nil, maxVisitedNodes)
+}
@@ -32,6 +32,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	// Configure the route discovery before the pools are updated, since the denom graph is built along with them.
+	k.SetRouteDiscovery(ctx, genState.RouteDiscovery)
+
 	// Update the pools on genesis.
 	if err := k.UpdatePools(ctx); err != nil {
 		panic(err)
@@ -128,6 +131,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.BaseDenoms = baseDenoms
 
+	// Export the route discovery configuration.
+	genesis.RouteDiscovery = k.GetRouteDiscovery(ctx)
+
 	// Export the developer fees that have been collected.
 	fees, err := k.GetAllDeveloperFees(ctx)
 	if err != nil {
@@ -28,6 +28,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	poolInfo := s.App.ProtoRevKeeper.GetInfoByPoolType(s.Ctx)
 	s.Require().Equal(poolInfo, exportedGenesis.InfoByPoolType)
 
+	routeDiscovery := s.App.ProtoRevKeeper.GetRouteDiscovery(s.Ctx)
+	s.Require().Equal(routeDiscovery, exportedGenesis.RouteDiscovery)
+
 	daysSinceGenesis, err := s.App.ProtoRevKeeper.GetDaysSinceModuleGenesis(s.Ctx)
 	s.Require().NoError(err)
 	s.Require().Equal(daysSinceGenesis, exportedGenesis.DaysSinceModuleGenesis)
@@ -180,6 +180,17 @@ func (q Querier) GetProtoRevInfoByPoolType(c context.Context, req *types.QueryGe
 	return &types.QueryGetProtoRevInfoByPoolTypeResponse{InfoByPoolType: infoByPoolType}, nil
 }
 
+// GetProtoRevRouteDiscovery queries the configuration of the route discovery
+func (q Querier) GetProtoRevRouteDiscovery(c context.Context, req *types.QueryGetProtoRevRouteDiscoveryRequest) (*types.QueryGetProtoRevRouteDiscoveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	routeDiscovery := q.Keeper.GetRouteDiscovery(ctx)
+
+	return &types.QueryGetProtoRevRouteDiscoveryResponse{RouteDiscovery: routeDiscovery}, nil
+}
+
 // GetProtoRevPoolPointsPerTx queries the maximum number of pool points that can be consumed per transaction
 func (q Querier) GetProtoRevMaxPoolPointsPerTx(c context.Context, req *types.QueryGetProtoRevMaxPoolPointsPerTxRequest) (*types.QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
 	if req == nil {
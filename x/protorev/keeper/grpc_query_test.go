@@ -308,6 +308,23 @@ func (s *KeeperTestSuite) TestGetProtoRevInfoByPoolType() {
 	s.Require().Equal(poolInfo, res.InfoByPoolType)
 }
 
+// TestGetProtoRevRouteDiscovery tests the query to retrieve the route discovery configuration
+func (s *KeeperTestSuite) TestGetProtoRevRouteDiscovery() {
+	// The default configuration is returned if none is set
+	req := &types.QueryGetProtoRevRouteDiscoveryRequest{}
+	res, err := s.queryClient.GetProtoRevRouteDiscovery(s.Ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultRouteDiscovery, res.RouteDiscovery)
+
+	// Set the route discovery configuration
+	routeDiscovery := types.RouteDiscovery{Enabled: true, MaxRouteLength: 4, MaxNeighbors: 3}
+	s.App.AppKeepers.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, routeDiscovery)
+
+	res, err = s.queryClient.GetProtoRevRouteDiscovery(s.Ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(routeDiscovery, res.RouteDiscovery)
+}
+
 // TestGetProtoRevMaxPoolPointsPerTx tests the query to retrieve the max pool points per tx
 func (s *KeeperTestSuite) TestGetProtoRevMaxPoolPointsPerTx() {
 	// Set the max pool points per tx
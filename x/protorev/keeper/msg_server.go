@@ -162,6 +162,25 @@ func (m MsgServer) SetBaseDenoms(c context.Context, msg *types.MsgSetBaseDenoms)
 	return &types.MsgSetBaseDenomsResponse{}, nil
 }
 
+// SetRouteDiscovery sets the configuration of the route discovery and rebuilds the denom graph accordingly
+func (m MsgServer) SetRouteDiscovery(c context.Context, msg *types.MsgSetRouteDiscovery) (*types.MsgSetRouteDiscoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	m.k.SetRouteDiscovery(ctx, msg.RouteDiscovery)
+
+	// Rebuild the denom graph with the new configuration
+	if err := m.k.UpdateDenomGraph(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRouteDiscoveryResponse{}, nil
+}
+
 // AdminCheck ensures that the sender is the admin account.
 func (m MsgServer) AdminCheck(ctx sdk.Context, admin string) error {
 	sender, err := sdk.AccAddressFromBech32(admin)
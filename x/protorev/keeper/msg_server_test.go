@@ -664,3 +664,86 @@ func (s *KeeperTestSuite) TestMsgSetBaseDenoms() {
 		})
 	}
 }
+
+// TestMsgSetRouteDiscovery tests the MsgSetRouteDiscovery message.
+func (s *KeeperTestSuite) TestMsgSetRouteDiscovery() {
+	s.SetupPoolsTest()
+
+	cases := []struct {
+		description       string
+		admin             string
+		routeDiscovery    types.RouteDiscovery
+		passValidateBasic bool
+		pass              bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5},
+			false,
+			false,
+		},
+		{
+			"Invalid message (invalid max route length)",
+			s.adminAccount.String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 1, MaxNeighbors: 5},
+			false,
+			false,
+		},
+		{
+			"Invalid message (wrong admin)",
+			apptesting.CreateRandomAccounts(1)[0].String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5},
+			true,
+			false,
+		},
+		{
+			"Valid message (enable route discovery)",
+			s.adminAccount.String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5},
+			true,
+			true,
+		},
+		{
+			"Valid message (disable route discovery)",
+			s.adminAccount.String(),
+			types.RouteDiscovery{Enabled: false, MaxRouteLength: 3, MaxNeighbors: 5},
+			true,
+			true,
+		},
+	}
+
+	for _, testCase := range cases {
+		s.Run(testCase.description, func() {
+			msg := types.NewMsgSetRouteDiscovery(testCase.admin, testCase.routeDiscovery)
+
+			err := msg.ValidateBasic()
+			if testCase.passValidateBasic {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+				return
+			}
+
+			server := keeper.NewMsgServer(*s.App.AppKeepers.ProtoRevKeeper)
+			response, err := server.SetRouteDiscovery(s.Ctx, msg)
+			if testCase.pass {
+				s.Require().NoError(err)
+				s.Require().Equal(response, &types.MsgSetRouteDiscoveryResponse{})
+
+				routeDiscovery := s.App.AppKeepers.ProtoRevKeeper.GetRouteDiscovery(s.Ctx)
+				s.Require().Equal(testCase.routeDiscovery, routeDiscovery)
+
+				// The denom graph is only built if the route discovery is enabled
+				neighbors := s.App.AppKeepers.ProtoRevKeeper.GetDenomGraphNeighbors(s.Ctx, types.OsmosisDenomination)
+				if testCase.routeDiscovery.Enabled {
+					s.Require().NotEmpty(neighbors)
+				} else {
+					s.Require().Empty(neighbors)
+				}
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -514,6 +514,70 @@ func (k Keeper) SetInfoByPoolType(ctx sdk.Context, poolWeights types.InfoByPoolT
 	osmoutils.MustSet(store, types.KeyPrefixInfoByPoolType, &poolWeights)
 }
 
+// GetRouteDiscovery returns the route discovery configuration. The default configuration is returned if none is set.
+func (k Keeper) GetRouteDiscovery(ctx sdk.Context) types.RouteDiscovery {
+	store := ctx.KVStore(k.storeKey)
+	routeDiscovery := types.DefaultRouteDiscovery
+	if store.Has(types.KeyPrefixRouteDiscovery) {
+		osmoutils.MustGet(store, types.KeyPrefixRouteDiscovery, &routeDiscovery)
+	}
+	return routeDiscovery
+}
+
+// SetRouteDiscovery sets the route discovery configuration.
+func (k Keeper) SetRouteDiscovery(ctx sdk.Context, routeDiscovery types.RouteDiscovery) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPrefixRouteDiscovery, &routeDiscovery)
+}
+
+// GetDenomGraphPool returns the id of the highest liquidity pool between two denoms of the denom graph
+func (k Keeper) GetDenomGraphPool(ctx sdk.Context, denomA, denomB string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyDenomGraphPool(denomA, denomB))
+	if len(bz) == 0 {
+		return 0, fmt.Errorf("no pool between %s and %s in the denom graph", denomA, denomB)
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetDenomGraphPool sets the id of the highest liquidity pool between two denoms of the denom graph, in both directions
+func (k Keeper) SetDenomGraphPool(ctx sdk.Context, denomA, denomB string, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyDenomGraphPool(denomA, denomB), sdk.Uint64ToBigEndian(poolId))
+	store.Set(types.GetKeyDenomGraphPool(denomB, denomA), sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetDenomGraphNeighbors returns the neighbors of the denom in the denom graph, sorted by rank
+func (k Keeper) GetDenomGraphNeighbors(ctx sdk.Context, denom string) []string {
+	neighbors := make([]string, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetKeyPrefixDenomGraphNeighbors(denom))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		neighbors = append(neighbors, string(iterator.Value()))
+	}
+
+	return neighbors
+}
+
+// SetDenomGraphNeighbors sets the neighbors of the denom in the denom graph. The rank of each neighbor matches its
+// position in the slice.
+func (k Keeper) SetDenomGraphNeighbors(ctx sdk.Context, denom string, neighbors []string) {
+	store := ctx.KVStore(k.storeKey)
+	for rank, neighbor := range neighbors {
+		store.Set(types.GetKeyDenomGraphNeighbor(denom, uint64(rank)), []byte(neighbor))
+	}
+}
+
+// DeleteDenomGraph deletes all of the pools and neighbors of the denom graph
+func (k Keeper) DeleteDenomGraph(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixDenomGraphPool)
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixDenomGraphNeighbors)
+}
+
 // GetAllProtocolRevenue returns all types of protocol revenue (txfees, taker fees, and cyclic arb profits), as well as the block height from which we started accounting
 // for each of these revenue sources.
 func (k Keeper) GetAllProtocolRevenue(ctx sdk.Context) types.AllProtocolRevenue {
@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v31/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v31/x/protorev/types"
+)
+
+// denomGraphEdge is the highest liquidity pool between two denoms of the denom graph
+type denomGraphEdge struct {
+	LiquidityPoolStruct
+	// amountA and amountB are the amounts of each denom in the pool, used to rank the neighbors of each denom
+	amountA osmomath.Int
+	amountB osmomath.Int
+}
+
+// denomGraphNeighbor is a neighbor of a denom in the denom graph with the amount of the denom in the pool between them
+type denomGraphNeighbor struct {
+	denom  string
+	amount osmomath.Int
+}
+
+// UpdateDenomGraph first deletes the denom graph and then rebuilds it from the pools if the route discovery is enabled.
+// The denom graph has an edge between two denoms for the highest liquidity pool between them. The neighbors of each
+// denom are ranked by the amount of the denom in the pool of the edge, and only the top MaxNeighbors are stored.
+func (k Keeper) UpdateDenomGraph(ctx sdk.Context) error {
+	k.DeleteDenomGraph(ctx)
+
+	routeDiscovery := k.GetRouteDiscovery(ctx)
+	if !routeDiscovery.Enabled {
+		return nil
+	}
+
+	pools, err := k.poolmanagerKeeper.AllPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	// edges maps each denom pair (sorted) to the highest liquidity pool between the two denoms
+	edges := make(map[[2]string]denomGraphEdge)
+	for _, pool := range pools {
+		coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
+		if err != nil {
+			return err
+		}
+
+		// Pool must be active and the number of coins must be 2
+		if !pool.IsActive(ctx) || len(coins) != 2 {
+			continue
+		}
+
+		tokenA, tokenB := coins[0], coins[1]
+		if tokenA.Denom > tokenB.Denom {
+			tokenA, tokenB = tokenB, tokenA
+		}
+
+		newEdge := denomGraphEdge{
+			LiquidityPoolStruct: LiquidityPoolStruct{
+				PoolId:    pool.GetId(),
+				Liquidity: tokenA.Amount.Mul(tokenB.Amount),
+			},
+			amountA: tokenA.Amount,
+			amountB: tokenB.Amount,
+		}
+
+		pair := [2]string{tokenA.Denom, tokenB.Denom}
+		if currEdge, ok := edges[pair]; !ok || newEdge.Liquidity.GT(currEdge.Liquidity) {
+			edges[pair] = newEdge
+		}
+	}
+
+	// Store the edges and collect the neighbors of each denom
+	neighbors := make(map[string][]denomGraphNeighbor)
+	for pair, edge := range edges {
+		k.SetDenomGraphPool(ctx, pair[0], pair[1], edge.PoolId)
+
+		neighbors[pair[0]] = append(neighbors[pair[0]], denomGraphNeighbor{denom: pair[1], amount: edge.amountA})
+		neighbors[pair[1]] = append(neighbors[pair[1]], denomGraphNeighbor{denom: pair[0], amount: edge.amountB})
+	}
+
+	// Store the top neighbors of each denom
+	for denom, denomNeighbors := range neighbors {
+		sort.Slice(denomNeighbors, func(i, j int) bool {
+			if !denomNeighbors[i].amount.Equal(denomNeighbors[j].amount) {
+				return denomNeighbors[i].amount.GT(denomNeighbors[j].amount)
+			}
+			return denomNeighbors[i].denom < denomNeighbors[j].denom
+		})
+
+		numNeighbors := min(uint64(len(denomNeighbors)), routeDiscovery.MaxNeighbors)
+		topNeighbors := make([]string, 0, numNeighbors)
+		for _, neighbor := range denomNeighbors[:numNeighbors] {
+			topNeighbors = append(topNeighbors, neighbor.denom)
+		}
+
+		k.SetDenomGraphNeighbors(ctx, denom, topNeighbors)
+	}
+
+	return nil
+}
+
+// BuildDiscoveredRoutes builds the cyclic arbitrage routes found by searching the denom graph for cycles that contain
+// the pool that was swapped on. Each cycle swaps on the pool in the opposite direction of the swap (tokenOut -> tokenIn)
+// and returns to tokenOut through at most MaxRouteLength - 1 other pools, using only the top neighbors of each denom.
+// Since profits must be made in a base denom, each cycle is rotated to start and end with its highest priority base denom
+// and cycles without any base denom are skipped. Routes that were already built are skipped, and the search stops once the
+// routes would consume more than the remaining pool points for the transaction.
+// The search visits at most MaxRouteDiscoveryVisitedNodes denoms, and a branch is pruned as soon as closing a cycle from it
+// would exceed the remaining pool points.
+func (k Keeper) BuildDiscoveredRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, builtRoutes []RouteMetaData) ([]RouteMetaData, error) {
+	return k.buildDiscoveredRoutes(ctx, tokenIn, tokenOut, poolId, builtRoutes, types.MaxRouteDiscoveryVisitedNodes)
+}
+
+// buildDiscoveredRoutes builds the discovered routes visiting at most maxVisitedNodes denoms.
+func (k Keeper) buildDiscoveredRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64, builtRoutes []RouteMetaData, maxVisitedNodes uint64) ([]RouteMetaData, error) {
+	routes := make([]RouteMetaData, 0)
+
+	routeDiscovery := k.GetRouteDiscovery(ctx)
+	if !routeDiscovery.Enabled {
+		return routes, nil
+	}
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
+	if err != nil {
+		return routes, err
+	}
+
+	// The routes that were already built are simulated first, so they consume the pool points first
+	builtRouteKeys := make(map[string]bool)
+	for _, route := range builtRoutes {
+		builtRouteKeys[discoveredRouteKey(route.Route)] = true
+		if route.PoolPoints >= remainingPoolPoints {
+			return routes, nil
+		}
+		remainingPoolPoints -= route.PoolPoints
+	}
+
+	infoByPoolType := k.GetInfoByPoolType(ctx)
+	swappedPoolPoints, err := k.calculatePoolPoints(ctx, infoByPoolType, poolId)
+	if err != nil {
+		return routes, err
+	}
+
+	search := routeDiscoverySearch{
+		k:                   k,
+		ctx:                 ctx,
+		infoByPoolType:      infoByPoolType,
+		targetDenom:         tokenOut,
+		maxHops:             int(routeDiscovery.MaxRouteLength) - 1,
+		maxVisitedNodes:     maxVisitedNodes,
+		minPoolPoints:       minPoolWeight(infoByPoolType),
+		remainingPoolPoints: remainingPoolPoints,
+		poolPoints:          map[uint64]uint64{poolId: swappedPoolPoints},
+		usedPools:           map[uint64]bool{poolId: true},
+		usedDenoms:          map[string]bool{tokenOut: true, tokenIn: true},
+		route:               poolmanagertypes.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: tokenIn}},
+		routePoints:         swappedPoolPoints,
+	}
+
+	search.onCycle = func(cycle poolmanagertypes.SwapAmountInRoutes, points uint64) bool {
+		if points > search.remainingPoolPoints {
+			return false
+		}
+
+		route, baseDenom, ok := rotateToBaseDenom(cycle, baseDenoms)
+		if !ok {
+			return false
+		}
+
+		key := discoveredRouteKey(route)
+		if builtRouteKeys[key] {
+			return false
+		}
+		builtRouteKeys[key] = true
+
+		routes = append(routes, RouteMetaData{
+			Route:      route,
+			PoolPoints: points,
+			StepSize:   baseDenom.StepSize,
+		})
+
+		search.remainingPoolPoints -= points
+		return search.remainingPoolPoints == 0
+	}
+
+	if swappedPoolPoints <= remainingPoolPoints {
+		search.search(tokenIn)
+	}
+
+	return routes, nil
+}
+
+// routeDiscoverySearch is a depth first search for the cycles of the denom graph that return to the target denom
+type routeDiscoverySearch struct {
+	k              Keeper
+	ctx            sdk.Context
+	infoByPoolType types.InfoByPoolType
+
+	// targetDenom is the denom the cycles return to
+	targetDenom string
+	// maxHops is the maximum number of hops after the first hop of the cycle
+	maxHops int
+	// maxVisitedNodes is the maximum number of denoms visited, and visitedNodes the number visited so far
+	maxVisitedNodes uint64
+	visitedNodes    uint64
+	// minPoolPoints is the lowest pool points of any pool, used to prune the routes that cannot close a cycle
+	// within the remaining pool points
+	minPoolPoints       uint64
+	remainingPoolPoints uint64
+	// onCycle is called for each cycle found with its pool points and returns true to stop the search
+	onCycle func(cycle poolmanagertypes.SwapAmountInRoutes, points uint64) bool
+
+	// poolPoints caches the pool points of the pools seen during the search
+	poolPoints map[uint64]uint64
+	usedPools  map[uint64]bool
+	usedDenoms map[string]bool
+
+	// route is the path from the target denom to the current denom
+	route       poolmanagertypes.SwapAmountInRoutes
+	routePoints uint64
+}
+
+// search extends the route from the current denom and returns true once the search must stop
+func (s *routeDiscoverySearch) search(denom string) bool {
+	s.visitedNodes++
+	if s.visitedNodes > s.maxVisitedNodes {
+		return true
+	}
+
+	// Closing the cycle requires at least one more pool
+	if s.routePoints+s.minPoolPoints > s.remainingPoolPoints {
+		return false
+	}
+
+	hops := len(s.route) - 1
+
+	// Close the cycle by swapping back to the target denom
+	if poolId, err := s.k.GetDenomGraphPool(s.ctx, denom, s.targetDenom); err == nil && !s.usedPools[poolId] {
+		if points, ok := s.getPoolPoints(poolId); ok {
+			cycle := make(poolmanagertypes.SwapAmountInRoutes, 0, len(s.route)+1)
+			cycle = append(cycle, s.route...)
+			cycle = append(cycle, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: s.targetDenom})
+
+			if s.onCycle(cycle, s.routePoints+points) {
+				return true
+			}
+		}
+	}
+
+	// At least one more hop is required to close the cycle after swapping to a neighbor
+	if hops+2 > s.maxHops {
+		return false
+	}
+
+	for _, neighbor := range s.k.GetDenomGraphNeighbors(s.ctx, denom) {
+		if s.usedDenoms[neighbor] {
+			continue
+		}
+
+		poolId, err := s.k.GetDenomGraphPool(s.ctx, denom, neighbor)
+		if err != nil || s.usedPools[poolId] {
+			continue
+		}
+
+		points, ok := s.getPoolPoints(poolId)
+		if !ok || s.routePoints+points+s.minPoolPoints > s.remainingPoolPoints {
+			continue
+		}
+
+		s.route = append(s.route, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: neighbor})
+		s.routePoints += points
+		s.usedPools[poolId] = true
+		s.usedDenoms[neighbor] = true
+
+		stop := s.search(neighbor)
+
+		s.route = s.route[:len(s.route)-1]
+		s.routePoints -= points
+		delete(s.usedPools, poolId)
+		delete(s.usedDenoms, neighbor)
+
+		if stop {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPoolPoints returns the pool points of the pool, or false if they cannot be calculated
+func (s *routeDiscoverySearch) getPoolPoints(poolId uint64) (uint64, bool) {
+	if points, ok := s.poolPoints[poolId]; ok {
+		return points, true
+	}
+
+	points, err := s.k.calculatePoolPoints(s.ctx, s.infoByPoolType, poolId)
+	if err != nil {
+		return 0, false
+	}
+
+	s.poolPoints[poolId] = points
+	return points, true
+}
+
+// minPoolWeight returns the lowest weight of any pool type, which is the fewest pool points a pool can consume
+func minPoolWeight(infoByPoolType types.InfoByPoolType) uint64 {
+	weight := min(infoByPoolType.Balancer.Weight, infoByPoolType.Stable.Weight, infoByPoolType.Concentrated.Weight)
+	for _, weightMap := range infoByPoolType.Cosmwasm.WeightMaps {
+		weight = min(weight, weightMap.Weight)
+	}
+	return weight
+}
+
+// rotateToBaseDenom rotates the cycle so that it starts and ends with the highest priority base denom it contains.
+// Returns false if the cycle does not contain any base denom.
+func rotateToBaseDenom(cycle poolmanagertypes.SwapAmountInRoutes, baseDenoms []types.BaseDenom) (poolmanagertypes.SwapAmountInRoutes, types.BaseDenom, bool) {
+	for _, baseDenom := range baseDenoms {
+		for i, hop := range cycle {
+			if hop.TokenOutDenom != baseDenom.Denom {
+				continue
+			}
+
+			route := make(poolmanagertypes.SwapAmountInRoutes, 0, len(cycle))
+			route = append(route, cycle[i+1:]...)
+			route = append(route, cycle[:i+1]...)
+			return route, baseDenom, true
+		}
+	}
+
+	return nil, types.BaseDenom{}, false
+}
+
+// discoveredRouteKey returns the key identifying a route by its pools and input denom
+func discoveredRouteKey(route poolmanagertypes.SwapAmountInRoutes) string {
+	if len(route) == 0 {
+		return ""
+	}
+	return string(types.CreateRouteKey(route.PoolIds())) + "|" + route[len(route)-1].TokenOutDenom
+}
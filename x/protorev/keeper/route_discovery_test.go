@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/v31/x/protorev/types"
+)
+
+// TestUpdateDenomGraph tests the UpdateDenomGraph function
+func (s *KeeperTestSuite) TestUpdateDenomGraph() {
+	s.SetupPoolsTest()
+
+	// The denom graph is empty when the route discovery is disabled
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: false, MaxRouteLength: 3, MaxNeighbors: 2})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+	s.Require().Empty(s.App.ProtoRevKeeper.GetDenomGraphNeighbors(s.Ctx, types.OsmosisDenomination))
+	_, err := s.App.ProtoRevKeeper.GetDenomGraphPool(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().Error(err)
+
+	// The denom graph is built when the route discovery is enabled
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 2})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+
+	// The edges of the denom graph are the highest liquidity pools, which match the pools stored for the base denoms
+	expectedPoolId, err := s.App.ProtoRevKeeper.GetPoolForDenomPair(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().NoError(err)
+	poolId, err := s.App.ProtoRevKeeper.GetDenomGraphPool(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPoolId, poolId)
+	poolId, err = s.App.ProtoRevKeeper.GetDenomGraphPool(s.Ctx, "Atom", types.OsmosisDenomination)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPoolId, poolId)
+
+	// Only the top neighbors are stored, and each of them has an edge
+	neighbors := s.App.ProtoRevKeeper.GetDenomGraphNeighbors(s.Ctx, types.OsmosisDenomination)
+	s.Require().Len(neighbors, 2)
+	for _, neighbor := range neighbors {
+		_, err := s.App.ProtoRevKeeper.GetDenomGraphPool(s.Ctx, types.OsmosisDenomination, neighbor)
+		s.Require().NoError(err)
+	}
+
+	// Rebuilding the denom graph with more neighbors keeps the previous top neighbors first
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: types.MaxRouteDiscoveryNeighbors})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+	allNeighbors := s.App.ProtoRevKeeper.GetDenomGraphNeighbors(s.Ctx, types.OsmosisDenomination)
+	s.Require().Greater(len(allNeighbors), len(neighbors))
+	s.Require().Equal(neighbors, allNeighbors[:len(neighbors)])
+
+	// Disabling the route discovery deletes the denom graph
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: false, MaxRouteLength: 3, MaxNeighbors: 2})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+	s.Require().Empty(s.App.ProtoRevKeeper.GetDenomGraphNeighbors(s.Ctx, types.OsmosisDenomination))
+	_, err = s.App.ProtoRevKeeper.GetDenomGraphPool(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().Error(err)
+}
+
+// TestBuildDiscoveredRoutes tests the BuildDiscoveredRoutes function
+func (s *KeeperTestSuite) TestBuildDiscoveredRoutes() {
+	cases := []struct {
+		description     string
+		routeDiscovery  types.RouteDiscovery
+		maxPointsPerTx  uint64
+		inputDenom      string
+		outputDenom     string
+		poolID          uint64
+		expectNoRoutes  bool
+		expectedMinimum int
+	}{
+		{
+			description:    "Route discovery is disabled",
+			routeDiscovery: types.RouteDiscovery{Enabled: false, MaxRouteLength: 3, MaxNeighbors: 5},
+			maxPointsPerTx: types.MaxPoolPointsPerTx,
+			inputDenom:     "akash",
+			outputDenom:    "Atom",
+			poolID:         1,
+			expectNoRoutes: true,
+		},
+		{
+			description:     "Routes are discovered for swap in akash and swap out Atom",
+			routeDiscovery:  types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5},
+			maxPointsPerTx:  types.MaxPoolPointsPerTx,
+			inputDenom:      "akash",
+			outputDenom:     "Atom",
+			poolID:          1,
+			expectedMinimum: 1,
+		},
+		{
+			description:     "Longer routes are discovered for swap in akash and swap out Atom",
+			routeDiscovery:  types.RouteDiscovery{Enabled: true, MaxRouteLength: 4, MaxNeighbors: 5},
+			maxPointsPerTx:  types.MaxPoolPointsPerTx,
+			inputDenom:      "akash",
+			outputDenom:     "Atom",
+			poolID:          1,
+			expectedMinimum: 1,
+		},
+		{
+			description:    "Not enough pool points to discover routes",
+			routeDiscovery: types.RouteDiscovery{Enabled: true, MaxRouteLength: 4, MaxNeighbors: 5},
+			maxPointsPerTx: 1,
+			inputDenom:     "akash",
+			outputDenom:    "Atom",
+			poolID:         1,
+			expectNoRoutes: true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			s.SetupPoolsTest()
+			s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, tc.routeDiscovery)
+			s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+			s.Require().NoError(s.App.ProtoRevKeeper.SetMaxPointsPerTx(s.Ctx, tc.maxPointsPerTx))
+
+			routes, err := s.App.ProtoRevKeeper.BuildDiscoveredRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID, nil)
+			s.Require().NoError(err)
+
+			if tc.expectNoRoutes {
+				s.Require().Empty(routes)
+				return
+			}
+			s.Require().GreaterOrEqual(len(routes), tc.expectedMinimum)
+
+			baseDenoms, err := s.App.ProtoRevKeeper.GetAllBaseDenoms(s.Ctx)
+			s.Require().NoError(err)
+			isBaseDenom := make(map[string]bool)
+			for _, baseDenom := range baseDenoms {
+				isBaseDenom[baseDenom.Denom] = true
+			}
+
+			remainingPoolPoints, _, err := s.App.ProtoRevKeeper.GetRemainingPoolPoints(s.Ctx)
+			s.Require().NoError(err)
+
+			totalPoolPoints := uint64(0)
+			seenRoutes := make(map[string]bool)
+			for _, route := range routes {
+				// The route is a cycle through the swapped pool that starts and ends with a base denom
+				s.Require().LessOrEqual(uint64(len(route.Route)), tc.routeDiscovery.MaxRouteLength)
+				s.Require().Contains(route.Route.PoolIds(), tc.poolID)
+				inputDenom := route.Route[len(route.Route)-1].TokenOutDenom
+				s.Require().True(isBaseDenom[inputDenom])
+
+				// The route does not reuse any pool or denom
+				seenPools := make(map[uint64]bool)
+				seenDenoms := make(map[string]bool)
+				for _, hop := range route.Route {
+					s.Require().False(seenPools[hop.PoolId])
+					s.Require().False(seenDenoms[hop.TokenOutDenom])
+					seenPools[hop.PoolId] = true
+					seenDenoms[hop.TokenOutDenom] = true
+				}
+
+				// The route swaps on the pool in the opposite direction of the swap
+				for i, hop := range route.Route {
+					if hop.PoolId == tc.poolID {
+						s.Require().Equal(tc.inputDenom, hop.TokenOutDenom)
+						previousHop := route.Route[(i+len(route.Route)-1)%len(route.Route)]
+						s.Require().Equal(tc.outputDenom, previousHop.TokenOutDenom)
+					}
+				}
+
+				// The routes are unique
+				key := string(types.CreateRouteKey(route.Route.PoolIds())) + "|" + inputDenom
+				s.Require().False(seenRoutes[key])
+				seenRoutes[key] = true
+
+				poolPoints, err := s.App.ProtoRevKeeper.CalculateRoutePoolPoints(s.Ctx, route.Route)
+				s.Require().NoError(err)
+				s.Require().Equal(poolPoints, route.PoolPoints)
+				totalPoolPoints += poolPoints
+			}
+
+			// The routes stay within the pool point budget
+			s.Require().LessOrEqual(totalPoolPoints, remainingPoolPoints)
+
+			// The routes that were already built are not discovered again
+			routesWithoutBuilt, err := s.App.ProtoRevKeeper.BuildDiscoveredRoutes(s.Ctx, tc.inputDenom, tc.outputDenom, tc.poolID, routes[:1])
+			s.Require().NoError(err)
+			for _, route := range routesWithoutBuilt {
+				s.Require().NotEqual(routes[0].Route, route.Route)
+			}
+		})
+	}
+}
+
+// TestBuildDiscoveredRoutesMaxVisitedNodes tests that the route discovery stops once it visited the maximum number of denoms
+func (s *KeeperTestSuite) TestBuildDiscoveredRoutesMaxVisitedNodes() {
+	s.SetupPoolsTest()
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: true, MaxRouteLength: 4, MaxNeighbors: 5})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+
+	routes, err := s.App.ProtoRevKeeper.BuildDiscoveredRoutes(s.Ctx, "akash", "Atom", 1, nil)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(routes)
+
+	// Without visiting any denom, no route is discovered
+	cappedRoutes, err := s.App.ProtoRevKeeper.BuildDiscoveredRoutesWithMaxVisitedNodes(s.Ctx, "akash", "Atom", 1, 0)
+	s.Require().NoError(err)
+	s.Require().Empty(cappedRoutes)
+
+	// Visiting only the denom swapped out of the pool, the cycles only close through a single other pool
+	cappedRoutes, err = s.App.ProtoRevKeeper.BuildDiscoveredRoutesWithMaxVisitedNodes(s.Ctx, "akash", "Atom", 1, 1)
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(len(cappedRoutes), len(routes))
+	for _, route := range cappedRoutes {
+		s.Require().Len(route.Route, 2)
+	}
+}
+
+// TestBuildRoutesWithRouteDiscovery tests that BuildRoutes appends the discovered routes to the other routes
+func (s *KeeperTestSuite) TestBuildRoutesWithRouteDiscovery() {
+	s.SetupPoolsTest()
+
+	routes := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, "akash", "Atom", 1)
+
+	s.App.ProtoRevKeeper.SetRouteDiscovery(s.Ctx, types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5})
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdateDenomGraph(s.Ctx))
+
+	routesWithDiscovery := s.App.ProtoRevKeeper.BuildRoutes(s.Ctx, "akash", "Atom", 1)
+	s.Require().Greater(len(routesWithDiscovery), len(routes))
+	s.Require().Equal(routes, routesWithDiscovery[:len(routes)])
+}
@@ -34,6 +34,11 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Append the routes found by the route discovery if it is enabled
+	if discoveredRoutes, err := k.BuildDiscoveredRoutes(ctx, tokenIn, tokenOut, poolId, routes); err == nil {
+		routes = append(routes, discoveredRoutes...)
+	}
+
 	return routes
 }
 
@@ -227,40 +232,12 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	totalWeight := uint64(0)
 
 	for _, poolId := range route.PoolIds() {
-		poolType, err := k.poolmanagerKeeper.GetPoolType(ctx, poolId)
+		weight, err := k.calculatePoolPoints(ctx, infoByPoolType, poolId)
 		if err != nil {
 			return 0, err
 		}
 
-		switch poolType {
-		case poolmanagertypes.Balancer:
-			totalWeight += infoByPoolType.Balancer.Weight
-		case poolmanagertypes.Stableswap:
-			totalWeight += infoByPoolType.Stable.Weight
-		case poolmanagertypes.Concentrated:
-			totalWeight += infoByPoolType.Concentrated.Weight
-		case poolmanagertypes.CosmWasm:
-			weight, ok := uint64(0), false
-			pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
-			if err != nil {
-				return 0, err
-			}
-			poolAddrString := pool.GetAddress().String()
-			for _, weightMap := range infoByPoolType.Cosmwasm.WeightMaps {
-				if weightMap.ContractAddress == poolAddrString {
-					weight = weightMap.Weight
-					ok = true
-					break
-				}
-			}
-			if !ok {
-				return 0, fmt.Errorf("cosmwasm pool %d does not have a weight", poolId)
-			}
-
-			totalWeight += weight
-		default:
-			return 0, errors.New("invalid pool type")
-		}
+		totalWeight += weight
 	}
 
 	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
@@ -276,6 +253,37 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	return totalWeight, nil
 }
 
+// calculatePoolPoints returns the number of pool points that a swap on the pool consumes, based on the pool type.
+func (k Keeper) calculatePoolPoints(ctx sdk.Context, infoByPoolType types.InfoByPoolType, poolId uint64) (uint64, error) {
+	poolType, err := k.poolmanagerKeeper.GetPoolType(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	switch poolType {
+	case poolmanagertypes.Balancer:
+		return infoByPoolType.Balancer.Weight, nil
+	case poolmanagertypes.Stableswap:
+		return infoByPoolType.Stable.Weight, nil
+	case poolmanagertypes.Concentrated:
+		return infoByPoolType.Concentrated.Weight, nil
+	case poolmanagertypes.CosmWasm:
+		pool, err := k.poolmanagerKeeper.GetPool(ctx, poolId)
+		if err != nil {
+			return 0, err
+		}
+		poolAddrString := pool.GetAddress().String()
+		for _, weightMap := range infoByPoolType.Cosmwasm.WeightMaps {
+			if weightMap.ContractAddress == poolAddrString {
+				return weightMap.Weight, nil
+			}
+		}
+		return 0, fmt.Errorf("cosmwasm pool %d does not have a weight", poolId)
+	default:
+		return 0, errors.New("invalid pool type")
+	}
+}
+
 // IsValidPool checks if the pool is active and exists
 func (k Keeper) IsValidPool(ctx sdk.Context, pool poolmanagertypes.PoolI) error {
 	if !pool.IsActive(ctx) {
@@ -31,6 +31,20 @@ const MaxPoolPointsPerBlock uint64 = 200
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
+// Bounds of the number of pools in a route found by the route discovery. The search time grows
+// exponentially with the route length.
+const (
+	MinDiscoveredRouteLength uint64 = 2
+	MaxDiscoveredRouteLength uint64 = 5
+)
+
+// Max number of highest liquidity pools of a denom that are explored by the route discovery.
+const MaxRouteDiscoveryNeighbors uint64 = 10
+
+// Max number of denoms visited by the route discovery when searching the routes of a swap. This bounds
+// the store reads and gas of the search regardless of the configured route length and neighbors.
+const MaxRouteDiscoveryVisitedNodes uint64 = 1_000
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
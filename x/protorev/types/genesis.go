@@ -43,6 +43,11 @@ var (
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
 	}
+	DefaultRouteDiscovery = RouteDiscovery{
+		Enabled:        false,
+		MaxRouteLength: 3,
+		MaxNeighbors:   5,
+	}
 )
 
 // DefaultGenesis returns the default genesis state
@@ -61,6 +66,7 @@ func DefaultGenesis() *GenesisState {
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
 		Profits:                DefaultProfits,
 		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		RouteDiscovery:         DefaultRouteDiscovery,
 	}
 }
 
@@ -103,6 +109,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the route discovery
+	if err := gs.RouteDiscovery.Validate(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
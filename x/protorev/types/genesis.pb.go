@@ -60,6 +60,8 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The configuration of the discovery of cyclic arbitrage routes.
+	RouteDiscovery RouteDiscovery `protobuf:"bytes,15,opt,name=route_discovery,json=routeDiscovery,proto3" json:"route_discovery" yaml:"route_discovery"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -194,6 +196,13 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetRouteDiscovery() RouteDiscovery {
+	if m != nil {
+		return m.RouteDiscovery
+	}
+	return RouteDiscovery{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -203,57 +212,59 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0x8f, 0x69, 0x48, 0xe9, 0x6c, 0xba, 0x6a, 0x06, 0x12, 0xcd, 0xae, 0x88, 0xd7, 0x0c, 0x0d,
-	0xac, 0x50, 0x6b, 0x2b, 0x2d, 0xa7, 0x1e, 0x90, 0xe2, 0xa0, 0x02, 0x42, 0x54, 0x91, 0x13, 0x84,
-	0x04, 0x12, 0xc3, 0xd8, 0x9e, 0xdd, 0x58, 0xb1, 0x3d, 0xd6, 0xcc, 0x6c, 0xba, 0x7e, 0x00, 0xee,
-	0x3c, 0x0c, 0x0f, 0xd1, 0x63, 0xc5, 0x89, 0xd3, 0x0a, 0x25, 0x47, 0x6e, 0xfb, 0x04, 0xc8, 0x33,
-	0xb3, 0x9b, 0x76, 0xb3, 0xa6, 0xb7, 0xcc, 0xf7, 0xfd, 0xfe, 0x7c, 0xbf, 0xf1, 0x37, 0x59, 0xf0,
-	0x19, 0x97, 0x05, 0x97, 0x99, 0x0c, 0x2a, 0xc1, 0x15, 0x17, 0xec, 0x32, 0xb8, 0x3c, 0x8c, 0x99,
-	0xa2, 0x87, 0xc1, 0x98, 0x95, 0x4c, 0x66, 0xd2, 0xd7, 0x0d, 0x88, 0x2c, 0xce, 0x5f, 0xe0, 0x7c,
-	0x8b, 0xeb, 0x7f, 0x34, 0xe6, 0x63, 0xae, 0xab, 0x41, 0xf3, 0x97, 0x01, 0xf4, 0x3f, 0x6f, 0xd5,
-	0x5d, 0x0a, 0x18, 0xe0, 0x41, 0x3b, 0x90, 0x0a, 0x5a, 0x58, 0xc3, 0x7e, 0x2f, 0xd1, 0x38, 0x62,
-	0x8c, 0xcc, 0xc1, 0xb6, 0x5c, 0x73, 0x0a, 0x62, 0x2a, 0xd9, 0x92, 0x9c, 0xf0, 0xac, 0x34, 0x7d,
-	0xfc, 0x2f, 0x00, 0xdb, 0xdf, 0x98, 0x30, 0xa7, 0x8a, 0x2a, 0x06, 0xbf, 0x02, 0x5b, 0x46, 0x1b,
-	0x39, 0x9e, 0x33, 0xec, 0x3c, 0xf1, 0xfc, 0xb6, 0x70, 0xfe, 0x89, 0xc6, 0x85, 0x9b, 0xaf, 0x66,
-	0x83, 0x8d, 0xc8, 0xb2, 0xe0, 0xef, 0x0e, 0xd8, 0x55, 0xfc, 0x82, 0x95, 0xa4, 0xa2, 0x99, 0x20,
-	0x54, 0xc4, 0x44, 0xf0, 0x89, 0x62, 0x12, 0xbd, 0xe7, 0xdd, 0x19, 0x76, 0x9e, 0x3c, 0x6a, 0xd7,
-	0x3b, 0x6b, 0x68, 0x27, 0x34, 0x13, 0x47, 0x22, 0x8e, 0x34, 0x27, 0x7c, 0xd8, 0x68, 0xcf, 0x67,
-	0x83, 0x8f, 0x6b, 0x5a, 0xe4, 0xcf, 0xf0, 0x5a, 0x61, 0x1c, 0x41, 0x75, 0x8b, 0x09, 0x7f, 0x03,
-	0x9d, 0x26, 0x33, 0x49, 0x59, 0xc9, 0x0b, 0x89, 0xee, 0x68, 0xf3, 0x4f, 0xdb, 0xcd, 0x43, 0x2a,
-	0xd9, 0xd7, 0x0d, 0x36, 0xec, 0x5b, 0x4f, 0x68, 0x3c, 0xdf, 0x50, 0xc1, 0x11, 0x88, 0x17, 0x30,
-	0x09, 0x6b, 0xb0, 0x5d, 0x71, 0x9e, 0x93, 0x97, 0x2c, 0x1b, 0x9f, 0x2b, 0x89, 0x36, 0xf5, 0x7d,
-	0x1d, 0xfc, 0xcf, 0x7d, 0x71, 0x9e, 0xff, 0x64, 0xc0, 0x61, 0x60, 0x4d, 0x0e, 0x8c, 0xc9, 0x9b,
-	0x42, 0xf8, 0x51, 0xca, 0x2a, 0xc1, 0x12, 0xaa, 0x58, 0xfa, 0x0c, 0x2b, 0x31, 0x61, 0x18, 0x39,
-	0x51, 0xa7, 0xba, 0x61, 0x43, 0x02, 0x7a, 0x29, 0xad, 0x25, 0x91, 0x59, 0x99, 0x30, 0x52, 0xf0,
-	0x74, 0x92, 0x33, 0x62, 0x77, 0x12, 0xbd, 0xef, 0x39, 0xc3, 0xcd, 0xf0, 0xe1, 0x7c, 0x36, 0xf0,
-	0x8c, 0x78, 0x2b, 0x14, 0x47, 0x7b, 0x4d, 0xef, 0xb4, 0x69, 0xfd, 0xa0, 0x3b, 0x76, 0x15, 0x20,
-	0x01, 0xdd, 0x94, 0x5d, 0xb2, 0x9c, 0x57, 0x4c, 0x90, 0x11, 0x63, 0x12, 0x6d, 0xe9, 0x0b, 0xec,
-	0xf9, 0x76, 0xbb, 0x9a, 0x7b, 0x58, 0x06, 0x3b, 0xe6, 0x59, 0x19, 0xee, 0xdb, 0x44, 0xbb, 0xd6,
-	0xf4, 0x2d, 0x3a, 0x8e, 0xee, 0x2f, 0x0b, 0xcf, 0x19, 0x93, 0xf0, 0x05, 0xf8, 0x30, 0xa7, 0x8a,
-	0x49, 0x45, 0xe2, 0x9c, 0x27, 0x17, 0xe4, 0x5c, 0x27, 0x43, 0x77, 0xf5, 0xec, 0xee, 0x7c, 0x36,
-	0xe8, 0x1b, 0x99, 0x35, 0x20, 0x1c, 0xed, 0x98, 0x6a, 0xd8, 0x14, 0xbf, 0xd5, 0x35, 0xf8, 0x0b,
-	0xd8, 0xb9, 0x71, 0xa4, 0x69, 0x2a, 0x98, 0x94, 0xe8, 0x03, 0xcf, 0x19, 0xde, 0x0b, 0xfd, 0xf9,
-	0x6c, 0x80, 0x56, 0x87, 0xb2, 0x10, 0xfc, 0xd7, 0x9f, 0x8f, 0xbb, 0x36, 0xd2, 0x91, 0x29, 0x45,
-	0x0f, 0x96, 0x28, 0x5b, 0x81, 0xbf, 0x82, 0x5e, 0x41, 0xa7, 0x44, 0x7f, 0xa4, 0x8a, 0x67, 0xa5,
-	0x92, 0xa4, 0xd1, 0xd0, 0x43, 0xa1, 0x7b, 0xab, 0xd7, 0xdd, 0x0a, 0xc5, 0xd1, 0x6e, 0x41, 0xa7,
-	0xcd, 0x16, 0x9c, 0xe8, 0xce, 0x09, 0x13, 0x3a, 0x02, 0xfc, 0x11, 0xec, 0xad, 0x23, 0xa9, 0x29,
-	0x02, 0x5a, 0xfc, 0x93, 0xf9, 0x6c, 0xb0, 0xdf, 0x2e, 0xae, 0xa6, 0x38, 0x82, 0xab, 0xca, 0x67,
-	0x53, 0x78, 0x0a, 0x76, 0x35, 0x8a, 0x24, 0x7c, 0x52, 0x2a, 0x32, 0xe2, 0x8b, 0x91, 0x3b, 0x5a,
-	0xd5, 0xbb, 0x79, 0x57, 0x6b, 0x61, 0x38, 0x82, 0xba, 0x7e, 0xdc, 0x94, 0x9f, 0x73, 0x3b, 0xeb,
-	0xf7, 0xe0, 0x6e, 0x25, 0xf8, 0x28, 0x53, 0x12, 0x6d, 0xbf, 0x6b, 0x25, 0xf6, 0xec, 0x4a, 0x74,
-	0xad, 0x8b, 0xe1, 0xe1, 0x68, 0xa1, 0x00, 0x27, 0x60, 0x27, 0x2b, 0x47, 0x9c, 0xc4, 0xb5, 0x09,
-	0xa5, 0xea, 0x8a, 0xa1, 0xfb, 0xfa, 0x1d, 0x0d, 0xdb, 0xdf, 0xd1, 0x77, 0xe5, 0x88, 0x87, 0x75,
-	0x93, 0xf6, 0xac, 0xae, 0x58, 0xe8, 0x59, 0x17, 0xfb, 0x8d, 0x6f, 0x09, 0xe2, 0xa8, 0x9b, 0xbd,
-	0xc5, 0x80, 0x2f, 0x01, 0x4c, 0xea, 0x24, 0xcf, 0x12, 0xfd, 0x5f, 0x44, 0x09, 0x9a, 0x5c, 0x30,
-	0x81, 0xba, 0xda, 0xf7, 0x8b, 0x76, 0xdf, 0x63, 0xcd, 0x39, 0x12, 0xf1, 0x99, 0x61, 0x84, 0xfb,
-	0xf3, 0xd9, 0xa0, 0x67, 0x5c, 0x6f, 0xeb, 0xe1, 0xe8, 0x41, 0xb2, 0x4a, 0x78, 0xf1, 0xea, 0xca,
-	0x75, 0x5e, 0x5f, 0xb9, 0xce, 0x3f, 0x57, 0xae, 0xf3, 0xc7, 0xb5, 0xbb, 0xf1, 0xfa, 0xda, 0xdd,
-	0xf8, 0xfb, 0xda, 0xdd, 0xf8, 0xf9, 0xcb, 0x71, 0xa6, 0xce, 0x27, 0xb1, 0x9f, 0xf0, 0x22, 0xb0,
-	0x03, 0x3c, 0xce, 0x69, 0x2c, 0x17, 0x87, 0xe0, 0xf2, 0xe9, 0x61, 0x30, 0xbd, 0xf9, 0x1d, 0x68,
-	0x82, 0xc9, 0x78, 0x4b, 0x9f, 0x9f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x30, 0x1e, 0x48, 0x5a,
-	0xa9, 0x06, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6e, 0xdb, 0x36,
+	0x18, 0x8f, 0xd6, 0x2c, 0x5d, 0xe9, 0xd4, 0x6d, 0xb8, 0x25, 0xa0, 0x8d, 0x45, 0xf6, 0xb8, 0x66,
+	0x33, 0x86, 0xd6, 0x42, 0xda, 0x9d, 0x7a, 0x18, 0x10, 0xa5, 0xe8, 0x36, 0x0c, 0x2b, 0x02, 0x26,
+	0xc3, 0x80, 0x0d, 0x18, 0x47, 0x49, 0xb4, 0x23, 0x44, 0x16, 0x35, 0x92, 0x76, 0xad, 0x07, 0xd8,
+	0x7d, 0x0f, 0xb3, 0x87, 0xe8, 0xb1, 0xd8, 0x69, 0x27, 0x63, 0x48, 0xde, 0xc0, 0x4f, 0x30, 0x88,
+	0xa4, 0x9d, 0xc6, 0x89, 0x9a, 0x9b, 0xf9, 0x7d, 0xbf, 0x3f, 0xfc, 0x7d, 0xfa, 0x24, 0x83, 0x2f,
+	0x84, 0x1a, 0x09, 0x95, 0xaa, 0xa0, 0x90, 0x42, 0x0b, 0xc9, 0x27, 0xc1, 0x64, 0x3f, 0xe2, 0x9a,
+	0xed, 0x07, 0x43, 0x9e, 0x73, 0x95, 0xaa, 0xbe, 0x69, 0x40, 0xe4, 0x70, 0xfd, 0x05, 0xae, 0xef,
+	0x70, 0xed, 0x4f, 0x86, 0x62, 0x28, 0x4c, 0x35, 0xa8, 0x7e, 0x59, 0x40, 0xfb, 0xcb, 0x5a, 0xdd,
+	0xa5, 0x80, 0x05, 0xee, 0xd5, 0x03, 0x99, 0x64, 0x23, 0x67, 0xd8, 0x6e, 0xc5, 0x06, 0x47, 0xad,
+	0x91, 0x3d, 0xb8, 0x96, 0x6f, 0x4f, 0x41, 0xc4, 0x14, 0x5f, 0x92, 0x63, 0x91, 0xe6, 0xb6, 0x8f,
+	0x67, 0x0d, 0xb0, 0xf9, 0xad, 0x0d, 0x73, 0xac, 0x99, 0xe6, 0xf0, 0x1b, 0xb0, 0x61, 0xb5, 0x91,
+	0xd7, 0xf5, 0x7a, 0x8d, 0xa7, 0xdd, 0x7e, 0x5d, 0xb8, 0xfe, 0x91, 0xc1, 0x85, 0xeb, 0x6f, 0x66,
+	0x9d, 0x35, 0xe2, 0x58, 0xf0, 0x4f, 0x0f, 0x6c, 0x6b, 0x71, 0xc6, 0x73, 0x5a, 0xb0, 0x54, 0x52,
+	0x26, 0x23, 0x2a, 0xc5, 0x58, 0x73, 0x85, 0x3e, 0xe8, 0xde, 0xe9, 0x35, 0x9e, 0x3e, 0xae, 0xd7,
+	0x3b, 0xa9, 0x68, 0x47, 0x2c, 0x95, 0x07, 0x32, 0x22, 0x86, 0x13, 0x3e, 0xaa, 0xb4, 0xe7, 0xb3,
+	0xce, 0xa7, 0x25, 0x1b, 0x65, 0xcf, 0xf1, 0x8d, 0xc2, 0x98, 0x40, 0x7d, 0x8d, 0x09, 0x7f, 0x07,
+	0x8d, 0x2a, 0x33, 0x4d, 0x78, 0x2e, 0x46, 0x0a, 0xdd, 0x31, 0xe6, 0x9f, 0xd7, 0x9b, 0x87, 0x4c,
+	0xf1, 0x17, 0x15, 0x36, 0x6c, 0x3b, 0x4f, 0x68, 0x3d, 0xdf, 0x51, 0xc1, 0x04, 0x44, 0x0b, 0x98,
+	0x82, 0x25, 0xd8, 0x2c, 0x84, 0xc8, 0xe8, 0x6b, 0x9e, 0x0e, 0x4f, 0xb5, 0x42, 0xeb, 0x66, 0x5e,
+	0x7b, 0xef, 0x99, 0x97, 0x10, 0xd9, 0xcf, 0x16, 0x1c, 0x06, 0xce, 0x64, 0xcf, 0x9a, 0xbc, 0x2b,
+	0x84, 0x1f, 0x27, 0xbc, 0x90, 0x3c, 0x66, 0x9a, 0x27, 0xcf, 0xb1, 0x96, 0x63, 0x8e, 0x91, 0x47,
+	0x1a, 0xc5, 0x25, 0x1b, 0x52, 0xd0, 0x4a, 0x58, 0xa9, 0xa8, 0x4a, 0xf3, 0x98, 0xd3, 0x91, 0x48,
+	0xc6, 0x19, 0xa7, 0x6e, 0x27, 0xd1, 0x87, 0x5d, 0xaf, 0xb7, 0x1e, 0x3e, 0x9a, 0xcf, 0x3a, 0x5d,
+	0x2b, 0x5e, 0x0b, 0xc5, 0x64, 0xa7, 0xea, 0x1d, 0x57, 0xad, 0x1f, 0x4d, 0xc7, 0xad, 0x02, 0xa4,
+	0xa0, 0x99, 0xf0, 0x09, 0xcf, 0x44, 0xc1, 0x25, 0x1d, 0x70, 0xae, 0xd0, 0x86, 0x19, 0x60, 0xab,
+	0xef, 0xb6, 0xab, 0x9a, 0xc3, 0x32, 0xd8, 0xa1, 0x48, 0xf3, 0x70, 0xd7, 0x25, 0xda, 0x76, 0xa6,
+	0x57, 0xe8, 0x98, 0xdc, 0x5f, 0x16, 0x5e, 0x72, 0xae, 0xe0, 0x2b, 0xf0, 0x71, 0xc6, 0x34, 0x57,
+	0x9a, 0x46, 0x99, 0x88, 0xcf, 0xe8, 0xa9, 0x49, 0x86, 0xee, 0x9a, 0xbb, 0xfb, 0xf3, 0x59, 0xa7,
+	0x6d, 0x65, 0x6e, 0x00, 0x61, 0xb2, 0x65, 0xab, 0x61, 0x55, 0xfc, 0xce, 0xd4, 0xe0, 0xaf, 0x60,
+	0xeb, 0xd2, 0x91, 0x25, 0x89, 0xe4, 0x4a, 0xa1, 0x8f, 0xba, 0x5e, 0xef, 0x5e, 0xd8, 0x9f, 0xcf,
+	0x3a, 0x68, 0xf5, 0x52, 0x0e, 0x82, 0xff, 0xf9, 0xfb, 0x49, 0xd3, 0x45, 0x3a, 0xb0, 0x25, 0xf2,
+	0x70, 0x89, 0x72, 0x15, 0xf8, 0x1b, 0x68, 0x8d, 0xd8, 0x94, 0x9a, 0x87, 0x54, 0x88, 0x34, 0xd7,
+	0x8a, 0x56, 0x1a, 0xe6, 0x52, 0xe8, 0xde, 0xea, 0xb8, 0x6b, 0xa1, 0x98, 0x6c, 0x8f, 0xd8, 0xb4,
+	0xda, 0x82, 0x23, 0xd3, 0x39, 0xe2, 0xd2, 0x44, 0x80, 0x3f, 0x81, 0x9d, 0x9b, 0x48, 0x7a, 0x8a,
+	0x80, 0x11, 0xff, 0x6c, 0x3e, 0xeb, 0xec, 0xd6, 0x8b, 0xeb, 0x29, 0x26, 0x70, 0x55, 0xf9, 0x64,
+	0x0a, 0x8f, 0xc1, 0xb6, 0x41, 0xd1, 0x58, 0x8c, 0x73, 0x4d, 0x07, 0x62, 0x71, 0xe5, 0x86, 0x51,
+	0xed, 0x5e, 0xbe, 0x57, 0x37, 0xc2, 0x30, 0x81, 0xa6, 0x7e, 0x58, 0x95, 0x5f, 0x0a, 0x77, 0xd7,
+	0x1f, 0xc0, 0xdd, 0x42, 0x8a, 0x41, 0xaa, 0x15, 0xda, 0xbc, 0x6d, 0x25, 0x76, 0xdc, 0x4a, 0x34,
+	0x9d, 0x8b, 0xe5, 0x61, 0xb2, 0x50, 0x80, 0x63, 0xb0, 0x95, 0xe6, 0x03, 0x41, 0xa3, 0xd2, 0x86,
+	0xd2, 0x65, 0xc1, 0xd1, 0x7d, 0xf3, 0x1e, 0xf5, 0xea, 0xdf, 0xa3, 0xef, 0xf3, 0x81, 0x08, 0xcb,
+	0x2a, 0xed, 0x49, 0x59, 0xf0, 0xb0, 0xeb, 0x5c, 0xdc, 0x33, 0xbe, 0x26, 0x88, 0x49, 0x33, 0xbd,
+	0xc2, 0x80, 0xaf, 0x01, 0x8c, 0xcb, 0x38, 0x4b, 0x63, 0xf3, 0x15, 0xd1, 0x92, 0xc5, 0x67, 0x5c,
+	0xa2, 0xa6, 0xf1, 0xfd, 0xaa, 0xde, 0xf7, 0xd0, 0x70, 0x0e, 0x64, 0x74, 0x62, 0x19, 0xe1, 0xee,
+	0x7c, 0xd6, 0x69, 0x59, 0xd7, 0xeb, 0x7a, 0x98, 0x3c, 0x8c, 0x57, 0x08, 0xf0, 0x0f, 0xf0, 0xc0,
+	0x7c, 0xb3, 0x68, 0x92, 0xaa, 0x58, 0x4c, 0xb8, 0x2c, 0xd1, 0x83, 0xdb, 0xd2, 0x9a, 0xef, 0xd9,
+	0x8b, 0x05, 0x3e, 0xf4, 0x5d, 0xda, 0x1d, 0xeb, 0xbb, 0x22, 0x87, 0x49, 0x53, 0x5e, 0xc5, 0xbf,
+	0x7a, 0x73, 0xee, 0x7b, 0x6f, 0xcf, 0x7d, 0xef, 0xbf, 0x73, 0xdf, 0xfb, 0xeb, 0xc2, 0x5f, 0x7b,
+	0x7b, 0xe1, 0xaf, 0xfd, 0x7b, 0xe1, 0xaf, 0xfd, 0xf2, 0xf5, 0x30, 0xd5, 0xa7, 0xe3, 0xa8, 0x1f,
+	0x8b, 0x51, 0xe0, 0xdc, 0x9f, 0x64, 0x2c, 0x52, 0x8b, 0x43, 0x30, 0x79, 0xb6, 0x1f, 0x4c, 0x2f,
+	0xff, 0x7a, 0xaa, 0x59, 0xaa, 0x68, 0xc3, 0x9c, 0x9f, 0xfd, 0x3f, 0x00, 0xb9, 0x9a, 0x73, 0x17,
+	0x1c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +287,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.RouteDiscovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +500,8 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.RouteDiscovery.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -915,6 +938,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteDiscovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteDiscovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,9 @@ const (
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
 	prefixBaseDenoms
+	prefixRouteDiscovery
+	prefixDenomGraphPool
+	prefixDenomGraphNeighbors
 )
 
 var (
@@ -103,6 +106,15 @@ var (
 
 	// KeyPrefixBaseDenoms is the prefix that is used to store the base denoms that are used to create cyclic arbitrage routes
 	KeyPrefixBaseDenoms = []byte{prefixBaseDenoms}
+
+	// KeyPrefixRouteDiscovery is the prefix for store that keeps track of the route discovery configuration
+	KeyPrefixRouteDiscovery = []byte{prefixRouteDiscovery}
+
+	// KeyPrefixDenomGraphPool is the prefix for store that keeps track of the highest liquidity pool between two denoms of the denom graph
+	KeyPrefixDenomGraphPool = []byte{prefixDenomGraphPool}
+
+	// KeyPrefixDenomGraphNeighbors is the prefix for store that keeps track of the neighbors of each denom of the denom graph
+	KeyPrefixDenomGraphNeighbors = []byte{prefixDenomGraphNeighbors}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -110,6 +122,21 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key needed to fetch the pool id of the denom graph edge between two denoms
+func GetKeyDenomGraphPool(denomA, denomB string) []byte {
+	return append(KeyPrefixDenomGraphPool, []byte(denomA+"|"+denomB)...)
+}
+
+// Returns the key prefix needed to fetch the neighbors of a denom in the denom graph
+func GetKeyPrefixDenomGraphNeighbors(denom string) []byte {
+	return append(KeyPrefixDenomGraphNeighbors, []byte(denom+"|")...)
+}
+
+// Returns the key needed to fetch the neighbor of a denom in the denom graph with the given rank
+func GetKeyDenomGraphNeighbor(denom string, rank uint64) []byte {
+	return append(GetKeyPrefixDenomGraphNeighbors(denom), sdk.Uint64ToBigEndian(rank)...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func DeprecatedGetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixDeprecatedBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
 	_ sdk.Msg = &MsgSetInfoByPoolType{}
 	_ sdk.Msg = &MsgSetBaseDenoms{}
+	_ sdk.Msg = &MsgSetRouteDiscovery{}
 )
 
 const (
@@ -21,6 +22,7 @@ const (
 	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
 	TypeMsgSetPoolTypeInfo          = "set_info_by_pool_type"
 	TypeMsgSetBaseDenoms            = "set_base_denoms"
+	TypeMsgSetRouteDiscovery        = "set_route_discovery"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -263,3 +265,42 @@ func (msg MsgSetBaseDenoms) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetRouteDiscovery ---------------------- //
+// NewMsgSetRouteDiscovery creates a new MsgSetRouteDiscovery instance
+func NewMsgSetRouteDiscovery(admin string, routeDiscovery RouteDiscovery) *MsgSetRouteDiscovery {
+	return &MsgSetRouteDiscovery{
+		Admin:          admin,
+		RouteDiscovery: routeDiscovery,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetRouteDiscovery) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetRouteDiscovery) Type() string {
+	return TypeMsgSetRouteDiscovery
+}
+
+// ValidateBasic validates the MsgSetRouteDiscovery
+func (msg MsgSetRouteDiscovery) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return errorsmod.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	if err := msg.RouteDiscovery.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetRouteDiscovery) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -723,6 +723,70 @@ func TestMsgSetBaseDenoms(t *testing.T) {
 	}
 }
 
+func TestMsgSetRouteDiscovery(t *testing.T) {
+	cases := []struct {
+		description    string
+		admin          string
+		routeDiscovery types.RouteDiscovery
+		pass           bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 5},
+			false,
+		},
+		{
+			"Invalid message (max route length too short)",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: types.MinDiscoveredRouteLength - 1, MaxNeighbors: 5},
+			false,
+		},
+		{
+			"Invalid message (max route length too long)",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: types.MaxDiscoveredRouteLength + 1, MaxNeighbors: 5},
+			false,
+		},
+		{
+			"Invalid message (no neighbors)",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: 0},
+			false,
+		},
+		{
+			"Invalid message (too many neighbors)",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: 3, MaxNeighbors: types.MaxRouteDiscoveryNeighbors + 1},
+			false,
+		},
+		{
+			"Valid message (disabled)",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: false, MaxRouteLength: 3, MaxNeighbors: 5},
+			true,
+		},
+		{
+			"Valid message",
+			createAccount().String(),
+			types.RouteDiscovery{Enabled: true, MaxRouteLength: types.MaxDiscoveredRouteLength, MaxNeighbors: types.MaxRouteDiscoveryNeighbors},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			msg := types.NewMsgSetRouteDiscovery(tc.admin, tc.routeDiscovery)
+			err := msg.ValidateBasic()
+			if tc.pass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func createAccount() sdk.AccAddress {
 	pk := ed25519.GenPrivKey().PubKey()
 	return sdk.AccAddress(pk.Address())
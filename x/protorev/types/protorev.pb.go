@@ -672,6 +672,74 @@ func (m *WeightMap) GetContractAddress() string {
 	return ""
 }
 
+// RouteDiscovery configures the discovery of cyclic arbitrage routes through
+// the graph of the denoms paired in the pools. When enabled, the discovered
+// routes are tried after the hot routes and the highest liquidity routes.
+type RouteDiscovery struct {
+	// Whether the route discovery is enabled
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// The maximum number of pools in a discovered route, including the pool
+	// that was swapped on
+	MaxRouteLength uint64 `protobuf:"varint,2,opt,name=max_route_length,json=maxRouteLength,proto3" json:"max_route_length,omitempty" yaml:"max_route_length"`
+	// The number of highest liquidity pools of a denom that are explored when
+	// searching for routes
+	MaxNeighbors uint64 `protobuf:"varint,3,opt,name=max_neighbors,json=maxNeighbors,proto3" json:"max_neighbors,omitempty" yaml:"max_neighbors"`
+}
+
+func (m *RouteDiscovery) Reset()         { *m = RouteDiscovery{} }
+func (m *RouteDiscovery) String() string { return proto.CompactTextString(m) }
+func (*RouteDiscovery) ProtoMessage()    {}
+func (*RouteDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{11}
+}
+func (m *RouteDiscovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteDiscovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteDiscovery.Merge(m, src)
+}
+func (m *RouteDiscovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteDiscovery proto.InternalMessageInfo
+
+func (m *RouteDiscovery) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *RouteDiscovery) GetMaxRouteLength() uint64 {
+	if m != nil {
+		return m.MaxRouteLength
+	}
+	return 0
+}
+
+func (m *RouteDiscovery) GetMaxNeighbors() uint64 {
+	if m != nil {
+		return m.MaxNeighbors
+	}
+	return 0
+}
+
 // BaseDenom represents a single base denom that the module uses for its
 // arbitrage trades. It contains the denom name alongside the step size of the
 // binary search that is used to find the optimal swap amount
@@ -687,7 +755,7 @@ func (m *BaseDenom) Reset()         { *m = BaseDenom{} }
 func (m *BaseDenom) String() string { return proto.CompactTextString(m) }
 func (*BaseDenom) ProtoMessage()    {}
 func (*BaseDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{11}
+	return fileDescriptor_1e9f2391fd9fec01, []int{12}
 }
 func (m *BaseDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +801,7 @@ func (m *BaseDenoms) Reset()         { *m = BaseDenoms{} }
 func (m *BaseDenoms) String() string { return proto.CompactTextString(m) }
 func (*BaseDenoms) ProtoMessage()    {}
 func (*BaseDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{12}
+	return fileDescriptor_1e9f2391fd9fec01, []int{13}
 }
 func (m *BaseDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +848,7 @@ func (m *AllProtocolRevenue) Reset()         { *m = AllProtocolRevenue{} }
 func (m *AllProtocolRevenue) String() string { return proto.CompactTextString(m) }
 func (*AllProtocolRevenue) ProtoMessage()    {}
 func (*AllProtocolRevenue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{13}
+	return fileDescriptor_1e9f2391fd9fec01, []int{14}
 }
 func (m *AllProtocolRevenue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +908,7 @@ func (m *CyclicArbTracker) Reset()         { *m = CyclicArbTracker{} }
 func (m *CyclicArbTracker) String() string { return proto.CompactTextString(m) }
 func (*CyclicArbTracker) ProtoMessage()    {}
 func (*CyclicArbTracker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{14}
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
 }
 func (m *CyclicArbTracker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,6 +963,7 @@ func init() {
 	proto.RegisterType((*ConcentratedPoolInfo)(nil), "osmosis.protorev.v1beta1.ConcentratedPoolInfo")
 	proto.RegisterType((*CosmwasmPoolInfo)(nil), "osmosis.protorev.v1beta1.CosmwasmPoolInfo")
 	proto.RegisterType((*WeightMap)(nil), "osmosis.protorev.v1beta1.WeightMap")
+	proto.RegisterType((*RouteDiscovery)(nil), "osmosis.protorev.v1beta1.RouteDiscovery")
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
 	proto.RegisterType((*BaseDenoms)(nil), "osmosis.protorev.v1beta1.BaseDenoms")
 	proto.RegisterType((*AllProtocolRevenue)(nil), "osmosis.protorev.v1beta1.AllProtocolRevenue")
@@ -906,84 +975,89 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x8f, 0xd3, 0x46,
-	0x14, 0x5e, 0x93, 0x65, 0x21, 0x13, 0x48, 0xc2, 0xb0, 0x40, 0x36, 0xb4, 0xf1, 0x76, 0xa0, 0x6d,
-	0xa8, 0x4a, 0xa2, 0x5d, 0x7a, 0xa8, 0xa8, 0xa8, 0xb4, 0xde, 0x0a, 0x15, 0x55, 0x05, 0x34, 0x1b,
-	0x09, 0xb5, 0x17, 0x77, 0xec, 0x4c, 0xb2, 0x56, 0x62, 0x4f, 0xe4, 0x99, 0x2c, 0x59, 0x2a, 0x21,
-	0x55, 0x3d, 0xf6, 0xd2, 0x0b, 0xb7, 0x1e, 0x7a, 0xeb, 0xa9, 0x7f, 0x43, 0xaf, 0x1c, 0x39, 0xa2,
-	0x1e, 0xac, 0x0a, 0x2e, 0x55, 0x8f, 0xf9, 0x0b, 0xaa, 0xf9, 0x61, 0x27, 0xf1, 0x12, 0x60, 0xa5,
-	0xaa, 0x37, 0xfb, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xdf, 0x78, 0x26, 0x13, 0xf0, 0x21, 0xe3, 0x21,
-	0xe3, 0x01, 0x6f, 0x8f, 0x62, 0x26, 0x58, 0x4c, 0x0f, 0xda, 0x07, 0x5b, 0x1e, 0x15, 0x64, 0x2b,
-	0x0b, 0xb4, 0xd4, 0x03, 0xac, 0x99, 0xc2, 0x56, 0x16, 0x37, 0x85, 0xf5, 0x0d, 0x5f, 0xa5, 0x5c,
-	0x95, 0x68, 0xeb, 0x17, 0x5d, 0x55, 0x5f, 0xef, 0xb3, 0x3e, 0xd3, 0x71, 0xf9, 0x64, 0xa2, 0x0d,
-	0x5d, 0xd3, 0xf6, 0x08, 0xa7, 0x99, 0x9c, 0xcf, 0x82, 0xc8, 0xe4, 0xaf, 0x65, 0x3d, 0x31, 0x36,
-	0x0c, 0x49, 0x44, 0xfa, 0x34, 0xce, 0xea, 0xfa, 0x34, 0xa2, 0x59, 0x1b, 0xf5, 0xab, 0x69, 0xa9,
-	0x98, 0xf4, 0x28, 0xe5, 0xaf, 0xae, 0x42, 0xcf, 0x2d, 0x00, 0x3b, 0x6c, 0x40, 0xa3, 0xfb, 0x24,
-	0x88, 0x77, 0x62, 0x0f, 0xb3, 0xb1, 0xa0, 0x1c, 0x7e, 0x03, 0x00, 0x89, 0x3d, 0x37, 0x56, 0x6f,
-	0x35, 0x6b, 0xb3, 0xd0, 0x2c, 0x6d, 0xdb, 0xad, 0x65, 0x73, 0xb6, 0x14, 0xca, 0xd9, 0x78, 0x9a,
-	0xd8, 0x2b, 0xd3, 0xc4, 0x3e, 0x77, 0x48, 0xc2, 0xe1, 0x4d, 0x34, 0x23, 0x40, 0xb8, 0x48, 0x32,
-	0xea, 0x16, 0x38, 0x2d, 0xa4, 0xa0, 0x1b, 0x44, 0xb5, 0x13, 0x9b, 0x56, 0xb3, 0xe8, 0x9c, 0x9f,
-	0x26, 0x76, 0x45, 0x63, 0xd2, 0x0c, 0xc2, 0xa7, 0xd4, 0xe3, 0x9d, 0x08, 0x6e, 0x81, 0xa2, 0x8e,
-	0xb2, 0xb1, 0xa8, 0x15, 0x14, 0x60, 0x7d, 0x9a, 0xd8, 0xd5, 0x79, 0x00, 0x1b, 0x0b, 0x84, 0x35,
-	0xed, 0xbd, 0xb1, 0xb8, 0xb9, 0xfa, 0xf7, 0xaf, 0xb6, 0x85, 0x7e, 0xb7, 0xc0, 0x49, 0xa5, 0x09,
-	0xef, 0x82, 0x35, 0x11, 0x93, 0xee, 0xdb, 0x4c, 0xd2, 0x91, 0x75, 0xce, 0x05, 0x33, 0xc9, 0x59,
-	0x23, 0xa2, 0xc0, 0x08, 0x1b, 0x16, 0x78, 0x17, 0x14, 0xb9, 0xa0, 0x23, 0x97, 0x07, 0x8f, 0xa8,
-	0x99, 0x61, 0x4b, 0x22, 0xfe, 0x4c, 0xec, 0x0b, 0x7a, 0x01, 0x79, 0x77, 0xd0, 0x0a, 0x58, 0x3b,
-	0x24, 0x62, 0xbf, 0x75, 0x27, 0x12, 0xb3, 0x7e, 0x33, 0x1c, 0xc2, 0xa7, 0xe5, 0xf3, 0x5e, 0xf0,
-	0x88, 0x9a, 0x7e, 0x9f, 0x58, 0xe0, 0xa4, 0x92, 0x87, 0x57, 0xc0, 0xaa, 0x5c, 0xdf, 0x9a, 0xb5,
-	0x69, 0x35, 0x57, 0x9d, 0xca, 0x34, 0xb1, 0x4b, 0x1a, 0x2d, 0xa3, 0x08, 0xab, 0xe4, 0xff, 0xe7,
-	0xe3, 0x3f, 0x16, 0xa8, 0x28, 0x1f, 0xf7, 0x04, 0x11, 0x01, 0x17, 0x81, 0xcf, 0xe1, 0x57, 0xe0,
-	0xd4, 0x28, 0x66, 0xbd, 0x40, 0xa4, 0x96, 0x6e, 0xb4, 0xcc, 0xd7, 0x2d, 0xbf, 0xdc, 0xcc, 0xcd,
-	0x5d, 0x16, 0x44, 0xce, 0x45, 0x63, 0x66, 0xd9, 0xcc, 0xa0, 0x71, 0x08, 0xa7, 0x0c, 0xd0, 0x03,
-	0xd5, 0x68, 0x1c, 0x7a, 0x34, 0x76, 0x59, 0xcf, 0x35, 0x0b, 0xa5, 0x27, 0xfa, 0xf4, 0x4d, 0xae,
-	0x5e, 0xd2, 0x9c, 0x79, 0x38, 0xc2, 0x65, 0x1d, 0xba, 0xd7, 0xeb, 0xe8, 0x25, 0xfb, 0x00, 0x9c,
-	0x54, 0xdf, 0x62, 0xad, 0xb0, 0x59, 0x68, 0xae, 0x3a, 0xd5, 0x69, 0x62, 0x9f, 0xd1, 0x58, 0x15,
-	0x46, 0x58, 0xa7, 0xd1, 0x6f, 0x27, 0x40, 0xe9, 0x3e, 0x63, 0xc3, 0x07, 0x34, 0xe8, 0xef, 0x0b,
-	0x0e, 0x6f, 0x81, 0xb3, 0x5c, 0x10, 0x6f, 0x48, 0xdd, 0x87, 0x2a, 0x62, 0xd6, 0xa4, 0x36, 0x4d,
-	0xec, 0xf5, 0x74, 0x45, 0xe7, 0xd2, 0x08, 0x9f, 0xd1, 0xef, 0x1a, 0x0f, 0x77, 0x41, 0xc5, 0x23,
-	0x43, 0x12, 0xf9, 0x34, 0x4e, 0x09, 0x4e, 0x28, 0x82, 0xfa, 0x34, 0xb1, 0x2f, 0x6a, 0x82, 0x5c,
-	0x01, 0xc2, 0xe5, 0x34, 0x62, 0x48, 0xee, 0x81, 0xf3, 0x3e, 0x8b, 0x7c, 0x1a, 0x89, 0x98, 0x08,
-	0xda, 0x4d, 0x89, 0x0a, 0x8a, 0xa8, 0x31, 0x4d, 0xec, 0xba, 0x26, 0x7a, 0x45, 0x11, 0xc2, 0x70,
-	0x3e, 0x3a, 0xeb, 0x4a, 0x1a, 0xfa, 0x90, 0xf0, 0x30, 0x25, 0x5b, 0xcd, 0x77, 0x95, 0x2b, 0x40,
-	0xb8, 0x9c, 0x46, 0x34, 0x09, 0xfa, 0xa5, 0x00, 0xca, 0x77, 0xa2, 0x1e, 0x73, 0x0e, 0xa5, 0x5f,
-	0x9d, 0xc3, 0x11, 0x85, 0x0f, 0xc0, 0x9a, 0x9e, 0x5e, 0xb9, 0x54, 0xda, 0x6e, 0x2e, 0xdf, 0x67,
-	0x7b, 0xaa, 0x4e, 0x22, 0x15, 0x47, 0x6e, 0xc3, 0x69, 0x16, 0x84, 0x0d, 0x1d, 0x74, 0xc1, 0xe9,
-	0xd4, 0x13, 0xe5, 0x5f, 0x69, 0xfb, 0xa3, 0xe5, 0xd4, 0x8e, 0xa9, 0xcc, 0xc8, 0x2f, 0x19, 0xf2,
-	0xca, 0xa2, 0xdf, 0x08, 0x67, 0xa4, 0x90, 0x81, 0x33, 0xf3, 0x3e, 0x29, 0x6f, 0x4b, 0xdb, 0xad,
-	0xe5, 0x22, 0xbb, 0x73, 0xd5, 0x99, 0xd0, 0x65, 0x23, 0x74, 0xfe, 0xe8, 0x7a, 0x20, 0xbc, 0x20,
-	0x20, 0x27, 0x4a, 0xfd, 0x54, 0xde, 0xbf, 0x76, 0xa2, 0x5d, 0x53, 0xb9, 0x6c, 0xa2, 0x94, 0x09,
-	0xe1, 0x8c, 0x14, 0x7d, 0x06, 0xca, 0x8b, 0x1e, 0xc3, 0x6b, 0x60, 0x6d, 0xe1, 0x1b, 0x3e, 0x37,
-	0xf3, 0x3b, 0x5d, 0x63, 0x53, 0x80, 0x6e, 0x81, 0x6a, 0xde, 0xc5, 0xe3, 0xc0, 0x7f, 0xb2, 0xc0,
-	0xfa, 0xab, 0x0c, 0x3a, 0x06, 0x07, 0xfc, 0x12, 0x9c, 0x0b, 0xc9, 0xc4, 0x15, 0x81, 0x3f, 0xe0,
-	0xae, 0x1f, 0x33, 0xce, 0x69, 0xd7, 0xec, 0x9d, 0x77, 0xa6, 0x89, 0x5d, 0xd3, 0xa8, 0x23, 0x25,
-	0x08, 0x57, 0x42, 0x32, 0xe9, 0xc8, 0xd0, 0xae, 0x89, 0x08, 0x50, 0xcd, 0x1b, 0x08, 0xbf, 0x03,
-	0x25, 0xad, 0xe3, 0x86, 0x64, 0x94, 0x9e, 0x61, 0x57, 0x96, 0xaf, 0x80, 0xfe, 0xe6, 0xbf, 0x26,
-	0x23, 0xa7, 0x6e, 0xac, 0x87, 0xf3, 0x6d, 0x2b, 0x16, 0x84, 0xc1, 0xc3, 0xb4, 0x8c, 0xa3, 0xc7,
-	0xa0, 0x98, 0x81, 0x8e, 0x33, 0xf7, 0x6d, 0x50, 0xf5, 0x99, 0xf4, 0xcd, 0x17, 0x2e, 0xe9, 0x76,
-	0x63, 0xca, 0xd3, 0xc3, 0xf0, 0xf2, 0xec, 0xbc, 0xcb, 0x57, 0x20, 0x5c, 0x49, 0x43, 0x3b, 0x26,
-	0xf2, 0xa3, 0x05, 0x8a, 0x0e, 0xe1, 0xf4, 0x0b, 0x1a, 0xb1, 0x50, 0x1e, 0x7f, 0x5d, 0xf9, 0xa0,
-	0xf4, 0x8b, 0xf3, 0xc7, 0x9f, 0x0a, 0x23, 0xac, 0xd3, 0xff, 0xf5, 0x2f, 0x1b, 0x8a, 0x00, 0xc8,
-	0x9a, 0xe0, 0xd2, 0x75, 0xf9, 0xf3, 0xe0, 0x2a, 0xad, 0xb7, 0x70, 0x3d, 0x83, 0xe6, 0x5d, 0x9f,
-	0x63, 0x41, 0x18, 0x78, 0x99, 0x02, 0x7a, 0x52, 0x00, 0x70, 0x67, 0x38, 0xbc, 0x2f, 0x99, 0x7c,
-	0x36, 0xc4, 0xf4, 0x80, 0x46, 0x63, 0x0a, 0x1f, 0x03, 0x28, 0xc8, 0x80, 0xc6, 0xae, 0xbc, 0x09,
-	0xc9, 0xdf, 0x08, 0x7f, 0x40, 0x63, 0x73, 0x48, 0x5d, 0x9f, 0xe9, 0xcf, 0xee, 0x54, 0xb3, 0xfb,
-	0x80, 0x84, 0xdd, 0xa6, 0x94, 0x77, 0x34, 0xc8, 0x79, 0xcf, 0x74, 0xb2, 0x61, 0x7e, 0x37, 0x8f,
-	0xd0, 0x22, 0x5c, 0x15, 0x39, 0x10, 0xfc, 0xc1, 0x02, 0x15, 0x31, 0x59, 0x54, 0xd7, 0xe7, 0xd8,
-	0xfb, 0x99, 0xba, 0xbe, 0xa6, 0xcd, 0x84, 0x27, 0xf3, 0xaa, 0xdb, 0x46, 0xb5, 0x69, 0x54, 0x17,
-	0xb9, 0xd0, 0xc7, 0x5d, 0x3a, 0x8a, 0xa9, 0x2f, 0xf7, 0x9a, 0xbc, 0xad, 0x8c, 0x29, 0xaa, 0x59,
-	0xf8, 0xac, 0x98, 0xa7, 0x80, 0xdf, 0x03, 0xe8, 0x1f, 0xfa, 0xc3, 0xc0, 0x77, 0xe5, 0xc5, 0x2c,
-	0xed, 0xa2, 0xf0, 0xc6, 0xb3, 0x47, 0x61, 0x76, 0x62, 0x6f, 0x89, 0x01, 0x47, 0x39, 0x11, 0xae,
-	0xfa, 0x39, 0x10, 0xfa, 0xc3, 0x02, 0xd5, 0x3c, 0x13, 0xfc, 0x1c, 0x80, 0x19, 0xfa, 0xcd, 0xf7,
-	0x88, 0x55, 0x29, 0x8c, 0x8b, 0x19, 0x37, 0x1c, 0x80, 0x77, 0xf7, 0xf5, 0xf6, 0x23, 0xbe, 0xcf,
-	0xc6, 0x91, 0x08, 0xa2, 0xbe, 0xcb, 0x05, 0x89, 0x05, 0x77, 0x7b, 0x31, 0x0b, 0x95, 0xc5, 0x05,
-	0xa7, 0x39, 0x4d, 0xec, 0xab, 0xba, 0xd9, 0xd7, 0x96, 0x23, 0x5c, 0xd7, 0xf9, 0x9d, 0x2c, 0xbd,
-	0xa7, 0xb2, 0xb7, 0x63, 0x16, 0x3a, 0x77, 0x9f, 0xbe, 0x68, 0x58, 0xcf, 0x5e, 0x34, 0xac, 0xbf,
-	0x5e, 0x34, 0xac, 0x9f, 0x5f, 0x36, 0x56, 0x9e, 0xbd, 0x6c, 0xac, 0x3c, 0x7f, 0xd9, 0x58, 0xf9,
-	0xf6, 0x93, 0x7e, 0x20, 0xf6, 0xc7, 0x5e, 0xcb, 0x67, 0x61, 0xdb, 0xd8, 0x78, 0x7d, 0x48, 0x3c,
-	0x9e, 0xbe, 0xb4, 0x0f, 0x6e, 0x6c, 0xb5, 0x27, 0xb3, 0x7f, 0x11, 0xe2, 0x70, 0x44, 0xb9, 0xb7,
-	0xa6, 0xde, 0x6f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x11, 0xe4, 0x79, 0x66, 0x0c, 0x00,
-	0x00,
+	// 1300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0x6e, 0x1a, 0x8f, 0x53, 0xdb, 0x9d, 0xa6, 0xad, 0xe3, 0x82, 0x37, 0x4c, 0x0b,
+	0xb8, 0xa8, 0xb5, 0x95, 0x94, 0x03, 0x2a, 0x2a, 0x52, 0x36, 0xa5, 0xa2, 0x02, 0xd2, 0x6a, 0x12,
+	0xa9, 0x82, 0xcb, 0x32, 0xbb, 0x9e, 0x38, 0xab, 0x78, 0x77, 0xac, 0x9d, 0x71, 0x6a, 0x17, 0xa9,
+	0x12, 0xe2, 0xc8, 0x85, 0x4b, 0x6f, 0x1c, 0xb8, 0x71, 0xe2, 0x6f, 0xe0, 0xc2, 0xa1, 0xc7, 0x1e,
+	0x2b, 0x0e, 0x16, 0x6a, 0x2f, 0x88, 0xa3, 0xff, 0x02, 0x34, 0x3f, 0x76, 0x6d, 0x6f, 0x6a, 0xd2,
+	0x48, 0x88, 0xdb, 0xee, 0x7b, 0xdf, 0xf7, 0xbd, 0x37, 0xdf, 0x8c, 0xdf, 0x8e, 0xc1, 0xfb, 0x8c,
+	0x87, 0x8c, 0x07, 0xbc, 0xd5, 0x8b, 0x99, 0x60, 0x31, 0x3d, 0x6c, 0x1d, 0xae, 0x7b, 0x54, 0x90,
+	0xf5, 0x34, 0xd0, 0x54, 0x0f, 0xb0, 0x6a, 0x80, 0xcd, 0x34, 0x6e, 0x80, 0xb5, 0x55, 0x5f, 0xa5,
+	0x5c, 0x95, 0x68, 0xe9, 0x17, 0x8d, 0xaa, 0xad, 0x74, 0x58, 0x87, 0xe9, 0xb8, 0x7c, 0x32, 0xd1,
+	0xba, 0xc6, 0xb4, 0x3c, 0xc2, 0x69, 0x5a, 0xce, 0x67, 0x41, 0x64, 0xf2, 0xd7, 0xd2, 0x9e, 0x18,
+	0xeb, 0x86, 0x24, 0x22, 0x1d, 0x1a, 0xa7, 0xb8, 0x0e, 0x8d, 0x68, 0xda, 0x46, 0xed, 0x6a, 0x02,
+	0x15, 0x83, 0x3d, 0x4a, 0xf9, 0xeb, 0x51, 0xe8, 0x85, 0x05, 0xe0, 0x2e, 0x3b, 0xa0, 0xd1, 0x03,
+	0x12, 0xc4, 0x9b, 0xb1, 0x87, 0x59, 0x5f, 0x50, 0x0e, 0xbf, 0x02, 0x80, 0xc4, 0x9e, 0x1b, 0xab,
+	0xb7, 0xaa, 0xb5, 0x96, 0x6b, 0x14, 0x37, 0xec, 0xe6, 0xbc, 0x75, 0x36, 0x15, 0xcb, 0x59, 0x7d,
+	0x36, 0xb2, 0x17, 0xc6, 0x23, 0xfb, 0xdc, 0x90, 0x84, 0xdd, 0x5b, 0x68, 0x22, 0x80, 0x70, 0x81,
+	0xa4, 0xd2, 0x4d, 0xb0, 0x24, 0x64, 0x41, 0x37, 0x88, 0xaa, 0xa7, 0xd6, 0xac, 0x46, 0xc1, 0x39,
+	0x3f, 0x1e, 0xd9, 0x65, 0xcd, 0x49, 0x32, 0x08, 0x9f, 0x51, 0x8f, 0xf7, 0x22, 0xb8, 0x0e, 0x0a,
+	0x3a, 0xca, 0xfa, 0xa2, 0x9a, 0x53, 0x84, 0x95, 0xf1, 0xc8, 0xae, 0x4c, 0x13, 0x58, 0x5f, 0x20,
+	0xac, 0x65, 0xef, 0xf7, 0xc5, 0xad, 0xfc, 0x5f, 0x3f, 0xdb, 0x16, 0xfa, 0xd5, 0x02, 0xa7, 0x55,
+	0x4d, 0xb8, 0x0d, 0x16, 0x45, 0x4c, 0xda, 0x6f, 0xb2, 0x92, 0x5d, 0x89, 0x73, 0x2e, 0x98, 0x95,
+	0x9c, 0x35, 0x45, 0x14, 0x19, 0x61, 0xa3, 0x02, 0xb7, 0x41, 0x81, 0x0b, 0xda, 0x73, 0x79, 0xf0,
+	0x98, 0x9a, 0x35, 0xac, 0x4b, 0xc6, 0x1f, 0x23, 0xfb, 0x82, 0xde, 0x40, 0xde, 0x3e, 0x68, 0x06,
+	0xac, 0x15, 0x12, 0xb1, 0xdf, 0xbc, 0x17, 0x89, 0x49, 0xbf, 0x29, 0x0f, 0xe1, 0x25, 0xf9, 0xbc,
+	0x13, 0x3c, 0xa6, 0xa6, 0xdf, 0xa7, 0x16, 0x38, 0xad, 0xca, 0xc3, 0x2b, 0x20, 0x2f, 0xf7, 0xb7,
+	0x6a, 0xad, 0x59, 0x8d, 0xbc, 0x53, 0x1e, 0x8f, 0xec, 0xa2, 0x66, 0xcb, 0x28, 0xc2, 0x2a, 0xf9,
+	0xff, 0xf9, 0xf8, 0xb7, 0x05, 0xca, 0xca, 0xc7, 0x1d, 0x41, 0x44, 0xc0, 0x45, 0xe0, 0x73, 0xf8,
+	0x39, 0x38, 0xd3, 0x8b, 0xd9, 0x5e, 0x20, 0x12, 0x4b, 0x57, 0x9b, 0xe6, 0x74, 0xcb, 0x93, 0x9b,
+	0xba, 0xb9, 0xc5, 0x82, 0xc8, 0xb9, 0x68, 0xcc, 0x2c, 0x99, 0x35, 0x68, 0x1e, 0xc2, 0x89, 0x02,
+	0xf4, 0x40, 0x25, 0xea, 0x87, 0x1e, 0x8d, 0x5d, 0xb6, 0xe7, 0x9a, 0x8d, 0xd2, 0x2b, 0xfa, 0xe8,
+	0x38, 0x57, 0x2f, 0x69, 0xcd, 0x2c, 0x1d, 0xe1, 0x92, 0x0e, 0xdd, 0xdf, 0xdb, 0xd5, 0x5b, 0xf6,
+	0x1e, 0x38, 0xad, 0xce, 0x62, 0x35, 0xb7, 0x96, 0x6b, 0xe4, 0x9d, 0xca, 0x78, 0x64, 0x2f, 0x6b,
+	0xae, 0x0a, 0x23, 0xac, 0xd3, 0xe8, 0x97, 0x53, 0xa0, 0xf8, 0x80, 0xb1, 0xee, 0x43, 0x1a, 0x74,
+	0xf6, 0x05, 0x87, 0xb7, 0xc1, 0x59, 0x2e, 0x88, 0xd7, 0xa5, 0xee, 0x23, 0x15, 0x31, 0x7b, 0x52,
+	0x1d, 0x8f, 0xec, 0x95, 0x64, 0x47, 0xa7, 0xd2, 0x08, 0x2f, 0xeb, 0x77, 0xcd, 0x87, 0x5b, 0xa0,
+	0xec, 0x91, 0x2e, 0x89, 0x7c, 0x1a, 0x27, 0x02, 0xa7, 0x94, 0x40, 0x6d, 0x3c, 0xb2, 0x2f, 0x6a,
+	0x81, 0x0c, 0x00, 0xe1, 0x52, 0x12, 0x31, 0x22, 0xf7, 0xc1, 0x79, 0x9f, 0x45, 0x3e, 0x8d, 0x44,
+	0x4c, 0x04, 0x6d, 0x27, 0x42, 0x39, 0x25, 0x54, 0x1f, 0x8f, 0xec, 0x9a, 0x16, 0x7a, 0x0d, 0x08,
+	0x61, 0x38, 0x1d, 0x9d, 0x74, 0x25, 0x0d, 0x7d, 0x44, 0x78, 0x98, 0x88, 0xe5, 0xb3, 0x5d, 0x65,
+	0x00, 0x08, 0x97, 0x92, 0x88, 0x16, 0x41, 0x3f, 0xe5, 0x40, 0xe9, 0x5e, 0xb4, 0xc7, 0x9c, 0xa1,
+	0xf4, 0x6b, 0x77, 0xd8, 0xa3, 0xf0, 0x21, 0x58, 0xd4, 0xab, 0x57, 0x2e, 0x15, 0x37, 0x1a, 0xf3,
+	0x7f, 0x67, 0x3b, 0x0a, 0x27, 0x99, 0x4a, 0x23, 0xf3, 0x83, 0xd3, 0x2a, 0x08, 0x1b, 0x39, 0xe8,
+	0x82, 0xa5, 0xc4, 0x13, 0xe5, 0x5f, 0x71, 0xe3, 0x83, 0xf9, 0xd2, 0x8e, 0x41, 0xa6, 0xe2, 0x97,
+	0x8c, 0x78, 0x79, 0xd6, 0x6f, 0x84, 0x53, 0x51, 0xc8, 0xc0, 0xf2, 0xb4, 0x4f, 0xca, 0xdb, 0xe2,
+	0x46, 0x73, 0x7e, 0x91, 0xad, 0x29, 0x74, 0x5a, 0xe8, 0xb2, 0x29, 0x74, 0xfe, 0xe8, 0x7e, 0x20,
+	0x3c, 0x53, 0x40, 0xae, 0x28, 0xf1, 0xb3, 0x9a, 0x3f, 0x6e, 0x45, 0x5b, 0x06, 0x39, 0x6f, 0x45,
+	0x89, 0x12, 0xc2, 0xa9, 0x28, 0xfa, 0x18, 0x94, 0x66, 0x3d, 0x86, 0xd7, 0xc0, 0xe2, 0xcc, 0x19,
+	0x3e, 0x37, 0xf1, 0x3b, 0xd9, 0x63, 0x03, 0x40, 0xb7, 0x41, 0x25, 0xeb, 0xe2, 0x49, 0xe8, 0x3f,
+	0x58, 0x60, 0xe5, 0x75, 0x06, 0x9d, 0x40, 0x03, 0x7e, 0x06, 0xce, 0x85, 0x64, 0xe0, 0x8a, 0xc0,
+	0x3f, 0xe0, 0xae, 0x1f, 0x33, 0xce, 0x69, 0xdb, 0xfc, 0x76, 0xde, 0x1a, 0x8f, 0xec, 0xaa, 0x66,
+	0x1d, 0x81, 0x20, 0x5c, 0x0e, 0xc9, 0x60, 0x57, 0x86, 0xb6, 0x4c, 0x44, 0x80, 0x4a, 0xd6, 0x40,
+	0xf8, 0x0d, 0x28, 0xea, 0x3a, 0x6e, 0x48, 0x7a, 0xc9, 0x0c, 0xbb, 0x32, 0x7f, 0x07, 0xf4, 0x99,
+	0xff, 0x92, 0xf4, 0x9c, 0x9a, 0xb1, 0x1e, 0x4e, 0xb7, 0xad, 0x54, 0x10, 0x06, 0x8f, 0x12, 0x18,
+	0x47, 0x4f, 0x40, 0x21, 0x25, 0x9d, 0x64, 0xdd, 0x77, 0x41, 0xc5, 0x67, 0xd2, 0x37, 0x5f, 0xb8,
+	0xa4, 0xdd, 0x8e, 0x29, 0x4f, 0x86, 0xe1, 0xe5, 0xc9, 0xbc, 0xcb, 0x22, 0x10, 0x2e, 0x27, 0xa1,
+	0x4d, 0x13, 0xf9, 0xdd, 0x02, 0x25, 0x35, 0xb5, 0xef, 0x04, 0xdc, 0x67, 0x87, 0x34, 0x1e, 0xc2,
+	0xeb, 0xe0, 0x0c, 0x8d, 0xe4, 0x91, 0x68, 0xab, 0x36, 0x96, 0x1c, 0x38, 0x99, 0xca, 0x26, 0x81,
+	0x70, 0x02, 0x81, 0x9f, 0x82, 0x8a, 0x74, 0x57, 0x8d, 0x45, 0xb7, 0x4b, 0xa3, 0x8e, 0xd8, 0x37,
+	0xfe, 0x4f, 0x35, 0x92, 0x45, 0x20, 0x5c, 0x0a, 0xc9, 0x40, 0xd5, 0xfd, 0x42, 0x05, 0xe4, 0x00,
+	0x95, 0xa0, 0x48, 0xae, 0xce, 0x63, 0x31, 0xaf, 0xe6, 0xb2, 0x03, 0x74, 0x26, 0x8d, 0xf0, 0x72,
+	0x48, 0x06, 0xdb, 0xe9, 0xeb, 0xf7, 0x16, 0x28, 0x38, 0x84, 0xd3, 0x3b, 0x34, 0x62, 0xa1, 0x9c,
+	0xe2, 0x6d, 0xf9, 0xa0, 0xfa, 0x2f, 0x4c, 0x4f, 0x71, 0x15, 0x46, 0x58, 0xa7, 0xff, 0xeb, 0x0f,
+	0x34, 0x8a, 0x00, 0x48, 0x9b, 0xe0, 0xf2, 0xf0, 0xc8, 0xaf, 0x9c, 0xab, 0x6a, 0xbd, 0xc1, 0xe1,
+	0x49, 0xa9, 0xd9, 0xc3, 0x33, 0xa5, 0x82, 0x30, 0xf0, 0xd2, 0x0a, 0xe8, 0x69, 0x0e, 0xc0, 0xcd,
+	0x6e, 0xf7, 0x81, 0x54, 0xf2, 0x59, 0x17, 0xd3, 0x43, 0x1a, 0xf5, 0x29, 0x7c, 0x02, 0xa0, 0x20,
+	0x07, 0x34, 0x76, 0xe5, 0x85, 0x4e, 0x7e, 0xea, 0xfc, 0x03, 0x1a, 0x9b, 0x59, 0x7b, 0x63, 0x52,
+	0x7f, 0x72, 0x35, 0x9c, 0x5c, 0x6b, 0x24, 0xed, 0x2e, 0xa5, 0x7c, 0x57, 0x93, 0x9c, 0x77, 0x4c,
+	0x27, 0xab, 0xe6, 0xf3, 0x7f, 0x44, 0x16, 0xe1, 0x8a, 0xc8, 0x90, 0xe0, 0x77, 0x16, 0x28, 0x8b,
+	0xc1, 0x6c, 0x75, 0x3d, 0x8e, 0xdf, 0x4d, 0xab, 0xeb, 0xdb, 0xe6, 0xa4, 0xf0, 0x60, 0xba, 0xea,
+	0x86, 0xa9, 0xda, 0x30, 0x55, 0x67, 0xb5, 0xd0, 0xf5, 0x36, 0xed, 0xc5, 0xd4, 0x97, 0x23, 0x43,
+	0x5e, 0xba, 0xfa, 0x14, 0x55, 0x2d, 0x7c, 0x56, 0x4c, 0x4b, 0xc0, 0x6f, 0x01, 0xf4, 0x87, 0x7e,
+	0x37, 0xf0, 0x5d, 0x79, 0xbf, 0x4c, 0xba, 0xc8, 0x1d, 0x3b, 0x42, 0x15, 0x67, 0x33, 0xf6, 0xe6,
+	0x18, 0x70, 0x54, 0x13, 0xe1, 0x8a, 0x9f, 0x21, 0xa1, 0xdf, 0x2c, 0x50, 0xc9, 0x2a, 0xc1, 0x4f,
+	0x00, 0x98, 0xb0, 0x8f, 0xbf, 0x0e, 0xe5, 0x65, 0x61, 0x5c, 0x48, 0xb5, 0xe1, 0x01, 0x78, 0x7b,
+	0x5f, 0x4f, 0x11, 0xe2, 0xfb, 0xac, 0x1f, 0x89, 0x20, 0xea, 0xb8, 0x5c, 0x90, 0x58, 0x70, 0x77,
+	0x2f, 0x66, 0xa1, 0xb2, 0x38, 0xe7, 0x34, 0xc6, 0x23, 0xfb, 0xaa, 0x6e, 0xf6, 0x5f, 0xe1, 0x08,
+	0xd7, 0x74, 0x7e, 0x33, 0x4d, 0xef, 0xa8, 0xec, 0xdd, 0x98, 0x85, 0xce, 0xf6, 0xb3, 0x97, 0x75,
+	0xeb, 0xf9, 0xcb, 0xba, 0xf5, 0xe7, 0xcb, 0xba, 0xf5, 0xe3, 0xab, 0xfa, 0xc2, 0xf3, 0x57, 0xf5,
+	0x85, 0x17, 0xaf, 0xea, 0x0b, 0x5f, 0x7f, 0xd8, 0x09, 0xc4, 0x7e, 0xdf, 0x6b, 0xfa, 0x2c, 0x6c,
+	0x19, 0x1b, 0x6f, 0x74, 0x89, 0xc7, 0x93, 0x97, 0xd6, 0xe1, 0xcd, 0xf5, 0xd6, 0x60, 0xf2, 0x67,
+	0x48, 0x0c, 0x7b, 0x94, 0x7b, 0x8b, 0xea, 0xfd, 0xe6, 0x3f, 0x03, 0x00, 0xc1, 0xbb, 0x3b, 0x7c,
+	0x2d, 0x0d, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -1555,6 +1629,49 @@ func (m *WeightMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RouteDiscovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteDiscovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteDiscovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNeighbors != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.MaxNeighbors))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxRouteLength != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.MaxRouteLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1930,6 +2047,24 @@ func (m *WeightMap) Size() (n int) {
 	return n
 }
 
+func (m *RouteDiscovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxRouteLength != 0 {
+		n += 1 + sovProtorev(uint64(m.MaxRouteLength))
+	}
+	if m.MaxNeighbors != 0 {
+		n += 1 + sovProtorev(uint64(m.MaxNeighbors))
+	}
+	return n
+}
+
 func (m *BaseDenom) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3311,6 +3446,114 @@ func (m *WeightMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RouteDiscovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteDiscovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRouteLength", wireType)
+			}
+			m.MaxRouteLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRouteLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNeighbors", wireType)
+			}
+			m.MaxNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNeighbors |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1469,6 +1469,93 @@ func (m *QueryGetAllProtocolRevenueResponse) GetAllProtocolRevenue() AllProtocol
 	return AllProtocolRevenue{}
 }
 
+// QueryGetProtoRevRouteDiscoveryRequest is request type for the
+// Query/GetProtoRevRouteDiscovery RPC method.
+type QueryGetProtoRevRouteDiscoveryRequest struct {
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryRequest) Reset()         { *m = QueryGetProtoRevRouteDiscoveryRequest{} }
+func (m *QueryGetProtoRevRouteDiscoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRouteDiscoveryRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevRouteDiscoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevRouteDiscoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRouteDiscoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRouteDiscoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRouteDiscoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRouteDiscoveryRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevRouteDiscoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRouteDiscoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRouteDiscoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRouteDiscoveryRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevRouteDiscoveryResponse is response type for the
+// Query/GetProtoRevRouteDiscovery RPC method.
+type QueryGetProtoRevRouteDiscoveryResponse struct {
+	// route_discovery is the configuration of the route discovery
+	RouteDiscovery RouteDiscovery `protobuf:"bytes,1,opt,name=route_discovery,json=routeDiscovery,proto3" json:"route_discovery" yaml:"route_discovery"`
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) Reset() {
+	*m = QueryGetProtoRevRouteDiscoveryResponse{}
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevRouteDiscoveryResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevRouteDiscoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevRouteDiscoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevRouteDiscoveryResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevRouteDiscoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevRouteDiscoveryResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) GetRouteDiscovery() RouteDiscovery {
+	if m != nil {
+		return m.RouteDiscovery
+	}
+	return RouteDiscovery{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
@@ -1502,6 +1589,8 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
 	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
 	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+	proto.RegisterType((*QueryGetProtoRevRouteDiscoveryRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRouteDiscoveryRequest")
+	proto.RegisterType((*QueryGetProtoRevRouteDiscoveryResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevRouteDiscoveryResponse")
 }
 
 func init() {
@@ -1509,108 +1598,112 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6f, 0xdb, 0xd4,
-	0x1b, 0xae, 0xf7, 0xd1, 0xfe, 0x76, 0xf6, 0xf1, 0x5b, 0x0f, 0x6d, 0x97, 0xba, 0x5d, 0x92, 0x9e,
-	0xb6, 0xeb, 0xd7, 0x9a, 0xb0, 0x0f, 0x60, 0x7c, 0x0c, 0xa8, 0x57, 0x98, 0xaa, 0x89, 0x35, 0x98,
-	0x72, 0x03, 0x12, 0xc1, 0x49, 0xdc, 0xce, 0x9a, 0xe3, 0xe3, 0xd9, 0x4e, 0xd5, 0xdc, 0x32, 0x09,
-	0x84, 0x84, 0xc4, 0xd7, 0x1f, 0x00, 0xd7, 0x88, 0x7f, 0x80, 0x4b, 0xb8, 0x9a, 0xe0, 0x66, 0x08,
-	0x09, 0xa1, 0x81, 0x22, 0xb4, 0x71, 0xc1, 0x75, 0xfe, 0x00, 0x84, 0x7c, 0xce, 0xeb, 0xc4, 0xf1,
-	0xb1, 0xd3, 0xb8, 0x95, 0xb8, 0x4b, 0x7c, 0xde, 0xf7, 0x79, 0x9f, 0xe7, 0x7c, 0x3e, 0x2f, 0x9a,
-	0xa3, 0x6e, 0x9d, 0xba, 0x86, 0x5b, 0xb4, 0x1d, 0xea, 0x51, 0x47, 0xdf, 0x2d, 0xee, 0x5e, 0xaa,
-	0xe8, 0x9e, 0x76, 0xa9, 0x78, 0xaf, 0xa1, 0x3b, 0xcd, 0x02, 0xfb, 0x8c, 0x33, 0x10, 0x55, 0x08,
-	0xa2, 0x0a, 0x10, 0x25, 0x8f, 0xed, 0xd0, 0x1d, 0xca, 0xbe, 0x16, 0xfd, 0x5f, 0x3c, 0x40, 0x9e,
-	0xde, 0xa1, 0x74, 0xc7, 0xd4, 0x8b, 0x9a, 0x6d, 0x14, 0x35, 0xcb, 0xa2, 0x9e, 0xe6, 0x19, 0xd4,
-	0x82, 0x74, 0x79, 0xb9, 0xca, 0xe0, 0x8a, 0x15, 0xcd, 0xd5, 0x79, 0x99, 0x4e, 0x51, 0x5b, 0xdb,
-	0x31, 0x2c, 0x16, 0x0c, 0xb1, 0xf3, 0x89, 0xfc, 0x6c, 0xcd, 0xd1, 0xea, 0x01, 0xe4, 0x42, 0x72,
-	0x58, 0xc0, 0x98, 0x07, 0x66, 0xc3, 0xb5, 0x83, 0x98, 0x2a, 0x35, 0xa0, 0x1e, 0x19, 0x43, 0xf8,
-	0x4d, 0x9f, 0x51, 0x89, 0xa1, 0xab, 0xfa, 0xbd, 0x86, 0xee, 0x7a, 0x64, 0x1b, 0x3d, 0xd5, 0xf3,
-	0xd5, 0xb5, 0xa9, 0xe5, 0xea, 0x78, 0x13, 0x0d, 0x73, 0x16, 0x19, 0x29, 0x2f, 0x2d, 0x9e, 0xbc,
-	0x9c, 0x2f, 0x24, 0xcd, 0x53, 0x81, 0x67, 0x2a, 0xe3, 0x0f, 0x5a, 0xb9, 0xa1, 0x76, 0x2b, 0x77,
-	0xba, 0xa9, 0xd5, 0xcd, 0x17, 0x08, 0xcf, 0x26, 0x2a, 0xc0, 0x90, 0x05, 0x34, 0xcf, 0xea, 0xdc,
-	0xd4, 0xbd, 0x92, 0x8f, 0xa0, 0xea, 0xbb, 0xb7, 0x1b, 0xf5, 0x8a, 0xee, 0x6c, 0x6e, 0x6f, 0x39,
-	0x5a, 0x4d, 0xef, 0x10, 0xfa, 0x44, 0x42, 0x17, 0xf6, 0x8b, 0x04, 0x92, 0x15, 0x74, 0xd6, 0x62,
-	0x23, 0x65, 0xba, 0x5d, 0xf6, 0xd8, 0x18, 0xa3, 0x7b, 0x42, 0xb9, 0xe6, 0x93, 0x79, 0xd4, 0xca,
-	0x8d, 0xf3, 0x39, 0x71, 0x6b, 0x77, 0x0b, 0x06, 0x2d, 0xd6, 0x35, 0xef, 0x4e, 0x61, 0xc3, 0xf2,
-	0xda, 0xad, 0xdc, 0x39, 0xce, 0x32, 0x9a, 0x4e, 0xd4, 0x33, 0x56, 0x4f, 0x2d, 0xb2, 0x29, 0xf2,
-	0x2e, 0x39, 0x74, 0xdb, 0xf0, 0x5c, 0xa5, 0xb9, 0xae, 0x5b, 0xb4, 0x0e, 0xbc, 0xf1, 0x05, 0x74,
-	0xbc, 0xe6, 0xff, 0x07, 0x06, 0x67, 0xdb, 0xad, 0xdc, 0x29, 0x5e, 0x84, 0x7d, 0x26, 0x2a, 0x1f,
-	0x26, 0x96, 0x28, 0x2f, 0x0a, 0x08, 0xf2, 0xd6, 0xd1, 0xb0, 0xcd, 0x46, 0x60, 0x0d, 0x26, 0x0b,
-	0x5c, 0x4d, 0xc1, 0x5f, 0xe1, 0xce, 0xf4, 0xdf, 0xa0, 0x86, 0xa5, 0x8c, 0x86, 0x26, 0x9e, 0xa5,
-	0xf8, 0x13, 0xcf, 0x7f, 0xcc, 0xa2, 0x99, 0x68, 0xbd, 0x35, 0xd3, 0x84, 0x92, 0xc1, 0xa4, 0xdf,
-	0x43, 0xa4, 0x5f, 0x10, 0x10, 0xba, 0x85, 0x46, 0x38, 0xa8, 0x3f, 0xcd, 0x47, 0xfb, 0x33, 0x9a,
-	0x80, 0xed, 0x70, 0x26, 0xcc, 0xca, 0x25, 0xea, 0x48, 0xe7, 0x17, 0x5a, 0x8c, 0x96, 0x7c, 0xcb,
-	0x3f, 0x4c, 0xae, 0x67, 0x54, 0x5d, 0xa5, 0xa9, 0xd2, 0x86, 0xa7, 0x87, 0xe6, 0xd6, 0xf1, 0xff,
-	0xb3, 0xb2, 0xc7, 0xc2, 0x73, 0xcb, 0x3e, 0x13, 0x95, 0x0f, 0x93, 0xcf, 0x25, 0xb4, 0x34, 0x00,
-	0x28, 0xc8, 0xa9, 0x21, 0xe4, 0x76, 0x06, 0x61, 0x8e, 0x97, 0x92, 0xf7, 0x39, 0x4b, 0x0e, 0xa1,
-	0x4d, 0x82, 0xc2, 0x51, 0xce, 0xa4, 0x0b, 0x45, 0xd4, 0x10, 0x2e, 0x59, 0x11, 0x29, 0xad, 0x99,
-	0x66, 0x04, 0x2c, 0x58, 0x87, 0x2f, 0x24, 0xb4, 0x3c, 0x48, 0x74, 0x82, 0x82, 0xa3, 0xff, 0x95,
-	0x82, 0x2d, 0x7a, 0x57, 0xb7, 0x4a, 0x9a, 0xe1, 0xac, 0x39, 0x15, 0x86, 0xda, 0x51, 0xf0, 0x71,
-	0x8c, 0x82, 0xb8, 0x68, 0x50, 0xf0, 0x2e, 0x1a, 0x66, 0x4b, 0x17, 0xb0, 0xbf, 0x98, 0xcc, 0x5e,
-	0x44, 0x89, 0xde, 0x39, 0x1c, 0x89, 0xa8, 0x00, 0x49, 0xe6, 0xd1, 0xac, 0x30, 0x99, 0xb5, 0xba,
-	0x61, 0xad, 0x55, 0xab, 0xb4, 0x61, 0x79, 0x01, 0x65, 0x1d, 0xcd, 0xf5, 0x0f, 0x03, 0xae, 0xd7,
-	0xd1, 0x69, 0xcd, 0xff, 0x5e, 0xd6, 0xf8, 0x00, 0x9c, 0xf4, 0x4c, 0xbb, 0x95, 0x1b, 0xe3, 0x04,
-	0x7a, 0x86, 0x89, 0x7a, 0x4a, 0x0b, 0xc1, 0x90, 0x25, 0xb4, 0x10, 0x2d, 0xb3, 0xae, 0xef, 0xea,
-	0x26, 0xb5, 0x75, 0x27, 0xc2, 0xa8, 0x21, 0x9e, 0x0d, 0x31, 0x14, 0x58, 0x6d, 0xa0, 0xd1, 0x5a,
-	0x30, 0x16, 0x61, 0x36, 0xdd, 0x6e, 0xe5, 0x32, 0xc1, 0x1d, 0x14, 0x09, 0x21, 0xea, 0xd9, 0x5a,
-	0x04, 0x32, 0xee, 0x8e, 0xde, 0xb0, 0xb6, 0xa9, 0xd2, 0x2c, 0x51, 0x6a, 0x6e, 0x35, 0xed, 0xe0,
-	0x3c, 0x92, 0xaf, 0x62, 0xee, 0xe8, 0x68, 0x24, 0xd0, 0x6b, 0xa0, 0x51, 0xc3, 0xda, 0xa6, 0xe5,
-	0x4a, 0xb3, 0x6c, 0x53, 0x6a, 0x96, 0xbd, 0xa6, 0xad, 0xc3, 0x59, 0x5b, 0x4c, 0x5e, 0xeb, 0x5e,
-	0x30, 0x25, 0x0f, 0xeb, 0x0c, 0x62, 0x04, 0x40, 0xa2, 0x9e, 0x31, 0x7a, 0x32, 0x48, 0x01, 0x5d,
-	0x8c, 0x12, 0x7c, 0x43, 0xdb, 0xf3, 0x87, 0x4b, 0xd4, 0xb0, 0x3c, 0xb7, 0xa4, 0x3b, 0x8a, 0x49,
-	0xab, 0x77, 0x03, 0x45, 0x9f, 0x4a, 0x68, 0x75, 0xc0, 0x04, 0x10, 0xf6, 0x1e, 0x9a, 0xac, 0x6b,
-	0x7b, 0x9c, 0x83, 0xcd, 0x42, 0xca, 0xfe, 0xf4, 0x56, 0xfc, 0x20, 0x26, 0xf0, 0x98, 0x32, 0xd7,
-	0x6e, 0xe5, 0xf2, 0x9c, 0x72, 0x62, 0x28, 0x51, 0xc7, 0xeb, 0x71, 0x75, 0xe2, 0x4e, 0x5d, 0x94,
-	0xd0, 0xd6, 0x5e, 0x40, 0xff, 0x7e, 0xcc, 0xa9, 0x8b, 0x8b, 0x06, 0xee, 0x6f, 0xa3, 0x89, 0x38,
-	0x42, 0xde, 0x1e, 0x10, 0x9f, 0x69, 0xb7, 0x72, 0xe7, 0x93, 0x89, 0x7b, 0x7b, 0x44, 0xc5, 0x75,
-	0x01, 0x3e, 0xee, 0xa9, 0x51, 0x34, 0x57, 0x67, 0xaf, 0x5a, 0xe7, 0x82, 0xf8, 0x50, 0x12, 0xdf,
-	0x9a, 0x70, 0x14, 0x50, 0x7c, 0x1f, 0x9d, 0xf4, 0x1f, 0x95, 0x32, 0x7b, 0x34, 0x83, 0xdb, 0x61,
-	0x36, 0x79, 0xc7, 0x74, 0x20, 0x14, 0x19, 0x36, 0x0b, 0xe6, 0x02, 0x42, 0x28, 0x44, 0x45, 0x95,
-	0x4e, 0x25, 0x92, 0x47, 0xd9, 0x28, 0x8f, 0xd7, 0x2c, 0xad, 0x62, 0xea, 0xb5, 0x80, 0xea, 0x26,
-	0xca, 0x25, 0x46, 0x00, 0xcd, 0x8b, 0x68, 0x44, 0xe7, 0x9f, 0xd8, 0xd4, 0xfd, 0x4f, 0xc1, 0xdd,
-	0x37, 0x0f, 0x06, 0x88, 0x1a, 0x84, 0xf8, 0xde, 0x66, 0x4a, 0x78, 0xfc, 0x29, 0x35, 0x83, 0x77,
-	0xee, 0x2a, 0x42, 0x5d, 0xba, 0x70, 0x88, 0xc7, 0xbb, 0x17, 0x74, 0x77, 0x8c, 0xa8, 0x27, 0x3a,
-	0x4a, 0xf0, 0x73, 0xe8, 0x24, 0xf5, 0xee, 0xe8, 0x0e, 0xa4, 0x1d, 0x61, 0x69, 0x13, 0xdd, 0x19,
-	0x08, 0x0d, 0x12, 0x15, 0xb1, 0x7f, 0x2c, 0x91, 0xdc, 0x42, 0xd3, 0xf1, 0x6c, 0x40, 0xdc, 0x0a,
-	0x1a, 0x61, 0x4b, 0x6f, 0xd4, 0x60, 0x5f, 0x84, 0xc4, 0xc1, 0x80, 0xef, 0x33, 0x28, 0x35, 0x37,
-	0x6a, 0xe1, 0xc5, 0xe7, 0xd6, 0xc1, 0xa3, 0x55, 0x1f, 0x6b, 0x57, 0xb7, 0x1a, 0x9d, 0x8b, 0xe3,
-	0x9b, 0xd0, 0xe2, 0xc7, 0x45, 0x41, 0xe1, 0xfb, 0x12, 0x1a, 0xd3, 0x4c, 0xb3, 0x6c, 0xc3, 0x78,
-	0xd9, 0xe1, 0x01, 0x70, 0x71, 0xf4, 0x79, 0x24, 0x44, 0x50, 0x65, 0x16, 0xf6, 0xc3, 0x14, 0xdc,
-	0xd1, 0x31, 0xb8, 0x44, 0xc5, 0x9a, 0x90, 0x78, 0xf9, 0x9f, 0x0c, 0x3a, 0xce, 0xc8, 0xe2, 0x8f,
-	0x24, 0x34, 0xcc, 0x6d, 0x2e, 0xee, 0x53, 0x5b, 0x74, 0xd7, 0xf2, 0xea, 0x80, 0xd1, 0x5c, 0x37,
-	0xc9, 0x7f, 0xf0, 0xcb, 0x5f, 0x5f, 0x1e, 0x91, 0x71, 0xa6, 0x28, 0x98, 0x7e, 0x6e, 0xa3, 0xf1,
-	0x8f, 0x12, 0x9a, 0x4c, 0x34, 0xc6, 0xf8, 0x95, 0x7d, 0xca, 0xed, 0x67, 0xbe, 0xe5, 0x57, 0x0f,
-	0x0e, 0x00, 0x12, 0x96, 0x99, 0x84, 0x39, 0x4c, 0x44, 0x09, 0x51, 0xb3, 0x1d, 0x15, 0xd3, 0x6b,
-	0x83, 0xd3, 0x88, 0x89, 0x75, 0xe4, 0x69, 0xc4, 0xc4, 0x3b, 0xf0, 0x7e, 0x62, 0xc0, 0xc6, 0xfa,
-	0xcf, 0x10, 0x3b, 0x59, 0xf8, 0x3b, 0x09, 0x8d, 0xc7, 0xda, 0x67, 0xfc, 0xe2, 0xe0, 0x3c, 0x04,
-	0x67, 0x2e, 0xbf, 0x74, 0xb0, 0x64, 0x10, 0x30, 0xcf, 0x04, 0xe4, 0xf0, 0x79, 0x51, 0x00, 0x9c,
-	0x03, 0xc6, 0xf0, 0x57, 0x09, 0x4d, 0xf7, 0xb3, 0xcc, 0x58, 0x19, 0x9c, 0x45, 0x92, 0x89, 0x97,
-	0x6f, 0x1c, 0x0a, 0x03, 0x04, 0xad, 0x32, 0x41, 0x0b, 0x78, 0x5e, 0x14, 0xd4, 0x75, 0xac, 0xfe,
-	0xa2, 0x30, 0x0b, 0x88, 0x1f, 0x49, 0xe8, 0x7c, 0x5f, 0x2b, 0x8d, 0x6f, 0xa4, 0x9a, 0xdf, 0x78,
-	0xdb, 0x2e, 0xaf, 0x1f, 0x0e, 0x04, 0xb4, 0x15, 0x98, 0xb6, 0x45, 0x7c, 0x21, 0x7e, 0xb1, 0x98,
-	0xa2, 0x72, 0x57, 0x25, 0xfe, 0xbd, 0x57, 0x9c, 0xe8, 0x8f, 0xd3, 0x88, 0x4b, 0x74, 0xf4, 0x69,
-	0xc4, 0x25, 0x1b, 0x7d, 0x52, 0x64, 0xe2, 0x96, 0xf0, 0x82, 0x28, 0xce, 0xf3, 0xb3, 0xca, 0xb6,
-	0x66, 0x38, 0x65, 0xcd, 0xa9, 0x70, 0x9d, 0x2e, 0xfe, 0x5e, 0x42, 0xe7, 0x12, 0x1c, 0x39, 0xbe,
-	0x9e, 0x62, 0xbe, 0x45, 0xc3, 0x2f, 0xbf, 0x7c, 0xd0, 0x74, 0xd0, 0xb2, 0xc0, 0xb4, 0xcc, 0xe0,
-	0x5c, 0xcc, 0x42, 0x85, 0x3b, 0x00, 0xfc, 0xb3, 0x84, 0xa6, 0xfa, 0x78, 0x78, 0xbc, 0x36, 0x38,
-	0x91, 0x84, 0x56, 0x41, 0x56, 0x0e, 0x03, 0x01, 0x7a, 0x56, 0x98, 0x9e, 0x79, 0x3c, 0x2b, 0xea,
-	0x11, 0xfa, 0x06, 0xfc, 0x53, 0xef, 0xa5, 0xdd, 0xeb, 0xd4, 0xd3, 0x5c, 0xda, 0xb1, 0xad, 0x45,
-	0x9a, 0x4b, 0x3b, 0xbe, 0xe3, 0xe8, 0xa7, 0x46, 0x68, 0x1c, 0xf0, 0x1f, 0xbd, 0x67, 0x48, 0xf4,
-	0xcc, 0x69, 0xce, 0x50, 0xa2, 0x3f, 0x4f, 0x73, 0x86, 0x92, 0x6d, 0x3b, 0x79, 0x9a, 0x29, 0x5b,
-	0xc6, 0x8b, 0xa2, 0xb2, 0x78, 0x9b, 0x8e, 0xff, 0x96, 0x50, 0x7e, 0xbf, 0x8e, 0x06, 0xbf, 0x7e,
-	0x70, 0x72, 0xe1, 0x1e, 0x4a, 0xbe, 0x79, 0x68, 0x1c, 0xd0, 0x79, 0x85, 0xe9, 0x5c, 0xc5, 0x2b,
-	0x83, 0xe9, 0x64, 0x7d, 0x54, 0xf4, 0xfd, 0xed, 0xb6, 0x14, 0x69, 0xde, 0x5f, 0xa1, 0x5d, 0x49,
-	0xf3, 0xfe, 0x8a, 0x5d, 0x4c, 0xbf, 0xf7, 0x37, 0xd4, 0x97, 0xe0, 0x6f, 0x25, 0x84, 0xc5, 0x26,
-	0x03, 0x5f, 0x1b, 0xbc, 0x76, 0x6f, 0xe7, 0x22, 0x3f, 0x7f, 0x80, 0x4c, 0xa0, 0x3c, 0xc3, 0x28,
-	0x4f, 0xe1, 0x49, 0x91, 0x32, 0xb4, 0x31, 0xf8, 0x6b, 0x09, 0xfd, 0x3f, 0xd2, 0x33, 0xe0, 0x67,
-	0x52, 0x98, 0xad, 0x6e, 0xc7, 0x23, 0x3f, 0x9b, 0x36, 0x0d, 0x58, 0x66, 0x19, 0xcb, 0x0c, 0x9e,
-	0x88, 0x71, 0x66, 0x3e, 0x9d, 0x1f, 0xf8, 0x6e, 0x10, 0xdb, 0x81, 0x41, 0x76, 0x43, 0x62, 0xff,
-	0x32, 0xc8, 0x6e, 0x48, 0x6e, 0x6b, 0xf6, 0x7b, 0xe0, 0xa3, 0x5d, 0x89, 0x72, 0xfb, 0xc1, 0xe3,
-	0xac, 0xf4, 0xf0, 0x71, 0x56, 0xfa, 0xf3, 0x71, 0x56, 0xfa, 0xec, 0x49, 0x76, 0xe8, 0xe1, 0x93,
-	0xec, 0xd0, 0x6f, 0x4f, 0xb2, 0x43, 0xef, 0x5c, 0xdd, 0x31, 0xbc, 0x3b, 0x8d, 0x4a, 0xa1, 0x4a,
-	0xeb, 0x01, 0xd6, 0xaa, 0xa9, 0x55, 0xdc, 0x0e, 0xf0, 0xee, 0x95, 0x4b, 0xc5, 0xbd, 0xd0, 0x13,
-	0xdb, 0xb4, 0x75, 0xb7, 0x32, 0xcc, 0xfe, 0x5f, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xc8,
-	0x3d, 0x34, 0x1a, 0x19, 0x00, 0x00,
+	// 1674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x1b, 0x8f, 0xfb, 0x91, 0xbc, 0x9d, 0xb6, 0x69, 0x33, 0x6f, 0x92, 0x26, 0x4e, 0xba, 0x9b, 0x4c,
+	0xbe, 0x93, 0x66, 0xf7, 0xed, 0xc7, 0x0b, 0x05, 0x5a, 0x48, 0xdc, 0x40, 0x15, 0x55, 0x34, 0xc1,
+	0x84, 0x0b, 0x48, 0x2c, 0xde, 0x5d, 0x27, 0xb5, 0xea, 0xf5, 0x6c, 0x6c, 0x6f, 0x94, 0xbd, 0x52,
+	0x09, 0x84, 0x84, 0xc4, 0xd7, 0x91, 0x03, 0x9c, 0x11, 0xff, 0x00, 0x47, 0x38, 0x95, 0x72, 0x29,
+	0x42, 0x42, 0xa8, 0xa0, 0x15, 0x6a, 0x39, 0x70, 0xde, 0xbf, 0x00, 0x79, 0xe6, 0xf1, 0xae, 0xed,
+	0xb1, 0xf7, 0x2b, 0x12, 0xb7, 0x5d, 0xcf, 0xf3, 0xfc, 0xe6, 0xf7, 0x7b, 0x66, 0xe6, 0x99, 0xf9,
+	0xa1, 0x59, 0xea, 0x94, 0xa8, 0x63, 0x38, 0xd9, 0xb2, 0x4d, 0x5d, 0x6a, 0xeb, 0x07, 0xd9, 0x83,
+	0xcb, 0x79, 0xdd, 0xd5, 0x2e, 0x67, 0xf7, 0x2b, 0xba, 0x5d, 0xcd, 0xb0, 0xcf, 0x78, 0x0c, 0xa2,
+	0x32, 0x7e, 0x54, 0x06, 0xa2, 0xe4, 0xe1, 0x3d, 0xba, 0x47, 0xd9, 0xd7, 0xac, 0xf7, 0x8b, 0x07,
+	0xc8, 0x93, 0x7b, 0x94, 0xee, 0x99, 0x7a, 0x56, 0x2b, 0x1b, 0x59, 0xcd, 0xb2, 0xa8, 0xab, 0xb9,
+	0x06, 0xb5, 0x20, 0x5d, 0x5e, 0x2e, 0x30, 0xb8, 0x6c, 0x5e, 0x73, 0x74, 0x3e, 0x4d, 0x63, 0xd2,
+	0xb2, 0xb6, 0x67, 0x58, 0x2c, 0x18, 0x62, 0xe7, 0x12, 0xf9, 0x95, 0x35, 0x5b, 0x2b, 0xf9, 0x90,
+	0x0b, 0xc9, 0x61, 0x3e, 0x63, 0x1e, 0x98, 0x0a, 0xce, 0xed, 0xc7, 0x14, 0xa8, 0x01, 0xf3, 0x91,
+	0x61, 0x84, 0xdf, 0xf0, 0x18, 0x6d, 0x33, 0x74, 0x55, 0xdf, 0xaf, 0xe8, 0x8e, 0x4b, 0x76, 0xd1,
+	0x7f, 0x43, 0x5f, 0x9d, 0x32, 0xb5, 0x1c, 0x1d, 0x6f, 0xa1, 0x7e, 0xce, 0x62, 0x4c, 0x9a, 0x92,
+	0x16, 0x4f, 0x5f, 0x99, 0xca, 0x24, 0xd5, 0x29, 0xc3, 0x33, 0x95, 0x91, 0x87, 0xb5, 0x74, 0x5f,
+	0xbd, 0x96, 0x3e, 0x5b, 0xd5, 0x4a, 0xe6, 0x8b, 0x84, 0x67, 0x13, 0x15, 0x60, 0xc8, 0x02, 0x9a,
+	0x63, 0xf3, 0xdc, 0xd6, 0xdd, 0x6d, 0x0f, 0x41, 0xd5, 0x0f, 0xee, 0x56, 0x4a, 0x79, 0xdd, 0xde,
+	0xda, 0xdd, 0xb1, 0xb5, 0xa2, 0xde, 0x20, 0xf4, 0xb1, 0x84, 0xe6, 0xdb, 0x45, 0x02, 0xc9, 0x3c,
+	0x3a, 0x6f, 0xb1, 0x91, 0x1c, 0xdd, 0xcd, 0xb9, 0x6c, 0x8c, 0xd1, 0x3d, 0xa5, 0x5c, 0xf7, 0xc8,
+	0x3c, 0xa9, 0xa5, 0x47, 0x78, 0x4d, 0x9c, 0xe2, 0xfd, 0x8c, 0x41, 0xb3, 0x25, 0xcd, 0xbd, 0x97,
+	0xd9, 0xb4, 0xdc, 0x7a, 0x2d, 0x7d, 0x81, 0xb3, 0x8c, 0xa6, 0x13, 0x75, 0xd0, 0x0a, 0xcd, 0x45,
+	0xb6, 0x44, 0xde, 0xdb, 0x36, 0xdd, 0x35, 0x5c, 0x47, 0xa9, 0x6e, 0xe8, 0x16, 0x2d, 0x01, 0x6f,
+	0x3c, 0x8f, 0x4e, 0x16, 0xbd, 0xff, 0xc0, 0xe0, 0x7c, 0xbd, 0x96, 0x3e, 0xc3, 0x27, 0x61, 0x9f,
+	0x89, 0xca, 0x87, 0x89, 0x85, 0xe6, 0xdb, 0x01, 0x82, 0xbc, 0x0d, 0xd4, 0x5f, 0x66, 0x23, 0xb0,
+	0x06, 0xe3, 0x19, 0xae, 0x26, 0xe3, 0xad, 0x70, 0xa3, 0xfc, 0xb7, 0xa8, 0x61, 0x29, 0x43, 0x81,
+	0xc2, 0xb3, 0x14, 0xaf, 0xf0, 0xfc, 0xc7, 0x0c, 0x9a, 0x8e, 0xce, 0xb7, 0x6e, 0x9a, 0x30, 0xa5,
+	0x5f, 0xf4, 0x7d, 0x44, 0x5a, 0x05, 0x01, 0xa1, 0x3b, 0x68, 0x80, 0x83, 0x7a, 0x65, 0x3e, 0xde,
+	0x9a, 0xd1, 0x28, 0x6c, 0x87, 0xc1, 0x20, 0x2b, 0x87, 0xa8, 0x03, 0x8d, 0x5f, 0x68, 0x31, 0x3a,
+	0xe5, 0x9b, 0xde, 0x61, 0x72, 0x5c, 0xa3, 0xe0, 0x28, 0x55, 0x95, 0x56, 0x5c, 0x3d, 0x50, 0x5b,
+	0xdb, 0xfb, 0xcf, 0xa6, 0x3d, 0x11, 0xac, 0x2d, 0xfb, 0x4c, 0x54, 0x3e, 0x4c, 0x3e, 0x93, 0xd0,
+	0x52, 0x07, 0xa0, 0x20, 0xa7, 0x88, 0x90, 0xd3, 0x18, 0x84, 0x1a, 0x2f, 0x25, 0xef, 0x73, 0x96,
+	0x1c, 0x40, 0x1b, 0x07, 0x85, 0x43, 0x9c, 0x49, 0x13, 0x8a, 0xa8, 0x01, 0x5c, 0xb2, 0x22, 0x52,
+	0x5a, 0x37, 0xcd, 0x08, 0x98, 0xbf, 0x0e, 0x9f, 0x4b, 0x68, 0xb9, 0x93, 0xe8, 0x04, 0x05, 0xc7,
+	0xff, 0x2d, 0x05, 0x3b, 0xf4, 0xbe, 0x6e, 0x6d, 0x6b, 0x86, 0xbd, 0x6e, 0xe7, 0x19, 0x6a, 0x43,
+	0xc1, 0x47, 0x31, 0x0a, 0xe2, 0xa2, 0x41, 0xc1, 0x3b, 0xa8, 0x9f, 0x2d, 0x9d, 0xcf, 0xfe, 0x52,
+	0x32, 0x7b, 0x11, 0x25, 0xda, 0x73, 0x38, 0x12, 0x51, 0x01, 0x92, 0xcc, 0xa1, 0x19, 0xa1, 0x98,
+	0xc5, 0x92, 0x61, 0xad, 0x17, 0x0a, 0xb4, 0x62, 0xb9, 0x3e, 0x65, 0x1d, 0xcd, 0xb6, 0x0e, 0x03,
+	0xae, 0x37, 0xd1, 0x59, 0xcd, 0xfb, 0x9e, 0xd3, 0xf8, 0x00, 0x9c, 0xf4, 0xb1, 0x7a, 0x2d, 0x3d,
+	0xcc, 0x09, 0x84, 0x86, 0x89, 0x7a, 0x46, 0x0b, 0xc0, 0x90, 0x25, 0xb4, 0x10, 0x9d, 0x66, 0x43,
+	0x3f, 0xd0, 0x4d, 0x5a, 0xd6, 0xed, 0x08, 0xa3, 0x0a, 0x5a, 0x6c, 0x1f, 0x0a, 0xac, 0x36, 0xd1,
+	0x50, 0xd1, 0x1f, 0x8b, 0x30, 0x9b, 0xac, 0xd7, 0xd2, 0x63, 0x7e, 0x0f, 0x8a, 0x84, 0x10, 0xf5,
+	0x7c, 0x31, 0x02, 0x19, 0xd7, 0xa3, 0x37, 0xad, 0x5d, 0xaa, 0x54, 0xb7, 0x29, 0x35, 0x77, 0xaa,
+	0x65, 0xff, 0x3c, 0x92, 0xaf, 0x62, 0x7a, 0x74, 0x34, 0x12, 0xe8, 0x55, 0xd0, 0x90, 0x61, 0xed,
+	0xd2, 0x5c, 0xbe, 0x9a, 0x2b, 0x53, 0x6a, 0xe6, 0xdc, 0x6a, 0x59, 0x87, 0xb3, 0xb6, 0x98, 0xbc,
+	0xd6, 0x61, 0x30, 0x65, 0x0a, 0xd6, 0x19, 0xc4, 0x08, 0x80, 0x44, 0x1d, 0x34, 0x42, 0x19, 0x24,
+	0x83, 0x2e, 0x45, 0x09, 0xbe, 0xae, 0x1d, 0x7a, 0xc3, 0xdb, 0xd4, 0xb0, 0x5c, 0x67, 0x5b, 0xb7,
+	0x15, 0x93, 0x16, 0xee, 0xfb, 0x8a, 0x3e, 0x91, 0xd0, 0x6a, 0x87, 0x09, 0x20, 0xec, 0x5d, 0x34,
+	0x5e, 0xd2, 0x0e, 0x39, 0x87, 0x32, 0x0b, 0xc9, 0x79, 0xe5, 0xcd, 0x7b, 0x41, 0x4c, 0xe0, 0x09,
+	0x65, 0xb6, 0x5e, 0x4b, 0x4f, 0x71, 0xca, 0x89, 0xa1, 0x44, 0x1d, 0x29, 0xc5, 0xcd, 0x13, 0x77,
+	0xea, 0xa2, 0x84, 0x76, 0x0e, 0x7d, 0xfa, 0x0f, 0x62, 0x4e, 0x5d, 0x5c, 0x34, 0x70, 0x7f, 0x0b,
+	0x8d, 0xc6, 0x11, 0x72, 0x0f, 0x81, 0xf8, 0x74, 0xbd, 0x96, 0xbe, 0x98, 0x4c, 0xdc, 0x3d, 0x24,
+	0x2a, 0x2e, 0x09, 0xf0, 0x71, 0x57, 0x8d, 0xa2, 0x39, 0x3a, 0xbb, 0xd5, 0x1a, 0x0d, 0xe2, 0x03,
+	0x09, 0x91, 0x56, 0x51, 0x40, 0xf1, 0x3d, 0x74, 0xda, 0xbb, 0x54, 0x72, 0xec, 0xd2, 0xf4, 0xbb,
+	0xc3, 0x4c, 0xf2, 0x8e, 0x69, 0x40, 0x28, 0x32, 0x6c, 0x16, 0xcc, 0x05, 0x04, 0x50, 0x88, 0x8a,
+	0xf2, 0x8d, 0x99, 0xc8, 0x14, 0x4a, 0x45, 0x79, 0xbc, 0x6a, 0x69, 0x79, 0x53, 0x2f, 0xfa, 0x54,
+	0xb7, 0x50, 0x3a, 0x31, 0x02, 0x68, 0x5e, 0x42, 0x03, 0x3a, 0xff, 0xc4, 0x4a, 0xf7, 0x1f, 0x05,
+	0x37, 0xef, 0x3c, 0x18, 0x20, 0xaa, 0x1f, 0xe2, 0xbd, 0x6d, 0x26, 0x84, 0xcb, 0x9f, 0x52, 0xd3,
+	0xbf, 0xe7, 0xae, 0x21, 0xd4, 0xa4, 0x0b, 0x87, 0x78, 0xa4, 0xd9, 0xa0, 0x9b, 0x63, 0x44, 0x3d,
+	0xd5, 0x50, 0x82, 0x9f, 0x47, 0xa7, 0xa9, 0x7b, 0x4f, 0xb7, 0x21, 0xed, 0x18, 0x4b, 0x1b, 0x6d,
+	0x56, 0x20, 0x30, 0x48, 0x54, 0xc4, 0xfe, 0xb1, 0x44, 0x72, 0x07, 0x4d, 0xc6, 0xb3, 0x01, 0x71,
+	0x2b, 0x68, 0x80, 0x2d, 0xbd, 0x51, 0x84, 0x7d, 0x11, 0x10, 0x07, 0x03, 0xde, 0x3b, 0x83, 0x52,
+	0x73, 0xb3, 0x18, 0x5c, 0x7c, 0xfe, 0x74, 0x70, 0x69, 0xc1, 0xc3, 0x3a, 0xd0, 0xad, 0x4a, 0xa3,
+	0x71, 0x7c, 0x13, 0x58, 0xfc, 0xb8, 0x28, 0x98, 0xf8, 0x81, 0x84, 0x86, 0x35, 0xd3, 0xcc, 0x95,
+	0x61, 0x3c, 0x67, 0xf3, 0x00, 0x68, 0x1c, 0x2d, 0x2e, 0x09, 0x11, 0x54, 0x99, 0x81, 0xfd, 0x30,
+	0x01, 0x3d, 0x3a, 0x06, 0x97, 0xa8, 0x58, 0x13, 0x12, 0xe3, 0xda, 0x21, 0xbb, 0x77, 0x36, 0x0c,
+	0xa7, 0x40, 0x0f, 0x74, 0xbb, 0xea, 0xab, 0xfa, 0x32, 0xa6, 0x1d, 0x46, 0x23, 0x41, 0xd9, 0x3e,
+	0x3a, 0xc7, 0x2e, 0xa7, 0x5c, 0xd1, 0x1f, 0x6a, 0xdf, 0x0c, 0xc3, 0x50, 0x4a, 0x0a, 0xf4, 0x8c,
+	0x06, 0x2e, 0xbd, 0x26, 0x1c, 0x51, 0x07, 0xed, 0x50, 0xfc, 0x95, 0x47, 0x32, 0x3a, 0xc9, 0xd8,
+	0xe1, 0x0f, 0x25, 0xd4, 0xcf, 0x5f, 0xeb, 0xb8, 0x45, 0x09, 0x45, 0x93, 0x20, 0xaf, 0x76, 0x18,
+	0xcd, 0x45, 0x92, 0xa9, 0xf7, 0x7f, 0xf9, 0xeb, 0x8b, 0x63, 0x32, 0x1e, 0xcb, 0x0a, 0xde, 0x85,
+	0xbb, 0x01, 0xfc, 0x48, 0x42, 0xe3, 0x89, 0xef, 0x7b, 0xfc, 0x4a, 0x9b, 0xe9, 0xda, 0x79, 0x08,
+	0x79, 0xad, 0x77, 0x00, 0x90, 0xb0, 0xcc, 0x24, 0xcc, 0x62, 0x22, 0x4a, 0x88, 0x7a, 0x86, 0xa8,
+	0x98, 0xf0, 0x6b, 0xbe, 0x1b, 0x31, 0xb1, 0xc6, 0x42, 0x5e, 0xeb, 0x1d, 0xa0, 0xbd, 0x18, 0x78,
+	0x8d, 0x7b, 0xb7, 0x29, 0x6b, 0x10, 0xf8, 0x3b, 0x09, 0x8d, 0xc4, 0xba, 0x00, 0xfc, 0x52, 0xe7,
+	0x3c, 0x04, 0x83, 0x21, 0xdf, 0xe8, 0x2d, 0x19, 0x04, 0xcc, 0x31, 0x01, 0x69, 0x7c, 0x51, 0x14,
+	0x00, 0xc7, 0x99, 0x31, 0xfc, 0x55, 0x42, 0x93, 0xad, 0x5e, 0xfe, 0x58, 0xe9, 0x9c, 0x45, 0x92,
+	0x17, 0x91, 0x6f, 0x1d, 0x09, 0x03, 0x04, 0xad, 0x32, 0x41, 0x0b, 0x78, 0x4e, 0x14, 0xd4, 0x7c,
+	0x78, 0x7b, 0x8b, 0xc2, 0xce, 0x32, 0x7e, 0x22, 0xa1, 0x8b, 0x2d, 0x1d, 0x01, 0xbe, 0xd5, 0x55,
+	0x7d, 0xe3, 0xdd, 0x87, 0xbc, 0x71, 0x34, 0x10, 0xd0, 0x96, 0x61, 0xda, 0x16, 0xf1, 0x7c, 0xfc,
+	0x62, 0xf1, 0x7e, 0xd5, 0x54, 0x89, 0x7f, 0x0f, 0x8b, 0x13, 0x9f, 0xf9, 0xdd, 0x88, 0x4b, 0x34,
+	0x26, 0xf2, 0xc6, 0xd1, 0x40, 0x40, 0x5c, 0x96, 0x89, 0x5b, 0xc2, 0x0b, 0xa2, 0x38, 0xd7, 0xcb,
+	0xca, 0x95, 0x35, 0xc3, 0xce, 0x69, 0x76, 0x9e, 0xeb, 0x74, 0xf0, 0xf7, 0x12, 0xba, 0x90, 0x60,
+	0x2c, 0xf0, 0xcd, 0x2e, 0xea, 0x2d, 0xfa, 0x16, 0xf9, 0xe5, 0x5e, 0xd3, 0x41, 0xcb, 0x02, 0xd3,
+	0x32, 0x8d, 0xd3, 0x31, 0x0b, 0x15, 0x34, 0x32, 0xf8, 0x67, 0x09, 0x4d, 0xb4, 0xb0, 0x22, 0x78,
+	0xbd, 0x73, 0x22, 0x09, 0x8e, 0x47, 0x56, 0x8e, 0x02, 0x01, 0x7a, 0x56, 0x98, 0x9e, 0x39, 0x3c,
+	0x23, 0xea, 0x11, 0xec, 0x0f, 0xfe, 0x29, 0xdc, 0xb4, 0xc3, 0x86, 0xa3, 0x9b, 0xa6, 0x1d, 0xeb,
+	0x90, 0xe4, 0xb5, 0xde, 0x01, 0xda, 0xab, 0x11, 0xfc, 0x0f, 0xfe, 0x23, 0x7c, 0x86, 0xc4, 0xa7,
+	0x7f, 0x37, 0x67, 0x28, 0xd1, 0x66, 0xc8, 0x1b, 0x47, 0x03, 0x01, 0x65, 0xff, 0x63, 0xca, 0x96,
+	0xf1, 0xa2, 0xa8, 0x2c, 0xde, 0x6d, 0xe0, 0xbf, 0x25, 0x34, 0xd5, 0xce, 0x98, 0xe1, 0xd7, 0x7a,
+	0x27, 0x17, 0xb4, 0x82, 0xf2, 0xed, 0x23, 0xe3, 0x80, 0xce, 0xab, 0x4c, 0xe7, 0x2a, 0x5e, 0xe9,
+	0x4c, 0x27, 0xb3, 0x83, 0xd1, 0xfb, 0xb7, 0xe9, 0x8c, 0xba, 0xb9, 0x7f, 0x05, 0xd7, 0x25, 0xdf,
+	0xe8, 0x2d, 0xb9, 0xfd, 0xfd, 0x1b, 0xb0, 0x57, 0xf8, 0x5b, 0x09, 0x61, 0xd1, 0x2b, 0xe1, 0xeb,
+	0x9d, 0xcf, 0x1d, 0x36, 0x60, 0xf2, 0x0b, 0x3d, 0x64, 0x02, 0xe5, 0x69, 0x46, 0x79, 0x02, 0x8f,
+	0x8b, 0x94, 0xc1, 0x8d, 0xe1, 0xaf, 0x25, 0x74, 0x2e, 0x62, 0x7d, 0xf0, 0xff, 0xbb, 0x78, 0x6c,
+	0x35, 0x8d, 0x9b, 0xfc, 0x5c, 0xb7, 0x69, 0xc0, 0x32, 0xc5, 0x58, 0x8e, 0xe1, 0x51, 0x91, 0xa5,
+	0xb7, 0x3d, 0xf0, 0x0f, 0x7c, 0x37, 0x88, 0xae, 0xa6, 0x93, 0xdd, 0x90, 0x68, 0xc3, 0xe4, 0x1b,
+	0xbd, 0x25, 0x77, 0x76, 0xc1, 0x47, 0xcd, 0x15, 0xfe, 0x31, 0xdc, 0x6a, 0xc3, 0x76, 0xa6, 0x9b,
+	0x56, 0x1b, 0xeb, 0xbe, 0xe4, 0xb5, 0xde, 0x01, 0x40, 0xd0, 0x12, 0x13, 0x34, 0x83, 0xa7, 0x45,
+	0x41, 0x11, 0x77, 0xa5, 0xdc, 0x7d, 0xf8, 0x34, 0x25, 0x3d, 0x7e, 0x9a, 0x92, 0xfe, 0x7c, 0x9a,
+	0x92, 0x3e, 0x7d, 0x96, 0xea, 0x7b, 0xfc, 0x2c, 0xd5, 0xf7, 0xdb, 0xb3, 0x54, 0xdf, 0xdb, 0xd7,
+	0xf6, 0x0c, 0xf7, 0x5e, 0x25, 0x9f, 0x29, 0xd0, 0x92, 0x0f, 0xb3, 0x6a, 0x6a, 0x79, 0xa7, 0x81,
+	0x79, 0x70, 0xf5, 0x72, 0xf6, 0xb0, 0x89, 0xec, 0xf5, 0x6d, 0x27, 0xdf, 0xcf, 0xfe, 0x5f, 0xfd,
+	0x67, 0x00, 0x2f, 0xfd, 0x01, 0x9b, 0xad, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1668,6 +1761,9 @@ type QueryClient interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevRouteDiscovery queries the configuration of the discovery of
+	// cyclic arbitrage routes
+	GetProtoRevRouteDiscovery(ctx context.Context, in *QueryGetProtoRevRouteDiscoveryRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRouteDiscoveryResponse, error)
 }
 
 type queryClient struct {
@@ -1822,6 +1918,15 @@ func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAll
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevRouteDiscovery(ctx context.Context, in *QueryGetProtoRevRouteDiscoveryRequest, opts ...grpc.CallOption) (*QueryGetProtoRevRouteDiscoveryResponse, error) {
+	out := new(QueryGetProtoRevRouteDiscoveryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevRouteDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1867,6 +1972,9 @@ type QueryServer interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevRouteDiscovery queries the configuration of the discovery of
+	// cyclic arbitrage routes
+	GetProtoRevRouteDiscovery(context.Context, *QueryGetProtoRevRouteDiscoveryRequest) (*QueryGetProtoRevRouteDiscoveryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1921,6 +2029,9 @@ func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevRouteDiscovery(ctx context.Context, req *QueryGetProtoRevRouteDiscoveryRequest) (*QueryGetProtoRevRouteDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevRouteDiscovery not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2214,6 +2325,24 @@ func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevRouteDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevRouteDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevRouteDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevRouteDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevRouteDiscovery(ctx, req.(*QueryGetProtoRevRouteDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2282,6 +2411,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProtocolRevenue",
 			Handler:    _Query_GetAllProtocolRevenue_Handler,
 		},
+		{
+			MethodName: "GetProtoRevRouteDiscovery",
+			Handler:    _Query_GetProtoRevRouteDiscovery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/query.proto",
@@ -3219,6 +3352,62 @@ func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetProtoRevRouteDiscoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RouteDiscovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3595,6 +3784,26 @@ func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetProtoRevRouteDiscoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevRouteDiscoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RouteDiscovery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5852,6 +6061,139 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetProtoRevRouteDiscoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRouteDiscoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRouteDiscoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevRouteDiscoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevRouteDiscoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevRouteDiscoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteDiscovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteDiscovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
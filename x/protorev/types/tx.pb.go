@@ -648,6 +648,99 @@ func (m *MsgSetPoolWeights) GetPoolWeights() *PoolWeights {
 	return nil
 }
 
+// MsgSetRouteDiscovery defines the Msg/SetRouteDiscovery request type.
+type MsgSetRouteDiscovery struct {
+	// admin is the account that is authorized to set the route discovery.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// route_discovery is the configuration of the route discovery to set.
+	RouteDiscovery RouteDiscovery `protobuf:"bytes,2,opt,name=route_discovery,json=routeDiscovery,proto3" json:"route_discovery" yaml:"route_discovery"`
+}
+
+func (m *MsgSetRouteDiscovery) Reset()         { *m = MsgSetRouteDiscovery{} }
+func (m *MsgSetRouteDiscovery) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteDiscovery) ProtoMessage()    {}
+func (*MsgSetRouteDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{13}
+}
+func (m *MsgSetRouteDiscovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteDiscovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteDiscovery.Merge(m, src)
+}
+func (m *MsgSetRouteDiscovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteDiscovery proto.InternalMessageInfo
+
+func (m *MsgSetRouteDiscovery) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetRouteDiscovery) GetRouteDiscovery() RouteDiscovery {
+	if m != nil {
+		return m.RouteDiscovery
+	}
+	return RouteDiscovery{}
+}
+
+// MsgSetRouteDiscoveryResponse defines the Msg/SetRouteDiscovery response
+// type.
+type MsgSetRouteDiscoveryResponse struct {
+}
+
+func (m *MsgSetRouteDiscoveryResponse) Reset()         { *m = MsgSetRouteDiscoveryResponse{} }
+func (m *MsgSetRouteDiscoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteDiscoveryResponse) ProtoMessage()    {}
+func (*MsgSetRouteDiscoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{14}
+}
+func (m *MsgSetRouteDiscoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteDiscoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteDiscoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteDiscoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteDiscoveryResponse.Merge(m, src)
+}
+func (m *MsgSetRouteDiscoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteDiscoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteDiscoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteDiscoveryResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetHotRoutes)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutes")
 	proto.RegisterType((*MsgSetHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutesResponse")
@@ -662,71 +755,78 @@ func init() {
 	proto.RegisterType((*MsgSetBaseDenoms)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenoms")
 	proto.RegisterType((*MsgSetBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenomsResponse")
 	proto.RegisterType((*MsgSetPoolWeights)(nil), "osmosis.protorev.v1beta1.MsgSetPoolWeights")
+	proto.RegisterType((*MsgSetRouteDiscovery)(nil), "osmosis.protorev.v1beta1.MsgSetRouteDiscovery")
+	proto.RegisterType((*MsgSetRouteDiscoveryResponse)(nil), "osmosis.protorev.v1beta1.MsgSetRouteDiscoveryResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/protorev/v1beta1/tx.proto", fileDescriptor_2783dce032fc6954) }
 
 var fileDescriptor_2783dce032fc6954 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xed, 0x74, 0x01, 0xb1, 0xd3, 0x02, 0x1b, 0xd3, 0xdd, 0x26, 0xa6, 0x38, 0xe9, 0x94, 0xa5,
-	0x69, 0xd9, 0xc6, 0x24, 0xbb, 0xfc, 0x50, 0x24, 0x90, 0x6a, 0xed, 0x61, 0xf7, 0x50, 0x54, 0x79,
-	0x8b, 0x90, 0x38, 0x60, 0xec, 0x64, 0xea, 0x5a, 0x1b, 0x7b, 0x2c, 0xcf, 0x34, 0x24, 0xd7, 0x3d,
-	0x72, 0x42, 0x42, 0xe2, 0xc0, 0xbf, 0x00, 0x87, 0x15, 0xe2, 0xca, 0x7d, 0xb9, 0xad, 0xca, 0x85,
-	0x0b, 0x11, 0xb4, 0x48, 0xbd, 0xe7, 0xc8, 0x09, 0x65, 0xc6, 0x71, 0x3a, 0xb1, 0x4d, 0x1b, 0x72,
-	0x69, 0x33, 0x33, 0xef, 0x7b, 0xdf, 0x7b, 0xcf, 0xf6, 0x37, 0x70, 0x9d, 0x50, 0x9f, 0x50, 0x8f,
-	0xea, 0x61, 0x44, 0x18, 0x89, 0x70, 0x57, 0xef, 0xd6, 0x1d, 0xcc, 0xec, 0xba, 0xce, 0x7a, 0x35,
-	0xbe, 0xa7, 0x14, 0x63, 0x48, 0x6d, 0x0c, 0xa9, 0xc5, 0x10, 0x75, 0xc5, 0x25, 0x2e, 0xe1, 0xbb,
-	0xfa, 0xe8, 0x97, 0x00, 0xa8, 0x05, 0xdb, 0xf7, 0x02, 0xa2, 0xf3, 0xbf, 0xf1, 0xd6, 0x9a, 0x4b,
-	0x88, 0xdb, 0xc1, 0xba, 0x1d, 0x7a, 0xba, 0x1d, 0x04, 0x84, 0xd9, 0xcc, 0x23, 0x41, 0xcc, 0xa8,
-	0x6e, 0xe6, 0x6a, 0x48, 0x3a, 0x0a, 0x60, 0xa9, 0xc5, 0x91, 0x96, 0x68, 0x29, 0x16, 0xf1, 0xd1,
-	0xaa, 0x58, 0xe9, 0x3e, 0x75, 0xf5, 0x6e, 0x7d, 0xf4, 0x4f, 0x1c, 0xa0, 0xbf, 0x00, 0x7c, 0x6d,
-	0x8f, 0xba, 0x8f, 0x30, 0x7b, 0x40, 0x98, 0x49, 0x8e, 0x19, 0xa6, 0xca, 0xc7, 0xf0, 0x45, 0xbb,
-	0xed, 0x7b, 0x41, 0x11, 0x54, 0x40, 0xf5, 0xba, 0x51, 0x1d, 0x0e, 0xca, 0xcb, 0x7d, 0xdb, 0xef,
-	0x34, 0x11, 0xdf, 0x46, 0x27, 0x3f, 0xef, 0xac, 0xc4, 0xec, 0xbb, 0xed, 0x76, 0x84, 0x29, 0x7d,
-	0xc4, 0x22, 0x2f, 0x70, 0x4d, 0x51, 0xa6, 0x1c, 0x42, 0x78, 0x44, 0x98, 0x15, 0x71, 0xb6, 0xe2,
-	0x62, 0xe5, 0x5a, 0x75, 0xa9, 0x71, 0xa7, 0x96, 0x17, 0x53, 0xed, 0x80, 0x3c, 0xc6, 0xc1, 0xbe,
-	0xed, 0x45, 0xbb, 0x91, 0x23, 0x14, 0x18, 0xa5, 0x67, 0x83, 0xf2, 0xc2, 0x70, 0x50, 0x2e, 0x88,
-	0xb6, 0x13, 0x36, 0x64, 0x5e, 0x3f, 0x1a, 0xeb, 0x6c, 0xbe, 0xfd, 0xe4, 0xfc, 0xe9, 0xb6, 0xe8,
-	0xf9, 0xf5, 0xf9, 0xd3, 0xed, 0xd5, 0x71, 0x4e, 0x53, 0x7e, 0x50, 0x09, 0xae, 0x4e, 0x6d, 0x99,
-	0x98, 0x86, 0x24, 0xa0, 0x18, 0x9d, 0x00, 0x78, 0x4b, 0x9c, 0xdd, 0xc7, 0x5d, 0xdc, 0x21, 0x21,
-	0x8e, 0x76, 0x5b, 0x2d, 0x72, 0x1c, 0xb0, 0xb9, 0x53, 0x78, 0x08, 0x0b, 0xed, 0x31, 0xa7, 0x65,
-	0x0b, 0xd2, 0xe2, 0x22, 0xe7, 0x5a, 0x1b, 0x0e, 0xca, 0x45, 0xc1, 0x95, 0x82, 0x20, 0xf3, 0x46,
-	0x7b, 0x4a, 0x4a, 0x73, 0x47, 0x36, 0xaa, 0xc9, 0x46, 0xa7, 0x95, 0xa3, 0x0a, 0xd4, 0xb2, 0x4f,
-	0x12, 0xdb, 0xff, 0x00, 0xb8, 0x22, 0x20, 0x0f, 0x83, 0x43, 0x62, 0xf4, 0xf7, 0x09, 0xe9, 0x1c,
-	0xf4, 0x43, 0x3c, 0xb7, 0xe9, 0x63, 0x58, 0xf0, 0x82, 0x43, 0x62, 0x39, 0x7d, 0x2b, 0x24, 0xa4,
-	0x63, 0xb1, 0x7e, 0x88, 0xb9, 0xe9, 0xa5, 0x46, 0x35, 0xff, 0x0d, 0x90, 0x45, 0x18, 0x95, 0xf8,
-	0xe9, 0xc7, 0x11, 0xa5, 0x08, 0x91, 0xf9, 0xaa, 0x27, 0x55, 0x34, 0xdf, 0x91, 0x03, 0x5a, 0x93,
-	0x03, 0x92, 0xe9, 0x91, 0x06, 0xd7, 0xb2, 0xf6, 0x93, 0x70, 0x4e, 0x01, 0x2c, 0x0a, 0xc0, 0x9e,
-	0xdd, 0x1b, 0x9d, 0xee, 0x13, 0x2f, 0x60, 0x74, 0x1f, 0x47, 0x07, 0xbd, 0xb9, 0x03, 0xfa, 0x14,
-	0xde, 0xf2, 0xed, 0x9e, 0xf0, 0x12, 0x72, 0x5e, 0x6b, 0xf4, 0xf0, 0x59, 0x8f, 0xa7, 0xf4, 0x82,
-	0xb1, 0x3e, 0x1c, 0x94, 0xdf, 0x14, 0x84, 0xd9, 0x38, 0x64, 0x2a, 0x7e, 0x4a, 0x56, 0x53, 0x97,
-	0x03, 0xa8, 0xc8, 0x01, 0xa4, 0x7d, 0x20, 0x04, 0x2b, 0x79, 0x67, 0x49, 0x10, 0xe7, 0x00, 0xbe,
-	0x91, 0x0d, 0x32, 0x3a, 0xa4, 0xf5, 0x78, 0xee, 0x2c, 0xbe, 0x80, 0xa5, 0x2c, 0x8f, 0xce, 0x88,
-	0x3c, 0x8e, 0xe3, 0xad, 0xe1, 0xa0, 0x5c, 0xc9, 0x8f, 0x83, 0x43, 0x91, 0x79, 0xd3, 0xcf, 0xd2,
-	0xd7, 0xac, 0xca, 0xa1, 0x94, 0xe4, 0x50, 0x46, 0x05, 0x9f, 0x61, 0xcf, 0x3d, 0x62, 0x14, 0xdd,
-	0x86, 0x1b, 0xff, 0x61, 0x34, 0x09, 0xe4, 0x0f, 0x00, 0x6f, 0x08, 0x9c, 0x61, 0x53, 0x7c, 0x1f,
-	0x07, 0xc4, 0x9f, 0x7f, 0x5a, 0x7e, 0x09, 0x97, 0x1c, 0x9b, 0x62, 0xab, 0xcd, 0xe9, 0xe2, 0x71,
-	0xb9, 0x91, 0xff, 0xb1, 0x24, 0xad, 0x0d, 0x35, 0xfe, 0x4e, 0x14, 0xd1, 0xee, 0x02, 0x0b, 0x32,
-	0xa1, 0x93, 0x28, 0x6c, 0x6e, 0xca, 0x39, 0x14, 0xe5, 0x1c, 0x26, 0x56, 0x90, 0x3a, 0x7e, 0xf1,
-	0x27, 0x7b, 0x89, 0x77, 0x0a, 0x0b, 0xa9, 0xdc, 0x94, 0x15, 0xc9, 0xfb, 0xd8, 0xd1, 0x03, 0xb8,
-	0xcc, 0x1f, 0xd4, 0x57, 0x02, 0x15, 0x7f, 0xff, 0xb7, 0xf3, 0x2d, 0x5d, 0xa0, 0x34, 0x97, 0xc2,
-	0xc9, 0xa2, 0xf1, 0xc3, 0xcb, 0xf0, 0xda, 0x1e, 0x75, 0x95, 0xef, 0x00, 0x5c, 0x96, 0xae, 0xa8,
-	0xad, 0x7c, 0xb2, 0xa9, 0x51, 0xaf, 0xd6, 0xaf, 0x0c, 0x4d, 0xbc, 0x56, 0x9f, 0xfc, 0xf6, 0xf7,
-	0xb7, 0x8b, 0x08, 0x55, 0xf4, 0xd4, 0xd5, 0x4b, 0x31, 0xb3, 0x26, 0xd7, 0x91, 0xf2, 0x13, 0x80,
-	0xaf, 0x67, 0x5d, 0x1e, 0xef, 0x5e, 0xd6, 0x74, 0xba, 0x42, 0xfd, 0x70, 0xd6, 0x8a, 0x44, 0xad,
-	0xce, 0xd5, 0x6e, 0xa1, 0xcd, 0x6c, 0xb5, 0xa9, 0x1b, 0x46, 0xf9, 0x05, 0xc0, 0x9b, 0xd9, 0xd3,
-	0xad, 0x71, 0x99, 0x88, 0x74, 0x8d, 0xda, 0x9c, 0xbd, 0x26, 0x91, 0x7e, 0x8f, 0x4b, 0xaf, 0xa1,
-	0x3b, 0xd9, 0xd2, 0xb3, 0x27, 0xa0, 0xf2, 0x2b, 0x80, 0xc5, 0xdc, 0xa1, 0xf4, 0xde, 0xac, 0x72,
-	0x78, 0x99, 0xfa, 0xd1, 0xff, 0x2a, 0x4b, 0x8c, 0x7c, 0xc0, 0x8d, 0xd4, 0x91, 0x7e, 0x75, 0x23,
-	0x7c, 0x76, 0x29, 0x3f, 0x02, 0x58, 0x48, 0x5f, 0xc3, 0xb5, 0xcb, 0xd4, 0xc8, 0x78, 0xf5, 0xfd,
-	0xd9, 0xf0, 0x57, 0x7d, 0x75, 0x52, 0x37, 0xaf, 0xf2, 0x3d, 0x80, 0xaf, 0xc8, 0xe3, 0x6f, 0xfb,
-	0xb2, 0xd6, 0x13, 0xac, 0xda, 0xb8, 0x3a, 0x36, 0x91, 0xb8, 0xc5, 0x25, 0x6e, 0xa0, 0xf5, 0x6c,
-	0x89, 0x17, 0x86, 0x9e, 0xf1, 0xc9, 0xb3, 0x53, 0x0d, 0x3c, 0x3f, 0xd5, 0xc0, 0x9f, 0xa7, 0x1a,
-	0xf8, 0xe6, 0x4c, 0x5b, 0x78, 0x7e, 0xa6, 0x2d, 0xfc, 0x7e, 0xa6, 0x2d, 0x7c, 0x7e, 0xcf, 0xf5,
-	0xd8, 0xd1, 0xb1, 0x53, 0x6b, 0x11, 0x7f, 0x4c, 0xb3, 0xd3, 0xb1, 0x1d, 0x9a, 0x70, 0x76, 0xef,
-	0xd6, 0xf5, 0xde, 0x84, 0x79, 0xe4, 0x95, 0x3a, 0x2f, 0xf1, 0xf5, 0xdd, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x85, 0x8f, 0x0a, 0x05, 0x0c, 0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x18, 0xad, 0xbb, 0x80, 0xd4, 0x69, 0xd9, 0xdd, 0x98, 0x6e, 0xeb, 0x98, 0xe2, 0xa4, 0x53, 0x4a,
+	0xd3, 0xb2, 0x8d, 0x49, 0x76, 0x59, 0x50, 0x24, 0x90, 0x6a, 0xf5, 0xb0, 0x7b, 0x28, 0xaa, 0xbc,
+	0x45, 0x48, 0x1c, 0x30, 0x76, 0x32, 0x75, 0xad, 0x8d, 0x3d, 0xc6, 0xe3, 0x86, 0xe4, 0xba, 0x47,
+	0x4e, 0x48, 0x48, 0x1c, 0xf8, 0x13, 0x10, 0x87, 0x15, 0xe2, 0xca, 0x7d, 0xb9, 0xad, 0x96, 0xcb,
+	0x5e, 0x88, 0xa0, 0x45, 0xea, 0x3d, 0x07, 0x0e, 0x9c, 0x50, 0x66, 0x1c, 0xa7, 0xe3, 0x1f, 0xa4,
+	0xd9, 0x5c, 0xda, 0x78, 0xe6, 0xcd, 0xfb, 0xde, 0x7b, 0xb6, 0xbf, 0xcf, 0x60, 0x1d, 0x13, 0x17,
+	0x13, 0x87, 0xa8, 0x7e, 0x80, 0x43, 0x1c, 0xa0, 0x8e, 0xda, 0xa9, 0x59, 0x28, 0x34, 0x6b, 0x6a,
+	0xd8, 0xad, 0xd2, 0x35, 0x51, 0x8a, 0x20, 0xd5, 0x11, 0xa4, 0x1a, 0x41, 0xe4, 0x65, 0x1b, 0xdb,
+	0x98, 0xae, 0xaa, 0xc3, 0x5f, 0x0c, 0x20, 0x17, 0x4c, 0xd7, 0xf1, 0xb0, 0x4a, 0xff, 0x46, 0x4b,
+	0x6b, 0x36, 0xc6, 0x76, 0x1b, 0xa9, 0xa6, 0xef, 0xa8, 0xa6, 0xe7, 0xe1, 0xd0, 0x0c, 0x1d, 0xec,
+	0x45, 0x8c, 0xf2, 0x56, 0xae, 0x86, 0xb8, 0x22, 0x03, 0x16, 0x9b, 0x14, 0x69, 0xb0, 0x92, 0xec,
+	0x22, 0xda, 0x5a, 0x65, 0x57, 0xaa, 0x4b, 0x6c, 0xb5, 0x53, 0x1b, 0xfe, 0x63, 0x1b, 0xf0, 0x2f,
+	0x01, 0xdc, 0x38, 0x20, 0xf6, 0x43, 0x14, 0xde, 0xc7, 0xa1, 0x8e, 0x4f, 0x43, 0x44, 0xc4, 0x8f,
+	0xc1, 0xab, 0x66, 0xcb, 0x75, 0x3c, 0x49, 0x28, 0x0b, 0x95, 0x05, 0xad, 0x32, 0xe8, 0x97, 0x96,
+	0x7a, 0xa6, 0xdb, 0x6e, 0x40, 0xba, 0x0c, 0x9f, 0xff, 0xb2, 0xbb, 0x1c, 0xb1, 0xef, 0xb5, 0x5a,
+	0x01, 0x22, 0xe4, 0x61, 0x18, 0x38, 0x9e, 0xad, 0xb3, 0x63, 0xe2, 0x31, 0x00, 0x27, 0x38, 0x34,
+	0x02, 0xca, 0x26, 0xcd, 0x97, 0xaf, 0x55, 0x16, 0xeb, 0xb7, 0xab, 0x79, 0x31, 0x55, 0x8f, 0xf0,
+	0x23, 0xe4, 0x1d, 0x9a, 0x4e, 0xb0, 0x17, 0x58, 0x4c, 0x81, 0x56, 0x7c, 0xda, 0x2f, 0xcd, 0x0d,
+	0xfa, 0xa5, 0x02, 0x2b, 0x3b, 0x66, 0x83, 0xfa, 0xc2, 0xc9, 0x48, 0x67, 0xe3, 0x9d, 0xc7, 0x17,
+	0x4f, 0x76, 0x58, 0xcd, 0x6f, 0x2e, 0x9e, 0xec, 0xac, 0x8e, 0x72, 0x4a, 0xf8, 0x81, 0x45, 0xb0,
+	0x9a, 0x58, 0xd2, 0x11, 0xf1, 0xb1, 0x47, 0x10, 0x7c, 0x2e, 0x80, 0x15, 0xb6, 0xb7, 0x8f, 0x3a,
+	0xa8, 0x8d, 0x7d, 0x14, 0xec, 0x35, 0x9b, 0xf8, 0xd4, 0x0b, 0x67, 0x4e, 0xe1, 0x01, 0x28, 0xb4,
+	0x46, 0x9c, 0x86, 0xc9, 0x48, 0xa5, 0x79, 0xca, 0xb5, 0x36, 0xe8, 0x97, 0x24, 0xc6, 0x95, 0x82,
+	0x40, 0xfd, 0x66, 0x2b, 0x21, 0xa5, 0xb1, 0xcb, 0x1b, 0x55, 0x78, 0xa3, 0x49, 0xe5, 0xb0, 0x0c,
+	0x94, 0xec, 0x9d, 0xd8, 0xf6, 0xbf, 0x02, 0x58, 0x66, 0x90, 0x07, 0xde, 0x31, 0xd6, 0x7a, 0x87,
+	0x18, 0xb7, 0x8f, 0x7a, 0x3e, 0x9a, 0xd9, 0xf4, 0x29, 0x28, 0x38, 0xde, 0x31, 0x36, 0xac, 0x9e,
+	0xe1, 0x63, 0xdc, 0x36, 0xc2, 0x9e, 0x8f, 0xa8, 0xe9, 0xc5, 0x7a, 0x25, 0xff, 0x09, 0xe0, 0x45,
+	0x68, 0xe5, 0xe8, 0xee, 0x47, 0x11, 0xa5, 0x08, 0xa1, 0x7e, 0xdd, 0xe1, 0x4e, 0x34, 0xde, 0xe5,
+	0x03, 0x5a, 0xe3, 0x03, 0xe2, 0xe9, 0xa1, 0x02, 0xd6, 0xb2, 0xd6, 0xe3, 0x70, 0xce, 0x04, 0x20,
+	0x31, 0xc0, 0x81, 0xd9, 0x1d, 0xee, 0x1e, 0x62, 0xc7, 0x0b, 0xc9, 0x21, 0x0a, 0x8e, 0xba, 0x33,
+	0x07, 0xf4, 0x29, 0x58, 0x71, 0xcd, 0x2e, 0xf3, 0xe2, 0x53, 0x5e, 0x63, 0x78, 0xf3, 0xc3, 0x2e,
+	0x4d, 0xe9, 0x15, 0x6d, 0x7d, 0xd0, 0x2f, 0xbd, 0xc5, 0x08, 0xb3, 0x71, 0x50, 0x17, 0xdd, 0x94,
+	0xac, 0x86, 0xca, 0x07, 0x50, 0xe6, 0x03, 0x48, 0xfb, 0x80, 0x10, 0x94, 0xf3, 0xf6, 0xe2, 0x20,
+	0x2e, 0x04, 0xf0, 0x66, 0x36, 0x48, 0x6b, 0xe3, 0xe6, 0xa3, 0x99, 0xb3, 0xf8, 0x02, 0x14, 0xb3,
+	0x3c, 0x5a, 0x43, 0xf2, 0x28, 0x8e, 0xb7, 0x07, 0xfd, 0x52, 0x39, 0x3f, 0x0e, 0x0a, 0x85, 0xfa,
+	0x2d, 0x37, 0x4b, 0x5f, 0xa3, 0xc2, 0x87, 0x52, 0xe4, 0x43, 0x19, 0x1e, 0xf8, 0x0c, 0x39, 0xf6,
+	0x49, 0x48, 0xe0, 0x26, 0xd8, 0xf8, 0x1f, 0xa3, 0x71, 0x20, 0x7f, 0x08, 0xe0, 0x26, 0xc3, 0x69,
+	0x26, 0x41, 0xfb, 0xc8, 0xc3, 0xee, 0xec, 0xdd, 0xf2, 0x4b, 0xb0, 0x68, 0x99, 0x04, 0x19, 0x2d,
+	0x4a, 0x17, 0xb5, 0xcb, 0x8d, 0xfc, 0x97, 0x25, 0x2e, 0xad, 0xc9, 0xd1, 0x7b, 0x22, 0xb2, 0x72,
+	0x97, 0x58, 0xa0, 0x0e, 0xac, 0x58, 0x61, 0x63, 0x8b, 0xcf, 0x41, 0xe2, 0x73, 0x18, 0x5b, 0x81,
+	0x32, 0x90, 0x92, 0x6b, 0xb1, 0x77, 0x02, 0x0a, 0xa9, 0xdc, 0xc4, 0x65, 0xce, 0xfb, 0xc8, 0xd1,
+	0x7d, 0xb0, 0x44, 0x6f, 0xd4, 0xd7, 0x0c, 0x15, 0xbd, 0xff, 0x9b, 0xf9, 0x96, 0x2e, 0x51, 0xea,
+	0x8b, 0xfe, 0xf8, 0x02, 0xfe, 0x13, 0xf7, 0x29, 0xda, 0xb7, 0xf7, 0x1d, 0xd2, 0xc4, 0x1d, 0x14,
+	0xf4, 0x66, 0x0e, 0xfd, 0x2b, 0x70, 0x83, 0x0e, 0x14, 0xa3, 0x35, 0xa2, 0x9c, 0xdc, 0xa5, 0x78,
+	0x09, 0x9a, 0x12, 0xa5, 0xbf, 0xc2, 0xea, 0x26, 0xe8, 0xa0, 0x7e, 0x3d, 0xe0, 0xf0, 0x13, 0x7a,
+	0x14, 0x4f, 0x3e, 0xee, 0x51, 0xfc, 0xfa, 0xe8, 0x6e, 0xd4, 0x5f, 0x2c, 0x80, 0x6b, 0x07, 0xc4,
+	0x16, 0xbf, 0x17, 0xc0, 0x12, 0x37, 0xbb, 0xb7, 0xf3, 0xf5, 0x27, 0x66, 0xa0, 0x5c, 0xbb, 0x32,
+	0x34, 0x7e, 0x08, 0x2a, 0x8f, 0x7f, 0xff, 0xfb, 0xbb, 0x79, 0x08, 0xcb, 0x6a, 0xea, 0x9b, 0x84,
+	0xa0, 0xd0, 0x18, 0xcf, 0x69, 0xf1, 0x67, 0x01, 0xbc, 0x91, 0x35, 0x55, 0xdf, 0x9b, 0x54, 0x34,
+	0x79, 0x42, 0xfe, 0x70, 0xda, 0x13, 0xb1, 0x5a, 0x95, 0xaa, 0xdd, 0x86, 0x5b, 0xd9, 0x6a, 0x53,
+	0xa3, 0x57, 0xfc, 0x55, 0x00, 0xb7, 0xb2, 0xdb, 0x7e, 0x7d, 0x92, 0x88, 0xf4, 0x19, 0xb9, 0x31,
+	0xfd, 0x99, 0x58, 0xfa, 0x5d, 0x2a, 0xbd, 0x0a, 0x6f, 0x67, 0x4b, 0xcf, 0x1e, 0x0d, 0xe2, 0x6f,
+	0x02, 0x90, 0x72, 0xbb, 0xf5, 0xfb, 0xd3, 0xca, 0xa1, 0xc7, 0xe4, 0x8f, 0x5e, 0xea, 0x58, 0x6c,
+	0xe4, 0x03, 0x6a, 0xa4, 0x06, 0xd5, 0xab, 0x1b, 0xa1, 0x4d, 0x5d, 0xfc, 0x49, 0x00, 0x85, 0xf4,
+	0xf7, 0x49, 0x75, 0x92, 0x1a, 0x1e, 0x2f, 0xdf, 0x9b, 0x0e, 0x7f, 0xd5, 0x47, 0x27, 0xf5, 0x49,
+	0x22, 0xfe, 0x20, 0x80, 0xd7, 0xf9, 0xb9, 0xb0, 0x33, 0xa9, 0xf4, 0x18, 0x2b, 0xd7, 0xaf, 0x8e,
+	0x8d, 0x25, 0x6e, 0x53, 0x89, 0x1b, 0x70, 0x3d, 0x5b, 0xe2, 0xa5, 0x69, 0x20, 0xfe, 0xc8, 0xb2,
+	0x4c, 0xf4, 0xd0, 0x89, 0x59, 0xf2, 0x78, 0xf9, 0xde, 0x74, 0xf8, 0x58, 0xe8, 0x2e, 0x15, 0xba,
+	0x05, 0x37, 0xb3, 0x85, 0x26, 0x1a, 0xa7, 0xf6, 0xc9, 0xd3, 0x33, 0x45, 0x78, 0x76, 0xa6, 0x08,
+	0x7f, 0x9e, 0x29, 0xc2, 0xb7, 0xe7, 0xca, 0xdc, 0xb3, 0x73, 0x65, 0xee, 0xc5, 0xb9, 0x32, 0xf7,
+	0xf9, 0x5d, 0xdb, 0x09, 0x4f, 0x4e, 0xad, 0x6a, 0x13, 0xbb, 0x23, 0xaa, 0xdd, 0xb6, 0x69, 0x91,
+	0x98, 0xb7, 0x73, 0xa7, 0xa6, 0x76, 0xc7, 0xec, 0xc3, 0x1b, 0x43, 0xac, 0xd7, 0xe8, 0xf5, 0x9d,
+	0xff, 0x06, 0x00, 0xe5, 0x47, 0x99, 0x0a, 0xcb, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -759,6 +859,9 @@ type MsgClient interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opts ...grpc.CallOption) (*MsgSetBaseDenomsResponse, error)
+	// SetRouteDiscovery sets the configuration of the discovery of cyclic
+	// arbitrage routes. Can only be called by the admin account.
+	SetRouteDiscovery(ctx context.Context, in *MsgSetRouteDiscovery, opts ...grpc.CallOption) (*MsgSetRouteDiscoveryResponse, error)
 }
 
 type msgClient struct {
@@ -823,6 +926,15 @@ func (c *msgClient) SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opt
 	return out, nil
 }
 
+func (c *msgClient) SetRouteDiscovery(ctx context.Context, in *MsgSetRouteDiscovery, opts ...grpc.CallOption) (*MsgSetRouteDiscoveryResponse, error) {
+	out := new(MsgSetRouteDiscoveryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetRouteDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetHotRoutes sets the hot routes that will be explored when creating
@@ -843,6 +955,9 @@ type MsgServer interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(context.Context, *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error)
+	// SetRouteDiscovery sets the configuration of the discovery of cyclic
+	// arbitrage routes. Can only be called by the admin account.
+	SetRouteDiscovery(context.Context, *MsgSetRouteDiscovery) (*MsgSetRouteDiscoveryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -867,6 +982,9 @@ func (*UnimplementedMsgServer) SetInfoByPoolType(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) SetBaseDenoms(ctx context.Context, req *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBaseDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetRouteDiscovery(ctx context.Context, req *MsgSetRouteDiscovery) (*MsgSetRouteDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouteDiscovery not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -980,6 +1098,24 @@ func _Msg_SetBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRouteDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRouteDiscovery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRouteDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetRouteDiscovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRouteDiscovery(ctx, req.(*MsgSetRouteDiscovery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1008,6 +1144,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBaseDenoms",
 			Handler:    _Msg_SetBaseDenoms_Handler,
 		},
+		{
+			MethodName: "SetRouteDiscovery",
+			Handler:    _Msg_SetRouteDiscovery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/tx.proto",
@@ -1428,6 +1568,69 @@ func (m *MsgSetPoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRouteDiscovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteDiscovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteDiscovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RouteDiscovery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRouteDiscoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteDiscoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteDiscoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1612,6 +1815,30 @@ func (m *MsgSetPoolWeights) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRouteDiscovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RouteDiscovery.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRouteDiscoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2699,6 +2926,171 @@ func (m *MsgSetPoolWeights) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRouteDiscovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteDiscovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteDiscovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteDiscovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRouteDiscoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteDiscoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteDiscoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
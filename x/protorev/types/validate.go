@@ -170,3 +170,17 @@ func ValidateMaxPoolPointsPerTx(points uint64) error {
 
 	return nil
 }
+
+// ---------------------- Route Discovery Validation ---------------------- //
+// Validates the configuration of the discovery of cyclic arbitrage routes.
+func (r *RouteDiscovery) Validate() error {
+	if r.MaxRouteLength < MinDiscoveredRouteLength || r.MaxRouteLength > MaxDiscoveredRouteLength {
+		return fmt.Errorf("max route length must be between %d and %d", MinDiscoveredRouteLength, MaxDiscoveredRouteLength)
+	}
+
+	if r.MaxNeighbors == 0 || r.MaxNeighbors > MaxRouteDiscoveryNeighbors {
+		return fmt.Errorf("max neighbors must be between 1 and %d", MaxRouteDiscoveryNeighbors)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package authenticator
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authenticator = &PasskeyVerification{}
+
+const (
+	// PasskeyVerificationType represents a type of authenticator verifying WebAuthn assertions
+	// signed by a secp256r1 (P-256) passkey.
+	PasskeyVerificationType = "PasskeyVerification"
+
+	// PasskeyPubKeySize is the size of a compressed secp256r1 public key.
+	PasskeyPubKeySize = 33
+
+	// webAuthnGetType is the type of the client data of a WebAuthn assertion.
+	webAuthnGetType = "webauthn.get"
+
+	// authenticatorDataMinLength is the length of the rpIdHash, flags and signCount of the authenticator data.
+	authenticatorDataMinLength = 37
+	// flagUserPresent and flagUserVerified are the UP and UV bits of the authenticator data flags.
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+)
+
+// secp256r1HalfOrder is half the order of the secp256r1 curve, used to reject malleable high-s signatures.
+var secp256r1HalfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// PasskeyConfig is the configuration of a passkey authenticator.
+type PasskeyConfig struct {
+	// PublicKey is the compressed secp256r1 public key of the passkey.
+	PublicKey []byte `json:"public_key"`
+	// RpId is the WebAuthn relying party id the passkey is scoped to, e.g. "app.osmosis.zone".
+	RpId string `json:"rp_id"`
+	// Origins are the origins the assertion can be created from. Any origin is accepted when empty.
+	Origins []string `json:"origins,omitempty"`
+	// RequireUserVerification requires the authenticator to have verified the user, e.g. with a PIN or biometrics.
+	RequireUserVerification bool `json:"require_user_verification,omitempty"`
+}
+
+// PasskeySignature is the signature expected by the passkey authenticator: a WebAuthn assertion
+// whose challenge is the sha256 hash of the transaction sign bytes.
+type PasskeySignature struct {
+	AuthenticatorData []byte `json:"authenticator_data"`
+	ClientDataJSON    []byte `json:"client_data_json"`
+	// Signature is the raw, low-s normalized, 64 bytes R || S encoding of the signature.
+	Signature []byte `json:"signature"`
+}
+
+// clientData holds the fields of the WebAuthn client data that are verified.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// PasskeyVerification verifies WebAuthn assertions over secp256r1, so that transactions can be signed
+// with device passkeys. The assertion challenge must be the sha256 hash of the transaction sign bytes.
+type PasskeyVerification struct {
+	ak authante.AccountKeeper
+
+	config    PasskeyConfig
+	publicKey *ecdsa.PublicKey
+}
+
+// NewPasskeyVerification creates a new PasskeyVerification
+func NewPasskeyVerification(ak authante.AccountKeeper) PasskeyVerification {
+	return PasskeyVerification{ak: ak}
+}
+
+func (pv PasskeyVerification) Type() string {
+	return PasskeyVerificationType
+}
+
+func (pv PasskeyVerification) StaticGas() uint64 {
+	// using 0 gas here. The gas is consumed in Authenticate()
+	return 0
+}
+
+// Initialize parses the passkey configuration and its public key
+func (pv PasskeyVerification) Initialize(config []byte) (Authenticator, error) {
+	passkeyConfig, publicKey, err := parsePasskeyConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	pv.config = passkeyConfig
+	pv.publicKey = publicKey
+	return pv, nil
+}
+
+// Authenticate verifies the WebAuthn assertion in the request signature against the transaction sign bytes
+func (pv PasskeyVerification) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	// First consume gas for verifying the signature
+	params := pv.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256r1(), "secp256r1 signature verification")
+
+	if request.Simulate || ctx.IsReCheckTx() {
+		return nil
+	}
+	if pv.publicKey == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey not set on authenticator")
+	}
+
+	var signature PasskeySignature
+	if err := json.Unmarshal(request.Signature, &signature); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to parse passkey signature")
+	}
+	if err := pv.verifyAssertion(request.SignModeTxData.Direct, signature); err != nil {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"passkey signature verification failed: %s; please verify account number (%d), sequence (%d) and chain-id (%s)",
+			err,
+			request.TxData.AccountNumber,
+			request.TxData.AccountSequence,
+			request.TxData.ChainID,
+		)
+	}
+	return nil
+}
+
+// verifyAssertion verifies the client data and authenticator data of the assertion, then its signature
+// over authenticatorData || sha256(clientDataJSON).
+func (pv PasskeyVerification) verifyAssertion(signBytes []byte, signature PasskeySignature) error {
+	var data clientData
+	if err := json.Unmarshal(signature.ClientDataJSON, &data); err != nil {
+		return fmt.Errorf("invalid client data")
+	}
+	if data.Type != webAuthnGetType {
+		return fmt.Errorf("invalid client data type %q", data.Type)
+	}
+	challenge := sha256.Sum256(signBytes)
+	if data.Challenge != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+		return fmt.Errorf("challenge does not match the sign bytes")
+	}
+	if len(pv.config.Origins) > 0 && !slices.Contains(pv.config.Origins, data.Origin) {
+		return fmt.Errorf("origin %q is not allowed", data.Origin)
+	}
+
+	authData := signature.AuthenticatorData
+	if len(authData) < authenticatorDataMinLength {
+		return fmt.Errorf("authenticator data too short")
+	}
+	rpIdHash := sha256.Sum256([]byte(pv.config.RpId))
+	if !bytes.Equal(authData[:32], rpIdHash[:]) {
+		return fmt.Errorf("relying party id does not match")
+	}
+	flags := authData[32]
+	if flags&flagUserPresent == 0 {
+		return fmt.Errorf("user presence flag not set")
+	}
+	if pv.config.RequireUserVerification && flags&flagUserVerified == 0 {
+		return fmt.Errorf("user verification flag not set")
+	}
+
+	if len(signature.Signature) != 64 {
+		return fmt.Errorf("invalid signature length, expected 64, got %d", len(signature.Signature))
+	}
+	r := new(big.Int).SetBytes(signature.Signature[:32])
+	s := new(big.Int).SetBytes(signature.Signature[32:])
+	if s.Cmp(secp256r1HalfOrder) > 0 {
+		return fmt.Errorf("signature is not low-s normalized")
+	}
+	clientDataHash := sha256.Sum256(signature.ClientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	if !ecdsa.Verify(pv.publicKey, digest[:], r, s) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+func (pv PasskeyVerification) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (pv PasskeyVerification) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+func (pv PasskeyVerification) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, _, err := parsePasskeyConfig(config)
+	return err
+}
+
+func (pv PasskeyVerification) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	return nil
+}
+
+// parsePasskeyConfig unmarshals the passkey configuration, rejecting unknown fields, and parses its public key.
+func parsePasskeyConfig(config []byte) (PasskeyConfig, *ecdsa.PublicKey, error) {
+	var passkeyConfig PasskeyConfig
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&passkeyConfig); err != nil {
+		return PasskeyConfig{}, nil, errorsmod.Wrap(err, "invalid passkey config")
+	}
+	if err := passkeyConfig.Validate(); err != nil {
+		return PasskeyConfig{}, nil, errorsmod.Wrap(err, "invalid passkey config")
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), passkeyConfig.PublicKey)
+	if x == nil {
+		return PasskeyConfig{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid secp256r1 public key")
+	}
+	return passkeyConfig, &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// Validate checks the passkey configuration.
+func (c PasskeyConfig) Validate() error {
+	if len(c.PublicKey) != PasskeyPubKeySize {
+		return fmt.Errorf("invalid secp256r1 public key size, expected %d, got %d", PasskeyPubKeySize, len(c.PublicKey))
+	}
+	if c.RpId == "" {
+		return fmt.Errorf("relying party id must be set")
+	}
+	for _, origin := range c.Origins {
+		if origin == "" {
+			return fmt.Errorf("origins must not be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+package authenticator_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/testutils"
+)
+
+const passkeyRpId = "app.osmosis.zone"
+
+type PasskeyVerificationTest struct {
+	BaseAuthenticatorSuite
+
+	PasskeyVerification authenticator.PasskeyVerification
+	privKey             *ecdsa.PrivateKey
+	signBytes           []byte
+}
+
+func TestPasskeyVerificationTest(t *testing.T) {
+	suite.Run(t, new(PasskeyVerificationTest))
+}
+
+func (s *PasskeyVerificationTest) SetupTest() {
+	s.SetupKeys()
+	s.PasskeyVerification = authenticator.NewPasskeyVerification(s.OsmosisApp.AccountKeeper)
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.privKey = privKey
+	s.signBytes = []byte("signBytes")
+}
+
+func (s *PasskeyVerificationTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *PasskeyVerificationTest) config(modify func(*authenticator.PasskeyConfig)) []byte {
+	config := authenticator.PasskeyConfig{
+		PublicKey: elliptic.MarshalCompressed(elliptic.P256(), s.privKey.X, s.privKey.Y),
+		RpId:      passkeyRpId,
+	}
+	if modify != nil {
+		modify(&config)
+	}
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	return bz
+}
+
+// assertion holds the inputs of a WebAuthn assertion that the tests can tamper with.
+type assertion struct {
+	clientType string
+	challenge  []byte
+	origin     string
+	rpId       string
+	flags      byte
+	highS      bool
+	signer     *ecdsa.PrivateKey
+}
+
+func (s *PasskeyVerificationTest) defaultAssertion() assertion {
+	return assertion{
+		clientType: "webauthn.get",
+		challenge:  s.signBytes,
+		origin:     "https://" + passkeyRpId,
+		rpId:       passkeyRpId,
+		flags:      0x05,
+		signer:     s.privKey,
+	}
+}
+
+// sign builds the WebAuthn assertion a passkey would return for the given inputs, encoded as a request signature.
+func (s *PasskeyVerificationTest) sign(a assertion) []byte {
+	challenge := sha256.Sum256(a.challenge)
+	clientDataJSON, err := json.Marshal(map[string]any{
+		"type":        a.clientType,
+		"challenge":   base64.RawURLEncoding.EncodeToString(challenge[:]),
+		"origin":      a.origin,
+		"crossOrigin": false,
+	})
+	s.Require().NoError(err)
+
+	rpIdHash := sha256.Sum256([]byte(a.rpId))
+	authData := append(rpIdHash[:], a.flags, 0, 0, 0, 1)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	r, sig, err := ecdsa.Sign(rand.Reader, a.signer, digest[:])
+	s.Require().NoError(err)
+
+	// Passkeys do not normalize signatures, so both forms are built explicitly
+	n := elliptic.P256().Params().N
+	halfOrder := new(big.Int).Rsh(n, 1)
+	isHighS := sig.Cmp(halfOrder) > 0
+	if isHighS != a.highS {
+		sig = new(big.Int).Sub(n, sig)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	sig.FillBytes(signature[32:])
+
+	bz, err := json.Marshal(authenticator.PasskeySignature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         signature,
+	})
+	s.Require().NoError(err)
+	return bz
+}
+
+func (s *PasskeyVerificationTest) request(signature []byte) authenticator.AuthenticationRequest {
+	return authenticator.AuthenticationRequest{
+		Account:        s.TestAccAddress[0],
+		Signature:      signature,
+		SignModeTxData: authenticator.SignModeData{Direct: s.signBytes},
+	}
+}
+
+func (s *PasskeyVerificationTest) TestConfigValidation() {
+	tests := map[string]struct {
+		modify    func(*authenticator.PasskeyConfig)
+		expectErr bool
+	}{
+		"valid": {},
+		"valid with origins": {
+			modify: func(c *authenticator.PasskeyConfig) { c.Origins = []string{"https://app.osmosis.zone"} },
+		},
+		"uncompressed public key": {
+			modify: func(c *authenticator.PasskeyConfig) {
+				c.PublicKey = elliptic.Marshal(elliptic.P256(), s.privKey.X, s.privKey.Y) //nolint:staticcheck
+			},
+			expectErr: true,
+		},
+		"invalid public key": {
+			modify:    func(c *authenticator.PasskeyConfig) { c.PublicKey = make([]byte, authenticator.PasskeyPubKeySize) },
+			expectErr: true,
+		},
+		"missing rp id": {
+			modify:    func(c *authenticator.PasskeyConfig) { c.RpId = "" },
+			expectErr: true,
+		},
+		"empty origin": {
+			modify:    func(c *authenticator.PasskeyConfig) { c.Origins = []string{""} },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			config := s.config(tc.modify)
+			err := s.PasskeyVerification.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], config, "1")
+			_, initErr := s.PasskeyVerification.Initialize(config)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Error(initErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(initErr)
+		})
+	}
+
+	_, err := s.PasskeyVerification.Initialize([]byte(`{"public_key":"","rp_id":"a","unknown":true}`))
+	s.Require().Error(err)
+}
+
+func (s *PasskeyVerificationTest) TestAuthenticate() {
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	tests := map[string]struct {
+		modifyConfig    func(*authenticator.PasskeyConfig)
+		modifyAssertion func(*assertion)
+		signature       []byte
+		expectErr       bool
+	}{
+		"valid assertion": {},
+		"valid assertion from allowed origin": {
+			modifyConfig: func(c *authenticator.PasskeyConfig) { c.Origins = []string{"https://" + passkeyRpId} },
+		},
+		"user verification required and set": {
+			modifyConfig: func(c *authenticator.PasskeyConfig) { c.RequireUserVerification = true },
+		},
+		"user verification required and not set": {
+			modifyConfig:    func(c *authenticator.PasskeyConfig) { c.RequireUserVerification = true },
+			modifyAssertion: func(a *assertion) { a.flags = 0x01 },
+			expectErr:       true,
+		},
+		"user not present": {
+			modifyAssertion: func(a *assertion) { a.flags = 0x04 },
+			expectErr:       true,
+		},
+		"challenge over other sign bytes": {
+			modifyAssertion: func(a *assertion) { a.challenge = []byte("otherSignBytes") },
+			expectErr:       true,
+		},
+		"wrong client data type": {
+			modifyAssertion: func(a *assertion) { a.clientType = "webauthn.create" },
+			expectErr:       true,
+		},
+		"wrong rp id": {
+			modifyAssertion: func(a *assertion) { a.rpId = "evil.zone" },
+			expectErr:       true,
+		},
+		"origin not allowed": {
+			modifyConfig:    func(c *authenticator.PasskeyConfig) { c.Origins = []string{"https://" + passkeyRpId} },
+			modifyAssertion: func(a *assertion) { a.origin = "https://evil.zone" },
+			expectErr:       true,
+		},
+		"high-s signature": {
+			modifyAssertion: func(a *assertion) { a.highS = true },
+			expectErr:       true,
+		},
+		"signed by another key": {
+			modifyAssertion: func(a *assertion) { a.signer = otherKey },
+			expectErr:       true,
+		},
+		"malformed signature": {
+			signature: []byte("not json"),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			a11r, err := s.PasskeyVerification.Initialize(s.config(tc.modifyConfig))
+			s.Require().NoError(err)
+
+			signature := tc.signature
+			if signature == nil {
+				a := s.defaultAssertion()
+				if tc.modifyAssertion != nil {
+					tc.modifyAssertion(&a)
+				}
+				signature = s.sign(a)
+			}
+
+			err = a11r.Authenticate(s.Ctx, s.request(signature))
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *PasskeyVerificationTest) TestSimulateSkipsVerification() {
+	a11r, err := s.PasskeyVerification.Initialize(s.config(nil))
+	s.Require().NoError(err)
+
+	request := s.request(nil)
+	request.Simulate = true
+	gasBefore := s.Ctx.GasMeter().GasConsumed()
+	s.Require().NoError(a11r.Authenticate(s.Ctx, request))
+	s.Require().Greater(s.Ctx.GasMeter().GasConsumed(), gasBefore)
+}
+
+func (s *PasskeyVerificationTest) TestComposesWithAnyOf() {
+	am := authenticator.NewAuthenticatorManager()
+	neverApprove := testutils.TestingAuthenticator{Approve: testutils.Never, Confirm: testutils.Always}
+	anyOf := authenticator.NewAnyOf(am)
+	am.InitializeAuthenticators([]authenticator.Authenticator{s.PasskeyVerification, neverApprove, anyOf})
+
+	config, err := json.Marshal([]authenticator.SubAuthenticatorInitData{
+		{Type: neverApprove.Type(), Config: nil},
+		{Type: authenticator.PasskeyVerificationType, Config: s.config(nil)},
+	})
+	s.Require().NoError(err)
+	a11r, err := anyOf.Initialize(config)
+	s.Require().NoError(err)
+
+	s.Require().NoError(a11r.Authenticate(s.Ctx, s.request(s.sign(s.defaultAssertion()))))
+
+	a := s.defaultAssertion()
+	a.challenge = []byte("otherSignBytes")
+	s.Require().Error(a11r.Authenticate(s.Ctx, s.request(s.sign(a))))
+}
@@ -0,0 +1,253 @@
+package authenticator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+var _ Authenticator = &SessionKey{}
+
+// SessionKeyType represents the session key authenticator.
+const SessionKeyType = "SessionKey"
+
+// SessionKeyConfig is the configuration of a session key authenticator.
+type SessionKeyConfig struct {
+	// SubAuthenticator is the authenticator the session key restricts, usually a SignatureVerification.
+	SubAuthenticator SubAuthenticatorInitData `json:"sub_authenticator"`
+	// NotBefore is the unix time in seconds before which the session key is not valid yet. Zero means no lower bound.
+	NotBefore int64 `json:"not_before,string,omitempty"`
+	// NotAfter is the unix time in seconds after which the session key expires. Zero means no expiry.
+	NotAfter int64 `json:"not_after,string,omitempty"`
+	// MaxUses is the number of transactions the session key can authenticate. Zero means unlimited.
+	MaxUses uint64 `json:"max_uses,string,omitempty"`
+	// MaxTxsPerBlock is the number of transactions the session key can authenticate per block. Zero means unlimited.
+	MaxTxsPerBlock uint64 `json:"max_txs_per_block,string,omitempty"`
+}
+
+// SessionKeyState is the usage state of a session key authenticator for an account.
+type SessionKeyState struct {
+	Uses uint64 `json:"uses,string"`
+	// LastSequence is the account sequence of the last transaction counted, so that a transaction
+	// is only counted once regardless of its number of messages.
+	LastSequence uint64 `json:"last_sequence,string"`
+	BlockHeight  int64  `json:"block_height,string"`
+	BlockTxs     uint64 `json:"block_txs,string"`
+}
+
+// SessionKey wraps a sub-authenticator, usually the signature verification of a key handed out to a bot, and
+// restricts it to a validity window in block time and to a maximum number of transactions, in total or per block.
+// Once the window has passed or the uses are exhausted, the sub-authenticator stops authenticating without the
+// authenticator having to be removed.
+//
+// A transaction counts as a use when it is tracked, even if its execution fails afterwards.
+type SessionKey struct {
+	am       *AuthenticatorManager
+	storeKey storetypes.StoreKey
+
+	config           SessionKeyConfig
+	subAuthenticator Authenticator
+}
+
+// NewSessionKey creates a new SessionKey authenticator storing its state under the given store key.
+func NewSessionKey(am *AuthenticatorManager, storeKey storetypes.StoreKey) SessionKey {
+	return SessionKey{
+		am:       am,
+		storeKey: storeKey,
+	}
+}
+
+func (sk SessionKey) Type() string {
+	return SessionKeyType
+}
+
+func (sk SessionKey) StaticGas() uint64 {
+	if sk.subAuthenticator == nil {
+		return 0
+	}
+	return sk.subAuthenticator.StaticGas()
+}
+
+// Initialize parses the session key configuration and initializes the sub-authenticator.
+func (sk SessionKey) Initialize(config []byte) (Authenticator, error) {
+	sessionKeyConfig, err := parseSessionKeyConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticatorCode := sk.am.GetAuthenticatorByType(sessionKeyConfig.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", sessionKeyConfig.SubAuthenticator.Type)
+	}
+	subAuthenticator, err := authenticatorCode.Initialize(sessionKeyConfig.SubAuthenticator.Config)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to initialize sub-authenticator (type = %s)", sessionKeyConfig.SubAuthenticator.Type)
+	}
+
+	sk.config = sessionKeyConfig
+	sk.subAuthenticator = subAuthenticator
+	return sk, nil
+}
+
+// Authenticate checks that the session key is within its validity window and has uses left in total
+// and in the current block, then authenticates the request with the sub-authenticator.
+func (sk SessionKey) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	now := ctx.BlockTime().Unix()
+	if now < sk.config.NotBefore {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key is not valid before %d, block time is %d", sk.config.NotBefore, now)
+	}
+	if sk.config.NotAfter != 0 && now > sk.config.NotAfter {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key expired at %d, block time is %d", sk.config.NotAfter, now)
+	}
+
+	state, err := sk.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	// Messages of a transaction that was already counted do not use the session key again.
+	if !sk.isCounted(state, request) {
+		if sk.config.MaxUses != 0 && state.Uses >= sk.config.MaxUses {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key used %d times, max uses is %d", state.Uses, sk.config.MaxUses)
+		}
+		if sk.config.MaxTxsPerBlock != 0 && state.BlockHeight == ctx.BlockHeight() && state.BlockTxs >= sk.config.MaxTxsPerBlock {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key used %d times in this block, max is %d", state.BlockTxs, sk.config.MaxTxsPerBlock)
+		}
+	}
+
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return sk.subAuthenticator.Authenticate(ctx, request)
+}
+
+// Track counts the transaction as a use of the session key, once per transaction, and tracks the
+// request with the sub-authenticator.
+func (sk SessionKey) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	state, err := sk.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	if !sk.isCounted(state, request) {
+		state.Uses++
+		state.LastSequence = request.TxData.AccountSequence
+		if state.BlockHeight != ctx.BlockHeight() {
+			state.BlockHeight = ctx.BlockHeight()
+			state.BlockTxs = 0
+		}
+		state.BlockTxs++
+		if err := sk.setState(ctx, request.Account, request.AuthenticatorId, state); err != nil {
+			return err
+		}
+	}
+
+	return subTrack(ctx, request, []Authenticator{sk.subAuthenticator})
+}
+
+// ConfirmExecution confirms the execution with the sub-authenticator.
+func (sk SessionKey) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	request.AuthenticatorId = compositeId(request.AuthenticatorId, 0)
+	return sk.subAuthenticator.ConfirmExecution(ctx, request)
+}
+
+// OnAuthenticatorAdded validates the session key configuration and adds the sub-authenticator.
+func (sk SessionKey) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	sessionKeyConfig, err := parseSessionKeyConfig(config)
+	if err != nil {
+		return err
+	}
+
+	authenticatorCode := sk.am.GetAuthenticatorByType(sessionKeyConfig.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", sessionKeyConfig.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorAdded(ctx, account, sessionKeyConfig.SubAuthenticator.Config, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorAdded` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// OnAuthenticatorRemoved deletes the usage state of the session key and removes the sub-authenticator.
+func (sk SessionKey) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	ctx.KVStore(sk.storeKey).Delete(types.KeySessionKey(account, authenticatorId))
+
+	sessionKeyConfig, err := parseSessionKeyConfig(config)
+	if err != nil {
+		return err
+	}
+	authenticatorCode := sk.am.GetAuthenticatorByType(sessionKeyConfig.SubAuthenticator.Type)
+	if authenticatorCode == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sub-authenticator type %s is not registered", sessionKeyConfig.SubAuthenticator.Type)
+	}
+	subId := compositeId(authenticatorId, 0)
+	if err := authenticatorCode.OnAuthenticatorRemoved(ctx, account, sessionKeyConfig.SubAuthenticator.Config, subId); err != nil {
+		return errorsmod.Wrapf(err, "sub-authenticator `OnAuthenticatorRemoved` failed (sub-authenticator id = %s)", subId)
+	}
+	return nil
+}
+
+// isCounted returns true if the transaction of the request was already counted as a use.
+func (sk SessionKey) isCounted(state SessionKeyState, request AuthenticationRequest) bool {
+	return state.Uses > 0 && state.LastSequence == request.TxData.AccountSequence
+}
+
+func (sk SessionKey) getState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SessionKeyState, error) {
+	var state SessionKeyState
+	bz := ctx.KVStore(sk.storeKey).Get(types.KeySessionKey(account, authenticatorId))
+	if bz == nil {
+		return state, nil
+	}
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return SessionKeyState{}, errorsmod.Wrap(err, "failed to unmarshal session key state")
+	}
+	return state, nil
+}
+
+func (sk SessionKey) setState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, state SessionKeyState) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal session key state")
+	}
+	ctx.KVStore(sk.storeKey).Set(types.KeySessionKey(account, authenticatorId), bz)
+	return nil
+}
+
+// parseSessionKeyConfig unmarshals the session key configuration, rejecting unknown fields, and validates it.
+func parseSessionKeyConfig(config []byte) (SessionKeyConfig, error) {
+	var sessionKeyConfig SessionKeyConfig
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&sessionKeyConfig); err != nil {
+		return SessionKeyConfig{}, errorsmod.Wrap(err, "invalid session key config")
+	}
+	if err := sessionKeyConfig.Validate(); err != nil {
+		return SessionKeyConfig{}, errorsmod.Wrap(err, "invalid session key config")
+	}
+	return sessionKeyConfig, nil
+}
+
+// Validate checks that the session key configuration restricts its sub-authenticator.
+func (c SessionKeyConfig) Validate() error {
+	if c.SubAuthenticator.Type == "" {
+		return fmt.Errorf("sub-authenticator type must be set")
+	}
+	if c.SubAuthenticator.Type == SessionKeyType {
+		return fmt.Errorf("session keys cannot be nested")
+	}
+	if c.NotBefore < 0 || c.NotAfter < 0 {
+		return fmt.Errorf("validity window times must not be negative")
+	}
+	if c.NotAfter != 0 && c.NotAfter < c.NotBefore {
+		return fmt.Errorf("not after (%d) must not be before not before (%d)", c.NotAfter, c.NotBefore)
+	}
+	if c.NotAfter == 0 && c.MaxUses == 0 && c.MaxTxsPerBlock == 0 {
+		return fmt.Errorf("at least one of not after, max uses or max txs per block must be set")
+	}
+	return nil
+}
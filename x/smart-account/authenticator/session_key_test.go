@@ -0,0 +1,202 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/testutils"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+type SessionKeyTest struct {
+	BaseAuthenticatorSuite
+
+	am            *authenticator.AuthenticatorManager
+	SessionKey    authenticator.SessionKey
+	AlwaysApprove testutils.TestingAuthenticator
+	NeverApprove  testutils.TestingAuthenticator
+}
+
+func TestSessionKeyTest(t *testing.T) {
+	suite.Run(t, new(SessionKeyTest))
+}
+
+var sessionKeyStartTime = time.Unix(1_700_000_000, 0).UTC()
+
+func (s *SessionKeyTest) SetupTest() {
+	s.SetupKeys()
+	s.Ctx = s.Ctx.WithBlockTime(sessionKeyStartTime).WithBlockHeight(10)
+
+	s.am = authenticator.NewAuthenticatorManager()
+	s.AlwaysApprove = testutils.TestingAuthenticator{Approve: testutils.Always, Confirm: testutils.Always}
+	s.NeverApprove = testutils.TestingAuthenticator{Approve: testutils.Never, Confirm: testutils.Always}
+	s.SessionKey = authenticator.NewSessionKey(s.am, s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+	s.am.InitializeAuthenticators([]authenticator.Authenticator{s.AlwaysApprove, s.NeverApprove, s.SessionKey})
+}
+
+func (s *SessionKeyTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SessionKeyTest) initialize(config authenticator.SessionKeyConfig) authenticator.Authenticator {
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	s.Require().NoError(s.SessionKey.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1"))
+	a11r, err := s.SessionKey.Initialize(bz)
+	s.Require().NoError(err)
+	return a11r
+}
+
+// useSessionKey authenticates and tracks a transaction with the given account sequence and number of messages.
+func (s *SessionKeyTest) useSessionKey(a11r authenticator.Authenticator, sequence uint64, numMsgs int) error {
+	request := authenticator.AuthenticationRequest{
+		Account:         s.TestAccAddress[0],
+		AuthenticatorId: "1",
+		TxData:          authenticator.ExplicitTxData{AccountSequence: sequence},
+	}
+	for i := 0; i < numMsgs; i++ {
+		if err := a11r.Authenticate(s.Ctx, request); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < numMsgs; i++ {
+		s.Require().NoError(a11r.Track(s.Ctx, request))
+	}
+	return nil
+}
+
+func (s *SessionKeyTest) TestConfigValidation() {
+	alwaysApprove := authenticator.SubAuthenticatorInitData{Type: s.AlwaysApprove.Type()}
+	tests := map[string]struct {
+		config    authenticator.SessionKeyConfig
+		expectErr bool
+	}{
+		"valid expiry": {
+			config: authenticator.SessionKeyConfig{SubAuthenticator: alwaysApprove, NotAfter: sessionKeyStartTime.Unix()},
+		},
+		"valid max uses": {
+			config: authenticator.SessionKeyConfig{SubAuthenticator: alwaysApprove, MaxUses: 10},
+		},
+		"valid max txs per block": {
+			config: authenticator.SessionKeyConfig{SubAuthenticator: alwaysApprove, MaxTxsPerBlock: 1},
+		},
+		"no restriction": {
+			config:    authenticator.SessionKeyConfig{SubAuthenticator: alwaysApprove, NotBefore: sessionKeyStartTime.Unix()},
+			expectErr: true,
+		},
+		"not after before not before": {
+			config:    authenticator.SessionKeyConfig{SubAuthenticator: alwaysApprove, NotBefore: sessionKeyStartTime.Unix(), NotAfter: sessionKeyStartTime.Unix() - 1},
+			expectErr: true,
+		},
+		"missing sub-authenticator": {
+			config:    authenticator.SessionKeyConfig{MaxUses: 10},
+			expectErr: true,
+		},
+		"unregistered sub-authenticator": {
+			config:    authenticator.SessionKeyConfig{SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: "Unknown"}, MaxUses: 10},
+			expectErr: true,
+		},
+		"nested session key": {
+			config:    authenticator.SessionKeyConfig{SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: authenticator.SessionKeyType}, MaxUses: 10},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.config)
+			s.Require().NoError(err)
+
+			err = s.SessionKey.OnAuthenticatorAdded(s.Ctx, s.TestAccAddress[0], bz, "1")
+			_, initErr := s.SessionKey.Initialize(bz)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Error(initErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(initErr)
+		})
+	}
+}
+
+func (s *SessionKeyTest) TestValidityWindow() {
+	a11r := s.initialize(authenticator.SessionKeyConfig{
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.AlwaysApprove.Type()},
+		NotBefore:        sessionKeyStartTime.Unix() + 100,
+		NotAfter:         sessionKeyStartTime.Unix() + 200,
+	})
+
+	s.Require().Error(s.useSessionKey(a11r, 0, 1))
+
+	s.Ctx = s.Ctx.WithBlockTime(sessionKeyStartTime.Add(100 * time.Second))
+	s.Require().NoError(s.useSessionKey(a11r, 0, 1))
+	s.Ctx = s.Ctx.WithBlockTime(sessionKeyStartTime.Add(200 * time.Second))
+	s.Require().NoError(s.useSessionKey(a11r, 1, 1))
+
+	// The session key expires without being removed.
+	s.Ctx = s.Ctx.WithBlockTime(sessionKeyStartTime.Add(201 * time.Second))
+	s.Require().Error(s.useSessionKey(a11r, 2, 1))
+}
+
+func (s *SessionKeyTest) TestMaxUses() {
+	a11r := s.initialize(authenticator.SessionKeyConfig{
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.AlwaysApprove.Type()},
+		MaxUses:          2,
+	})
+
+	// A transaction with several messages counts as a single use.
+	s.Require().NoError(s.useSessionKey(a11r, 0, 3))
+	s.Require().NoError(s.useSessionKey(a11r, 1, 1))
+	s.Require().Error(s.useSessionKey(a11r, 2, 1))
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.Require().Error(s.useSessionKey(a11r, 2, 1))
+}
+
+func (s *SessionKeyTest) TestMaxTxsPerBlock() {
+	a11r := s.initialize(authenticator.SessionKeyConfig{
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.AlwaysApprove.Type()},
+		MaxTxsPerBlock:   2,
+	})
+
+	s.Require().NoError(s.useSessionKey(a11r, 0, 2))
+	s.Require().NoError(s.useSessionKey(a11r, 1, 1))
+	s.Require().Error(s.useSessionKey(a11r, 2, 1))
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.Require().NoError(s.useSessionKey(a11r, 2, 1))
+	s.Require().NoError(s.useSessionKey(a11r, 3, 1))
+	s.Require().Error(s.useSessionKey(a11r, 4, 1))
+}
+
+func (s *SessionKeyTest) TestSubAuthenticatorRejects() {
+	a11r := s.initialize(authenticator.SessionKeyConfig{
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.NeverApprove.Type()},
+		NotAfter:         sessionKeyStartTime.Unix() + 100,
+	})
+
+	s.Require().Error(s.useSessionKey(a11r, 0, 1))
+}
+
+func (s *SessionKeyTest) TestOnAuthenticatorRemoved() {
+	config := authenticator.SessionKeyConfig{
+		SubAuthenticator: authenticator.SubAuthenticatorInitData{Type: s.AlwaysApprove.Type()},
+		MaxUses:          1,
+	}
+	a11r := s.initialize(config)
+	s.Require().NoError(s.useSessionKey(a11r, 0, 1))
+
+	store := s.Ctx.KVStore(s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+	s.Require().True(store.Has(smartaccounttypes.KeySessionKey(s.TestAccAddress[0], "1")))
+
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	s.Require().NoError(a11r.OnAuthenticatorRemoved(s.Ctx, s.TestAccAddress[0], bz, "1"))
+	s.Require().False(store.Has(smartaccounttypes.KeySessionKey(s.TestAccAddress[0], "1")))
+}
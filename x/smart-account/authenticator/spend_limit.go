@@ -0,0 +1,359 @@
+package authenticator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+var _ Authenticator = &SpendLimit{}
+
+const (
+	// SpendLimitType represents the native spend limit authenticator.
+	SpendLimitType = "SpendLimit"
+
+	// SpendLimitWindowFixed resets the spending at the start of every period, periods being aligned to the unix epoch.
+	SpendLimitWindowFixed = "fixed"
+	// SpendLimitWindowRolling limits the spending over the last period at any point in time.
+	SpendLimitWindowRolling = "rolling"
+
+	// spendLimitRollingBuckets is the number of buckets a rolling period is divided into.
+	// Spending is recorded per bucket, so a rolling window moves forward one bucket at a time.
+	spendLimitRollingBuckets = 24
+)
+
+// SpendLimitPriceSource is the pool whose TWAP values a denom in the quote denom.
+type SpendLimitPriceSource struct {
+	Denom  string `json:"denom"`
+	PoolId uint64 `json:"pool_id,string"`
+}
+
+// SpendLimitConfig is the configuration of a spend limit authenticator.
+type SpendLimitConfig struct {
+	// PeriodSeconds is the duration of the period the limits apply to.
+	PeriodSeconds uint64 `json:"period_seconds,string"`
+	// Window is either SpendLimitWindowFixed or SpendLimitWindowRolling.
+	Window string `json:"window"`
+	// Limits are the maximum amounts of each denom that can be spent per period.
+	Limits sdk.Coins `json:"limits,omitempty"`
+	// QuoteLimit is the maximum value, in the quote denom, of the spending of the quote denom
+	// and of every denom with a price source per period.
+	QuoteLimit *sdk.Coin `json:"quote_limit,omitempty"`
+	// PriceSources are the pools used to value spending in the quote denom.
+	PriceSources []SpendLimitPriceSource `json:"price_sources,omitempty"`
+	// TwapWindowSeconds is the duration of the arithmetic TWAP used to value spending.
+	TwapWindowSeconds uint64 `json:"twap_window_seconds,string,omitempty"`
+}
+
+// SpendLimitBucket is the spending recorded over a period, or part of a period for rolling windows.
+type SpendLimitBucket struct {
+	Start      int64        `json:"start,string"`
+	Spent      sdk.Coins    `json:"spent"`
+	QuoteSpent osmomath.Int `json:"quote_spent"`
+}
+
+// SpendLimitState is the spending state of a spend limit authenticator for an account.
+type SpendLimitState struct {
+	// PreExecBalances are the balances of the tracked denoms before execution.
+	PreExecBalances sdk.Coins          `json:"pre_exec_balances"`
+	Buckets         []SpendLimitBucket `json:"buckets"`
+}
+
+// SpendLimit limits the outflows of an account per denom, and optionally their total value in a quote denom,
+// over fixed or rolling periods.
+//
+// Outflows are measured from bank balance deltas: Track records the balances of the tracked denoms before
+// execution, and ConfirmExecution charges any decrease to the current period and rejects the transaction
+// if a limit is exceeded. Since fees are deducted before Track, they are not counted.
+//
+// SpendLimit does not verify signatures. It is meant to be composed with a signature authenticator using AllOf.
+type SpendLimit struct {
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	twapKeeper types.TwapKeeper
+
+	config SpendLimitConfig
+}
+
+// NewSpendLimit creates a new SpendLimit authenticator storing its state under the given store key.
+func NewSpendLimit(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper, twapKeeper types.TwapKeeper) SpendLimit {
+	return SpendLimit{
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		twapKeeper: twapKeeper,
+	}
+}
+
+func (sl SpendLimit) Type() string {
+	return SpendLimitType
+}
+
+func (sl SpendLimit) StaticGas() uint64 {
+	return 0
+}
+
+// Initialize parses and validates the spend limit configuration.
+func (sl SpendLimit) Initialize(config []byte) (Authenticator, error) {
+	spendLimitConfig, err := parseSpendLimitConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	sl.config = spendLimitConfig
+	return sl, nil
+}
+
+// Authenticate always succeeds, the limits are enforced in ConfirmExecution.
+func (sl SpendLimit) Authenticate(ctx sdk.Context, request AuthenticationRequest) error {
+	return nil
+}
+
+// Track records the balances of the tracked denoms before execution.
+func (sl SpendLimit) Track(ctx sdk.Context, request AuthenticationRequest) error {
+	state, err := sl.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+	state.PreExecBalances = sl.getTrackedBalances(ctx, request.Account)
+	return sl.setState(ctx, request.Account, request.AuthenticatorId, state)
+}
+
+// ConfirmExecution charges the decrease of the tracked balances since Track to the current period,
+// and errors if the spending of the period exceeds a limit.
+func (sl SpendLimit) ConfirmExecution(ctx sdk.Context, request AuthenticationRequest) error {
+	state, err := sl.getState(ctx, request.Account, request.AuthenticatorId)
+	if err != nil {
+		return err
+	}
+
+	balances := sl.getTrackedBalances(ctx, request.Account)
+	spent := sdk.NewCoins()
+	for _, preExecBalance := range state.PreExecBalances {
+		if balance := balances.AmountOf(preExecBalance.Denom); balance.LT(preExecBalance.Amount) {
+			spent = spent.Add(sdk.NewCoin(preExecBalance.Denom, preExecBalance.Amount.Sub(balance)))
+		}
+	}
+	// Further messages of the transaction are only charged for what they spend themselves.
+	state.PreExecBalances = balances
+
+	quoteSpent := osmomath.ZeroInt()
+	if sl.config.QuoteLimit != nil {
+		quoteSpent, err = sl.quoteValue(ctx, spent)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := ctx.BlockTime().Unix()
+	windowStart, bucketStart := sl.windowStart(now), sl.bucketStart(now)
+	buckets := make([]SpendLimitBucket, 0, len(state.Buckets)+1)
+	for _, bucket := range state.Buckets {
+		if bucket.Start >= windowStart {
+			buckets = append(buckets, bucket)
+		}
+	}
+	if len(buckets) == 0 || buckets[len(buckets)-1].Start != bucketStart {
+		buckets = append(buckets, SpendLimitBucket{Start: bucketStart, Spent: sdk.NewCoins(), QuoteSpent: osmomath.ZeroInt()})
+	}
+	current := &buckets[len(buckets)-1]
+	current.Spent = current.Spent.Add(spent...)
+	current.QuoteSpent = current.QuoteSpent.Add(quoteSpent)
+	state.Buckets = buckets
+
+	windowSpent, windowQuoteSpent := sdk.NewCoins(), osmomath.ZeroInt()
+	for _, bucket := range buckets {
+		windowSpent = windowSpent.Add(bucket.Spent...)
+		windowQuoteSpent = windowQuoteSpent.Add(bucket.QuoteSpent)
+	}
+	for _, limit := range sl.config.Limits {
+		if windowSpent.AmountOf(limit.Denom).GT(limit.Amount) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit exceeded for %s: spent %s, limit %s", limit.Denom, windowSpent.AmountOf(limit.Denom), limit.Amount)
+		}
+	}
+	if sl.config.QuoteLimit != nil && windowQuoteSpent.GT(sl.config.QuoteLimit.Amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "spend limit exceeded: spent %s%s, limit %s", windowQuoteSpent, sl.config.QuoteLimit.Denom, sl.config.QuoteLimit)
+	}
+
+	return sl.setState(ctx, request.Account, request.AuthenticatorId, state)
+}
+
+// OnAuthenticatorAdded validates the spend limit configuration.
+func (sl SpendLimit) OnAuthenticatorAdded(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	_, err := parseSpendLimitConfig(config)
+	return err
+}
+
+// OnAuthenticatorRemoved deletes the spending state of the account.
+func (sl SpendLimit) OnAuthenticatorRemoved(ctx sdk.Context, account sdk.AccAddress, config []byte, authenticatorId string) error {
+	ctx.KVStore(sl.storeKey).Delete(types.KeySpendLimit(account, authenticatorId))
+	return nil
+}
+
+// getTrackedBalances returns the balances of the account in every denom with a limit or a price.
+func (sl SpendLimit) getTrackedBalances(ctx sdk.Context, account sdk.AccAddress) sdk.Coins {
+	balances := sdk.NewCoins()
+	for _, denom := range sl.config.trackedDenoms() {
+		balances = balances.Add(sl.bankKeeper.GetBalance(ctx, account, denom))
+	}
+	return balances
+}
+
+// quoteValue returns the value of the spent coins in the quote denom, rounded up.
+// Denoms that are neither the quote denom nor have a price source are not valued.
+func (sl SpendLimit) quoteValue(ctx sdk.Context, spent sdk.Coins) (osmomath.Int, error) {
+	value := spent.AmountOf(sl.config.QuoteLimit.Denom)
+	startTime := ctx.BlockTime().Add(-time.Duration(sl.config.TwapWindowSeconds) * time.Second)
+	for _, source := range sl.config.PriceSources {
+		amount := spent.AmountOf(source.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+		price, err := sl.twapKeeper.GetArithmeticTwapToNow(ctx, source.PoolId, source.Denom, sl.config.QuoteLimit.Denom, startTime)
+		if err != nil {
+			return osmomath.Int{}, errorsmod.Wrapf(err, "failed to price %s in %s", source.Denom, sl.config.QuoteLimit.Denom)
+		}
+		value = value.Add(amount.ToLegacyDec().Mul(price).Ceil().TruncateInt())
+	}
+	return value, nil
+}
+
+// windowStart returns the start time of the buckets counting toward the limits at the given time.
+func (sl SpendLimit) windowStart(now int64) int64 {
+	period := int64(sl.config.PeriodSeconds)
+	if sl.config.Window == SpendLimitWindowFixed {
+		return now - now%period
+	}
+	bucketDuration := period / spendLimitRollingBuckets
+	return sl.bucketStart(now) - period + bucketDuration
+}
+
+// bucketStart returns the start time of the bucket spending is recorded in at the given time.
+func (sl SpendLimit) bucketStart(now int64) int64 {
+	bucketDuration := int64(sl.config.PeriodSeconds)
+	if sl.config.Window == SpendLimitWindowRolling {
+		bucketDuration /= spendLimitRollingBuckets
+	}
+	return now - now%bucketDuration
+}
+
+func (sl SpendLimit) getState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string) (SpendLimitState, error) {
+	state := SpendLimitState{PreExecBalances: sdk.NewCoins()}
+	bz := ctx.KVStore(sl.storeKey).Get(types.KeySpendLimit(account, authenticatorId))
+	if bz == nil {
+		return state, nil
+	}
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return SpendLimitState{}, errorsmod.Wrap(err, "failed to unmarshal spend limit state")
+	}
+	return state, nil
+}
+
+func (sl SpendLimit) setState(ctx sdk.Context, account sdk.AccAddress, authenticatorId string, state SpendLimitState) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to marshal spend limit state")
+	}
+	ctx.KVStore(sl.storeKey).Set(types.KeySpendLimit(account, authenticatorId), bz)
+	return nil
+}
+
+// trackedDenoms returns the denoms whose balances are tracked, without duplicates.
+func (c SpendLimitConfig) trackedDenoms() []string {
+	seen := make(map[string]struct{})
+	denoms := []string{}
+	add := func(denom string) {
+		if _, ok := seen[denom]; !ok {
+			seen[denom] = struct{}{}
+			denoms = append(denoms, denom)
+		}
+	}
+	for _, limit := range c.Limits {
+		add(limit.Denom)
+	}
+	if c.QuoteLimit != nil {
+		add(c.QuoteLimit.Denom)
+		for _, source := range c.PriceSources {
+			add(source.Denom)
+		}
+	}
+	return denoms
+}
+
+// parseSpendLimitConfig unmarshals the spend limit configuration, rejecting unknown fields, and validates it.
+func parseSpendLimitConfig(config []byte) (SpendLimitConfig, error) {
+	var spendLimitConfig SpendLimitConfig
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spendLimitConfig); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid spend limit config")
+	}
+	if err := spendLimitConfig.Validate(); err != nil {
+		return SpendLimitConfig{}, errorsmod.Wrap(err, "invalid spend limit config")
+	}
+	return spendLimitConfig, nil
+}
+
+// Validate checks that the spend limit configuration is well formed.
+func (c SpendLimitConfig) Validate() error {
+	switch c.Window {
+	case SpendLimitWindowFixed:
+		if c.PeriodSeconds == 0 {
+			return fmt.Errorf("period must be positive")
+		}
+	case SpendLimitWindowRolling:
+		if c.PeriodSeconds < spendLimitRollingBuckets || c.PeriodSeconds%spendLimitRollingBuckets != 0 {
+			return fmt.Errorf("rolling period must be a positive multiple of %d seconds, got %d", spendLimitRollingBuckets, c.PeriodSeconds)
+		}
+	default:
+		return fmt.Errorf("window must be either %s or %s, got %s", SpendLimitWindowFixed, SpendLimitWindowRolling, c.Window)
+	}
+
+	if len(c.Limits) == 0 && c.QuoteLimit == nil {
+		return fmt.Errorf("at least one limit must be set")
+	}
+	if err := c.Limits.Validate(); err != nil {
+		return err
+	}
+
+	if c.QuoteLimit == nil {
+		if len(c.PriceSources) > 0 {
+			return fmt.Errorf("price sources require a quote limit")
+		}
+		return nil
+	}
+	if err := c.QuoteLimit.Validate(); err != nil {
+		return err
+	}
+	if !c.QuoteLimit.IsPositive() {
+		return fmt.Errorf("quote limit must be positive")
+	}
+	if len(c.PriceSources) > 0 && c.TwapWindowSeconds == 0 {
+		return fmt.Errorf("twap window must be positive")
+	}
+	seen := make(map[string]struct{}, len(c.PriceSources))
+	for _, source := range c.PriceSources {
+		if err := sdk.ValidateDenom(source.Denom); err != nil {
+			return err
+		}
+		if source.Denom == c.QuoteLimit.Denom {
+			return fmt.Errorf("price source denom %s is the quote denom", source.Denom)
+		}
+		if _, ok := seen[source.Denom]; ok {
+			return fmt.Errorf("duplicate price source for %s", source.Denom)
+		}
+		seen[source.Denom] = struct{}{}
+		if source.PoolId == 0 {
+			return fmt.Errorf("price source pool id must be positive")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package authenticator_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v31/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v31/x/smart-account/authenticator"
+	smartaccounttypes "github.com/osmosis-labs/osmosis/v31/x/smart-account/types"
+)
+
+type SpendLimitTest struct {
+	BaseAuthenticatorSuite
+
+	SpendLimit authenticator.SpendLimit
+	Account    sdk.AccAddress
+	Recipient  sdk.AccAddress
+}
+
+func TestSpendLimitTest(t *testing.T) {
+	suite.Run(t, new(SpendLimitTest))
+}
+
+// spendLimitStartTime is aligned to the start of a day.
+var spendLimitStartTime = time.Unix(1_699_920_000, 0).UTC()
+
+func (s *SpendLimitTest) SetupTest() {
+	s.SetupKeys()
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime)
+	s.SpendLimit = authenticator.NewSpendLimit(s.OsmosisApp.GetKey(smartaccounttypes.StoreKey), s.OsmosisApp.BankKeeper, s.OsmosisApp.TwapKeeper)
+
+	accounts := apptesting.CreateRandomAccounts(2)
+	s.Account, s.Recipient = accounts[0], accounts[1]
+	s.FundAcc(s.Account, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("uusdc", 1_000_000)))
+}
+
+func (s *SpendLimitTest) TearDownTest() {
+	os.RemoveAll(s.HomeDir)
+}
+
+func (s *SpendLimitTest) initialize(config authenticator.SpendLimitConfig) authenticator.Authenticator {
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	s.Require().NoError(s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.Account, bz, "1"))
+	a11r, err := s.SpendLimit.Initialize(bz)
+	s.Require().NoError(err)
+	return a11r
+}
+
+// spend runs a transaction spending the given coins through the authenticator, returning the
+// error of ConfirmExecution.
+func (s *SpendLimitTest) spend(a11r authenticator.Authenticator, coins ...sdk.Coin) error {
+	request := authenticator.AuthenticationRequest{Account: s.Account, AuthenticatorId: "1"}
+	s.Require().NoError(a11r.Authenticate(s.Ctx, request))
+	s.Require().NoError(a11r.Track(s.Ctx, request))
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.Account, s.Recipient, sdk.NewCoins(coins...)))
+
+	// A failed ConfirmExecution reverts the whole transaction.
+	cacheCtx, write := s.Ctx.CacheContext()
+	err := a11r.ConfirmExecution(cacheCtx, request)
+	if err == nil {
+		write()
+	}
+	return err
+}
+
+func (s *SpendLimitTest) TestConfigValidation() {
+	quoteLimit := sdk.NewInt64Coin("uusdc", 1000)
+	tests := map[string]struct {
+		config    authenticator.SpendLimitConfig
+		expectErr bool
+	}{
+		"valid fixed": {
+			config: authenticator.SpendLimitConfig{PeriodSeconds: 86400, Window: authenticator.SpendLimitWindowFixed, Limits: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))},
+		},
+		"valid rolling with quote limit": {
+			config: authenticator.SpendLimitConfig{
+				PeriodSeconds:     86400,
+				Window:            authenticator.SpendLimitWindowRolling,
+				QuoteLimit:        &quoteLimit,
+				PriceSources:      []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}},
+				TwapWindowSeconds: 3600,
+			},
+		},
+		"zero period": {
+			config:    authenticator.SpendLimitConfig{Window: authenticator.SpendLimitWindowFixed, Limits: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))},
+			expectErr: true,
+		},
+		"rolling period not a multiple of the buckets": {
+			config:    authenticator.SpendLimitConfig{PeriodSeconds: 100, Window: authenticator.SpendLimitWindowRolling, Limits: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))},
+			expectErr: true,
+		},
+		"unknown window": {
+			config:    authenticator.SpendLimitConfig{PeriodSeconds: 86400, Window: "monthly", Limits: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000))},
+			expectErr: true,
+		},
+		"no limits": {
+			config:    authenticator.SpendLimitConfig{PeriodSeconds: 86400, Window: authenticator.SpendLimitWindowFixed},
+			expectErr: true,
+		},
+		"price sources without quote limit": {
+			config: authenticator.SpendLimitConfig{
+				PeriodSeconds:     86400,
+				Window:            authenticator.SpendLimitWindowFixed,
+				Limits:            sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+				PriceSources:      []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}},
+				TwapWindowSeconds: 3600,
+			},
+			expectErr: true,
+		},
+		"price sources without twap window": {
+			config: authenticator.SpendLimitConfig{
+				PeriodSeconds: 86400,
+				Window:        authenticator.SpendLimitWindowFixed,
+				QuoteLimit:    &quoteLimit,
+				PriceSources:  []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}},
+			},
+			expectErr: true,
+		},
+		"price source for the quote denom": {
+			config: authenticator.SpendLimitConfig{
+				PeriodSeconds:     86400,
+				Window:            authenticator.SpendLimitWindowFixed,
+				QuoteLimit:        &quoteLimit,
+				PriceSources:      []authenticator.SpendLimitPriceSource{{Denom: "uusdc", PoolId: 1}},
+				TwapWindowSeconds: 3600,
+			},
+			expectErr: true,
+		},
+		"duplicate price sources": {
+			config: authenticator.SpendLimitConfig{
+				PeriodSeconds:     86400,
+				Window:            authenticator.SpendLimitWindowFixed,
+				QuoteLimit:        &quoteLimit,
+				PriceSources:      []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: 1}, {Denom: "uosmo", PoolId: 2}},
+				TwapWindowSeconds: 3600,
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			bz, err := json.Marshal(tc.config)
+			s.Require().NoError(err)
+
+			err = s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.Account, bz, "1")
+			_, initErr := s.SpendLimit.Initialize(bz)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Error(initErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(initErr)
+		})
+	}
+
+	// Unknown fields are rejected.
+	err := s.SpendLimit.OnAuthenticatorAdded(s.Ctx, s.Account, []byte(`{"period_seconds":"86400","window":"fixed","limits":[{"denom":"uosmo","amount":"1"}],"limit":"1"}`), "1")
+	s.Require().Error(err)
+}
+
+func (s *SpendLimitTest) TestFixedWindow() {
+	a11r := s.initialize(authenticator.SpendLimitConfig{
+		PeriodSeconds: 86400,
+		Window:        authenticator.SpendLimitWindowFixed,
+		Limits:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+	})
+
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 600)))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 500)))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 400)))
+
+	// Denoms without a limit are not restricted.
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uusdc", 10_000)))
+
+	// The spending resets at the start of the next day, even if less than a day passed since the last spend.
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(23 * time.Hour))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(24 * time.Hour))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1000)))
+}
+
+func (s *SpendLimitTest) TestRollingWindow() {
+	a11r := s.initialize(authenticator.SpendLimitConfig{
+		PeriodSeconds: 86400,
+		Window:        authenticator.SpendLimitWindowRolling,
+		Limits:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+	})
+
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(12 * time.Hour))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 600)))
+
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(20 * time.Hour))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 400)))
+
+	// Unlike a fixed window, crossing the start of a day does not reset the spending.
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(24 * time.Hour))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+
+	// The first spend leaves the window once its bucket started a day ago.
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(35 * time.Hour))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(36 * time.Hour))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 600)))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+}
+
+func (s *SpendLimitTest) TestQuoteLimit() {
+	poolId := s.prepareSpendLimitPool()
+	quoteLimit := sdk.NewInt64Coin("uusdc", 3000)
+	a11r := s.initialize(authenticator.SpendLimitConfig{
+		PeriodSeconds:     86400,
+		Window:            authenticator.SpendLimitWindowFixed,
+		QuoteLimit:        &quoteLimit,
+		PriceSources:      []authenticator.SpendLimitPriceSource{{Denom: "uosmo", PoolId: poolId}},
+		TwapWindowSeconds: 3600,
+	})
+	s.Ctx = s.Ctx.WithBlockTime(spendLimitStartTime.Add(time.Hour))
+
+	// 1000 uosmo are worth 1500 uusdc.
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1000)))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uusdc", 1500)))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uusdc", 1)))
+
+	// Denoms without a price source do not count toward the quote limit.
+	s.FundAcc(s.Account, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uatom", 1000)))
+}
+
+func (s *SpendLimitTest) TestMultipleMessages() {
+	a11r := s.initialize(authenticator.SpendLimitConfig{
+		PeriodSeconds: 86400,
+		Window:        authenticator.SpendLimitWindowFixed,
+		Limits:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+	})
+	request := authenticator.AuthenticationRequest{Account: s.Account, AuthenticatorId: "1"}
+
+	// Track and ConfirmExecution are called once per message of the transaction,
+	// the spending of the transaction must only be counted once.
+	s.Require().NoError(a11r.Track(s.Ctx, request))
+	s.Require().NoError(a11r.Track(s.Ctx, request))
+	s.Require().NoError(s.OsmosisApp.BankKeeper.SendCoins(s.Ctx, s.Account, s.Recipient, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 600))))
+	s.Require().NoError(a11r.ConfirmExecution(s.Ctx, request))
+	s.Require().NoError(a11r.ConfirmExecution(s.Ctx, request))
+
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 400)))
+	s.Require().Error(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1)))
+}
+
+func (s *SpendLimitTest) TestOnAuthenticatorRemoved() {
+	config := authenticator.SpendLimitConfig{
+		PeriodSeconds: 86400,
+		Window:        authenticator.SpendLimitWindowFixed,
+		Limits:        sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+	}
+	a11r := s.initialize(config)
+	s.Require().NoError(s.spend(a11r, sdk.NewInt64Coin("uosmo", 1000)))
+
+	store := s.Ctx.KVStore(s.OsmosisApp.GetKey(smartaccounttypes.StoreKey))
+	s.Require().True(store.Has(smartaccounttypes.KeySpendLimit(s.Account, "1")))
+
+	bz, err := json.Marshal(config)
+	s.Require().NoError(err)
+	s.Require().NoError(a11r.OnAuthenticatorRemoved(s.Ctx, s.Account, bz, "1"))
+	s.Require().False(store.Has(smartaccounttypes.KeySpendLimit(s.Account, "1")))
+}
+
+// prepareSpendLimitPool creates a uosmo/uusdc pool pricing uosmo at 1.5 uusdc.
+func (s *SpendLimitTest) prepareSpendLimitPool() uint64 {
+	poolCreator := s.TestAccAddress[0]
+	poolAssets := []balancer.PoolAsset{
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000_000)},
+		{Weight: osmomath.NewInt(1), Token: sdk.NewInt64Coin("uusdc", 1_500_000_000)},
+	}
+
+	s.FundAcc(poolCreator, s.OsmosisApp.PoolManagerKeeper.GetParams(s.Ctx).PoolCreationFee)
+	for _, asset := range poolAssets {
+		s.FundAcc(poolCreator, sdk.NewCoins(asset.Token))
+	}
+
+	poolParams := balancer.PoolParams{SwapFee: osmomath.ZeroDec(), ExitFee: osmomath.ZeroDec()}
+	poolId, err := s.OsmosisApp.PoolManagerKeeper.CreatePool(s.Ctx, balancer.NewMsgCreateBalancerPool(poolCreator, poolParams, poolAssets, ""))
+	s.Require().NoError(err)
+	return poolId
+}
@@ -33,6 +33,8 @@ var (
 	// Store prefix keys
 	KeyNextAccountAuthenticatorIdPrefix = []byte{0x01}
 	KeyAccountAuthenticatorsPrefix      = []byte{0x02}
+	KeySpendLimitPrefix                 = []byte{0x03}
+	KeySessionKeyPrefix                 = []byte{0x04}
 
 	// Parameter keys
 	KeyMaximumUnauthenticatedGas = []byte("MaximumUnauthenticatedGas")
@@ -52,6 +54,16 @@ func KeyNextAccountAuthenticatorId() []byte {
 	return BuildKey(KeyNextAccountAuthenticatorIdPrefix)
 }
 
+// KeySpendLimit returns the key of the spending state of a spend limit authenticator of an account.
+func KeySpendLimit(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySpendLimitPrefix, account.String(), authenticatorId)
+}
+
+// KeySessionKey returns the key of the usage state of a session key authenticator of an account.
+func KeySessionKey(account sdk.AccAddress, authenticatorId string) []byte {
+	return BuildKey(KeySessionKeyPrefix, account.String(), authenticatorId)
+}
+
 func KeyAccountAuthenticatorsPrefixId() []byte {
 	return BuildKey(KeyAccountAuthenticatorsPrefix)
 }
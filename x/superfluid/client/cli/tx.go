@@ -34,7 +34,7 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
 		NewSuperfluidUndelegateAndUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 		NewUnbondConvertAndStake(),
@@ -94,6 +94,13 @@ func NewSuperfluidUndelegateCmd() *cobra.Command {
 	})
 }
 
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSuperfluidRedelegate](&osmocli.TxCliDesc{
+		Use:   "redelegate",
+		Short: "superfluid redelegate a lock to a new validator",
+	})
+}
+
 func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgSuperfluidUnbondLock](&osmocli.TxCliDesc{
 		Use:   "unbond-lock",
@@ -17,8 +17,13 @@ import (
 	incentivestypes "github.com/osmosis-labs/osmosis/v31/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
+// refreshBatchSize is the number of intermediary accounts whose delegation amounts are refreshed
+// between checks of the epoch work budget.
+const refreshBatchSize = 10
+
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
 	return nil
 }
@@ -40,6 +45,9 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 		return nil
 	})
 
+	ctx.Logger().Info("Delete matured superfluid redelegation records")
+	k.DeleteMaturedLockRedelegationRecords(ctx)
+
 	// Update all LP tokens multipliers for the upcoming epoch.
 	// This affects staking reward distribution until the next epochs rewards.
 	// Exclusive of current epoch's rewards, inclusive of next epoch's rewards.
@@ -57,8 +65,78 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 
 	// Refresh intermediary accounts' delegation amounts,
 	// making staking rewards follow the updated multiplier numbers.
+	// The refresh gets what is left of the epoch work budget of the block, and is continued as epoch work
+	// in the following blocks if it exceeds it.
 	ctx.Logger().Info("Refresh all superfluid delegation amounts")
-	k.RefreshIntermediaryDelegationAmounts(ctx, accs)
+	k.startRefresh(ctx, k.GetEpochIdentifier(ctx), curEpoch-1)
+	_, err := k.continueRefresh(ctx, k.GetEpochIdentifier(ctx), curEpoch-1, k.ek.GetBlockEpochWorkBudget(ctx))
+	if err != nil {
+		ctx.Logger().Error("Error in continueRefresh", "error", err)
+	}
+}
+
+// getPendingRefresh returns the unfinished refresh of the intermediary accounts' delegation amounts, if any.
+func (k Keeper) getPendingRefresh(ctx sdk.Context) (types.PendingRefresh, bool, error) {
+	pendingRefresh := types.PendingRefresh{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPendingRefresh, &pendingRefresh)
+	return pendingRefresh, found, err
+}
+
+// setPendingRefresh sets the progress of the refresh of the intermediary accounts' delegation amounts.
+func (k Keeper) setPendingRefresh(ctx sdk.Context, pendingRefresh types.PendingRefresh) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPendingRefresh, &pendingRefresh)
+}
+
+// deletePendingRefresh deletes the progress of the refresh of the intermediary accounts' delegation amounts.
+func (k Keeper) deletePendingRefresh(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPendingRefresh)
+}
+
+// startRefresh records the refresh of the intermediary accounts' delegation amounts for the given ended epoch.
+// The delegation amounts are refreshed by continueRefresh.
+func (k Keeper) startRefresh(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	k.setPendingRefresh(ctx, types.PendingRefresh{
+		EpochIdentifier: epochIdentifier,
+		EpochNumber:     epochNumber,
+	})
+}
+
+// continueRefresh refreshes the delegation amounts of the intermediary accounts for the given ended epoch,
+// in batches of refreshBatchSize accounts in the order of their addresses, until the budget is exhausted.
+// Returns true once all accounts were refreshed, or if there is no refresh for the epoch.
+func (k Keeper) continueRefresh(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochstypes.EpochWorkBudget) (bool, error) {
+	pendingRefresh, found, err := k.getPendingRefresh(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !found || pendingRefresh.EpochIdentifier != epochIdentifier || pendingRefresh.EpochNumber != epochNumber {
+		return true, nil
+	}
+
+	for {
+		var lastAcc sdk.AccAddress
+		if pendingRefresh.LastIntermediaryAccount != "" {
+			lastAcc, err = sdk.AccAddressFromBech32(pendingRefresh.LastIntermediaryAccount)
+			if err != nil {
+				return false, err
+			}
+		}
+		accs := k.getIntermediaryAccountsAfter(ctx, lastAcc, refreshBatchSize)
+		k.RefreshIntermediaryDelegationAmounts(ctx, accs)
+		if len(accs) < refreshBatchSize {
+			break
+		}
+		pendingRefresh.LastIntermediaryAccount = accs[len(accs)-1].GetAccAddress().String()
+
+		if budget.Exhausted(ctx) {
+			k.setPendingRefresh(ctx, pendingRefresh)
+			ctx.Logger().Info("x/superfluid refresh of delegation amounts continues in the next block", "module", types.ModuleName, "lastIntermediaryAccount", pendingRefresh.LastIntermediaryAccount, "height", ctx.BlockHeight())
+			return false, nil
+		}
+	}
+
+	k.deletePendingRefresh(ctx)
+	return true, nil
 }
 
 func (k Keeper) MoveSuperfluidDelegationRewardToGauges(ctx sdk.Context, accs []types.SuperfluidIntermediaryAccount) {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	lockuptypes "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v31/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliers() {
@@ -392,3 +394,63 @@ func (s *KeeperTestSuite) TestDistributeSuperfluidGauges() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestRefreshEpochWorkBudget() {
+	s.SetupTest()
+	superfluidKeeper := s.App.SuperfluidKeeper
+	epochIdentifier := superfluidKeeper.GetEpochIdentifier(s.Ctx)
+
+	// more intermediary accounts than two batches, delegating to validators that do not exist
+	numAccs := 2*keeper.RefreshBatchSize + 1
+	for i := 0; i < numAccs; i++ {
+		valAddr := sdk.ValAddress(fmt.Sprintf("validator%011d", i)).String()
+		superfluidKeeper.SetIntermediaryAccount(s.Ctx, types.NewSuperfluidIntermediaryAccount(DefaultGammAsset, valAddr, 0))
+	}
+	accs := superfluidKeeper.GetAllIntermediaryAccounts(s.Ctx)
+	s.Require().Len(accs, numAccs)
+
+	// the refresh starts in the begin blocker, within what the epoch work of the block left of its budget
+	params := s.App.EpochsKeeper.GetParams(s.Ctx)
+	params.EpochWorkGasPerBlock = 100_000_000
+	s.App.EpochsKeeper.SetParams(s.Ctx, params)
+	s.App.EpochsKeeper.BeginBlocker(s.Ctx)
+	s.Ctx.GasMeter().ConsumeGas(params.EpochWorkGasPerBlock, "epoch work")
+	superfluidKeeper.AfterEpochStartBeginBlock(s.Ctx)
+	curEpoch := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, epochIdentifier).CurrentEpoch
+	pending, found, err := superfluidKeeper.GetPendingRefresh(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.PendingRefresh{
+		EpochIdentifier:         epochIdentifier,
+		EpochNumber:             curEpoch - 1,
+		LastIntermediaryAccount: accs[keeper.RefreshBatchSize-1].GetAccAddress().String(),
+	}, pending)
+
+	hooks := superfluidKeeper.Hooks()
+
+	// the work of the current epoch waits for the begin blocker to start the refresh
+	done, err := hooks.ContinueEpochWork(s.Ctx, epochIdentifier, curEpoch, epochstypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().False(done)
+
+	// the work of another epoch is done right away
+	done, err = hooks.ContinueEpochWork(s.Ctx, epochIdentifier, curEpoch+1, epochstypes.UnlimitedEpochWorkBudget())
+	s.Require().NoError(err)
+	s.Require().True(done)
+
+	// an exhausted budget stops after the next batch
+	done, err = hooks.ContinueEpochWork(s.Ctx, epochIdentifier, curEpoch-1, epochstypes.NewEpochWorkBudget(s.Ctx, 1))
+	s.Require().NoError(err)
+	s.Require().False(done)
+	pending, found, err = superfluidKeeper.GetPendingRefresh(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(accs[2*keeper.RefreshBatchSize-1].GetAccAddress().String(), pending.LastIntermediaryAccount)
+
+	done, err = hooks.ContinueEpochWork(s.Ctx, epochIdentifier, curEpoch-1, epochstypes.NewEpochWorkBudget(s.Ctx, 1))
+	s.Require().NoError(err)
+	s.Require().True(done)
+	_, found, err = superfluidKeeper.GetPendingRefresh(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	cltypes "github.com/osmosis-labs/osmosis/v31/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
 )
 
 var (
@@ -68,3 +69,13 @@ func (k Keeper) ConvertUnlockedToStake(ctx sdk.Context, sender sdk.AccAddress, v
 func (k Keeper) DelegateBaseOnValsetPref(ctx sdk.Context, sender sdk.AccAddress, valAddr, originalSuperfluidValAddr string, totalAmtToStake osmomath.Int) error {
 	return k.delegateBaseOnValsetPref(ctx, sender, valAddr, originalSuperfluidValAddr, totalAmtToStake)
 }
+
+const RefreshBatchSize = refreshBatchSize
+
+func (k Keeper) GetPendingRefresh(ctx sdk.Context) (types.PendingRefresh, bool, error) {
+	return k.getPendingRefresh(ctx)
+}
+
+func (k Keeper) StartRefresh(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	k.startRefresh(ctx, epochIdentifier, epochNumber)
+}
@@ -37,15 +37,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize lock redelegation records
+	for _, record := range genState.LockRedelegationRecords {
+		k.SetLockRedelegationRecord(ctx, record)
+	}
+
+	// initialize the unfinished refresh of delegation amounts
+	if genState.PendingRefresh != nil {
+		k.setPendingRefresh(ctx, *genState.PendingRefresh)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var pendingRefresh *types.PendingRefresh
+	if refresh, found, err := k.getPendingRefresh(ctx); err != nil {
+		panic(err)
+	} else if found {
+		pendingRefresh = &refresh
+	}
+
 	return &types.GenesisState{
 		Params:                        k.GetParams(ctx),
 		SuperfluidAssets:              k.GetAllSuperfluidAssets(ctx),
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		LockRedelegationRecords:       k.GetAllLockRedelegationRecords(ctx),
+		PendingRefresh:                pendingRefresh,
 	}
 }
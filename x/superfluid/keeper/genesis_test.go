@@ -21,7 +21,8 @@ var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: osmomath.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:         osmomath.NewDecWithPrec(5, 1), // 50%
+		MinRedelegationOsmoAmount: types.DefaultMinRedelegationOsmoAmount,
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
@@ -49,6 +50,14 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	LockRedelegationRecords: []types.LockRedelegationRecord{
+		{
+			LockId:              1,
+			SrcValidatorAddress: "osmovaloper1xrzj0p6w5ulse0h6vnmw9x5zspgq6edhntpy7r",
+			DstValidatorAddress: "osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+			CompletionTime:      now.Add(time.Hour),
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -99,6 +108,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	records := app.SuperfluidKeeper.GetAllLockRedelegationRecords(ctx)
+	require.Equal(t, records, genesis.LockRedelegationRecords)
 	os.RemoveAll(dirName)
 }
 
@@ -126,6 +138,7 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesisExported.LockRedelegationRecords, genesis.LockRedelegationRecords)
 
 	os.RemoveAll(dirName)
 }
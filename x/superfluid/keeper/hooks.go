@@ -17,7 +17,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks     = Hooks{}
+	_ epochstypes.EpochWorkHooks = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -39,6 +42,16 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
 
+// ContinueEpochWork continues the refresh of the intermediary accounts' delegation amounts for an ended epoch.
+// The refresh starts in the superfluid begin blocker of the block the epoch ended in, after the osmo
+// equivalent multipliers are updated, so the work is not done until then.
+func (h Hooks) ContinueEpochWork(ctx sdk.Context, epochIdentifier string, epochNumber int64, budget epochstypes.EpochWorkBudget) (bool, error) {
+	if epochIdentifier == h.k.GetEpochIdentifier(ctx) && h.k.ek.GetEpochInfo(ctx, epochIdentifier).CurrentEpoch == epochNumber {
+		return false, nil
+	}
+	return h.k.continueRefresh(ctx, epochIdentifier, epochNumber, budget)
+}
+
 // lockup hooks
 // if you add tokens to a lock that is superfluid unbonding, nothing happens superfluid side.
 // This lock does as an edge case take on the slashing risk as well for historical slashes.
@@ -36,6 +36,33 @@ func (k Keeper) GetAllIntermediaryAccounts(context context.Context) []types.Supe
 	return accounts
 }
 
+// getIntermediaryAccountsAfter returns up to limit intermediary accounts in the order of their addresses,
+// starting after the given address, or from the first one if it is empty.
+func (k Keeper) getIntermediaryAccountsAfter(ctx sdk.Context, address sdk.AccAddress, limit int) []types.SuperfluidIntermediaryAccount {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixIntermediaryAccount)
+
+	var start []byte
+	if !address.Empty() {
+		// the smallest key greater than the address
+		start = append(append([]byte{}, address...), 0x00)
+	}
+	iterator := prefixStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	accounts := []types.SuperfluidIntermediaryAccount{}
+	for ; iterator.Valid() && len(accounts) < limit; iterator.Next() {
+		account := types.SuperfluidIntermediaryAccount{}
+		err := proto.Unmarshal(iterator.Value(), &account)
+		if err != nil {
+			panic(err)
+		}
+
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
 func (k Keeper) GetIntermediaryAccount(ctx sdk.Context, address sdk.AccAddress) types.SuperfluidIntermediaryAccount {
 	store := ctx.KVStore(k.storeKey)
 	prefixStore := prefix.NewStore(store, types.KeyPrefixIntermediaryAccount)
@@ -2,6 +2,7 @@ package events
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -120,6 +121,28 @@ func newSuperfluidUndelegateEvent(lockId uint64, lockCoins sdk.Coins) sdk.Event
 	)
 }
 
+func EmitSuperfluidRedelegateEvent(ctx sdk.Context, lockId uint64, lockCoins sdk.Coins, srcValAddress, valAddress string, completionTime time.Time) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidRedelegateEvent(lockId, lockCoins, srcValAddress, valAddress, completionTime),
+	})
+}
+
+func newSuperfluidRedelegateEvent(lockId uint64, lockCoins sdk.Coins, srcValAddress, valAddress string, completionTime time.Time) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSuperfluidRedelegate,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeLockAmount, lockCoins[0].Amount.String()),
+		sdk.NewAttribute(types.AttributeLockDenom, lockCoins[0].Denom),
+		sdk.NewAttribute(types.AttributeSrcValidator, srcValAddress),
+		sdk.NewAttribute(types.AttributeValidator, valAddress),
+		sdk.NewAttribute(types.AttributeCompletionTime, completionTime.String()),
+	)
+}
+
 func EmitSuperfluidUnbondLockEvent(ctx sdk.Context, lockId uint64) {
 	if ctx.EventManager() == nil {
 		return
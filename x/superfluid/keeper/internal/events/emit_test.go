@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -19,9 +20,10 @@ type SuperfluidEventsTestSuite struct {
 }
 
 const (
-	addressString = "addr1---------------"
-	testDenomA    = "denoma"
-	testDenomB    = "denomb"
+	addressString  = "addr1---------------"
+	addressString2 = "addr2---------------"
+	testDenomA     = "denoma"
+	testDenomB     = "denomb"
 )
 
 func TestSuperfluidEventsTestSuite(t *testing.T) {
@@ -301,6 +303,60 @@ func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidUndelegateEvent() {
 	}
 }
 
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidRedelegateEvent() {
+	testcases := map[string]struct {
+		ctx            sdk.Context
+		lockID         uint64
+		lockCoins      sdk.Coins
+		srcValAddr     string
+		valAddr        string
+		completionTime time.Time
+	}{
+		"basic valid": {
+			ctx:            suite.CreateTestContext(),
+			lockID:         1,
+			lockCoins:      sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+			srcValAddr:     sdk.ValAddress([]byte(addressString)).String(),
+			valAddr:        sdk.ValAddress([]byte(addressString2)).String(),
+			completionTime: time.Unix(1, 0).UTC(),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSuperfluidRedelegateEvent(tc.ctx, tc.lockID, tc.lockCoins, tc.srcValAddr, tc.valAddr, tc.completionTime)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidRedelegate,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeLockAmount, tc.lockCoins[0].Amount.String()),
+					sdk.NewAttribute(types.AttributeLockDenom, tc.lockCoins[0].Denom),
+					sdk.NewAttribute(types.AttributeSrcValidator, tc.srcValAddr),
+					sdk.NewAttribute(types.AttributeValidator, tc.valAddr),
+					sdk.NewAttribute(types.AttributeCompletionTime, tc.completionTime.String()),
+				),
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidUnbondLockEvent() {
 	testcases := map[string]struct {
 		ctx    sdk.Context
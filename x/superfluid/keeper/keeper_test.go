@@ -82,6 +82,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	distributionParams.CommunityTax = osmomath.ZeroDec()
 	s.App.DistrKeeper.Params.Set(s.Ctx, distributionParams)
 	s.App.IncentivesKeeper.SetParam(s.Ctx, incentivetypes.KeyMinValueForDistr, sdk.NewCoin("stake", osmomath.NewInt(1)))
+	s.App.SuperfluidKeeper.SetParam(s.Ctx, types.KeyMinRedelegationOsmoAmount, osmomath.ZeroInt())
 }
 
 func (s *KeeperTestSuite) SetupDefaultPool() {
@@ -74,14 +74,25 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// SuperfluidRedelegate is a method to redelegate superfluid staked asset into a different validator.
-// Currently this feature is not supported.
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+// SuperfluidRedelegate moves the superfluid delegation of the given lock to a different validator without unbonding.
+// The delegation is redelegated through the staking module, so the redelegation limits of the staking module apply
+// and the lock cannot be redelegated again until the redelegation completes.
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	srcIntermediaryAcc, _ := server.keeper.GetIntermediaryAccountFromLockId(ctx, msg.LockId)
+	completionTime, err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err != nil {
+		return &types.MsgSuperfluidRedelegateResponse{}, err
+	}
+
+	lock, err := server.keeper.lk.GetLockByID(ctx, msg.LockId)
+	if err != nil {
+		return &types.MsgSuperfluidRedelegateResponse{}, err
+	}
+	events.EmitSuperfluidRedelegateEvent(ctx, msg.LockId, lock.Coins, srcIntermediaryAcc.ValAddr, msg.NewValAddr, completionTime)
+	return &types.MsgSuperfluidRedelegateResponse{CompletionTime: completionTime}, nil
+}
 
 // SuperfluidUnbondLock starts unbonding for currently superfluid undelegating lock.
 // This method would return an error when the underlying lock is not in an superfluid undelegating state,
@@ -408,6 +408,32 @@ func (s *KeeperTestSuite) TestMsgSuperfluidUndelegate_Event() {
 	}
 }
 
+// TestMsgSuperfluidRedelegate_Event tests that events are correctly emitted
+// when calling SuperfluidRedelegate.
+func (s *KeeperTestSuite) TestMsgSuperfluidRedelegate_Event() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.SuperfluidKeeper)
+
+	// setup validators
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+
+	// setup superfluid delegations
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+
+	// superfluid redelegate
+	sender, err := sdk.AccAddressFromBech32(locks[0].Owner)
+	s.Require().NoError(err)
+	resp, err := msgServer.SuperfluidRedelegate(s.Ctx, types.NewMsgSuperfluidRedelegate(sender, locks[0].ID, valAddrs[1]))
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Add(stakingParams.UnbondingTime), resp.CompletionTime)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidRedelegate, 1)
+}
+
 // TestMsgSuperfluidUnbondLock_Event tests that events are correctly emitted
 // when calling SuperfluidUnbondLock.
 func (s *KeeperTestSuite) TestMsgSuperfluidUnbondLock_Event() {
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) SetLockRedelegationRecord(ctx sdk.Context, record types.LockRedelegationRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	bz, err := proto.Marshal(&record)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(record.LockId), bz)
+}
+
+// GetLockRedelegationRecord returns the redelegation record of the given lock and a bool if found / not found.
+func (k Keeper) GetLockRedelegationRecord(ctx sdk.Context, lockId uint64) (types.LockRedelegationRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockId))
+	if bz == nil {
+		return types.LockRedelegationRecord{}, false
+	}
+	record := types.LockRedelegationRecord{}
+	err := proto.Unmarshal(bz, &record)
+	if err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+func (k Keeper) GetAllLockRedelegationRecords(ctx sdk.Context) []types.LockRedelegationRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := []types.LockRedelegationRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.LockRedelegationRecord{}
+		err := proto.Unmarshal(iterator.Value(), &record)
+		if err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (k Keeper) DeleteLockRedelegationRecord(ctx sdk.Context, lockId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+// DeleteMaturedLockRedelegationRecords deletes the redelegation records whose redelegation has completed.
+func (k Keeper) DeleteMaturedLockRedelegationRecords(ctx sdk.Context) {
+	for _, record := range k.GetAllLockRedelegationRecords(ctx) {
+		if !isRedelegationInProgress(ctx, record) {
+			k.DeleteLockRedelegationRecord(ctx, record.LockId)
+		}
+	}
+}
+
+// isRedelegationInProgress returns true if the redelegation of the record has not completed yet.
+func isRedelegationInProgress(ctx sdk.Context, record types.LockRedelegationRecord) bool {
+	return record.CompletionTime.After(ctx.BlockTime())
+}
@@ -58,14 +58,27 @@ func (k Keeper) SlashLockupsForValidatorSlash(context context.Context, valAddr s
 			// slash the lock whether its bonding or unbonding.
 			// this overslashes unbondings that started unbonding before the slash infraction,
 			// but this seems to be an acceptable trade-off based upon choices taken in the SDK.
-			k.slashSynthLock(ctx, synthLock, slashFactor)
+			k.slashLock(ctx, synthLock.UnderlyingLockId, slashFactor)
 		}
 	}
+
+	// locks redelegated away from the validator are slashed as well until their redelegation completes,
+	// as they were still delegated to the validator during the unbonding period.
+	// Similarly to unbondings, this overslashes redelegations that started before the slash infraction.
+	for _, record := range k.GetAllLockRedelegationRecords(ctx) {
+		if record.SrcValidatorAddress != valAddr.String() || !isRedelegationInProgress(ctx, record) {
+			continue
+		}
+		k.slashLock(ctx, record.LockId, slashFactor)
+	}
 }
 
-func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.SyntheticLock, slashFactor osmomath.Dec) {
+func (k Keeper) slashLock(ctx sdk.Context, lockID uint64, slashFactor osmomath.Dec) {
 	// Only single token lock is allowed here
-	lock, _ := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return
+	}
 	slashAmt := lock.Coins[0].Amount.ToLegacyDec().Mul(slashFactor)
 	lockSharesToSlash := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt.TruncateInt()))
 
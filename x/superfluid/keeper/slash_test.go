@@ -205,6 +205,72 @@ func (s *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSlashLockupsForRedelegationSlash() {
+	testCases := []struct {
+		name                 string
+		completeRedelegation bool
+		expSlashed           bool
+	}{
+		{
+			"redelegated lock is slashed for the source validator until the redelegation completes",
+			false,
+			true,
+		},
+		{
+			"redelegated lock is not slashed for the source validator after the redelegation completes",
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+			s.Require().NoError(err)
+
+			// setup validators
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+			// setup superfluid delegations, lock1 => val0, lock2 => val1
+			_, intermediaryAccs, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}}, denoms)
+			s.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			// superfluid redelegate lock1 => val0 -> val1
+			_, err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, locks[0].Owner, locks[0].ID, valAddrs[1].String())
+			s.Require().NoError(err)
+
+			if tc.completeRedelegation {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(stakingParams.UnbondingTime))
+			}
+
+			// slash lockups for the source validator
+			// Note: the staking module slashes the redelegation itself, so the superfluid invariants are not checked here
+			slashFactor := osmomath.NewDecWithPrec(5, 2)
+			s.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(s.Ctx, valAddrs[0], slashFactor)
+
+			// check redelegated lockup changes
+			gotLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, locks[0].ID)
+			s.Require().NoError(err)
+			if tc.expSlashed {
+				s.Require().Equal(osmomath.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+			} else {
+				s.Require().Equal(osmomath.NewInt(1000000).String(), gotLock.Coins[0].Amount.String())
+			}
+
+			// the lockup delegated to the destination validator is not slashed
+			gotLock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, locks[1].ID)
+			s.Require().NoError(err)
+			s.Require().Equal(osmomath.NewInt(1000000).String(), gotLock.Coins[0].Amount.String())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestPrepareConcentratedLockForSlash() {
 	type prepareConcentratedLockTestCase struct {
 		name         string
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	addresscodec "cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
@@ -326,6 +327,99 @@ func (k Keeper) SuperfluidUndelegateToConcentratedPosition(ctx sdk.Context, send
 	return k.undelegateCommon(ctx, sender, gammLockID)
 }
 
+// SuperfluidRedelegate moves the superfluid delegation of the given lock to a new validator, without
+// unbonding the lock. The lock is connected to the intermediary account of the (denom, new validator) pair,
+// and its synthetic lockup is moved to the new validator.
+// The delegation of the old intermediary account is undelegated and burnt, and the new intermediary account
+// redelegates the same amount from the old validator to the new validator through the staking module.
+// Hence, the redelegation limits of the staking module apply, and the lock keeps being slashed for
+// the infractions of the old validator until the redelegation completes. In particular, the redelegations
+// of all the locks of a denom from the old validator to the new validator are entries of the same
+// intermediary account, limited to the staking MaxEntries until they complete. To keep these shared entries
+// from being used up by small locks, the OSMO equivalent of the lock must be at least MinRedelegationOsmoAmount.
+// As the staking module forbids transitive redelegations, the lock cannot be redelegated again
+// until its redelegation completes.
+// Returns the completion time of the redelegation.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) (time.Time, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	err = k.validateLockForSF(lock, sender)
+	if err != nil {
+		return time.Time{}, err
+	}
+	lockedCoin := lock.Coins[0]
+
+	// get the intermediate account currently associated with lock id.
+	oldIntermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return time.Time{}, types.ErrNotSuperfluidUsedLockup
+	}
+	if oldIntermediaryAcc.ValAddr == newValAddr {
+		return time.Time{}, types.ErrSameValidatorRedelegation
+	}
+	if record, found := k.GetLockRedelegationRecord(ctx, lockID); found && isRedelegationInProgress(ctx, record) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrRedelegationInProgress, "lock id : %d, completion time : %s", lockID, record.CompletionTime)
+	}
+	_, err = k.validateValAddrForDelegate(ctx, newValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the redelegations of all the locks of the denom from the old validator to the new validator share the
+	// staking redelegation entries of the new intermediary account, so small locks cannot be redelegated.
+	amount, err := k.GetSuperfluidOSMOTokens(ctx, lockedCoin.Denom, lockedCoin.Amount)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if amount.IsZero() {
+		return time.Time{}, types.ErrOsmoEquivalentZeroNotAllowed
+	}
+	if minAmount := k.GetParams(ctx).MinRedelegationOsmoAmount; amount.LT(minAmount) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrRedelegationAmountTooSmall, "lock id : %d, osmo equivalent : %s, minimum : %s", lockID, amount, minAmount)
+	}
+
+	// get the intermediate account for the (denom, new validator) pair,
+	// and move the connection and the synthetic lockup to it.
+	newIntermediaryAcc, err := k.GetOrCreateIntermediaryAccount(ctx, lockedCoin.Denom, newValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	k.SetLockIdIntermediaryAccountConnection(ctx, lockID, newIntermediaryAcc)
+
+	err = k.lk.DeleteSyntheticLockup(ctx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldIntermediaryAcc.ValAddr))
+	if err != nil {
+		return time.Time{}, err
+	}
+	err = k.createSyntheticLockup(ctx, lockID, newIntermediaryAcc, bondedStatus)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// move this lock's delegation amount from the old intermediary account to the new one.
+	err = k.forceUndelegateAndBurnOsmoTokens(ctx, amount, oldIntermediaryAcc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	completionTime, err := k.mintOsmoTokensAndRedelegate(ctx, amount, newIntermediaryAcc, oldIntermediaryAcc.ValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// the redelegation completes right away if the old validator is unbonded.
+	record := types.LockRedelegationRecord{
+		LockId:              lockID,
+		SrcValidatorAddress: oldIntermediaryAcc.ValAddr,
+		DstValidatorAddress: newValAddr,
+		CompletionTime:      completionTime,
+	}
+	if isRedelegationInProgress(ctx, record) {
+		k.SetLockRedelegationRecord(ctx, record)
+	}
+	return completionTime, nil
+}
+
 // SuperfluidUnbondLock unbonds the lock that has been used for superfluid staking.
 // This method would return an error if the underlying lock is not superfluid undelegating.
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
@@ -467,17 +561,7 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount osmomath.I
 	}
 
 	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-		bondDenom, err := k.sk.BondDenom(cacheCtx)
-		if err != nil {
-			return err
-		}
-		coins := sdk.Coins{sdk.NewCoin(bondDenom, osmoAmount)}
-		err = k.bk.MintCoins(cacheCtx, types.ModuleName, coins)
-		if err != nil {
-			return err
-		}
-		k.bk.AddSupplyOffset(cacheCtx, bondDenom, osmoAmount.Neg())
-		err = k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, intermediaryAccount.GetAccAddress(), coins)
+		err := k.mintOsmoTokensToIntermediaryAccount(cacheCtx, osmoAmount, intermediaryAccount)
 		if err != nil {
 			return err
 		}
@@ -493,6 +577,60 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount osmomath.I
 	return err
 }
 
+// mintOsmoTokensAndRedelegate mints osmoAmount of OSMO tokens, delegates them to the source validator on behalf of
+// intermediary account and immediately redelegates them to the validator of intermediary account.
+// The redelegation goes through the staking module, so it is subject to its redelegation limits,
+// and the staking module slashes it for the infractions of the source validator until it completes.
+// Returns the completion time of the redelegation.
+func (k Keeper) mintOsmoTokensAndRedelegate(ctx sdk.Context, osmoAmount osmomath.Int, intermediaryAccount types.SuperfluidIntermediaryAccount, srcValAddr string) (completionTime time.Time, err error) {
+	srcValidator, err := k.validateValAddrForDelegate(ctx, srcValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	srcValAddress, err := sdk.ValAddressFromBech32(srcValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	dstValAddress, err := sdk.ValAddressFromBech32(intermediaryAccount.ValAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		err := k.mintOsmoTokensToIntermediaryAccount(cacheCtx, osmoAmount, intermediaryAccount)
+		if err != nil {
+			return err
+		}
+
+		shares, err := k.sk.Delegate(cacheCtx,
+			intermediaryAccount.GetAccAddress(),
+			osmoAmount, stakingtypes.Unbonded, srcValidator, true)
+		if err != nil {
+			return err
+		}
+
+		completionTime, err = k.sk.BeginRedelegation(cacheCtx, intermediaryAccount.GetAccAddress(), srcValAddress, dstValAddress, shares)
+		return err
+	})
+	return completionTime, err
+}
+
+// mintOsmoTokensToIntermediaryAccount mints osmoAmount of OSMO tokens and sends them to intermediary account.
+// The minted tokens are excluded from the supply through a supply offset.
+func (k Keeper) mintOsmoTokensToIntermediaryAccount(ctx sdk.Context, osmoAmount osmomath.Int, intermediaryAccount types.SuperfluidIntermediaryAccount) error {
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	coins := sdk.Coins{sdk.NewCoin(bondDenom, osmoAmount)}
+	err = k.bk.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	k.bk.AddSupplyOffset(ctx, bondDenom, osmoAmount.Neg())
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intermediaryAccount.GetAccAddress(), coins)
+}
+
 // forceUndelegateAndBurnOsmoTokens force undelegates osmoAmount worth of delegation shares
 // from delegations between intermediary account and valAddr.
 // We take the returned tokens, and then immediately burn them.
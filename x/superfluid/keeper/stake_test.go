@@ -13,6 +13,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v31/x/superfluid/keeper"
 	"github.com/osmosis-labs/osmosis/v31/x/superfluid/types"
 
+	"cosmossdk.io/core/header"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
@@ -1744,158 +1745,245 @@ func (s *KeeperTestSuite) getExpectedBondDenomPoolAmtAfterConvert(sender sdk.Acc
 	return expectedLiquidity.AmountOf(bondDenom)
 }
 
-// type superfluidRedelegation struct {
-// 	lockId      uint64
-// 	oldValIndex int64
-// 	newValIndex int64
-// }
-
-// func (s *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		s.Run(tc.name, func() {
-// 			s.SetupTest()
-
-// 			poolId := s.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			s.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := s.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := s.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			s.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					s.Require().Error(err)
-// 					continue
-// 				}
-// 				s.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
-// 				synthLock, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				s.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().Equal(synthLock.EndTime, s.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				s.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				s.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				s.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := s.App.SuperfluidKeeper.GetIntermediaryAccount(s.Ctx, expAcc.GetAccAddress())
-// 				s.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				s.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gotAcc.GaugeId)
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				s.Require().Equal(gauge.IsPerpetual, true)
-// 				s.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				s.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				s.Require().Equal(gauge.StartTime, s.Ctx.BlockTime())
-// 				s.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				s.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				s.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := s.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(s.Ctx, srd.lockId)
-// 				s.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, err := s.App.StakingKeeper.GetDelegation(s.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				s.Require().NoError(err)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := s.Ctx.CacheContext()
-// 				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				s.Require().NoError(err)
-// 				err = s.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				s.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+type superfluidRedelegation struct {
+	lockId      uint64
+	newValIndex int64
+}
+
+func (s *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		superDelegations        []superfluidDelegation
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []error
+	}{
+		{
+			"with single superfluid delegation and single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}}, // lock1 => val0 -> val1
+			[]error{nil},
+		},
+		{
+			"with multiple superfluid delegations and multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}, {2, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]error{nil, nil},
+		},
+		{
+			"redelegation to a validator with existing superfluid delegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}}, // lock1 => val0 -> val1
+			[]error{nil},
+		},
+		{
+			"redelegation from an unbonding validator",
+			[]stakingtypes.BondStatus{stakingtypes.Unbonding, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}}, // lock1 => val0 -> val1
+			[]error{nil},
+		},
+		{
+			"redelegation from an unbonded validator completes right away",
+			[]stakingtypes.BondStatus{stakingtypes.Unbonded, stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}, {1, 2}}, // lock1 => val0 -> val1, lock1 => val1 -> val2
+			[]error{nil, nil},
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}, {1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]error{nil, types.ErrRedelegationInProgress},
+		},
+		{
+			"try redelegating again before the redelegation completes",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1}, {1, 2}}, // lock1 => val0 -> val1, lock1 => val1 -> val2
+			[]error{nil, types.ErrRedelegationInProgress},
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0}}, // lock1 => val0 -> val0
+			[]error{types.ErrSameValidatorRedelegation},
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 1}}, // lock2 => val1
+			[]error{lockuptypes.ErrLockupNotFound},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+			s.Require().NoError(err)
+			bondDenom := stakingParams.BondDenom
+
+			// setup validators
+			valAddrs := s.SetupValidators(tc.validatorStats)
+
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+			// setup superfluid delegations
+			_, intermediaryAccs, _ := s.setupSuperfluidDelegations(valAddrs, tc.superDelegations, denoms)
+			s.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			for index, srd := range tc.superRedelegations {
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+				oldAcc, _ := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, srd.lockId)
+				newValAddr := valAddrs[srd.newValIndex]
+
+				presupplyWithOffset := s.App.BankKeeper.GetSupplyWithOffset(s.Ctx, bondDenom)
+
+				// superfluid redelegate
+				completionTime, err := s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, srd.lockId, newValAddr.String())
+				if tc.expSuperRedelegationErr[index] != nil {
+					s.Require().ErrorIs(err, tc.expSuperRedelegationErr[index])
+					continue
+				}
+				s.Require().NoError(err)
+
+				// ensure post-superfluid redelegation osmo supplywithoffset is the same
+				postsupplyWithOffset := s.App.BankKeeper.GetSupplyWithOffset(s.Ctx, bondDenom)
+				s.Require().True(postsupplyWithOffset.IsEqual(presupplyWithOffset))
+
+				// check lockId connection with the new intermediary account
+				newAcc, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, srd.lockId)
+				s.Require().True(found)
+				s.Require().Equal(lock.Coins[0].Denom, newAcc.Denom)
+				s.Require().Equal(newValAddr.String(), newAcc.ValAddr)
+
+				// check synthetic lockup move from the old validator to the new validator
+				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, oldAcc.ValAddr))
+				s.Require().Error(err)
+				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, oldAcc.ValAddr))
+				s.Require().Error(err)
+				synthLock, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSyntheticDenom(lock.Coins[0].Denom, newValAddr.String()))
+				s.Require().NoError(err)
+				s.Require().Equal(time.Time{}, synthLock.EndTime)
+
+				// check delegation from the new intermediary account to the new validator
+				expAmount, err := s.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, lock.Coins[0].Denom, lock.Coins[0].Amount)
+				s.Require().NoError(err)
+				delegation, err := s.App.StakingKeeper.GetDelegation(s.Ctx, newAcc.GetAccAddress(), newValAddr)
+				s.Require().NoError(err)
+				validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, newValAddr)
+				s.Require().NoError(err)
+				s.Require().True(validator.TokensFromShares(delegation.Shares).TruncateInt().GTE(expAmount))
+
+				// check the redelegation record, which only exists until the redelegation completes
+				oldValAddr, err := sdk.ValAddressFromBech32(oldAcc.ValAddr)
+				s.Require().NoError(err)
+				oldValidator, err := s.App.StakingKeeper.GetValidator(s.Ctx, oldValAddr)
+				s.Require().NoError(err)
+				record, found := s.App.SuperfluidKeeper.GetLockRedelegationRecord(s.Ctx, srd.lockId)
+				_, err = s.App.StakingKeeper.GetRedelegation(s.Ctx, newAcc.GetAccAddress(), oldValAddr, newValAddr)
+				if oldValidator.IsBonded() {
+					s.Require().Equal(s.Ctx.BlockTime().Add(stakingParams.UnbondingTime), completionTime)
+					s.Require().True(found)
+					s.Require().Equal(types.LockRedelegationRecord{
+						LockId:              srd.lockId,
+						SrcValidatorAddress: oldAcc.ValAddr,
+						DstValidatorAddress: newValAddr.String(),
+						CompletionTime:      completionTime,
+					}, record)
+					s.Require().NoError(err)
+				} else {
+					s.Require().False(completionTime.After(s.Ctx.BlockTime()))
+					s.Require().False(found)
+				}
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*s.App.SuperfluidKeeper)(s.Ctx)
+			s.Require().False(broken, reason)
+
+			// the redelegation records are deleted once the redelegations complete
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(stakingParams.UnbondingTime))
+			s.App.SuperfluidKeeper.DeleteMaturedLockRedelegationRecords(s.Ctx)
+			s.Require().Empty(s.App.SuperfluidKeeper.GetAllLockRedelegationRecords(s.Ctx))
+		})
+	}
+}
+
+// TestSuperfluidRedelegateMinAmount tests that the locks worth less than the minimum OSMO equivalent cannot be redelegated.
+func (s *KeeperTestSuite) TestSuperfluidRedelegateMinAmount() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	amount, err := s.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(s.Ctx, lock.Coins[0].Denom, lock.Coins[0].Amount)
+	s.Require().NoError(err)
+	s.App.SuperfluidKeeper.SetParam(s.Ctx, types.KeyMinRedelegationOsmoAmount, amount.AddRaw(1))
+
+	_, err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	s.Require().ErrorIs(err, types.ErrRedelegationAmountTooSmall)
+
+	s.App.SuperfluidKeeper.SetParam(s.Ctx, types.KeyMinRedelegationOsmoAmount, amount)
+	_, err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	s.Require().NoError(err)
+}
+
+// TestSuperfluidRedelegateMaxEntries tests that the redelegations of the locks of a denom between two validators
+// share the staking redelegation entries of the intermediary account, so that they fail once MaxEntries is reached.
+func (s *KeeperTestSuite) TestSuperfluidRedelegateMaxEntries() {
+	s.SetupTest()
+	stakingParams, err := s.App.StakingKeeper.GetParams(s.Ctx)
+	s.Require().NoError(err)
+
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+
+	// one lock per delegator more than the staking redelegation entries
+	superDelegations := []superfluidDelegation{}
+	for i := uint32(0); i <= stakingParams.MaxEntries; i++ {
+		superDelegations = append(superDelegations, superfluidDelegation{int64(i), 0, 0, 1000000})
+	}
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, superDelegations, denoms)
+
+	for i, lock := range locks {
+		oldAcc, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, lock.ID)
+		s.Require().True(found)
+
+		// the redelegation is atomic, as in a transaction
+		cacheCtx, write := s.Ctx.CacheContext()
+		_, err := s.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, lock.ID, valAddrs[1].String())
+		if uint32(i) < stakingParams.MaxEntries {
+			s.Require().NoError(err)
+			write()
+			continue
+		}
+
+		s.Require().ErrorIs(err, stakingtypes.ErrMaxRedelegationEntries)
+		acc, found := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, lock.ID)
+		s.Require().True(found)
+		s.Require().Equal(oldAcc, acc)
+	}
+
+	// the entries are released once the redelegations complete
+	completionTime := s.Ctx.BlockTime().Add(stakingParams.UnbondingTime)
+	s.Ctx = s.Ctx.WithBlockTime(completionTime).WithHeaderInfo(header.Info{Height: s.Ctx.BlockHeight(), Time: completionTime})
+	_, err = s.App.StakingKeeper.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	lastLock := locks[len(locks)-1]
+	_, err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lastLock.Owner, lastLock.ID, valAddrs[1].String())
+	s.Require().NoError(err)
+}
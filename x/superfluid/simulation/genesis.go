@@ -14,7 +14,8 @@ import (
 func RandomizedGenState(simState *module.SimulationState) {
 	superfluidGenesis := &types.GenesisState{
 		Params: types.Params{
-			MinimumRiskFactor: osmomath.NewDecWithPrec(5, 2), // 5%
+			MinimumRiskFactor:         osmomath.NewDecWithPrec(5, 2), // 5%
+			MinRedelegationOsmoAmount: types.DefaultMinRedelegationOsmoAmount,
 		},
 		SuperfluidAssets:          []types.SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{},
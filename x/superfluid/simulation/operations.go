@@ -35,6 +35,7 @@ const (
 var (
 	typeMsgSuperfluidDelegate   = sdk.MsgTypeURL(&types.MsgSuperfluidDelegate{})
 	typeMsgSuperfluidUndelegate = sdk.MsgTypeURL(&types.MsgSuperfluidUndelegate{})
+	typeMsgSuperfluidRedelegate = sdk.MsgTypeURL(&types.MsgSuperfluidRedelegate{})
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights.
@@ -45,7 +46,7 @@ func WeightedOperations(
 	var (
 		weightMsgSuperfluidDelegate   int
 		weightMsgSuperfluidUndelegate int
-		// weightMsgSuperfluidRedelegate int
+		weightMsgSuperfluidRedelegate int
 	)
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	protoCdc := codec.NewProtoCodec(interfaceRegistry)
@@ -62,11 +63,11 @@ func WeightedOperations(
 		},
 	)
 
-	// appParams.GetOrGenerate(OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
-	// 	func(_ *rand.Rand) {
-	// 		weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
-	// 	},
-	// )
+	appParams.GetOrGenerate(OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
+		},
+	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -77,10 +78,10 @@ func WeightedOperations(
 			weightMsgSuperfluidUndelegate,
 			SimulateMsgSuperfluidUndelegate(protoCdc, ak, bk, lk, k),
 		),
-		// simulation.NewWeightedOperation(
-		// 	weightMsgSuperfluidRedelegate,
-		// 	SimulateMsgSuperfluidRedelegate(ak, bk, sk, lk, k),
-		// ),
+		simulation.NewWeightedOperation(
+			weightMsgSuperfluidRedelegate,
+			SimulateMsgSuperfluidRedelegate(protoCdc, ak, bk, sk, lk, k),
+		),
 	}
 }
 
@@ -158,41 +159,53 @@ func SimulateMsgSuperfluidUndelegate(cdc *codec.ProtoCodec, ak stakingtypes.Acco
 	}
 }
 
-// func SimulateMsgSuperfluidRedelegate(ak stakingtypes.AccountKeeper, bk osmosimtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
-// 	return func(
-// 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-// 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-// 		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-// 		// select random validator
-// 		validator := RandomValidator(ctx, r, sk)
-// 		if validator == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
-// 		}
-
-// 		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
-// 		if lock == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
-// 		}
-
-// 		if k.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).Empty() {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
-// 		}
-
-// 		msg := types.MsgSuperfluidRedelegate{
-// 			Sender:     lock.Owner,
-// 			LockId:     lock.ID,
-// 			NewValAddr: validator.OperatorAddress,
-// 		}
-
-// 		txGen := testutil.MakeTestEncodingConfig().TxConfig
-// 		return osmosimtypes.GenAndDeliverTxWithRandFees(
-// 			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
-// 	}
-// }
+func SimulateMsgSuperfluidRedelegate(cdc *codec.ProtoCodec, ak stakingtypes.AccountKeeper, bk osmosimtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// select random validator
+		validator := RandomValidator(ctx, r, sk)
+		if validator == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
+		}
+
+		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
+		if lock == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
+		}
+
+		intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lock.ID)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
+		}
+
+		if intermediaryAcc.ValAddr == validator.OperatorAddress {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is already delegated to the validator"), nil, nil
+		}
+
+		if record, found := k.GetLockRedelegationRecord(ctx, lock.ID); found && record.CompletionTime.After(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock has a redelegation in progress"), nil, nil
+		}
+
+		msg := types.MsgSuperfluidRedelegate{
+			Sender:     lock.Owner,
+			LockId:     lock.ID,
+			NewValAddr: validator.OperatorAddress,
+		}
+
+		opMsg, err := osmosimtypes.GenerateAndDeliverTx(r, app, ctx, chainID, cdc, ak, bk, simAccount, types.ModuleName, &msg, typeMsgSuperfluidRedelegate, false)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSuperfluidRedelegate, "unable to generate and deliver tx"), nil, err
+		}
+
+		return opMsg, nil, nil
+	}
+}
 
 func RandomLockAndAccount(ctx sdk.Context, r *rand.Rand, lk types.LockupKeeper, accs []simtypes.Account) (*lockuptypes.PeriodLock, simtypes.Account) {
 	simAccount, _ := simtypes.RandomAcc(r, accs)
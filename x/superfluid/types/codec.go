@@ -11,6 +11,7 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSuperfluidDelegate{}, "osmosis/superfluid-delegate", nil)
 	cdc.RegisterConcrete(&MsgSuperfluidUndelegate{}, "osmosis/superfluid-undelegate", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidRedelegate{}, "osmosis/superfluid-redelegate", nil)
 	cdc.RegisterConcrete(&MsgLockAndSuperfluidDelegate{}, "osmosis/lock-and-superfluid-delegate", nil)
 	cdc.RegisterConcrete(&MsgSuperfluidUnbondLock{}, "osmosis/superfluid-unbond-lock", nil)
 	cdc.RegisterConcrete(&MsgSuperfluidUndelegateAndUnbondLock{}, "osmosis/sf-undelegate-and-unbond-lock", nil)
@@ -29,6 +30,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSuperfluidDelegate{},
 		&MsgSuperfluidUndelegate{},
+		&MsgSuperfluidRedelegate{},
 		&MsgLockAndSuperfluidDelegate{},
 		&MsgSuperfluidUnbondLock{},
 		&MsgSuperfluidUndelegateAndUnbondLock{},
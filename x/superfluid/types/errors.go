@@ -24,6 +24,9 @@ var (
 
 	ErrNonSuperfluidAsset = errorsmod.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
 
+	ErrRedelegationInProgress     = errorsmod.Register(ModuleName, 11, "lockup has a superfluid redelegation in progress")
+	ErrRedelegationAmountTooSmall = errorsmod.Register(ModuleName, 12, "lockup osmo equivalent is below the minimum for superfluid redelegation")
+
 	ErrPoolNotWhitelisted   = errorsmod.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = errorsmod.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = errorsmod.Register(ModuleName, 43, "lock has more than one asset")
@@ -7,6 +7,7 @@ const (
 	TypeEvtSuperfluidDelegate                           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation                 = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate                         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate                         = "superfluid_redelegate"
 	TypeEvtSuperfluidUnbondLock                         = "superfluid_unbond_lock"
 	TypeEvtSuperfluidUndelegateAndUnbondLock            = "superfluid_undelegate_and_unbond_lock"
 	TypeEvtAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
@@ -33,5 +34,7 @@ const (
 	AttributeLockAmount          = "lock_amount"
 	AttributeLockDenom           = "lock_denom"
 	AttributeValidator           = "validator"
+	AttributeSrcValidator        = "source_validator"
+	AttributeCompletionTime      = "completion_time"
 	AttributeAmount              = "amount"
 )
@@ -80,6 +80,7 @@ type StakingKeeper interface {
 	ValidateUnbondAmount(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt osmomath.Int) (shares osmomath.Dec, err error)
 	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt osmomath.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (newShares osmomath.Dec, err error)
 	InstantUndelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount osmomath.Dec) (sdk.Coins, error)
+	BeginRedelegation(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount osmomath.Dec) (completionTime time.Time, err error)
 	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, err error)
 	UnbondingTime(ctx context.Context) (time.Duration, error)
 	GetParams(ctx context.Context) (stakingtypes.Params, error)
@@ -109,6 +110,8 @@ type IncentivesKeeper interface {
 type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 	NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error)
+	GetParams(ctx sdk.Context) epochstypes.Params
+	GetBlockEpochWorkBudget(ctx sdk.Context) epochstypes.EpochWorkBudget
 }
 
 type ConcentratedKeeper interface {
@@ -33,5 +33,10 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if gs.PendingRefresh != nil {
+		if err := gs.PendingRefresh.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -36,6 +36,12 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// lock_redelegation_records are the superfluid redelegations that have not
+	// completed yet.
+	LockRedelegationRecords []LockRedelegationRecord `protobuf:"bytes,6,rep,name=lock_redelegation_records,json=lockRedelegationRecords,proto3" json:"lock_redelegation_records"`
+	// pending_refresh is the unfinished refresh of the intermediary accounts'
+	// delegation amounts for an ended epoch, if any.
+	PendingRefresh *PendingRefresh `protobuf:"bytes,7,opt,name=pending_refresh,json=pendingRefresh,proto3" json:"pending_refresh,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +112,20 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetLockRedelegationRecords() []LockRedelegationRecord {
+	if m != nil {
+		return m.LockRedelegationRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRefresh() *PendingRefresh {
+	if m != nil {
+		return m.PendingRefresh
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +133,36 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x41, 0x86, 0xe2, 0xa0, 0x0d, 0x26, 0x15, 0x63, 0x21, 0xb2, 0xb0, 0xd8, 0x06,
-	0x48, 0xd4, 0x15, 0x8c, 0x31, 0x24, 0x1a, 0x09, 0x24, 0x0e, 0x2e, 0xcd, 0x51, 0xce, 0x7a, 0xb1,
-	0xed, 0xd5, 0x7b, 0x57, 0x02, 0x1f, 0xc0, 0x9d, 0x8f, 0xc5, 0xc8, 0xe8, 0x64, 0x0c, 0x7c, 0x11,
-	0xd3, 0xf6, 0x2c, 0x28, 0xd5, 0xed, 0xb5, 0xef, 0xf7, 0x7f, 0xbf, 0x77, 0xc9, 0x53, 0xaa, 0x14,
-	0x3c, 0x0a, 0x04, 0x4c, 0x08, 0x03, 0xcc, 0x9e, 0xdc, 0x90, 0x8c, 0x4c, 0x07, 0xfb, 0x18, 0x08,
-	0x18, 0x01, 0xa3, 0x9c, 0xaa, 0xaa, 0x20, 0x8c, 0x35, 0x51, 0x2e, 0x39, 0xd4, 0xa1, 0x71, 0xdb,
-	0x8c, 0xaa, 0x84, 0x2c, 0xd7, 0x32, 0x66, 0xad, 0x4b, 0x01, 0x55, 0x32, 0xa0, 0x00, 0x31, 0xe4,
-	0x09, 0xdf, 0xe9, 0x2c, 0xaf, 0xec, 0xdd, 0x24, 0x1b, 0x0c, 0x38, 0xe2, 0x58, 0xbd, 0x54, 0x0a,
-	0x09, 0xa0, 0xc9, 0x55, 0xb9, 0x5e, 0x6c, 0x96, 0x8d, 0xed, 0x8d, 0x8c, 0x5e, 0x4c, 0x74, 0xf2,
-	0xf3, 0x8f, 0x8a, 0xd4, 0x17, 0xbc, 0xfa, 0xa0, 0x1c, 0xac, 0x11, 0x0b, 0x01, 0x60, 0x0e, 0xda,
-	0x4e, 0x35, 0x57, 0x2f, 0x36, 0x6b, 0x59, 0x43, 0x06, 0x69, 0xd9, 0x8e, 0x58, 0x31, 0x6d, 0x1f,
-	0x7e, 0xfe, 0x06, 0x75, 0xa2, 0x1c, 0x47, 0x69, 0x0b, 0xbf, 0x86, 0x64, 0x8c, 0x5c, 0xec, 0x73,
-	0xcb, 0x0b, 0x5d, 0x4e, 0x02, 0x97, 0x60, 0x06, 0x5a, 0x2e, 0x36, 0x34, 0xb3, 0x0c, 0xf7, 0xe0,
-	0xd1, 0xeb, 0x34, 0x75, 0x97, 0x86, 0xfa, 0xd8, 0xa6, 0x6c, 0x24, 0x84, 0x47, 0xf4, 0x0f, 0x0a,
-	0x54, 0x57, 0x39, 0x24, 0x3e, 0xc7, 0xcc, 0xc3, 0x23, 0x82, 0xd8, 0xd4, 0x42, 0xb6, 0x4d, 0x43,
-	0x9f, 0x83, 0x96, 0x8f, 0x9d, 0x8d, 0xff, 0x5f, 0xd5, 0xdd, 0x88, 0xb6, 0x93, 0xa4, 0x50, 0x96,
-	0xc8, 0x76, 0x0b, 0xd4, 0x37, 0x59, 0xa9, 0x44, 0x8d, 0x5f, 0x36, 0xcb, 0xa6, 0xbe, 0x8f, 0x6d,
-	0x4e, 0xa8, 0x0f, 0xda, 0x6e, 0x2c, 0xbe, 0xc8, 0x12, 0xdf, 0x52, 0xfb, 0xa5, 0x9b, 0x25, 0xbd,
-	0x4a, 0xf3, 0x42, 0x7f, 0xb2, 0x61, 0xd9, 0x62, 0xa0, 0xd3, 0x9b, 0x2f, 0x75, 0x79, 0xb1, 0xd4,
-	0xe5, 0xcf, 0xa5, 0x2e, 0xcf, 0x56, 0xba, 0xb4, 0x58, 0xe9, 0xd2, 0xfb, 0x4a, 0x97, 0x1e, 0xcf,
-	0x1d, 0xc2, 0x9f, 0xc3, 0xa1, 0x61, 0x53, 0xcf, 0x14, 0x1b, 0x9c, 0xb9, 0x68, 0x08, 0xdf, 0x1f,
-	0xe6, 0xb8, 0xd5, 0x30, 0x27, 0x9b, 0xb7, 0xc6, 0xa7, 0x01, 0x86, 0x61, 0x21, 0xbe, 0xb5, 0xd6,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x34, 0x8d, 0x44, 0xff, 0x02, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0xb6, 0x5d, 0x21, 0x15, 0x7f, 0x84, 0x8a, 0xe9, 0x8a, 0xd9, 0xa5, 0xbd, 0x14,
+	0xc1, 0x84, 0x6e, 0x41, 0xbd, 0xb6, 0x22, 0x52, 0x54, 0x2c, 0x29, 0x78, 0xf0, 0x12, 0x66, 0x27,
+	0xaf, 0xe9, 0xd0, 0xc9, 0x4c, 0x9c, 0x37, 0x29, 0xed, 0x1f, 0xe0, 0xdd, 0x3f, 0xab, 0xe0, 0xa5,
+	0x47, 0x4f, 0x22, 0xbb, 0xff, 0x88, 0x64, 0x32, 0xee, 0x0f, 0x77, 0xd6, 0xdb, 0x24, 0xef, 0xf3,
+	0x7d, 0x9f, 0x37, 0xc3, 0x8c, 0x3f, 0x90, 0x58, 0x4a, 0x64, 0x98, 0x60, 0x5d, 0x81, 0x3a, 0xe3,
+	0x35, 0xcb, 0x93, 0x02, 0x04, 0x20, 0xc3, 0xb8, 0x52, 0x52, 0xcb, 0x20, 0xb0, 0x44, 0x3c, 0x23,
+	0x7a, 0x5b, 0x85, 0x2c, 0xa4, 0x29, 0x27, 0xcd, 0xaa, 0x25, 0x7b, 0xbb, 0x8e, 0x5e, 0xb3, 0xa5,
+	0x85, 0xfa, 0x0e, 0xa8, 0x22, 0x8a, 0x94, 0xd6, 0xb7, 0xf3, 0x63, 0xc3, 0xbf, 0xf7, 0xae, 0x9d,
+	0xe0, 0x54, 0x13, 0x0d, 0xc1, 0x6b, 0xbf, 0xdb, 0x02, 0xa1, 0x37, 0xf0, 0xf6, 0x36, 0x87, 0xbd,
+	0x78, 0x79, 0xa2, 0xf8, 0xc4, 0x10, 0x47, 0xeb, 0x37, 0xbf, 0xfa, 0x9d, 0xd4, 0xf2, 0xc1, 0x67,
+	0xff, 0xd1, 0x0c, 0xc9, 0x08, 0x22, 0x68, 0x0c, 0xef, 0x0c, 0xd6, 0xf6, 0x36, 0x87, 0xbb, 0xae,
+	0x26, 0xa7, 0xd3, 0xe5, 0x61, 0xc3, 0xda, 0x6e, 0x0f, 0x71, 0xf1, 0x37, 0x06, 0x57, 0xfe, 0xd3,
+	0x26, 0x9d, 0xc1, 0xd7, 0x9a, 0x5d, 0x12, 0x0e, 0x42, 0x67, 0x65, 0xcd, 0x35, 0xab, 0x38, 0x03,
+	0x85, 0xe1, 0x9a, 0x31, 0x0c, 0x5d, 0x86, 0x4f, 0x58, 0xca, 0xb7, 0xd3, 0xd4, 0xc7, 0x69, 0x28,
+	0x05, 0x2a, 0x55, 0x6e, 0x85, 0xdb, 0x72, 0x05, 0x85, 0x01, 0xf7, 0x1f, 0x33, 0xa1, 0x41, 0x95,
+	0x90, 0x33, 0xa2, 0xae, 0x33, 0x42, 0xa9, 0xac, 0x85, 0xc6, 0x70, 0xdd, 0x38, 0xf7, 0xff, 0xbf,
+	0xab, 0xe3, 0xb9, 0xe8, 0x61, 0x9b, 0xb4, 0xca, 0x2d, 0xb6, 0x5c, 0xc2, 0xe0, 0x9b, 0xe7, 0xf7,
+	0x9b, 0xc2, 0x3f, 0xb6, 0x8c, 0x4a, 0x21, 0x80, 0x6a, 0x26, 0x05, 0x86, 0x1b, 0x46, 0xfc, 0xca,
+	0x25, 0xfe, 0x20, 0xe9, 0xc5, 0xb1, 0x4b, 0xfa, 0x66, 0x9a, 0xb7, 0xfa, 0x67, 0x73, 0x96, 0x25,
+	0xa6, 0xd9, 0xf5, 0x36, 0x97, 0xf4, 0x22, 0x53, 0x90, 0x03, 0x87, 0x82, 0x34, 0x7f, 0x33, 0x65,
+	0x8e, 0x0c, 0xc3, 0xae, 0x19, 0xe0, 0xf9, 0xaa, 0x01, 0xd2, 0xb9, 0xcc, 0xc2, 0x29, 0x3f, 0xe1,
+	0xce, 0x2a, 0x06, 0xef, 0xfd, 0x07, 0x15, 0x88, 0x9c, 0x89, 0x22, 0x53, 0x70, 0xa6, 0x00, 0xcf,
+	0xc3, 0xbb, 0xe6, 0xe2, 0xed, 0x38, 0x2f, 0x5e, 0x8b, 0xa6, 0x2d, 0x99, 0xde, 0xaf, 0x16, 0xbe,
+	0x8f, 0x4e, 0x6e, 0xc6, 0x91, 0x77, 0x3b, 0x8e, 0xbc, 0xdf, 0xe3, 0xc8, 0xfb, 0x3e, 0x89, 0x3a,
+	0xb7, 0x93, 0xa8, 0xf3, 0x73, 0x12, 0x75, 0xbe, 0xbc, 0x2c, 0x98, 0x3e, 0xaf, 0x47, 0x31, 0x95,
+	0x65, 0x62, 0xfb, 0xbe, 0xe0, 0x64, 0x84, 0x7f, 0x3f, 0x92, 0xcb, 0x83, 0xfd, 0xe4, 0x6a, 0xfe,
+	0x99, 0xe8, 0xeb, 0x0a, 0x70, 0xd4, 0x35, 0xcf, 0xe4, 0xe0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xbe, 0xe0, 0x26, 0xce, 0xba, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +185,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingRefresh != nil {
+		{
+			size, err := m.PendingRefresh.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LockRedelegationRecords) > 0 {
+		for iNdEx := len(m.LockRedelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRedelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +323,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LockRedelegationRecords) > 0 {
+		for _, e := range m.LockRedelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingRefresh != nil {
+		l = m.PendingRefresh.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -479,6 +540,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRedelegationRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockRedelegationRecords = append(m.LockRedelegationRecords, LockRedelegationRecord{})
+			if err := m.LockRedelegationRecords[len(m.LockRedelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefresh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRefresh == nil {
+				m.PendingRefresh = &PendingRefresh{}
+			}
+			if err := m.PendingRefresh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
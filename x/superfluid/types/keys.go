@@ -27,4 +27,10 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixLockRedelegation defines prefix to set the redelegation record of a lockId.
+	KeyPrefixLockRedelegation = []byte{0x07}
+
+	// KeyPendingRefresh defines key to set the unfinished refresh of the intermediary accounts' delegation amounts.
+	KeyPendingRefresh = []byte{0x08}
 )
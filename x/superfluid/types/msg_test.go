@@ -63,6 +63,14 @@ func TestAuthzMsg(t *testing.T) {
 				LockId: 1,
 			},
 		},
+		{
+			name: "MsgSuperfluidRedelegate",
+			msg: &types.MsgSuperfluidRedelegate{
+				Sender:     addr1,
+				LockId:     1,
+				NewValAddr: "valoper1xyz",
+			},
+		},
 		{
 			name: "MsgUnPoolWhitelistedPool",
 			msg: &types.MsgUnPoolWhitelistedPool{
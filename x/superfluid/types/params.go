@@ -13,6 +13,11 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = osmomath.NewDecWithPrec(5, 1) // 50%
+
+	KeyMinRedelegationOsmoAmount = []byte("MinRedelegationOsmoAmount")
+	// DefaultMinRedelegationOsmoAmount is 100 OSMO, so that using up the 7 staking redelegation entries
+	// shared by the locks of a denom between two validators requires locking 700 OSMO.
+	DefaultMinRedelegationOsmoAmount = osmomath.NewInt(100_000_000)
 )
 
 // ParamTable for minting module.
@@ -20,16 +25,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor osmomath.Dec) Params {
+func NewParams(minimumRiskFactor osmomath.Dec, minRedelegationOsmoAmount osmomath.Int) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:         minimumRiskFactor,
+		MinRedelegationOsmoAmount: minRedelegationOsmoAmount,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:         defaultMinimumRiskFactor, // 5%
+		MinRedelegationOsmoAmount: DefaultMinRedelegationOsmoAmount,
 	}
 }
 
@@ -42,6 +49,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyMinRedelegationOsmoAmount, &p.MinRedelegationOsmoAmount, ValidateMinRedelegationOsmoAmount),
 	}
 }
 
@@ -58,6 +66,19 @@ func ValidateMinimumRiskFactor(i interface{}) error {
 	return nil
 }
 
+func ValidateMinRedelegationOsmoAmount(i interface{}) error {
+	v, ok := i.(osmomath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min redelegation osmo amount should not be negative: %s", v.String())
+	}
+
+	return nil
+}
+
 func ValidateUnbondingDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
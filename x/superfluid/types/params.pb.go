@@ -32,6 +32,12 @@ type Params struct {
 	// to counter-balance the staked amount on chain's exposure to various asset
 	// volatilities, and have base staking be 'resistant' to volatility.
 	MinimumRiskFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// min_redelegation_osmo_amount is the minimum OSMO equivalent of a lock for it
+	// to be superfluid redelegated. The redelegations of all the locks of a denom
+	// between two validators share the staking redelegation entries of one
+	// intermediary account, limited to the staking MaxEntries until they complete,
+	// so the minimum makes it costly to use up the entries of the other users.
+	MinRedelegationOsmoAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_redelegation_osmo_amount,json=minRedelegationOsmoAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_redelegation_osmo_amount" yaml:"min_redelegation_osmo_amount"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,24 +80,28 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x9a, 0x8b, 0x2d, 0x00, 0x6c, 0xb2, 0x50,
-	0x21, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76, 0x7c,
-	0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xe3, 0x89, 0x7b,
-	0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x27, 0x83, 0x6d, 0x2e, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0xaa, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x8b, 0x39, 0x4a, 0x41, 0x82, 0x50, 0xd1,
-	0xa0, 0xcc, 0xe2, 0x6c, 0x37, 0xb0, 0x98, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43,
-	0xfd, 0xaa, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x1b, 0xea, 0x57, 0x20, 0x87,
-	0x4f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x57, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x25, 0x6c, 0xc4, 0xc0, 0x42, 0x01, 0x00, 0x00,
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4b, 0xeb, 0x40,
+	0x14, 0xc5, 0x93, 0x2e, 0x0a, 0x2f, 0xbb, 0x97, 0xf7, 0x84, 0x5a, 0x65, 0x22, 0x71, 0xe3, 0xc6,
+	0x0c, 0x52, 0x70, 0xe1, 0xae, 0xa5, 0x08, 0x82, 0x60, 0xc9, 0xd2, 0x4d, 0x98, 0x24, 0xd3, 0xe9,
+	0xd0, 0x4c, 0x6e, 0x9c, 0x3f, 0x62, 0xd7, 0xe2, 0xde, 0x8f, 0xd5, 0x65, 0x97, 0xe2, 0x22, 0x48,
+	0xfb, 0x0d, 0xfa, 0x09, 0xa4, 0xd3, 0xa8, 0x05, 0x75, 0x37, 0xf7, 0x9e, 0xc3, 0xf9, 0x0d, 0xe7,
+	0x7a, 0x01, 0x28, 0x01, 0x8a, 0x2b, 0xac, 0x4c, 0x45, 0xe5, 0xb8, 0x30, 0x3c, 0xc7, 0x15, 0x91,
+	0x44, 0xa8, 0xa8, 0x92, 0xa0, 0xc1, 0xf7, 0x1b, 0x43, 0xf4, 0x65, 0xe8, 0xfe, 0x67, 0xc0, 0xc0,
+	0xca, 0x78, 0xf3, 0xda, 0x3a, 0xbb, 0x88, 0x01, 0xb0, 0x82, 0x62, 0x3b, 0xa5, 0x66, 0x8c, 0x73,
+	0x23, 0x89, 0xe6, 0x50, 0x6e, 0xf5, 0xf0, 0xb1, 0xe5, 0xb5, 0x47, 0x36, 0xda, 0xbf, 0xf3, 0xfe,
+	0x09, 0x5e, 0x72, 0x61, 0x44, 0x22, 0xb9, 0x9a, 0x26, 0x63, 0x92, 0x69, 0x90, 0x1d, 0xf7, 0xc8,
+	0x3d, 0xf9, 0x33, 0xe8, 0xcf, 0xeb, 0xc0, 0x79, 0xad, 0x83, 0x83, 0xcc, 0xa2, 0x55, 0x3e, 0x8d,
+	0x38, 0x60, 0x41, 0xf4, 0x24, 0xba, 0xa6, 0x8c, 0x64, 0xb3, 0x21, 0xcd, 0xd6, 0x75, 0xd0, 0x9d,
+	0x11, 0x51, 0x5c, 0x84, 0x3f, 0xe4, 0x84, 0xf1, 0xdf, 0x66, 0x1b, 0x73, 0x35, 0xbd, 0xb4, 0x3b,
+	0xff, 0xc9, 0xf5, 0x0e, 0x05, 0x2f, 0x13, 0x49, 0x73, 0x5a, 0x50, 0x66, 0x3f, 0x96, 0x6c, 0x00,
+	0x09, 0x11, 0x60, 0x4a, 0xdd, 0x69, 0x59, 0xf8, 0xb0, 0x81, 0xef, 0x7d, 0x87, 0x5f, 0x95, 0x7a,
+	0x5d, 0x07, 0xc7, 0x9f, 0xd8, 0x5f, 0xa3, 0xc2, 0x78, 0x5f, 0xf0, 0x32, 0xde, 0x51, 0x6f, 0x94,
+	0x80, 0xbe, 0xd5, 0x06, 0xa3, 0xf9, 0x12, 0xb9, 0x8b, 0x25, 0x72, 0xdf, 0x96, 0xc8, 0x7d, 0x5e,
+	0x21, 0x67, 0xb1, 0x42, 0xce, 0xcb, 0x0a, 0x39, 0xb7, 0xe7, 0x8c, 0xeb, 0x89, 0x49, 0xa3, 0x0c,
+	0x04, 0x6e, 0x4a, 0x3f, 0x2d, 0x48, 0xaa, 0x3e, 0x06, 0x7c, 0xdf, 0x3b, 0xc3, 0x0f, 0xbb, 0x87,
+	0xd2, 0xb3, 0x8a, 0xaa, 0xb4, 0x6d, 0xeb, 0xed, 0xbd, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x91,
+	0xb6, 0xae, 0xcb, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +124,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinRedelegationOsmoAmount.Size()
+		i -= size
+		if _, err := m.MinRedelegationOsmoAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -146,6 +166,8 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MinRedelegationOsmoAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -218,6 +240,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedelegationOsmoAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRedelegationOsmoAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
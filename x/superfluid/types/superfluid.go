@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -37,3 +39,16 @@ func GetSuperfluidIntermediaryAccountAddr(denom, valAddr string) sdk.AccAddress
 	// We are launching with the address as is, so this will have to be done as a migration in the future.
 	return authtypes.NewModuleAddress(denom + valAddr)
 }
+
+// Validate checks that the pending refresh has an epoch identifier and a valid last intermediary account, if any.
+func (p PendingRefresh) Validate() error {
+	if p.EpochIdentifier == "" {
+		return fmt.Errorf("pending refresh epoch identifier must not be empty")
+	}
+	if p.LastIntermediaryAccount != "" {
+		if _, err := sdk.AccAddressFromBech32(p.LastIntermediaryAccount); err != nil {
+			return fmt.Errorf("pending refresh last intermediary account is invalid: %w", err)
+		}
+	}
+	return nil
+}
@@ -9,18 +9,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/osmosis-labs/osmosis/v31/x/lockup/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -350,6 +353,145 @@ func (m *LockIdIntermediaryAccountConnection) GetIntermediaryAccount() string {
 	return ""
 }
 
+// LockRedelegationRecord is a struct used to indicate a superfluid
+// redelegation of the underlying lock id that has not completed yet.
+// Until the completion time, the lock is slashed for infractions of the source
+// validator and it cannot be redelegated again.
+type LockRedelegationRecord struct {
+	LockId              uint64    `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	SrcValidatorAddress string    `protobuf:"bytes,2,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	DstValidatorAddress string    `protobuf:"bytes,3,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	CompletionTime      time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *LockRedelegationRecord) Reset()         { *m = LockRedelegationRecord{} }
+func (m *LockRedelegationRecord) String() string { return proto.CompactTextString(m) }
+func (*LockRedelegationRecord) ProtoMessage()    {}
+func (*LockRedelegationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
+}
+func (m *LockRedelegationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRedelegationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRedelegationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRedelegationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRedelegationRecord.Merge(m, src)
+}
+func (m *LockRedelegationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRedelegationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRedelegationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRedelegationRecord proto.InternalMessageInfo
+
+func (m *LockRedelegationRecord) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRedelegationRecord) GetSrcValidatorAddress() string {
+	if m != nil {
+		return m.SrcValidatorAddress
+	}
+	return ""
+}
+
+func (m *LockRedelegationRecord) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
+	}
+	return ""
+}
+
+func (m *LockRedelegationRecord) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// PendingRefresh holds the progress of refreshing the delegation amounts of
+// the intermediary accounts for an ended superfluid epoch, whose refresh is
+// split across blocks.
+type PendingRefresh struct {
+	// epoch_identifier is the identifier of the ended epoch.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// epoch_number is the number of the ended epoch.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// last_intermediary_account is the address of the last intermediary account
+	// refreshed, or empty if none was refreshed yet.
+	LastIntermediaryAccount string `protobuf:"bytes,3,opt,name=last_intermediary_account,json=lastIntermediaryAccount,proto3" json:"last_intermediary_account,omitempty"`
+}
+
+func (m *PendingRefresh) Reset()         { *m = PendingRefresh{} }
+func (m *PendingRefresh) String() string { return proto.CompactTextString(m) }
+func (*PendingRefresh) ProtoMessage()    {}
+func (*PendingRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
+}
+func (m *PendingRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRefresh.Merge(m, src)
+}
+func (m *PendingRefresh) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRefresh.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRefresh proto.InternalMessageInfo
+
+func (m *PendingRefresh) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PendingRefresh) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *PendingRefresh) GetLastIntermediaryAccount() string {
+	if m != nil {
+		return m.LastIntermediaryAccount
+	}
+	return ""
+}
+
 type UnpoolWhitelistedPools struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
@@ -358,7 +500,7 @@ func (m *UnpoolWhitelistedPools) Reset()         { *m = UnpoolWhitelistedPools{}
 func (m *UnpoolWhitelistedPools) String() string { return proto.CompactTextString(m) }
 func (*UnpoolWhitelistedPools) ProtoMessage()    {}
 func (*UnpoolWhitelistedPools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{5}
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
 }
 func (m *UnpoolWhitelistedPools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +549,7 @@ func (m *ConcentratedPoolUserPositionRecord) Reset()         { *m = Concentrated
 func (m *ConcentratedPoolUserPositionRecord) String() string { return proto.CompactTextString(m) }
 func (*ConcentratedPoolUserPositionRecord) ProtoMessage()    {}
 func (*ConcentratedPoolUserPositionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{6}
+	return fileDescriptor_79d3c29d82dbb734, []int{8}
 }
 func (m *ConcentratedPoolUserPositionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,6 +627,8 @@ func init() {
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
+	proto.RegisterType((*LockRedelegationRecord)(nil), "osmosis.superfluid.LockRedelegationRecord")
+	proto.RegisterType((*PendingRefresh)(nil), "osmosis.superfluid.PendingRefresh")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
 }
@@ -494,59 +638,69 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0xb4, 0x12, 0x42, 0xbd, 0x39, 0x29, 0x48, 0x46, 0xa1, 0x58, 0x6b, 0x2a,
-	0x10, 0x97, 0xd5, 0x78, 0xe7, 0x75, 0x3d, 0xf2, 0xec, 0xce, 0x76, 0x67, 0xd6, 0xe0, 0x1b, 0x07,
-	0x0e, 0x3d, 0xf2, 0x27, 0x54, 0xe2, 0xc6, 0x95, 0x7f, 0xa2, 0xc7, 0x4a, 0x5c, 0x10, 0x87, 0x80,
-	0x92, 0x0b, 0xe7, 0xfe, 0x05, 0x68, 0x66, 0x7f, 0x78, 0xd3, 0x38, 0x42, 0x5c, 0xe8, 0xc9, 0x33,
-	0xef, 0x7b, 0xf3, 0xde, 0xf7, 0xcd, 0xfb, 0x3c, 0x8b, 0x6e, 0x71, 0x11, 0x73, 0x41, 0x85, 0x27,
-	0xf2, 0x14, 0xb2, 0xa7, 0x2c, 0xa7, 0xa4, 0xb1, 0x74, 0xd3, 0x8c, 0x4b, 0x6e, 0x9a, 0x65, 0x92,
-	0xbb, 0x44, 0x76, 0xb7, 0x22, 0x1e, 0x71, 0x0d, 0x7b, 0x6a, 0x55, 0x64, 0xee, 0x5a, 0x11, 0xe7,
-	0x11, 0x03, 0x4f, 0xef, 0x26, 0xf9, 0x53, 0x8f, 0xe4, 0x19, 0x96, 0x94, 0x27, 0x25, 0x6e, 0xbf,
-	0x89, 0x4b, 0x1a, 0x83, 0x90, 0x38, 0x4e, 0xab, 0x02, 0xa1, 0xee, 0xe5, 0x4d, 0xb0, 0x00, 0x6f,
-	0x7e, 0x30, 0x01, 0x89, 0x0f, 0xbc, 0x90, 0xd3, 0xaa, 0xc0, 0x4e, 0xc5, 0x97, 0xf1, 0x70, 0x96,
-	0xa7, 0xfa, 0xa7, 0x80, 0x9c, 0x05, 0x7a, 0x6f, 0x5c, 0xf3, 0x1b, 0x08, 0x01, 0xd2, 0xdc, 0x42,
-	0x57, 0x08, 0x24, 0x3c, 0xde, 0x36, 0xf6, 0x8d, 0xfe, 0x86, 0x5f, 0x6c, 0xcc, 0xcf, 0x10, 0xc2,
-	0x0a, 0x0e, 0xe4, 0x22, 0x85, 0xed, 0xf6, 0xbe, 0xd1, 0xbf, 0x76, 0xff, 0xb6, 0x7b, 0x51, 0xa3,
-	0xfb, 0x46, 0xb9, 0xaf, 0x16, 0x29, 0xf8, 0x1b, 0xb8, 0x5a, 0x3e, 0xbc, 0xfa, 0xfc, 0x85, 0xdd,
-	0xfa, 0xfb, 0x85, 0x6d, 0x38, 0x33, 0xb4, 0xb7, 0xcc, 0x1d, 0x26, 0x12, 0xb2, 0x18, 0x08, 0xc5,
-	0xd9, 0x62, 0x10, 0x86, 0x3c, 0x4f, 0x2e, 0x23, 0xb2, 0x83, 0xae, 0xce, 0x31, 0x0b, 0x30, 0x21,
-	0x99, 0xa6, 0xb1, 0xe1, 0xaf, 0xcf, 0x31, 0x1b, 0x10, 0x92, 0x29, 0x28, 0xc2, 0x79, 0x04, 0x01,
-	0x25, 0xdb, 0x9d, 0x7d, 0xa3, 0xdf, 0xf5, 0xd7, 0xf5, 0x7e, 0x48, 0x9c, 0x5f, 0x0d, 0x64, 0x7d,
-	0x29, 0x62, 0xfe, 0xe9, 0xb3, 0x9c, 0xce, 0x31, 0x83, 0x44, 0x7e, 0x91, 0x33, 0x49, 0x53, 0x46,
-	0x21, 0xf3, 0x21, 0xe4, 0x19, 0x31, 0x3f, 0x44, 0xef, 0x40, 0xca, 0xc3, 0x69, 0x90, 0xe4, 0xf1,
-	0x04, 0x32, 0xdd, 0xb5, 0xe3, 0x6f, 0xea, 0xd8, 0x63, 0x1d, 0x5a, 0x32, 0x6a, 0x37, 0x19, 0x7d,
-	0x83, 0x50, 0x5c, 0x17, 0xd3, 0x8d, 0x37, 0x0e, 0x3f, 0x79, 0x79, 0x62, 0xb7, 0xfe, 0x38, 0xb1,
-	0x3f, 0x28, 0x46, 0x23, 0xc8, 0xcc, 0xa5, 0xdc, 0x8b, 0xb1, 0x9c, 0xba, 0xc7, 0x10, 0xe1, 0x70,
-	0xf1, 0x08, 0xc2, 0xd7, 0x27, 0xf6, 0xf5, 0x05, 0x8e, 0xd9, 0x43, 0x67, 0x79, 0xdc, 0xf1, 0x1b,
-	0xb5, 0x9c, 0xd7, 0x6d, 0xb4, 0xbb, 0xbc, 0xa3, 0x47, 0xc0, 0x20, 0xd2, 0xc6, 0x28, 0x19, 0xdf,
-	0x45, 0xd7, 0x49, 0x11, 0xe3, 0x99, 0xbe, 0x10, 0x10, 0xa2, 0xbc, 0xac, 0x5e, 0x0d, 0x0c, 0x8a,
-	0xb8, 0x4a, 0x9e, 0x63, 0x46, 0xc9, 0xb9, 0xe4, 0x42, 0x47, 0xaf, 0x06, 0xaa, 0xe4, 0xef, 0xea,
-	0xca, 0x94, 0x27, 0x01, 0x8e, 0xd5, 0x3c, 0xb4, 0xb2, 0xcd, 0xfb, 0x3b, 0x6e, 0x21, 0xc9, 0x55,
-	0x6e, 0x73, 0x4b, 0xb7, 0xb9, 0x47, 0x9c, 0x26, 0x87, 0x9e, 0x12, 0xfd, 0xcb, 0x9f, 0xf6, 0xed,
-	0x88, 0xca, 0x69, 0x3e, 0x71, 0x43, 0x1e, 0x7b, 0xa5, 0x35, 0x8b, 0x9f, 0x7b, 0x82, 0xcc, 0x3c,
-	0x65, 0x20, 0xa1, 0x0f, 0xd4, 0x2c, 0x29, 0x4f, 0x06, 0xba, 0x87, 0xf9, 0x83, 0x81, 0xb6, 0xa1,
-	0x9e, 0x51, 0x20, 0x24, 0x9e, 0x01, 0xa9, 0x08, 0x74, 0xff, 0x8d, 0xc0, 0xdd, 0xff, 0xd2, 0xfc,
-	0xe6, 0xb2, 0xcf, 0x58, 0xb7, 0x29, 0x28, 0x38, 0xcf, 0xd0, 0xad, 0x63, 0x1e, 0xce, 0x86, 0xab,
-	0x3c, 0x79, 0xc4, 0x93, 0x04, 0x42, 0xc5, 0xd7, 0x7c, 0x1f, 0xad, 0xab, 0xff, 0x91, 0xf2, 0x9a,
-	0xa1, 0xbd, 0xb6, 0xc6, 0xf4, 0x29, 0xf3, 0x00, 0x6d, 0xd1, 0xc6, 0xc9, 0x00, 0x17, 0x47, 0xcb,
-	0xbb, 0xbe, 0x41, 0x2f, 0x56, 0x75, 0xee, 0xa0, 0x9b, 0x4f, 0x92, 0x94, 0x73, 0xf6, 0xf5, 0x94,
-	0x4a, 0x60, 0x54, 0x48, 0x20, 0x23, 0xce, 0x99, 0x30, 0x7b, 0xa8, 0x43, 0x89, 0x1a, 0x6a, 0xa7,
-	0xdf, 0xf5, 0xd5, 0xd2, 0xf9, 0xad, 0x83, 0x9c, 0x23, 0x9e, 0x84, 0x90, 0xc8, 0x0c, 0x97, 0x79,
-	0x4f, 0x04, 0x64, 0x23, 0x2e, 0xe8, 0x79, 0x6f, 0x5c, 0x1c, 0xb7, 0x71, 0xc9, 0xb8, 0x6d, 0xb4,
-	0x99, 0x96, 0xc7, 0x95, 0x9e, 0xb6, 0xd6, 0x83, 0xaa, 0xd0, 0x90, 0x34, 0xc5, 0x76, 0xce, 0x89,
-	0xfd, 0x1c, 0x5d, 0x13, 0x8b, 0x44, 0x4e, 0x41, 0xd2, 0x30, 0x50, 0xb1, 0x72, 0x48, 0x7b, 0xf5,
-	0xd3, 0x50, 0xbc, 0x39, 0xee, 0xb8, 0xca, 0x52, 0x77, 0x7b, 0xd8, 0x55, 0x4e, 0xf1, 0xdf, 0x15,
-	0xcd, 0xe0, 0x6a, 0xd3, 0x5d, 0x79, 0xdb, 0xa6, 0x5b, 0xfb, 0x3f, 0x4c, 0x77, 0xe7, 0x47, 0x03,
-	0xdd, 0x58, 0xf1, 0x72, 0x9a, 0x7b, 0x68, 0x67, 0x45, 0xf8, 0x31, 0x96, 0x74, 0x0e, 0xbd, 0x96,
-	0x69, 0x35, 0xdf, 0x87, 0x1a, 0x3e, 0x1e, 0x8d, 0xa7, 0x38, 0x83, 0x9e, 0x61, 0xf6, 0xd1, 0x47,
-	0x2b, 0xf0, 0xa6, 0x7d, 0x8a, 0xcc, 0xf6, 0x6e, 0xf7, 0xf9, 0xcf, 0x56, 0xeb, 0x70, 0xf4, 0xf2,
-	0xd4, 0x32, 0x5e, 0x9d, 0x5a, 0xc6, 0x5f, 0xa7, 0x96, 0xf1, 0xd3, 0x99, 0xd5, 0x7a, 0x75, 0x66,
-	0xb5, 0x7e, 0x3f, 0xb3, 0x5a, 0xdf, 0x7e, 0xdc, 0x50, 0x58, 0x8e, 0xf6, 0x1e, 0xc3, 0x13, 0x51,
-	0x6d, 0xbc, 0xf9, 0x83, 0x03, 0xef, 0xfb, 0xe6, 0x17, 0x51, 0xab, 0x9e, 0xac, 0xe9, 0xef, 0xcc,
-	0x83, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x97, 0x95, 0xfc, 0xb2, 0x34, 0x07, 0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x6e, 0x52, 0x4f, 0x20, 0x71, 0x37, 0x69, 0x1a, 0x1b, 0x65, 0x37, 0x6c, 0x91,
+	0x6a, 0x5a, 0x75, 0x57, 0x49, 0x25, 0x84, 0x72, 0xb3, 0x53, 0x90, 0x8c, 0x42, 0xb1, 0x36, 0x2d,
+	0x20, 0x2e, 0xab, 0xf1, 0xce, 0x64, 0x3d, 0xf2, 0xee, 0xce, 0x76, 0x67, 0xd6, 0xe0, 0x1b, 0x42,
+	0x1c, 0x7a, 0xec, 0x99, 0x53, 0x25, 0x6e, 0x5c, 0xf9, 0x12, 0x3d, 0x56, 0xe2, 0x82, 0x38, 0xa4,
+	0x28, 0xb9, 0x70, 0xce, 0x27, 0x40, 0x33, 0xfb, 0xc7, 0x1b, 0xdb, 0x11, 0xe2, 0x02, 0x27, 0xcf,
+	0xbc, 0xff, 0x6f, 0x7e, 0xbf, 0xf7, 0xd6, 0xe0, 0x2e, 0x65, 0x01, 0x65, 0x84, 0x59, 0x2c, 0x89,
+	0x70, 0x7c, 0xea, 0x27, 0x04, 0x95, 0x8e, 0x66, 0x14, 0x53, 0x4e, 0x55, 0x35, 0x33, 0x32, 0x67,
+	0x9a, 0xf6, 0x96, 0x47, 0x3d, 0x2a, 0xd5, 0x96, 0x38, 0xa5, 0x96, 0x6d, 0xcd, 0xa3, 0xd4, 0xf3,
+	0xb1, 0x25, 0x6f, 0xc3, 0xe4, 0xd4, 0x42, 0x49, 0x0c, 0x39, 0xa1, 0x61, 0xa6, 0xd7, 0xe7, 0xf5,
+	0x9c, 0x04, 0x98, 0x71, 0x18, 0x44, 0x79, 0x00, 0x57, 0xe6, 0xb2, 0x86, 0x90, 0x61, 0x6b, 0xb2,
+	0x3f, 0xc4, 0x1c, 0xee, 0x5b, 0x2e, 0x25, 0x79, 0x80, 0x56, 0x5e, 0xaf, 0x4f, 0xdd, 0x71, 0x12,
+	0xc9, 0x9f, 0x54, 0x65, 0x4c, 0xc1, 0xc6, 0x49, 0x51, 0x5f, 0x97, 0x31, 0xcc, 0xd5, 0x2d, 0x70,
+	0x03, 0xe1, 0x90, 0x06, 0x3b, 0xca, 0x9e, 0xd2, 0x69, 0xd8, 0xe9, 0x45, 0xfd, 0x14, 0x00, 0x28,
+	0xd4, 0x0e, 0x9f, 0x46, 0x78, 0xa7, 0xba, 0xa7, 0x74, 0xd6, 0x0f, 0xee, 0x99, 0x8b, 0x3d, 0x9a,
+	0x73, 0xe1, 0x9e, 0x4e, 0x23, 0x6c, 0x37, 0x60, 0x7e, 0x3c, 0xbc, 0xf9, 0xe2, 0x95, 0x5e, 0xf9,
+	0xeb, 0x95, 0xae, 0x18, 0x63, 0xb0, 0x3b, 0xb3, 0xed, 0x87, 0x1c, 0xc7, 0x01, 0x46, 0x04, 0xc6,
+	0xd3, 0xae, 0xeb, 0xd2, 0x24, 0xbc, 0xae, 0x90, 0x16, 0xb8, 0x39, 0x81, 0xbe, 0x03, 0x11, 0x8a,
+	0x65, 0x19, 0x0d, 0x7b, 0x75, 0x02, 0xfd, 0x2e, 0x42, 0xb1, 0x50, 0x79, 0x30, 0xf1, 0xb0, 0x43,
+	0xd0, 0x4e, 0x6d, 0x4f, 0xe9, 0xd4, 0xed, 0x55, 0x79, 0xef, 0x23, 0xe3, 0x57, 0x05, 0x68, 0x5f,
+	0xb0, 0x80, 0x7e, 0xf2, 0x3c, 0x21, 0x13, 0xe8, 0xe3, 0x90, 0x7f, 0x9e, 0xf8, 0x9c, 0x44, 0x3e,
+	0xc1, 0xb1, 0x8d, 0x5d, 0x1a, 0x23, 0xf5, 0x7d, 0xf0, 0x0e, 0x8e, 0xa8, 0x3b, 0x72, 0xc2, 0x24,
+	0x18, 0xe2, 0x58, 0x66, 0xad, 0xd9, 0x6b, 0x52, 0xf6, 0x44, 0x8a, 0x66, 0x15, 0x55, 0xcb, 0x15,
+	0x7d, 0x0d, 0x40, 0x50, 0x04, 0x93, 0x89, 0x1b, 0xbd, 0x8f, 0x5f, 0x9f, 0xe9, 0x95, 0x3f, 0xce,
+	0xf4, 0xf7, 0x52, 0x68, 0x18, 0x1a, 0x9b, 0x84, 0x5a, 0x01, 0xe4, 0x23, 0xf3, 0x18, 0x7b, 0xd0,
+	0x9d, 0x3e, 0xc6, 0xee, 0xe5, 0x99, 0x7e, 0x6b, 0x0a, 0x03, 0xff, 0xd0, 0x98, 0xb9, 0x1b, 0x76,
+	0x29, 0x96, 0x71, 0x59, 0x05, 0xed, 0xd9, 0x1b, 0x3d, 0xc6, 0x3e, 0xf6, 0x24, 0x31, 0xb2, 0x8a,
+	0x1f, 0x80, 0x5b, 0x28, 0x95, 0xd1, 0x58, 0x3e, 0x08, 0x66, 0x2c, 0x7b, 0xac, 0x66, 0xa1, 0xe8,
+	0xa6, 0x72, 0x61, 0x3c, 0x81, 0x3e, 0x41, 0x57, 0x8c, 0xd3, 0x3e, 0x9a, 0x85, 0x22, 0x37, 0xfe,
+	0xb6, 0x88, 0x4c, 0x68, 0xe8, 0xc0, 0x40, 0xe0, 0x21, 0x3b, 0x5b, 0x3b, 0x68, 0x99, 0x69, 0x4b,
+	0xa6, 0x60, 0x9b, 0x99, 0xb1, 0xcd, 0x3c, 0xa2, 0x24, 0xec, 0x59, 0xa2, 0xe9, 0x5f, 0xde, 0xea,
+	0xf7, 0x3c, 0xc2, 0x47, 0xc9, 0xd0, 0x74, 0x69, 0x60, 0x65, 0xd4, 0x4c, 0x7f, 0x1e, 0x32, 0x34,
+	0xb6, 0x04, 0x81, 0x98, 0x74, 0x28, 0xaa, 0x24, 0x34, 0xec, 0xca, 0x1c, 0xea, 0xf7, 0x0a, 0xd8,
+	0xc1, 0x05, 0x46, 0x0e, 0xe3, 0x70, 0x8c, 0x51, 0x5e, 0x40, 0xfd, 0x9f, 0x0a, 0x78, 0xf0, 0x6f,
+	0x92, 0x6f, 0xcf, 0xf2, 0x9c, 0xc8, 0x34, 0x69, 0x09, 0xc6, 0x73, 0x70, 0xf7, 0x98, 0xba, 0xe3,
+	0xfe, 0x32, 0x4e, 0x1e, 0xd1, 0x30, 0xc4, 0xae, 0xa8, 0x57, 0xbd, 0x03, 0x56, 0xc5, 0x1c, 0x09,
+	0xae, 0x29, 0x92, 0x6b, 0x2b, 0xbe, 0xf4, 0x52, 0xf7, 0xc1, 0x16, 0x29, 0x79, 0x3a, 0x30, 0x75,
+	0xcd, 0xde, 0x7a, 0x93, 0x2c, 0x46, 0x35, 0x7e, 0xa8, 0x82, 0x6d, 0x91, 0xd3, 0xc6, 0x68, 0x1e,
+	0xe3, 0x6b, 0xd3, 0x1c, 0x80, 0xdb, 0x2c, 0x76, 0x9d, 0xeb, 0x30, 0xdd, 0x64, 0xb1, 0xfb, 0xe5,
+	0x3c, 0xac, 0x07, 0xe0, 0x36, 0x62, 0x7c, 0x89, 0x4f, 0x2d, 0xf5, 0x41, 0x8c, 0x2f, 0xf8, 0x78,
+	0x60, 0xc3, 0xa5, 0x41, 0xe4, 0x63, 0x49, 0x05, 0xb1, 0x7a, 0x32, 0x1c, 0xda, 0x66, 0xba, 0x97,
+	0xcc, 0x7c, 0x2f, 0x99, 0x4f, 0xf3, 0xbd, 0xd4, 0x33, 0x04, 0x13, 0x2e, 0xcf, 0xf4, 0xed, 0x94,
+	0xdf, 0x73, 0x01, 0x8c, 0x97, 0x6f, 0x75, 0xc5, 0x5e, 0x9f, 0x49, 0x85, 0xa3, 0xf1, 0x93, 0x02,
+	0xd6, 0x07, 0x38, 0x44, 0x24, 0xf4, 0x6c, 0x7c, 0x1a, 0x63, 0x36, 0x52, 0x3f, 0x04, 0xcd, 0x74,
+	0x24, 0x09, 0xc2, 0x21, 0x27, 0xa7, 0x24, 0x1b, 0xcb, 0x86, 0xbd, 0x21, 0xe5, 0xfd, 0x42, 0xbc,
+	0x30, 0xbd, 0xd5, 0xc5, 0xe9, 0x3d, 0x04, 0x2d, 0x1f, 0x32, 0xee, 0x2c, 0x45, 0x27, 0x7d, 0x81,
+	0x3b, 0xc2, 0x60, 0x09, 0xee, 0xc6, 0x7d, 0xb0, 0xfd, 0x2c, 0x8c, 0x28, 0xf5, 0xbf, 0x1a, 0x11,
+	0x8e, 0x7d, 0xc2, 0x38, 0x46, 0x03, 0x4a, 0x7d, 0xa6, 0x36, 0x41, 0x8d, 0x20, 0x31, 0x76, 0xb5,
+	0x4e, 0xdd, 0x16, 0x47, 0xe3, 0xb7, 0x1a, 0x30, 0x8e, 0x68, 0xe8, 0xe2, 0x90, 0xc7, 0x30, 0xb3,
+	0x7b, 0xc6, 0x70, 0x3c, 0xa0, 0x8c, 0x5c, 0x9d, 0xde, 0x45, 0x20, 0x94, 0x6b, 0x06, 0x52, 0x07,
+	0x6b, 0x51, 0xe6, 0x2e, 0xa8, 0x50, 0x95, 0x54, 0x00, 0xb9, 0xa8, 0x7f, 0x85, 0x27, 0xb5, 0x2b,
+	0x3c, 0xf9, 0x0c, 0xac, 0xb3, 0x69, 0xc8, 0x47, 0x98, 0x13, 0xd7, 0x11, 0xb2, 0x0c, 0xbe, 0xdd,
+	0x62, 0x79, 0xa7, 0x5f, 0x05, 0xf3, 0x24, 0xb7, 0x12, 0x4c, 0xec, 0xd5, 0x05, 0x82, 0xf6, 0xbb,
+	0xac, 0x2c, 0x5c, 0xbe, 0x16, 0x6e, 0xfc, 0xdf, 0x6b, 0x61, 0xe5, 0xbf, 0x58, 0x0b, 0xf7, 0x7f,
+	0x54, 0xc0, 0xe6, 0x92, 0x6f, 0x9b, 0xba, 0x0b, 0x5a, 0x4b, 0xc4, 0x4f, 0x20, 0x27, 0x13, 0xdc,
+	0xac, 0xa8, 0x5a, 0x79, 0x83, 0x17, 0xea, 0xe3, 0xc1, 0xc9, 0x08, 0xc6, 0xb8, 0xa9, 0xa8, 0x1d,
+	0xf0, 0xc1, 0x12, 0x7d, 0x99, 0x3e, 0xa9, 0x65, 0xb5, 0x5d, 0x7f, 0xf1, 0xb3, 0x56, 0xe9, 0x0d,
+	0x5e, 0x9f, 0x6b, 0xca, 0x9b, 0x73, 0x4d, 0xf9, 0xf3, 0x5c, 0x53, 0x5e, 0x5e, 0x68, 0x95, 0x37,
+	0x17, 0x5a, 0xe5, 0xf7, 0x0b, 0xad, 0xf2, 0xcd, 0x47, 0xa5, 0x0e, 0x33, 0x68, 0x1f, 0xfa, 0x70,
+	0xc8, 0xf2, 0x8b, 0x35, 0x79, 0xb4, 0x6f, 0x7d, 0x57, 0xfe, 0xcf, 0x22, 0xbb, 0x1e, 0xae, 0xc8,
+	0xf9, 0x7d, 0xf4, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0xa7, 0xae, 0x3e, 0xd6, 0x08, 0x00,
+	0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -792,6 +946,98 @@ func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *LockRedelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockRedelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastIntermediaryAccount) > 0 {
+		i -= len(m.LastIntermediaryAccount)
+		copy(dAtA[i:], m.LastIntermediaryAccount)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.LastIntermediaryAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *UnpoolWhitelistedPools) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -813,20 +1059,20 @@ func (m *UnpoolWhitelistedPools) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Ids)*10)
+		var j4 int
 		for _, num := range m.Ids {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintSuperfluid(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintSuperfluid(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1009,6 +1255,48 @@ func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
 	return n
 }
 
+func (m *LockRedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
+func (m *PendingRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovSuperfluid(uint64(m.EpochNumber))
+	}
+	l = len(m.LastIntermediaryAccount)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
 func (m *UnpoolWhitelistedPools) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1711,6 +1999,305 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockRedelegationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockRedelegationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockRedelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRefresh) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRefresh: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIntermediaryAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastIntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *UnpoolWhitelistedPools) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -422,6 +422,114 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) GetLockId() uint64 {
 	return 0
 }
 
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator without unbonding, using a redelegation of the staking module.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{8}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+	// completion_time is the time the redelegation completes, until which the
+	// lock is slashed for infractions of the source validator.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{9}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -435,7 +543,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +601,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +654,7 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegate) String() string {
 }
 func (*MsgCreateFullRangePositionAndSuperfluidDelegate) ProtoMessage() {}
 func (*MsgCreateFullRangePositionAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{10}
+	return fileDescriptor_55b645f187d22814, []int{12}
 }
 func (m *MsgCreateFullRangePositionAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +724,7 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) String() strin
 }
 func (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse) ProtoMessage() {}
 func (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{11}
+	return fileDescriptor_55b645f187d22814, []int{13}
 }
 func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +784,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{12}
+	return fileDescriptor_55b645f187d22814, []int{14}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +835,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{13}
+	return fileDescriptor_55b645f187d22814, []int{15}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +889,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) String() stri
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{14}
+	return fileDescriptor_55b645f187d22814, []int{16}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +961,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Strin
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{15}
+	return fileDescriptor_55b645f187d22814, []int{17}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +1013,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) String() string {
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{16}
+	return fileDescriptor_55b645f187d22814, []int{18}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,7 +1089,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) String() strin
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{17}
+	return fileDescriptor_55b645f187d22814, []int{19}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,7 +1154,7 @@ func (m *MsgUnbondConvertAndStake) Reset()         { *m = MsgUnbondConvertAndSta
 func (m *MsgUnbondConvertAndStake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStake) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{18}
+	return fileDescriptor_55b645f187d22814, []int{20}
 }
 func (m *MsgUnbondConvertAndStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1219,7 @@ func (m *MsgUnbondConvertAndStakeResponse) Reset()         { *m = MsgUnbondConve
 func (m *MsgUnbondConvertAndStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStakeResponse) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{19}
+	return fileDescriptor_55b645f187d22814, []int{21}
 }
 func (m *MsgUnbondConvertAndStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1149,6 +1257,8 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
 	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgCreateFullRangePositionAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgCreateFullRangePositionAndSuperfluidDelegate")
@@ -1166,105 +1276,109 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x3d, 0x6c, 0xdb, 0x46,
-	0x14, 0x36, 0x25, 0xc7, 0x4e, 0xce, 0xb1, 0x63, 0xb3, 0x71, 0xac, 0x28, 0x89, 0xe4, 0xb0, 0x69,
-	0xeb, 0xfc, 0x88, 0xb4, 0x9c, 0x3f, 0x57, 0x1d, 0x1a, 0xcb, 0x42, 0x0b, 0x35, 0x36, 0x1a, 0x30,
-	0x0e, 0x0a, 0x74, 0x51, 0x29, 0xdd, 0x99, 0x66, 0x4d, 0xf2, 0x1c, 0xdd, 0xc9, 0xb1, 0xd1, 0xad,
-	0x43, 0x0b, 0x04, 0x6d, 0x11, 0x74, 0xe9, 0x54, 0x74, 0xe8, 0xd6, 0x29, 0x43, 0x81, 0x0e, 0x5d,
-	0x3a, 0x66, 0xcc, 0x58, 0xb4, 0x80, 0x53, 0x24, 0x43, 0xd0, 0xd5, 0x4b, 0xa7, 0x02, 0xc5, 0x91,
-	0x47, 0x8a, 0xa2, 0x49, 0x49, 0x54, 0xbc, 0x74, 0x49, 0xc4, 0xbb, 0x7b, 0x7f, 0xdf, 0x7b, 0xdf,
-	0x7b, 0x77, 0x06, 0x67, 0x30, 0xb1, 0x30, 0x31, 0x88, 0x42, 0x5a, 0x5b, 0xa8, 0xb9, 0x6e, 0xb6,
-	0x0c, 0xa8, 0xd0, 0x1d, 0x79, 0xab, 0x89, 0x29, 0x16, 0x45, 0xbe, 0x29, 0xb7, 0x37, 0xb3, 0x27,
-	0x75, 0xac, 0x63, 0x67, 0x5b, 0x61, 0xbf, 0xdc, 0x93, 0xd9, 0x29, 0xcd, 0x32, 0x6c, 0xac, 0x38,
-	0xff, 0xf2, 0xa5, 0x9c, 0x8e, 0xb1, 0x6e, 0x22, 0xc5, 0xf9, 0xaa, 0xb7, 0xd6, 0x15, 0xd8, 0x6a,
-	0x6a, 0xd4, 0xc0, 0xb6, 0xb7, 0xdf, 0x70, 0xb4, 0x2b, 0x75, 0x8d, 0x20, 0x65, 0xbb, 0x58, 0x47,
-	0x54, 0x2b, 0x2a, 0x0d, 0x6c, 0x78, 0xfb, 0xf9, 0xb0, 0x3c, 0x35, 0x2c, 0x44, 0xa8, 0x66, 0x6d,
-	0xf1, 0x03, 0xaf, 0x47, 0xb8, 0xde, 0xfe, 0xc9, 0x0f, 0xcd, 0x70, 0x2b, 0x16, 0xd1, 0x95, 0xed,
-	0x22, 0xfb, 0xcf, 0xdd, 0x90, 0x7e, 0x14, 0xc0, 0xf4, 0x2a, 0xd1, 0xef, 0xfa, 0x02, 0x15, 0x64,
-	0x22, 0x5d, 0xa3, 0x48, 0xbc, 0x08, 0x46, 0x08, 0xb2, 0x21, 0x6a, 0x66, 0x84, 0x59, 0x61, 0xee,
-	0x58, 0x79, 0x6a, 0x7f, 0x2f, 0x3f, 0xbe, 0xab, 0x59, 0x66, 0x49, 0x72, 0xd7, 0x25, 0x95, 0x1f,
-	0x10, 0x67, 0xc0, 0xa8, 0x89, 0x1b, 0x9b, 0x35, 0x03, 0x66, 0x52, 0xb3, 0xc2, 0xdc, 0xb0, 0x3a,
-	0xc2, 0x3e, 0xab, 0x50, 0x3c, 0x0d, 0x8e, 0x6e, 0x6b, 0x66, 0x4d, 0x83, 0xb0, 0x99, 0x49, 0x33,
-	0x2d, 0xea, 0xe8, 0xb6, 0x66, 0x2e, 0x41, 0xd8, 0x2c, 0x5d, 0xfe, 0xfc, 0xe5, 0xe3, 0x4b, 0x5c,
-	0xc1, 0xc3, 0x97, 0x8f, 0x2f, 0x45, 0x64, 0xa0, 0x00, 0xb9, 0x2f, 0x52, 0x1e, 0x9c, 0x8b, 0x74,
-	0x52, 0x45, 0x64, 0x0b, 0xdb, 0x04, 0x49, 0x5f, 0x09, 0x60, 0xa6, 0xe3, 0xc4, 0x3d, 0x1b, 0x1e,
-	0x62, 0x20, 0xa5, 0x42, 0xc8, 0xdb, 0x73, 0x11, 0xde, 0xb6, 0x7c, 0x93, 0xd2, 0x79, 0x90, 0x8f,
-	0xf1, 0xc6, 0xf7, 0xf8, 0xeb, 0x83, 0x1e, 0xd7, 0xb1, 0x0d, 0x57, 0x70, 0x63, 0xf3, 0x50, 0x3c,
-	0x96, 0x43, 0x1e, 0xe7, 0x22, 0x3d, 0x66, 0x26, 0x0b, 0x4c, 0x22, 0xc2, 0x65, 0xcf, 0x1d, 0xdf,
-	0xe5, 0xbf, 0x05, 0x70, 0x21, 0x26, 0xac, 0x25, 0xfb, 0x90, 0xfd, 0x17, 0xcb, 0x60, 0x98, 0xb1,
-	0xc0, 0x29, 0x9b, 0xb1, 0x85, 0xd3, 0xb2, 0x5b, 0xc0, 0x32, 0xa3, 0x89, 0xcc, 0x69, 0x22, 0x2f,
-	0x63, 0xc3, 0x2e, 0xbf, 0xf6, 0x64, 0x2f, 0x3f, 0xb4, 0xbf, 0x97, 0x1f, 0x73, 0x0d, 0x30, 0x21,
-	0x49, 0x75, 0x64, 0x4b, 0x6f, 0x87, 0x30, 0xb8, 0xd8, 0x35, 0x6b, 0x1d, 0x70, 0xbc, 0x0f, 0xae,
-	0xf4, 0x13, 0xaa, 0x87, 0x4d, 0x30, 0x0e, 0x21, 0x18, 0x87, 0xf4, 0xaf, 0x00, 0xce, 0xae, 0x12,
-	0x9d, 0x1d, 0x5e, 0xb2, 0xe1, 0xab, 0xf1, 0x4c, 0x03, 0x47, 0x58, 0x5c, 0x24, 0x93, 0x9a, 0x4d,
-	0x77, 0x07, 0x65, 0x9e, 0x81, 0xf2, 0xd3, 0xb3, 0xfc, 0x9c, 0x6e, 0xd0, 0x8d, 0x56, 0x5d, 0x6e,
-	0x60, 0x4b, 0xe1, 0x2d, 0xc0, 0xfd, 0xaf, 0x40, 0xe0, 0xa6, 0x42, 0x77, 0xb7, 0x10, 0x71, 0x04,
-	0x88, 0xea, 0x6a, 0xee, 0xc6, 0xd8, 0x6b, 0x21, 0x34, 0x2f, 0x78, 0x68, 0xb2, 0x48, 0x0b, 0x9a,
-	0x0d, 0x0b, 0x51, 0xd4, 0xbd, 0xe1, 0xd4, 0x4c, 0x6c, 0xf8, 0x3e, 0x80, 0x13, 0x20, 0x55, 0xad,
-	0x70, 0xec, 0x52, 0xd5, 0x8a, 0xf4, 0x6b, 0x0a, 0x28, 0xab, 0x44, 0x5f, 0x6e, 0x22, 0x8d, 0xa2,
-	0xf7, 0x5a, 0xa6, 0xa9, 0x6a, 0xb6, 0x8e, 0xee, 0x60, 0x62, 0xb0, 0xe6, 0xf9, 0xff, 0x86, 0x52,
-	0xbc, 0x0c, 0x46, 0xb7, 0x30, 0x36, 0x59, 0xb5, 0x0c, 0xb3, 0x88, 0xcb, 0xe2, 0xfe, 0x5e, 0x7e,
-	0xc2, 0xf5, 0x94, 0x6f, 0x48, 0xea, 0x08, 0xfb, 0x55, 0x85, 0xa5, 0x85, 0x10, 0xee, 0x92, 0x87,
-	0xfb, 0x7a, 0xcb, 0x34, 0x0b, 0x4d, 0x06, 0x8b, 0x8b, 0xfe, 0x7a, 0x1b, 0xf5, 0xfb, 0xe0, 0x66,
-	0x42, 0xf0, 0xfc, 0x44, 0x9c, 0x02, 0x6e, 0xe9, 0x56, 0x3a, 0x0a, 0xb9, 0x22, 0xe6, 0x00, 0xd8,
-	0xe2, 0x0a, 0xaa, 0x15, 0x4e, 0xd6, 0xc0, 0x0a, 0x9b, 0x24, 0x99, 0x55, 0xa2, 0xdf, 0xb3, 0xef,
-	0x60, 0x6c, 0x7e, 0xb4, 0x61, 0x50, 0x64, 0x1a, 0x84, 0x22, 0xc8, 0x3e, 0x93, 0x64, 0x26, 0x80,
-	0x4d, 0xaa, 0x27, 0x36, 0x4a, 0x08, 0x9b, 0xbc, 0x87, 0x4d, 0xcb, 0x66, 0x27, 0x0a, 0x0f, 0xda,
-	0x7e, 0x14, 0xd8, 0x82, 0xf4, 0x01, 0x98, 0x8d, 0x73, 0xd2, 0x47, 0xe0, 0x4d, 0x70, 0x02, 0xed,
-	0x18, 0x14, 0xc1, 0x1a, 0xa7, 0x34, 0xc9, 0x08, 0xb3, 0xe9, 0xb9, 0x61, 0x75, 0xdc, 0x5d, 0x5e,
-	0x71, 0x98, 0x4d, 0xa4, 0x9f, 0xd3, 0x60, 0xd1, 0x51, 0x66, 0xba, 0xd5, 0xbd, 0x6a, 0xe8, 0x4d,
-	0x8d, 0xa2, 0xbb, 0x1b, 0x5a, 0x13, 0x91, 0x35, 0xec, 0xe3, 0xbe, 0x8c, 0xed, 0x06, 0xb2, 0x29,
-	0xdb, 0x83, 0x5e, 0x0e, 0x12, 0x22, 0x12, 0xec, 0x91, 0xe9, 0x20, 0x22, 0x7c, 0x43, 0xf2, 0xfb,
-	0xa6, 0x0e, 0xa6, 0x88, 0xe3, 0x40, 0x8d, 0xe2, 0x9a, 0xe5, 0x7a, 0xd4, 0xbb, 0x89, 0xce, 0xf2,
-	0x26, 0x9a, 0xe1, 0x1e, 0x84, 0x35, 0x48, 0xea, 0x09, 0xc2, 0xc3, 0xe2, 0x51, 0x8a, 0x0f, 0x05,
-	0x30, 0x41, 0xf1, 0x26, 0xb2, 0x6b, 0xb8, 0x45, 0x6b, 0x16, 0xe3, 0xd2, 0x70, 0x2f, 0x2e, 0x55,
-	0xb9, 0x99, 0x69, 0xd7, 0x4c, 0xa7, 0xb8, 0x94, 0x88, 0x64, 0xc7, 0x1d, 0xe1, 0x0f, 0x5b, 0x74,
-	0xd5, 0xb0, 0x49, 0xe9, 0x52, 0xa8, 0x0e, 0xb2, 0xed, 0x3a, 0xf0, 0xbb, 0x93, 0x17, 0xca, 0xf7,
-	0x69, 0x70, 0x6b, 0xd0, 0xb4, 0xf9, 0x35, 0x52, 0x05, 0xa3, 0x9a, 0x85, 0x5b, 0x36, 0x9d, 0xe7,
-	0xf9, 0x53, 0x58, 0x68, 0x7f, 0xec, 0xe5, 0xa7, 0x5d, 0x7f, 0x09, 0xdc, 0x94, 0x0d, 0xac, 0x58,
-	0x1a, 0xdd, 0x90, 0xab, 0x36, 0x6d, 0x27, 0x8c, 0x4b, 0x49, 0xaa, 0x27, 0xdf, 0x56, 0x55, 0x74,
-	0xd2, 0x9b, 0x54, 0x55, 0xd1, 0x57, 0x55, 0x14, 0x4d, 0x30, 0x65, 0x1a, 0xf7, 0x5b, 0x06, 0x34,
-	0xe8, 0x6e, 0xad, 0xe1, 0xb0, 0x1f, 0xba, 0xbd, 0xa7, 0xfc, 0x2e, 0x57, 0x7a, 0xe6, 0xa0, 0xd2,
-	0x15, 0xa4, 0x6b, 0x8d, 0xdd, 0x0a, 0x6a, 0xb4, 0x0b, 0xe0, 0x80, 0x16, 0x49, 0x9d, 0xf4, 0xd7,
-	0xdc, 0xb6, 0x02, 0xc5, 0x7b, 0xe0, 0xd8, 0xa7, 0xd8, 0xb0, 0x6b, 0xec, 0x46, 0xea, 0xf4, 0xb1,
-	0xb1, 0x85, 0xac, 0xec, 0x5e, 0x57, 0x65, 0xef, 0xba, 0x2a, 0xaf, 0x79, 0xd7, 0xd5, 0xf2, 0x59,
-	0x9e, 0xfc, 0x49, 0xd7, 0x84, 0x2f, 0x2a, 0x3d, 0x7a, 0x96, 0x17, 0xd4, 0xa3, 0xec, 0x9b, 0x1d,
-	0x96, 0xbe, 0x49, 0x3b, 0x9d, 0x7f, 0x09, 0xc2, 0x35, 0x1c, 0xcc, 0xc1, 0x8a, 0x67, 0xbf, 0xdd,
-	0xbc, 0x7c, 0x36, 0xdd, 0x04, 0x63, 0x5e, 0x2b, 0xf2, 0x47, 0x70, 0xf9, 0xd4, 0xfe, 0x5e, 0x5e,
-	0xf4, 0x1a, 0x87, 0xbf, 0x29, 0x05, 0xba, 0x16, 0x0c, 0xd0, 0x30, 0xd5, 0x8b, 0x86, 0x35, 0xaf,
-	0xde, 0x21, 0x22, 0x46, 0x13, 0xc1, 0xf9, 0xde, 0xb4, 0x3a, 0x17, 0x55, 0xef, 0x9e, 0xb8, 0xa4,
-	0x8e, 0x3b, 0x0b, 0x15, 0xfe, 0x7d, 0xc0, 0x40, 0x91, 0x83, 0x3a, 0xa0, 0x81, 0x62, 0xc8, 0x40,
-	0xb1, 0x74, 0x3d, 0xc4, 0x92, 0x37, 0x3c, 0x96, 0x68, 0x10, 0x16, 0x28, 0x2e, 0x34, 0xcc, 0xe0,
-	0x08, 0xf7, 0x50, 0x92, 0xbe, 0x4b, 0x3b, 0xd3, 0x24, 0x49, 0x42, 0x7c, 0x9e, 0x0c, 0x9c, 0x98,
-	0x00, 0xc1, 0x52, 0x87, 0x47, 0xb0, 0xf4, 0x2b, 0x12, 0xec, 0x13, 0x30, 0x6e, 0xa3, 0x07, 0x35,
-	0x9f, 0x0a, 0x99, 0x23, 0x8e, 0xc2, 0x77, 0xfa, 0x23, 0xd7, 0x49, 0x57, 0x6d, 0x87, 0x06, 0x49,
-	0x3d, 0x6e, 0xa3, 0x07, 0x3e, 0x94, 0xc1, 0x66, 0x7f, 0xe0, 0x6a, 0x10, 0x6e, 0xf6, 0xd2, 0x2f,
-	0x69, 0x3e, 0x73, 0xd9, 0x7d, 0x74, 0x19, 0xdb, 0xdb, 0xa8, 0x49, 0xd9, 0x74, 0xa7, 0xda, 0x26,
-	0x0a, 0x6a, 0x12, 0x7a, 0x69, 0x4a, 0xc2, 0x83, 0x2e, 0xf7, 0x1a, 0x0d, 0x4c, 0x5a, 0x86, 0x5d,
-	0xd3, 0x2c, 0xca, 0x66, 0x07, 0x61, 0x6e, 0x38, 0x51, 0x1c, 0x2b, 0x2f, 0xf6, 0x82, 0x7c, 0xc6,
-	0x35, 0x16, 0x16, 0x97, 0xd4, 0x71, 0xcb, 0xb0, 0x97, 0x2c, 0xba, 0x86, 0xdd, 0xa8, 0xbe, 0x15,
-	0x82, 0x03, 0xae, 0xe1, 0xc6, 0xec, 0xa4, 0xa1, 0x2b, 0x51, 0x6e, 0xc7, 0x0d, 0x38, 0xae, 0x81,
-	0x0d, 0x9f, 0xb7, 0xfa, 0x1c, 0x3e, 0xed, 0x59, 0xc8, 0x21, 0x2f, 0xcd, 0x87, 0x88, 0x35, 0xdb,
-	0x1e, 0x3f, 0xce, 0x93, 0x82, 0x1b, 0x71, 0xaf, 0x69, 0x4e, 0x58, 0x5f, 0x08, 0xfc, 0x22, 0x12,
-	0x91, 0x39, 0x9f, 0x3c, 0x75, 0x30, 0x49, 0x31, 0x65, 0x58, 0x5b, 0xd4, 0x85, 0x03, 0xf2, 0x69,
-	0xd3, 0x2f, 0x9c, 0x61, 0x71, 0x49, 0x9d, 0x70, 0x96, 0x96, 0x2c, 0xea, 0x98, 0x82, 0x0b, 0xff,
-	0x8c, 0x81, 0xf4, 0x2a, 0xd1, 0xc5, 0x26, 0x10, 0xa3, 0x6e, 0xd4, 0xf2, 0xc1, 0xbf, 0x7d, 0xc8,
-	0x91, 0x4f, 0xf1, 0x6c, 0xb1, 0xef, 0xa3, 0x7e, 0x7c, 0x3b, 0xe0, 0x64, 0xe4, 0x8b, 0xfd, 0x72,
-	0x4f, 0x55, 0xed, 0xc3, 0xd9, 0xab, 0x09, 0x0e, 0xc7, 0x59, 0xf6, 0x5f, 0xae, 0xfd, 0x58, 0xf6,
-	0x0e, 0xf7, 0x65, 0xf9, 0xc0, 0x43, 0xf1, 0x07, 0x01, 0x9c, 0xef, 0xfd, 0x82, 0x5e, 0x4c, 0x10,
-	0x54, 0x87, 0x64, 0xf6, 0xd6, 0xa0, 0x92, 0xbe, 0x87, 0x5f, 0x0a, 0xe0, 0x74, 0xfc, 0x73, 0x75,
-	0x3e, 0x46, 0x7f, 0xac, 0x44, 0x76, 0x31, 0xa9, 0x84, 0xef, 0xc9, 0x6f, 0x02, 0xb8, 0x92, 0xe8,
-	0x01, 0xb8, 0x1c, 0x63, 0x2a, 0x89, 0x92, 0xec, 0xed, 0x43, 0x50, 0xe2, 0x87, 0xf0, 0x19, 0x98,
-	0x8e, 0x7e, 0x11, 0x5d, 0x89, 0xb1, 0x12, 0x79, 0x3a, 0x7b, 0x2d, 0xc9, 0x69, 0xdf, 0xf8, 0x9f,
-	0x02, 0xb8, 0x3e, 0xd8, 0xeb, 0x64, 0x25, 0xd6, 0xde, 0x00, 0xda, 0xb2, 0x6b, 0x87, 0xa9, 0xad,
-	0xa3, 0x3a, 0x12, 0x5d, 0x12, 0xe3, 0xaa, 0x23, 0x89, 0x92, 0xd8, 0xea, 0x18, 0xe8, 0x76, 0xe4,
-	0x54, 0x47, 0xd4, 0xec, 0x8e, 0xaf, 0x8e, 0x88, 0xd3, 0x5d, 0xaa, 0xa3, 0xcb, 0x74, 0x29, 0xdf,
-	0x79, 0xf2, 0x3c, 0x27, 0x3c, 0x7d, 0x9e, 0x13, 0xfe, 0x7a, 0x9e, 0x13, 0x1e, 0xbd, 0xc8, 0x0d,
-	0x3d, 0x7d, 0x91, 0x1b, 0xfa, 0xfd, 0x45, 0x6e, 0xe8, 0xe3, 0x1b, 0x81, 0x41, 0xc8, 0x35, 0x17,
-	0x4c, 0xad, 0x4e, 0xbc, 0x0f, 0x65, 0xfb, 0x6a, 0x51, 0xd9, 0xe9, 0xf8, 0x5b, 0x39, 0x1b, 0x8e,
-	0xf5, 0x11, 0xe7, 0xd6, 0x7f, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x1a, 0x8e, 0x6d,
-	0x4e, 0x17, 0x00, 0x00,
+	// 1630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6c, 0xdb, 0x54,
+	0x18, 0xaf, 0x93, 0xae, 0xdd, 0x5e, 0xd7, 0xae, 0x35, 0xeb, 0x9a, 0x65, 0x5b, 0x92, 0x99, 0x01,
+	0xdd, 0x9f, 0xd8, 0x4d, 0xbb, 0x3f, 0x25, 0x1c, 0x58, 0xd3, 0x08, 0x14, 0xd6, 0x88, 0xc9, 0xeb,
+	0x40, 0xe2, 0x12, 0x9c, 0xbc, 0x57, 0xd7, 0xd4, 0xf6, 0xcb, 0xf2, 0x5e, 0xba, 0x56, 0xdc, 0x38,
+	0x80, 0x34, 0x01, 0x9a, 0xb8, 0x70, 0x42, 0x1c, 0xb8, 0x71, 0x40, 0x3b, 0x20, 0x71, 0xe0, 0xc2,
+	0x71, 0xc7, 0x1d, 0x11, 0x48, 0x1d, 0xda, 0x0e, 0x13, 0x47, 0x7a, 0x47, 0x42, 0xcf, 0x7e, 0x76,
+	0x1c, 0xd7, 0x69, 0xea, 0x2e, 0x17, 0x2e, 0x6b, 0xfc, 0xde, 0xfb, 0x7e, 0xdf, 0xdf, 0xdf, 0xf7,
+	0x3d, 0x7b, 0xe0, 0x0c, 0x26, 0x16, 0x26, 0x06, 0x51, 0x48, 0xbb, 0x89, 0x5a, 0x6b, 0x66, 0xdb,
+	0x80, 0x0a, 0xdd, 0x92, 0x9b, 0x2d, 0x4c, 0xb1, 0x28, 0xf2, 0x4d, 0xb9, 0xb3, 0x99, 0x3e, 0xa9,
+	0x63, 0x1d, 0x3b, 0xdb, 0x0a, 0xfb, 0xe5, 0x9e, 0x4c, 0x4f, 0x69, 0x96, 0x61, 0x63, 0xc5, 0xf9,
+	0x97, 0x2f, 0x65, 0x74, 0x8c, 0x75, 0x13, 0x29, 0xce, 0x53, 0xbd, 0xbd, 0xa6, 0xc0, 0x76, 0x4b,
+	0xa3, 0x06, 0xb6, 0xbd, 0xfd, 0x86, 0x83, 0xae, 0xd4, 0x35, 0x82, 0x94, 0xcd, 0x42, 0x1d, 0x51,
+	0xad, 0xa0, 0x34, 0xb0, 0xe1, 0xed, 0x67, 0xc3, 0xf2, 0xd4, 0xb0, 0x10, 0xa1, 0x9a, 0xd5, 0xe4,
+	0x07, 0x5e, 0x8d, 0x30, 0xbd, 0xf3, 0x93, 0x1f, 0x9a, 0xe1, 0x5a, 0x2c, 0xa2, 0x2b, 0x9b, 0x05,
+	0xf6, 0xc7, 0xdd, 0x90, 0x7e, 0x10, 0xc0, 0x74, 0x95, 0xe8, 0x77, 0x7c, 0x81, 0x32, 0x32, 0x91,
+	0xae, 0x51, 0x24, 0x5e, 0x04, 0x23, 0x04, 0xd9, 0x10, 0xb5, 0x52, 0x42, 0x4e, 0x98, 0x3d, 0x56,
+	0x9a, 0xda, 0xdd, 0xc9, 0x8e, 0x6f, 0x6b, 0x96, 0x59, 0x94, 0xdc, 0x75, 0x49, 0xe5, 0x07, 0xc4,
+	0x19, 0x30, 0x6a, 0xe2, 0xc6, 0x46, 0xcd, 0x80, 0xa9, 0x44, 0x4e, 0x98, 0x1d, 0x56, 0x47, 0xd8,
+	0x63, 0x05, 0x8a, 0xa7, 0xc1, 0xd1, 0x4d, 0xcd, 0xac, 0x69, 0x10, 0xb6, 0x52, 0x49, 0x86, 0xa2,
+	0x8e, 0x6e, 0x6a, 0xe6, 0x12, 0x84, 0xad, 0xe2, 0xe5, 0xcf, 0x5e, 0x3c, 0xba, 0xc4, 0x01, 0x1e,
+	0xbc, 0x78, 0x74, 0x29, 0x22, 0x03, 0x79, 0xc8, 0x6d, 0x91, 0xb2, 0xe0, 0x5c, 0xa4, 0x91, 0x2a,
+	0x22, 0x4d, 0x6c, 0x13, 0x24, 0x7d, 0x29, 0x80, 0x99, 0xae, 0x13, 0x77, 0x6d, 0x38, 0x40, 0x47,
+	0x8a, 0xf9, 0x90, 0xb5, 0xe7, 0x22, 0xac, 0x6d, 0xfb, 0x2a, 0xa5, 0xf3, 0x20, 0xdb, 0xc3, 0x1a,
+	0xdf, 0xe2, 0xaf, 0xf6, 0x5a, 0x5c, 0xc7, 0x36, 0x5c, 0xc1, 0x8d, 0x8d, 0x81, 0x58, 0x2c, 0x87,
+	0x2c, 0xce, 0x44, 0x5a, 0xcc, 0x54, 0xe6, 0x99, 0x44, 0x84, 0xc9, 0x9e, 0x39, 0xbe, 0xc9, 0x7f,
+	0x0b, 0xe0, 0x42, 0x0f, 0xb7, 0x96, 0xec, 0x01, 0xdb, 0x2f, 0x96, 0xc0, 0x30, 0x63, 0x81, 0x53,
+	0x36, 0x63, 0xf3, 0xa7, 0x65, 0xb7, 0x80, 0x65, 0x46, 0x13, 0x99, 0xd3, 0x44, 0x5e, 0xc6, 0x86,
+	0x5d, 0x7a, 0xe5, 0xf1, 0x4e, 0x76, 0x68, 0x77, 0x27, 0x3b, 0xe6, 0x2a, 0x60, 0x42, 0x92, 0xea,
+	0xc8, 0x16, 0xdf, 0x0c, 0xc5, 0xe0, 0xe2, 0xbe, 0x59, 0xeb, 0x0a, 0xc7, 0xbb, 0xe0, 0xca, 0x41,
+	0x5c, 0xf5, 0x62, 0x13, 0xf4, 0x43, 0x08, 0xfa, 0x21, 0xfd, 0x14, 0xce, 0xb3, 0x8a, 0x06, 0x59,
+	0x99, 0x62, 0x0e, 0x1c, 0xb7, 0xd1, 0xfd, 0x5a, 0x88, 0x66, 0xc0, 0x46, 0xf7, 0x3f, 0xe0, 0x4c,
+	0x3b, 0x48, 0xed, 0xb6, 0x7c, 0xa3, 0xa4, 0x07, 0x02, 0xc8, 0xf6, 0x30, 0xd8, 0xf7, 0x56, 0x07,
+	0x27, 0x1a, 0xd8, 0x6a, 0x9a, 0x88, 0x35, 0xb2, 0x1a, 0xeb, 0x48, 0x8e, 0x07, 0x63, 0xf3, 0x69,
+	0xd9, 0x6d, 0x57, 0xb2, 0xd7, 0xae, 0xe4, 0x55, 0xaf, 0x5d, 0x95, 0x24, 0x9e, 0xa8, 0x53, 0x5e,
+	0xa2, 0xba, 0x00, 0xa4, 0x87, 0x4f, 0xb3, 0x82, 0x3a, 0xd1, 0x59, 0x65, 0x82, 0xd2, 0xbf, 0x02,
+	0x38, 0x5b, 0x25, 0x3a, 0x0b, 0xf5, 0x92, 0x0d, 0x5f, 0xae, 0x4b, 0x69, 0xe0, 0x08, 0xab, 0x0a,
+	0x92, 0x4a, 0xe4, 0x92, 0xfb, 0x97, 0xd4, 0x1c, 0xb3, 0xf4, 0xc7, 0xa7, 0xd9, 0x59, 0xdd, 0xa0,
+	0xeb, 0xed, 0xba, 0xdc, 0xc0, 0x96, 0xc2, 0x1b, 0xa8, 0xfb, 0x27, 0x4f, 0xe0, 0x86, 0x42, 0xb7,
+	0x9b, 0x88, 0x38, 0x02, 0x44, 0x75, 0x91, 0xf7, 0xeb, 0x77, 0x57, 0x43, 0x59, 0xb8, 0xe0, 0x65,
+	0x81, 0xe5, 0x31, 0xaf, 0xd9, 0x30, 0x1f, 0xd5, 0xf8, 0xae, 0x83, 0x0b, 0xfb, 0xb9, 0xef, 0x27,
+	0x64, 0x02, 0x24, 0x2a, 0x65, 0x5e, 0x79, 0x89, 0x4a, 0x59, 0xfa, 0x35, 0x01, 0x94, 0x2a, 0xd1,
+	0x97, 0x5b, 0x48, 0xa3, 0xe8, 0x9d, 0xb6, 0x69, 0xaa, 0x9a, 0xad, 0xa3, 0xdb, 0x98, 0x18, 0x2c,
+	0xb4, 0xff, 0xef, 0x50, 0x8a, 0x97, 0xc1, 0x68, 0x13, 0x63, 0x93, 0x71, 0x61, 0x98, 0x79, 0x5c,
+	0x12, 0x77, 0x77, 0xb2, 0x13, 0xae, 0xa5, 0x7c, 0x43, 0x52, 0x47, 0xd8, 0xaf, 0x0a, 0x2c, 0xce,
+	0x87, 0xe2, 0x2e, 0x79, 0x71, 0x5f, 0x6b, 0x9b, 0x66, 0xbe, 0xc5, 0xc2, 0xe2, 0x46, 0x7f, 0xad,
+	0x13, 0xf5, 0x7b, 0xe0, 0x46, 0xcc, 0xe0, 0xf9, 0x89, 0x38, 0x05, 0x5c, 0x62, 0x96, 0xbb, 0xda,
+	0x40, 0x59, 0xcc, 0x00, 0xd0, 0xe4, 0x00, 0x95, 0x32, 0xa7, 0x70, 0x60, 0x85, 0xcd, 0xe1, 0x54,
+	0x95, 0xe8, 0x77, 0xed, 0xdb, 0x18, 0x9b, 0x1f, 0xae, 0x1b, 0x14, 0x99, 0x06, 0xa1, 0x08, 0xb2,
+	0xc7, 0x38, 0x99, 0x09, 0xc4, 0x26, 0xd1, 0x37, 0x36, 0x4a, 0x28, 0x36, 0x59, 0x2f, 0x36, 0x6d,
+	0x9b, 0x9d, 0xc8, 0xdf, 0xef, 0xd8, 0x91, 0x67, 0x0b, 0xd2, 0x7b, 0x20, 0xd7, 0xcb, 0x48, 0x3f,
+	0x02, 0xaf, 0x83, 0x13, 0x68, 0xcb, 0xa0, 0x08, 0xd6, 0x78, 0xc3, 0x22, 0x29, 0x21, 0x97, 0x9c,
+	0x1d, 0x56, 0xc7, 0xdd, 0xe5, 0x15, 0xa7, 0x6f, 0x11, 0xe9, 0xe7, 0x24, 0x58, 0x74, 0xc0, 0x4c,
+	0xb7, 0xba, 0xab, 0x86, 0xde, 0xd2, 0x28, 0xba, 0xb3, 0xae, 0xb5, 0x10, 0x59, 0xc5, 0x7e, 0xdc,
+	0x97, 0xb1, 0xdd, 0x40, 0x36, 0x65, 0x7b, 0xd0, 0xcb, 0x41, 0xcc, 0x88, 0x04, 0x3b, 0x67, 0x32,
+	0x18, 0x11, 0xbe, 0x21, 0xf9, 0xdd, 0x54, 0x07, 0x53, 0xc4, 0x31, 0xa0, 0x46, 0x71, 0xcd, 0x72,
+	0x2d, 0xea, 0x3f, 0x82, 0x72, 0xbc, 0xb3, 0xa5, 0xb8, 0x05, 0x61, 0x04, 0x49, 0x3d, 0x41, 0xb8,
+	0x5b, 0xdc, 0x4b, 0xf1, 0x81, 0x00, 0x26, 0x28, 0xde, 0x40, 0x76, 0x0d, 0xb7, 0x69, 0xcd, 0x62,
+	0x5c, 0x1a, 0xee, 0xc7, 0xa5, 0x0a, 0x57, 0x33, 0xed, 0xaa, 0xe9, 0x16, 0x97, 0x62, 0x91, 0xec,
+	0xb8, 0x23, 0xfc, 0x7e, 0x9b, 0x56, 0x0d, 0x9b, 0x14, 0x2f, 0x85, 0xea, 0x20, 0xdd, 0xa9, 0x03,
+	0xbf, 0x3b, 0x79, 0xae, 0x7c, 0x97, 0x04, 0x37, 0x0f, 0x9b, 0x36, 0xbf, 0x46, 0x2a, 0x60, 0x54,
+	0xb3, 0x70, 0xdb, 0xa6, 0x73, 0x3c, 0x7f, 0x0a, 0x73, 0xed, 0x8f, 0x9d, 0xec, 0xb4, 0x6b, 0x2f,
+	0x81, 0x1b, 0xb2, 0x81, 0x15, 0x4b, 0xa3, 0xeb, 0x72, 0xc5, 0xa6, 0x9d, 0x84, 0x71, 0x29, 0x49,
+	0xf5, 0xe4, 0x3b, 0x50, 0x85, 0x54, 0xe2, 0x10, 0x50, 0x05, 0x1f, 0xaa, 0x20, 0x9a, 0x60, 0xca,
+	0x34, 0xee, 0xb5, 0x0d, 0x68, 0xd0, 0xed, 0x5a, 0xc3, 0x61, 0x3f, 0x74, 0x7b, 0x4f, 0xe9, 0x6d,
+	0x0e, 0x7a, 0x66, 0x2f, 0xe8, 0x0a, 0xd2, 0xb5, 0xc6, 0x76, 0x19, 0x35, 0x3a, 0x05, 0xb0, 0x07,
+	0x45, 0x52, 0x27, 0xfd, 0x35, 0xb7, 0xad, 0x40, 0xf1, 0x2e, 0x38, 0xf6, 0x09, 0x36, 0xf8, 0xf4,
+	0x1c, 0xee, 0x3b, 0x3d, 0xcf, 0xf2, 0xe4, 0x4f, 0xba, 0x2a, 0x7c, 0x51, 0x77, 0x6e, 0x1e, 0x65,
+	0xcf, 0xce, 0xc4, 0xfc, 0x3a, 0xe9, 0x74, 0xfe, 0x25, 0x08, 0x57, 0x71, 0x30, 0x07, 0x2b, 0x9e,
+	0xfe, 0x4e, 0xf3, 0xf2, 0xd9, 0x74, 0x03, 0x8c, 0x79, 0xad, 0xc8, 0xbf, 0xc0, 0x94, 0x4e, 0xed,
+	0xee, 0x64, 0x45, 0xaf, 0x71, 0xf8, 0x9b, 0x52, 0xa0, 0x6b, 0xc1, 0x00, 0x0d, 0x13, 0xfd, 0x68,
+	0x58, 0xf3, 0xea, 0x1d, 0x22, 0x62, 0xb4, 0x10, 0x9c, 0xeb, 0x4f, 0xab, 0x73, 0x51, 0xf5, 0xee,
+	0x89, 0x4b, 0xea, 0xb8, 0xb3, 0x50, 0xe6, 0xcf, 0x7b, 0x14, 0x14, 0x52, 0xc3, 0x2f, 0xa3, 0xa0,
+	0x10, 0x52, 0x50, 0x28, 0x5e, 0x0b, 0xb1, 0xe4, 0x35, 0x8f, 0x25, 0x1a, 0x84, 0x79, 0x8a, 0xf3,
+	0x0d, 0x33, 0x38, 0xc2, 0xbd, 0x28, 0x49, 0xdf, 0x26, 0xc1, 0x8d, 0x98, 0x09, 0xf1, 0x79, 0x72,
+	0xe8, 0xc4, 0x04, 0x08, 0x96, 0x18, 0x1c, 0xc1, 0x92, 0x2f, 0x49, 0xb0, 0x8f, 0xc1, 0x38, 0xbb,
+	0xab, 0xfa, 0x54, 0x48, 0x1d, 0x71, 0x00, 0xdf, 0x3a, 0x18, 0xb9, 0x4e, 0xba, 0xb0, 0x5d, 0x08,
+	0x92, 0xca, 0x6e, 0xbf, 0x7e, 0x28, 0x83, 0xcd, 0x7e, 0xcf, 0xd5, 0x20, 0xdc, 0xec, 0xa5, 0x5f,
+	0x92, 0x7c, 0xe6, 0xb2, 0xdb, 0xfc, 0x32, 0xb6, 0x37, 0x51, 0x8b, 0xb2, 0xe9, 0x4e, 0xb5, 0x0d,
+	0x14, 0x44, 0x12, 0xfa, 0x21, 0xc5, 0xe1, 0xc1, 0x3e, 0xf7, 0x1a, 0x0d, 0x4c, 0x5a, 0x86, 0x5d,
+	0xd3, 0x2c, 0xca, 0x66, 0x07, 0x61, 0x66, 0x38, 0x5e, 0x1c, 0x2b, 0x2d, 0xf6, 0x0b, 0xf9, 0x8c,
+	0xab, 0x2c, 0x2c, 0x2e, 0xa9, 0xe3, 0x96, 0x61, 0x2f, 0x59, 0x74, 0x15, 0xbb, 0x5e, 0x7d, 0x23,
+	0x04, 0x07, 0x5c, 0xc3, 0xf5, 0x39, 0x75, 0xa4, 0x1f, 0x51, 0x6e, 0xf5, 0x1a, 0x70, 0x1c, 0x81,
+	0x0d, 0x9f, 0x37, 0x0e, 0x38, 0x7c, 0x3a, 0xb3, 0x90, 0x87, 0xbc, 0x38, 0x17, 0x22, 0x56, 0xae,
+	0x33, 0x7e, 0x9c, 0x17, 0x32, 0xae, 0xc4, 0xbd, 0xa6, 0x39, 0x6e, 0x7d, 0x2e, 0xf0, 0x8b, 0x48,
+	0x44, 0xe6, 0x7c, 0xf2, 0xd4, 0xc1, 0x24, 0xc5, 0x94, 0xc5, 0xda, 0xa2, 0x6e, 0x38, 0x60, 0x4a,
+	0x88, 0x15, 0xce, 0xb0, 0xb8, 0xa4, 0x4e, 0x38, 0x4b, 0x4b, 0x16, 0x75, 0x54, 0xc1, 0xf9, 0x7f,
+	0x8e, 0x83, 0x64, 0x95, 0xe8, 0x62, 0x0b, 0x88, 0x51, 0x37, 0x6a, 0x79, 0xef, 0x97, 0x23, 0x39,
+	0xf2, 0x43, 0x46, 0xba, 0x70, 0xe0, 0xa3, 0xbe, 0x7f, 0x5b, 0xe0, 0x64, 0xe4, 0xf7, 0x8e, 0xcb,
+	0x7d, 0xa1, 0x3a, 0x87, 0xd3, 0x0b, 0x31, 0x0e, 0x47, 0x6b, 0x56, 0x51, 0x0c, 0xcd, 0x2a, 0x8a,
+	0xa1, 0x59, 0x45, 0xfb, 0x6b, 0x0e, 0x7c, 0x71, 0x38, 0x88, 0xcf, 0xde, 0xe1, 0xf4, 0x42, 0x8c,
+	0xc3, 0xbe, 0xe6, 0xef, 0x05, 0x70, 0xbe, 0xff, 0x97, 0x8f, 0xc5, 0x18, 0xe1, 0xec, 0x92, 0x4c,
+	0xdf, 0x3c, 0xac, 0xa4, 0x6f, 0xe1, 0x17, 0x02, 0x38, 0xdd, 0xfb, 0x45, 0x79, 0xae, 0x07, 0x7e,
+	0x4f, 0x89, 0xf4, 0x62, 0x5c, 0x09, 0xdf, 0x92, 0xdf, 0x04, 0x70, 0x25, 0xd6, 0xab, 0xe7, 0x72,
+	0x0f, 0x55, 0x71, 0x40, 0xd2, 0xb7, 0x06, 0x00, 0xe2, 0xbb, 0xf0, 0x29, 0x98, 0x8e, 0x7e, 0x17,
+	0xbb, 0xd2, 0x43, 0x4b, 0xe4, 0xe9, 0xf4, 0xd5, 0x38, 0xa7, 0x7d, 0xe5, 0x7f, 0x0a, 0xe0, 0xda,
+	0xe1, 0xde, 0x8b, 0x56, 0x7a, 0xea, 0x3b, 0x04, 0x5a, 0x7a, 0x75, 0x90, 0x68, 0x5d, 0xd5, 0x11,
+	0xeb, 0x7a, 0xda, 0xab, 0x3a, 0xe2, 0x80, 0xa4, 0x6f, 0x0d, 0x00, 0xa4, 0xbb, 0x3a, 0xa2, 0x6e,
+	0x0d, 0xbd, 0xab, 0x23, 0xe2, 0x74, 0xfa, 0x6a, 0x9c, 0xd3, 0x9e, 0xf2, 0xd2, 0xed, 0xc7, 0xcf,
+	0x32, 0xc2, 0x93, 0x67, 0x19, 0xe1, 0xaf, 0x67, 0x19, 0xe1, 0xe1, 0xf3, 0xcc, 0xd0, 0x93, 0xe7,
+	0x99, 0xa1, 0xdf, 0x9f, 0x67, 0x86, 0x3e, 0xba, 0x1e, 0x18, 0xc1, 0x1c, 0x39, 0x6f, 0x6a, 0x75,
+	0xe2, 0x3d, 0x28, 0x9b, 0x0b, 0x05, 0x65, 0xab, 0xeb, 0xff, 0x38, 0xd8, 0x58, 0xae, 0x8f, 0x38,
+	0xef, 0x1b, 0x0b, 0xff, 0x0d, 0x00, 0xbd, 0x7e, 0xae, 0xeb, 0x06, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1283,6 +1397,8 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
@@ -1325,6 +1441,15 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -1403,6 +1528,8 @@ type MsgServer interface {
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
@@ -1429,6 +1556,9 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
@@ -1494,6 +1624,24 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -1650,6 +1798,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -1941,6 +2093,79 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2165,20 +2390,20 @@ func (m *MsgUnPoolWhitelistedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if len(m.ExitedLockIds) > 0 {
-		dAtA3 := make([]byte, len(m.ExitedLockIds)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExitedLockIds)*10)
+		var j3 int
 		for _, num := range m.ExitedLockIds {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2264,12 +2489,12 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Marsh
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -2638,6 +2863,37 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3557,6 +3813,222 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
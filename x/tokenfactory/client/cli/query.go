@@ -22,6 +22,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomAuthorityMetadata)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomsFromCreator)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdAllBeforeSendHooks)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomRestrictions)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdIsFrozen)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomMinters)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdDenomSupplyCap)
 
 	cmd.AddCommand(
 		osmocli.GetParams[*types.QueryParamsRequest](
@@ -55,6 +59,42 @@ func GetCmdAllBeforeSendHooks() (*osmocli.QueryDescriptor, *types.QueryAllBefore
 	}, &types.QueryAllBeforeSendHooksAddressesRequest{}
 }
 
+func GetCmdDenomRestrictions() (*osmocli.QueryDescriptor, *types.QueryDenomRestrictionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-restrictions",
+		Short: "Returns whether the transfers of a denom are paused, and the addresses frozen for the denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom>`,
+	}, &types.QueryDenomRestrictionsRequest{}
+}
+
+func GetCmdIsFrozen() (*osmocli.QueryDescriptor, *types.QueryIsFrozenRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "is-frozen",
+		Short: "Returns whether an address is frozen for a denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom> <address>`,
+	}, &types.QueryIsFrozenRequest{}
+}
+
+func GetCmdDenomMinters() (*osmocli.QueryDescriptor, *types.QueryDenomMintersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-minters",
+		Short: "Returns the minters of a denom and their remaining allowances",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom>`,
+	}, &types.QueryDenomMintersRequest{}
+}
+
+func GetCmdDenomSupplyCap() (*osmocli.QueryDescriptor, *types.QueryDenomSupplyCapRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "denom-supply-cap",
+		Short: "Returns the supply cap of a denom, zero for no cap, and its current supply",
+		Long: `{{.Short}}{{.ExampleHeader}}
+		{{.CommandPrefix}} factory/<creator>/<subdenom>`,
+	}, &types.QueryDenomSupplyCapRequest{}
+}
+
 // GetCmdDenomAuthorityMetadata returns the authority metadata for a queried denom
 func GetCmdDenomBeforeSendHook() *cobra.Command {
 	cmd := &cobra.Command{
@@ -56,6 +56,30 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryDenomsFromCreatorRequest{Creator: s.TestAccs[0].String()},
 			&types.QueryDenomsFromCreatorResponse{},
 		},
+		{
+			"Query denom restrictions",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomRestrictions",
+			&types.QueryDenomRestrictionsRequest{Denom: "factory%2Fosmo1zs0txy03pv5crj2rvty8wemd3zhrka2ne8u05n%2Fdenom"},
+			&types.QueryDenomRestrictionsResponse{},
+		},
+		{
+			"Query is frozen",
+			"/osmosis.tokenfactory.v1beta1.Query/IsFrozen",
+			&types.QueryIsFrozenRequest{Denom: "factory%2Fosmo1zs0txy03pv5crj2rvty8wemd3zhrka2ne8u05n%2Fdenom", Address: s.TestAccs[0].String()},
+			&types.QueryIsFrozenResponse{},
+		},
+		{
+			"Query denom minters",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomMinters",
+			&types.QueryDenomMintersRequest{Denom: "factory%2Fosmo1zs0txy03pv5crj2rvty8wemd3zhrka2ne8u05n%2Fdenom"},
+			&types.QueryDenomMintersResponse{},
+		},
+		{
+			"Query denom supply cap",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomSupplyCap",
+			&types.QueryDenomSupplyCapRequest{Denom: "factory%2Fosmo1zs0txy03pv5crj2rvty8wemd3zhrka2ne8u05n%2Fdenom"},
+			&types.QueryDenomSupplyCapResponse{},
+		},
 		{
 			"Query params",
 			"/osmosis.tokenfactory.v1beta1.Query/Params",
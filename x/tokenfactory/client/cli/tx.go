@@ -22,6 +22,13 @@ func GetTxCmd() *cobra.Command {
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHookCmd(),
 		NewMsgSetDenomMetadata(),
+		NewSetComplianceAdminCmd(),
+		NewFreezeAddressCmd(),
+		NewUnfreezeAddressCmd(),
+		NewSetDenomPausedCmd(),
+		NewSetMinterCmd(),
+		NewRemoveMinterCmd(),
+		NewSetSupplyCapCmd(),
 	)
 
 	return cmd
@@ -44,7 +51,7 @@ func NewCreateDenomCmd() *cobra.Command {
 func NewMintCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgMint](&osmocli.TxCliDesc{
 		Use:   "mint",
-		Short: "Mint a denom to an address. Must have admin authority to do so.",
+		Short: "Mint a denom to an address. Must have admin authority, or be a minter with enough allowance, to do so.",
 	})
 }
 
@@ -62,6 +69,55 @@ func NewChangeAdminCmd() *cobra.Command {
 	})
 }
 
+func NewSetComplianceAdminCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetComplianceAdmin](&osmocli.TxCliDesc{
+		Use:   "set-compliance-admin",
+		Short: "Sets the compliance admin address, able to freeze addresses and pause a factory-created denom. Must have admin authority to do so.",
+	})
+}
+
+func NewFreezeAddressCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgFreezeAddress](&osmocli.TxCliDesc{
+		Use:   "freeze-address",
+		Short: "Prevents an address from sending or receiving a factory-created denom. Must have admin or compliance admin authority to do so.",
+	})
+}
+
+func NewUnfreezeAddressCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgUnfreezeAddress](&osmocli.TxCliDesc{
+		Use:   "unfreeze-address",
+		Short: "Unfreezes an address for a factory-created denom. Must have admin or compliance admin authority to do so.",
+	})
+}
+
+func NewSetDenomPausedCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetDenomPaused](&osmocli.TxCliDesc{
+		Use:   "set-denom-paused",
+		Short: "Pauses (true) or resumes (false) every transfer of a factory-created denom. Must have admin or compliance admin authority to do so.",
+	})
+}
+
+func NewSetMinterCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetMinter](&osmocli.TxCliDesc{
+		Use:   "set-minter",
+		Short: "Allows an address to mint a factory-created denom up to the given allowance, replacing its remaining allowance. Must have admin authority to do so.",
+	})
+}
+
+func NewRemoveMinterCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgRemoveMinter](&osmocli.TxCliDesc{
+		Use:   "remove-minter",
+		Short: "Revokes the permission of a minter to mint a factory-created denom. Must have admin authority to do so.",
+	})
+}
+
+func NewSetSupplyCapCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetSupplyCap](&osmocli.TxCliDesc{
+		Use:   "set-supply-cap",
+		Short: "Sets the maximum supply of a factory-created denom, which can only be lowered once set. Must have admin authority to do so.",
+	})
+}
+
 // NewChangeAdminCmd broadcast MsgChangeAdmin
 func NewSetBeforeSendHookCmd() *cobra.Command {
 	cmd := &cobra.Command{
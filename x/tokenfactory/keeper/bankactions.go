@@ -26,6 +26,12 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return types.ErrMintToModuleAccount
 	}
 
+	err = k.checkSupplyCap(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	ctx = withRestrictionsBypass(ctx, amount.Denom)
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -52,6 +58,7 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return types.ErrBurnFromModuleAccount
 	}
 
+	ctx = withRestrictionsBypass(ctx, amount.Denom)
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
 		addr,
 		types.ModuleName,
@@ -101,7 +108,8 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		}
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	// The admin can transfer the funds of a frozen address, and while the denom is paused.
+	return k.bankKeeper.SendCoins(withRestrictionsBypass(ctx, amount.Denom), fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
 }
 
 // IsModuleAcc checks if a given address is restricted
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func WithRestrictionsBypass(ctx sdk.Context, denom string) sdk.Context {
+	return withRestrictionsBypass(ctx, denom)
+}
+
+func (k Keeper) SetMinterAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress, allowance osmomath.Int) error {
+	return k.setMinterAllowance(ctx, denom, minter, allowance)
+}
@@ -29,6 +29,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		k.setDenomPaused(ctx, genDenom.GetDenom(), genDenom.GetPaused())
+		for _, frozenAddress := range genDenom.GetFrozenAddresses() {
+			k.freezeAddress(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(frozenAddress))
+		}
+		if !genDenom.SupplyCap.IsNil() && genDenom.SupplyCap.IsPositive() {
+			err = k.setSupplyCap(ctx, genDenom.GetDenom(), genDenom.SupplyCap)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, minter := range genDenom.GetMinters() {
+			err = k.setMinterAllowance(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(minter.Address), minter.Allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -45,10 +61,28 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
+		minters, err := k.GetMinters(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+
+		genDenom := types.GenesisDenom{
 			Denom:             denom,
 			AuthorityMetadata: authorityMetadata,
-		})
+			Paused:            k.IsDenomPaused(ctx, denom),
+			FrozenAddresses:   k.GetFrozenAddresses(ctx, denom),
+			Minters:           minters,
+		}
+
+		supplyCap, found, err := k.GetSupplyCap(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
+		if found {
+			genDenom.SupplyCap = supplyCap
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	appparams "github.com/osmosis-labs/osmosis/v31/app/params"
 	"github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
 )
@@ -29,6 +30,19 @@ func (s *KeeperTestSuite) TestGenesis() {
 					Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
 				},
 			},
+			{
+				Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/restricted",
+				AuthorityMetadata: types.DenomAuthorityMetadata{
+					Admin:           "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+					ComplianceAdmin: "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn",
+				},
+				Paused:          true,
+				FrozenAddresses: []string{"osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44", "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn"},
+				SupplyCap:       osmomath.NewInt(1000000),
+				Minters: []types.DenomMinter{
+					{Address: "osmo15czt5nhlnvayqq37xun9s9yus0d6y26dw9xnzn", Allowance: osmomath.NewInt(1000)},
+				},
+			},
 		},
 	}
 
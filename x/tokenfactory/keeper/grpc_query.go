@@ -57,3 +57,64 @@ func (k Keeper) AllBeforeSendHooksAddresses(ctx context.Context, req *types.Quer
 
 	return &types.QueryAllBeforeSendHooksAddressesResponse{Denoms: denoms, BeforeSendHookAddresses: beforesendHookAddresses}, nil
 }
+
+func (k Keeper) DenomRestrictions(ctx context.Context, req *types.QueryDenomRestrictionsRequest) (*types.QueryDenomRestrictionsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	return &types.QueryDenomRestrictionsResponse{
+		Paused:          k.IsDenomPaused(sdkCtx, req.GetDenom()),
+		FrozenAddresses: k.GetFrozenAddresses(sdkCtx, req.GetDenom()),
+	}, nil
+}
+
+func (k Keeper) IsFrozen(ctx context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIsFrozenResponse{Frozen: k.IsFrozenAddress(sdkCtx, req.GetDenom(), address)}, nil
+}
+
+func (k Keeper) DenomMinters(ctx context.Context, req *types.QueryDenomMintersRequest) (*types.QueryDenomMintersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	minters, err := k.GetMinters(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomMintersResponse{Minters: minters}, nil
+}
+
+func (k Keeper) DenomSupplyCap(ctx context.Context, req *types.QueryDenomSupplyCapRequest) (*types.QueryDenomSupplyCapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	supplyCap, _, err := k.GetSupplyCap(sdkCtx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomSupplyCapResponse{
+		SupplyCap: supplyCap,
+		Supply:    k.bankKeeper.GetSupply(sdkCtx, req.GetDenom()).Amount,
+	}, nil
+}
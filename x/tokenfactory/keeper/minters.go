@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
+)
+
+func (k Keeper) getMintersStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetMintersPrefix())
+}
+
+func (k Keeper) setMinterAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress, allowance osmomath.Int) error {
+	bz, err := allowance.Marshal()
+	if err != nil {
+		return err
+	}
+	k.getMintersStore(ctx, denom).Set(minter, bz)
+	return nil
+}
+
+func (k Keeper) removeMinter(ctx sdk.Context, denom string, minter sdk.AccAddress) error {
+	store := k.getMintersStore(ctx, denom)
+	if !store.Has(minter) {
+		return types.ErrMinterNotFound.Wrapf("minter %s for denom %s", minter, denom)
+	}
+	store.Delete(minter)
+	return nil
+}
+
+// removeAllMinters revokes the permission of every minter of the denom.
+func (k Keeper) removeAllMinters(ctx sdk.Context, denom string) {
+	store := k.getMintersStore(ctx, denom)
+	iterator := store.Iterator(nil, nil)
+	var minters [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		minters = append(minters, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, minter := range minters {
+		store.Delete(minter)
+	}
+}
+
+// GetMinterAllowance returns the amount of the denom the minter can still mint,
+// and false if the address is not a minter of the denom.
+func (k Keeper) GetMinterAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) (osmomath.Int, bool, error) {
+	bz := k.getMintersStore(ctx, denom).Get(minter)
+	if bz == nil {
+		return osmomath.ZeroInt(), false, nil
+	}
+
+	var allowance osmomath.Int
+	err := allowance.Unmarshal(bz)
+	if err != nil {
+		return osmomath.ZeroInt(), false, err
+	}
+	return allowance, true, nil
+}
+
+// GetMinters returns the minters of the denom with their remaining allowances.
+func (k Keeper) GetMinters(ctx sdk.Context, denom string) ([]types.DenomMinter, error) {
+	iterator := k.getMintersStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var minters []types.DenomMinter
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance osmomath.Int
+		err := allowance.Unmarshal(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		minters = append(minters, types.DenomMinter{
+			Address:   sdk.AccAddress(iterator.Key()).String(),
+			Allowance: allowance,
+		})
+	}
+	return minters, nil
+}
+
+// useMintAllowance deducts the minted amount from the allowance of the minter,
+// failing if the sender is not a minter of the denom or if the amount exceeds its allowance.
+func (k Keeper) useMintAllowance(ctx sdk.Context, amount sdk.Coin, sender string) error {
+	minter, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	allowance, found, err := k.GetMinterAllowance(ctx, amount.Denom, minter)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return types.ErrUnauthorized
+	}
+	if allowance.LT(amount.Amount) {
+		return types.ErrMintAllowanceExceeded.Wrapf("allowance %s, mint amount %s", allowance, amount.Amount)
+	}
+
+	return k.setMinterAllowance(ctx, amount.Denom, minter, allowance.Sub(amount.Amount))
+}
+
+func (k Keeper) setSupplyCap(ctx sdk.Context, denom string, supplyCap osmomath.Int) error {
+	bz, err := supplyCap.Marshal()
+	if err != nil {
+		return err
+	}
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.SupplyCapKey), bz)
+	return nil
+}
+
+// GetSupplyCap returns the maximum supply of the denom, and false if the denom has no supply cap.
+func (k Keeper) GetSupplyCap(ctx sdk.Context, denom string) (osmomath.Int, bool, error) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.SupplyCapKey))
+	if bz == nil {
+		return osmomath.ZeroInt(), false, nil
+	}
+
+	var supplyCap osmomath.Int
+	err := supplyCap.Unmarshal(bz)
+	if err != nil {
+		return osmomath.ZeroInt(), false, err
+	}
+	return supplyCap, true, nil
+}
+
+// checkSupplyCap fails if minting the amount would bring the supply of the denom above its supply cap.
+func (k Keeper) checkSupplyCap(ctx sdk.Context, amount sdk.Coin) error {
+	supplyCap, found, err := k.GetSupplyCap(ctx, amount.Denom)
+	if err != nil || !found {
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	if supply.Add(amount.Amount).GT(supplyCap) {
+		return types.ErrSupplyCapExceeded.Wrapf("supply %s, mint amount %s, supply cap %s", supply, amount.Amount, supplyCap)
+	}
+	return nil
+}
+
+// updateSupplyCap sets the supply cap of the denom, which cannot be below the current supply
+// and cannot be raised once set.
+func (k Keeper) updateSupplyCap(ctx sdk.Context, denom string, supplyCap osmomath.Int) error {
+	currentCap, found, err := k.GetSupplyCap(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if found && supplyCap.GT(currentCap) {
+		return types.ErrInvalidSupplyCap.Wrapf("supply cap %s cannot be raised above %s", supplyCap, currentCap)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supplyCap.LT(supply) {
+		return types.ErrInvalidSupplyCap.Wrapf("supply cap %s is below the current supply %s", supplyCap, supply)
+	}
+
+	return k.setSupplyCap(ctx, denom, supplyCap)
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestMinters() {
+	s.CreateDefaultDenom()
+	admin, minter, other := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+
+	// Only the admin can set minters
+	_, err := s.msgServer.SetMinter(s.Ctx, types.NewMsgSetMinter(other.String(), s.defaultDenom, minter.String(), osmomath.NewInt(100)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetMinter(s.Ctx, types.NewMsgSetMinter(admin.String(), s.defaultDenom, minter.String(), osmomath.NewInt(100)))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgSetMinter, 1)
+
+	// The minter can mint to any address within its allowance
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMintTo(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 60), other.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(60), s.App.BankKeeper.GetBalance(s.Ctx, other, s.defaultDenom).Amount.Int64())
+
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 50)))
+	s.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	res, err := s.queryClient.DenomMinters(s.Ctx.Context(), &types.QueryDenomMintersRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomMinter{{Address: minter.String(), Allowance: osmomath.NewInt(40)}}, res.Minters)
+
+	// The minter cannot burn, and other addresses cannot mint
+	_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurn(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// The admin minting does not use any allowance
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1000)))
+	s.Require().NoError(err)
+
+	// Setting the minter again replaces its allowance
+	_, err = s.msgServer.SetMinter(s.Ctx, types.NewMsgSetMinter(admin.String(), s.defaultDenom, minter.String(), osmomath.NewInt(500)))
+	s.Require().NoError(err)
+	allowance, found, err := s.App.TokenFactoryKeeper.GetMinterAllowance(s.Ctx, s.defaultDenom, minter)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(osmomath.NewInt(500), allowance)
+
+	// Removing the minter revokes its permission
+	_, err = s.msgServer.RemoveMinter(s.Ctx, types.NewMsgRemoveMinter(admin.String(), s.defaultDenom, minter.String()))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeMsgRemoveMinter, 1)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.RemoveMinter(s.Ctx, types.NewMsgRemoveMinter(admin.String(), s.defaultDenom, minter.String()))
+	s.Require().ErrorIs(err, types.ErrMinterNotFound)
+}
+
+func (s *KeeperTestSuite) TestSupplyCap() {
+	s.CreateDefaultDenom()
+	admin, minter, other := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+
+	_, err := s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 100)))
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc        string
+		sender      string
+		supplyCap   int64
+		expectedErr error
+	}{
+		{
+			desc:        "not the admin",
+			sender:      other.String(),
+			supplyCap:   1000,
+			expectedErr: types.ErrUnauthorized,
+		},
+		{
+			desc:        "below the current supply",
+			sender:      admin.String(),
+			supplyCap:   99,
+			expectedErr: types.ErrInvalidSupplyCap,
+		},
+		{
+			desc:      "set the supply cap",
+			sender:    admin.String(),
+			supplyCap: 1000,
+		},
+		{
+			desc:        "raise the supply cap",
+			sender:      admin.String(),
+			supplyCap:   1001,
+			expectedErr: types.ErrInvalidSupplyCap,
+		},
+		{
+			desc:      "lower the supply cap",
+			sender:    admin.String(),
+			supplyCap: 500,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			_, err := s.msgServer.SetSupplyCap(s.Ctx, types.NewMsgSetSupplyCap(tc.sender, s.defaultDenom, osmomath.NewInt(tc.supplyCap)))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	res, err := s.queryClient.DenomSupplyCap(s.Ctx.Context(), &types.QueryDenomSupplyCapRequest{Denom: s.defaultDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewInt(500), res.SupplyCap)
+	s.Require().Equal(osmomath.NewInt(100), res.Supply)
+
+	// The supply cap applies to the admin and the minters
+	_, err = s.msgServer.SetMinter(s.Ctx, types.NewMsgSetMinter(admin.String(), s.defaultDenom, minter.String(), osmomath.NewInt(1000)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 401)))
+	s.Require().ErrorIs(err, types.ErrSupplyCapExceeded)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 400)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 1)))
+	s.Require().ErrorIs(err, types.ErrSupplyCapExceeded)
+
+	// Burning frees room under the supply cap
+	_, err = s.msgServer.Burn(s.Ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMintersRemovedOnChangeAdmin() {
+	tests := map[string]struct {
+		newAdmin func(s *KeeperTestSuite) string
+	}{
+		"change to another admin": {
+			newAdmin: func(s *KeeperTestSuite) string { return s.TestAccs[2].String() },
+		},
+		"renounce the admin": {
+			newAdmin: func(s *KeeperTestSuite) string { return "" },
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.CreateDefaultDenom()
+			admin, minter := s.TestAccs[0], s.TestAccs[1]
+
+			_, err := s.msgServer.SetMinter(s.Ctx, types.NewMsgSetMinter(admin.String(), s.defaultDenom, minter.String(), osmomath.NewInt(100)))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.ChangeAdmin(s.Ctx, types.NewMsgChangeAdmin(admin.String(), s.defaultDenom, tc.newAdmin(s)))
+			s.Require().NoError(err)
+
+			// The minters granted by the previous admin are removed
+			minters, err := s.App.TokenFactoryKeeper.GetMinters(s.Ctx, s.defaultDenom)
+			s.Require().NoError(err)
+			s.Require().Empty(minters)
+			_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMinterCannotMintWithoutAdmin() {
+	s.CreateDefaultDenom()
+	admin, minter := s.TestAccs[0], s.TestAccs[1]
+
+	_, err := s.msgServer.ChangeAdmin(s.Ctx, types.NewMsgChangeAdmin(admin.String(), s.defaultDenom, ""))
+	s.Require().NoError(err)
+
+	// A minter of a denom without admin, such as one imported from genesis, cannot mint
+	err = s.App.TokenFactoryKeeper.SetMinterAllowance(s.Ctx, s.defaultDenom, minter, osmomath.NewInt(100))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.Ctx, types.NewMsgMint(minter.String(), sdk.NewInt64Coin(s.defaultDenom, 10)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
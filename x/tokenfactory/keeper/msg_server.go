@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -55,8 +56,16 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
+	// the minters of the denom can mint besides the admin, up to their allowance,
+	// as long as the denom has an admin.
 	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+		if authorityMetadata.GetAdmin() == "" {
+			return nil, types.ErrUnauthorized
+		}
+		err = server.Keeper.useMintAllowance(ctx, msg.Amount, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if msg.MintToAddress == "" {
@@ -162,6 +171,9 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 	if err != nil {
 		return nil, err
 	}
+	// The minters were granted by the previous admin, the new admin sets its own.
+	server.Keeper.removeAllMinters(ctx, msg.Denom)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgChangeAdmin,
@@ -231,3 +243,224 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetComplianceAdmin(goCtx context.Context, msg *types.MsgSetComplianceAdmin) (*types.MsgSetComplianceAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	authorityMetadata.ComplianceAdmin = msg.ComplianceAdmin
+	err = server.Keeper.setAuthorityMetadata(ctx, msg.Denom, authorityMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetComplianceAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeComplianceAdmin, msg.ComplianceAdmin),
+		),
+	})
+
+	return &types.MsgSetComplianceAdminResponse{}, nil
+}
+
+func (server msgServer) FreezeAddress(goCtx context.Context, msg *types.MsgFreezeAddress) (*types.MsgFreezeAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := server.authorizeCompliance(ctx, msg.Sender, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.freezeAddress(ctx, msg.Denom, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgFreezeAddress,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgFreezeAddressResponse{}, nil
+}
+
+func (server msgServer) UnfreezeAddress(goCtx context.Context, msg *types.MsgUnfreezeAddress) (*types.MsgUnfreezeAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := server.authorizeCompliance(ctx, msg.Sender, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	server.Keeper.unfreezeAddress(ctx, msg.Denom, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUnfreezeAddress,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgUnfreezeAddressResponse{}, nil
+}
+
+func (server msgServer) SetDenomPaused(goCtx context.Context, msg *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.IsComplianceAuthorized(msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.Keeper.setDenomPaused(ctx, msg.Denom, msg.Paused)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (server msgServer) SetMinter(goCtx context.Context, msg *types.MsgSetMinter) (*types.MsgSetMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter, err := server.authorizeAdmin(ctx, msg.Sender, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setMinterAllowance(ctx, msg.Denom, minter, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeAllowance, msg.Allowance.String()),
+		),
+	})
+
+	return &types.MsgSetMinterResponse{}, nil
+}
+
+func (server msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter, err := server.authorizeAdmin(ctx, msg.Sender, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.removeMinter(ctx, msg.Denom, minter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRemoveMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgRemoveMinterResponse{}, nil
+}
+
+func (server msgServer) SetSupplyCap(goCtx context.Context, msg *types.MsgSetSupplyCap) (*types.MsgSetSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// pay some extra gas cost to give a better error here.
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.updateSupplyCap(ctx, msg.Denom, msg.SupplyCap)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetSupplyCap,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeSupplyCap, msg.SupplyCap.String()),
+		),
+	})
+
+	return &types.MsgSetSupplyCapResponse{}, nil
+}
+
+// authorizeAdmin checks that the sender is the admin of the denom,
+// and returns the address of the minter to set or remove.
+func (server msgServer) authorizeAdmin(ctx sdk.Context, sender, denom, address string) (sdk.AccAddress, error) {
+	// pay some extra gas cost to give a better error here.
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	return sdk.AccAddressFromBech32(address)
+}
+
+// authorizeCompliance checks that the sender is the admin or the compliance admin of the denom,
+// and returns the address to freeze or unfreeze.
+func (server msgServer) authorizeCompliance(ctx sdk.Context, sender, denom, address string) (sdk.AccAddress, error) {
+	// pay some extra gas cost to give a better error here.
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.IsComplianceAuthorized(sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	return sdk.AccAddressFromBech32(address)
+}
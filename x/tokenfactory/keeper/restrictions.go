@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
+)
+
+// restrictionsBypassKey is the context key set by the admin actions (mint, burn and force transfer),
+// which are not subject to the freezes and pauses of the denom. Its value is the denom of the action,
+// so that the sends of other denoms made during the action, such as by a before send hook, are
+// still restricted.
+type restrictionsBypassKey struct{}
+
+func withRestrictionsBypass(ctx sdk.Context, denom string) sdk.Context {
+	return ctx.WithValue(restrictionsBypassKey{}, denom)
+}
+
+func hasRestrictionsBypass(ctx context.Context, denom string) bool {
+	bypassDenom, ok := ctx.Value(restrictionsBypassKey{}).(string)
+	return ok && bypassDenom == denom
+}
+
+func (k Keeper) setDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if paused {
+		store.Set([]byte(types.DenomPausedKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomPausedKey))
+	}
+}
+
+// IsDenomPaused returns true if every transfer of the denom is paused.
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+func (k Keeper) getFrozenAddressesStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAddressesPrefix())
+}
+
+func (k Keeper) freezeAddress(ctx sdk.Context, denom string, address sdk.AccAddress) {
+	k.getFrozenAddressesStore(ctx, denom).Set(address, []byte{1})
+}
+
+func (k Keeper) unfreezeAddress(ctx sdk.Context, denom string, address sdk.AccAddress) {
+	k.getFrozenAddressesStore(ctx, denom).Delete(address)
+}
+
+// IsFrozenAddress returns true if the address can neither send nor receive the denom.
+func (k Keeper) IsFrozenAddress(ctx sdk.Context, denom string, address sdk.AccAddress) bool {
+	return k.getFrozenAddressesStore(ctx, denom).Has(address)
+}
+
+// GetFrozenAddresses returns the addresses frozen for the denom.
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, denom string) []string {
+	iterator := k.getFrozenAddressesStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var frozenAddresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		frozenAddresses = append(frozenAddresses, sdk.AccAddress(iterator.Key()).String())
+	}
+	return frozenAddresses
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestriction
+
+// SendRestriction is the bank send restriction refusing the transfers of the factory denoms that are paused,
+// or sent from or to a frozen address. The mints, burns and force transfers of the denom admin are not restricted
+// for the denom they act on.
+func (k Keeper) SendRestriction(goCtx context.Context, from, to sdk.AccAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, coin := range amount {
+		// Only read the state of factory denoms, every send of any denom goes through this restriction.
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+		if hasRestrictionsBypass(goCtx, coin.Denom) {
+			continue
+		}
+
+		if k.IsDenomPaused(ctx, coin.Denom) {
+			return to, types.ErrDenomPaused.Wrapf("denom: %s", coin.Denom)
+		}
+		if k.IsFrozenAddress(ctx, coin.Denom, from) {
+			return to, types.ErrAddressFrozen.Wrapf("sender %s is frozen for denom %s", from, coin.Denom)
+		}
+		if k.IsFrozenAddress(ctx, coin.Denom, to) {
+			return to, types.ErrAddressFrozen.Wrapf("recipient %s is frozen for denom %s", to, coin.Denom)
+		}
+	}
+	return to, nil
+}
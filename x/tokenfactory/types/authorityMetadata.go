@@ -11,5 +11,19 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+	if metadata.ComplianceAdmin != "" {
+		_, err := sdk.AccAddressFromBech32(metadata.ComplianceAdmin)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// IsComplianceAuthorized returns true if the address is the admin or the compliance admin of the denom.
+func (metadata DenomAuthorityMetadata) IsComplianceAuthorized(address string) bool {
+	if address == "" {
+		return false
+	}
+	return address == metadata.Admin || address == metadata.ComplianceAdmin
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,11 +26,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin has every permission over
+// the denom, the compliance admin can only freeze addresses and pause the
+// denom.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Can be empty for no compliance admin, or a valid osmosis address
+	ComplianceAdmin string `protobuf:"bytes,2,opt,name=compliance_admin,json=complianceAdmin,proto3" json:"compliance_admin,omitempty" yaml:"compliance_admin"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +76,65 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetComplianceAdmin() string {
+	if m != nil {
+		return m.ComplianceAdmin
+	}
+	return ""
+}
+
+// DenomMinter is an address allowed by the admin of a denom to mint the denom,
+// up to its remaining allowance.
+type DenomMinter struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// allowance is the amount the minter can still mint, decreased by every mint
+	// of the minter.
+	Allowance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance" yaml:"allowance"`
+}
+
+func (m *DenomMinter) Reset()         { *m = DenomMinter{} }
+func (m *DenomMinter) String() string { return proto.CompactTextString(m) }
+func (*DenomMinter) ProtoMessage()    {}
+func (*DenomMinter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+func (m *DenomMinter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMinter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMinter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMinter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMinter.Merge(m, src)
+}
+func (m *DenomMinter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMinter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMinter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMinter proto.InternalMessageInfo
+
+func (m *DenomMinter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomMinter)(nil), "osmosis.tokenfactory.v1beta1.DenomMinter")
 }
 
 func init() {
@@ -81,22 +142,30 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x53, 0xd0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0xa0, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97,
-	0x19, 0x1b, 0xea, 0x57, 0xa0, 0x86, 0x44, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x3b, 0xcd, 0x5a, 0x2e, 0x01, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x33, 0x97, 0x7b, 0xaf, 0x34, 0x8a, 0x96, 0xe0, 0x3f, 0xaa, 0x24, 0x92, 0x85, 0xb8,
+	0xd0, 0x0c, 0xa1, 0x2e, 0xa4, 0xbb, 0x16, 0x11, 0x5c, 0x14, 0x21, 0x4b, 0x37, 0x72, 0x92, 0x8c,
+	0xed, 0xd0, 0x24, 0xa7, 0x64, 0xa6, 0xd5, 0xbc, 0x84, 0x88, 0x4f, 0xe0, 0xe3, 0x74, 0xd9, 0xa5,
+	0xb8, 0x08, 0xd2, 0x6e, 0x5c, 0xe7, 0x09, 0xa4, 0xf9, 0x63, 0xb5, 0xbb, 0xe4, 0x9c, 0xef, 0xf7,
+	0x9d, 0x8f, 0xf9, 0xd4, 0x73, 0x14, 0x21, 0x0a, 0x2e, 0xa8, 0xc4, 0x01, 0x8b, 0xee, 0xc1, 0x93,
+	0x18, 0x27, 0x74, 0x6c, 0xbb, 0x4c, 0x82, 0x4d, 0x61, 0x24, 0xfb, 0x18, 0x73, 0x99, 0x74, 0x99,
+	0x04, 0x1f, 0x24, 0x58, 0xc3, 0x18, 0x25, 0x6a, 0x87, 0x25, 0x65, 0xfd, 0xa4, 0xac, 0x92, 0x6a,
+	0x6c, 0xf7, 0xb0, 0x87, 0xb9, 0x90, 0x2e, 0xbe, 0x0a, 0xa6, 0xa1, 0x7b, 0x39, 0x44, 0x5d, 0x10,
+	0xec, 0xfb, 0x80, 0x87, 0x3c, 0x2a, 0xf6, 0xe6, 0x13, 0x51, 0x77, 0x2f, 0x59, 0x84, 0x61, 0x7b,
+	0xf5, 0xa8, 0x76, 0xac, 0xfe, 0x03, 0x3f, 0xe4, 0xd1, 0x3e, 0x39, 0x22, 0x27, 0xb5, 0x4e, 0x3d,
+	0x4b, 0x8d, 0x8d, 0x04, 0xc2, 0xa0, 0x65, 0xe6, 0x63, 0xd3, 0x29, 0xd6, 0xda, 0x95, 0x5a, 0xf7,
+	0x30, 0x1c, 0x06, 0x1c, 0x22, 0x8f, 0xdd, 0x15, 0xc8, 0x9f, 0x1c, 0x39, 0xc8, 0x52, 0x63, 0xaf,
+	0x40, 0x56, 0x15, 0xa6, 0xb3, 0xb5, 0x1c, 0xb5, 0x17, 0x93, 0xd6, 0xdf, 0xcf, 0x57, 0x83, 0x98,
+	0x2f, 0x44, 0x5d, 0xcf, 0x03, 0x75, 0x79, 0x24, 0x59, 0xac, 0x9d, 0xaa, 0x6b, 0xe0, 0xfb, 0x31,
+	0x13, 0xa2, 0xcc, 0xa1, 0x65, 0xa9, 0xb1, 0x59, 0xe5, 0xc8, 0x17, 0xa6, 0x53, 0x49, 0xb4, 0x1b,
+	0xb5, 0x06, 0x41, 0x80, 0x0f, 0x0b, 0xd7, 0x32, 0x84, 0x3d, 0x49, 0x0d, 0xe5, 0x3d, 0x35, 0x76,
+	0x8a, 0x97, 0x10, 0xfe, 0xc0, 0xe2, 0x48, 0x43, 0x90, 0x7d, 0xeb, 0x3a, 0x92, 0x59, 0x6a, 0xd4,
+	0x4b, 0xb3, 0x8a, 0x33, 0x9d, 0xa5, 0x47, 0x11, 0xaa, 0xe3, 0x4c, 0x66, 0x3a, 0x99, 0xce, 0x74,
+	0xf2, 0x31, 0xd3, 0xc9, 0xf3, 0x5c, 0x57, 0xa6, 0x73, 0x5d, 0x79, 0x9b, 0xeb, 0xca, 0xed, 0x45,
+	0x8f, 0xcb, 0xfe, 0xc8, 0xb5, 0x3c, 0x0c, 0x69, 0x59, 0xcf, 0x59, 0x00, 0xae, 0xa8, 0x7e, 0xe8,
+	0xb8, 0x69, 0xd3, 0xc7, 0xdf, 0x3d, 0xcb, 0x64, 0xc8, 0x84, 0xfb, 0x3f, 0x2f, 0xa0, 0xf9, 0x35,
+	0x00, 0xdb, 0x89, 0xfd, 0xae, 0x0c, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +190,36 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.ComplianceAdmin != that1.ComplianceAdmin {
+		return false
+	}
+	return true
+}
+func (this *DenomMinter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomMinter)
+	if !ok {
+		that2, ok := that.(DenomMinter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Allowance.Equal(that1.Allowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +242,13 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ComplianceAdmin) > 0 {
+		i -= len(m.ComplianceAdmin)
+		copy(dAtA[i:], m.ComplianceAdmin)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ComplianceAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +259,46 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMinter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMinter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMinter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +320,25 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	l = len(m.ComplianceAdmin)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomMinter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
 	return n
 }
 
@@ -244,6 +409,154 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMinter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMinter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMinter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -18,6 +18,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "osmosis/tokenfactory/set-denom-metadata")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-bef-send-hook")
 	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer")
+	legacy.RegisterAminoMsg(cdc, &MsgSetComplianceAdmin{}, "osmosis/tokenfactory/set-compliance")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeAddress{}, "osmosis/tokenfactory/freeze-address")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeAddress{}, "osmosis/tokenfactory/unfreeze-address")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomPaused{}, "osmosis/tokenfactory/set-denom-paused")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMinter{}, "osmosis/tokenfactory/set-minter")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveMinter{}, "osmosis/tokenfactory/remove-minter")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSupplyCap{}, "osmosis/tokenfactory/set-supply-cap")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +37,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetDenomMetadata{},
 		&MsgSetBeforeSendHook{},
 		&MsgForceTransfer{},
+		&MsgSetComplianceAdmin{},
+		&MsgFreezeAddress{},
+		&MsgUnfreezeAddress{},
+		&MsgSetDenomPaused{},
+		&MsgSetMinter{},
+		&MsgRemoveMinter{},
+		&MsgSetSupplyCap{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -22,4 +22,10 @@ var (
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
 	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
 	ErrMintToModuleAccount      = errorsmod.Register(ModuleName, 13, "minting to Module Account is not allowed")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 14, "transfers of the denom are paused")
+	ErrAddressFrozen            = errorsmod.Register(ModuleName, 15, "address is frozen for the denom")
+	ErrMinterNotFound           = errorsmod.Register(ModuleName, 16, "minter not found for the denom")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 17, "mint amount exceeds the allowance of the minter")
+	ErrSupplyCapExceeded        = errorsmod.Register(ModuleName, 18, "mint amount exceeds the supply cap of the denom")
+	ErrInvalidSupplyCap         = errorsmod.Register(ModuleName, 19, "invalid supply cap")
 )
@@ -14,4 +14,9 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeComplianceAdmin       = "compliance_admin"
+	AttributeAddress               = "address"
+	AttributePaused                = "paused"
+	AttributeAllowance             = "allowance"
+	AttributeSupplyCap             = "supply_cap"
 )
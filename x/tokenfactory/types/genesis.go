@@ -45,6 +45,46 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.AuthorityMetadata.ComplianceAdmin != "" {
+			_, err = sdk.AccAddressFromBech32(denom.AuthorityMetadata.ComplianceAdmin)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid compliance admin address (%s)", err)
+			}
+		}
+
+		seenFrozenAddresses := map[string]bool{}
+		for _, address := range denom.GetFrozenAddresses() {
+			if seenFrozenAddresses[address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen address %s for denom %s", address, denom.GetDenom())
+			}
+			seenFrozenAddresses[address] = true
+
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid frozen address (%s)", err)
+			}
+		}
+
+		if !denom.SupplyCap.IsNil() && denom.SupplyCap.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidSupplyCap, "negative supply cap for denom %s", denom.GetDenom())
+		}
+
+		seenMinters := map[string]bool{}
+		for _, minter := range denom.GetMinters() {
+			if seenMinters[minter.Address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate minter %s for denom %s", minter.Address, denom.GetDenom())
+			}
+			seenMinters[minter.Address] = true
+
+			_, err = sdk.AccAddressFromBech32(minter.Address)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid minter address (%s)", err)
+			}
+			if minter.Allowance.IsNil() || minter.Allowance.IsNegative() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid allowance of minter %s for denom %s", minter.Address, denom.GetDenom())
+			}
+		}
 	}
 
 	return nil
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -83,6 +84,15 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// paused is true when every transfer of the denom is paused.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// frozen_addresses are the addresses that can neither send nor receive the
+	// denom.
+	FrozenAddresses []string `protobuf:"bytes,4,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	// supply_cap is the maximum supply of the denom, zero for no cap.
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap" yaml:"supply_cap"`
+	// minters are the addresses allowed to mint the denom besides the admin.
+	Minters []DenomMinter `protobuf:"bytes,6,rep,name=minters,proto3" json:"minters" yaml:"minters"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +142,27 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetMinters() []DenomMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +173,39 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xb1,
-	0xa1, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xac, 0x1d, 0x3c, 0x33, 0xac, 0x02, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0xd6, 0xae, 0x50, 0xef, 0x0f, 0xab, 0xc5, 0x20, 0x0c, 0x48, 0x4a, 0x84, 0x50, 0x3b,
+	0x89, 0x44, 0xed, 0x76, 0x40, 0xbb, 0xcd, 0x4c, 0x20, 0x0e, 0x93, 0xc0, 0xdc, 0xe0, 0x50, 0xb9,
+	0x8d, 0xd7, 0x46, 0x6b, 0xe2, 0x28, 0x76, 0x27, 0xc2, 0x07, 0xe0, 0xcc, 0x47, 0xe0, 0xc3, 0x70,
+	0xd8, 0x71, 0x47, 0xc4, 0x21, 0x42, 0xed, 0x65, 0xe7, 0x7c, 0x02, 0x14, 0xdb, 0x1d, 0x6c, 0x95,
+	0xa2, 0xdd, 0xe2, 0x97, 0xf7, 0xde, 0xef, 0xfd, 0x9e, 0x65, 0xb0, 0xcb, 0x78, 0xc8, 0x78, 0xc0,
+	0x3d, 0xc1, 0x4e, 0x69, 0x74, 0x42, 0x86, 0x82, 0x25, 0xa9, 0x77, 0xd6, 0x1d, 0x50, 0x41, 0xba,
+	0xde, 0x88, 0x46, 0x94, 0x07, 0xdc, 0x8d, 0x13, 0x26, 0x18, 0x7c, 0xa2, 0xb9, 0xee, 0xff, 0x5c,
+	0x57, 0x73, 0x77, 0xee, 0x8f, 0xd8, 0x88, 0x49, 0xa2, 0x57, 0x7c, 0x29, 0xcd, 0xce, 0x7e, 0xa9,
+	0x3f, 0x99, 0x8a, 0x31, 0x4b, 0x02, 0x91, 0x1e, 0x53, 0x41, 0x7c, 0x22, 0x88, 0x56, 0x75, 0x4a,
+	0x55, 0x31, 0x49, 0x48, 0xa8, 0x43, 0x39, 0x3f, 0x0d, 0xb0, 0xfe, 0x56, 0xc5, 0xfc, 0x28, 0x88,
+	0xa0, 0x10, 0x81, 0xba, 0x22, 0x98, 0x46, 0xcb, 0x68, 0xaf, 0xf5, 0x9e, 0xbb, 0x65, 0xb1, 0xdd,
+	0xf7, 0x92, 0x8b, 0x6a, 0xe7, 0x99, 0x5d, 0xc1, 0x5a, 0x09, 0x63, 0xb0, 0xa9, 0x79, 0x7d, 0x9f,
+	0x46, 0x2c, 0xe4, 0xe6, 0x4a, 0xab, 0xda, 0x5e, 0xeb, 0xed, 0x96, 0x7b, 0xe9, 0x1c, 0x47, 0x85,
+	0x04, 0x3d, 0x2d, 0x1c, 0xf3, 0xcc, 0xde, 0x4e, 0x49, 0x38, 0x39, 0x70, 0xae, 0xfb, 0x39, 0x78,
+	0x43, 0x03, 0x47, 0xea, 0x7c, 0x59, 0xbd, 0x5a, 0x43, 0x22, 0xf0, 0x05, 0x58, 0x95, 0x54, 0xb9,
+	0x45, 0x03, 0x6d, 0xe5, 0x99, 0xbd, 0xae, 0x9c, 0x24, 0xec, 0x60, 0xf5, 0x1b, 0x7e, 0x33, 0x00,
+	0xbc, 0xaa, 0xb1, 0x1f, 0xea, 0x1e, 0xcd, 0x15, 0xb9, 0xfb, 0x7e, 0x79, 0x5e, 0x39, 0xe9, 0xf0,
+	0xe6, 0x1d, 0xa0, 0x67, 0x3a, 0xf9, 0x23, 0x35, 0x6f, 0xd9, 0xdd, 0xc1, 0xcd, 0xa5, 0x9b, 0x83,
+	0x9d, 0xa2, 0xf7, 0x29, 0xa7, 0xbe, 0x59, 0x6d, 0x19, 0xed, 0xbb, 0xa8, 0x99, 0x67, 0xf6, 0x86,
+	0x72, 0x50, 0xb8, 0x83, 0x35, 0x01, 0xbe, 0x01, 0x5b, 0x27, 0x09, 0xfb, 0x4a, 0xa3, 0x3e, 0xf1,
+	0xfd, 0x84, 0x72, 0x4e, 0xb9, 0x59, 0x6b, 0x55, 0xdb, 0x0d, 0xf4, 0x38, 0xcf, 0xec, 0x87, 0xba,
+	0xb0, 0x1b, 0x0c, 0x07, 0xdf, 0x53, 0xd0, 0xe1, 0x02, 0x81, 0x1f, 0x00, 0xe0, 0xd3, 0x38, 0x9e,
+	0xa4, 0xfd, 0x21, 0x89, 0xcd, 0x55, 0x59, 0x54, 0xaf, 0x08, 0xff, 0x3b, 0xb3, 0xb7, 0x87, 0x72,
+	0x75, 0xee, 0x9f, 0xba, 0x01, 0xf3, 0x42, 0x22, 0xc6, 0xee, 0xbb, 0x48, 0xe4, 0x99, 0xdd, 0x54,
+	0xf6, 0xff, 0x84, 0x0e, 0x6e, 0xa8, 0xc3, 0x6b, 0x12, 0xc3, 0xcf, 0xe0, 0x4e, 0x18, 0x44, 0x82,
+	0x26, 0xdc, 0xac, 0xcb, 0x2b, 0xef, 0xdc, 0xa2, 0xc2, 0x63, 0xa9, 0x40, 0x0f, 0x74, 0x6f, 0x9b,
+	0x6a, 0x82, 0xf6, 0x71, 0xf0, 0xc2, 0xf1, 0xa0, 0x76, 0xf9, 0xc3, 0x36, 0x10, 0x3e, 0x9f, 0x59,
+	0xc6, 0xc5, 0xcc, 0x32, 0xfe, 0xcc, 0x2c, 0xe3, 0xfb, 0xdc, 0xaa, 0x5c, 0xcc, 0xad, 0xca, 0xaf,
+	0xb9, 0x55, 0xf9, 0xf4, 0x6a, 0x14, 0x88, 0xf1, 0x74, 0xe0, 0x0e, 0x59, 0xe8, 0xe9, 0xa9, 0x2f,
+	0x27, 0x64, 0xc0, 0x17, 0x07, 0xef, 0x6c, 0xaf, 0xeb, 0x7d, 0xb9, 0xfe, 0x28, 0x44, 0x1a, 0x53,
+	0x3e, 0xa8, 0xcb, 0xc7, 0xb0, 0xf7, 0x77, 0x00, 0x20, 0xe7, 0xeb, 0x57, 0xcf, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +233,28 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
+	if !this.SupplyCap.Equal(that1.SupplyCap) {
+		return false
+	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if !this.Minters[i].Equal(&that1.Minters[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +324,49 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +427,23 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +664,126 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, DenomMinter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
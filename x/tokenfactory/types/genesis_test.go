@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v31/x/tokenfactory/types"
 )
 
@@ -33,6 +34,121 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:           "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							ComplianceAdmin: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9",
+						},
+						Paused:          true,
+						FrozenAddresses: []string{"osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						FrozenAddresses: []string{"osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9", "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid frozen address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						FrozenAddresses: []string{"frozen"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid minters and supply cap",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						SupplyCap: osmomath.NewInt(1000),
+						Minters: []types.DenomMinter{
+							{Address: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9", Allowance: osmomath.NewInt(100)},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative minter allowance",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						Minters: []types.DenomMinter{
+							{Address: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9", Allowance: osmomath.NewInt(-1)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate minters",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						Minters: []types.DenomMinter{
+							{Address: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9", Allowance: osmomath.NewInt(100)},
+							{Address: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9", Allowance: osmomath.NewInt(200)},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative supply cap",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+						},
+						SupplyCap: osmomath.NewInt(-1),
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "different admin from creator",
 			genState: &types.GenesisState{
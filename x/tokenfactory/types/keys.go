@@ -30,6 +30,10 @@ var (
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	DenomPausedKey                 = "paused"
+	FrozenAddressPrefixKey         = "frozen"
+	SupplyCapKey                   = "supplycap"
+	MinterPrefixKey                = "minter"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -38,6 +42,16 @@ func GetDenomPrefixStore(denom string) []byte {
 	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
 }
 
+// GetFrozenAddressesPrefix returns the prefix, within the store of a denom, of the addresses frozen for the denom
+func GetFrozenAddressesPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, ""}, KeySeparator))
+}
+
+// GetMintersPrefix returns the prefix, within the store of a denom, of the minters of the denom and their allowances
+func GetMintersPrefix() []byte {
+	return []byte(strings.Join([]string{MinterPrefixKey, ""}, KeySeparator))
+}
+
 // GetCreatorsPrefix returns the store prefix where the list of the denoms created by a specific
 // creator are stored
 func GetCreatorPrefix(creator string) []byte {
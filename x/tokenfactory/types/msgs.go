@@ -11,13 +11,20 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgCreateDenom        = "create_denom"
+	TypeMsgMint               = "tf_mint"
+	TypeMsgBurn               = "tf_burn"
+	TypeMsgForceTransfer      = "force_transfer"
+	TypeMsgChangeAdmin        = "change_admin"
+	TypeMsgSetDenomMetadata   = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook  = "set_before_send_hook"
+	TypeMsgSetComplianceAdmin = "set_compliance_admin"
+	TypeMsgFreezeAddress      = "freeze_address"
+	TypeMsgUnfreezeAddress    = "unfreeze_address"
+	TypeMsgSetDenomPaused     = "set_denom_paused"
+	TypeMsgSetMinter          = "set_minter"
+	TypeMsgRemoveMinter       = "remove_minter"
+	TypeMsgSetSupplyCap       = "set_supply_cap"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -280,3 +287,246 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetComplianceAdmin{}
+
+// NewMsgSetComplianceAdmin creates a message to set the compliance admin of a denom
+func NewMsgSetComplianceAdmin(sender, denom, complianceAdmin string) *MsgSetComplianceAdmin {
+	return &MsgSetComplianceAdmin{
+		Sender:          sender,
+		Denom:           denom,
+		ComplianceAdmin: complianceAdmin,
+	}
+}
+
+func (m MsgSetComplianceAdmin) Route() string { return RouterKey }
+func (m MsgSetComplianceAdmin) Type() string  { return TypeMsgSetComplianceAdmin }
+func (m MsgSetComplianceAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.ComplianceAdmin != "" {
+		_, err = sdk.AccAddressFromBech32(m.ComplianceAdmin)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid compliance admin address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetComplianceAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFreezeAddress{}
+
+// NewMsgFreezeAddress creates a message to freeze an address for a denom
+func NewMsgFreezeAddress(sender, denom, address string) *MsgFreezeAddress {
+	return &MsgFreezeAddress{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (m MsgFreezeAddress) Route() string { return RouterKey }
+func (m MsgFreezeAddress) Type() string  { return TypeMsgFreezeAddress }
+func (m MsgFreezeAddress) ValidateBasic() error {
+	return validateFreezeMsg(m.Sender, m.Denom, m.Address)
+}
+
+func (m MsgFreezeAddress) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnfreezeAddress{}
+
+// NewMsgUnfreezeAddress creates a message to unfreeze an address for a denom
+func NewMsgUnfreezeAddress(sender, denom, address string) *MsgUnfreezeAddress {
+	return &MsgUnfreezeAddress{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (m MsgUnfreezeAddress) Route() string { return RouterKey }
+func (m MsgUnfreezeAddress) Type() string  { return TypeMsgUnfreezeAddress }
+func (m MsgUnfreezeAddress) ValidateBasic() error {
+	return validateFreezeMsg(m.Sender, m.Denom, m.Address)
+}
+
+func (m MsgUnfreezeAddress) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateFreezeMsg(sender, denom, address string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetDenomPaused{}
+
+// NewMsgSetDenomPaused creates a message to pause or resume the transfers of a denom
+func NewMsgSetDenomPaused(sender, denom string, paused bool) *MsgSetDenomPaused {
+	return &MsgSetDenomPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPaused) Route() string { return RouterKey }
+func (m MsgSetDenomPaused) Type() string  { return TypeMsgSetDenomPaused }
+func (m MsgSetDenomPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMinter{}
+
+// NewMsgSetMinter creates a message to allow an address to mint a denom up to the allowance
+func NewMsgSetMinter(sender, denom, address string, allowance osmomath.Int) *MsgSetMinter {
+	return &MsgSetMinter{
+		Sender:    sender,
+		Denom:     denom,
+		Address:   address,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgSetMinter) Route() string { return RouterKey }
+func (m MsgSetMinter) Type() string  { return TypeMsgSetMinter }
+func (m MsgSetMinter) ValidateBasic() error {
+	err := validateMinterMsg(m.Sender, m.Denom, m.Address)
+	if err != nil {
+		return err
+	}
+
+	if m.Allowance.IsNil() || !m.Allowance.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "allowance must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgSetMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRemoveMinter{}
+
+// NewMsgRemoveMinter creates a message to revoke the permission of a minter to mint a denom
+func NewMsgRemoveMinter(sender, denom, address string) *MsgRemoveMinter {
+	return &MsgRemoveMinter{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+	}
+}
+
+func (m MsgRemoveMinter) Route() string { return RouterKey }
+func (m MsgRemoveMinter) Type() string  { return TypeMsgRemoveMinter }
+func (m MsgRemoveMinter) ValidateBasic() error {
+	return validateMinterMsg(m.Sender, m.Denom, m.Address)
+}
+
+func (m MsgRemoveMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+func validateMinterMsg(sender, denom, address string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetSupplyCap{}
+
+// NewMsgSetSupplyCap creates a message to set the maximum supply of a denom
+func NewMsgSetSupplyCap(sender, denom string, supplyCap osmomath.Int) *MsgSetSupplyCap {
+	return &MsgSetSupplyCap{
+		Sender:    sender,
+		Denom:     denom,
+		SupplyCap: supplyCap,
+	}
+}
+
+func (m MsgSetSupplyCap) Route() string { return RouterKey }
+func (m MsgSetSupplyCap) Type() string  { return TypeMsgSetSupplyCap }
+func (m MsgSetSupplyCap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.SupplyCap.IsNil() || !m.SupplyCap.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidSupplyCap, "supply cap must be positive")
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetSupplyCap) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
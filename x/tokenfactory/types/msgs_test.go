@@ -55,6 +55,15 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgSetMinter",
+			msg: &types.MsgSetMinter{
+				Sender:    addr1,
+				Denom:     "denom",
+				Address:   "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+				Allowance: osmomath.NewInt(100),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -450,3 +459,170 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetMinter tests if valid/invalid set minter messages are properly validated/invalidated
+func TestMsgSetMinter(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+
+	// make a proper set minter message
+	createMsg := func(after func(msg types.MsgSetMinter) types.MsgSetMinter) types.MsgSetMinter {
+		properMsg := *types.NewMsgSetMinter(
+			addr1.String(),
+			fmt.Sprintf("factory/%s/bitcoin", addr1.String()),
+			addr2.String(),
+			osmomath.NewInt(500000000),
+		)
+
+		return after(properMsg)
+	}
+
+	// validate set minter message was created as intended
+	msg := createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_minter")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetMinter
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid minter address",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				msg.Address = "minter"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				msg.Denom = "bitcoin"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero allowance",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				msg.Allowance = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative allowance",
+			msg: createMsg(func(msg types.MsgSetMinter) types.MsgSetMinter {
+				msg.Allowance = osmomath.NewInt(-10000000)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetSupplyCap tests if valid/invalid set supply cap messages are properly validated/invalidated
+func TestMsgSetSupplyCap(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	// make a proper set supply cap message
+	createMsg := func(after func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+		properMsg := *types.NewMsgSetSupplyCap(
+			addr1.String(),
+			fmt.Sprintf("factory/%s/bitcoin", addr1.String()),
+			osmomath.NewInt(500000000),
+		)
+
+		return after(properMsg)
+	}
+
+	// validate set supply cap message was created as intended
+	msg := createMsg(func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+		return msg
+	})
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "set_supply_cap")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetSupplyCap
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: createMsg(func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+				msg.Sender = ""
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero supply cap",
+			msg: createMsg(func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+				msg.SupplyCap = osmomath.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil supply cap",
+			msg: createMsg(func(msg types.MsgSetSupplyCap) types.MsgSetSupplyCap {
+				msg.SupplyCap = osmomath.Int{}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
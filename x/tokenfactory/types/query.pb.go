@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -481,6 +482,376 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) GetBeforeSendHookAddresses()
 	return nil
 }
 
+type QueryDenomRestrictionsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomRestrictionsRequest) Reset()         { *m = QueryDenomRestrictionsRequest{} }
+func (m *QueryDenomRestrictionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsRequest) ProtoMessage()    {}
+func (*QueryDenomRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{10}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomRestrictionsResponse defines the response structure for the
+// DenomRestrictions gRPC query.
+type QueryDenomRestrictionsResponse struct {
+	Paused          bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	FrozenAddresses []string `protobuf:"bytes,2,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+}
+
+func (m *QueryDenomRestrictionsResponse) Reset()         { *m = QueryDenomRestrictionsResponse{} }
+func (m *QueryDenomRestrictionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRestrictionsResponse) ProtoMessage()    {}
+func (*QueryDenomRestrictionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{11}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRestrictionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.Merge(m, src)
+}
+func (m *QueryDenomRestrictionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRestrictionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRestrictionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRestrictionsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRestrictionsResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryDenomRestrictionsResponse) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+type QueryIsFrozenRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *QueryIsFrozenRequest) Reset()         { *m = QueryIsFrozenRequest{} }
+func (m *QueryIsFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsFrozenRequest) ProtoMessage()    {}
+func (*QueryIsFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{12}
+}
+func (m *QueryIsFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsFrozenRequest.Merge(m, src)
+}
+func (m *QueryIsFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsFrozenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryIsFrozenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryIsFrozenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryIsFrozenResponse defines the response structure for the IsFrozen gRPC
+// query.
+type QueryIsFrozenResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+}
+
+func (m *QueryIsFrozenResponse) Reset()         { *m = QueryIsFrozenResponse{} }
+func (m *QueryIsFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsFrozenResponse) ProtoMessage()    {}
+func (*QueryIsFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{13}
+}
+func (m *QueryIsFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsFrozenResponse.Merge(m, src)
+}
+func (m *QueryIsFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsFrozenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryIsFrozenResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type QueryDenomMintersRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomMintersRequest) Reset()         { *m = QueryDenomMintersRequest{} }
+func (m *QueryDenomMintersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintersRequest) ProtoMessage()    {}
+func (*QueryDenomMintersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{14}
+}
+func (m *QueryDenomMintersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintersRequest.Merge(m, src)
+}
+func (m *QueryDenomMintersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintersRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMintersResponse defines the response structure for the
+// DenomMinters gRPC query.
+type QueryDenomMintersResponse struct {
+	Minters []DenomMinter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters" yaml:"minters"`
+}
+
+func (m *QueryDenomMintersResponse) Reset()         { *m = QueryDenomMintersResponse{} }
+func (m *QueryDenomMintersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintersResponse) ProtoMessage()    {}
+func (*QueryDenomMintersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{15}
+}
+func (m *QueryDenomMintersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintersResponse.Merge(m, src)
+}
+func (m *QueryDenomMintersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintersResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintersResponse) GetMinters() []DenomMinter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+type QueryDenomSupplyCapRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomSupplyCapRequest) Reset()         { *m = QueryDenomSupplyCapRequest{} }
+func (m *QueryDenomSupplyCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyCapRequest) ProtoMessage()    {}
+func (*QueryDenomSupplyCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{16}
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyCapRequest.Merge(m, src)
+}
+func (m *QueryDenomSupplyCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyCapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyCapRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyCapRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomSupplyCapResponse defines the response structure for the
+// DenomSupplyCap gRPC query. A zero supply cap means the denom has no cap.
+type QueryDenomSupplyCapResponse struct {
+	SupplyCap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=supply_cap,json=supplyCap,proto3,customtype=cosmossdk.io/math.Int" json:"supply_cap" yaml:"supply_cap"`
+	Supply    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply" yaml:"supply"`
+}
+
+func (m *QueryDenomSupplyCapResponse) Reset()         { *m = QueryDenomSupplyCapResponse{} }
+func (m *QueryDenomSupplyCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyCapResponse) ProtoMessage()    {}
+func (*QueryDenomSupplyCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{17}
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyCapResponse.Merge(m, src)
+}
+func (m *QueryDenomSupplyCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyCapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyCapResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -492,6 +863,14 @@ func init() {
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
 	proto.RegisterType((*QueryAllBeforeSendHooksAddressesRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryAllBeforeSendHooksAddressesRequest")
 	proto.RegisterType((*QueryAllBeforeSendHooksAddressesResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryAllBeforeSendHooksAddressesResponse")
+	proto.RegisterType((*QueryDenomRestrictionsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomRestrictionsRequest")
+	proto.RegisterType((*QueryDenomRestrictionsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomRestrictionsResponse")
+	proto.RegisterType((*QueryIsFrozenRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryIsFrozenRequest")
+	proto.RegisterType((*QueryIsFrozenResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryIsFrozenResponse")
+	proto.RegisterType((*QueryDenomMintersRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintersRequest")
+	proto.RegisterType((*QueryDenomMintersResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintersResponse")
+	proto.RegisterType((*QueryDenomSupplyCapRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomSupplyCapRequest")
+	proto.RegisterType((*QueryDenomSupplyCapResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomSupplyCapResponse")
 }
 
 func init() {
@@ -499,55 +878,77 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x4f, 0x13, 0x4b,
-	0x14, 0xee, 0x72, 0x2f, 0xbd, 0x97, 0xb9, 0x57, 0x85, 0x11, 0x05, 0x17, 0xec, 0xc2, 0x48, 0xb0,
-	0x18, 0xec, 0x5a, 0xc0, 0xc4, 0x88, 0x04, 0xba, 0x08, 0x9a, 0x20, 0x89, 0xae, 0x4f, 0x9a, 0x98,
-	0xcd, 0x6c, 0x3b, 0x94, 0x86, 0xdd, 0x9d, 0xb2, 0x33, 0x45, 0x1b, 0xc2, 0x8b, 0x0f, 0x3e, 0x9b,
-	0xf8, 0xe8, 0x7f, 0xf0, 0x89, 0x1f, 0xc1, 0x23, 0x09, 0x2f, 0x3e, 0x6d, 0x14, 0x8c, 0x89, 0xaf,
-	0xfd, 0x05, 0xa6, 0xb3, 0xd3, 0x0a, 0x6d, 0x59, 0x5a, 0x78, 0xea, 0x66, 0xe6, 0x3b, 0xdf, 0xf9,
-	0xbe, 0x73, 0xe6, 0x9c, 0x14, 0x24, 0x29, 0x73, 0x29, 0x2b, 0x30, 0x9d, 0xd3, 0x0d, 0xe2, 0xad,
-	0xe1, 0x2c, 0xa7, 0x7e, 0x59, 0xdf, 0x4a, 0xdb, 0x84, 0xe3, 0xb4, 0xbe, 0x59, 0x22, 0x7e, 0x39,
-	0x55, 0xf4, 0x29, 0xa7, 0x70, 0x58, 0x22, 0x53, 0xc7, 0x91, 0x29, 0x89, 0x54, 0xfb, 0xf3, 0x34,
-	0x4f, 0x05, 0x50, 0xaf, 0x7e, 0x85, 0x31, 0xea, 0x70, 0x9e, 0xd2, 0xbc, 0x43, 0x74, 0x5c, 0x2c,
-	0xe8, 0xd8, 0xf3, 0x28, 0xc7, 0xbc, 0x40, 0x3d, 0x26, 0x6f, 0xef, 0x64, 0x05, 0xa5, 0x6e, 0x63,
-	0x46, 0xc2, 0x54, 0xf5, 0xc4, 0x45, 0x9c, 0x2f, 0x78, 0x02, 0x2c, 0xb1, 0x33, 0x91, 0x3a, 0x71,
-	0x89, 0xaf, 0x53, 0xbf, 0xc0, 0xcb, 0xab, 0x84, 0xe3, 0x1c, 0xe6, 0x58, 0x46, 0x4d, 0x44, 0x46,
-	0x15, 0xb1, 0x8f, 0x5d, 0x29, 0x06, 0xf5, 0x03, 0xf8, 0xa2, 0x2a, 0xe1, 0xb9, 0x38, 0x34, 0xc9,
-	0x66, 0x89, 0x30, 0x8e, 0x5e, 0x81, 0xab, 0x27, 0x4e, 0x59, 0x91, 0x7a, 0x8c, 0x40, 0x03, 0xc4,
-	0xc3, 0xe0, 0x41, 0x65, 0x44, 0x49, 0xfe, 0x37, 0x35, 0x96, 0x8a, 0x2a, 0x4e, 0x2a, 0x8c, 0x36,
-	0xfe, 0xde, 0x0b, 0xb4, 0x98, 0x29, 0x23, 0xd1, 0x33, 0x80, 0x04, 0xf5, 0x63, 0xe2, 0x51, 0x37,
-	0xd3, 0x68, 0x40, 0x0a, 0x80, 0xe3, 0xa0, 0x3b, 0x57, 0x05, 0x88, 0x44, 0x3d, 0x46, 0x6f, 0x25,
-	0xd0, 0xfe, 0x2f, 0x63, 0xd7, 0x79, 0x88, 0xc4, 0x31, 0x32, 0xc3, 0x6b, 0xf4, 0x45, 0x01, 0xb7,
-	0x22, 0xe9, 0xa4, 0xf2, 0x0f, 0x0a, 0x80, 0xf5, 0x6a, 0x59, 0xae, 0xbc, 0x96, 0x36, 0x66, 0xa2,
-	0x6d, 0xb4, 0xa6, 0x36, 0x46, 0xab, 0xb6, 0x2a, 0x81, 0x76, 0x23, 0xd4, 0xd5, 0xcc, 0x8e, 0xcc,
-	0xbe, 0xa6, 0x06, 0xa1, 0x55, 0x70, 0xf3, 0x8f, 0x5e, 0xb6, 0xec, 0x53, 0x77, 0xd1, 0x27, 0x98,
-	0x53, 0xbf, 0xe6, 0x7c, 0x12, 0xfc, 0x93, 0x0d, 0x4f, 0xa4, 0x77, 0x58, 0x09, 0xb4, 0xcb, 0x61,
-	0x0e, 0x79, 0x81, 0xcc, 0x1a, 0x04, 0xad, 0x80, 0xc4, 0x69, 0x74, 0xd2, 0xf9, 0x04, 0x88, 0x8b,
-	0x52, 0x55, 0x7b, 0xf6, 0x57, 0xb2, 0xc7, 0xe8, 0xab, 0x04, 0xda, 0xa5, 0x63, 0xa5, 0x64, 0xc8,
-	0x94, 0x00, 0xb4, 0x02, 0x46, 0x05, 0x99, 0x41, 0xd6, 0xa8, 0x4f, 0x5e, 0x12, 0x2f, 0xf7, 0x94,
-	0xd2, 0x8d, 0x4c, 0x2e, 0xe7, 0x13, 0xc6, 0x3a, 0xed, 0x8c, 0x23, 0xfb, 0x7c, 0x0a, 0x99, 0x54,
-	0xb7, 0x0c, 0x7a, 0xab, 0xd3, 0xf0, 0x16, 0x33, 0xd7, 0xc2, 0xe1, 0x9d, 0x24, 0x1e, 0xaa, 0x04,
-	0xda, 0x80, 0xb4, 0xdd, 0x80, 0x40, 0xe6, 0x95, 0xda, 0x91, 0xe4, 0x43, 0x13, 0xe0, 0xb6, 0xc8,
-	0x96, 0x71, 0x9c, 0x93, 0x09, 0x99, 0x44, 0x90, 0xfa, 0xdb, 0xde, 0x55, 0x40, 0xf2, 0x6c, 0x6c,
-	0xc7, 0xd5, 0x83, 0x6f, 0x80, 0x6a, 0x0b, 0x3a, 0x8b, 0x11, 0x2f, 0x67, 0xad, 0x53, 0xba, 0x51,
-	0x13, 0x4c, 0xd8, 0x60, 0x97, 0x08, 0x1f, 0xa9, 0x04, 0xda, 0x70, 0x18, 0x7e, 0x1c, 0x5b, 0x87,
-	0x21, 0x73, 0xc0, 0x6e, 0x55, 0x2f, 0xc2, 0xa6, 0x76, 0xff, 0x05, 0xdd, 0x42, 0x36, 0xfc, 0xac,
-	0x80, 0x78, 0x38, 0x5a, 0xf0, 0x5e, 0xf4, 0xcb, 0x6d, 0x9e, 0x6c, 0x35, 0xdd, 0x41, 0x44, 0x58,
-	0x03, 0x34, 0xf9, 0xfe, 0xe0, 0xc7, 0xa7, 0xae, 0x71, 0x38, 0xa6, 0xb7, 0xb1, 0x56, 0xe0, 0x4f,
-	0x05, 0x5c, 0x6f, 0x3d, 0x31, 0x70, 0xa1, 0x8d, 0xdc, 0x91, 0x6b, 0x41, 0xcd, 0x5c, 0x80, 0x41,
-	0xba, 0x79, 0x22, 0xdc, 0x64, 0xe0, 0x7c, 0xb4, 0x9b, 0xb0, 0xa9, 0xfa, 0xb6, 0xf8, 0xdd, 0xd1,
-	0x9b, 0xa7, 0x1b, 0x1e, 0x28, 0xa0, 0xaf, 0x69, 0xec, 0xe0, 0x6c, 0xbb, 0x0a, 0x5b, 0xcc, 0xbe,
-	0xfa, 0xe8, 0x7c, 0xc1, 0xd2, 0xd9, 0xa2, 0x70, 0x36, 0x07, 0x67, 0xdb, 0x71, 0x66, 0xad, 0xf9,
-	0xd4, 0xb5, 0xe4, 0x1a, 0xd1, 0xb7, 0xe5, 0xc7, 0x0e, 0xfc, 0xae, 0x80, 0x6b, 0x2d, 0x47, 0x16,
-	0xce, 0xb7, 0x21, 0x2e, 0x6a, 0x73, 0xa8, 0x0b, 0xe7, 0x27, 0x90, 0x0e, 0x97, 0x84, 0xc3, 0x79,
-	0x38, 0xd7, 0x51, 0xef, 0x1a, 0xa7, 0x12, 0xfe, 0x52, 0xc0, 0x50, 0xc4, 0xf0, 0xc3, 0xa5, 0x36,
-	0x84, 0x9e, 0xbd, 0x68, 0xd4, 0xe5, 0x8b, 0xd2, 0x48, 0xd7, 0xb3, 0xc2, 0xf5, 0x7d, 0x38, 0x1d,
-	0xed, 0x1a, 0x3b, 0x8e, 0xd5, 0x68, 0x95, 0x19, 0xe6, 0xde, 0x61, 0x42, 0xd9, 0x3f, 0x4c, 0x28,
-	0xdf, 0x0e, 0x13, 0xca, 0xc7, 0xa3, 0x44, 0x6c, 0xff, 0x28, 0x11, 0xfb, 0x7a, 0x94, 0x88, 0xbd,
-	0x7e, 0x90, 0x2f, 0xf0, 0xf5, 0x92, 0x9d, 0xca, 0x52, 0xb7, 0x46, 0x7c, 0xd7, 0xc1, 0x36, 0xab,
-	0x67, 0xd9, 0x9a, 0x4e, 0xeb, 0xef, 0x4e, 0xe6, 0xe2, 0xe5, 0x22, 0x61, 0x76, 0x5c, 0xfc, 0x75,
-	0x98, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xed, 0xe7, 0x57, 0x45, 0x09, 0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xe6, 0xfb, 0x6d, 0xda, 0x4c, 0xdb, 0xb4, 0x19, 0x92, 0x36, 0xdd, 0x04, 0xbb, 0x1d,
+	0xaa, 0x92, 0xa0, 0xe2, 0x25, 0x3f, 0x68, 0x1b, 0x92, 0x2a, 0xb5, 0xd3, 0xa6, 0x54, 0x25, 0x12,
+	0xdd, 0x9e, 0x00, 0x21, 0x6b, 0x6c, 0x4f, 0x1c, 0x2b, 0xbb, 0x3b, 0xdb, 0x9d, 0x75, 0xa9, 0x1b,
+	0xe5, 0xc2, 0x81, 0x33, 0x52, 0x8f, 0xfc, 0x0f, 0x9c, 0x38, 0x20, 0x24, 0xae, 0xa8, 0x27, 0x54,
+	0xd4, 0x0b, 0xe2, 0x60, 0x41, 0x82, 0x90, 0xb8, 0xfa, 0x2f, 0x40, 0x3b, 0xf3, 0xd6, 0x5e, 0xff,
+	0xe8, 0x66, 0x37, 0x39, 0x65, 0xf3, 0xe6, 0xbd, 0xcf, 0xfb, 0x7c, 0xde, 0x9b, 0xdd, 0x8f, 0x8c,
+	0x66, 0xb9, 0xb0, 0xb9, 0xa8, 0x09, 0xc3, 0xe7, 0x3b, 0xcc, 0xd9, 0xa2, 0x65, 0x9f, 0x7b, 0x0d,
+	0xe3, 0xe9, 0x7c, 0x89, 0xf9, 0x74, 0xde, 0x78, 0x52, 0x67, 0x5e, 0x23, 0xe7, 0x7a, 0xdc, 0xe7,
+	0x78, 0x06, 0x32, 0x73, 0xd1, 0xcc, 0x1c, 0x64, 0xea, 0x13, 0x55, 0x5e, 0xe5, 0x32, 0xd1, 0x08,
+	0x9e, 0x54, 0x8d, 0x3e, 0x53, 0xe5, 0xbc, 0x6a, 0x31, 0x83, 0xba, 0x35, 0x83, 0x3a, 0x0e, 0xf7,
+	0xa9, 0x5f, 0xe3, 0x8e, 0x80, 0xd3, 0xf7, 0xca, 0x12, 0xd2, 0x28, 0x51, 0xc1, 0x54, 0xab, 0x76,
+	0x63, 0x97, 0x56, 0x6b, 0x8e, 0x4c, 0x86, 0xdc, 0xa5, 0x58, 0x9e, 0xb4, 0xee, 0x6f, 0x73, 0xaf,
+	0xe6, 0x37, 0x36, 0x99, 0x4f, 0x2b, 0xd4, 0xa7, 0x50, 0x35, 0x17, 0x5b, 0xe5, 0x52, 0x8f, 0xda,
+	0x40, 0x86, 0x4c, 0x20, 0xfc, 0x28, 0xa0, 0xf0, 0xa9, 0x0c, 0x9a, 0xec, 0x49, 0x9d, 0x09, 0x9f,
+	0x7c, 0x86, 0xde, 0xea, 0x8a, 0x0a, 0x97, 0x3b, 0x82, 0xe1, 0x02, 0x1a, 0x51, 0xc5, 0x53, 0xda,
+	0x65, 0x6d, 0xf6, 0xf4, 0xc2, 0xd5, 0x5c, 0xdc, 0x70, 0x72, 0xaa, 0xba, 0xf0, 0xff, 0x97, 0xcd,
+	0xec, 0x90, 0x09, 0x95, 0xe4, 0x13, 0x44, 0x24, 0xf4, 0x5d, 0xe6, 0x70, 0x3b, 0xdf, 0x2b, 0x00,
+	0x08, 0xe0, 0x6b, 0xe8, 0x44, 0x25, 0x48, 0x90, 0x8d, 0x46, 0x0b, 0xe7, 0x5b, 0xcd, 0xec, 0x99,
+	0x06, 0xb5, 0xad, 0x8f, 0x88, 0x0c, 0x13, 0x53, 0x1d, 0x93, 0xef, 0x35, 0xf4, 0x4e, 0x2c, 0x1c,
+	0x30, 0xff, 0x46, 0x43, 0xb8, 0x3d, 0xad, 0xa2, 0x0d, 0xc7, 0x20, 0x63, 0x29, 0x5e, 0xc6, 0x60,
+	0xe8, 0xc2, 0x95, 0x40, 0x56, 0xab, 0x99, 0xbd, 0xa4, 0x78, 0xf5, 0xa3, 0x13, 0x73, 0xbc, 0x6f,
+	0x41, 0x64, 0x13, 0xbd, 0xdd, 0xe1, 0x2b, 0x36, 0x3c, 0x6e, 0xaf, 0x7b, 0x8c, 0xfa, 0xdc, 0x0b,
+	0x95, 0x5f, 0x47, 0x27, 0xcb, 0x2a, 0x02, 0xda, 0x71, 0xab, 0x99, 0x1d, 0x53, 0x3d, 0xe0, 0x80,
+	0x98, 0x61, 0x0a, 0x79, 0x88, 0x32, 0x6f, 0x82, 0x03, 0xe5, 0x73, 0x68, 0x44, 0x8e, 0x2a, 0xd8,
+	0xd9, 0xff, 0x66, 0x47, 0x0b, 0xe3, 0xad, 0x66, 0xf6, 0x6c, 0x64, 0x94, 0x82, 0x98, 0x90, 0x40,
+	0x1e, 0xa2, 0x2b, 0x12, 0xac, 0xc0, 0xb6, 0xb8, 0xc7, 0x1e, 0x33, 0xa7, 0xf2, 0x31, 0xe7, 0x3b,
+	0xf9, 0x4a, 0xc5, 0x63, 0x42, 0xa4, 0xdd, 0x8c, 0x85, 0x48, 0x1c, 0x18, 0xb0, 0xdb, 0x40, 0xe7,
+	0x83, 0xb7, 0xe1, 0x2b, 0x2a, 0xec, 0x22, 0x55, 0x67, 0x00, 0x3c, 0xdd, 0x6a, 0x66, 0x2f, 0x82,
+	0xec, 0x9e, 0x0c, 0x62, 0x9e, 0x0b, 0x43, 0x80, 0x47, 0xe6, 0xd0, 0xbb, 0xb2, 0x5b, 0xde, 0xb2,
+	0xba, 0x1b, 0x0a, 0xc8, 0x60, 0xed, 0xbb, 0xfd, 0x83, 0x86, 0x66, 0x0f, 0xcf, 0x4d, 0x3d, 0x3d,
+	0xfc, 0x25, 0xd2, 0x4b, 0x12, 0xae, 0x28, 0x98, 0x53, 0x29, 0x6e, 0x73, 0xbe, 0x13, 0x12, 0x66,
+	0x62, 0x6a, 0x58, 0x96, 0x5f, 0x6e, 0x35, 0xb3, 0x33, 0xaa, 0x3c, 0x9a, 0xdb, 0x4e, 0x23, 0xe6,
+	0xc5, 0xd2, 0xa0, 0x79, 0x31, 0x41, 0xee, 0x47, 0x2f, 0x8e, 0xc9, 0x84, 0xef, 0xd5, 0xca, 0xf2,
+	0xab, 0x92, 0x76, 0x31, 0x2f, 0x34, 0x94, 0x79, 0x13, 0x52, 0x47, 0xb5, 0x4b, 0xeb, 0x82, 0x55,
+	0x24, 0xd6, 0xa9, 0xa8, 0x6a, 0x15, 0x27, 0x26, 0x24, 0x04, 0x0b, 0xdc, 0xf2, 0xf8, 0x73, 0xe6,
+	0xf4, 0x69, 0x8d, 0x2c, 0xb0, 0x37, 0x83, 0x98, 0xe7, 0x54, 0xa8, 0x23, 0xcf, 0x42, 0x13, 0x92,
+	0xd4, 0x83, 0xe0, 0x12, 0x3f, 0x67, 0x4e, 0x4a, 0x55, 0xc1, 0x6b, 0x13, 0xde, 0x9f, 0xe1, 0xde,
+	0xd7, 0xa6, 0x7d, 0x6d, 0xc2, 0x14, 0x52, 0x40, 0x93, 0x3d, 0xdd, 0x3a, 0xca, 0x15, 0xb3, 0x7e,
+	0xe5, 0x2a, 0x4e, 0x4c, 0x48, 0x20, 0x05, 0x34, 0xd5, 0x19, 0xe3, 0x66, 0xcd, 0xf1, 0x99, 0x97,
+	0x7a, 0x17, 0xcf, 0xd0, 0xa5, 0x01, 0x18, 0xc0, 0xe5, 0x0b, 0x74, 0xd2, 0x56, 0x21, 0x79, 0xf9,
+	0x4e, 0x2f, 0xcc, 0x25, 0xf8, 0x4e, 0x29, 0x90, 0xc2, 0x05, 0xf8, 0x38, 0xc1, 0x04, 0x00, 0x87,
+	0x98, 0x21, 0x22, 0xb9, 0x8b, 0xf4, 0x4e, 0xe7, 0xc7, 0x75, 0xd7, 0xb5, 0x1a, 0xeb, 0xd4, 0x4d,
+	0xcb, 0xff, 0x47, 0x0d, 0x4d, 0x0f, 0x84, 0x01, 0x09, 0x8f, 0x10, 0x12, 0x32, 0x58, 0x2c, 0x53,
+	0x17, 0xc0, 0x16, 0x02, 0x6a, 0x7f, 0x34, 0xb3, 0x93, 0xca, 0x06, 0x45, 0x65, 0x27, 0x57, 0xe3,
+	0x86, 0x4d, 0xfd, 0xed, 0xdc, 0x03, 0xc7, 0x6f, 0x35, 0xb3, 0xe3, 0xaa, 0x53, 0xa7, 0x90, 0x98,
+	0xa3, 0x22, 0x84, 0xc6, 0x1b, 0x68, 0x44, 0xfd, 0x03, 0x7b, 0xce, 0x1d, 0x06, 0x77, 0x36, 0x0a,
+	0x47, 0x4c, 0xa8, 0x5e, 0xf8, 0x75, 0x0c, 0x9d, 0x90, 0xd4, 0xf1, 0x77, 0x1a, 0x1a, 0x51, 0x56,
+	0x85, 0x3f, 0x88, 0x9f, 0x70, 0xbf, 0x53, 0xea, 0xf3, 0x29, 0x2a, 0xd4, 0x50, 0xc8, 0xf5, 0xaf,
+	0x5f, 0xff, 0xfd, 0x62, 0xf8, 0x1a, 0xbe, 0x6a, 0x24, 0xb0, 0x69, 0xfc, 0x8f, 0x86, 0x2e, 0x0c,
+	0x76, 0x20, 0x7c, 0x27, 0x41, 0xef, 0x58, 0x9b, 0xd5, 0xf3, 0xc7, 0x40, 0x00, 0x35, 0xf7, 0xa5,
+	0x9a, 0x3c, 0x5e, 0x8b, 0x57, 0xa3, 0x3e, 0x92, 0xc6, 0xae, 0xfc, 0xbb, 0x67, 0xf4, 0xbb, 0x25,
+	0x7e, 0xad, 0xa1, 0xf1, 0x3e, 0x1b, 0xc3, 0x2b, 0x49, 0x19, 0x0e, 0xf0, 0x52, 0x7d, 0xf5, 0x68,
+	0xc5, 0xa0, 0x6c, 0x5d, 0x2a, 0xbb, 0x8d, 0x57, 0x92, 0x28, 0x2b, 0x6e, 0x79, 0xdc, 0x2e, 0x82,
+	0x2d, 0x1b, 0xbb, 0xf0, 0xb0, 0x87, 0xff, 0xd2, 0xd0, 0xe4, 0x40, 0x0b, 0xc4, 0x6b, 0x09, 0xc8,
+	0xc5, 0x39, 0xb1, 0x7e, 0xe7, 0xe8, 0x00, 0xa0, 0xf0, 0x9e, 0x54, 0xb8, 0x86, 0x6f, 0xa7, 0xda,
+	0x5d, 0xaf, 0xcb, 0xe1, 0x7f, 0x35, 0x34, 0x1d, 0x63, 0xa6, 0xf8, 0x5e, 0x02, 0xa2, 0x87, 0x1b,
+	0xb7, 0xbe, 0x71, 0x5c, 0x18, 0x50, 0xbd, 0x22, 0x55, 0x7f, 0x88, 0x17, 0xe3, 0x55, 0x53, 0xcb,
+	0x2a, 0xf6, 0x4a, 0x15, 0xf8, 0xb7, 0xf0, 0x96, 0x46, 0x8d, 0x33, 0xf9, 0x2d, 0x1d, 0x60, 0xdc,
+	0xfa, 0xea, 0xd1, 0x8a, 0x41, 0x4d, 0x5e, 0xaa, 0x59, 0xc1, 0xcb, 0xa9, 0x76, 0xe8, 0x45, 0xd9,
+	0xff, 0xa4, 0xa1, 0x53, 0xa1, 0x13, 0xe2, 0x85, 0x04, 0x6c, 0x7a, 0x4c, 0x5a, 0x5f, 0x4c, 0x55,
+	0x73, 0xac, 0xcb, 0xa7, 0xcc, 0xd7, 0xd8, 0x05, 0x27, 0xdf, 0xc3, 0x3f, 0x6b, 0xe8, 0x4c, 0xd4,
+	0x3e, 0xf1, 0x8d, 0xa4, 0xe3, 0xec, 0xf6, 0x6c, 0xfd, 0x66, 0xea, 0x3a, 0x10, 0xb2, 0x2a, 0x85,
+	0xdc, 0xc0, 0x4b, 0xa9, 0x84, 0x80, 0x11, 0xe3, 0x5f, 0x34, 0x34, 0xd6, 0xed, 0x9e, 0xf8, 0x56,
+	0x52, 0x26, 0xbd, 0xbe, 0xad, 0x2f, 0x1f, 0xa1, 0x12, 0x54, 0xac, 0x49, 0x15, 0xcb, 0xf8, 0x66,
+	0x2a, 0x15, 0x1d, 0x93, 0x2e, 0x98, 0x2f, 0xf7, 0x33, 0xda, 0xab, 0xfd, 0x8c, 0xf6, 0xe7, 0x7e,
+	0x46, 0xfb, 0xf6, 0x20, 0x33, 0xf4, 0xea, 0x20, 0x33, 0xf4, 0xfb, 0x41, 0x66, 0xe8, 0xf3, 0x5b,
+	0xd5, 0x9a, 0xbf, 0x5d, 0x2f, 0xe5, 0xca, 0xdc, 0x0e, 0xc1, 0xdf, 0xb7, 0x68, 0x49, 0xb4, 0x3b,
+	0x3d, 0x5d, 0x9c, 0x37, 0x9e, 0x75, 0xf7, 0xf3, 0x1b, 0x2e, 0x13, 0xa5, 0x11, 0xf9, 0x23, 0x75,
+	0xf1, 0xbf, 0x01, 0x00, 0x46, 0xff, 0xdc, 0xc1, 0xaf, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -580,6 +981,18 @@ type QueryClient interface {
 	// of before send hook addresses. The idx of denom corresponds to before send
 	// hook addresse's idx.
 	AllBeforeSendHooksAddresses(ctx context.Context, in *QueryAllBeforeSendHooksAddressesRequest, opts ...grpc.CallOption) (*QueryAllBeforeSendHooksAddressesResponse, error)
+	// DenomRestrictions defines a gRPC query method for getting whether the
+	// transfers of a denom are paused, and the addresses frozen for the denom.
+	DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error)
+	// IsFrozen defines a gRPC query method for getting whether an address is
+	// frozen for a denom.
+	IsFrozen(ctx context.Context, in *QueryIsFrozenRequest, opts ...grpc.CallOption) (*QueryIsFrozenResponse, error)
+	// DenomMinters defines a gRPC query method for fetching the minters of a
+	// denom and their remaining allowances.
+	DenomMinters(ctx context.Context, in *QueryDenomMintersRequest, opts ...grpc.CallOption) (*QueryDenomMintersResponse, error)
+	// DenomSupplyCap defines a gRPC query method for fetching the supply cap of
+	// a denom.
+	DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error)
 }
 
 type queryClient struct {
@@ -635,6 +1048,42 @@ func (c *queryClient) AllBeforeSendHooksAddresses(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) DenomRestrictions(ctx context.Context, in *QueryDenomRestrictionsRequest, opts ...grpc.CallOption) (*QueryDenomRestrictionsResponse, error) {
+	out := new(QueryDenomRestrictionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomRestrictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsFrozen(ctx context.Context, in *QueryIsFrozenRequest, opts ...grpc.CallOption) (*QueryIsFrozenResponse, error) {
+	out := new(QueryIsFrozenResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/IsFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMinters(ctx context.Context, in *QueryDenomMintersRequest, opts ...grpc.CallOption) (*QueryDenomMintersResponse, error) {
+	out := new(QueryDenomMintersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomMinters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomSupplyCap(ctx context.Context, in *QueryDenomSupplyCapRequest, opts ...grpc.CallOption) (*QueryDenomSupplyCapResponse, error) {
+	out := new(QueryDenomSupplyCapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomSupplyCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -655,6 +1104,18 @@ type QueryServer interface {
 	// of before send hook addresses. The idx of denom corresponds to before send
 	// hook addresse's idx.
 	AllBeforeSendHooksAddresses(context.Context, *QueryAllBeforeSendHooksAddressesRequest) (*QueryAllBeforeSendHooksAddressesResponse, error)
+	// DenomRestrictions defines a gRPC query method for getting whether the
+	// transfers of a denom are paused, and the addresses frozen for the denom.
+	DenomRestrictions(context.Context, *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error)
+	// IsFrozen defines a gRPC query method for getting whether an address is
+	// frozen for a denom.
+	IsFrozen(context.Context, *QueryIsFrozenRequest) (*QueryIsFrozenResponse, error)
+	// DenomMinters defines a gRPC query method for fetching the minters of a
+	// denom and their remaining allowances.
+	DenomMinters(context.Context, *QueryDenomMintersRequest) (*QueryDenomMintersResponse, error)
+	// DenomSupplyCap defines a gRPC query method for fetching the supply cap of
+	// a denom.
+	DenomSupplyCap(context.Context, *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -676,6 +1137,18 @@ func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req
 func (*UnimplementedQueryServer) AllBeforeSendHooksAddresses(ctx context.Context, req *QueryAllBeforeSendHooksAddressesRequest) (*QueryAllBeforeSendHooksAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBeforeSendHooksAddresses not implemented")
 }
+func (*UnimplementedQueryServer) DenomRestrictions(ctx context.Context, req *QueryDenomRestrictionsRequest) (*QueryDenomRestrictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRestrictions not implemented")
+}
+func (*UnimplementedQueryServer) IsFrozen(ctx context.Context, req *QueryIsFrozenRequest) (*QueryIsFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFrozen not implemented")
+}
+func (*UnimplementedQueryServer) DenomMinters(ctx context.Context, req *QueryDenomMintersRequest) (*QueryDenomMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMinters not implemented")
+}
+func (*UnimplementedQueryServer) DenomSupplyCap(ctx context.Context, req *QueryDenomSupplyCapRequest) (*QueryDenomSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSupplyCap not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -771,16 +1244,88 @@ func _Query_AllBeforeSendHooksAddresses_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DenomAuthorityMetadata",
+func _Query_DenomRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomRestrictions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRestrictions(ctx, req.(*QueryDenomRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/IsFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsFrozen(ctx, req.(*QueryIsFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMinters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMinters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomMinters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMinters(ctx, req.(*QueryDenomMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSupplyCapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomSupplyCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSupplyCap(ctx, req.(*QueryDenomSupplyCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DenomAuthorityMetadata",
 			Handler:    _Query_DenomAuthorityMetadata_Handler,
 		},
 		{
@@ -795,6 +1340,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllBeforeSendHooksAddresses",
 			Handler:    _Query_AllBeforeSendHooksAddresses_Handler,
 		},
+		{
+			MethodName: "DenomRestrictions",
+			Handler:    _Query_DenomRestrictions_Handler,
+		},
+		{
+			MethodName: "IsFrozen",
+			Handler:    _Query_IsFrozen_Handler,
+		},
+		{
+			MethodName: "DenomMinters",
+			Handler:    _Query_DenomMinters_Handler,
+		},
+		{
+			MethodName: "DenomSupplyCap",
+			Handler:    _Query_DenomSupplyCap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -1105,128 +1666,410 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomRestrictionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDenomRestrictionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomRestrictionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomRestrictionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRestrictionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIsFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIsFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDenomMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmwasmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBeforeSendHooksAddressesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBeforeSendHooksAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBeforeSendHooksAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBeforeSendHooksAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.Denoms) > 0 {
@@ -1241,16 +2084,756 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryDenomRestrictionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRestrictionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,10 +2856,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1300,7 +2883,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,17 +2906,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1343,24 +2926,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddresses = append(m.BeforeSendHookAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1383,7 +2997,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRestrictionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,10 +3020,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRestrictionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1465,7 +3079,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRestrictionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,17 +3102,37 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRestrictionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,24 +3142,23 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1548,7 +3181,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIsFrozenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1571,15 +3204,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsFrozenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1607,7 +3240,39 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1630,7 +3295,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,17 +3318,17 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1673,24 +3338,12 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1712,7 +3365,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,10 +3388,10 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1794,7 +3447,7 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1817,17 +3470,17 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1837,23 +3490,25 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			m.Minters = append(m.Minters, DenomMinter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1876,7 +3531,7 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomSupplyCapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,12 +3554,44 @@ func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomSupplyCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomSupplyCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1926,7 +3613,7 @@ func (m *QueryAllBeforeSendHooksAddressesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomSupplyCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1949,15 +3636,15 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomSupplyCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBeforeSendHooksAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomSupplyCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,11 +3672,13 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2017,7 +3706,9 @@ func (m *QueryAllBeforeSendHooksAddressesResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeforeSendHookAddresses = append(m.BeforeSendHookAddresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57,6 +57,53 @@ func (k Keeper) GetGeometricTwap(
 	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetGeometricStrategy())
 }
 
+// GetMultiHopGeometricTwap returns the geometric time weighted average price (TWAP) of the base asset,
+// in units of the quote asset of the last hop of the route, from (startTime, endTime).
+// Each hop prices the quote asset of the previous hop (or the base asset for the first hop) in units of its
+// own quote asset, as determined by prices from its pool. The geometric TWAP of a product of prices is the
+// product of their geometric TWAPs over the same window, so the route TWAP is the product of the hop TWAPs.
+//
+// startTime and endTime follow the same rules as GetGeometricTwap, and the same window is used for every hop.
+//
+// This function will error if:
+// * the route is empty or has more than types.MaxTwapRouteHops hops
+// * any hop errors as GetGeometricTwap would, e.g. if its pool has no records in the window.
+// The error then identifies the hop.
+func (k Keeper) GetMultiHopGeometricTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	route []types.TwapRouteHop,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if len(route) == 0 {
+		return osmomath.Dec{}, types.EmptyTwapRouteError{}
+	}
+	if len(route) > types.MaxTwapRouteHops {
+		return osmomath.Dec{}, types.TwapRouteTooLongError{NumHops: len(route), MaxHops: types.MaxTwapRouteHops}
+	}
+
+	twap := osmomath.OneDec()
+	hopBaseAssetDenom := baseAssetDenom
+	for i, hop := range route {
+		hopTwap, err := k.GetGeometricTwap(ctx, hop.PoolId, hopBaseAssetDenom, hop.QuoteAsset, startTime, endTime)
+		if err != nil {
+			return osmomath.Dec{}, types.TwapRouteHopError{
+				Hop:        i,
+				PoolId:     hop.PoolId,
+				BaseAsset:  hopBaseAssetDenom,
+				QuoteAsset: hop.QuoteAsset,
+				Err:        err,
+			}
+		}
+
+		twap = twap.Mul(hopTwap)
+		hopBaseAssetDenom = hop.QuoteAsset
+	}
+
+	return twap, nil
+}
+
 // GetArithmeticTwapToNow returns arithmetic twap from start time until the current block time for quote and base
 // assets in a given pool.
 func (k Keeper) GetArithmeticTwapToNow(
@@ -141,3 +188,107 @@ func (k Keeper) getTwapToNow(
 func (k Keeper) GetBeginBlockAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
 	return k.getMostRecentRecord(ctx, poolId, asset0Denom, asset1Denom)
 }
+
+// GetRealizedVolatility returns the realized volatility of the spot price from (startTime, endTime),
+// as determined by prices from AMM pool `poolId`.
+// The realized volatility is the standard deviation of the natural log returns of the spot price
+// between the consecutive records in the window, starting from the spot price at startTime.
+// A record is written at the end of every block the pool's price changed in, so the returns are per
+// such block, and the result is not annualized. A steady trend has no volatility.
+// Since ln(1/P) = -ln(P), the result is the same regardless of which of the two assets is the quote asset.
+//
+// startTime and endTime follow the same rules as GetArithmeticTwap, and this function errors
+// in the same cases.
+func (k Keeper) GetRealizedVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	}
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	records, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeRealizedVolatility(startRecord, records, endRecord)
+}
+
+// GetOHLC returns the open, high, low and close spot price of the base asset, in units of the quote asset,
+// for each consecutive interval from startTime to endTime, as determined by prices from AMM pool `poolId`.
+// The last candle ends at endTime, and is shorter than the interval if the window is not a multiple of it.
+//
+// Open and close are the spot prices at the start and end of the candle.
+// High and low include the spot price after every swap in the blocks within the candle,
+// not only the spot price at the end of each block.
+//
+// This function will error if:
+// * interval is not positive
+// * more than types.MaxCandles candles are requested
+// * startTime > endTime
+// * endTime in the future
+// * startTime older than 48 hours OR pool creation
+// * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
+func (k Keeper) GetOHLC(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	interval time.Duration,
+) ([]types.Candle, error) {
+	if interval <= 0 {
+		return nil, types.InvalidCandleIntervalError{Interval: interval}
+	}
+	if startTime.After(endTime) {
+		return nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return nil, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	window := endTime.Sub(startTime)
+	numCandles := int64(window / interval)
+	if window%interval != 0 {
+		numCandles++
+	}
+	if numCandles > types.MaxCandles {
+		return nil, types.TooManyCandlesError{NumCandles: numCandles, MaxCandles: types.MaxCandles}
+	}
+
+	openRecord, err := k.getRecordAtOrBeforeTime(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeCandles(openRecord, records, quoteAssetDenom, startTime, endTime, interval), nil
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	sdkrand "github.com/osmosis-labs/osmosis/v31/simulation/simtypes/random"
 	"github.com/osmosis-labs/osmosis/v31/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v31/x/twap"
@@ -452,6 +453,281 @@ func (s *TestSuite) TestGetArithmeticTwap_ThreeAsset() {
 	}
 }
 
+func (s *TestSuite) TestGetRealizedVolatility() {
+	// recordAt returns a record at ten seconds times i after baseTime with the given spot price of asset 0.
+	recordAt := func(i int64, sp0 osmomath.Dec) types.TwapRecord {
+		return newRecord(1, baseTime.Add(time.Duration(i)*10*time.Second), sp0, osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	}
+	sp2Record := recordAt(0, osmomath.NewDec(2))
+	// the price goes 2, 4, 2, 4, so the log_{2} returns are 1, -1, 1 with a mean of 1/3,
+	// and a standard deviation of sqrt(8/9).
+	backAndForthRecords := []types.TwapRecord{sp2Record, recordAt(1, osmomath.NewDec(4)), recordAt(2, osmomath.NewDec(2)), recordAt(3, osmomath.NewDec(4))}
+	backAndForthVolatility := osmomath.MustMonotonicSqrt(osmomath.NewDec(8).QuoInt64(9)).Mul(types.Ln2)
+	// the price goes 2, 4, 8, 16, so the log_{2} returns are all 1.
+	trendRecords := []types.TwapRecord{sp2Record, recordAt(1, osmomath.NewDec(4)), recordAt(2, osmomath.NewDec(8)), recordAt(3, osmomath.NewDec(16))}
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		ctxTime       time.Time
+		input         getTwapInput
+		expVolatility osmomath.Dec
+		expectError   error
+	}{
+		"constant price": {
+			recordsToSet:  []types.TwapRecord{sp2Record},
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"steady trend": {
+			recordsToSet:  trendRecords,
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"price back and forth": {
+			recordsToSet:  backAndForthRecords,
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			expVolatility: backAndForthVolatility,
+		},
+		"price back and forth, use sp1": {
+			recordsToSet:  backAndForthRecords,
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteAB),
+			expVolatility: backAndForthVolatility,
+		},
+		// the last record is outside of the window, leaving the returns 1, -1
+		"price back and forth, records after end time ignored": {
+			recordsToSet:  backAndForthRecords,
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(25*time.Second), baseQuoteBA),
+			expVolatility: types.Ln2,
+		},
+		// the first record is the price at the start of the window, leaving the returns -1, 1
+		"price back and forth, records before start time ignored": {
+			recordsToSet:  backAndForthRecords,
+			ctxTime:       tPlusOneMin,
+			input:         makeSimpleTwapInput(baseTime.Add(15*time.Second), baseTime.Add(30*time.Second), baseQuoteBA),
+			expVolatility: types.Ln2,
+		},
+		"price back and forth, end time = now": {
+			recordsToSet:  backAndForthRecords,
+			ctxTime:       baseTime.Add(30 * time.Second),
+			input:         makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			expVolatility: backAndForthVolatility,
+		},
+		"end time in future": {
+			recordsToSet: []types.TwapRecord{sp2Record},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			volatility, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expVolatility, volatility, osmomath.NewDecWithPrec(1, 12))
+		})
+	}
+}
+
+func (s *TestSuite) TestGetMultiHopGeometricTwap() {
+	// pool 2 only has records from ten seconds after the records of pool 1
+	laterThreeAssetRecords := []types.TwapRecord{
+		withTime(threeAssetRecordAB, baseTime.Add(10*time.Second)),
+		withTime(threeAssetRecordAC, baseTime.Add(10*time.Second)),
+		withTime(threeAssetRecordBC, baseTime.Add(10*time.Second)),
+	}
+
+	tests := map[string]struct {
+		recordsToSet   []types.TwapRecord
+		baseAssetDenom string
+		route          []types.TwapRouteHop
+		startTime      time.Time
+		expTwap        osmomath.Dec
+		expectError    error
+	}{
+		"single hop": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			baseAssetDenom: denom1,
+			route:          []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom0}},
+			startTime:      baseTime,
+			expTwap:        osmomath.NewDec(10),
+		},
+		"round trip through two pools": {
+			recordsToSet:   []types.TwapRecord{baseRecord, threeAssetRecordAB, threeAssetRecordAC, threeAssetRecordBC},
+			baseAssetDenom: denom1,
+			route:          []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom0}, {PoolId: 2, QuoteAsset: denom1}},
+			startTime:      baseTime,
+			expTwap:        osmomath.OneDec(),
+		},
+		"empty route": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			baseAssetDenom: denom1,
+			route:          []types.TwapRouteHop{},
+			startTime:      baseTime,
+			expectError:    types.EmptyTwapRouteError{},
+		},
+		"route too long": {
+			recordsToSet:   []types.TwapRecord{baseRecord},
+			baseAssetDenom: denom1,
+			route:          make([]types.TwapRouteHop, types.MaxTwapRouteHops+1),
+			startTime:      baseTime,
+			expectError:    types.TwapRouteTooLongError{NumHops: types.MaxTwapRouteHops + 1, MaxHops: types.MaxTwapRouteHops},
+		},
+		"second hop has no records in the window": {
+			recordsToSet:   append([]types.TwapRecord{baseRecord}, laterThreeAssetRecords...),
+			baseAssetDenom: denom1,
+			route:          []types.TwapRouteHop{{PoolId: 1, QuoteAsset: denom0}, {PoolId: 2, QuoteAsset: denom2}},
+			startTime:      baseTime.Add(5 * time.Second),
+			expectError: types.TwapRouteHopError{
+				Hop:        1,
+				PoolId:     2,
+				BaseAsset:  denom0,
+				QuoteAsset: denom2,
+				Err:        twap.TimeTooOldError{Time: baseTime.Add(5 * time.Second)},
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin)
+
+			twapResult, err := s.twapkeeper.GetMultiHopGeometricTwap(s.Ctx, test.baseAssetDenom, test.route, test.startTime, tPlusOneMin)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twapResult, osmomath.NewDecWithPrec(1, 8))
+		})
+	}
+}
+
+func (s *TestSuite) TestGetOHLC() {
+	newCandle := func(start, end time.Duration, open, high, low, closePrice int64) types.Candle {
+		return types.Candle{
+			StartTime: baseTime.Add(start),
+			EndTime:   baseTime.Add(end),
+			Open:      osmomath.NewDec(open),
+			High:      osmomath.NewDec(high),
+			Low:       osmomath.NewDec(low),
+			Close:     osmomath.NewDec(closePrice),
+		}
+	}
+
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		interval     time.Duration
+		expCandles   []types.Candle
+		expectError  error
+	}{
+		"(1 record) constant price": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			interval:     10 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 10*time.Second, 10, 10, 10, 10),
+				newCandle(10*time.Second, 20*time.Second, 10, 10, 10, 10),
+			},
+		},
+		"(3 records) one price change per candle": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(30*time.Second), baseQuoteBA),
+			interval:     10 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 10*time.Second, 10, 10, 5, 5),
+				newCandle(10*time.Second, 20*time.Second, 5, 5, 2, 2),
+				newCandle(20*time.Second, 30*time.Second, 2, 2, 2, 2),
+			},
+		},
+		"(3 records) start between records": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record, tPlus20sp2Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(15*time.Second), baseTime.Add(30*time.Second), baseQuoteBA),
+			interval:     15 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(15*time.Second, 30*time.Second, 5, 5, 2, 2),
+			},
+		},
+		"interval is zero": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			interval:     0,
+			expectError:  types.InvalidCandleIntervalError{Interval: 0},
+		},
+		"too many candles": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			interval:     time.Millisecond - 1,
+			expectError:  types.TooManyCandlesError{NumCandles: 1001, MaxCandles: types.MaxCandles},
+		},
+		"end time in future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			interval:     time.Second,
+			expectError:  types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			interval:     time.Second,
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			candles, err := s.twapkeeper.GetOHLC(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime, test.interval)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expCandles, candles)
+		})
+	}
+}
+
 // TestGetArithmeticTwap_PruningRecordKeepPeriod is similar to TestGetArithmeticTwap.
 // It specifically focuses on testing edge cases related to the
 // pruning record keep period when interacting with GetArithmeticTwap.
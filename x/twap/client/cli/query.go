@@ -30,6 +30,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryRealizedVolatilityCommand())
+	cmd.AddCommand(GetQueryOHLCCommand())
+	cmd.AddCommand(GetQueryMultiHopGeometricCommand())
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -138,6 +141,154 @@ Example:
 	return cmd
 }
 
+// GetQueryRealizedVolatilityCommand returns a realized volatility query command.
+func GetQueryRealizedVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "realized-volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query realized volatility",
+		Long: osmocli.FormatLongDescDirect(`Query the realized volatility of the spot price for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} realized-volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} realized-volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.RealizedVolatility(cmd.Context(), &queryproto.RealizedVolatilityRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryOHLCCommand returns an OHLC candles query command.
+func GetQueryOHLCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ohlc [poolid] [base denom] [start time] [end time] [interval]",
+		Short: "Query OHLC candles",
+		Long: osmocli.FormatLongDescDirect(`Query open, high, low and close spot prices for pool, one candle per interval. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} ohlc 1 uosmo 1667088000 24h 1h
+{{.CommandPrefix}} ohlc 1 uosmo 1667088000 1667174400 15m
+`, types.ModuleName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args[:4])
+			if err != nil {
+				return err
+			}
+			interval, err := time.ParseDuration(args[4])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.OHLC(cmd.Context(), &queryproto.OHLCRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+				Interval:   interval,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryMultiHopGeometricCommand returns a multi-hop geometric twap query command.
+func GetQueryMultiHopGeometricCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-hop-geometric [base denom] [route] [start time] [end time]",
+		Short: "Query geometric twap over a route of pools",
+		Long: osmocli.FormatLongDescDirect(`Query geometric twap of the base denom in units of the quote denom of the last hop of the route.
+The route is a comma separated list of poolid:quote denom hops. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} multi-hop-geometric uatom 1:uosmo,678:uusdc 1667088000 24h
+{{.CommandPrefix}} multi-hop-geometric uatom 1:uosmo,678:uusdc 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route, err := twapQueryParseRoute(args[1])
+			if err != nil {
+				return err
+			}
+			startTime, endTime, err := twapQueryParseWindow(args[2], args[3])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.MultiHopGeometricTwap(cmd.Context(), &queryproto.MultiHopGeometricTwapRequest{
+				BaseAsset: strings.TrimSpace(args[0]),
+				Route:     route,
+				StartTime: startTime,
+				EndTime:   &endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -173,29 +324,57 @@ func twapQueryParseArgs(args []string) (twapQueryArgs, error) {
 	// <DENOM PARSE>
 	baseDenom := strings.TrimSpace(args[1])
 
-	// <UNIX TIME PARSE>
-	startTime, err := osmocli.ParseUnixTime(args[2], "start time")
+	startTime, endTime, err := twapQueryParseWindow(args[2], args[3])
 	if err != nil {
 		return twapQueryArgs{}, err
 	}
+	return twapQueryArgs{
+		PoolId:    poolId,
+		BaseDenom: baseDenom,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// twapQueryParseWindow parses the start time and the end time of a twap query.
+func twapQueryParseWindow(startArg, endArg string) (time.Time, time.Time, error) {
+	// <UNIX TIME PARSE>
+	startTime, err := osmocli.ParseUnixTime(startArg, "start time")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
 
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err := osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err := osmocli.ParseUnixTime(endArg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(endArg)
 		if err2 != nil {
 			err = err2
-			return twapQueryArgs{}, err
+			return time.Time{}, time.Time{}, err
 		}
 		endTime = startTime.Add(duration)
 	}
-	return twapQueryArgs{
-		PoolId:    poolId,
-		BaseDenom: baseDenom,
-		StartTime: startTime,
-		EndTime:   endTime,
-	}, nil
+	return startTime, endTime, nil
+}
+
+// twapQueryParseRoute parses a multi-hop twap route of the form "poolid:quote denom,poolid:quote denom".
+func twapQueryParseRoute(arg string) ([]types.TwapRouteHop, error) {
+	route := []types.TwapRouteHop{}
+	for _, hopArg := range strings.Split(arg, ",") {
+		poolIdArg, quoteDenom, found := strings.Cut(strings.TrimSpace(hopArg), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid route hop %q, expected poolid:quote denom", hopArg)
+		}
+
+		poolId, err := osmocli.ParseUint(poolIdArg, "poolId")
+		if err != nil {
+			return nil, err
+		}
+
+		route = append(route, types.TwapRouteHop{PoolId: poolId, QuoteAsset: strings.TrimSpace(quoteDenom)})
+	}
+	return route, nil
 }
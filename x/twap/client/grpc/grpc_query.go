@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RealizedVolatility(grpcCtx context.Context,
+	req *queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RealizedVolatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +41,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) OHLC(grpcCtx context.Context,
+	req *queryproto.OHLCRequest,
+) (*queryproto.OHLCResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OHLC(ctx, *req)
+}
+
+func (q Querier) MultiHopGeometricTwap(grpcCtx context.Context,
+	req *queryproto.MultiHopGeometricTwapRequest,
+) (*queryproto.MultiHopGeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.MultiHopGeometricTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -61,6 +61,51 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) RealizedVolatility(ctx sdk.Context,
+	req queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetRealizedVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.RealizedVolatilityResponse{RealizedVolatility: volatility}, err
+}
+
+func (q Querier) OHLC(ctx sdk.Context,
+	req queryproto.OHLCRequest,
+) (*queryproto.OHLCResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	candles, err := q.K.GetOHLC(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime, req.Interval)
+
+	return &queryproto.OHLCResponse{Candles: candles}, err
+}
+
+func (q Querier) MultiHopGeometricTwap(ctx sdk.Context,
+	req queryproto.MultiHopGeometricTwapRequest,
+) (*queryproto.MultiHopGeometricTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetMultiHopGeometricTwap(ctx, req.BaseAsset, req.Route, req.StartTime, *req.EndTime)
+
+	return &queryproto.MultiHopGeometricTwapResponse{GeometricTwap: twap}, err
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -9,9 +9,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/osmosis/v31/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v31/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v31/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v31/x/twap/types"
 )
 
 type QueryTestSuite struct {
@@ -234,3 +236,93 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 		})
 	}
 }
+
+func (suite *QueryTestSuite) TestQueryMultiHopGeometricTwap() {
+	suite.SetupTest()
+
+	var (
+		poolIdAB = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenA", 1000), sdk.NewInt64Coin("tokenB", 2000))
+		poolIdBC = suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("tokenB", 1000), sdk.NewInt64Coin("tokenC", 4000))
+
+		validStartTime  = suite.Ctx.BlockTime()
+		newBlockTime    = validStartTime.Add(time.Hour)
+		startTimeTooOld = validStartTime.Add(-time.Hour)
+
+		// Set current block time one hour from initial.
+		ctx = suite.Ctx.WithBlockTime(newBlockTime)
+	)
+
+	testCases := []struct {
+		name               string
+		baseAssetDenom     string
+		route              []types.TwapRouteHop
+		startTimeOverwrite *time.Time
+		endTime            *time.Time
+		expectErr          bool
+		result             string
+	}{
+		{
+			name:           "empty route",
+			baseAssetDenom: "tokenA",
+			expectErr:      true,
+		},
+		{
+			name:           "single hop matches the pool twap",
+			baseAssetDenom: "tokenA",
+			route:          []types.TwapRouteHop{{PoolId: poolIdAB, QuoteAsset: "tokenB"}},
+			endTime:        &newBlockTime,
+			result:         osmomath.NewDec(2).String(),
+		},
+		{
+			name:           "tokenA in terms of tokenC through tokenB",
+			baseAssetDenom: "tokenA",
+			route:          []types.TwapRouteHop{{PoolId: poolIdAB, QuoteAsset: "tokenB"}, {PoolId: poolIdBC, QuoteAsset: "tokenC"}},
+			endTime:        &newBlockTime,
+			result:         osmomath.NewDec(8).String(),
+		},
+		{
+			name:           "tokenC in terms of tokenA through tokenB",
+			baseAssetDenom: "tokenC",
+			route:          []types.TwapRouteHop{{PoolId: poolIdBC, QuoteAsset: "tokenB"}, {PoolId: poolIdAB, QuoteAsset: "tokenA"}},
+			result:         osmomath.NewDecWithPrec(125, 3).String(),
+		},
+		{
+			name:           "hop asset not in pool",
+			baseAssetDenom: "tokenA",
+			route:          []types.TwapRouteHop{{PoolId: poolIdAB, QuoteAsset: "tokenB"}, {PoolId: poolIdAB, QuoteAsset: "tokenC"}},
+			expectErr:      true,
+		},
+		{
+			name:               "start time too old",
+			baseAssetDenom:     "tokenA",
+			route:              []types.TwapRouteHop{{PoolId: poolIdAB, QuoteAsset: "tokenB"}, {PoolId: poolIdBC, QuoteAsset: "tokenC"}},
+			startTimeOverwrite: &startTimeTooOld,
+			expectErr:          true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			client := client.Querier{K: *suite.App.TwapKeeper}
+
+			startTime := validStartTime
+			if tc.startTimeOverwrite != nil {
+				startTime = *tc.startTimeOverwrite
+			}
+
+			result, err := client.MultiHopGeometricTwap(ctx, queryproto.MultiHopGeometricTwapRequest{
+				BaseAsset: tc.baseAssetDenom,
+				Route:     tc.route,
+				StartTime: startTime,
+				EndTime:   tc.endTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			osmoassert.DecApproxEq(suite.T(), osmomath.MustNewDecFromStr(tc.result), result.GeometricTwap, osmomath.NewDecWithPrec(1, 8))
+		})
+	}
+}
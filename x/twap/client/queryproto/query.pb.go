@@ -20,6 +20,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -475,6 +476,352 @@ func (m *GeometricTwapToNowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GeometricTwapToNowResponse proto.InternalMessageInfo
 
+type RealizedVolatilityRequest struct {
+	PoolId     uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string     `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string     `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *RealizedVolatilityRequest) Reset()         { *m = RealizedVolatilityRequest{} }
+func (m *RealizedVolatilityRequest) String() string { return proto.CompactTextString(m) }
+func (*RealizedVolatilityRequest) ProtoMessage()    {}
+func (*RealizedVolatilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{8}
+}
+func (m *RealizedVolatilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RealizedVolatilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RealizedVolatilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RealizedVolatilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RealizedVolatilityRequest.Merge(m, src)
+}
+func (m *RealizedVolatilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RealizedVolatilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RealizedVolatilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RealizedVolatilityRequest proto.InternalMessageInfo
+
+func (m *RealizedVolatilityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *RealizedVolatilityRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *RealizedVolatilityRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *RealizedVolatilityRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *RealizedVolatilityRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type RealizedVolatilityResponse struct {
+	RealizedVolatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=realized_volatility,json=realizedVolatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"realized_volatility" yaml:"realized_volatility"`
+}
+
+func (m *RealizedVolatilityResponse) Reset()         { *m = RealizedVolatilityResponse{} }
+func (m *RealizedVolatilityResponse) String() string { return proto.CompactTextString(m) }
+func (*RealizedVolatilityResponse) ProtoMessage()    {}
+func (*RealizedVolatilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{9}
+}
+func (m *RealizedVolatilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RealizedVolatilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RealizedVolatilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RealizedVolatilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RealizedVolatilityResponse.Merge(m, src)
+}
+func (m *RealizedVolatilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RealizedVolatilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RealizedVolatilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RealizedVolatilityResponse proto.InternalMessageInfo
+
+type OHLCRequest struct {
+	PoolId     uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	BaseAsset  string        `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset string        `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	StartTime  time.Time     `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    *time.Time    `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	Interval   time.Duration `protobuf:"bytes,6,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
+}
+
+func (m *OHLCRequest) Reset()         { *m = OHLCRequest{} }
+func (m *OHLCRequest) String() string { return proto.CompactTextString(m) }
+func (*OHLCRequest) ProtoMessage()    {}
+func (*OHLCRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{10}
+}
+func (m *OHLCRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OHLCRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OHLCRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OHLCRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OHLCRequest.Merge(m, src)
+}
+func (m *OHLCRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OHLCRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OHLCRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OHLCRequest proto.InternalMessageInfo
+
+func (m *OHLCRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *OHLCRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *OHLCRequest) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
+func (m *OHLCRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *OHLCRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *OHLCRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+type OHLCResponse struct {
+	Candles []types.Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles" yaml:"candles"`
+}
+
+func (m *OHLCResponse) Reset()         { *m = OHLCResponse{} }
+func (m *OHLCResponse) String() string { return proto.CompactTextString(m) }
+func (*OHLCResponse) ProtoMessage()    {}
+func (*OHLCResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{11}
+}
+func (m *OHLCResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OHLCResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OHLCResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OHLCResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OHLCResponse.Merge(m, src)
+}
+func (m *OHLCResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OHLCResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OHLCResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OHLCResponse proto.InternalMessageInfo
+
+func (m *OHLCResponse) GetCandles() []types.Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+type MultiHopGeometricTwapRequest struct {
+	BaseAsset string               `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	Route     []types.TwapRouteHop `protobuf:"bytes,2,rep,name=route,proto3" json:"route" yaml:"route"`
+	StartTime time.Time            `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   *time.Time           `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+}
+
+func (m *MultiHopGeometricTwapRequest) Reset()         { *m = MultiHopGeometricTwapRequest{} }
+func (m *MultiHopGeometricTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiHopGeometricTwapRequest) ProtoMessage()    {}
+func (*MultiHopGeometricTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{12}
+}
+func (m *MultiHopGeometricTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiHopGeometricTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiHopGeometricTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiHopGeometricTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiHopGeometricTwapRequest.Merge(m, src)
+}
+func (m *MultiHopGeometricTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiHopGeometricTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiHopGeometricTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiHopGeometricTwapRequest proto.InternalMessageInfo
+
+func (m *MultiHopGeometricTwapRequest) GetBaseAsset() string {
+	if m != nil {
+		return m.BaseAsset
+	}
+	return ""
+}
+
+func (m *MultiHopGeometricTwapRequest) GetRoute() []types.TwapRouteHop {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *MultiHopGeometricTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MultiHopGeometricTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type MultiHopGeometricTwapResponse struct {
+	GeometricTwap cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=geometric_twap,json=geometricTwap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"geometric_twap" yaml:"geometric_twap"`
+}
+
+func (m *MultiHopGeometricTwapResponse) Reset()         { *m = MultiHopGeometricTwapResponse{} }
+func (m *MultiHopGeometricTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiHopGeometricTwapResponse) ProtoMessage()    {}
+func (*MultiHopGeometricTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{13}
+}
+func (m *MultiHopGeometricTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiHopGeometricTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiHopGeometricTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiHopGeometricTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiHopGeometricTwapResponse.Merge(m, src)
+}
+func (m *MultiHopGeometricTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiHopGeometricTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiHopGeometricTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiHopGeometricTwapResponse proto.InternalMessageInfo
+
 type ParamsRequest struct {
 }
 
@@ -482,7 +829,7 @@ func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
 func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ParamsRequest) ProtoMessage()    {}
 func (*ParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141a22dba58615af, []int{8}
+	return fileDescriptor_141a22dba58615af, []int{14}
 }
 func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +866,7 @@ func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
 func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ParamsResponse) ProtoMessage()    {}
 func (*ParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141a22dba58615af, []int{9}
+	return fileDescriptor_141a22dba58615af, []int{15}
 }
 func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,6 +911,12 @@ func init() {
 	proto.RegisterType((*GeometricTwapResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapResponse")
 	proto.RegisterType((*GeometricTwapToNowRequest)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowRequest")
 	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
+	proto.RegisterType((*RealizedVolatilityRequest)(nil), "osmosis.twap.v1beta1.RealizedVolatilityRequest")
+	proto.RegisterType((*RealizedVolatilityResponse)(nil), "osmosis.twap.v1beta1.RealizedVolatilityResponse")
+	proto.RegisterType((*OHLCRequest)(nil), "osmosis.twap.v1beta1.OHLCRequest")
+	proto.RegisterType((*OHLCResponse)(nil), "osmosis.twap.v1beta1.OHLCResponse")
+	proto.RegisterType((*MultiHopGeometricTwapRequest)(nil), "osmosis.twap.v1beta1.MultiHopGeometricTwapRequest")
+	proto.RegisterType((*MultiHopGeometricTwapResponse)(nil), "osmosis.twap.v1beta1.MultiHopGeometricTwapResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
 }
@@ -571,57 +924,76 @@ func init() {
 func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
 
 var fileDescriptor_141a22dba58615af = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x33, 0x29, 0x84, 0x66, 0x10, 0x41, 0x9d, 0x02, 0x05, 0x03, 0x76, 0x64, 0x28, 0x4a,
-	0x09, 0xb5, 0x09, 0xdc, 0x10, 0x3d, 0x10, 0x55, 0xaa, 0x2a, 0xa1, 0xaa, 0xb5, 0x50, 0x55, 0xf5,
-	0x12, 0x4d, 0x9c, 0xc1, 0x58, 0x8d, 0x3d, 0xc6, 0x9e, 0x40, 0x23, 0xf5, 0xd0, 0x56, 0xea, 0x1d,
-	0xb5, 0xea, 0xa1, 0x87, 0xf6, 0xde, 0x43, 0xbf, 0x07, 0xa7, 0x2e, 0xd2, 0x5e, 0x56, 0x7b, 0xc8,
-	0xae, 0x60, 0x3f, 0x01, 0x9f, 0x60, 0xe5, 0x99, 0x71, 0x36, 0xce, 0x5a, 0xac, 0xf7, 0x82, 0x84,
-	0xb4, 0x27, 0x98, 0x79, 0xff, 0xf7, 0xfe, 0xbf, 0x79, 0x33, 0x9e, 0x09, 0xac, 0xd2, 0xc8, 0xa3,
-	0x91, 0x1b, 0x99, 0xec, 0x1c, 0x07, 0xe6, 0x59, 0xa3, 0x4d, 0x18, 0x6e, 0x98, 0xa7, 0x3d, 0x12,
-	0xf6, 0x8d, 0x20, 0xa4, 0x8c, 0xa2, 0x39, 0xa9, 0x30, 0x62, 0x85, 0x21, 0x15, 0xca, 0x9c, 0x43,
-	0x1d, 0xca, 0x05, 0x66, 0xfc, 0x9f, 0xd0, 0x2a, 0x1b, 0x99, 0xd5, 0xe2, 0x41, 0x2b, 0x24, 0x36,
-	0x0d, 0x3b, 0x52, 0xa7, 0x67, 0xea, 0x1c, 0xe2, 0x93, 0xd8, 0x48, 0x68, 0x54, 0x9b, 0x8b, 0xcc,
-	0x36, 0x8e, 0xc8, 0x50, 0x62, 0x53, 0xd7, 0x97, 0xf1, 0xcd, 0xd1, 0x38, 0x07, 0x1e, 0xaa, 0x02,
-	0xec, 0xb8, 0x3e, 0x66, 0x2e, 0x4d, 0xb4, 0x2b, 0x0e, 0xa5, 0x4e, 0x97, 0x98, 0x38, 0x70, 0x4d,
-	0xec, 0xfb, 0x94, 0xf1, 0x60, 0xe2, 0xb4, 0x24, 0xa3, 0x7c, 0xd4, 0xee, 0x1d, 0x9b, 0xd8, 0xef,
-	0x27, 0x21, 0x61, 0xd2, 0x12, 0x2b, 0x15, 0x03, 0x19, 0xd2, 0xc6, 0xb3, 0x98, 0xeb, 0x91, 0x88,
-	0x61, 0x2f, 0x10, 0x02, 0xfd, 0x9f, 0x22, 0x9c, 0x3f, 0x08, 0x5d, 0x76, 0xe2, 0x11, 0xe6, 0xda,
-	0x47, 0xe7, 0x38, 0xb0, 0xc8, 0x69, 0x8f, 0x44, 0x0c, 0x7d, 0x04, 0xa7, 0x02, 0x4a, 0xbb, 0x2d,
-	0xb7, 0xb3, 0x08, 0xaa, 0xa0, 0x36, 0x61, 0x95, 0xe2, 0xe1, 0x97, 0x1d, 0xb4, 0x0a, 0x61, 0xbc,
-	0x9c, 0x16, 0x8e, 0x22, 0xc2, 0x16, 0x8b, 0x55, 0x50, 0x2b, 0x5b, 0xe5, 0x78, 0xe6, 0x20, 0x9e,
-	0x40, 0x1a, 0x9c, 0x3e, 0xed, 0x51, 0x96, 0xc4, 0xdf, 0xe3, 0x71, 0xc8, 0xa7, 0x84, 0xe0, 0x3b,
-	0x08, 0x23, 0x86, 0x43, 0xd6, 0x8a, 0x59, 0x16, 0x27, 0xaa, 0xa0, 0x36, 0xbd, 0xa3, 0x18, 0x02,
-	0xd4, 0x48, 0x40, 0x8d, 0xa3, 0x04, 0xb4, 0xb9, 0x7a, 0x39, 0xd0, 0x0a, 0xb7, 0x03, 0xed, 0x83,
-	0x3e, 0xf6, 0xba, 0x7b, 0xfa, 0xab, 0x5c, 0xfd, 0xe2, 0x99, 0x06, 0xac, 0x32, 0x9f, 0x88, 0xe5,
-	0xc8, 0x82, 0xef, 0x13, 0xbf, 0x23, 0xea, 0x4e, 0xbe, 0xb1, 0xee, 0xf2, 0xe5, 0x40, 0x03, 0xb7,
-	0x03, 0x6d, 0x56, 0xd4, 0x4d, 0x32, 0x45, 0xd5, 0x29, 0xe2, 0x77, 0x62, 0xa9, 0xfe, 0x33, 0x80,
-	0x0b, 0xe3, 0x0d, 0x8a, 0x02, 0xea, 0x47, 0x04, 0x1d, 0xc3, 0x59, 0x3c, 0x8c, 0xb4, 0xe2, 0x53,
-	0xc2, 0x3b, 0x55, 0x6e, 0x7e, 0x16, 0x13, 0x3f, 0x1d, 0x68, 0xcb, 0x62, 0x2f, 0xa2, 0xce, 0x0f,
-	0x86, 0x4b, 0x4d, 0x0f, 0xb3, 0x13, 0xe3, 0x90, 0x38, 0xd8, 0xee, 0x7f, 0x4e, 0xec, 0xdb, 0x81,
-	0xb6, 0x20, 0x8c, 0xc7, 0x6a, 0xe8, 0x56, 0x05, 0xa7, 0xfc, 0xf4, 0x47, 0x00, 0x2a, 0x69, 0x84,
-	0x23, 0xfa, 0x15, 0x3d, 0x7f, 0xb8, 0x1b, 0xa5, 0xff, 0x06, 0xe0, 0x72, 0xe6, 0x8a, 0xee, 0xb9,
-	0xb3, 0x7f, 0x17, 0xe1, 0xdc, 0x17, 0x84, 0x7a, 0x84, 0x85, 0xef, 0x0e, 0x7f, 0xc6, 0xe1, 0xff,
-	0x09, 0xce, 0x8f, 0xb5, 0x47, 0x6e, 0x90, 0x0d, 0x2b, 0x4e, 0x12, 0x18, 0xdd, 0x9f, 0xfd, 0x7c,
-	0xfb, 0x33, 0x2f, 0x5c, 0xd3, 0x25, 0x74, 0x6b, 0xc6, 0x19, 0x35, 0xd3, 0xff, 0x07, 0x70, 0x29,
-	0x65, 0xff, 0xd0, 0x8f, 0xfd, 0x2f, 0x00, 0x2a, 0x59, 0x0b, 0xba, 0xcf, 0xa6, 0xce, 0xc2, 0x99,
-	0xaf, 0x71, 0x88, 0xbd, 0x48, 0xf6, 0x51, 0x3f, 0x84, 0x95, 0x64, 0x42, 0x72, 0xec, 0xc1, 0x52,
-	0xc0, 0x67, 0xb8, 0xff, 0xf4, 0xce, 0x8a, 0x91, 0xf5, 0xba, 0x1a, 0x22, 0xab, 0x39, 0x11, 0xd3,
-	0x59, 0x32, 0x63, 0xe7, 0xf7, 0x12, 0x9c, 0xfc, 0x26, 0x7e, 0xe7, 0x50, 0x1f, 0x96, 0x84, 0x02,
-	0xad, 0xdd, 0x95, 0x2f, 0x31, 0x94, 0xf5, 0xbb, 0x45, 0x02, 0x4d, 0x5f, 0xff, 0xf5, 0xf1, 0x8b,
-	0x3f, 0x8a, 0x2a, 0x5a, 0x31, 0x33, 0x1f, 0x67, 0x69, 0xf8, 0x17, 0x80, 0x95, 0xf4, 0xf5, 0x82,
-	0xea, 0xd9, 0xe5, 0x33, 0x9f, 0x3e, 0x65, 0x2b, 0x9f, 0x58, 0x32, 0x6d, 0x71, 0xa6, 0x0d, 0xb4,
-	0x9e, 0xcd, 0x34, 0x06, 0xf2, 0x1f, 0x80, 0x1f, 0x66, 0x5c, 0x7d, 0x68, 0x3b, 0x8f, 0xe7, 0xe8,
-	0x07, 0xa0, 0x34, 0xde, 0x22, 0x43, 0xa2, 0x36, 0x38, 0x6a, 0x1d, 0x7d, 0x92, 0x07, 0x55, 0x70,
-	0xfd, 0x09, 0xe0, 0x4c, 0xea, 0xcc, 0xa2, 0xcd, 0x6c, 0xdf, 0xac, 0x7b, 0x54, 0xa9, 0xe7, 0xd2,
-	0x4a, 0xba, 0x3a, 0xa7, 0xfb, 0x18, 0xad, 0x65, 0xd3, 0xa5, 0x29, 0xfe, 0x05, 0x10, 0xbd, 0xfe,
-	0x2d, 0x21, 0x33, 0x87, 0x61, 0xaa, 0x8b, 0xdb, 0xf9, 0x13, 0x24, 0xe6, 0x36, 0xc7, 0xdc, 0x44,
-	0xb5, 0x1c, 0x98, 0x3c, 0xb3, 0xf9, 0xed, 0xe5, 0xb5, 0x0a, 0xae, 0xae, 0x55, 0xf0, 0xfc, 0x5a,
-	0x05, 0x17, 0x37, 0x6a, 0xe1, 0xea, 0x46, 0x2d, 0x3c, 0xb9, 0x51, 0x0b, 0xdf, 0xef, 0x3b, 0x2e,
-	0x3b, 0xe9, 0xb5, 0x0d, 0x9b, 0x7a, 0x49, 0xb5, 0x4f, 0xbb, 0xb8, 0x1d, 0x0d, 0x4b, 0x9f, 0xed,
-	0x36, 0xcc, 0x1f, 0x85, 0x81, 0xdd, 0x75, 0x89, 0xcf, 0xc4, 0xaf, 0x48, 0x71, 0x0d, 0x95, 0xf8,
-	0x9f, 0xdd, 0x97, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xe2, 0x54, 0x10, 0x20, 0x0b, 0x00, 0x00,
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xcd, 0x4f, 0x24, 0xc5,
+	0x1b, 0xc7, 0xa9, 0xe1, 0xed, 0x47, 0xb1, 0x40, 0x7e, 0xb5, 0x80, 0x43, 0x03, 0x33, 0x58, 0x8b,
+	0x1b, 0x84, 0xdd, 0x6e, 0x5e, 0x6e, 0x9b, 0xf5, 0xc0, 0xec, 0x26, 0x62, 0x82, 0xa8, 0x1d, 0xb2,
+	0x31, 0x1e, 0x9c, 0xd4, 0xcc, 0xd4, 0x0e, 0x1d, 0x7b, 0xba, 0x9a, 0xee, 0x1a, 0x70, 0x8c, 0x07,
+	0x35, 0x7a, 0x27, 0x31, 0x26, 0x7a, 0xd0, 0x83, 0x37, 0x0f, 0x1e, 0x3d, 0xf9, 0x0f, 0x70, 0xd2,
+	0x4d, 0xbc, 0x18, 0x0f, 0xa3, 0x01, 0x0f, 0x7a, 0xe5, 0x2f, 0x30, 0xf5, 0xd2, 0xe3, 0xf4, 0x50,
+	0x60, 0x6f, 0xa2, 0x24, 0x9b, 0x70, 0x82, 0xae, 0xe7, 0xfb, 0x3c, 0xdf, 0x4f, 0xd5, 0x53, 0x74,
+	0x55, 0x03, 0x17, 0x58, 0xdc, 0x60, 0xb1, 0x17, 0x3b, 0xfc, 0x90, 0x84, 0xce, 0xc1, 0x5a, 0x85,
+	0x72, 0xb2, 0xe6, 0xec, 0x37, 0x69, 0xd4, 0xb2, 0xc3, 0x88, 0x71, 0x86, 0x26, 0xb5, 0xc2, 0x16,
+	0x0a, 0x5b, 0x2b, 0xac, 0xc9, 0x3a, 0xab, 0x33, 0x29, 0x70, 0xc4, 0x6f, 0x4a, 0x6b, 0xdd, 0x36,
+	0x56, 0x13, 0x0f, 0xe5, 0x88, 0x56, 0x59, 0x54, 0xd3, 0x3a, 0x6c, 0xd4, 0xd5, 0x69, 0x40, 0x85,
+	0x91, 0xd2, 0x14, 0xaa, 0x52, 0xe4, 0x54, 0x48, 0x4c, 0x3b, 0x92, 0x2a, 0xf3, 0x02, 0x1d, 0x5f,
+	0xee, 0x8e, 0x4b, 0xe0, 0x8e, 0x2a, 0x24, 0x75, 0x2f, 0x20, 0xdc, 0x63, 0x89, 0x76, 0xae, 0xce,
+	0x58, 0xdd, 0xa7, 0x0e, 0x09, 0x3d, 0x87, 0x04, 0x01, 0xe3, 0x32, 0x98, 0x38, 0xcd, 0xe8, 0xa8,
+	0x7c, 0xaa, 0x34, 0x1f, 0x3b, 0x24, 0x68, 0x25, 0x21, 0x65, 0x52, 0x56, 0x33, 0x55, 0x0f, 0x3a,
+	0x54, 0xec, 0xcd, 0xe2, 0x5e, 0x83, 0xc6, 0x9c, 0x34, 0xc2, 0x64, 0x02, 0xbd, 0x82, 0x5a, 0x33,
+	0xea, 0x82, 0xc2, 0x5f, 0xe5, 0xe0, 0xd4, 0x66, 0xe4, 0xf1, 0xbd, 0x06, 0xe5, 0x5e, 0x75, 0xf7,
+	0x90, 0x84, 0x2e, 0xdd, 0x6f, 0xd2, 0x98, 0xa3, 0xe7, 0xe0, 0x70, 0xc8, 0x98, 0x5f, 0xf6, 0x6a,
+	0x79, 0xb0, 0x00, 0x96, 0x06, 0xdc, 0x21, 0xf1, 0xf8, 0x4a, 0x0d, 0xcd, 0x43, 0x28, 0xa6, 0x5b,
+	0x26, 0x71, 0x4c, 0x79, 0x3e, 0xb7, 0x00, 0x96, 0x46, 0xdc, 0x11, 0x31, 0xb2, 0x29, 0x06, 0x50,
+	0x11, 0x8e, 0xee, 0x37, 0x19, 0x4f, 0xe2, 0xfd, 0x32, 0x0e, 0xe5, 0x90, 0x12, 0xbc, 0x09, 0x61,
+	0xcc, 0x49, 0xc4, 0xcb, 0x82, 0x35, 0x3f, 0xb0, 0x00, 0x96, 0x46, 0xd7, 0x2d, 0x5b, 0x71, 0xda,
+	0x09, 0xa7, 0xbd, 0x9b, 0x4c, 0xa4, 0x34, 0x7f, 0xdc, 0x2e, 0xf6, 0x9d, 0xb5, 0x8b, 0xff, 0x6f,
+	0x91, 0x86, 0x7f, 0x0f, 0xff, 0x9d, 0x8b, 0x8f, 0x7e, 0x2d, 0x02, 0x77, 0x44, 0x0e, 0x08, 0x39,
+	0x72, 0xe1, 0xff, 0x68, 0x50, 0x53, 0x75, 0x07, 0xff, 0xb1, 0xee, 0xec, 0x71, 0xbb, 0x08, 0xce,
+	0xda, 0xc5, 0x09, 0x55, 0x37, 0xc9, 0x54, 0x55, 0x87, 0x69, 0x50, 0x13, 0x52, 0xfc, 0x01, 0x80,
+	0xd3, 0xbd, 0x0b, 0x14, 0x87, 0x2c, 0x88, 0x29, 0x7a, 0x0c, 0x27, 0x48, 0x27, 0x52, 0x16, 0xbb,
+	0x48, 0xae, 0xd4, 0x48, 0xe9, 0x25, 0x41, 0xfc, 0x4b, 0xbb, 0x38, 0xab, 0x7a, 0x15, 0xd7, 0xde,
+	0xb1, 0x3d, 0xe6, 0x34, 0x08, 0xdf, 0xb3, 0xb7, 0x69, 0x9d, 0x54, 0x5b, 0x0f, 0x69, 0xf5, 0xac,
+	0x5d, 0x9c, 0x56, 0xc6, 0x3d, 0x35, 0xb0, 0x3b, 0x4e, 0x52, 0x7e, 0xf8, 0x47, 0x00, 0xad, 0x34,
+	0xc2, 0x2e, 0xdb, 0x61, 0x87, 0xcf, 0x6e, 0xa3, 0xf0, 0x27, 0x00, 0xce, 0x1a, 0x67, 0x74, 0xc5,
+	0x2b, 0xfb, 0x65, 0x0e, 0x4e, 0xbe, 0x4c, 0x59, 0x83, 0xf2, 0xe8, 0x7a, 0xf3, 0x1b, 0x36, 0xff,
+	0xfb, 0x70, 0xaa, 0x67, 0x79, 0x74, 0x83, 0xaa, 0x70, 0xbc, 0x9e, 0x04, 0xba, 0xfb, 0x73, 0x3f,
+	0x5b, 0x7f, 0xa6, 0x94, 0x6b, 0xba, 0x04, 0x76, 0xc7, 0xea, 0xdd, 0x66, 0xf8, 0x07, 0x00, 0x67,
+	0x52, 0xf6, 0xcf, 0xfa, 0xb6, 0xff, 0x10, 0x40, 0xcb, 0x34, 0xa1, 0xab, 0x5c, 0xd4, 0xaf, 0x73,
+	0x70, 0xc6, 0xa5, 0xc4, 0xf7, 0xde, 0xa3, 0xb5, 0x47, 0xcc, 0x27, 0xdc, 0xf3, 0x3d, 0xde, 0xba,
+	0xde, 0xf7, 0xa9, 0x7d, 0x7f, 0x04, 0xa0, 0x65, 0x5a, 0x24, 0xdd, 0xa8, 0x08, 0xde, 0x8c, 0x74,
+	0xb4, 0x7c, 0xd0, 0x09, 0xeb, 0x6e, 0x6d, 0x66, 0xeb, 0x96, 0xa5, 0x00, 0x0c, 0x75, 0xb0, 0x8b,
+	0xa2, 0x73, 0xde, 0xf8, 0x8f, 0x1c, 0x1c, 0x7d, 0x6d, 0x6b, 0xfb, 0xc1, 0x75, 0xa7, 0xba, 0x3b,
+	0x25, 0x6a, 0x7a, 0x01, 0xa7, 0xd1, 0x01, 0xf1, 0xf3, 0x43, 0xb2, 0xe6, 0xcc, 0xb9, 0x9a, 0x0f,
+	0xf5, 0x95, 0xa7, 0x34, 0xab, 0x51, 0x75, 0xc9, 0x24, 0x11, 0x7f, 0x2e, 0x4a, 0x76, 0xea, 0xe0,
+	0xb7, 0xe1, 0x0d, 0xb5, 0xd2, 0xba, 0xdd, 0x3b, 0x70, 0xb8, 0x4a, 0x82, 0x9a, 0x4f, 0xe3, 0x3c,
+	0x58, 0xe8, 0x5f, 0x1a, 0x5d, 0x9f, 0xb3, 0x4d, 0xd7, 0x51, 0xfb, 0x81, 0x14, 0x95, 0xa6, 0xb5,
+	0xcb, 0xb8, 0x72, 0xd1, 0xa9, 0xd8, 0x4d, 0x8a, 0xe0, 0xef, 0x73, 0x70, 0xee, 0xd5, 0xa6, 0xcf,
+	0xbd, 0x2d, 0x16, 0x1a, 0x4f, 0x9f, 0x74, 0x0b, 0x41, 0x6f, 0x0b, 0x77, 0xe0, 0x60, 0xc4, 0x9a,
+	0x9c, 0xe6, 0x73, 0x92, 0x06, 0x9b, 0x69, 0x64, 0x41, 0x21, 0xdb, 0x62, 0x61, 0x69, 0x52, 0x33,
+	0xdd, 0xd0, 0xbb, 0x4e, 0x8c, 0x63, 0x57, 0x95, 0xe9, 0xe9, 0x78, 0xff, 0x7f, 0xd4, 0xf1, 0x81,
+	0x7f, 0xe9, 0x6f, 0xf3, 0x63, 0x00, 0xe7, 0x2f, 0x58, 0xbd, 0xab, 0x7c, 0x8f, 0x4e, 0xc0, 0xb1,
+	0xd7, 0x49, 0x44, 0x1a, 0xb1, 0x6e, 0x1a, 0xde, 0x86, 0xe3, 0xc9, 0x80, 0xe6, 0xb8, 0x07, 0x87,
+	0x42, 0x39, 0x22, 0xfd, 0x2f, 0xdc, 0x36, 0x2a, 0xab, 0x34, 0x20, 0xe8, 0x5c, 0x9d, 0xb1, 0xfe,
+	0xe7, 0x08, 0x1c, 0x7c, 0x43, 0x7c, 0x4f, 0xa0, 0x16, 0x1c, 0x52, 0x0a, 0x74, 0xeb, 0xb2, 0x7c,
+	0x8d, 0x61, 0x2d, 0x5e, 0x2e, 0x52, 0x68, 0x78, 0xf1, 0xa3, 0x9f, 0x7e, 0xff, 0x34, 0x57, 0x40,
+	0x73, 0x8e, 0xf1, 0x23, 0x48, 0x1b, 0x7e, 0x01, 0xe0, 0x78, 0xfa, 0x9a, 0x86, 0x56, 0xcc, 0xe5,
+	0x8d, 0x9f, 0x10, 0xd6, 0x9d, 0x6c, 0x62, 0xcd, 0x74, 0x47, 0x32, 0xdd, 0x46, 0x8b, 0x66, 0xa6,
+	0x1e, 0x90, 0x6f, 0x01, 0xbc, 0x69, 0xb8, 0x42, 0xa2, 0xd5, 0x2c, 0x9e, 0xdd, 0x17, 0x09, 0x6b,
+	0xed, 0x29, 0x32, 0x34, 0xea, 0x9a, 0x44, 0x5d, 0x41, 0x2f, 0x66, 0x41, 0x55, 0x5c, 0x9f, 0x01,
+	0x38, 0x96, 0xda, 0xae, 0x68, 0xd9, 0xec, 0x6b, 0x7a, 0x23, 0x58, 0x2b, 0x99, 0xb4, 0x9a, 0x6e,
+	0x45, 0xd2, 0xbd, 0x80, 0x6e, 0x99, 0xe9, 0xd2, 0x14, 0xdf, 0x00, 0x88, 0xce, 0xdf, 0x49, 0x90,
+	0x93, 0xc1, 0x30, 0xb5, 0x8a, 0xab, 0xd9, 0x13, 0x34, 0xe6, 0xaa, 0xc4, 0x5c, 0x46, 0x4b, 0x19,
+	0x30, 0x15, 0x94, 0x60, 0x3d, 0x7f, 0x2c, 0x5f, 0xc4, 0x7a, 0xe1, 0x2d, 0xc7, 0x5a, 0xcd, 0x9e,
+	0x90, 0x8d, 0xd5, 0x00, 0xb5, 0x0f, 0x07, 0xc4, 0x21, 0x82, 0x9e, 0x37, 0x7b, 0x75, 0x1d, 0xe5,
+	0x16, 0xbe, 0x4c, 0xa2, 0x01, 0xb0, 0x04, 0x98, 0x43, 0x96, 0x19, 0x40, 0x5a, 0x7d, 0x07, 0xe0,
+	0x94, 0xf1, 0xcd, 0x88, 0xd6, 0xcd, 0x0e, 0x97, 0x1d, 0x42, 0xd6, 0xc6, 0x53, 0xe5, 0x68, 0xcc,
+	0x0d, 0x89, 0x79, 0x17, 0xad, 0x98, 0x31, 0x8d, 0xc9, 0xa5, 0x47, 0xc7, 0x27, 0x05, 0xf0, 0xe4,
+	0xa4, 0x00, 0x7e, 0x3b, 0x29, 0x80, 0xa3, 0xd3, 0x42, 0xdf, 0x93, 0xd3, 0x42, 0xdf, 0xcf, 0xa7,
+	0x85, 0xbe, 0xb7, 0xee, 0xd7, 0x3d, 0xbe, 0xd7, 0xac, 0xd8, 0x55, 0xd6, 0x48, 0x0a, 0xde, 0xf5,
+	0x49, 0x25, 0xee, 0x54, 0x3f, 0xd8, 0x58, 0x73, 0xde, 0x55, 0x1e, 0x55, 0xdf, 0xa3, 0x01, 0x57,
+	0xff, 0x84, 0x51, 0x87, 0xcb, 0x90, 0xfc, 0xb1, 0xf1, 0xd7, 0x00, 0x48, 0x0a, 0x81, 0x37, 0x5f,
+	0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -641,6 +1013,9 @@ type QueryClient interface {
 	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+	RealizedVolatility(ctx context.Context, in *RealizedVolatilityRequest, opts ...grpc.CallOption) (*RealizedVolatilityResponse, error)
+	OHLC(ctx context.Context, in *OHLCRequest, opts ...grpc.CallOption) (*OHLCResponse, error)
+	MultiHopGeometricTwap(ctx context.Context, in *MultiHopGeometricTwapRequest, opts ...grpc.CallOption) (*MultiHopGeometricTwapResponse, error)
 }
 
 type queryClient struct {
@@ -696,6 +1071,33 @@ func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapT
 	return out, nil
 }
 
+func (c *queryClient) RealizedVolatility(ctx context.Context, in *RealizedVolatilityRequest, opts ...grpc.CallOption) (*RealizedVolatilityResponse, error) {
+	out := new(RealizedVolatilityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/RealizedVolatility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OHLC(ctx context.Context, in *OHLCRequest, opts ...grpc.CallOption) (*OHLCResponse, error) {
+	out := new(OHLCResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/OHLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MultiHopGeometricTwap(ctx context.Context, in *MultiHopGeometricTwapRequest, opts ...grpc.CallOption) (*MultiHopGeometricTwapResponse, error) {
+	out := new(MultiHopGeometricTwapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/MultiHopGeometricTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -703,6 +1105,9 @@ type QueryServer interface {
 	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+	RealizedVolatility(context.Context, *RealizedVolatilityRequest) (*RealizedVolatilityResponse, error)
+	OHLC(context.Context, *OHLCRequest) (*OHLCResponse, error)
+	MultiHopGeometricTwap(context.Context, *MultiHopGeometricTwapRequest) (*MultiHopGeometricTwapResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -724,6 +1129,15 @@ func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *Geometr
 func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
 }
+func (*UnimplementedQueryServer) RealizedVolatility(ctx context.Context, req *RealizedVolatilityRequest) (*RealizedVolatilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealizedVolatility not implemented")
+}
+func (*UnimplementedQueryServer) OHLC(ctx context.Context, req *OHLCRequest) (*OHLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OHLC not implemented")
+}
+func (*UnimplementedQueryServer) MultiHopGeometricTwap(ctx context.Context, req *MultiHopGeometricTwapRequest) (*MultiHopGeometricTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiHopGeometricTwap not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -819,12 +1233,66 @@ func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.twap.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
+func _Query_RealizedVolatility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RealizedVolatilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RealizedVolatility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/RealizedVolatility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RealizedVolatility(ctx, req.(*RealizedVolatilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OHLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OHLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OHLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/OHLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OHLC(ctx, req.(*OHLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MultiHopGeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiHopGeometricTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultiHopGeometricTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/MultiHopGeometricTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultiHopGeometricTwap(ctx, req.(*MultiHopGeometricTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.twap.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
 		{
@@ -843,6 +1311,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GeometricTwapToNow",
 			Handler:    _Query_GeometricTwapToNow_Handler,
 		},
+		{
+			MethodName: "RealizedVolatility",
+			Handler:    _Query_RealizedVolatility_Handler,
+		},
+		{
+			MethodName: "OHLC",
+			Handler:    _Query_OHLC_Handler,
+		},
+		{
+			MethodName: "MultiHopGeometricTwap",
+			Handler:    _Query_MultiHopGeometricTwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/twap/v1beta1/query.proto",
@@ -1200,7 +1680,7 @@ func (m *GeometricTwapToNowResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RealizedVolatilityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1210,20 +1690,57 @@ func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RealizedVolatilityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RealizedVolatilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *RealizedVolatilityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1233,22 +1750,22 @@ func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RealizedVolatilityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RealizedVolatilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RealizedVolatility.Size()
+		i -= size
+		if _, err := m.RealizedVolatility.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -1256,119 +1773,375 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OHLCRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ArithmeticTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OHLCRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OHLCRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err9 != nil {
+		return 0, err9
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.EndTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ArithmeticTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OHLCResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArithmeticTwapToNowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OHLCResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OHLCResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ArithmeticTwapToNowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiHopGeometricTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ArithmeticTwap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GeometricTwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MultiHopGeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopGeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.EndTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintQuery(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GeometricTwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiHopGeometricTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MultiHopGeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopGeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapToNowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArithmeticTwapToNowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GeometricTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GeometricTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.GeometricTwap.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
@@ -1407,33 +2180,971 @@ func (m *GeometricTwapToNowResponse) Size() (n int) {
 	return n
 }
 
-func (m *ParamsRequest) Size() (n int) {
+func (m *RealizedVolatilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RealizedVolatilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RealizedVolatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OHLCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OHLCResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiHopGeometricTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiHopGeometricTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,10 +3167,10 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1578,42 +3289,6 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1635,7 +3310,7 @@ func (m *ArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,15 +3333,15 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1694,7 +3369,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1719,7 +3394,7 @@ func (m *ArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *RealizedVolatilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,10 +3417,10 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RealizedVolatilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RealizedVolatilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1864,6 +3539,42 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1885,7 +3596,7 @@ func (m *ArithmeticTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *RealizedVolatilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,15 +3619,15 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RealizedVolatilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArithmeticTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RealizedVolatilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedVolatility", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1944,7 +3655,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedVolatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1969,7 +3680,7 @@ func (m *ArithmeticTwapToNowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
+func (m *OHLCRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1992,10 +3703,10 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OHLCRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OHLCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2150,6 +3861,39 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2171,7 +3915,7 @@ func (m *GeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
+func (m *OHLCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2194,17 +3938,17 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OHLCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OHLCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2214,23 +3958,23 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, types.Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2255,7 +3999,7 @@ func (m *GeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiHopGeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2278,17 +4022,17 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiHopGeometricTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiHopGeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2298,16 +4042,29 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2317,29 +4074,31 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.Route = append(m.Route, types.TwapRouteHop{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2349,27 +4108,28 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2396,7 +4156,10 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2421,7 +4184,7 @@ func (m *GeometricTwapToNowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiHopGeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2444,10 +4207,10 @@ func (m *GeometricTwapToNowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiHopGeometricTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeometricTwapToNowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiHopGeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -60,6 +60,22 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func ComputeRealizedVolatility(startRecord types.TwapRecord, records []types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	return computeRealizedVolatility(startRecord, records, endRecord)
+}
+
+func ComputeCandles(openRecord types.TwapRecord, records []types.TwapRecord, quoteAsset string, startTime, endTime time.Time, interval time.Duration) []types.Candle {
+	return computeCandles(openRecord, records, quoteAsset, startTime, endTime, interval)
+}
+
+func (k Keeper) GetSpotPriceRange(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.SpotPriceRange, bool) {
+	return k.getSpotPriceRange(ctx, poolId, denom0, denom1)
+}
+
+func (k Keeper) GetRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, asset0Denom, asset1Denom string) ([]types.TwapRecord, error) {
+	return k.getRecordsInTimeRange(ctx, poolId, startTime, endTime, asset0Denom, asset1Denom)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -63,6 +63,11 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+
+		P0HighSpotPrice: osmomath.OneDec(),
+		P0LowSpotPrice:  osmomath.OneDec(),
+		P1HighSpotPrice: osmomath.OneDec(),
+		P1LowSpotPrice:  osmomath.OneDec(),
 	}
 
 	basicCustomGenesis = types.NewGenesisState(
@@ -85,6 +90,11 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+
+				P0HighSpotPrice: osmomath.OneDec(),
+				P0LowSpotPrice:  osmomath.OneDec(),
+				P1HighSpotPrice: osmomath.OneDec(),
+				P1LowSpotPrice:  osmomath.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -97,6 +107,11 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+
+				P0HighSpotPrice: osmomath.OneDec(),
+				P0LowSpotPrice:  osmomath.OneDec(),
+				P1HighSpotPrice: osmomath.OneDec(),
+				P1LowSpotPrice:  osmomath.OneDec(),
 			},
 			mostRecentRecordPoolOne,
 		})
@@ -112,6 +127,11 @@ var (
 		P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 		P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 		GeometricTwapAccumulator:    osmomath.OneDec(),
+
+		P0HighSpotPrice: osmomath.OneDec(),
+		P0LowSpotPrice:  osmomath.OneDec(),
+		P1HighSpotPrice: osmomath.OneDec(),
+		P1LowSpotPrice:  osmomath.OneDec(),
 	}
 
 	decreasingOrderByTimeRecordsPoolTwo = types.NewGenesisState(
@@ -129,6 +149,11 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+
+				P0HighSpotPrice: osmomath.OneDec(),
+				P0LowSpotPrice:  osmomath.OneDec(),
+				P1HighSpotPrice: osmomath.OneDec(),
+				P1LowSpotPrice:  osmomath.OneDec(),
 			},
 			{
 				PoolId:                      basePoolId,
@@ -141,6 +166,11 @@ var (
 				P0ArithmeticTwapAccumulator: osmomath.OneDec(),
 				P1ArithmeticTwapAccumulator: osmomath.OneDec(),
 				GeometricTwapAccumulator:    osmomath.OneDec(),
+
+				P0HighSpotPrice: osmomath.OneDec(),
+				P0LowSpotPrice:  osmomath.OneDec(),
+				P1HighSpotPrice: osmomath.OneDec(),
+				P1LowSpotPrice:  osmomath.OneDec(),
 			},
 		})
 
@@ -427,6 +457,11 @@ func newTwoAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accum0, accum1, geo
 		P0ArithmeticTwapAccumulator: accum0,
 		P1ArithmeticTwapAccumulator: accum1,
 		GeometricTwapAccumulator:    geomAccum,
+
+		P0HighSpotPrice: sp0,
+		P0LowSpotPrice:  sp0,
+		P1HighSpotPrice: osmomath.OneDec().Quo(sp0),
+		P1LowSpotPrice:  osmomath.OneDec().Quo(sp0),
 	}
 }
 
@@ -448,15 +483,22 @@ func newThreeAssetPoolTwapRecordWithDefaults(t time.Time, sp0, accumA, accumB, a
 		P0ArithmeticTwapAccumulator: accumA,
 		P1ArithmeticTwapAccumulator: accumB,
 		GeometricTwapAccumulator:    geomAccumAB,
+
+		P0HighSpotPrice: spA,
+		P0LowSpotPrice:  spA,
+		P1HighSpotPrice: spB,
+		P1LowSpotPrice:  spB,
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
 	twapAC.P1LastSpotPrice = spC
+	twapAC.P1HighSpotPrice, twapAC.P1LowSpotPrice = spC, spC
 	twapAC.P1ArithmeticTwapAccumulator = accumC
 	twapAC.GeometricTwapAccumulator = geomAccumAC
 	twapBC := twapAC
 	twapBC.Asset0Denom = denom1
 	twapBC.P0LastSpotPrice = spB
+	twapBC.P0HighSpotPrice, twapBC.P0LowSpotPrice = spB, spB
 	twapBC.P0ArithmeticTwapAccumulator = accumB
 	twapBC.GeometricTwapAccumulator = geomAccumBC
 
@@ -475,6 +517,11 @@ func newEmptyPriceRecord(poolId uint64, t time.Time, asset0 string, asset1 strin
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+
+		P0HighSpotPrice: osmomath.ZeroDec(),
+		P0LowSpotPrice:  osmomath.ZeroDec(),
+		P1HighSpotPrice: osmomath.ZeroDec(),
+		P1LowSpotPrice:  osmomath.ZeroDec(),
 	}
 }
 
@@ -505,6 +552,11 @@ func newRecord(poolId uint64, t time.Time, sp0, accum0, accum1, geomAccum osmoma
 		P0ArithmeticTwapAccumulator: accum0.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accum1.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccum.Add(osmomath.ZeroDec()),
+
+		P0HighSpotPrice: sp0,
+		P0LowSpotPrice:  sp0,
+		P1HighSpotPrice: osmomath.OneDec().Quo(sp0),
+		P1LowSpotPrice:  osmomath.OneDec().Quo(sp0),
 	}
 }
 
@@ -535,15 +587,22 @@ func newThreeAssetRecord(poolId uint64, t time.Time, sp0, accumA, accumB, accumC
 		P0ArithmeticTwapAccumulator: accumA.Add(osmomath.ZeroDec()),
 		P1ArithmeticTwapAccumulator: accumB.Add(osmomath.ZeroDec()),
 		GeometricTwapAccumulator:    geomAccumAB.Add(osmomath.ZeroDec()),
+
+		P0HighSpotPrice: spA,
+		P0LowSpotPrice:  spA,
+		P1HighSpotPrice: spB,
+		P1LowSpotPrice:  spB,
 	}
 	twapAC := twapAB
 	twapAC.Asset1Denom = denom2
 	twapAC.P1LastSpotPrice = spC
+	twapAC.P1HighSpotPrice, twapAC.P1LowSpotPrice = spC, spC
 	twapAC.P1ArithmeticTwapAccumulator = accumC
 	twapAC.GeometricTwapAccumulator = geomAccumAC.Add(osmomath.ZeroDec())
 	twapBC := twapAC
 	twapBC.Asset0Denom = denom1
 	twapBC.P0LastSpotPrice = spB
+	twapBC.P0HighSpotPrice, twapBC.P0LowSpotPrice = spB, spB
 	twapBC.P0ArithmeticTwapAccumulator = accumB
 	twapBC.GeometricTwapAccumulator = geomAccumBC.Add(osmomath.ZeroDec())
 	return []types.TwapRecord{twapAB, twapAC, twapBC}
@@ -619,3 +678,9 @@ func recordWithUpdatedSpotPrice(record types.TwapRecord, sp0 osmomath.Dec, sp1 o
 	record.P1LastSpotPrice = sp1
 	return record
 }
+
+func recordWithSpotPriceRange(record types.TwapRecord, spotPriceRange types.SpotPriceRange) types.TwapRecord {
+	record.P0HighSpotPrice, record.P0LowSpotPrice = spotPriceRange.P0High, spotPriceRange.P0Low
+	record.P1HighSpotPrice, record.P1LowSpotPrice = spotPriceRange.P1High, spotPriceRange.P1Low
+	return record
+}
@@ -370,6 +370,76 @@ func (s *TestSuite) TestPoolStateChange() {
 	}
 }
 
+// TestSwapTracksSpotPriceRange validates that the spot prices after every swap in a block
+// are included in the spot price range of the record written at the end of the block.
+func (s *TestSuite) TestSwapTracksSpotPriceRange() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+
+	s.EndBlock()
+	s.Commit()
+
+	_, found := s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom0, denom1)
+	s.Require().False(found)
+
+	// swap denom0 in, raising the price of denom1, then swap more denom1 in, lowering it
+	// below where it started.
+	s.swapExactAmountIn(poolId, sdk.NewInt64Coin(denom0, 100_000_000), denom1)
+	spAfterFirstSwap := s.spotPrice(poolId, denom0, denom1)
+	s.swapExactAmountIn(poolId, sdk.NewInt64Coin(denom1, 300_000_000), denom0)
+	spAfterSecondSwap := s.spotPrice(poolId, denom0, denom1)
+	s.Require().True(spAfterFirstSwap.GT(spAfterSecondSwap))
+
+	spotPriceRange, found := s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom0, denom1)
+	s.Require().True(found)
+	s.Require().Equal(spAfterFirstSwap, spotPriceRange.P0High)
+	s.Require().Equal(spAfterSecondSwap, spotPriceRange.P0Low)
+
+	s.EndBlock()
+	s.Commit()
+
+	// the record keeps the intra-block high, which is not its last spot price.
+	record, err := s.twapkeeper.GetRecordAtOrBeforeTime(s.Ctx, poolId, s.Ctx.BlockTime(), denom0, denom1)
+	s.Require().NoError(err)
+	s.Require().Equal(spAfterSecondSwap, record.P0LastSpotPrice)
+	s.Require().Equal(spAfterFirstSwap, record.P0HighSpotPrice)
+	s.Require().Equal(spAfterSecondSwap, record.P0LowSpotPrice)
+	s.Require().True(record.P1HighSpotPrice.GT(record.P1LowSpotPrice))
+
+	// the transient range is cleared with the block.
+	_, found = s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom0, denom1)
+	s.Require().False(found)
+}
+
+// TestSwapTracksSpotPriceRangeOfSwappedPair validates that only the spot price range of the swapped
+// pair of a multi-asset pool is tracked.
+func (s *TestSuite) TestSwapTracksSpotPriceRangeOfSwappedPair() {
+	poolId := s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...)
+
+	s.EndBlock()
+	s.Commit()
+
+	s.swapExactAmountIn(poolId, sdk.NewInt64Coin(denom2, 100_000_000), denom0)
+
+	_, found := s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom0, denom2)
+	s.Require().True(found)
+	_, found = s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom0, denom1)
+	s.Require().False(found)
+	_, found = s.twapkeeper.GetSpotPriceRange(s.Ctx, poolId, denom1, denom2)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, _, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, tokenIn, tokenOutDenom, osmomath.ZeroInt())
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) spotPrice(poolId uint64, quoteDenom, baseDenom string) osmomath.Dec {
+	spotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPrice(s.Ctx, poolId, quoteDenom, baseDenom)
+	s.Require().NoError(err)
+	return spotPrice.Dec()
+}
+
 // This test validates that all twap record mutators (listeners) run as expected
 // and update twap + last spot price error at the desired points in the execution flow.
 // It assumed that every state change message occurs in a separate block.
@@ -30,6 +30,10 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
 		LastErrorTime:               lastErrorTime,
+		P0HighSpotPrice:             sp0,
+		P0LowSpotPrice:              sp0,
+		P1HighSpotPrice:             sp1,
+		P1LowSpotPrice:              sp1,
 	}, nil
 }
 
@@ -200,6 +204,18 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	newRecord.P1LastSpotPrice = newSp1
 	newRecord.LastErrorTime = lastErrorTime
 
+	// the block's spot price range includes the prices after every swap in the block, and the final price.
+	spotPriceRange, found := k.getSpotPriceRange(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+	if found {
+		spotPriceRange = spotPriceRange.With(newSp0, newSp1)
+	} else {
+		spotPriceRange = types.NewSpotPriceRange(newSp0, newSp1)
+	}
+	newRecord.P0HighSpotPrice = spotPriceRange.P0High
+	newRecord.P0LowSpotPrice = spotPriceRange.P0Low
+	newRecord.P1HighSpotPrice = spotPriceRange.P1High
+	newRecord.P1LowSpotPrice = spotPriceRange.P1Low
+
 	return newRecord, nil
 }
 
@@ -287,12 +303,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := checkSpotPriceErrorBetween(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
@@ -305,6 +316,114 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// checkSpotPriceErrorBetween returns an error if a spot price error occurred between the start and end record.
+// if (endRecord.LastErrorTime >= startRecord.Time) returns an error
+// if (startRecord.LastErrorTime == startRecord.Time) returns an error
+func checkSpotPriceErrorBetween(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
+// computeRealizedVolatility computes and returns the standard deviation of the natural log returns
+// of the spot price between consecutive records, from startRecord through records.
+// records must be all records with a time in (startRecord.Time, endRecord.Time], ordered by time.
+// The log return of a record is ln(P_i / P_{i-1}), where P_i is its last spot price of asset 0.
+// Since ln(1/P) = -ln(P), the result does not depend on which asset is the quote asset.
+// A steady trend has constant returns and so no volatility, while a price that moves back and forth does.
+// Returns zero if there are fewer than two returns.
+// Returns are taken per record rather than per unit of time, so the result is not annualized.
+// Records with a zero spot price are skipped, as their logarithm is undefined.
+// Returns the same spot price errors as computeTwap.
+func computeRealizedVolatility(startRecord types.TwapRecord, records []types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	err := checkSpotPriceErrorBetween(startRecord, endRecord)
+
+	logReturns := []osmomath.Dec{}
+	prevSpotPrice := startRecord.P0LastSpotPrice
+	for _, record := range records {
+		spotPrice := record.P0LastSpotPrice
+		if !prevSpotPrice.IsZero() && !spotPrice.IsZero() {
+			logReturns = append(logReturns, twapLog(spotPrice).Sub(twapLog(prevSpotPrice)))
+		}
+		prevSpotPrice = spotPrice
+	}
+	if len(logReturns) < 2 {
+		return osmomath.ZeroDec(), err
+	}
+
+	numReturns := osmomath.NewDec(int64(len(logReturns)))
+	meanLogReturn := osmomath.ZeroDec()
+	for _, logReturn := range logReturns {
+		meanLogReturn.AddMut(logReturn)
+	}
+	meanLogReturn.QuoMut(numReturns)
+
+	variance := osmomath.ZeroDec()
+	for _, logReturn := range logReturns {
+		deviation := logReturn.Sub(meanLogReturn)
+		variance.AddMut(deviation.Mul(deviation))
+	}
+	variance.QuoMut(numReturns)
+	if !variance.IsPositive() {
+		return osmomath.ZeroDec(), err
+	}
+
+	stdDev, sqrtErr := osmomath.MonotonicSqrt(variance)
+	if sqrtErr != nil {
+		return osmomath.Dec{}, sqrtErr
+	}
+
+	// log returns are in base 2, ln(x) = log_{2}{x} * ln(2)
+	return stdDev.Mul(types.Ln2), err
+}
+
+// computeCandles splits [startTime, endTime] into consecutive intervals and returns the open,
+// high, low and close spot price of the base asset for each of them.
+// openRecord must be the record at or before startTime, and records must be all records
+// with a time in (startTime, endTime], ordered by time.
+// The spot price at time t is the last spot price of the record at or before t.
+// A record at time t contributes the range of spot prices observed during its block to the
+// candle containing t. The last candle ends at endTime, and may be shorter than the interval.
+func computeCandles(openRecord types.TwapRecord, records []types.TwapRecord, quoteAsset string, startTime, endTime time.Time, interval time.Duration) []types.Candle {
+	isQuoteAsset0 := quoteAsset == openRecord.Asset0Denom
+	lastSpotPrice := func(record types.TwapRecord) osmomath.Dec {
+		if isQuoteAsset0 {
+			return record.P0LastSpotPrice
+		}
+		return record.P1LastSpotPrice
+	}
+
+	candles := []types.Candle{}
+	price := lastSpotPrice(openRecord)
+	for candleStart := startTime; candleStart.Before(endTime); candleStart = candleStart.Add(interval) {
+		candleEnd := candleStart.Add(interval)
+		if candleEnd.After(endTime) {
+			candleEnd = endTime
+		}
+
+		candle := types.Candle{StartTime: candleStart, EndTime: candleEnd, Open: price, High: price, Low: price}
+		for len(records) > 0 && !records[0].Time.After(candleEnd) {
+			spotPriceRange := types.SpotPriceRangeOf(records[0])
+			high, low := spotPriceRange.P1High, spotPriceRange.P1Low
+			if isQuoteAsset0 {
+				high, low = spotPriceRange.P0High, spotPriceRange.P0Low
+			}
+			candle.High = osmomath.MaxDec(candle.High, high)
+			candle.Low = osmomath.MinDec(candle.Low, low)
+
+			price = lastSpotPrice(records[0])
+			records = records[1:]
+		}
+		candle.Close = price
+
+		candles = append(candles, candle)
+	}
+	return candles
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
@@ -234,6 +234,8 @@ func (s *TestSuite) TestUpdateRecord() {
 			if (test.expRecord.P1LastSpotPrice == osmomath.Dec{}) {
 				test.expRecord.P1LastSpotPrice = test.spotPriceResult1.Sp
 			}
+			// no swaps happened in the block, so the range is the updated spot price.
+			test.expRecord = recordWithSpotPriceRange(test.expRecord, types.NewSpotPriceRange(test.expRecord.P0LastSpotPrice, test.expRecord.P1LastSpotPrice))
 			test.expRecord.Height = s.Ctx.BlockHeight()
 			test.expRecord.Time = s.Ctx.BlockTime()
 
@@ -315,6 +317,7 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 			test.expRecord.PoolId = test.record.PoolId
 			test.expRecord.P0LastSpotPrice = test.record.P0LastSpotPrice
 			test.expRecord.P1LastSpotPrice = test.record.P1LastSpotPrice
+			test.expRecord = recordWithSpotPriceRange(test.expRecord, types.SpotPriceRangeOf(test.record))
 
 			osmoassert.ConditionalPanic(t, test.expectPanic, func() {
 				gotRecord := twap.RecordWithUpdatedAccumulators(test.record, test.newTime)
@@ -355,6 +358,7 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 				test.expRecord[i].Time = test.interpolateTime
 				test.expRecord[i].P0LastSpotPrice = test.record[i].P0LastSpotPrice
 				test.expRecord[i].P1LastSpotPrice = test.record[i].P1LastSpotPrice
+				test.expRecord[i] = recordWithSpotPriceRange(test.expRecord[i], types.SpotPriceRangeOf(test.record[i]))
 
 				gotRecord := twap.RecordWithUpdatedAccumulators(test.record[i], test.interpolateTime)
 				require.Equal(t, test.expRecord[i], gotRecord)
@@ -363,6 +367,171 @@ func TestRecordWithUpdatedAccumulators_ThreeAsset(t *testing.T) {
 	}
 }
 
+func TestComputeRealizedVolatility(t *testing.T) {
+	sp2Record := newRecord(1, baseTime, twoDec, zeroDec, zeroDec, zeroDec)
+	// recordsWithPrices returns a record every ten seconds after sp2Record with the given spot prices.
+	recordsWithPrices := func(spotPrices ...int64) []types.TwapRecord {
+		records := []types.TwapRecord{}
+		for i, spotPrice := range spotPrices {
+			records = append(records, withSp0(withTime(sp2Record, baseTime.Add(time.Duration(i+1)*10*time.Second)), osmomath.NewDec(spotPrice)))
+		}
+		return records
+	}
+
+	tests := map[string]struct {
+		records       []types.TwapRecord
+		endRecord     types.TwapRecord
+		expVolatility osmomath.Dec
+		expErr        bool
+	}{
+		"no records": {
+			endRecord:     sp2Record,
+			expVolatility: zeroDec,
+		},
+		// a single return has no dispersion
+		"one price change": {
+			records:       recordsWithPrices(8),
+			expVolatility: zeroDec,
+		},
+		// log_{2} returns are 1, 1, 1
+		"steady trend": {
+			records:       recordsWithPrices(4, 8, 16),
+			expVolatility: zeroDec,
+		},
+		// log_{2} returns are 1, -1, so their standard deviation is 1
+		"price back and forth": {
+			records:       recordsWithPrices(4, 2),
+			expVolatility: types.Ln2,
+		},
+		// log_{2} returns are 1, -1, 1, -1, so their standard deviation is 1
+		"price back and forth twice": {
+			records:       recordsWithPrices(4, 2, 4, 2),
+			expVolatility: types.Ln2,
+		},
+		// log_{2} returns are 2, -2, 2, so their mean is 2/3 and their standard deviation is sqrt(32/9)
+		"price back and forth, uneven": {
+			records:       recordsWithPrices(8, 2, 8),
+			expVolatility: osmomath.MustMonotonicSqrt(osmomath.NewDec(32).QuoInt64(9)).Mul(types.Ln2),
+		},
+		// returns from and to the zero spot price are skipped, leaving 1, -1
+		"zero spot price skipped": {
+			records:       recordsWithPrices(4, 0, 4, 2),
+			expVolatility: types.Ln2,
+		},
+		"spot price error in window": {
+			records:       recordsWithPrices(4, 2),
+			endRecord:     withLastErrTime(sp2Record, baseTime.Add(10*time.Second)),
+			expVolatility: types.Ln2,
+			expErr:        true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			endRecord := test.endRecord
+			if endRecord.Time.IsZero() {
+				endRecord = test.records[len(test.records)-1]
+			}
+			volatility, err := twap.ComputeRealizedVolatility(sp2Record, test.records, endRecord)
+			if test.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			osmoassert.DecApproxEq(t, test.expVolatility, volatility, osmomath.NewDecWithPrec(1, 12))
+		})
+	}
+}
+
+func TestComputeCandles(t *testing.T) {
+	tenDec := osmomath.NewDec(10)
+	oneOver := func(d osmomath.Dec) osmomath.Dec {
+		return osmomath.OneDec().Quo(d)
+	}
+	openRecord := newRecord(1, baseTime.Add(-5*time.Second), tenDec, zeroDec, zeroDec, zeroDec)
+	// the price went from 10 to 15 and 9 during the block, and closed at 12.
+	volatileRecord := recordWithSpotPriceRange(newRecord(1, baseTime.Add(5*time.Second), osmomath.NewDec(12), zeroDec, zeroDec, zeroDec),
+		types.NewSpotPriceRange(osmomath.NewDec(15), oneOver(osmomath.NewDec(15))).With(osmomath.NewDec(9), oneOver(osmomath.NewDec(9))))
+	sp8Record := newRecord(1, baseTime.Add(25*time.Second), osmomath.NewDec(8), zeroDec, zeroDec, zeroDec)
+	records := []types.TwapRecord{volatileRecord, sp8Record}
+
+	newCandle := func(start, end time.Duration, open, high, low, closePrice osmomath.Dec) types.Candle {
+		return types.Candle{StartTime: baseTime.Add(start), EndTime: baseTime.Add(end), Open: open, High: high, Low: low, Close: closePrice}
+	}
+
+	tests := map[string]struct {
+		records    []types.TwapRecord
+		quoteAsset string
+		endTime    time.Time
+		interval   time.Duration
+		expCandles []types.Candle
+	}{
+		"no records in window": {
+			records:    []types.TwapRecord{},
+			quoteAsset: denom0,
+			endTime:    baseTime.Add(20 * time.Second),
+			interval:   10 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 10*time.Second, tenDec, tenDec, tenDec, tenDec),
+				newCandle(10*time.Second, 20*time.Second, tenDec, tenDec, tenDec, tenDec),
+			},
+		},
+		"intra-block range widens high and low": {
+			records:    records,
+			quoteAsset: denom0,
+			endTime:    baseTime.Add(30 * time.Second),
+			interval:   10 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 10*time.Second, tenDec, osmomath.NewDec(15), osmomath.NewDec(9), osmomath.NewDec(12)),
+				newCandle(10*time.Second, 20*time.Second, osmomath.NewDec(12), osmomath.NewDec(12), osmomath.NewDec(12), osmomath.NewDec(12)),
+				newCandle(20*time.Second, 30*time.Second, osmomath.NewDec(12), osmomath.NewDec(12), osmomath.NewDec(8), osmomath.NewDec(8)),
+			},
+		},
+		"quote asset 1": {
+			records:    records,
+			quoteAsset: denom1,
+			endTime:    baseTime.Add(30 * time.Second),
+			interval:   30 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 30*time.Second, oneOver(tenDec), oneOver(osmomath.NewDec(8)), oneOver(osmomath.NewDec(15)), oneOver(osmomath.NewDec(8))),
+			},
+		},
+		"last candle is shorter than the interval": {
+			records:    records,
+			quoteAsset: denom0,
+			endTime:    baseTime.Add(25 * time.Second),
+			interval:   20 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 20*time.Second, tenDec, osmomath.NewDec(15), osmomath.NewDec(9), osmomath.NewDec(12)),
+				newCandle(20*time.Second, 25*time.Second, osmomath.NewDec(12), osmomath.NewDec(12), osmomath.NewDec(8), osmomath.NewDec(8)),
+			},
+		},
+		"records without a range fall back to the last spot price": {
+			records:    []types.TwapRecord{recordWithSpotPriceRange(volatileRecord, types.SpotPriceRange{P0High: zeroDec, P0Low: zeroDec, P1High: zeroDec, P1Low: zeroDec})},
+			quoteAsset: denom0,
+			endTime:    baseTime.Add(10 * time.Second),
+			interval:   10 * time.Second,
+			expCandles: []types.Candle{
+				newCandle(0, 10*time.Second, tenDec, osmomath.NewDec(12), tenDec, osmomath.NewDec(12)),
+			},
+		},
+		"empty window": {
+			records:    []types.TwapRecord{},
+			quoteAsset: denom0,
+			endTime:    baseTime,
+			interval:   10 * time.Second,
+			expCandles: []types.Candle{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			candles := twap.ComputeCandles(openRecord, test.records, test.quoteAsset, baseTime, test.endTime, test.interval)
+			require.Equal(t, test.expCandles, candles)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetInterpolatedRecord() {
 	baseRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.OneDec(), osmomath.OneDec(), osmomath.OneDec(), osmomath.OneDec().Quo(twoDec))
 
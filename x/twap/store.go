@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -44,7 +45,7 @@ var sentinelExistsValue = []byte{1}
 // to track that this pool changed this block.
 // This tracking is for use in EndBlock, to create new TWAP records.
 func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolTransientPrefix)
 	poolIdBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(poolIdBz, poolId)
 
@@ -55,7 +56,7 @@ func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
 // This is to be guaranteed by trackChangedPool being called on every
 // price-affecting pool action.
 func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
-	store := ctx.TransientStore(k.transientKey)
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.ChangedPoolTransientPrefix)
 	iter := store.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -68,6 +69,81 @@ func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
 	return alteredPoolIds
 }
 
+// trackSpotPriceRange widens the range of spot prices observed this block for the asset pairs
+// swapped between the given input and output denoms to include the pool's current spot prices.
+// This tracking is for use in EndBlock, to record the intra-block high and low of each pair.
+// Only the swapped pairs are tracked so that the cost of a swap does not grow with the number
+// of assets in the pool. Pairs that were not swapped fall back to their last spot price in EndBlock.
+// Spot prices that fail to compute are skipped, the error is recorded by EndBlock instead.
+func (k Keeper) trackSpotPriceRange(ctx sdk.Context, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for _, tokenIn := range input {
+		for _, tokenOut := range output {
+			if tokenIn.Denom == tokenOut.Denom {
+				continue
+			}
+			denom0, denom1, err := types.LexicographicalOrderDenoms(tokenIn.Denom, tokenOut.Denom)
+			if err != nil {
+				continue
+			}
+			k.trackPairSpotPriceRange(ctx, poolId, denom0, denom1)
+		}
+	}
+}
+
+// trackPairSpotPriceRange widens the range of spot prices observed this block for the given
+// lexicographically ordered asset pair to include the pool's current spot prices.
+func (k Keeper) trackPairSpotPriceRange(ctx sdk.Context, poolId uint64, denom0, denom1 string) {
+	sp0, sp1, latestErrTime := getSpotPrices(ctx, k.poolmanagerKeeper, poolId, denom0, denom1, time.Time{})
+	if latestErrTime.Equal(ctx.BlockTime()) {
+		return
+	}
+
+	spotPriceRange, found := k.getSpotPriceRange(ctx, poolId, denom0, denom1)
+	if found {
+		spotPriceRange = spotPriceRange.With(sp0, sp1)
+	} else {
+		spotPriceRange = types.NewSpotPriceRange(sp0, sp1)
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	osmoutils.MustSet(store, types.FormatSpotPriceRangeKey(poolId, denom0, denom1), &spotPriceRange)
+}
+
+// getSpotPriceRange returns the range of spot prices observed this block for the given asset pair.
+// Returns false if the pair's pool was not swapped against this block.
+func (k Keeper) getSpotPriceRange(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.SpotPriceRange, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	spotPriceRange := types.SpotPriceRange{}
+	found, err := osmoutils.Get(store, types.FormatSpotPriceRangeKey(poolId, denom0, denom1), &spotPriceRange)
+	if err != nil || !found {
+		return types.SpotPriceRange{}, false
+	}
+	return spotPriceRange, true
+}
+
+// getRecordsInTimeRange returns all records of the given asset pair with a time in (startTime, endTime],
+// ordered by time.
+func (k Keeper) getRecordsInTimeRange(ctx sdk.Context, poolId uint64, startTime, endTime time.Time, asset0Denom, asset1Denom string) ([]types.TwapRecord, error) {
+	asset0Denom, asset1Denom, err := types.LexicographicalOrderDenoms(asset0Denom, asset1Denom)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	// The iterator's end is exclusive, so it ends right after the key of a record at endTime.
+	startKey := types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, startTime)
+	endKey := storetypes.PrefixEndBytes(types.FormatHistoricalPoolIndexTWAPKey(poolId, asset0Denom, asset1Denom, endTime))
+	records, err := osmoutils.GatherValuesFromStore(store, startKey, endKey, types.ParseTwapFromBz)
+	if err != nil {
+		return nil, err
+	}
+
+	// The iterator's start is inclusive, so a record at startTime has to be skipped.
+	if len(records) > 0 && records[0].Time.Equal(startTime) {
+		records = records[1:]
+	}
+	return records, nil
+}
+
 // storeHistoricalTWAP writes a twap to the store, indexed by pool id.
 func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 	store := ctx.KVStore(k.storeKey)
@@ -331,6 +331,58 @@ func (s *TestSuite) TestGetRecordAtOrBeforeTime() {
 // TestPruneRecordsBeforeTime tests that all twap records earlier than
 // current block time - given time are pruned from the store while
 // the newest record for each pool before the time to keep is preserved.
+// TestGetRecordsInTimeRange validates that records exactly at the start time are excluded
+// and records exactly at the end time are included.
+func (s *TestSuite) TestGetRecordsInTimeRange() {
+	tPlus10s, tPlus20s, tPlus30s := baseTime.Add(10*time.Second), baseTime.Add(20*time.Second), baseTime.Add(30*time.Second)
+	records := []types.TwapRecord{
+		newEmptyPriceRecord(1, baseTime, denom0, denom1),
+		newEmptyPriceRecord(1, tPlus10s, denom0, denom1),
+		newEmptyPriceRecord(1, tPlus20s, denom0, denom1),
+		newEmptyPriceRecord(1, tPlus30s, denom0, denom1),
+		// records of another pair and pool at the same times are never included.
+		newEmptyPriceRecord(1, tPlus20s, denom0, denom2),
+		newEmptyPriceRecord(2, tPlus20s, denom0, denom1),
+	}
+
+	tests := map[string]struct {
+		startTime       time.Time
+		endTime         time.Time
+		expectedRecords []types.TwapRecord
+	}{
+		"start and end at records": {
+			startTime:       baseTime,
+			endTime:         tPlus20s,
+			expectedRecords: records[1:3],
+		},
+		"start and end between records": {
+			startTime:       baseTime.Add(5 * time.Second),
+			endTime:         baseTime.Add(25 * time.Second),
+			expectedRecords: records[1:3],
+		},
+		"end at the last record": {
+			startTime:       tPlus20s,
+			endTime:         tPlus30s,
+			expectedRecords: records[3:4],
+		},
+		"start at the last record": {
+			startTime:       tPlus30s,
+			endTime:         tPlus30s.Add(time.Second),
+			expectedRecords: []types.TwapRecord{},
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(records)
+
+			actualRecords, err := s.twapkeeper.GetRecordsInTimeRange(s.Ctx, 1, tc.startTime, tc.endTime, denom1, denom0)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRecords, actualRecords)
+		})
+	}
+}
+
 func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 	// N.B.: the records follow the following naming convention:
 	// <pool id><delta from base time in seconds><delta from base time in milliseconds>
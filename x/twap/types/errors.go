@@ -73,3 +73,51 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type InvalidCandleIntervalError struct {
+	Interval time.Duration
+}
+
+func (e InvalidCandleIntervalError) Error() string {
+	return fmt.Sprintf("candle interval must be positive, was %s", e.Interval)
+}
+
+type TooManyCandlesError struct {
+	NumCandles int64
+	MaxCandles int64
+}
+
+func (e TooManyCandlesError) Error() string {
+	return fmt.Sprintf("requested %d candles, which is more than the maximum of %d. Use a larger interval or a shorter time range", e.NumCandles, e.MaxCandles)
+}
+
+type EmptyTwapRouteError struct{}
+
+func (e EmptyTwapRouteError) Error() string {
+	return "twap route must have at least one hop"
+}
+
+type TwapRouteTooLongError struct {
+	NumHops int
+	MaxHops int
+}
+
+func (e TwapRouteTooLongError) Error() string {
+	return fmt.Sprintf("twap route has %d hops, which is more than the maximum of %d", e.NumHops, e.MaxHops)
+}
+
+type TwapRouteHopError struct {
+	Hop        int
+	PoolId     uint64
+	BaseAsset  string
+	QuoteAsset string
+	Err        error
+}
+
+func (e TwapRouteHopError) Error() string {
+	return fmt.Sprintf("failed to get the twap of hop %d (pool %d, base asset %s, quote asset %s): %s", e.Hop, e.PoolId, e.BaseAsset, e.QuoteAsset, e.Err)
+}
+
+func (e TwapRouteHopError) Unwrap() error {
+	return e.Err
+}
@@ -44,10 +44,21 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+
+	// Transient store prefixes.
+	// format is changed pool prefix | pool id (little endian)
+	ChangedPoolTransientPrefix = []byte{0x01}
+	// format is spot price range prefix | pool id | denom1 | denom2
+	SpotPriceRangeTransientPrefix = []byte{0x02}
 )
 
 // TODO: make utility command to automatically interlace separators
 
+func FormatSpotPriceRangeKey(poolId uint64, denom1, denom2 string) []byte {
+	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", SpotPriceRangeTransientPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
+}
+
 func FormatKeyPoolTwapRecords(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", HistoricalTWAPPoolIndexPrefix, poolId))
 }
@@ -108,5 +119,18 @@ func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if twap.GeometricTwapAccumulator.IsNil() {
 		twap.GeometricTwapAccumulator = osmomath.ZeroDec()
 	}
+	// records written before OHLC tracking lack these fields.
+	if twap.P0HighSpotPrice.IsNil() {
+		twap.P0HighSpotPrice = osmomath.ZeroDec()
+	}
+	if twap.P0LowSpotPrice.IsNil() {
+		twap.P0LowSpotPrice = osmomath.ZeroDec()
+	}
+	if twap.P1HighSpotPrice.IsNil() {
+		twap.P1HighSpotPrice = osmomath.ZeroDec()
+	}
+	if twap.P1LowSpotPrice.IsNil() {
+		twap.P1LowSpotPrice = osmomath.ZeroDec()
+	}
 	return twap, err
 }
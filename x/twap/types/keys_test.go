@@ -71,6 +71,11 @@ func TestParseTwapFromBz(t *testing.T) {
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+
+		P0HighSpotPrice: osmomath.NewDecWithPrec(1, 5),
+		P0LowSpotPrice:  osmomath.NewDecWithPrec(1, 5),
+		P1HighSpotPrice: osmomath.NewDecWithPrec(2, 5),
+		P1LowSpotPrice:  osmomath.NewDecWithPrec(2, 5),
 	}
 
 	withGeomAcc := func(r TwapRecord, acc osmomath.Dec) TwapRecord {
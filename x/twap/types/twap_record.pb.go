@@ -57,6 +57,13 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// The highest and lowest spot prices observed during the block this record
+	// was written in, including the spot price at the end of the block.
+	// Used to compute OHLC candles without missing intra-block price moves.
+	P0HighSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=p0_high_spot_price,json=p0HighSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p0_high_spot_price"`
+	P0LowSpotPrice  cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=p0_low_spot_price,json=p0LowSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p0_low_spot_price"`
+	P1HighSpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=p1_high_spot_price,json=p1HighSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p1_high_spot_price"`
+	P1LowSpotPrice  cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=p1_low_spot_price,json=p1LowSpotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p1_low_spot_price"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -134,6 +141,162 @@ func (m *TwapRecord) GetLastErrorTime() time.Time {
 	return time.Time{}
 }
 
+// SpotPriceRange is the range of spot prices of an asset pair observed during
+// the current block. It only lives in the transient store and is folded into
+// the pair's TWAP record at the end of the block.
+type SpotPriceRange struct {
+	P0High cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=p0_high,json=p0High,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p0_high"`
+	P0Low  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=p0_low,json=p0Low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p0_low"`
+	P1High cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=p1_high,json=p1High,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p1_high"`
+	P1Low  cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=p1_low,json=p1Low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"p1_low"`
+}
+
+func (m *SpotPriceRange) Reset()         { *m = SpotPriceRange{} }
+func (m *SpotPriceRange) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceRange) ProtoMessage()    {}
+func (*SpotPriceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{1}
+}
+func (m *SpotPriceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceRange.Merge(m, src)
+}
+func (m *SpotPriceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceRange proto.InternalMessageInfo
+
+// Candle is the open, high, low and close spot price of the base asset in
+// units of the quote asset over [start_time, end_time].
+type Candle struct {
+	StartTime time.Time                   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime   time.Time                   `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Open      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	High      cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	Low       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	Close     cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{2}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// TwapRouteHop is a hop of a multi-hop TWAP route. It prices the quote asset of
+// the previous hop (or the base asset for the first hop) in units of
+// quote_asset, as determined by prices from pool pool_id.
+type TwapRouteHop struct {
+	PoolId     uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	QuoteAsset string `protobuf:"bytes,2,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty" yaml:"quote_asset"`
+}
+
+func (m *TwapRouteHop) Reset()         { *m = TwapRouteHop{} }
+func (m *TwapRouteHop) String() string { return proto.CompactTextString(m) }
+func (*TwapRouteHop) ProtoMessage()    {}
+func (*TwapRouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf5c78678e601aa, []int{3}
+}
+func (m *TwapRouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRouteHop.Merge(m, src)
+}
+func (m *TwapRouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRouteHop proto.InternalMessageInfo
+
+func (m *TwapRouteHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRouteHop) GetQuoteAsset() string {
+	if m != nil {
+		return m.QuoteAsset
+	}
+	return ""
+}
+
 // PruningState allows us to spread out the pruning of TWAP records over time,
 // instead of pruning all at once at the end of the epoch.
 type PruningState struct {
@@ -158,7 +321,7 @@ func (m *PruningState) Reset()         { *m = PruningState{} }
 func (m *PruningState) String() string { return proto.CompactTextString(m) }
 func (*PruningState) ProtoMessage()    {}
 func (*PruningState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf5c78678e601aa, []int{1}
+	return fileDescriptor_dbf5c78678e601aa, []int{4}
 }
 func (m *PruningState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,6 +381,9 @@ func (m *PruningState) GetLastSeenPoolId() uint64 {
 
 func init() {
 	proto.RegisterType((*TwapRecord)(nil), "osmosis.twap.v1beta1.TwapRecord")
+	proto.RegisterType((*SpotPriceRange)(nil), "osmosis.twap.v1beta1.SpotPriceRange")
+	proto.RegisterType((*Candle)(nil), "osmosis.twap.v1beta1.Candle")
+	proto.RegisterType((*TwapRouteHop)(nil), "osmosis.twap.v1beta1.TwapRouteHop")
 	proto.RegisterType((*PruningState)(nil), "osmosis.twap.v1beta1.PruningState")
 }
 
@@ -226,47 +392,64 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x4f, 0xdb, 0x40,
-	0x14, 0x8e, 0x21, 0x0d, 0x70, 0x09, 0x45, 0x58, 0xb4, 0x58, 0x41, 0xb5, 0x83, 0x2b, 0x55, 0x61,
-	0xa8, 0x1d, 0x97, 0x8d, 0x4e, 0x44, 0x74, 0x68, 0x8b, 0xaa, 0xc8, 0x30, 0x75, 0xb1, 0x2e, 0xf6,
-	0xc3, 0xb1, 0x88, 0x73, 0xa7, 0xbb, 0x0b, 0x34, 0xff, 0x05, 0xff, 0x52, 0x37, 0x46, 0xc6, 0xaa,
-	0x43, 0x5a, 0xc1, 0xd6, 0x91, 0xb1, 0x53, 0x75, 0x77, 0x4e, 0x4a, 0xe8, 0x0f, 0x60, 0xf3, 0x7b,
-	0xf7, 0xbd, 0xef, 0xfb, 0x9e, 0xef, 0xdd, 0x43, 0x2f, 0x08, 0xcf, 0x09, 0xcf, 0xb8, 0x2f, 0x4e,
-	0x31, 0xf5, 0x4f, 0x82, 0x2e, 0x08, 0x1c, 0xa8, 0x20, 0x62, 0x10, 0x13, 0x96, 0x78, 0x94, 0x11,
-	0x41, 0xcc, 0xb5, 0x02, 0xe7, 0xc9, 0x23, 0xaf, 0xc0, 0xd5, 0xd7, 0x52, 0x92, 0x12, 0x05, 0xf0,
-	0xe5, 0x97, 0xc6, 0xd6, 0x9d, 0x94, 0x90, 0xb4, 0x0f, 0xbe, 0x8a, 0xba, 0xc3, 0x23, 0x5f, 0x64,
-	0x39, 0x70, 0x81, 0x73, 0xaa, 0x01, 0xee, 0xe7, 0x0a, 0x42, 0x87, 0xa7, 0x98, 0x86, 0x4a, 0xc1,
-	0x5c, 0x47, 0x0b, 0x94, 0x90, 0x7e, 0x94, 0x25, 0x96, 0xd1, 0x30, 0x9a, 0xe5, 0xb0, 0x22, 0xc3,
-	0xb7, 0x89, 0xb9, 0x89, 0x6a, 0x98, 0x73, 0x10, 0xad, 0x28, 0x81, 0x01, 0xc9, 0xad, 0xb9, 0x86,
-	0xd1, 0x5c, 0x0a, 0xab, 0x3a, 0xb7, 0x27, 0x53, 0x53, 0x48, 0x50, 0x40, 0xe6, 0x6f, 0x40, 0x02,
-	0x0d, 0xd9, 0x45, 0x95, 0x1e, 0x64, 0x69, 0x4f, 0x58, 0xe5, 0x86, 0xd1, 0x9c, 0x6f, 0x6f, 0xfd,
-	0x18, 0x3b, 0xcb, 0xba, 0xb9, 0x48, 0x1f, 0x5c, 0x8f, 0x9d, 0xb5, 0x11, 0xce, 0xfb, 0x3b, 0xee,
-	0x4c, 0xda, 0x0d, 0x8b, 0x42, 0xf3, 0x03, 0x2a, 0xcb, 0x1e, 0xac, 0x47, 0x0d, 0xa3, 0x59, 0x7d,
-	0x55, 0xf7, 0x74, 0x83, 0xde, 0xa4, 0x41, 0xef, 0x70, 0xd2, 0x60, 0xdb, 0x3e, 0x1f, 0x3b, 0xa5,
-	0xeb, 0xb1, 0x63, 0xce, 0xf0, 0xc9, 0x62, 0xf7, 0xec, 0x9b, 0x63, 0x84, 0x8a, 0xc7, 0xec, 0x20,
-	0x93, 0xb6, 0xa2, 0x3e, 0xe6, 0x22, 0xe2, 0x94, 0x88, 0x88, 0xb2, 0x2c, 0x06, 0xab, 0x22, 0xbd,
-	0xb7, 0x9f, 0x4b, 0x86, 0xaf, 0x63, 0x67, 0x23, 0x56, 0xbf, 0x9c, 0x27, 0xc7, 0x5e, 0x46, 0xfc,
-	0x1c, 0x8b, 0x9e, 0xb7, 0x0f, 0x29, 0x8e, 0x47, 0x7b, 0x10, 0x87, 0x2b, 0xb4, 0xb5, 0x8f, 0xb9,
-	0x38, 0xa0, 0x44, 0x74, 0x64, 0xad, 0x62, 0x0c, 0xfe, 0x60, 0x5c, 0x78, 0x08, 0x63, 0x30, 0xcb,
-	0xd8, 0x43, 0x36, 0x6d, 0x45, 0x98, 0x65, 0xa2, 0x97, 0x83, 0xc8, 0xe2, 0x48, 0x0d, 0x05, 0x8e,
-	0xe3, 0x61, 0x3e, 0xec, 0x63, 0x41, 0x98, 0xb5, 0x78, 0x7f, 0xf6, 0x0d, 0xda, 0xda, 0x9d, 0x32,
-	0xc9, 0xab, 0xdf, 0xfd, 0xcd, 0xa3, 0x94, 0x82, 0xff, 0x2a, 0x2d, 0x3d, 0x44, 0x29, 0xf8, 0xb7,
-	0x12, 0x46, 0xf5, 0x14, 0x48, 0x0e, 0x82, 0xfd, 0x4d, 0x05, 0xdd, 0x5f, 0xc5, 0x9a, 0xd2, 0xdc,
-	0x96, 0x38, 0x42, 0x2b, 0xea, 0x16, 0x80, 0x31, 0xc2, 0xd4, 0xc5, 0x5b, 0xd5, 0x3b, 0xa7, 0xc6,
-	0x2d, 0xa6, 0xe6, 0xa9, 0x9e, 0x9a, 0x5b, 0x04, 0x7a, 0x72, 0x96, 0x65, 0xf6, 0x8d, 0x4c, 0xca,
-	0x3a, 0xf7, 0xa7, 0x81, 0x6a, 0x1d, 0x36, 0x1c, 0x64, 0x83, 0xf4, 0x40, 0x60, 0x01, 0xe6, 0x33,
-	0x84, 0x32, 0x1e, 0x51, 0x9d, 0x52, 0x0f, 0x69, 0x31, 0x5c, 0xca, 0x78, 0x81, 0x31, 0x63, 0xf4,
-	0x58, 0xd1, 0x1e, 0x03, 0x15, 0xda, 0xd6, 0xdc, 0x9d, 0xb6, 0x36, 0x0b, 0x5b, 0x4f, 0x6e, 0xd8,
-	0x9a, 0xd6, 0x6b, 0x57, 0x35, 0x99, 0x7c, 0x0f, 0x54, 0xc8, 0x2a, 0xf3, 0x35, 0x5a, 0x2e, 0x40,
-	0xa3, 0x88, 0x03, 0x0c, 0xd4, 0x73, 0xac, 0xb5, 0xd7, 0xaf, 0xc7, 0xce, 0x6a, 0x02, 0x94, 0x41,
-	0x8c, 0x05, 0x24, 0x3b, 0xae, 0x60, 0x43, 0x70, 0x2d, 0x23, 0xac, 0xea, 0xea, 0xd1, 0x01, 0xc0,
-	0xc0, 0xdc, 0x42, 0xab, 0x7a, 0x7e, 0x01, 0x06, 0xd1, 0x64, 0x21, 0x94, 0xd5, 0x42, 0x50, 0xd6,
-	0x25, 0xa8, 0xa3, 0x16, 0x43, 0xfb, 0xdd, 0xf9, 0xa5, 0x6d, 0x5c, 0x5c, 0xda, 0xc6, 0xf7, 0x4b,
-	0xdb, 0x38, 0xbb, 0xb2, 0x4b, 0x17, 0x57, 0x76, 0xe9, 0xcb, 0x95, 0x5d, 0xfa, 0xd8, 0x4a, 0x33,
-	0xd1, 0x1b, 0x76, 0xbd, 0x98, 0xe4, 0x7e, 0xb1, 0xb2, 0x5e, 0xf6, 0x71, 0x97, 0x4f, 0x02, 0xff,
-	0x64, 0x3b, 0xf0, 0x3f, 0xe9, 0x6d, 0x27, 0x46, 0x14, 0x78, 0xb7, 0xa2, 0x1a, 0xdf, 0xfe, 0x15,
-	0x00, 0x00, 0xff, 0xff, 0x8e, 0x49, 0xf2, 0x91, 0x0a, 0x05, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x63, 0x59, 0xb6, 0x56, 0xb2, 0x5c, 0x13, 0x6e, 0x42, 0xd8, 0x88, 0xe4, 0xb0, 0x40,
+	0xe1, 0xa0, 0x28, 0x29, 0x36, 0x28, 0x82, 0xba, 0xbd, 0x58, 0x4d, 0x81, 0xb4, 0x35, 0x02, 0x83,
+	0xf6, 0xa1, 0xe8, 0x85, 0x58, 0x91, 0x13, 0x92, 0x88, 0xc8, 0xdd, 0x72, 0x57, 0x71, 0x75, 0x6f,
+	0xef, 0x39, 0xf7, 0x17, 0xe5, 0x98, 0x63, 0xd1, 0x83, 0x5a, 0xd8, 0xb7, 0x1e, 0x7d, 0xec, 0xa9,
+	0xd8, 0x59, 0xea, 0xc3, 0x4a, 0x9b, 0x50, 0x37, 0xce, 0xec, 0xcc, 0x9b, 0xd9, 0x37, 0x0f, 0xb3,
+	0x24, 0x1f, 0x33, 0x91, 0x31, 0x91, 0x0a, 0x57, 0x5e, 0x52, 0xee, 0xbe, 0xf4, 0x06, 0x20, 0xa9,
+	0x87, 0x46, 0x50, 0x40, 0xc8, 0x8a, 0xc8, 0xe1, 0x05, 0x93, 0xcc, 0xdc, 0x2b, 0xe3, 0x1c, 0x75,
+	0xe4, 0x94, 0x71, 0xfb, 0x7b, 0x31, 0x8b, 0x19, 0x06, 0xb8, 0xea, 0x4b, 0xc7, 0xee, 0x77, 0x63,
+	0xc6, 0xe2, 0x21, 0xb8, 0x68, 0x0d, 0x46, 0xcf, 0x5d, 0x99, 0x66, 0x20, 0x24, 0xcd, 0xb8, 0x0e,
+	0xb0, 0x7f, 0x6d, 0x10, 0x72, 0x71, 0x49, 0xb9, 0x8f, 0x15, 0xcc, 0x7b, 0x64, 0x93, 0x33, 0x36,
+	0x0c, 0xd2, 0xc8, 0x32, 0x0e, 0x8d, 0xa3, 0x9a, 0x5f, 0x57, 0xe6, 0xb7, 0x91, 0xf9, 0x80, 0xb4,
+	0xa8, 0x10, 0x20, 0x7b, 0x41, 0x04, 0x39, 0xcb, 0xac, 0x3b, 0x87, 0xc6, 0x51, 0xc3, 0x6f, 0x6a,
+	0xdf, 0x13, 0xe5, 0x9a, 0x85, 0x78, 0x65, 0xc8, 0xfa, 0x42, 0x88, 0xa7, 0x43, 0x4e, 0x48, 0x3d,
+	0x81, 0x34, 0x4e, 0xa4, 0x55, 0x3b, 0x34, 0x8e, 0xd6, 0xfb, 0x0f, 0xff, 0x9e, 0x74, 0xb7, 0xf5,
+	0xe5, 0x02, 0x7d, 0x70, 0x33, 0xe9, 0xee, 0x8d, 0x69, 0x36, 0x3c, 0xb6, 0x6f, 0xb9, 0x6d, 0xbf,
+	0x4c, 0x34, 0x9f, 0x91, 0x9a, 0xba, 0x83, 0xb5, 0x71, 0x68, 0x1c, 0x35, 0x3f, 0xdb, 0x77, 0xf4,
+	0x05, 0x9d, 0xe9, 0x05, 0x9d, 0x8b, 0xe9, 0x05, 0xfb, 0x9d, 0xd7, 0x93, 0xee, 0xda, 0xcd, 0xa4,
+	0x6b, 0xde, 0xc2, 0x53, 0xc9, 0xf6, 0xab, 0x3f, 0xbb, 0x86, 0x8f, 0x38, 0xe6, 0x19, 0x31, 0x79,
+	0x2f, 0x18, 0x52, 0x21, 0x03, 0xc1, 0x99, 0x0c, 0x78, 0x91, 0x86, 0x60, 0xd5, 0x55, 0xef, 0xfd,
+	0x8f, 0x14, 0xc2, 0x1f, 0x93, 0xee, 0x41, 0x88, 0x94, 0x8b, 0xe8, 0x85, 0x93, 0x32, 0x37, 0xa3,
+	0x32, 0x71, 0x4e, 0x21, 0xa6, 0xe1, 0xf8, 0x09, 0x84, 0xfe, 0x0e, 0xef, 0x9d, 0x52, 0x21, 0xcf,
+	0x39, 0x93, 0x67, 0x2a, 0x17, 0x11, 0xbd, 0xb7, 0x10, 0x37, 0x57, 0x41, 0xf4, 0x6e, 0x23, 0x26,
+	0xa4, 0xc3, 0x7b, 0x01, 0x2d, 0x52, 0x99, 0x64, 0x20, 0xd3, 0x30, 0x40, 0x51, 0xd0, 0x30, 0x1c,
+	0x65, 0xa3, 0x21, 0x95, 0xac, 0xb0, 0xb6, 0xaa, 0xa3, 0x1f, 0xf0, 0xde, 0xc9, 0x0c, 0x49, 0x8d,
+	0xfe, 0x64, 0x8e, 0x83, 0x95, 0xbc, 0x77, 0x56, 0x6a, 0xac, 0x52, 0xc9, 0xfb, 0xff, 0x4a, 0x94,
+	0xec, 0xc7, 0xc0, 0x32, 0x90, 0xc5, 0x7f, 0x55, 0x21, 0xd5, 0xab, 0x58, 0x33, 0x98, 0xe5, 0x12,
+	0xcf, 0xc9, 0x0e, 0x4e, 0x01, 0x8a, 0x82, 0x15, 0x38, 0x78, 0xab, 0xf9, 0x5e, 0xd5, 0xd8, 0xa5,
+	0x6a, 0xee, 0x6a, 0xd5, 0x2c, 0x01, 0x68, 0xe5, 0x6c, 0x2b, 0xef, 0x37, 0xca, 0x79, 0x31, 0x97,
+	0x50, 0x92, 0xc6, 0xc9, 0xe2, 0xc0, 0xb7, 0x57, 0x92, 0xd0, 0xd3, 0x34, 0x4e, 0xe6, 0x03, 0x7f,
+	0x46, 0x76, 0x95, 0x28, 0xd9, 0xe5, 0x22, 0x60, 0xbb, 0x3a, 0x60, 0x9b, 0xf7, 0x4e, 0xd9, 0xe5,
+	0xb2, 0x24, 0x97, 0x3b, 0xdc, 0x59, 0x49, 0x92, 0x6f, 0x77, 0xe8, 0x2d, 0x77, 0xf8, 0xc1, 0x2a,
+	0x1d, 0x7a, 0x8b, 0x1d, 0xda, 0xbf, 0xdc, 0x21, 0xed, 0x99, 0xe5, 0xd3, 0x3c, 0x06, 0xf3, 0x2b,
+	0xb2, 0x59, 0xd2, 0x8a, 0xbb, 0xa8, 0x22, 0x70, 0x5d, 0x73, 0x69, 0x1e, 0x93, 0xba, 0xa6, 0x50,
+	0xaf, 0xaa, 0x6a, 0xc9, 0x1b, 0xc8, 0x1b, 0x56, 0xd6, 0x74, 0xe9, 0x25, 0x56, 0xb5, 0xb2, 0x37,
+	0xab, 0x8c, 0xd4, 0xe0, 0x92, 0xab, 0x5c, 0x59, 0xf1, 0x61, 0xff, 0xb6, 0x4e, 0xea, 0x5f, 0xd3,
+	0x3c, 0x1a, 0x82, 0xf9, 0x03, 0x21, 0x42, 0xd2, 0x42, 0x6a, 0xe1, 0x1a, 0xef, 0x15, 0xee, 0xfd,
+	0x52, 0xb8, 0xbb, 0x5a, 0xb8, 0xf3, 0x5c, 0xad, 0xd9, 0x06, 0x3a, 0x50, 0xaf, 0x3e, 0xd9, 0x82,
+	0x5c, 0x6f, 0x42, 0x24, 0xe7, 0xdd, 0xb8, 0x07, 0x25, 0xee, 0x8e, 0xc6, 0x9d, 0x66, 0x6a, 0xd4,
+	0x4d, 0xc8, 0x23, 0xc4, 0x7c, 0x4c, 0x6a, 0x8c, 0x43, 0xbe, 0x0a, 0x5f, 0x98, 0xa0, 0x12, 0x91,
+	0xe8, 0x15, 0xb8, 0xc2, 0x04, 0xf3, 0x73, 0xb2, 0xae, 0x38, 0xde, 0xa8, 0x9e, 0xa7, 0xe2, 0xcd,
+	0x2f, 0xc8, 0x46, 0x38, 0x64, 0x62, 0xa5, 0x15, 0xaf, 0x33, 0x6c, 0x49, 0x5a, 0xf8, 0x54, 0xb2,
+	0x91, 0x84, 0xa7, 0x8c, 0x9b, 0x9f, 0x2c, 0x3d, 0x96, 0x7d, 0xf3, 0x66, 0xd2, 0x6d, 0x6b, 0x9a,
+	0xca, 0x03, 0x7b, 0xf6, 0x80, 0x3e, 0x26, 0xcd, 0x9f, 0x46, 0x4c, 0x42, 0x80, 0xef, 0x61, 0x29,
+	0xca, 0xbb, 0xf3, 0xe7, 0x69, 0xe1, 0xd0, 0xf6, 0x09, 0x5a, 0x27, 0x68, 0xfc, 0x63, 0x90, 0xd6,
+	0x59, 0x31, 0xca, 0xd3, 0x3c, 0x3e, 0x97, 0x54, 0x82, 0x79, 0x9f, 0x90, 0x54, 0x04, 0x5c, 0xbb,
+	0xb0, 0xf2, 0x96, 0xdf, 0x48, 0x45, 0x19, 0x63, 0x86, 0xa4, 0x8d, 0x4b, 0xeb, 0x05, 0x70, 0x59,
+	0x75, 0xc6, 0x0f, 0xca, 0x19, 0x7f, 0xb8, 0xb0, 0xf4, 0x66, 0xf9, 0x7a, 0xd2, 0x2d, 0xe5, 0xfc,
+	0x1e, 0xb8, 0x96, 0xd0, 0x97, 0x64, 0xbb, 0x0c, 0x1a, 0x07, 0x02, 0xca, 0xb9, 0xb7, 0xfa, 0xf7,
+	0x94, 0xfe, 0x22, 0xe0, 0x05, 0x84, 0x54, 0x42, 0x74, 0x6c, 0xcb, 0x62, 0x04, 0xb6, 0x65, 0xf8,
+	0x4d, 0x9d, 0x3d, 0x3e, 0x07, 0xc8, 0xcd, 0x87, 0x64, 0x57, 0xbf, 0x8e, 0x00, 0x79, 0x30, 0x65,
+	0xb0, 0x86, 0xbf, 0x1b, 0xd8, 0xba, 0x0a, 0x3a, 0x43, 0xd6, 0xfa, 0xdf, 0xbd, 0xbe, 0xea, 0x18,
+	0x6f, 0xae, 0x3a, 0xc6, 0x5f, 0x57, 0x1d, 0xe3, 0xd5, 0x75, 0x67, 0xed, 0xcd, 0x75, 0x67, 0xed,
+	0xf7, 0xeb, 0xce, 0xda, 0x8f, 0xbd, 0x38, 0x95, 0xc9, 0x68, 0xe0, 0x84, 0x2c, 0x73, 0xcb, 0x1f,
+	0xa2, 0x4f, 0x87, 0x74, 0x20, 0xa6, 0x86, 0xfb, 0xf2, 0x91, 0xe7, 0xfe, 0xac, 0xff, 0xa5, 0xe4,
+	0x98, 0x83, 0x18, 0xd4, 0xf1, 0xe2, 0x8f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x66, 0x4e, 0xb7,
+	0x8c, 0x68, 0x09, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -289,6 +472,48 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.P1LowSpotPrice.Size()
+		i -= size
+		if _, err := m.P1LowSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.P1HighSpotPrice.Size()
+		i -= size
+		if _, err := m.P1HighSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.P0LowSpotPrice.Size()
+		i -= size
+		if _, err := m.P0LowSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.P0HighSpotPrice.Size()
+		i -= size
+		if _, err := m.P0HighSpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -382,6 +607,183 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpotPriceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.P1Low.Size()
+		i -= size
+		if _, err := m.P1Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.P1High.Size()
+		i -= size
+		if _, err := m.P1High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.P0Low.Size()
+		i -= size
+		if _, err := m.P0Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.P0High.Size()
+		i -= size
+		if _, err := m.P0High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintTwapRecord(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PruningState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,12 +816,12 @@ func (m *PruningState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastKeptTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastKeptTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastKeptTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastKeptTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTwapRecord(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.IsPruning {
@@ -480,7 +882,69 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
-	return n
+	l = m.P0HighSpotPrice.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P0LowSpotPrice.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1HighSpotPrice.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1LowSpotPrice.Size()
+	n += 2 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *SpotPriceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.P0High.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P0Low.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1High.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.P1Low.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func (m *TwapRouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwapRecord(uint64(m.PoolId))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	return n
 }
 
 func (m *PruningState) Size() (n int) {
@@ -501,16 +965,540 @@ func (m *PruningState) Size() (n int) {
 	if m.LastSeenPoolId != 0 {
 		n += 1 + sovTwapRecord(uint64(m.LastSeenPoolId))
 	}
-	return n
-}
+	return n
+}
+
+func sovTwapRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwapRecord(x uint64) (n int) {
+	return sovTwapRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset0Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset1Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0LastSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1LastSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0ArithmeticTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0ArithmeticTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1ArithmeticTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1ArithmeticTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwapAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeometricTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0HighSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0HighSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0LowSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0LowSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1HighSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1HighSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1LowSpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1LowSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTwapRecord(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTwapRecord(x uint64) (n int) {
-	return sovTwapRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+func (m *SpotPriceRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -533,17 +1521,17 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P0High", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTwapRecord
@@ -553,14 +1541,29 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P0High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P0Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -588,11 +1591,13 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset0Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.P0Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P1High", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -620,13 +1625,15 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset1Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.P1High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P1Low", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTwapRecord
@@ -636,14 +1643,79 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P1Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -670,15 +1742,15 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P0LastSpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTwapRecord
@@ -688,29 +1760,28 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTwapRecord
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTwapRecord
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.P0LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P1LastSpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -738,13 +1809,13 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.P1LastSpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P0ArithmeticTwapAccumulator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -772,13 +1843,13 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.P0ArithmeticTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field P1ArithmeticTwapAccumulator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -806,13 +1877,13 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.P1ArithmeticTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwapAccumulator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -840,15 +1911,84 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeometricTwapAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTwapRecord
@@ -858,24 +1998,23 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTwapRecord
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTwapRecord
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
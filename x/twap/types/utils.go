@@ -11,8 +11,17 @@ import (
 var (
 	MaxSpotPrice       = osmomath.NewDec(2).Power(128).Sub(osmomath.OneDec())
 	MaxSpotPriceBigDec = osmomath.BigDecFromDec(MaxSpotPrice)
+
+	// Ln2 is the natural logarithm of 2, used to convert base 2 log prices to natural log prices.
+	Ln2 = osmomath.MustNewDecFromStr("0.693147180559945309")
 )
 
+// MaxCandles is the maximum number of OHLC candles that can be requested at once.
+const MaxCandles = 1000
+
+// MaxTwapRouteHops is the maximum number of hops of a multi-hop TWAP route.
+const MaxTwapRouteHops = 5
+
 // GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
 // (X, Y), X < Y.
 // The pair (X,Y) should only appear once in the list. Denoms are lexicographically sorted.
@@ -76,3 +85,31 @@ type DenomPair struct {
 	Denom0 string
 	Denom1 string
 }
+
+// NewSpotPriceRange returns a range containing only the given spot prices.
+func NewSpotPriceRange(sp0, sp1 osmomath.Dec) SpotPriceRange {
+	return SpotPriceRange{P0High: sp0, P0Low: sp0, P1High: sp1, P1Low: sp1}
+}
+
+// With returns the range widened to include the given spot prices.
+func (r SpotPriceRange) With(sp0, sp1 osmomath.Dec) SpotPriceRange {
+	return SpotPriceRange{
+		P0High: osmomath.MaxDec(r.P0High, sp0),
+		P0Low:  osmomath.MinDec(r.P0Low, sp0),
+		P1High: osmomath.MaxDec(r.P1High, sp1),
+		P1Low:  osmomath.MinDec(r.P1Low, sp1),
+	}
+}
+
+// SpotPriceRangeOf returns the range of spot prices observed during the block the record was written in.
+// Records written before ranges were tracked fall back to their last spot prices.
+func SpotPriceRangeOf(record TwapRecord) SpotPriceRange {
+	spotPriceRange := NewSpotPriceRange(record.P0LastSpotPrice, record.P1LastSpotPrice)
+	if !record.P0HighSpotPrice.IsNil() && !record.P0HighSpotPrice.IsZero() {
+		spotPriceRange.P0High, spotPriceRange.P0Low = record.P0HighSpotPrice, record.P0LowSpotPrice
+	}
+	if !record.P1HighSpotPrice.IsNil() && !record.P1HighSpotPrice.IsZero() {
+		spotPriceRange.P1High, spotPriceRange.P1Low = record.P1HighSpotPrice, record.P1LowSpotPrice
+	}
+	return spotPriceRange
+}